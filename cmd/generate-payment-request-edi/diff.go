@@ -0,0 +1,47 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	ediinvoice "github.com/transcom/mymove/pkg/edi/invoice"
+)
+
+// diffPaymentRequestEDIs prints the differences between two 858 files, such as the invoice Syncada
+// rejected and the one generated after fixing the payment request
+func diffPaymentRequestEDIs(cmd *cobra.Command, args []string) error {
+	left, err := readInvoice(args[0])
+	if err != nil {
+		return err
+	}
+	right, err := readInvoice(args[1])
+	if err != nil {
+		return err
+	}
+
+	differences := ediinvoice.Diff(left, right)
+	if len(differences) == 0 {
+		fmt.Fprintln(cmd.OutOrStdout(), "The 858s are the same")
+		return nil
+	}
+
+	for _, difference := range differences {
+		fmt.Fprintln(cmd.OutOrStdout(), difference.String())
+	}
+	return fmt.Errorf("found %d differences between %s and %s", len(differences), args[0], args[1])
+}
+
+func readInvoice(path string) (ediinvoice.Invoice858C, error) {
+	var invoice ediinvoice.Invoice858C
+	content, err := os.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return invoice, fmt.Errorf("could not read %s: %w", path, err)
+	}
+	if err := invoice.Parse(string(content)); err != nil {
+		return invoice, fmt.Errorf("could not parse %s: %w", path, err)
+	}
+	return invoice, nil
+}
@@ -9,6 +9,7 @@ import (
 	"strings"
 
 	"github.com/benbjohnson/clock"
+	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 	"github.com/spf13/viper"
 	"go.uber.org/zap"
@@ -27,6 +28,8 @@ import (
 
 // Call this from command line with go run ./cmd/generate-payment-request-edi/ --payment-request-number <paymentRequestNumber>
 // Must use a payment request that is submitted, but not yet approved for payment (that does not already have a submitted invoice)
+//
+// Compare two 858s with go run ./cmd/generate-payment-request-edi/ diff <left.edi> <right.edi>
 
 func checkConfig(v *viper.Viper, logger *zap.Logger) error {
 	paymentRequestNumber := v.GetString("payment-request-number")
@@ -57,13 +60,31 @@ func initFlags(flag *pflag.FlagSet) {
 }
 
 func main() {
-	flag := pflag.CommandLine
-	initFlags(flag)
-	parseErr := flag.Parse(os.Args[1:])
-	if parseErr != nil {
-		log.Fatal("failed to parse flags", zap.Error(parseErr))
+	root := cobra.Command{
+		Use:          "generate-payment-request-edi [flags]",
+		Short:        "Generates an 858 for a payment request",
+		Long:         "Generates an 858 for a payment request",
+		RunE:         generatePaymentRequestEDI,
+		SilenceUsage: true,
 	}
+	initFlags(root.Flags())
+
+	root.AddCommand(&cobra.Command{
+		Use:          "diff <left 858 file> <right 858 file>",
+		Short:        "Compares two 858s segment by segment",
+		Long:         "Parses two 858 EDI files and prints every segment and element that differs between them",
+		Args:         cobra.ExactArgs(2),
+		RunE:         diffPaymentRequestEDIs,
+		SilenceUsage: true,
+	})
+
+	if err := root.Execute(); err != nil {
+		os.Exit(1)
+	}
+}
 
+func generatePaymentRequestEDI(cmd *cobra.Command, _ []string) error {
+	flag := cmd.Flags()
 	v := viper.New()
 	bindErr := v.BindPFlags(flag)
 	if bindErr != nil {
@@ -158,4 +179,5 @@ func main() {
 		}
 
 	}
+	return nil
 }
@@ -47,6 +47,8 @@ func main() {
 	flag.StringVar(&params.ContractCode, "contract-code", "", "Contract code to use for this import")
 	flag.StringVar(&params.ContractName, "contract-name", "", "Contract name to use for this import; if not provided, the contract-code value will be used")
 	flag.StringVar(&params.ContractStartDate, "contract-start-date", "2021-02-01", "Beginning base date for contracts periods, in format: YYYY-MM-DD; if not provided, 2021-02-01 will be used")
+	flag.StringVar(&params.ContractEffectiveDate, "contract-effective-date", "", "First day the contract is used to price moves, in format: YYYY-MM-DD; required when its contract years overlap an existing contract")
+	flag.StringVar(&params.ContractExpirationDate, "contract-expiration-date", "", "Last day the contract is used to price moves, in format: YYYY-MM-DD; if not provided, the contract does not expire")
 	flag.BoolVar(&params.ProcessAll, "all", true, "Parse entire GHC Rate Engine XLSX")
 	flag.StringSliceVar(&params.XlsxSheets, "xlsxSheets", []string{}, xlsxSheetsUsage(xlsxDataSheets))
 	flag.BoolVar(&params.ShowOutput, "display", false, "Display output of parsed info")
@@ -122,6 +124,15 @@ func main() {
 		logger.Fatal("could not parse the given contract start date", zap.Error(err))
 	}
 
+	contractEffectiveDate, err := parseOptionalDate(params.ContractEffectiveDate)
+	if err != nil {
+		logger.Fatal("could not parse the given contract effective date", zap.Error(err))
+	}
+	contractExpirationDate, err := parseOptionalDate(params.ContractExpirationDate)
+	if err != nil {
+		logger.Fatal("could not parse the given contract expiration date", zap.Error(err))
+	}
+
 	if params.ShowOutput {
 		pterm.EnableDebugMessages()
 	}
@@ -150,9 +161,11 @@ func main() {
 	if params.RunImport {
 		printDivider("Importing")
 		ghcREImporter := ghcimport.GHCRateEngineImporter{
-			ContractCode:      params.ContractCode,
-			ContractName:      params.ContractName,
-			ContractStartDate: basePeriodStartDateForPrimeContract1,
+			ContractCode:           params.ContractCode,
+			ContractName:           params.ContractName,
+			ContractStartDate:      basePeriodStartDateForPrimeContract1,
+			ContractEffectiveDate:  contractEffectiveDate,
+			ContractExpirationDate: contractExpirationDate,
 		}
 		err = ghcREImporter.Import(appCtx)
 		if err != nil {
@@ -164,6 +177,18 @@ func main() {
 	}
 }
 
+// parseOptionalDate parses a YYYY-MM-DD flag value, returning nil when the flag was not given
+func parseOptionalDate(value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+	date, err := time.Parse("2006-01-02", value)
+	if err != nil {
+		return nil, err
+	}
+	return &date, nil
+}
+
 func summarizeXlsxStageParsing(appCtx appcontext.AppContext) error {
 	printDivider("XLSX to stage table parsing complete; summary follows")
 
@@ -0,0 +1,116 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+	"go.uber.org/zap"
+
+	"github.com/transcom/mymove/pkg/cli"
+	"github.com/transcom/mymove/pkg/iws"
+	"github.com/transcom/mymove/pkg/logging"
+)
+
+const (
+	// IWSRBSStandInFixturesFlag is the ENV var for the directory of RBS records the stand-in serves
+	IWSRBSStandInFixturesFlag string = "iws-rbs-stand-in-fixtures"
+)
+
+// Call this from the command line with go run ./cmd/milmove-tasks iws-rbs-stand-in
+
+func initIWSRBSStandInFlags(flag *pflag.FlagSet) {
+	// Logging Levels
+	cli.InitLoggingFlags(flag)
+
+	flag.String(cli.IWSRBSStandInAddressFlag, "localhost:8099", "Address the IWS RBS stand-in listens on")
+	flag.String(IWSRBSStandInFixturesFlag, "pkg/iws/fixtures/rbs", "Directory of RBS records, one .xml file per person, to serve")
+
+	// Don't sort flags
+	flag.SortFlags = false
+}
+
+func checkIWSRBSStandInConfig(v *viper.Viper) error {
+	if err := cli.CheckLogging(v); err != nil {
+		return err
+	}
+
+	if _, _, err := net.SplitHostPort(v.GetString(cli.IWSRBSStandInAddressFlag)); err != nil {
+		return fmt.Errorf("invalid value for %s: %w", cli.IWSRBSStandInAddressFlag, err)
+	}
+	if v.GetString(IWSRBSStandInFixturesFlag) == "" {
+		return fmt.Errorf("missing value for %s", IWSRBSStandInFixturesFlag)
+	}
+	return nil
+}
+
+// iwsRBSStandIn serves the IWS RBS REST API from fixture records, so identity lookups work in local
+// development without access to DMDC
+func iwsRBSStandIn(cmd *cobra.Command, args []string) error {
+	err := cmd.ParseFlags(args)
+	if err != nil {
+		return fmt.Errorf("could not parse args: %w", err)
+	}
+	v := viper.New()
+	err = v.BindPFlags(cmd.Flags())
+	if err != nil {
+		return fmt.Errorf("could not bind flags: %w", err)
+	}
+	v.SetEnvKeyReplacer(strings.NewReplacer("-", "_"))
+	v.AutomaticEnv()
+
+	logger, _, err := logging.Config(
+		logging.WithEnvironment(v.GetString(cli.LoggingEnvFlag)),
+		logging.WithLoggingLevel(v.GetString(cli.LoggingLevelFlag)),
+		logging.WithStacktraceLength(v.GetInt(cli.StacktraceLengthFlag)),
+	)
+	if err != nil {
+		log.Fatalf("Failed to initialize Zap logging due to %v", err)
+	}
+	zap.ReplaceGlobals(logger)
+
+	err = checkIWSRBSStandInConfig(v)
+	if err != nil {
+		logger.Fatal("invalid configuration", zap.Error(err))
+	}
+
+	standIn, err := iws.NewRBSStandInServer(logger, v.GetString(IWSRBSStandInFixturesFlag))
+	if err != nil {
+		logger.Fatal("couldn't load IWS RBS stand-in fixtures", zap.Error(err))
+	}
+
+	server := &http.Server{
+		Addr:              v.GetString(cli.IWSRBSStandInAddressFlag),
+		Handler:           standIn,
+		ReadHeaderTimeout: 5 * time.Second,
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if shutdownErr := server.Shutdown(shutdownCtx); shutdownErr != nil {
+			logger.Error("could not shut down IWS RBS stand-in", zap.Error(shutdownErr))
+		}
+	}()
+
+	logger.Info("IWS RBS stand-in listening, set IWS_RBS_STAND_IN_ADDRESS to use it", zap.String("address", server.Addr))
+	err = server.ListenAndServe()
+	if err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
@@ -77,6 +77,26 @@ func main() {
 	initConnectToGEXViaSFTPFlags(processEDIsCommand.Flags())
 	root.AddCommand(processEDIsCommand)
 
+	syncadaStandInCommand := &cobra.Command{
+		Use:          "syncada-stand-in",
+		Short:        "answers 858s for local development",
+		Long:         "answers the 858s uploaded to a local directory with 997s, 824s and TPPS paid invoice reports, optionally serving the directory over SFTP",
+		RunE:         syncadaStandIn,
+		SilenceUsage: true,
+	}
+	initSyncadaStandInFlags(syncadaStandInCommand.Flags())
+	root.AddCommand(syncadaStandInCommand)
+
+	iwsRBSStandInCommand := &cobra.Command{
+		Use:          "iws-rbs-stand-in",
+		Short:        "serves IWS RBS lookups for local development",
+		Long:         "serves the edi, pids-P and wkEma operations of the IWS RBS REST API from fixture records",
+		RunE:         iwsRBSStandIn,
+		SilenceUsage: true,
+	}
+	initIWSRBSStandInFlags(iwsRBSStandInCommand.Flags())
+	root.AddCommand(iwsRBSStandInCommand)
+
 	processTPPSCommand := &cobra.Command{
 		Use:          "process-tpps",
 		Short:        "process TPPS files asynchrounously",
@@ -87,6 +107,36 @@ func main() {
 	initProcessTPPSFlags(processTPPSCommand.Flags())
 	root.AddCommand(processTPPSCommand)
 
+	migrateUploadStorageKeysCommand := &cobra.Command{
+		Use:          "migrate-upload-storage-keys",
+		Short:        "move existing uploads to content-addressed storage keys",
+		Long:         "move existing uploads to content-addressed storage keys so duplicate files share one stored object",
+		RunE:         migrateUploadStorageKeys,
+		SilenceUsage: true,
+	}
+	initMigrateUploadStorageKeysFlags(migrateUploadStorageKeysCommand.Flags())
+	root.AddCommand(migrateUploadStorageKeysCommand)
+
+	repricePaymentRequestsCommand := &cobra.Command{
+		Use:          "reprice-payment-requests",
+		Short:        "reprice payment requests under a candidate contract",
+		Long:         "reprice historical payment requests under a candidate contract and report how each service item price would change",
+		RunE:         repricePaymentRequests,
+		SilenceUsage: true,
+	}
+	initRepricePaymentRequestsFlags(repricePaymentRequestsCommand.Flags())
+	root.AddCommand(repricePaymentRequestsCommand)
+
+	recalculatePaymentRequestsCommand := &cobra.Command{
+		Use:          "recalculate-payment-requests",
+		Short:        "recalculate pending payment requests in bulk",
+		Long:         "recalculate the pending payment requests matching a set of filters and report how each service item price changes, saving the recalculated payment requests only with --apply",
+		RunE:         recalculatePaymentRequests,
+		SilenceUsage: true,
+	}
+	initRecalculatePaymentRequestsFlags(recalculatePaymentRequestsCommand.Flags())
+	root.AddCommand(recalculatePaymentRequestsCommand)
+
 	completionCommand := &cobra.Command{
 		Use:   "completion",
 		Short: "Generates bash completion scripts",
@@ -0,0 +1,126 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+	"go.uber.org/zap"
+
+	"github.com/transcom/mymove/pkg/appcontext"
+	"github.com/transcom/mymove/pkg/cli"
+	"github.com/transcom/mymove/pkg/logging"
+	"github.com/transcom/mymove/pkg/storage"
+	"github.com/transcom/mymove/pkg/uploader"
+)
+
+const (
+	// migrateUploadsBatchSizeFlag is the number of uploads read from the database at a time
+	migrateUploadsBatchSizeFlag string = "batch-size"
+	// migrateUploadsDryRunFlag reports what would change without writing anything
+	migrateUploadsDryRunFlag string = "dry-run"
+	// migrateUploadsDeleteOldObjectsFlag removes original objects once they are unreferenced
+	migrateUploadsDeleteOldObjectsFlag string = "delete-old-objects"
+)
+
+func checkMigrateUploadStorageKeysConfig(v *viper.Viper, logger *zap.Logger) error {
+
+	logger.Debug("checking config")
+
+	err := cli.CheckStorage(v)
+	if err != nil {
+		return err
+	}
+
+	err = cli.CheckDatabase(v, logger)
+	if err != nil {
+		return err
+	}
+
+	if v.GetInt(migrateUploadsBatchSizeFlag) <= 0 {
+		return fmt.Errorf("invalid value for %s: must be greater than zero", migrateUploadsBatchSizeFlag)
+	}
+
+	return nil
+}
+
+func initMigrateUploadStorageKeysFlags(flag *pflag.FlagSet) {
+
+	// DB Config
+	cli.InitDatabaseFlags(flag)
+
+	// Storage
+	cli.InitStorageFlags(flag)
+
+	// Logging Levels
+	cli.InitLoggingFlags(flag)
+
+	flag.Int(migrateUploadsBatchSizeFlag, 500, "Number of uploads to read from the database at a time")
+	flag.Bool(migrateUploadsDryRunFlag, false, "Report which uploads would move without writing objects or updating the database")
+	flag.Bool(migrateUploadsDeleteOldObjectsFlag, false, "Delete each original object once no upload references it. Deletions are disabled on our S3 buckets as per our ATO, so only use this against local storage.")
+
+	// Don't sort flags
+	flag.SortFlags = false
+}
+
+// Command: go run ./cmd/milmove-tasks migrate-upload-storage-keys
+func migrateUploadStorageKeys(cmd *cobra.Command, args []string) error {
+
+	err := cmd.ParseFlags(args)
+	if err != nil {
+		return fmt.Errorf("could not parse args: %w", err)
+	}
+	flags := cmd.Flags()
+	v := viper.New()
+	err = v.BindPFlags(flags)
+	if err != nil {
+		return fmt.Errorf("could not bind flags: %w", err)
+	}
+	v.SetEnvKeyReplacer(strings.NewReplacer("-", "_"))
+	v.AutomaticEnv()
+
+	dbEnv := v.GetString(cli.DbEnvFlag)
+
+	logger, _, err := logging.Config(
+		logging.WithEnvironment(dbEnv),
+		logging.WithLoggingLevel(v.GetString(cli.LoggingLevelFlag)),
+		logging.WithStacktraceLength(v.GetInt(cli.StacktraceLengthFlag)),
+	)
+	if err != nil {
+		log.Fatalf("Failed to initialize Zap logging due to %v", err)
+	}
+	zap.ReplaceGlobals(logger)
+
+	err = checkMigrateUploadStorageKeysConfig(v, logger)
+	if err != nil {
+		logger.Fatal("invalid configuration", zap.Error(err))
+	}
+
+	// Create a connection to the DB
+	dbConnection, err := cli.InitDatabase(v, logger)
+	if err != nil {
+		logger.Fatal("Connecting to DB", zap.Error(err))
+	}
+
+	appCtx := appcontext.NewAppContext(dbConnection, logger, nil, nil)
+	storer := storage.InitStorage(v, logger)
+
+	result, err := uploader.MigrateToContentAddressedKeys(appCtx, storer, uploader.ContentAddressedMigrationParams{
+		BatchSize:        v.GetInt(migrateUploadsBatchSizeFlag),
+		DryRun:           v.GetBool(migrateUploadsDryRunFlag),
+		DeleteOldObjects: v.GetBool(migrateUploadsDeleteOldObjectsFlag),
+	})
+	logger.Info("finished migrating upload storage keys",
+		zap.Bool("dry_run", v.GetBool(migrateUploadsDryRunFlag)),
+		zap.Int("migrated", result.Migrated),
+		zap.Int("deduplicated", result.Deduplicated),
+		zap.Int("skipped", result.Skipped),
+		zap.Int("deleted_objects", result.DeletedObjects))
+	if err != nil {
+		logger.Fatal("error migrating upload storage keys", zap.Error(err))
+	}
+	return nil
+}
@@ -20,6 +20,7 @@ import (
 	ediinvoice "github.com/transcom/mymove/pkg/edi/invoice"
 	"github.com/transcom/mymove/pkg/logging"
 	"github.com/transcom/mymove/pkg/notifications"
+	"github.com/transcom/mymove/pkg/services"
 	"github.com/transcom/mymove/pkg/services/invoice"
 	paymentrequest "github.com/transcom/mymove/pkg/services/payment_request"
 )
@@ -68,6 +69,9 @@ func initProcessEDIsFlags(flag *pflag.FlagSet) {
 	// DB Config
 	cli.InitDatabaseFlags(flag)
 
+	// Environment
+	cli.InitEnvironmentFlags(flag)
+
 	// GEX
 	cli.InitGEXFlags(flag)
 
@@ -178,7 +182,18 @@ func processEDIs(_ *cobra.Command, _ []string) error {
 		v.GetString(cli.GEXBasicAuthUsernameFlag),
 		v.GetString(cli.GEXBasicAuthPasswordFlag))
 
-	reviewedPaymentRequestProcessor, err := paymentrequest.InitNewPaymentRequestReviewedProcessor(appCtx, sendToSyncada, icnSequencer, gexSender)
+	// The directory transport stands in for both GEX and the Syncada SFTP server, so 858s are sent through it
+	syncadaUploadDirectory := "/" + v.GetString(cli.GEXSFTPUserIDFlag)
+	var syncadaTransport services.SyncadaTransport
+	var ediSender services.GexSender = gexSender
+	if v.GetString(cli.GEXSyncadaTransportFlag) == cli.SyncadaTransportDirectory {
+		syncadaDirectory := v.GetString(cli.GEXSyncadaDirectoryFlag)
+		logger.Info("Exchanging files with Syncada through a local directory", zap.String("directory", syncadaDirectory))
+		syncadaTransport = invoice.NewDirectorySyncadaTransport(syncadaDirectory, syncadaUploadDirectory)
+		ediSender = nil
+	}
+
+	reviewedPaymentRequestProcessor, err := paymentrequest.InitNewPaymentRequestReviewedProcessor(appCtx, sendToSyncada, icnSequencer, ediSender, syncadaTransport)
 	if err != nil {
 		logger.Fatal("InitNewPaymentRequestReviewedProcessor failed", zap.Error(err))
 	}
@@ -192,29 +207,31 @@ func processEDIs(_ *cobra.Command, _ []string) error {
 		return nil
 	}
 
-	// SSH and SFTP Connection Setup
-	sshClient, err := cli.InitGEXSSH(logger, v)
-	if err != nil {
-		logger.Fatal("couldn't initialize SSH client", zap.Error(err))
-	}
-	defer func() {
-		if closeErr := sshClient.Close(); closeErr != nil {
-			logger.Error("could not close SFTP client", zap.Error(closeErr))
+	if syncadaTransport == nil {
+		// SSH and SFTP Connection Setup
+		sshClient, err := cli.InitGEXSSH(logger, v)
+		if err != nil {
+			logger.Fatal("couldn't initialize SSH client", zap.Error(err))
 		}
-	}()
+		defer func() {
+			if closeErr := sshClient.Close(); closeErr != nil {
+				logger.Error("could not close SFTP client", zap.Error(closeErr))
+			}
+		}()
 
-	sftpClient, err := cli.InitGEXSFTP(logger, sshClient)
-	if err != nil {
-		logger.Fatal("couldn't initialize SFTP client", zap.Error(err))
-	}
-	defer func() {
-		if closeErr := sftpClient.Close(); closeErr != nil {
-			logger.Error("could not close SFTP client", zap.Error(closeErr))
+		sftpClient, err := cli.InitGEXSFTP(logger, sshClient)
+		if err != nil {
+			logger.Fatal("couldn't initialize SFTP client", zap.Error(err))
 		}
-	}()
+		defer func() {
+			if closeErr := sftpClient.Close(); closeErr != nil {
+				logger.Error("could not close SFTP client", zap.Error(closeErr))
+			}
+		}()
 
-	wrappedSFTPClient := invoice.NewSFTPClientWrapper(sftpClient)
-	syncadaSFTPSession := invoice.NewSyncadaSFTPReaderSession(wrappedSFTPClient, v.GetBool(ProcessEDIDeleteFilesFlag))
+		syncadaTransport = invoice.NewSFTPSyncadaTransport(sftpClient, syncadaUploadDirectory)
+	}
+	syncadaSFTPSession := invoice.NewSyncadaSFTPReaderSession(syncadaTransport, v.GetBool(ProcessEDIDeleteFilesFlag))
 
 	// Sample expected format: 2021-03-16T18:25:36Z
 	lastReadTimeFlag := v.GetString(ProcessEDILastReadTimeFlag)
@@ -249,5 +266,16 @@ func processEDIs(_ *cobra.Command, _ []string) error {
 		logger.Info("Successfully processed EDI824 application advice responses")
 	}
 
+	// Process TPPS paid invoice reports, if Syncada delivers them
+	if pathTPPS := v.GetString(cli.GEXSFTPTPPSPickupDirectory); pathTPPS != "" {
+		tppsProcessor := invoice.NewTPPSSyncadaFileProcessor(invoice.NewTPPSPaidInvoiceReportProcessor())
+		_, err = syncadaSFTPSession.FetchAndProcessSyncadaFiles(appCtx, pathTPPS, lastReadTime, tppsProcessor)
+		if err != nil {
+			logger.Error("Error reading TPPS paid invoice reports", zap.Error(err))
+		} else {
+			logger.Info("Successfully processed TPPS paid invoice reports")
+		}
+	}
+
 	return nil
 }
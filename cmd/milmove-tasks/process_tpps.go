@@ -22,6 +22,7 @@ import (
 	"github.com/transcom/mymove/pkg/appcontext"
 	"github.com/transcom/mymove/pkg/cli"
 	"github.com/transcom/mymove/pkg/logging"
+	"github.com/transcom/mymove/pkg/services"
 	"github.com/transcom/mymove/pkg/services/invoice"
 )
 
@@ -198,6 +199,10 @@ func processTPPS(cmd *cobra.Command, args []string) error {
 			logger.Error("Error processing TPPS Paid Invoice Report", zap.Error(err))
 		} else {
 			logger.Info("Successfully processed TPPS Paid Invoice Report")
+
+			if paidDate, ok := tppsFileDate(tppsFilename); ok {
+				logTPPSVariances(appCtx, invoice.NewTPPSReconciler(), paidDate)
+			}
 		}
 	} else {
 		logger.Warn("Skipping unclean file",
@@ -211,6 +216,55 @@ func processTPPS(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+// tppsFileDate returns the payment date of a TPPS file named like MILMOVE-enYYYYMMDD.csv
+func tppsFileDate(tppsFilename string) (time.Time, bool) {
+	dateString := strings.TrimSuffix(strings.TrimPrefix(filepath.Base(tppsFilename), "MILMOVE-en"), ".csv")
+	paidDate, err := time.Parse("20060102", dateString)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return paidDate, true
+}
+
+// logTPPSVariances reconciles the payments TPPS made on the date against what was billed and logs each
+// underpayment, overpayment and unmatched TPPS line so they can be alerted on. Invoices sent that day are
+// usually not paid yet, so invoices without a TPPS payment are left to the reconciliation reports.
+func logTPPSVariances(appCtx appcontext.AppContext, reconciler services.TPPSReconciler, paidDate time.Time) {
+	report, err := reconciler.ReconcileTPPSPayments(appCtx, services.TPPSReconciliationParams{
+		StartDate:             paidDate,
+		EndDate:               paidDate,
+		ExcludeUnpaidInvoices: true,
+	})
+	if err != nil {
+		appCtx.Logger().Error("Error reconciling TPPS payments", zap.Error(err))
+		return
+	}
+
+	variances := report.Variances()
+	for _, line := range variances {
+		fields := []zap.Field{
+			zap.String("paymentRequestNumber", line.PaymentRequestNumber),
+			zap.String("referenceID", line.ReferenceID),
+			zap.String("serviceCode", line.ServiceCode),
+			zap.Int64("varianceCents", line.VarianceCents.Int64()),
+			zap.String("status", string(line.Status)),
+		}
+		if line.BilledCents != nil {
+			fields = append(fields, zap.Int64("billedCents", line.BilledCents.Int64()))
+		}
+		if line.PaidCents != nil {
+			fields = append(fields, zap.Int64("paidCents", line.PaidCents.Int64()))
+		}
+		appCtx.Logger().Warn("TPPS payment variance", fields...)
+	}
+	appCtx.Logger().Info("Reconciled TPPS payments",
+		zap.String("paidDate", paidDate.Format("2006-01-02")),
+		zap.Int("lines", len(report.Lines)),
+		zap.Int("variances", len(variances)),
+		zap.Int64("totalBilledCents", report.TotalBilledCents.Int64()),
+		zap.Int64("totalPaidCents", report.TotalPaidCents.Int64()))
+}
+
 func getS3ObjectTags(s3Client S3API, bucket, key string) (string, map[string]string, error) {
 	tagResp, err := s3Client.GetObjectTagging(context.Background(),
 		&s3.GetObjectTaggingInput{
@@ -8,6 +8,7 @@ import (
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
@@ -21,6 +22,9 @@ import (
 
 	"github.com/transcom/mymove/pkg/appcontext"
 	"github.com/transcom/mymove/pkg/cli"
+	"github.com/transcom/mymove/pkg/services"
+	"github.com/transcom/mymove/pkg/services/mocks"
+	"github.com/transcom/mymove/pkg/unit"
 )
 
 type MockTPPSPaidInvoiceReportProcessor struct {
@@ -256,3 +260,44 @@ func TestLogFileContentsLongFilePreview(t *testing.T) {
 	assert.Contains(t, logOutput, "MMMMM")
 	assert.Contains(t, logOutput, "...")
 }
+
+func TestTPPSFileDate(t *testing.T) {
+	paidDate, ok := tppsFileDate("MILMOVE-en20250210.csv")
+	assert.True(t, ok)
+	assert.Equal(t, time.Date(2025, time.February, 10, 0, 0, 0, 0, time.UTC), paidDate)
+
+	_, ok = tppsFileDate("TPPSPaidInvoiceReport.csv")
+	assert.False(t, ok)
+}
+
+func TestLogTPPSVariances(t *testing.T) {
+	paidDate := time.Date(2025, time.February, 10, 0, 0, 0, 0, time.UTC)
+	billed := unit.Cents(10000)
+	paid := unit.Cents(9000)
+	reconciler := mocks.NewTPPSReconciler(t)
+	reconciler.On("ReconcileTPPSPayments", mock.Anything, services.TPPSReconciliationParams{StartDate: paidDate, EndDate: paidDate, ExcludeUnpaidInvoices: true}).
+		Return(&services.TPPSReconciliationReport{
+			TotalBilledCents: billed,
+			TotalPaidCents:   paid,
+			Lines: []services.TPPSReconciliationLine{
+				{
+					PaymentRequestNumber: "1234-5678-1",
+					ReferenceID:          "1234-5678-a1b2c3d4",
+					ServiceCode:          "DLH",
+					BilledCents:          &billed,
+					PaidCents:            &paid,
+					VarianceCents:        paid - billed,
+					Status:               services.TPPSReconciliationStatusUnderpaid,
+				},
+			},
+		}, nil)
+
+	logOutput := captureLogs(func(logger *zap.Logger) {
+		logTPPSVariances(appcontext.NewAppContext(nil, logger, nil, nil), reconciler, paidDate)
+	})
+
+	assert.Contains(t, logOutput, "TPPS payment variance")
+	assert.Contains(t, logOutput, "1234-5678-a1b2c3d4")
+	assert.Contains(t, logOutput, "UNDERPAID")
+	assert.Contains(t, logOutput, "Reconciled TPPS payments")
+}
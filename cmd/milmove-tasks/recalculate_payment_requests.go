@@ -0,0 +1,288 @@
+package main
+
+import (
+	"crypto/tls"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+	"go.uber.org/zap"
+
+	"github.com/transcom/mymove/pkg/appcontext"
+	"github.com/transcom/mymove/pkg/certs"
+	"github.com/transcom/mymove/pkg/cli"
+	"github.com/transcom/mymove/pkg/logging"
+	"github.com/transcom/mymove/pkg/models"
+	"github.com/transcom/mymove/pkg/route"
+	"github.com/transcom/mymove/pkg/services"
+	"github.com/transcom/mymove/pkg/services/ghcrateengine"
+	paymentrequest "github.com/transcom/mymove/pkg/services/payment_request"
+	"github.com/transcom/mymove/pkg/services/query"
+)
+
+const (
+	// recalculateContractCodeFlag limits the recalculation to payment requests priced under a contract
+	recalculateContractCodeFlag string = "contract-code"
+	// recalculateCreatedFromFlag is the first day payment requests to recalculate were created
+	recalculateCreatedFromFlag string = "created-from"
+	// recalculateCreatedToFlag is the last day payment requests to recalculate were created
+	recalculateCreatedToFlag string = "created-to"
+	// recalculateServiceCodesFlag limits the recalculation to payment requests with one of the service codes
+	recalculateServiceCodesFlag string = "service-codes"
+	// recalculateGBLOCFlag limits the recalculation to payment requests for moves in a GBLOC
+	recalculateGBLOCFlag string = "gbloc"
+	// recalculateApplyFlag saves the recalculated payment requests instead of only reporting the changes
+	recalculateApplyFlag string = "apply"
+	// recalculateReportFileFlag is where the CSV report of price changes is written
+	recalculateReportFileFlag string = "report-file"
+)
+
+func checkRecalculatePaymentRequestsConfig(v *viper.Viper, logger *zap.Logger) error {
+
+	logger.Debug("checking config")
+
+	err := cli.CheckDatabase(v, logger)
+	if err != nil {
+		return err
+	}
+
+	err = cli.CheckRoute(v)
+	if err != nil {
+		return err
+	}
+
+	if !usesDTODWithoutTLS(v) {
+		err = cli.CheckCert(v)
+		if err != nil {
+			return err
+		}
+	}
+
+	if v.GetString(recalculateContractCodeFlag) == "" &&
+		v.GetString(recalculateCreatedFromFlag) == "" &&
+		v.GetString(recalculateCreatedToFlag) == "" &&
+		len(v.GetStringSlice(recalculateServiceCodesFlag)) == 0 &&
+		v.GetString(recalculateGBLOCFlag) == "" {
+		return fmt.Errorf("at least one of %s, %s, %s, %s or %s must be provided",
+			recalculateContractCodeFlag, recalculateCreatedFromFlag, recalculateCreatedToFlag, recalculateServiceCodesFlag, recalculateGBLOCFlag)
+	}
+
+	for _, flag := range []string{recalculateCreatedFromFlag, recalculateCreatedToFlag} {
+		if _, err := parseRepriceDate(v.GetString(flag)); err != nil {
+			return fmt.Errorf("invalid value for %s: %w", flag, err)
+		}
+	}
+
+	return nil
+}
+
+func initRecalculatePaymentRequestsFlags(flag *pflag.FlagSet) {
+
+	// DB Config
+	cli.InitDatabaseFlags(flag)
+
+	// Environment
+	cli.InitEnvironmentFlags(flag)
+
+	// Route Planners
+	cli.InitRouteFlags(flag)
+
+	// Certificate
+	cli.InitCertFlags(flag)
+
+	// Logging Levels
+	cli.InitLoggingFlags(flag)
+
+	flag.String(recalculateContractCodeFlag, "", "Recalculate payment requests priced under this contract code")
+	flag.String(recalculateCreatedFromFlag, "", "Recalculate payment requests created on or after this date, in format: YYYY-MM-DD")
+	flag.String(recalculateCreatedToFlag, "", "Recalculate payment requests created on or before this date, in format: YYYY-MM-DD")
+	flag.StringSlice(recalculateServiceCodesFlag, []string{}, "Comma separated service codes; recalculate payment requests with a service item for one of them")
+	flag.String(recalculateGBLOCFlag, "", "Recalculate payment requests for moves in this GBLOC")
+	flag.Bool(recalculateApplyFlag, false, "Save the recalculated payment requests; without this the changes are only reported")
+	flag.String(recalculateReportFileFlag, "", "File to write the CSV report of price changes to; if not provided, the report is written to stdout")
+
+	// Don't sort flags
+	flag.SortFlags = false
+}
+
+// usesDTODWithoutTLS is true when the route planner gets its mileage without calling DTOD, so no
+// certificates are needed
+func usesDTODWithoutTLS(v *viper.Viper) bool {
+	return v.GetBool(cli.DTODUseMockFlag) || v.GetBool(cli.DTODUseLocalFlag)
+}
+
+// Command: go run ./cmd/milmove-tasks recalculate-payment-requests
+func recalculatePaymentRequests(cmd *cobra.Command, args []string) error {
+
+	err := cmd.ParseFlags(args)
+	if err != nil {
+		return fmt.Errorf("could not parse args: %w", err)
+	}
+	flags := cmd.Flags()
+	v := viper.New()
+	err = v.BindPFlags(flags)
+	if err != nil {
+		return fmt.Errorf("could not bind flags: %w", err)
+	}
+	v.SetEnvKeyReplacer(strings.NewReplacer("-", "_"))
+	v.AutomaticEnv()
+
+	dbEnv := v.GetString(cli.DbEnvFlag)
+
+	logger, _, err := logging.Config(
+		logging.WithEnvironment(dbEnv),
+		logging.WithLoggingLevel(v.GetString(cli.LoggingLevelFlag)),
+		logging.WithStacktraceLength(v.GetInt(cli.StacktraceLengthFlag)),
+	)
+	if err != nil {
+		log.Fatalf("Failed to initialize Zap logging due to %v", err)
+	}
+	zap.ReplaceGlobals(logger)
+
+	err = checkRecalculatePaymentRequestsConfig(v, logger)
+	if err != nil {
+		logger.Fatal("invalid configuration", zap.Error(err))
+	}
+
+	// Create a connection to the DB
+	dbConnection, err := cli.InitDatabase(v, logger)
+	if err != nil {
+		logger.Fatal("Connecting to DB", zap.Error(err))
+	}
+
+	appCtx := appcontext.NewAppContext(dbConnection, logger, nil, nil)
+
+	var tlsConfig *tls.Config
+	if !usesDTODWithoutTLS(v) {
+		certificates, rootCAs, certsErr := certs.InitDoDCertificates(v, logger)
+		if certificates == nil || rootCAs == nil || certsErr != nil {
+			logger.Fatal("Failed to initialize DOD certificates", zap.Error(certsErr))
+		}
+		tlsConfig = &tls.Config{Certificates: certificates, RootCAs: rootCAs, MinVersion: tls.VersionTLS12}
+	}
+
+	hhgRoutePlanner, err := route.InitHHGRoutePlanner(appCtx, v, tlsConfig)
+	if err != nil {
+		logger.Fatal("Could not instantiate HHG route planner", zap.Error(err))
+	}
+
+	bulkRecalculator := paymentrequest.NewBulkPaymentRequestRecalculator(
+		paymentrequest.NewPaymentRequestRecalculator(
+			paymentrequest.NewPaymentRequestCreator(hhgRoutePlanner, ghcrateengine.NewServiceItemPricer()),
+			paymentrequest.NewPaymentRequestStatusUpdater(query.NewQueryBuilder()),
+		),
+	)
+
+	report, err := bulkRecalculator.RecalculatePaymentRequests(appCtx, bulkRecalculationParams(v))
+	if err != nil {
+		logger.Fatal("error recalculating payment requests", zap.Error(err))
+	}
+
+	out := os.Stdout
+	if reportFile := v.GetString(recalculateReportFileFlag); reportFile != "" {
+		out, err = os.Create(reportFile)
+		if err != nil {
+			logger.Fatal("could not create report file", zap.String("report_file", reportFile), zap.Error(err))
+		}
+		defer out.Close()
+	}
+	err = writeBulkRecalculationCSV(out, report)
+	if err != nil {
+		logger.Fatal("could not write recalculation report", zap.Error(err))
+	}
+
+	logger.Info("finished recalculating payment requests",
+		zap.Bool("applied", report.Applied),
+		zap.Int("payment_requests", len(report.PaymentRequests)),
+		zap.Int("changed", report.ChangedCount),
+		zap.Int("unchanged", report.UnchangedCount),
+		zap.Int("failed", report.FailedCount),
+		zap.Int64("delta_cents", report.DeltaCents.Int64()))
+	return nil
+}
+
+// bulkRecalculationParams builds the recalculation filters from the flags; the dates were checked with
+// the rest of the config
+func bulkRecalculationParams(v *viper.Viper) services.BulkRecalculationParams {
+	params := services.BulkRecalculationParams{
+		Apply: v.GetBool(recalculateApplyFlag),
+	}
+	params.CreatedFrom, _ = parseRepriceDate(v.GetString(recalculateCreatedFromFlag))
+	params.CreatedTo, _ = parseRepriceDate(v.GetString(recalculateCreatedToFlag))
+	if contractCode := v.GetString(recalculateContractCodeFlag); contractCode != "" {
+		params.ContractCode = &contractCode
+	}
+	if gbloc := v.GetString(recalculateGBLOCFlag); gbloc != "" {
+		gbloc = strings.ToUpper(gbloc)
+		params.GBLOC = &gbloc
+	}
+	for _, code := range v.GetStringSlice(recalculateServiceCodesFlag) {
+		params.ServiceCodes = append(params.ServiceCodes, models.ReServiceCode(strings.ToUpper(strings.TrimSpace(code))))
+	}
+	return params
+}
+
+// writeBulkRecalculationCSV writes a row for each recalculated service item, or for the payment request
+// when it could not be recalculated, followed by a row with the total delta. Prices are in cents.
+func writeBulkRecalculationCSV(w io.Writer, report *services.BulkRecalculationReport) error {
+	writer := csv.NewWriter(w)
+	err := writer.Write([]string{
+		"Payment Request Number",
+		"New Payment Request Number",
+		"MTO Service Item ID",
+		"Service Code",
+		"Old Price Cents",
+		"New Price Cents",
+		"Delta Cents",
+		"Error",
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, paymentRequest := range report.PaymentRequests {
+		newPaymentRequestNumber := ""
+		if paymentRequest.NewPaymentRequestNumber != nil {
+			newPaymentRequestNumber = *paymentRequest.NewPaymentRequestNumber
+		}
+
+		if paymentRequest.Error != "" {
+			err = writer.Write([]string{paymentRequest.PaymentRequestNumber, "", "", "", "", "", "", paymentRequest.Error})
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		for _, item := range paymentRequest.ServiceItems {
+			err = writer.Write([]string{
+				paymentRequest.PaymentRequestNumber,
+				newPaymentRequestNumber,
+				item.MTOServiceItemID.String(),
+				string(item.ServiceCode),
+				strconv.FormatInt(item.OldPriceCents.Int64(), 10),
+				strconv.FormatInt(item.NewPriceCents.Int64(), 10),
+				strconv.FormatInt(item.DeltaCents.Int64(), 10),
+				"",
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	err = writer.Write([]string{"Total", "", "", "", "", "", strconv.FormatInt(report.DeltaCents.Int64(), 10), ""})
+	if err != nil {
+		return err
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
@@ -0,0 +1,86 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/gofrs/uuid"
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/transcom/mymove/pkg/models"
+	"github.com/transcom/mymove/pkg/services"
+	"github.com/transcom/mymove/pkg/unit"
+)
+
+func TestBulkRecalculationParams(t *testing.T) {
+	flag := pflag.NewFlagSet("recalculate-payment-requests", pflag.ContinueOnError)
+	initRecalculatePaymentRequestsFlags(flag)
+	assert.NoError(t, flag.Parse([]string{
+		"--" + recalculateCreatedFromFlag, "2025-06-02",
+		"--" + recalculateServiceCodesFlag, "dlh, FSC",
+		"--" + recalculateGBLOCFlag, "kkfa",
+		"--" + recalculateApplyFlag,
+	}))
+	v := viper.New()
+	assert.NoError(t, v.BindPFlags(flag))
+
+	params := bulkRecalculationParams(v)
+	assert.True(t, params.Apply)
+	assert.Nil(t, params.ContractCode)
+	if assert.NotNil(t, params.CreatedFrom) {
+		assert.Equal(t, "2025-06-02", params.CreatedFrom.Format("2006-01-02"))
+	}
+	assert.Nil(t, params.CreatedTo)
+	assert.Equal(t, []models.ReServiceCode{models.ReServiceCodeDLH, models.ReServiceCodeFSC}, params.ServiceCodes)
+	if assert.NotNil(t, params.GBLOC) {
+		assert.Equal(t, "KKFA", *params.GBLOC)
+	}
+}
+
+func TestWriteBulkRecalculationCSV(t *testing.T) {
+	msItemID := uuid.Must(uuid.FromString("b7dc7f6b-0a6b-4a8b-9c4d-0f5b0d7cbe11"))
+	dlhItemID := uuid.Must(uuid.FromString("3f1b0c6e-6f0a-4f4e-9a0d-2a4d5c1e7b22"))
+	newPaymentRequestNumber := "1234-5678-2"
+	report := &services.BulkRecalculationReport{
+		Applied: true,
+		PaymentRequests: []services.BulkRecalculatedPaymentRequest{
+			{
+				PaymentRequestNumber:    "1234-5678-1",
+				NewPaymentRequestNumber: &newPaymentRequestNumber,
+				ServiceItems: []services.BulkRecalculatedServiceItem{
+					{
+						MTOServiceItemID: msItemID,
+						ServiceCode:      models.ReServiceCodeMS,
+						OldPriceCents:    unit.Cents(12303),
+						NewPriceCents:    unit.Cents(12303),
+					},
+					{
+						MTOServiceItemID: dlhItemID,
+						ServiceCode:      models.ReServiceCodeDLH,
+						OldPriceCents:    unit.Cents(20724832),
+						NewPriceCents:    unit.Cents(19363113),
+						DeltaCents:       unit.Cents(-1361719),
+					},
+				},
+				DeltaCents: unit.Cents(-1361719),
+			},
+			{
+				PaymentRequestNumber: "9876-5432-1",
+				Error:                "could not fetch domestic linehaul rate",
+			},
+		},
+		DeltaCents: unit.Cents(-1361719),
+	}
+
+	var buf bytes.Buffer
+	assert.NoError(t, writeBulkRecalculationCSV(&buf, report))
+	assert.Equal(t,
+		"Payment Request Number,New Payment Request Number,MTO Service Item ID,Service Code,Old Price Cents,New Price Cents,Delta Cents,Error\n"+
+			"1234-5678-1,1234-5678-2,b7dc7f6b-0a6b-4a8b-9c4d-0f5b0d7cbe11,MS,12303,12303,0,\n"+
+			"1234-5678-1,1234-5678-2,3f1b0c6e-6f0a-4f4e-9a0d-2a4d5c1e7b22,DLH,20724832,19363113,-1361719,\n"+
+			"9876-5432-1,,,,,,,could not fetch domestic linehaul rate\n"+
+			"Total,,,,,,-1361719,\n",
+		buf.String())
+}
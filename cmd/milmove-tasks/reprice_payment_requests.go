@@ -0,0 +1,234 @@
+package main
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+	"go.uber.org/zap"
+
+	"github.com/transcom/mymove/pkg/appcontext"
+	"github.com/transcom/mymove/pkg/cli"
+	"github.com/transcom/mymove/pkg/logging"
+	"github.com/transcom/mymove/pkg/services"
+	"github.com/transcom/mymove/pkg/services/ghcrateengine"
+	"github.com/transcom/mymove/pkg/unit"
+)
+
+const (
+	// repriceContractCodeFlag is the code of the candidate contract to price the payment requests under
+	repriceContractCodeFlag string = "contract-code"
+	// repricePaymentRequestNumbersFlag is a list of payment requests to reprice
+	repricePaymentRequestNumbersFlag string = "payment-request-numbers"
+	// repriceCreatedFromFlag is the first day payment requests to reprice were created
+	repriceCreatedFromFlag string = "created-from"
+	// repriceCreatedToFlag is the last day payment requests to reprice were created
+	repriceCreatedToFlag string = "created-to"
+	// repriceReportFileFlag is where the CSV report of price changes is written
+	repriceReportFileFlag string = "report-file"
+)
+
+func checkRepricePaymentRequestsConfig(v *viper.Viper, logger *zap.Logger) error {
+
+	logger.Debug("checking config")
+
+	err := cli.CheckDatabase(v, logger)
+	if err != nil {
+		return err
+	}
+
+	if v.GetString(repriceContractCodeFlag) == "" {
+		return fmt.Errorf("missing value for %s", repriceContractCodeFlag)
+	}
+
+	if len(v.GetStringSlice(repricePaymentRequestNumbersFlag)) == 0 && v.GetString(repriceCreatedFromFlag) == "" && v.GetString(repriceCreatedToFlag) == "" {
+		return fmt.Errorf("either %s or %s/%s must be provided", repricePaymentRequestNumbersFlag, repriceCreatedFromFlag, repriceCreatedToFlag)
+	}
+
+	for _, flag := range []string{repriceCreatedFromFlag, repriceCreatedToFlag} {
+		if _, err := parseRepriceDate(v.GetString(flag)); err != nil {
+			return fmt.Errorf("invalid value for %s: %w", flag, err)
+		}
+	}
+
+	return nil
+}
+
+func initRepricePaymentRequestsFlags(flag *pflag.FlagSet) {
+
+	// DB Config
+	cli.InitDatabaseFlags(flag)
+
+	// Logging Levels
+	cli.InitLoggingFlags(flag)
+
+	flag.String(repriceContractCodeFlag, "", "Code of the candidate contract to price the payment requests under")
+	flag.StringSlice(repricePaymentRequestNumbersFlag, []string{}, "Comma separated payment request numbers to reprice")
+	flag.String(repriceCreatedFromFlag, "", "Reprice payment requests created on or after this date, in format: YYYY-MM-DD")
+	flag.String(repriceCreatedToFlag, "", "Reprice payment requests created on or before this date, in format: YYYY-MM-DD")
+	flag.String(repriceReportFileFlag, "", "File to write the CSV report of price changes to; if not provided, the report is written to stdout")
+
+	// Don't sort flags
+	flag.SortFlags = false
+}
+
+// parseRepriceDate parses a YYYY-MM-DD flag value, returning nil when the flag was not given
+func parseRepriceDate(value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+	date, err := time.Parse("2006-01-02", value)
+	if err != nil {
+		return nil, err
+	}
+	return &date, nil
+}
+
+// Command: go run ./cmd/milmove-tasks reprice-payment-requests
+func repricePaymentRequests(cmd *cobra.Command, args []string) error {
+
+	err := cmd.ParseFlags(args)
+	if err != nil {
+		return fmt.Errorf("could not parse args: %w", err)
+	}
+	flags := cmd.Flags()
+	v := viper.New()
+	err = v.BindPFlags(flags)
+	if err != nil {
+		return fmt.Errorf("could not bind flags: %w", err)
+	}
+	v.SetEnvKeyReplacer(strings.NewReplacer("-", "_"))
+	v.AutomaticEnv()
+
+	dbEnv := v.GetString(cli.DbEnvFlag)
+
+	logger, _, err := logging.Config(
+		logging.WithEnvironment(dbEnv),
+		logging.WithLoggingLevel(v.GetString(cli.LoggingLevelFlag)),
+		logging.WithStacktraceLength(v.GetInt(cli.StacktraceLengthFlag)),
+	)
+	if err != nil {
+		log.Fatalf("Failed to initialize Zap logging due to %v", err)
+	}
+	zap.ReplaceGlobals(logger)
+
+	err = checkRepricePaymentRequestsConfig(v, logger)
+	if err != nil {
+		logger.Fatal("invalid configuration", zap.Error(err))
+	}
+
+	// Create a connection to the DB
+	dbConnection, err := cli.InitDatabase(v, logger)
+	if err != nil {
+		logger.Fatal("Connecting to DB", zap.Error(err))
+	}
+
+	appCtx := appcontext.NewAppContext(dbConnection, logger, nil, nil)
+
+	// the dates were checked with the rest of the config
+	createdFrom, _ := parseRepriceDate(v.GetString(repriceCreatedFromFlag))
+	createdTo, _ := parseRepriceDate(v.GetString(repriceCreatedToFlag))
+
+	report, err := ghcrateengine.NewContractRepricer().RepriceUnderContract(appCtx, services.ContractRepricingParams{
+		CandidateContractCode: v.GetString(repriceContractCodeFlag),
+		PaymentRequestNumbers: v.GetStringSlice(repricePaymentRequestNumbersFlag),
+		CreatedFrom:           createdFrom,
+		CreatedTo:             createdTo,
+	})
+	if err != nil {
+		logger.Fatal("error repricing payment requests", zap.Error(err))
+	}
+
+	out := os.Stdout
+	if reportFile := v.GetString(repriceReportFileFlag); reportFile != "" {
+		out, err = os.Create(reportFile)
+		if err != nil {
+			logger.Fatal("could not create report file", zap.String("report_file", reportFile), zap.Error(err))
+		}
+		defer out.Close()
+	}
+	err = writeContractRepricingCSV(out, report)
+	if err != nil {
+		logger.Fatal("could not write repricing report", zap.Error(err))
+	}
+
+	logger.Info("finished repricing payment requests",
+		zap.String("contract_code", report.CandidateContractCode),
+		zap.Int("payment_requests", len(report.PaymentRequests)),
+		zap.Int("repriced", report.RepricedCount),
+		zap.Int("failed", report.FailedCount),
+		zap.Int64("original_total_cents", report.OriginalTotalCents.Int64()),
+		zap.Int64("candidate_total_cents", report.CandidateTotalCents.Int64()),
+		zap.Int64("delta_cents", report.DeltaCents.Int64()))
+	return nil
+}
+
+// writeContractRepricingCSV writes a row for each repriced service item followed by a row with
+// the totals, with prices in dollars
+func writeContractRepricingCSV(w io.Writer, report *services.ContractRepricingReport) error {
+	writer := csv.NewWriter(w)
+	err := writer.Write([]string{
+		"Payment Request Number",
+		"Payment Service Item ID",
+		"Service Code",
+		"Original Contract",
+		"Original Price",
+		report.CandidateContractCode + " Price",
+		"Delta",
+		"Error",
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, paymentRequest := range report.PaymentRequests {
+		for _, item := range paymentRequest.ServiceItems {
+			row := []string{
+				paymentRequest.PaymentRequestNumber,
+				item.PaymentServiceItemID.String(),
+				string(item.ServiceCode),
+				item.OriginalContractCode,
+				repriceDollars(item.OriginalPriceCents),
+				"",
+				"",
+				item.Error,
+			}
+			if item.Error == "" {
+				row[5] = repriceDollars(item.CandidatePriceCents)
+				row[6] = repriceDollars(item.DeltaCents)
+			}
+			if err = writer.Write(row); err != nil {
+				return err
+			}
+		}
+	}
+
+	err = writer.Write([]string{
+		"Total",
+		"",
+		"",
+		"",
+		repriceDollars(report.OriginalTotalCents),
+		repriceDollars(report.CandidateTotalCents),
+		repriceDollars(report.DeltaCents),
+		"",
+	})
+	if err != nil {
+		return err
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+func repriceDollars(cents unit.Cents) string {
+	return strconv.FormatFloat(cents.ToDollarFloatNoRound(), 'f', 2, 64)
+}
@@ -0,0 +1,69 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/transcom/mymove/pkg/models"
+	"github.com/transcom/mymove/pkg/services"
+	"github.com/transcom/mymove/pkg/unit"
+)
+
+func TestParseRepriceDate(t *testing.T) {
+	date, err := parseRepriceDate("")
+	assert.NoError(t, err)
+	assert.Nil(t, date)
+
+	date, err = parseRepriceDate("2025-06-02")
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2025, time.June, 2, 0, 0, 0, 0, time.UTC), *date)
+
+	_, err = parseRepriceDate("06/02/2025")
+	assert.Error(t, err)
+}
+
+func TestWriteContractRepricingCSV(t *testing.T) {
+	msItemID := uuid.Must(uuid.FromString("b7dc7f6b-0a6b-4a8b-9c4d-0f5b0d7cbe11"))
+	dlhItemID := uuid.Must(uuid.FromString("3f1b0c6e-6f0a-4f4e-9a0d-2a4d5c1e7b22"))
+	report := &services.ContractRepricingReport{
+		CandidateContractCode: "CANDIDATE",
+		PaymentRequests: []services.RepricedPaymentRequest{
+			{
+				PaymentRequestNumber: "1234-5678-1",
+				ServiceItems: []services.RepricedServiceItem{
+					{
+						PaymentServiceItemID: msItemID,
+						ServiceCode:          models.ReServiceCodeMS,
+						OriginalContractCode: "TRUSS_TEST",
+						OriginalPriceCents:   unit.Cents(12403),
+						CandidatePriceCents:  unit.Cents(12303),
+						DeltaCents:           unit.Cents(-100),
+					},
+					{
+						PaymentServiceItemID: dlhItemID,
+						ServiceCode:          models.ReServiceCodeDLH,
+						OriginalContractCode: "TRUSS_TEST",
+						OriginalPriceCents:   unit.Cents(20724832),
+						Error:                "could not fetch domestic linehaul rate",
+					},
+				},
+			},
+		},
+		OriginalTotalCents:  unit.Cents(12403),
+		CandidateTotalCents: unit.Cents(12303),
+		DeltaCents:          unit.Cents(-100),
+	}
+
+	var buf bytes.Buffer
+	assert.NoError(t, writeContractRepricingCSV(&buf, report))
+	assert.Equal(t,
+		"Payment Request Number,Payment Service Item ID,Service Code,Original Contract,Original Price,CANDIDATE Price,Delta,Error\n"+
+			"1234-5678-1,b7dc7f6b-0a6b-4a8b-9c4d-0f5b0d7cbe11,MS,TRUSS_TEST,124.03,123.03,-1.00,\n"+
+			"1234-5678-1,3f1b0c6e-6f0a-4f4e-9a0d-2a4d5c1e7b22,DLH,TRUSS_TEST,207248.32,,,could not fetch domestic linehaul rate\n"+
+			"Total,,,,124.03,123.03,-1.00,\n",
+		buf.String())
+}
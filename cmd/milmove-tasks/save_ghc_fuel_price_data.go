@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
@@ -16,6 +17,13 @@ import (
 	"github.com/transcom/mymove/pkg/services/ghcdieselfuelprice"
 )
 
+const (
+	// fuelPriceBackfillStartFlag is the first day to backfill missing weekly fuel prices from
+	fuelPriceBackfillStartFlag string = "backfill-start"
+	// fuelPriceBackfillEndFlag is the last day to backfill missing weekly fuel prices to
+	fuelPriceBackfillEndFlag string = "backfill-end"
+)
+
 func checkSaveGHCFuelPriceConfig(v *viper.Viper, logger *zap.Logger) error {
 
 	logger.Debug("checking config")
@@ -30,6 +38,24 @@ func checkSaveGHCFuelPriceConfig(v *viper.Viper, logger *zap.Logger) error {
 		return err
 	}
 
+	start, end := v.GetString(fuelPriceBackfillStartFlag), v.GetString(fuelPriceBackfillEndFlag)
+	if (start == "") != (end == "") {
+		return fmt.Errorf("%s and %s must be provided together", fuelPriceBackfillStartFlag, fuelPriceBackfillEndFlag)
+	}
+	if start != "" {
+		startDate, err := time.Parse("2006-01-02", start)
+		if err != nil {
+			return fmt.Errorf("invalid value for %s: %w", fuelPriceBackfillStartFlag, err)
+		}
+		endDate, err := time.Parse("2006-01-02", end)
+		if err != nil {
+			return fmt.Errorf("invalid value for %s: %w", fuelPriceBackfillEndFlag, err)
+		}
+		if endDate.Before(startDate) {
+			return fmt.Errorf("%s must not be before %s", fuelPriceBackfillEndFlag, fuelPriceBackfillStartFlag)
+		}
+	}
+
 	return nil
 }
 
@@ -44,6 +70,9 @@ func initSaveGHCFuelPriceFlags(flag *pflag.FlagSet) {
 	// Logging Levels
 	cli.InitLoggingFlags(flag)
 
+	flag.String(fuelPriceBackfillStartFlag, "", "Backfill the weeks missing a fuel price from this date, in format: YYYY-MM-DD; requires --backfill-end")
+	flag.String(fuelPriceBackfillEndFlag, "", "Backfill the weeks missing a fuel price up to this date, in format: YYYY-MM-DD; requires --backfill-start")
+
 	// Don't sort flags
 	flag.SortFlags = false
 }
@@ -91,7 +120,20 @@ func saveGHCFuelPriceData(cmd *cobra.Command, args []string) error {
 
 	eiaURL := v.GetString(cli.EIAURLFlag)
 	eiaKey := v.GetString(cli.EIAKeyFlag)
-	newDieselFuelPriceInfo := ghcdieselfuelprice.NewDieselFuelPriceInfo(eiaURL, eiaKey, ghcdieselfuelprice.FetchEIAData, logger)
+	eiaDataFetcher := ghcdieselfuelprice.FetchEIAData
+	if fixtureFile := v.GetString(cli.EIAFixtureFileFlag); fixtureFile != "" {
+		logger.Info("reading diesel fuel prices from fixture file", zap.String("fixture_file", fixtureFile))
+		eiaDataFetcher = ghcdieselfuelprice.NewEIAFixtureFetcher(fixtureFile)
+	}
+	newDieselFuelPriceInfo := ghcdieselfuelprice.NewDieselFuelPriceInfo(eiaURL, eiaKey, eiaDataFetcher, logger)
+
+	if v.GetString(fuelPriceBackfillStartFlag) != "" {
+		// the dates were checked with the rest of the config
+		start, _ := time.Parse("2006-01-02", v.GetString(fuelPriceBackfillStartFlag))
+		end, _ := time.Parse("2006-01-02", v.GetString(fuelPriceBackfillEndFlag))
+		backfillGHCFuelPrices(appCtx, newDieselFuelPriceInfo, start, end)
+		return nil
+	}
 
 	err = newDieselFuelPriceInfo.RunFetcher(appCtx)
 	if err != nil {
@@ -104,3 +146,31 @@ func saveGHCFuelPriceData(cmd *cobra.Command, args []string) error {
 	}
 	return nil
 }
+
+// backfillGHCFuelPrices fills in the weeks missing a fuel price and then reports the weeks that still have
+// shipments picked up without a price, since their fuel surcharges are priced with an older week's price
+func backfillGHCFuelPrices(appCtx appcontext.AppContext, dieselFuelPriceInfo *ghcdieselfuelprice.DieselFuelPriceInfo, start time.Time, end time.Time) {
+	logger := appCtx.Logger()
+
+	result, err := dieselFuelPriceInfo.RunBackfill(appCtx, start, end)
+	if err != nil {
+		logger.Fatal("error returned by RunBackfill function in ghcdieselfuelprice service", zap.Error(err))
+	}
+	for _, week := range result.MissingWeeks {
+		logger.Warn("no diesel fuel price was published for week", zap.String("week_start", week.Format("2006-01-02")))
+	}
+	logger.Info("finished backfilling diesel fuel prices",
+		zap.Int("stored", len(result.StoredPublicationDates)),
+		zap.Int("existing", result.ExistingWeeks),
+		zap.Int("missing", len(result.MissingWeeks)))
+
+	missingWeeks, err := ghcdieselfuelprice.FindMissingFuelPriceWeeks(appCtx, start, end)
+	if err != nil {
+		logger.Fatal("error finding weeks missing diesel fuel prices", zap.Error(err))
+	}
+	for _, week := range missingWeeks {
+		logger.Warn("shipments picked up in a week without a diesel fuel price",
+			zap.String("week_start", week.WeekStart.Format("2006-01-02")),
+			zap.Int("shipments", week.ShipmentCount))
+	}
+}
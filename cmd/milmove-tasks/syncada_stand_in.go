@@ -0,0 +1,174 @@
+package main
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"fmt"
+	"log"
+	"net"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+	"go.uber.org/zap"
+	"golang.org/x/crypto/ssh"
+
+	"github.com/transcom/mymove/pkg/cli"
+	"github.com/transcom/mymove/pkg/logging"
+	"github.com/transcom/mymove/pkg/services/invoice"
+)
+
+const (
+	// SyncadaStandInServeSFTPFlag is the ENV var for serving the stand-in directory over SFTP
+	SyncadaStandInServeSFTPFlag string = "syncada-stand-in-serve-sftp"
+	// SyncadaStandInHostKeyFlag is the ENV var for the private host key of the stand-in SFTP server
+	SyncadaStandInHostKeyFlag string = "syncada-stand-in-host-key"
+	// SyncadaStandInIntervalFlag is the ENV var for how often the stand-in looks for new 858s
+	SyncadaStandInIntervalFlag string = "syncada-stand-in-interval"
+)
+
+// Call this from the command line with go run ./cmd/milmove-tasks syncada-stand-in
+
+func initSyncadaStandInFlags(flag *pflag.FlagSet) {
+	// Logging Levels
+	cli.InitLoggingFlags(flag)
+
+	// GEX SFTP Config
+	cli.InitGEXSFTPFlags(flag)
+
+	flag.Bool(SyncadaStandInServeSFTPFlag, false, "If present, serve the Syncada directory over SFTP on the GEX SFTP port")
+	flag.String(SyncadaStandInHostKeyFlag, "", "Private host key of the SFTP server. A new key is generated when empty.")
+	flag.Duration(SyncadaStandInIntervalFlag, 5*time.Second, "How often to look for new 858s")
+
+	// Don't sort flags
+	flag.SortFlags = false
+}
+
+func checkSyncadaStandInConfig(v *viper.Viper) error {
+	if err := cli.CheckLogging(v); err != nil {
+		return err
+	}
+
+	if v.GetString(cli.GEXSyncadaDirectoryFlag) == "" {
+		return fmt.Errorf("Invalid configuration missing GEX_SYNCADA_DIRECTORY")
+	}
+	if v.GetString(cli.GEXSFTPUserIDFlag) == "" {
+		return fmt.Errorf("Invalid configuration missing GEX_SFTP_USER_ID")
+	}
+	if v.GetString(cli.GEXSFTP997PickupDirectory) == "" || v.GetString(cli.GEXSFTP824PickupDirectory) == "" {
+		return fmt.Errorf("Invalid configuration missing GEX_SFTP_997_PICKUP_DIRECTORY or GEX_SFTP_824_PICKUP_DIRECTORY")
+	}
+	if v.GetBool(SyncadaStandInServeSFTPFlag) {
+		if v.GetString(cli.GEXSFTPPasswordFlag) == "" {
+			return fmt.Errorf("Invalid credentials SFTP missing GEX_SFTP_PASSWORD")
+		}
+		if err := cli.ValidatePort(v, cli.GEXSFTPPortFlag); err != nil {
+			return err
+		}
+	}
+	if v.GetDuration(SyncadaStandInIntervalFlag) <= 0 {
+		return fmt.Errorf("Invalid %s, must be positive", SyncadaStandInIntervalFlag)
+	}
+	return nil
+}
+
+// syncadaStandInHostKey parses the configured host key, or generates one
+func syncadaStandInHostKey(logger *zap.Logger, v *viper.Viper) (ssh.Signer, error) {
+	if hostKeyString := v.GetString(SyncadaStandInHostKeyFlag); hostKeyString != "" {
+		return ssh.ParsePrivateKey([]byte(hostKeyString))
+	}
+
+	_, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	hostKey, err := ssh.NewSignerFromKey(privateKey)
+	if err != nil {
+		return nil, err
+	}
+	logger.Info("Generated a Syncada stand-in host key, use it as GEX_SFTP_HOST_KEY",
+		zap.String("hostKey", strings.TrimSpace(string(ssh.MarshalAuthorizedKey(hostKey.PublicKey())))))
+	return hostKey, nil
+}
+
+// syncadaStandIn answers the 858s uploaded to a local directory with 997s, 824s and TPPS paid invoice
+// reports, so the whole invoice exchange can run in local development without Syncada
+func syncadaStandIn(_ *cobra.Command, _ []string) error {
+	v := viper.New()
+
+	logger, _, err := logging.Config(
+		logging.WithEnvironment(v.GetString(cli.LoggingEnvFlag)),
+		logging.WithLoggingLevel(v.GetString(cli.LoggingLevelFlag)),
+		logging.WithStacktraceLength(v.GetInt(cli.StacktraceLengthFlag)),
+	)
+	if err != nil {
+		logger.Fatal("Failed to initialize Zap logging", zap.Error(err))
+	}
+	zap.ReplaceGlobals(logger)
+
+	flag := pflag.CommandLine
+	initSyncadaStandInFlags(flag)
+	err = flag.Parse(os.Args[1:])
+	if err != nil {
+		log.Fatal("failed to parse flags", zap.Error(err))
+	}
+
+	err = v.BindPFlags(flag)
+	if err != nil {
+		log.Fatal("failed to bind flags", zap.Error(err))
+	}
+	v.SetEnvKeyReplacer(strings.NewReplacer("-", "_"))
+	v.AutomaticEnv()
+
+	err = checkSyncadaStandInConfig(v)
+	if err != nil {
+		logger.Fatal("invalid configuration", zap.Error(err))
+	}
+
+	root := v.GetString(cli.GEXSyncadaDirectoryFlag)
+	userID := v.GetString(cli.GEXSFTPUserIDFlag)
+
+	if v.GetBool(SyncadaStandInServeSFTPFlag) {
+		hostKey, err := syncadaStandInHostKey(logger, v)
+		if err != nil {
+			logger.Fatal("couldn't parse Syncada stand-in host key", zap.Error(err))
+		}
+
+		server := invoice.NewSyncadaSFTPStandInServer(logger, root, userID, v.GetString(cli.GEXSFTPPasswordFlag), hostKey)
+		err = server.Listen(net.JoinHostPort("localhost", v.GetString(cli.GEXSFTPPortFlag)))
+		if err != nil {
+			logger.Fatal("couldn't start Syncada stand-in SFTP server", zap.Error(err))
+		}
+		defer func() {
+			if closeErr := server.Close(); closeErr != nil {
+				logger.Error("could not close Syncada stand-in SFTP server", zap.Error(closeErr))
+			}
+		}()
+		go func() {
+			if serveErr := server.Serve(); serveErr != nil {
+				logger.Error("Syncada stand-in SFTP server stopped", zap.Error(serveErr))
+			}
+		}()
+		logger.Info("Syncada stand-in SFTP server listening", zap.String("address", server.Addr().String()))
+	}
+
+	standIn := invoice.SyncadaStandIn{
+		Root:               root,
+		UploadDirectory:    "/" + userID,
+		PickupDirectory997: v.GetString(cli.GEXSFTP997PickupDirectory),
+		PickupDirectory824: v.GetString(cli.GEXSFTP824PickupDirectory),
+		TPPSDirectory:      v.GetString(cli.GEXSFTPTPPSPickupDirectory),
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	logger.Info("Syncada stand-in watching for 858s", zap.String("directory", root))
+	return standIn.Watch(ctx, logger, v.GetDuration(SyncadaStandInIntervalFlag))
+}
@@ -41,6 +41,7 @@ import (
 	"github.com/transcom/mymove/pkg/iws"
 	"github.com/transcom/mymove/pkg/logging"
 	"github.com/transcom/mymove/pkg/notifications"
+	"github.com/transcom/mymove/pkg/pubsub"
 	"github.com/transcom/mymove/pkg/route"
 	"github.com/transcom/mymove/pkg/server"
 	"github.com/transcom/mymove/pkg/services"
@@ -48,6 +49,7 @@ import (
 	"github.com/transcom/mymove/pkg/services/invoice"
 	"github.com/transcom/mymove/pkg/storage"
 	"github.com/transcom/mymove/pkg/telemetry"
+	"github.com/transcom/mymove/pkg/uploader"
 )
 
 // initServeFlags - Order matters!
@@ -92,6 +94,12 @@ func initServeFlags(flag *pflag.FlagSet) {
 	// Storage
 	cli.InitStorageFlags(flag)
 
+	// Upload virus scanning
+	cli.InitScannerFlags(flag)
+
+	// PubSub between servers
+	cli.InitPubSubFlags(flag)
+
 	// Email
 	cli.InitEmailFlags(flag)
 
@@ -188,6 +196,14 @@ func checkServeConfig(v *viper.Viper, logger *zap.Logger) error {
 		return err
 	}
 
+	if err := cli.CheckScanner(v); err != nil {
+		return err
+	}
+
+	if err := cli.CheckPubSub(v); err != nil {
+		return err
+	}
+
 	if err := cli.CheckEmail(v); err != nil {
 		return err
 	}
@@ -492,6 +508,18 @@ func buildRoutingConfig(appCtx appcontext.AppContext, v *viper.Viper, redisPool
 	// Storage
 	fileStorer := storage.InitStorage(v, appCtx.Logger())
 
+	// PubSub between servers, for example to stream upload status changes
+	pubSub, err := pubsub.InitPubSub(v, appCtx.Logger(), appCtx.DB())
+	if err != nil {
+		appCtx.Logger().Fatal("Could not instantiate pubsub", zap.Error(err))
+	}
+
+	// Upload virus scanning, for storage backends that are not scanned by S3
+	fileStorer, err = uploader.InitScanner(v, appCtx.Logger(), fileStorer, pubSub)
+	if err != nil {
+		appCtx.Logger().Fatal("Could not instantiate upload scanner", zap.Error(err))
+	}
+
 	// Create a secondary planner specifically for HHG.
 	hhgRoutePlanner, err := route.InitHHGRoutePlanner(appCtx, v, tlsConfig)
 	if err != nil {
@@ -576,6 +604,7 @@ func buildRoutingConfig(appCtx appcontext.AppContext, v *viper.Viper, redisPool
 		fileStorer,
 		notificationSender,
 		notificationReceiver,
+		pubSub,
 		iwsPersonLookup,
 		sendProductionInvoice,
 		gexSender,
@@ -920,6 +949,11 @@ func serveFunction(cmd *cobra.Command, args []string) error {
 	logger.Info("All listeners are shutdown")
 	loggerSync()
 
+	logger.Info("closing pubsub")
+	if err := routingConfig.HandlerConfig.PubSub().Close(); err != nil {
+		logger.Error("error closing pubsub", zap.Error(err))
+	}
+
 	var dbCloseErr error
 	dbClose.Do(func() {
 		logger.Info("closing database connections")
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
@@ -11,6 +12,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/transcom/mymove/cmd/webhook-client/utils"
+	"github.com/transcom/mymove/pkg/webhooksignature"
 )
 
 // WebhookRequest is the body of our request
@@ -26,10 +28,13 @@ type WebhookRequest struct {
 const (
 	// FilenameFlag is the string to send in the payload
 	FilenameFlag string = "filename"
+	// SigningSecretFlag is the secret to sign the request with
+	SigningSecretFlag string = "signing-secret"
 )
 
 func initPostWebhookNotifyFlags(flag *pflag.FlagSet) {
 	flag.String(FilenameFlag, "", "Filename of json file to send")
+	flag.String(SigningSecretFlag, "", "Secret to sign the request with, leave empty to send it unsigned")
 	flag.SortFlags = false
 }
 
@@ -106,7 +111,8 @@ func postWebhookNotify(cmd *cobra.Command, args []string) error {
 	path := "support/v1/webhook-notify"
 
 	url := fmt.Sprintf("https://%s:%d/%s", hostname, port, path)
-	resp, body, err := runtime.Post(json, url)
+	header := webhooksignature.Headers([]string{v.GetString(SigningSecretFlag)}, time.Now(), json)
+	resp, body, err := runtime.Post(json, url, header)
 
 	if err != nil {
 		logger.Error("Error making request:", zap.Error(err))
@@ -25,7 +25,7 @@ const (
 // WebhookClientPoster is an interface that WebhookRuntime implements
 type WebhookClientPoster interface {
 	SetupClient(cert *tls.Certificate) (*WebhookRuntime, error)
-	Post(data []byte, url string) (*http.Response, []byte, error)
+	Post(data []byte, url string, header http.Header) (*http.Response, []byte, error)
 }
 
 // WebhookRuntime comment here
@@ -83,9 +83,9 @@ func (wr *WebhookRuntime) SetupClient(cert *tls.Certificate) (*WebhookRuntime, e
 	return wr, nil
 }
 
-// Post function of the WebhookRuntime http posts the data passed in and returns the
-// response, body data, and any error
-func (wr *WebhookRuntime) Post(data []byte, url string) (*http.Response, []byte, error) {
+// Post function of the WebhookRuntime http posts the data passed in, with any extra headers,
+// and returns the response, body data, and any error
+func (wr *WebhookRuntime) Post(data []byte, url string, header http.Header) (*http.Response, []byte, error) {
 	bufferData := bytes.NewBuffer(data)
 	// Create the POST request
 	req, err := http.NewRequest(
@@ -96,6 +96,11 @@ func (wr *WebhookRuntime) Post(data []byte, url string) (*http.Response, []byte,
 	if err != nil {
 		return nil, nil, err
 	}
+	for key, values := range header {
+		for _, value := range values {
+			req.Header.Add(key, value)
+		}
+	}
 	req.Header.Set("Content-type", wr.ContentType)
 
 	// Print out the request when debug mode is on
@@ -16,13 +16,13 @@ type WebhookRuntimeClient struct {
 	mock.Mock
 }
 
-// Post provides a mock function with given fields: data, url
-func (_m *WebhookRuntimeClient) Post(data []byte, url string) (*http.Response, []byte, error) {
-	ret := _m.Called(data, url)
+// Post provides a mock function with given fields: data, url, header
+func (_m *WebhookRuntimeClient) Post(data []byte, url string, header http.Header) (*http.Response, []byte, error) {
+	ret := _m.Called(data, url, header)
 
 	var r0 *http.Response
-	if rf, ok := ret.Get(0).(func([]byte, string) *http.Response); ok {
-		r0 = rf(data, url)
+	if rf, ok := ret.Get(0).(func([]byte, string, http.Header) *http.Response); ok {
+		r0 = rf(data, url, header)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*http.Response)
@@ -30,8 +30,8 @@ func (_m *WebhookRuntimeClient) Post(data []byte, url string) (*http.Response, [
 	}
 
 	var r1 []byte
-	if rf, ok := ret.Get(1).(func([]byte, string) []byte); ok {
-		r1 = rf(data, url)
+	if rf, ok := ret.Get(1).(func([]byte, string, http.Header) []byte); ok {
+		r1 = rf(data, url, header)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).([]byte)
@@ -39,8 +39,8 @@ func (_m *WebhookRuntimeClient) Post(data []byte, url string) (*http.Response, [
 	}
 
 	var r2 error
-	if rf, ok := ret.Get(2).(func([]byte, string) error); ok {
-		r2 = rf(data, url)
+	if rf, ok := ret.Get(2).(func([]byte, string, http.Header) error); ok {
+		r2 = rf(data, url, header)
 	} else {
 		r2 = ret.Error(2)
 	}
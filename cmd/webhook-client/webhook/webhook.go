@@ -6,12 +6,14 @@ import (
 	"os"
 	"time"
 
+	"github.com/gofrs/uuid"
 	"github.com/spf13/cobra"
 	"go.uber.org/zap"
 
 	"github.com/transcom/mymove/cmd/webhook-client/utils"
 	"github.com/transcom/mymove/pkg/appcontext"
 	"github.com/transcom/mymove/pkg/models"
+	"github.com/transcom/mymove/pkg/webhooksignature"
 )
 
 // Engine encapsulates the services used by the webhook notification engine
@@ -20,78 +22,92 @@ type Engine struct {
 	Cmd                 *cobra.Command
 	PeriodInSeconds     int
 	MaxImmediateRetries int
-	SeverityThresholds  []int
-	QuitChannel         chan os.Signal
-	DoneChannel         chan bool
+	// MaxAttempts is how many runs a notification can fail before it is dead lettered, zero means no limit
+	MaxAttempts int
+	// MaxAgeSeconds is how long after its first attempt a failing notification is dead lettered, zero means no
+	// limit. The severity thresholds only raise alerts.
+	MaxAgeSeconds int
+	// BackoffInitialSeconds is how long a subscription waits after a failure before it is sent to again. The wait
+	// doubles for each failure in a row up to BackoffMaxSeconds. Zero disables backoff.
+	BackoffInitialSeconds int
+	BackoffMaxSeconds     int
+	SeverityThresholds    []int
+	QuitChannel           chan os.Signal
+	DoneChannel           chan bool
 }
 
-// processNotifications reads all the notifications and all the subscriptions and processes them one by one
+// processNotifications reads all the notifications and all the subscriptions and processes them one by one.
+// Notifications for a move are sent in the order they were created, so once one of them is held back (because it
+// failed, its subscription is backing off, or it was dead lettered) the move's later notifications wait for it.
+// Notifications for other moves and subscriptions keep going.
 func (eng *Engine) processNotifications(appCtx appcontext.AppContext, notifications []models.WebhookNotification, subscriptions []models.WebhookSubscription) {
+	now := time.Now()
+
+	heldMoves, err := eng.fetchDeadLetteredMoveIDs(appCtx)
+	if err != nil {
+		// Without this we can't guarantee ordering, so wait for the next run
+		appCtx.Logger().Error("Failed to fetch dead lettered moves", zap.Error(err))
+		return
+	}
+
+	// Subscriptions that are backing off, or that fail during this run, aren't sent anything else this run
+	heldSubs := map[uuid.UUID]bool{}
+	for _, sub := range subscriptions {
+		if sub.NextAttemptAt != nil && now.Before(*sub.NextAttemptAt) {
+			heldSubs[sub.ID] = true
+		}
+	}
+
 	for _, notif := range notifications {
 		notif := notif
 
+		if notif.MoveTaskOrderID != nil && heldMoves[*notif.MoveTaskOrderID] {
+			appCtx.Logger().Info("Holding notification until earlier notifications for the move are sent",
+				zap.String("notificationID", notif.ID.String()),
+				zap.String("moveID", notif.MoveTaskOrderID.String()))
+			continue
+		}
+
 		// search for subscription
 		foundSub := false
-		for _, sub := range subscriptions {
-			sub := sub
-			if sub.EventKey == notif.EventKey {
-				foundSub = true
-				stopLoop := false
-				var sev int
-				// If found, send  to subscription
-				err := eng.sendOneNotification(appCtx, &notif, &sub)
-				// If notification send failed, we need to log the severity
-				if err != nil {
-					appCtx.Logger().Error("Webhook Notification send failed", zap.Error(err))
-					if notif.FirstAttemptedAt == nil {
-						appCtx.Logger().Error("FirstAttempted at time was not stored", zap.Error(err))
-						// We should not ever get this error, so we trigger a sev1 failure immediately
-						sev = 1
-						appCtx.Logger().Error("Raising severity of failure",
-							zap.String("subscriptionEvent", sub.EventKey),
-							zap.Int("severityFrom", sub.Severity),
-							zap.Int("severityTo", sev))
-						notif.Status = models.WebhookNotificationFailed
-						err = eng.updateNotification(appCtx, &notif)
-						if err != nil {
-							appCtx.Logger().Error("Webhook Notification update failed", zap.Error(err))
-						}
-					} else {
-						sev = eng.GetSeverity(time.Now(), *notif.FirstAttemptedAt)
-						if sev != sub.Severity {
-							appCtx.Logger().Error("Raising severity of failure",
-								zap.String("subscriptionEvent", sub.EventKey),
-								zap.Int("severityFrom", sub.Severity),
-								zap.Int("severityTo", sev))
-							if sev == 1 {
-								notif.Status = models.WebhookNotificationFailed
-								err = eng.updateNotification(appCtx, &notif)
-								if err != nil {
-									appCtx.Logger().Error("Webhook Notification update failed", zap.Error(err))
-								}
-							}
-						}
-					}
-					stopLoop = true
-				}
-				// Update subscription, needs to be done on success sometimes, hence it's out of the previous if
-				errDB := eng.updateSubscriptionStatus(appCtx, &notif, &sub, sev)
-				if errDB != nil {
-					appCtx.Logger().Error("Webhook Subscription update failed", zap.Error(err))
-				}
-
-				if stopLoop {
-					return
-				}
-
-				// Return out of loop if quit signal recieved, otherwise, keep going
-				select {
-				case <-eng.QuitChannel:
-					appCtx.Logger().Info("Interrupt signal recieved...")
-					eng.DoneChannel <- true
-					return
-				default:
-				}
+		for i := range subscriptions {
+			sub := &subscriptions[i]
+			if sub.EventKey != notif.EventKey {
+				continue
+			}
+			foundSub = true
+
+			if heldSubs[sub.ID] {
+				appCtx.Logger().Info("Subscription is backing off, holding notification",
+					zap.String("notificationID", notif.ID.String()),
+					zap.String("subscriptionID", sub.ID.String()))
+				eng.holdMove(heldMoves, &notif)
+				continue
+			}
+
+			var sev int
+			// If found, send  to subscription
+			err := eng.sendOneNotification(appCtx, &notif, sub)
+			// If notification send failed, we need to log the severity and back off
+			if err != nil {
+				appCtx.Logger().Error("Webhook Notification send failed", zap.Error(err))
+				sev = eng.handleFailedNotification(appCtx, &notif, sub, err, now)
+				heldSubs[sub.ID] = true
+				eng.holdMove(heldMoves, &notif)
+			}
+			// Update subscription, needs to be done on success sometimes, hence it's out of the previous if
+			errDB := eng.updateSubscriptionStatus(appCtx, &notif, sub, sev)
+			if errDB != nil {
+				appCtx.Logger().Error("Webhook Subscription update failed", zap.Error(errDB))
+			}
+
+			// Return out of loop if quit signal recieved, otherwise, keep going
+			select {
+			case <-eng.QuitChannel:
+				appCtx.Logger().Info("Interrupt signal recieved...")
+				eng.DoneChannel <- true
+				return
+			default:
 			}
 		}
 		if !foundSub {
@@ -106,6 +122,100 @@ func (eng *Engine) processNotifications(appCtx appcontext.AppContext, notificati
 	}
 }
 
+// handleFailedNotification records a failed send on the notification, moving it to the dead letter queue once it has
+// run out of retries, and starts the subscription backing off. Returns the new severity for the subscription.
+func (eng *Engine) handleFailedNotification(appCtx appcontext.AppContext, notif *models.WebhookNotification, sub *models.WebhookSubscription, sendErr error, now time.Time) int {
+	var sev int
+	if notif.FirstAttemptedAt == nil {
+		appCtx.Logger().Error("FirstAttempted at time was not stored", zap.Error(sendErr))
+		// We should not ever get this error, so we trigger a sev1 failure immediately
+		sev = 1
+	} else {
+		sev = eng.GetSeverity(now, *notif.FirstAttemptedAt)
+	}
+	if sev != sub.Severity {
+		appCtx.Logger().Error("Raising severity of failure",
+			zap.String("subscriptionEvent", sub.EventKey),
+			zap.Int("severityFrom", sub.Severity),
+			zap.Int("severityTo", sev))
+	}
+
+	errMsg := sendErr.Error()
+	notif.LastError = &errMsg
+	notif.AttemptCount++
+	// A notification that couldn't be built is already FAILED, there's no point replaying it
+	if notif.Status != models.WebhookNotificationFailed && eng.outOfRetries(notif, now) {
+		appCtx.Logger().Error("Moving notification to the dead letter queue",
+			zap.String("notificationID", notif.ID.String()),
+			zap.Int("attemptCount", notif.AttemptCount))
+		notif.Status = models.WebhookNotificationDeadLetter
+	}
+	err := eng.updateNotification(appCtx, notif)
+	if err != nil {
+		appCtx.Logger().Error("Webhook Notification update failed", zap.Error(err))
+	}
+
+	sub.ConsecutiveFailures++
+	if delay := eng.backoffDelay(sub.ConsecutiveFailures); delay > 0 {
+		nextAttemptAt := now.Add(delay)
+		sub.NextAttemptAt = &nextAttemptAt
+	}
+	return sev
+}
+
+// outOfRetries reports whether a failing notification has used up its attempts or is too old to keep retrying
+func (eng *Engine) outOfRetries(notif *models.WebhookNotification, now time.Time) bool {
+	if eng.MaxAttempts > 0 && notif.AttemptCount >= eng.MaxAttempts {
+		return true
+	}
+	maxAge := time.Duration(eng.MaxAgeSeconds) * time.Second
+	return maxAge > 0 && notif.FirstAttemptedAt != nil && now.Sub(*notif.FirstAttemptedAt) >= maxAge
+}
+
+// backoffDelay returns how long to wait before sending to a subscription again after it has failed the given
+// number of times in a row. The delay doubles with each failure up to BackoffMaxSeconds.
+func (eng *Engine) backoffDelay(consecutiveFailures int) time.Duration {
+	if eng.BackoffInitialSeconds <= 0 || consecutiveFailures <= 0 {
+		return 0
+	}
+	delay := time.Duration(eng.BackoffInitialSeconds) * time.Second
+	maxDelay := time.Duration(eng.BackoffMaxSeconds) * time.Second
+	for i := 1; i < consecutiveFailures; i++ {
+		delay *= 2
+		if maxDelay > 0 && delay >= maxDelay {
+			break
+		}
+	}
+	if maxDelay > 0 && delay > maxDelay {
+		delay = maxDelay
+	}
+	return delay
+}
+
+// holdMove stops any later notifications for the notification's move from being sent this run
+func (eng *Engine) holdMove(heldMoves map[uuid.UUID]bool, notif *models.WebhookNotification) {
+	if notif.MoveTaskOrderID != nil {
+		heldMoves[*notif.MoveTaskOrderID] = true
+	}
+}
+
+// fetchDeadLetteredMoveIDs returns the moves that have dead lettered notifications. Their later notifications are
+// held until the dead letters are replayed.
+func (eng *Engine) fetchDeadLetteredMoveIDs(appCtx appcontext.AppContext) (map[uuid.UUID]bool, error) {
+	var moveIDs []uuid.UUID
+	err := appCtx.DB().RawQuery(`SELECT DISTINCT move_id FROM webhook_notifications WHERE status = ? AND move_id IS NOT NULL`,
+		models.WebhookNotificationDeadLetter).All(&moveIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	heldMoves := make(map[uuid.UUID]bool, len(moveIDs))
+	for _, moveID := range moveIDs {
+		heldMoves[moveID] = true
+	}
+	return heldMoves, nil
+}
+
 // updateSubscriptionStatus updates the subscription based on the status of the last notification.
 // Returns nil if nothing to update or update succeeds, returns error if error found
 func (eng *Engine) updateSubscriptionStatus(appCtx appcontext.AppContext, notif *models.WebhookNotification, sub *models.WebhookSubscription,
@@ -119,20 +229,21 @@ func (eng *Engine) updateSubscriptionStatus(appCtx appcontext.AppContext, notif
 		if sub.Status != models.WebhookSubscriptionStatusDisabled {
 			sub.Status = models.WebhookSubscriptionStatusDisabled
 			sub.Severity = newSeverity
-			doUpdate = true
-		}
-	case models.WebhookNotificationFailing:
-		// If the notification is failing, then we may need to update the status and/or severity
-		if sub.Status != models.WebhookSubscriptionStatusFailing || sub.Severity != newSeverity {
-			sub.Status = models.WebhookSubscriptionStatusFailing
-			sub.Severity = newSeverity
-			doUpdate = true
 		}
+		doUpdate = true
+	case models.WebhookNotificationFailing, models.WebhookNotificationDeadLetter:
+		// If the notification is failing, then we need to store the backoff and may need to update the
+		// status and/or severity. Dead lettered notifications can be replayed, so the subscription stays enabled.
+		sub.Status = models.WebhookSubscriptionStatusFailing
+		sub.Severity = newSeverity
+		doUpdate = true
 	case models.WebhookNotificationSent:
-		// If the notification sent, we may need to recover the status and severity back to a-ok
-		if sub.Status != models.WebhookSubscriptionStatusActive || sub.Severity != 0 {
+		// If the notification sent, we may need to recover the status, severity and backoff back to a-ok
+		if sub.Status != models.WebhookSubscriptionStatusActive || sub.Severity != 0 || sub.ConsecutiveFailures != 0 || sub.NextAttemptAt != nil {
 			sub.Status = models.WebhookSubscriptionStatusActive
 			sub.Severity = 0
+			sub.ConsecutiveFailures = 0
+			sub.NextAttemptAt = nil
 			doUpdate = true
 		}
 	}
@@ -187,12 +298,19 @@ func (eng *Engine) sendOneNotification(appCtx appcontext.AppContext, notif *mode
 		return err
 	}
 
+	if len(sub.ActiveSigningSecrets(time.Now())) == 0 {
+		logger.Warn("Subscription has no signing secret, sending notification unsigned",
+			zap.String("subscriptionID", sub.ID.String()))
+	}
+
 	// Try MaxImmediateRetries times to send
 	try := 0
 	for try = 0; try < eng.MaxImmediateRetries; try++ {
 		// Post the notification
 		url := sub.CallbackURL
-		resp, body, err2 := eng.Client.Post(json, url)
+		// Sign each attempt separately so the timestamp is current
+		header := webhooksignature.Headers(sub.ActiveSigningSecrets(time.Now()), time.Now(), json)
+		resp, body, err2 := eng.Client.Post(json, url, header)
 
 		if notif.Status == models.WebhookNotificationPending {
 			now := time.Now()
@@ -310,6 +428,10 @@ func (eng *Engine) Start(appCtx appcontext.AppContext) error {
 	logger := appCtx.Logger()
 	logger.Info("Starting engine", zap.Int("periodInSeconds", eng.PeriodInSeconds),
 		zap.Int("maxImmediateRetries", eng.MaxImmediateRetries),
+		zap.Int("maxAttempts", eng.MaxAttempts),
+		zap.Int("maxAgeSeconds", eng.MaxAgeSeconds),
+		zap.Int("backoffInitialSeconds", eng.BackoffInitialSeconds),
+		zap.Int("backoffMaxSeconds", eng.BackoffMaxSeconds),
 		zap.Any("SeverityThresholds", eng.SeverityThresholds))
 
 	// Set timer tick
@@ -30,6 +30,7 @@ import (
 	"github.com/transcom/mymove/pkg/models"
 	"github.com/transcom/mymove/pkg/testdatagen"
 	"github.com/transcom/mymove/pkg/testingsuite"
+	"github.com/transcom/mymove/pkg/webhooksignature"
 )
 
 // WebhookClientTestingSuite is a suite for testing the webhook client
@@ -177,7 +178,7 @@ func (suite *WebhookClientTestingSuite) Test_SendOneNotification() {
 
 		// Expectation: When Post is called, verify it was called with correct url.
 		// Then, make it return 200 success and a body. It should run once.
-		mockClient.On("Post", mock.Anything, subscription.CallbackURL).Return(&responseSuccess, bodyBytes, nil).Once()
+		mockClient.On("Post", mock.Anything, subscription.CallbackURL, mock.Anything).Return(&responseSuccess, bodyBytes, nil).Once()
 
 		// Call the engine function. Internally it should call the mocked client
 		err := engine.sendOneNotification(suite.AppContextForTest(), &notification, &subscription)
@@ -217,7 +218,7 @@ func (suite *WebhookClientTestingSuite) Test_SendOneNotification() {
 
 		// Set Expectation: When Post is called, verify it was called with the callback url from the subscription.
 		// Then, return failure.
-		mockClient.On("Post", mock.Anything, subscription.CallbackURL).Return(&responseFail, bodyBytes, nil)
+		mockClient.On("Post", mock.Anything, subscription.CallbackURL, mock.Anything).Return(&responseFail, bodyBytes, nil)
 
 		// Call the engine function. Internally it should call the mocked client
 		err := engine.sendOneNotification(suite.AppContextForTest(), &notification, &subscription)
@@ -255,7 +256,7 @@ func (suite *WebhookClientTestingSuite) Test_SendOneNotification() {
 
 		// Expectation: When Post is called, verify it was called with the callback url from the subscription.
 		// Then make it return an error.
-		mockClient.On("Post", mock.Anything, subscription.CallbackURL).Return(&responseSuccess, bodyBytes, errors.New("Error due to server down"))
+		mockClient.On("Post", mock.Anything, subscription.CallbackURL, mock.Anything).Return(&responseSuccess, bodyBytes, errors.New("Error due to server down"))
 
 		// Call the engine function. Internally it should call the mocked client
 		err := engine.sendOneNotification(suite.AppContextForTest(), &notification, &subscription)
@@ -293,10 +294,10 @@ func (suite *WebhookClientTestingSuite) Test_SendOneNotification() {
 		engine.Client = &mockClient
 
 		// Set Expectation: When Post is called, return failure twice
-		mockClient.On("Post", mock.Anything, subscription.CallbackURL).Return(&responseFail, bodyBytes, nil).Twice()
+		mockClient.On("Post", mock.Anything, subscription.CallbackURL, mock.Anything).Return(&responseFail, bodyBytes, nil).Twice()
 
 		// Then return success
-		mockClient.On("Post", mock.Anything, subscription.CallbackURL).Return(&responseSuccess, bodyBytes, nil)
+		mockClient.On("Post", mock.Anything, subscription.CallbackURL, mock.Anything).Return(&responseSuccess, bodyBytes, nil)
 
 		// Call the engine function. Internally it should call the mocked client
 		err := engine.sendOneNotification(suite.AppContextForTest(), &notification, &subscription)
@@ -314,6 +315,42 @@ func (suite *WebhookClientTestingSuite) Test_SendOneNotification() {
 		suite.False(notif.FirstAttemptedAt.IsZero())
 	})
 
+	suite.T().Run("Post is signed with the subscription's secrets", func(_ *testing.T) {
+
+		// Under test: sendOneNotification function
+		// Mocked:     Client
+		// Set up:     We provide a PENDING webhook notification to a subscription that is rotating its secret
+		// Expected outcome:
+		//             Client.Post is called with a body signed with both the new and previous secrets
+
+		suite.DB().Find(&notification, notification.ID)
+		notification.Status = models.WebhookNotificationPending
+		suite.DB().ValidateAndUpdate(&notification)
+
+		previousExpiresAt := time.Now().Add(time.Hour)
+		rotatingSub := subscription
+		rotatingSub.SigningSecret = models.StringPointer("new-secret")
+		rotatingSub.PreviousSigningSecret = models.StringPointer("old-secret")
+		rotatingSub.PreviousSigningSecretExpiresAt = &previousExpiresAt
+
+		signingClient := &mocks.WebhookRuntimeClient{}
+		engine.Client = signingClient
+		var sentBody []byte
+		var sentHeader http.Header
+		signingClient.On("Post", mock.Anything, subscription.CallbackURL, mock.Anything).Run(func(args mock.Arguments) {
+			sentBody = args.Get(0).([]byte)
+			sentHeader = args.Get(2).(http.Header)
+		}).Return(&responseSuccess, bodyBytes, nil).Once()
+
+		err := engine.sendOneNotification(suite.AppContextForTest(), &notification, &rotatingSub)
+		suite.Nil(err)
+		signingClient.AssertExpectations(suite.T())
+
+		suite.NoError(webhooksignature.Verify(sentHeader, sentBody, []string{"new-secret"}, webhooksignature.DefaultTolerance, time.Now()))
+		suite.NoError(webhooksignature.Verify(sentHeader, sentBody, []string{"old-secret"}, webhooksignature.DefaultTolerance, time.Now()))
+		suite.ErrorIs(webhooksignature.Verify(sentHeader, sentBody, []string{"other-secret"}, webhooksignature.DefaultTolerance, time.Now()), webhooksignature.ErrNoMatchingSignature)
+	})
+
 }
 
 func (suite *WebhookClientTestingSuite) Test_EngineRunSuccessful() {
@@ -345,19 +382,19 @@ func (suite *WebhookClientTestingSuite) Test_EngineRunSuccessful() {
 	mockClient.On("Post", mock.MatchedBy(func(body []byte) bool {
 		message := convertBodyToPayload(body)
 		return message.ID == *handlers.FmtUUID(notifications[0].ID)
-	}), subscriptions[0].CallbackURL).Return(&response, bodyBytes, nil)
+	}), subscriptions[0].CallbackURL, mock.Anything).Return(&response, bodyBytes, nil)
 
 	bodyBytes = []byte("notification1 received")
 	mockClient.On("Post", mock.MatchedBy(func(body []byte) bool {
 		message := convertBodyToPayload(body)
 		return message.ID == *handlers.FmtUUID(notifications[1].ID)
-	}), subscriptions[1].CallbackURL).Return(&response, bodyBytes, nil)
+	}), subscriptions[1].CallbackURL, mock.Anything).Return(&response, bodyBytes, nil)
 
 	bodyBytes = []byte("notification2 received")
 	mockClient.On("Post", mock.MatchedBy(func(body []byte) bool {
 		message := convertBodyToPayload(body)
 		return message.ID == *handlers.FmtUUID(notifications[2].ID)
-	}), subscriptions[0].CallbackURL).Return(&response, bodyBytes, nil)
+	}), subscriptions[0].CallbackURL, mock.Anything).Return(&response, bodyBytes, nil)
 
 	// RUN TEST
 	// Call the engine function. Internally it should call the mocked client
@@ -425,13 +462,13 @@ func (suite *WebhookClientTestingSuite) Test_EngineRunInactiveSub() {
 	mockClient.On("Post", mock.MatchedBy(func(body []byte) bool {
 		message := convertBodyToPayload(body)
 		return message.ID == *handlers.FmtUUID(notifications[0].ID)
-	}), subscriptions[0].CallbackURL).Return(&response, bodyBytes, nil)
+	}), subscriptions[0].CallbackURL, mock.Anything).Return(&response, bodyBytes, nil)
 
 	bodyBytes = []byte("notification3 received")
 	mockClient.On("Post", mock.MatchedBy(func(body []byte) bool {
 		message := convertBodyToPayload(body)
 		return message.ID == *handlers.FmtUUID(notifications[2].ID)
-	}), subscriptions[0].CallbackURL).Return(&response, bodyBytes, nil)
+	}), subscriptions[0].CallbackURL, mock.Anything).Return(&response, bodyBytes, nil)
 
 	// RUN TEST
 	// Call the engine function. Internally it should call the mocked client
@@ -469,9 +506,10 @@ func (suite *WebhookClientTestingSuite) Test_EngineRunFailingSub() {
 	//             1 active subscription for PaymentUpdate, client returns success
 	//             1 active subscription for PaymentCreate, client returns failure
 	// Expected outcome:
-	//             PaymentUpdate notification would be updated as SENT
+	//             PaymentUpdate notifications would be updated as SENT
 	//             PaymentCreate notification would be updated as FAILING
 	//             PaymentCreate subscription would be updated as FAILING
+	//             The failure doesn't stop the later PaymentUpdate notification for a different move
 
 	// SETUP SCENARIO
 	engine, notifications, subscriptions := setupEngineRun(suite)
@@ -501,19 +539,24 @@ func (suite *WebhookClientTestingSuite) Test_EngineRunFailingSub() {
 	}
 
 	// SETUP MOCKED OBJECT EXPECTATIONS
-	// Expectation: Post will be once for notification 1 and return success
+	// Expectation: Post will be once for notifications 1 and 3 and return success
 	// It will be called 3 times for notification 2 and return failure
 	bodyBytes := []byte("notification1 received")
 	mockClient.On("Post", mock.MatchedBy(func(body []byte) bool {
 		message := convertBodyToPayload(body)
 		return message.ID == *handlers.FmtUUID(notifications[0].ID)
-	}), subscriptions[0].CallbackURL).Return(&responseSuccess, bodyBytes, nil)
+	}), subscriptions[0].CallbackURL, mock.Anything).Return(&responseSuccess, bodyBytes, nil)
+
+	mockClient.On("Post", mock.MatchedBy(func(body []byte) bool {
+		message := convertBodyToPayload(body)
+		return message.ID == *handlers.FmtUUID(notifications[2].ID)
+	}), subscriptions[0].CallbackURL, mock.Anything).Return(&responseSuccess, []byte("notification3 received"), nil)
 
 	bodyBytes = []byte("notification2 received")
 	mockClient.On("Post", mock.MatchedBy(func(body []byte) bool {
 		message := convertBodyToPayload(body)
 		return message.ID == *handlers.FmtUUID(notifications[1].ID)
-	}), subscriptions[1].CallbackURL).Return(&responseFail, bodyBytes, nil)
+	}), subscriptions[1].CallbackURL, mock.Anything).Return(&responseFail, bodyBytes, nil)
 
 	// RUN TEST
 	// Call the engine function. Internally it should call the mocked client
@@ -524,7 +567,7 @@ func (suite *WebhookClientTestingSuite) Test_EngineRunFailingSub() {
 	suite.Nil(err)
 	// Check that the set expectations were met (the mockClient.On call)
 	mockClient.AssertExpectations(suite.T())
-	mockClient.AssertNumberOfCalls(suite.T(), "Post", 4)
+	mockClient.AssertNumberOfCalls(suite.T(), "Post", 5)
 
 	// Check that notification Status was SENT on 1st notification
 	updatedNotifs := []models.WebhookNotification{}
@@ -539,10 +582,11 @@ func (suite *WebhookClientTestingSuite) Test_EngineRunFailingSub() {
 	// Subscription should be set to FAILING
 	suite.DB().Find(&subscriptions[1], subscriptions[1].ID)
 	suite.Equal(models.WebhookSubscriptionStatusFailing, subscriptions[1].Status)
+	suite.Equal(1, subscriptions[1].ConsecutiveFailures)
 
-	// Third notification should be PENDING
-	suite.Equal(models.WebhookNotificationPending, updatedNotifs[2].Status)
-	suite.Nil(updatedNotifs[2].FirstAttemptedAt)
+	// Third notification is for another move, so it should still have been SENT
+	suite.Equal(models.WebhookNotificationSent, updatedNotifs[2].Status)
+	suite.False(updatedNotifs[2].FirstAttemptedAt.IsZero())
 
 }
 
@@ -559,7 +603,8 @@ func (suite *WebhookClientTestingSuite) Test_EngineRunFailedSubWithSeverity() {
 	//             After first failure - notif marked as failing, subscription severity = 3
 	//             After second failure one minute later - notif marked as failing, subscription severity = 3
 	//             After first threshold - notif marked as failing, subscription severity = 2
-	//             After final threshold - notif marked as failed, subscription severity = 1, subscription deactivated
+	//             After final threshold - notif marked as failing, subscription severity = 1, subscription still failing
+	//             After max age - notif dead lettered, subscription severity = 1, subscription still failing
 	//
 
 	// SETUP SCENARIO
@@ -586,7 +631,7 @@ func (suite *WebhookClientTestingSuite) Test_EngineRunFailedSubWithSeverity() {
 
 		// SETUP MOCKED OBJECT EXPECTATIONS
 		// Expectation: Client.Post will be called and will return failure
-		mockClient.On("Post", mock.Anything, subscriptions[0].CallbackURL).Return(&responseFail, nil, errors.New("Mocked webhook client fails to send"))
+		mockClient.On("Post", mock.Anything, subscriptions[0].CallbackURL, mock.Anything).Return(&responseFail, nil, errors.New("Mocked webhook client fails to send"))
 
 		// RUN TEST
 		// Call the engine function. Internally it should call the mocked client
@@ -687,13 +732,13 @@ func (suite *WebhookClientTestingSuite) Test_EngineRunFailedSubWithSeverity() {
 
 	})
 
-	suite.T().Run("Severity 1 failure - deactivation", func(_ *testing.T) {
+	suite.T().Run("Severity 1 failure", func(_ *testing.T) {
 
 		// Set up:     Notification is FAILING already
 		//			   We update the firstAttemptedAt time to mimic a notification that's been failing
 		//             longer than the final threshold
 		// Expected outcome:
-		//             After final threshold - notif marked as FAILED, subscription severity = 1, subscription DISABLED
+		//             After final threshold - notif still marked as FAILING, subscription severity = 1, subscription FAILING
 
 		// Update firstAttemptedTime to be more than one threshold ago
 		durationOffset := time.Duration(engine.SeverityThresholds[1]) * time.Second
@@ -715,20 +760,61 @@ func (suite *WebhookClientTestingSuite) Test_EngineRunFailedSubWithSeverity() {
 		mockClient.AssertExpectations(suite.T())
 		mockClient.AssertNumberOfCalls(suite.T(), "Post", numExpectedPosts)
 
-		// Check that notification is marked as FAILED
+		// Check that notification is still marked as FAILING, severity alone doesn't dead letter it
+		suite.DB().Find(&notifications[0], notifications[0].ID)
+		suite.Equal(models.WebhookNotificationFailing, notifications[0].Status)
+
+		// Check that subscription is still marked as FAILING, with severity 1
+		suite.DB().Find(&subscriptions[0], subscriptions[0].ID)
+		suite.Equal(models.WebhookSubscriptionStatusFailing, subscriptions[0].Status)
+		suite.Equal(1, subscriptions[0].Severity)
+
+	})
+
+	suite.T().Run("Max age failure - dead letter", func(_ *testing.T) {
+
+		// Set up:     Notification is FAILING already
+		//			   We update the firstAttemptedAt time to mimic a notification that's been failing
+		//             longer than the max age
+		// Expected outcome:
+		//             After max age - notif marked as DEAD_LETTER, subscription severity = 1, subscription FAILING
+
+		engine.MaxAgeSeconds = 2 * engine.SeverityThresholds[1]
+		durationOffset := time.Duration(engine.MaxAgeSeconds) * time.Second
+		timestamp := *(notifications[0].FirstAttemptedAt)
+		timestamp = timestamp.Add(-durationOffset)
+		notifications[0].FirstAttemptedAt = &timestamp
+		suite.DB().ValidateAndUpdate(&notifications[0])
+
+		// RUN TEST
+		// Call the engine function. Internally it should call the mocked client
+		err := engine.run(suite.AppContextForTest())
+
+		// VERIFY RESULTS
+		// Check that there was no error
+		suite.Nil(err)
+
+		// Check that the set expectations were met (the mockClient.On call)
+		numExpectedPosts += engine.MaxImmediateRetries
+		mockClient.AssertExpectations(suite.T())
+		mockClient.AssertNumberOfCalls(suite.T(), "Post", numExpectedPosts)
+
+		// Check that notification is marked as DEAD_LETTER
 		suite.DB().Find(&notifications[0], notifications[0].ID)
-		suite.Equal(models.WebhookNotificationFailed, notifications[0].Status)
+		suite.Equal(models.WebhookNotificationDeadLetter, notifications[0].Status)
+		suite.Equal(5, notifications[0].AttemptCount)
+		suite.NotNil(notifications[0].LastError)
 
-		// Check that subscription is marked as DISABLED, with severity 1
+		// Check that subscription is still marked as FAILING, with severity 1
 		suite.DB().Find(&subscriptions[0], subscriptions[0].ID)
-		suite.Equal(models.WebhookSubscriptionStatusDisabled, subscriptions[0].Status)
+		suite.Equal(models.WebhookSubscriptionStatusFailing, subscriptions[0].Status)
 		suite.Equal(1, subscriptions[0].Severity)
 
 	})
 
 	suite.T().Run("Notification not tried again", func(_ *testing.T) {
 
-		// Set up:     Notification has FAILED, subscription has been DISABLED
+		// Set up:     Notification has been dead lettered
 		// Expected outcome:
 		//             Engine no longer attempts to send this notification.
 
@@ -745,13 +831,13 @@ func (suite *WebhookClientTestingSuite) Test_EngineRunFailedSubWithSeverity() {
 		mockClient.AssertExpectations(suite.T())
 		mockClient.AssertNumberOfCalls(suite.T(), "Post", numExpectedPosts)
 
-		// Check that notification is marked as FAILING
+		// Check that notification is still marked as DEAD_LETTER
 		suite.DB().Find(&notifications[0], notifications[0].ID)
-		suite.Equal(models.WebhookNotificationFailed, notifications[0].Status)
+		suite.Equal(models.WebhookNotificationDeadLetter, notifications[0].Status)
 
-		// Check that subscription is marked as DISABLED, with severity 1
+		// Check that subscription is still marked as FAILING, with severity 1
 		suite.DB().Find(&subscriptions[0], subscriptions[0].ID)
-		suite.Equal(models.WebhookSubscriptionStatusDisabled, subscriptions[0].Status)
+		suite.Equal(models.WebhookSubscriptionStatusFailing, subscriptions[0].Status)
 		suite.Equal(1, subscriptions[0].Severity)
 
 	})
@@ -764,9 +850,9 @@ func (suite *WebhookClientTestingSuite) Test_EngineRunFailingRecovery() {
 	// Under test: Engine.run() function
 	// Mocked:     Client
 	// Set up:     We provide 3 PENDING webhook notifications with active subscriptions.
-	//             Client returns failure repeatedly on the first run, then recovers on the second run.
+	//             Client returns failure repeatedly for one subscription on the first run, then recovers on the second run.
 	// Expected outcome:
-	//             After failure - notif marked as failing, sub severity = 2, subscription status = failing
+	//             After failure - notif marked as failing, sub severity = 3, subscription status = failing
 	//             After success - notif marked as sent, subscription severity = 0, subscription status = active
 
 	// SETUP SCENARIO
@@ -786,12 +872,14 @@ func (suite *WebhookClientTestingSuite) Test_EngineRunFailingRecovery() {
 	suite.T().Run("Severity 3 failure", func(_ *testing.T) {
 
 		// Set up:     We provide 3 PENDING webhook notifications with active subscriptions.
-		//             Client returns failure repeatedly
+		//             Client returns failure repeatedly for the Payment.Update subscription
 		// Expected outcome:
 		//             After failure - notif marked as FAILING, sub severity = 3, subscription status FAILING
+		//             The other Payment.Update notification is held, the Payment.Create notification is sent
 
 		// Make mockClient fail to send
-		mockClient.On("Post", mock.Anything, subscriptions[0].CallbackURL).Return(&responseFail, nil, errors.New("Mocked webhook client fails to send"))
+		mockClient.On("Post", mock.Anything, subscriptions[0].CallbackURL, mock.Anything).Return(&responseFail, nil, errors.New("Mocked webhook client fails to send"))
+		mockClient.On("Post", mock.Anything, subscriptions[1].CallbackURL, mock.Anything).Return(&responseSuccess, []byte("notification1 received"), nil)
 
 		// RUN TEST
 		// Call the engine function. Internally it should call the mocked client
@@ -803,16 +891,24 @@ func (suite *WebhookClientTestingSuite) Test_EngineRunFailingRecovery() {
 
 		// Check that the set expectations were met (the mockClient.On call)
 		mockClient.AssertExpectations(suite.T())
-		mockClient.AssertNumberOfCalls(suite.T(), "Post", 3)
+		mockClient.AssertNumberOfCalls(suite.T(), "Post", 4)
 
 		// Check that notification is marked as FAILING
 		suite.DB().Find(&notifications[0], notifications[0].ID)
 		suite.Equal(models.WebhookNotificationFailing, notifications[0].Status)
+		suite.Equal(1, notifications[0].AttemptCount)
+
+		// Check that the notifications after the failure were handled per subscription
+		suite.DB().Find(&notifications[1], notifications[1].ID)
+		suite.Equal(models.WebhookNotificationSent, notifications[1].Status)
+		suite.DB().Find(&notifications[2], notifications[2].ID)
+		suite.Equal(models.WebhookNotificationPending, notifications[2].Status)
 
 		// Check that subscription is marked as FAILING, with severity 3
 		suite.DB().Find(&subscriptions[0], subscriptions[0].ID)
 		suite.Equal(models.WebhookSubscriptionStatusFailing, subscriptions[0].Status)
 		suite.Equal(3, subscriptions[0].Severity)
+		suite.Equal(1, subscriptions[0].ConsecutiveFailures)
 
 	})
 
@@ -827,7 +923,7 @@ func (suite *WebhookClientTestingSuite) Test_EngineRunFailingRecovery() {
 		mockClient = &mocks.WebhookRuntimeClient{}
 		engine.Client = mockClient
 		bodyBytes := []byte("notification0 received")
-		mockClient.On("Post", mock.Anything, mock.Anything).Return(&responseSuccess, bodyBytes, nil)
+		mockClient.On("Post", mock.Anything, mock.Anything, mock.Anything).Return(&responseSuccess, bodyBytes, nil)
 
 		// RUN TEST
 		// Call the engine function. Internally it should call the mocked client
@@ -839,7 +935,7 @@ func (suite *WebhookClientTestingSuite) Test_EngineRunFailingRecovery() {
 
 		// Check that the set expectations were met (the mockClient.On call)
 		mockClient.AssertExpectations(suite.T())
-		mockClient.AssertNumberOfCalls(suite.T(), "Post", 3)
+		mockClient.AssertNumberOfCalls(suite.T(), "Post", 2)
 
 		// Check that notifications are marked as SENT
 		suite.DB().Find(&notifications[0], notifications[0].ID)
@@ -853,6 +949,8 @@ func (suite *WebhookClientTestingSuite) Test_EngineRunFailingRecovery() {
 		suite.DB().Find(&subscriptions[0], subscriptions[0].ID)
 		suite.Equal(models.WebhookSubscriptionStatusActive, subscriptions[0].Status)
 		suite.Equal(0, subscriptions[0].Severity)
+		suite.Equal(0, subscriptions[0].ConsecutiveFailures)
+		suite.Nil(subscriptions[0].NextAttemptAt)
 		suite.DB().Find(&subscriptions[1], subscriptions[1].ID)
 		suite.Equal(models.WebhookSubscriptionStatusActive, subscriptions[1].Status)
 		suite.Equal(0, subscriptions[1].Severity)
@@ -867,9 +965,9 @@ func (suite *WebhookClientTestingSuite) Test_EngineRunNoThresholds() {
 	// Mocked:     Client
 	// Set up:     We provide 3 PENDING webhook notifications with active subscriptions.
 	//             No thresholds are set, empty array
-	//             Client returns failure repeatedly on the first run, then recovers on the second run.
+	//             Client returns failure repeatedly for one subscription.
 	// Expected outcome:
-	//             After failure - notif marked as FAILED, sub severity = 1, subscription status = DISABLED
+	//             After failure - notif marked as FAILING, sub severity = 1, subscription status = FAILING
 	//             No panics!
 
 	// SETUP SCENARIO
@@ -878,6 +976,10 @@ func (suite *WebhookClientTestingSuite) Test_EngineRunNoThresholds() {
 	engine.SeverityThresholds = []int{}
 	defer teardownEngineRun(suite)
 
+	var responseSuccess = http.Response{
+		Status:     "200 Success",
+		StatusCode: 200,
+	}
 	var responseFail = http.Response{
 		Status:     "400 Not Found Error",
 		StatusCode: 400,
@@ -887,13 +989,14 @@ func (suite *WebhookClientTestingSuite) Test_EngineRunNoThresholds() {
 
 		// Set up:     We provide 3 PENDING webhook notifications with active subscriptions.
 		//             No thresholds are set, empty array
-		//             Client returns failure repeatedly on the first run, then recovers on the second run.
+		//             Client returns failure repeatedly for one subscription.
 		// Expected outcome:
-		//             After failure - notif marked as FAILED, sub severity = 1, subscription status = DISABLED
+		//             After failure - notif marked as FAILING, sub severity = 1, subscription status = FAILING
 		//             No panics!
 
 		// Make mockClient fail to send
-		mockClient.On("Post", mock.Anything, subscriptions[0].CallbackURL).Return(&responseFail, nil, errors.New("Mocked webhook client fails to send"))
+		mockClient.On("Post", mock.Anything, subscriptions[0].CallbackURL, mock.Anything).Return(&responseFail, nil, errors.New("Mocked webhook client fails to send"))
+		mockClient.On("Post", mock.Anything, subscriptions[1].CallbackURL, mock.Anything).Return(&responseSuccess, []byte("notification1 received"), nil)
 
 		// RUN TEST
 		// Call the engine function. Internally it should call the mocked client
@@ -905,15 +1008,15 @@ func (suite *WebhookClientTestingSuite) Test_EngineRunNoThresholds() {
 
 		// Check that the set expectations were met (the mockClient.On call)
 		mockClient.AssertExpectations(suite.T())
-		mockClient.AssertNumberOfCalls(suite.T(), "Post", 3)
+		mockClient.AssertNumberOfCalls(suite.T(), "Post", 4)
 
-		// Check that notification is marked as FAILED
+		// Check that notification is marked as FAILING, it is only dead lettered once out of retries
 		suite.DB().Find(&notifications[0], notifications[0].ID)
-		suite.Equal(models.WebhookNotificationFailed, notifications[0].Status)
+		suite.Equal(models.WebhookNotificationFailing, notifications[0].Status)
 
-		// Check that subscription is marked as DISABLED, with severity 1
+		// Check that subscription is marked as FAILING, with severity 1
 		suite.DB().Find(&subscriptions[0], subscriptions[0].ID)
-		suite.Equal(models.WebhookSubscriptionStatusDisabled, subscriptions[0].Status)
+		suite.Equal(models.WebhookSubscriptionStatusFailing, subscriptions[0].Status)
 		suite.Equal(1, subscriptions[0].Severity)
 	})
 }
@@ -952,7 +1055,7 @@ func (suite *WebhookClientTestingSuite) Test_EngineRunNoPending() {
 	// SETUP MOCKED OBJECT EXPECTATIONS
 	// Expectation: We set up a possible call here, but we will be checking that in fact
 	// it was NOT called.
-	mockClient.On("Post", mock.Anything, mock.Anything).Return(&response, []byte(""), nil)
+	mockClient.On("Post", mock.Anything, mock.Anything, mock.Anything).Return(&response, []byte(""), nil)
 
 	// RUN TEST
 	// Call the engine function. Internally it should call the mocked client
@@ -962,8 +1065,209 @@ func (suite *WebhookClientTestingSuite) Test_EngineRunNoPending() {
 	// Check that there was no error
 	suite.Nil(err)
 	// Check that the Post function was not called
-	mockClient.AssertNotCalled(suite.T(), "Post", mock.Anything, mock.Anything)
+	mockClient.AssertNotCalled(suite.T(), "Post", mock.Anything, mock.Anything, mock.Anything)
+
+}
+
+func (suite *WebhookClientTestingSuite) Test_EngineRunBackoff() {
+
+	// TESTCASE SCENARIO
+	// Under test: Engine.run() function
+	// Mocked:     Client
+	// Set up:     We provide a PENDING webhook notification with an ACTIVE subscription.
+	//             Backoff is enabled and the client returns failure repeatedly
+	// Expected outcome:
+	//             After each failure the subscription waits twice as long before it is sent to again
+	//             The subscription isn't sent to while it's waiting
+
+	// SETUP SCENARIO
+	engine, notifications, subscriptions := setupEngineRun(suite)
+	mockClient := engine.Client.(*mocks.WebhookRuntimeClient)
+	engine.BackoffInitialSeconds = 60
+	engine.BackoffMaxSeconds = 600
+	defer teardownEngineRun(suite)
+
+	// We only need 1st notification, delete the others
+	suite.DB().Destroy(&notifications[1])
+	suite.DB().Destroy(&notifications[2])
+
+	var responseFail = http.Response{
+		Status:     "400 Not Found Error",
+		StatusCode: 400,
+	}
+	mockClient.On("Post", mock.Anything, subscriptions[0].CallbackURL, mock.Anything).Return(&responseFail, nil, errors.New("Mocked webhook client fails to send"))
 
+	suite.T().Run("First failure backs off", func(_ *testing.T) {
+		before := time.Now()
+		err := engine.run(suite.AppContextForTest())
+		suite.Nil(err)
+		mockClient.AssertNumberOfCalls(suite.T(), "Post", engine.MaxImmediateRetries)
+
+		suite.DB().Find(&subscriptions[0], subscriptions[0].ID)
+		suite.Equal(1, subscriptions[0].ConsecutiveFailures)
+		suite.NotNil(subscriptions[0].NextAttemptAt)
+		suite.True(subscriptions[0].NextAttemptAt.After(before.Add(59 * time.Second)))
+	})
+
+	suite.T().Run("Subscription is not sent to while backing off", func(_ *testing.T) {
+		err := engine.run(suite.AppContextForTest())
+		suite.Nil(err)
+		mockClient.AssertNumberOfCalls(suite.T(), "Post", engine.MaxImmediateRetries)
+
+		suite.DB().Find(&notifications[0], notifications[0].ID)
+		suite.Equal(models.WebhookNotificationFailing, notifications[0].Status)
+		suite.Equal(1, notifications[0].AttemptCount)
+	})
+
+	suite.T().Run("Second failure doubles the backoff", func(_ *testing.T) {
+		// Pretend the backoff has passed
+		past := time.Now().Add(-time.Second)
+		subscriptions[0].NextAttemptAt = &past
+		suite.DB().ValidateAndUpdate(&subscriptions[0])
+
+		before := time.Now()
+		err := engine.run(suite.AppContextForTest())
+		suite.Nil(err)
+		mockClient.AssertNumberOfCalls(suite.T(), "Post", 2*engine.MaxImmediateRetries)
+
+		suite.DB().Find(&subscriptions[0], subscriptions[0].ID)
+		suite.Equal(2, subscriptions[0].ConsecutiveFailures)
+		suite.True(subscriptions[0].NextAttemptAt.After(before.Add(119 * time.Second)))
+
+		suite.DB().Find(&notifications[0], notifications[0].ID)
+		suite.Equal(2, notifications[0].AttemptCount)
+	})
+}
+
+func (suite *WebhookClientTestingSuite) Test_EngineRunMoveOrdering() {
+
+	// TESTCASE SCENARIO
+	// Under test: Engine.run() function
+	// Mocked:     Client
+	// Set up:     We provide a MTOShipment.Create and a MTOShipment.Update notification for the same move,
+	//             each with its own subscription. The client fails to send the create.
+	//             The engine dead letters a notification after one failed run.
+	// Expected outcome:
+	//             The update is held until the create has been sent, even once the create is dead lettered
+	//             Once the create is replayed both are sent in order
+
+	// SETUP SCENARIO
+	engine, notifications, _ := setupEngineRun(suite)
+	mockClient := engine.Client.(*mocks.WebhookRuntimeClient)
+	engine.MaxAttempts = 1
+	defer teardownEngineRun(suite)
+
+	// We only need notifications for a single move, delete the others
+	for i := range notifications {
+		suite.DB().Destroy(&notifications[i])
+	}
+
+	move := factory.BuildMove(suite.DB(), nil, nil)
+	createNotif := factory.BuildWebhookNotification(suite.DB(), []factory.Customization{
+		{
+			Model:    move,
+			LinkOnly: true,
+		},
+		{
+			Model: models.WebhookNotification{
+				EventKey: "MTOShipment.Create",
+			},
+		},
+	}, nil)
+	updateNotif := factory.BuildWebhookNotification(suite.DB(), []factory.Customization{
+		{
+			Model:    move,
+			LinkOnly: true,
+		},
+		{
+			Model: models.WebhookNotification{
+				EventKey: "MTOShipment.Update",
+			},
+		},
+	}, nil)
+	createSub := testdatagen.MakeWebhookSubscription(suite.DB(), testdatagen.Assertions{
+		WebhookSubscription: models.WebhookSubscription{
+			EventKey:    "MTOShipment.Create",
+			CallbackURL: "/my/callback/url/create",
+		},
+	})
+	updateSub := testdatagen.MakeWebhookSubscription(suite.DB(), testdatagen.Assertions{
+		WebhookSubscription: models.WebhookSubscription{
+			EventKey:    "MTOShipment.Update",
+			CallbackURL: "/my/callback/url/update",
+		},
+	})
+
+	var responseSuccess = http.Response{
+		Status:     "200 Success",
+		StatusCode: 200,
+	}
+	var responseFail = http.Response{
+		Status:     "400 Not Found Error",
+		StatusCode: 400,
+	}
+
+	suite.T().Run("Update is held while the create is failing", func(_ *testing.T) {
+		mockClient.On("Post", mock.Anything, createSub.CallbackURL, mock.Anything).Return(&responseFail, nil, errors.New("Mocked webhook client fails to send"))
+		mockClient.On("Post", mock.Anything, updateSub.CallbackURL, mock.Anything).Return(&responseSuccess, []byte("update received"), nil)
+
+		err := engine.run(suite.AppContextForTest())
+		suite.Nil(err)
+		mockClient.AssertNumberOfCalls(suite.T(), "Post", engine.MaxImmediateRetries)
+		mockClient.AssertNotCalled(suite.T(), "Post", mock.Anything, updateSub.CallbackURL, mock.Anything)
+
+		suite.DB().Find(&createNotif, createNotif.ID)
+		suite.Equal(models.WebhookNotificationDeadLetter, createNotif.Status)
+		suite.DB().Find(&updateNotif, updateNotif.ID)
+		suite.Equal(models.WebhookNotificationPending, updateNotif.Status)
+	})
+
+	suite.T().Run("Update is held while the create is dead lettered", func(_ *testing.T) {
+		err := engine.run(suite.AppContextForTest())
+		suite.Nil(err)
+		mockClient.AssertNumberOfCalls(suite.T(), "Post", engine.MaxImmediateRetries)
+
+		suite.DB().Find(&updateNotif, updateNotif.ID)
+		suite.Equal(models.WebhookNotificationPending, updateNotif.Status)
+	})
+
+	suite.T().Run("Both are sent in order once the create is replayed", func(_ *testing.T) {
+		createNotif.Status = models.WebhookNotificationPending
+		createNotif.AttemptCount = 0
+		suite.DB().ValidateAndUpdate(&createNotif)
+
+		mockClient = &mocks.WebhookRuntimeClient{}
+		engine.Client = mockClient
+		var sentURLs []string
+		mockClient.On("Post", mock.Anything, mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			sentURLs = append(sentURLs, args.String(1))
+		}).Return(&responseSuccess, []byte("received"), nil)
+
+		err := engine.run(suite.AppContextForTest())
+		suite.Nil(err)
+		suite.Equal([]string{createSub.CallbackURL, updateSub.CallbackURL}, sentURLs)
+
+		suite.DB().Find(&createNotif, createNotif.ID)
+		suite.Equal(models.WebhookNotificationSent, createNotif.Status)
+		suite.DB().Find(&updateNotif, updateNotif.ID)
+		suite.Equal(models.WebhookNotificationSent, updateNotif.Status)
+	})
+}
+
+func (suite *WebhookClientTestingSuite) Test_BackoffDelay() {
+	engine := Engine{
+		BackoffInitialSeconds: 30,
+		BackoffMaxSeconds:     300,
+	}
+	suite.Equal(time.Duration(0), engine.backoffDelay(0))
+	suite.Equal(30*time.Second, engine.backoffDelay(1))
+	suite.Equal(60*time.Second, engine.backoffDelay(2))
+	suite.Equal(240*time.Second, engine.backoffDelay(4))
+	suite.Equal(300*time.Second, engine.backoffDelay(5))
+	suite.Equal(300*time.Second, engine.backoffDelay(100))
+
+	engine.BackoffInitialSeconds = 0
+	suite.Equal(time.Duration(0), engine.backoffDelay(3))
 }
 
 func setupEngineRun(suite *WebhookClientTestingSuite) (*Engine, []models.WebhookNotification, []models.WebhookSubscription) {
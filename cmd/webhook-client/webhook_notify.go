@@ -1,6 +1,7 @@
 package main
 
 import (
+	"fmt"
 	"log"
 	"os"
 	"os/signal"
@@ -20,15 +21,40 @@ const (
 	PeriodFlag string = "period"
 	// MaxRetriesFlag indicates how many times to immediately retry
 	MaxRetriesFlag string = "max-retries"
+	// MaxAttemptsFlag indicates how many runs a notification can fail before it is dead lettered
+	MaxAttemptsFlag string = "max-attempts"
+	// MaxAgeFlag indicates how long in secs a notification can keep failing before it is dead lettered
+	MaxAgeFlag string = "max-age"
+	// BackoffInitialFlag indicates how long to wait in secs before sending to a failing subscription again
+	BackoffInitialFlag string = "backoff-initial"
+	// BackoffMaxFlag indicates the longest time in secs to wait before sending to a failing subscription again
+	BackoffMaxFlag string = "backoff-max"
 )
 
 // Init flags specific to this command
 func initWebhookNotifyFlags(flag *pflag.FlagSet) {
 	flag.Int(PeriodFlag, 5, "Period in secs to check for notifications")
 	flag.Int(MaxRetriesFlag, 3, "Number of times to immediately retry")
+	flag.Int(MaxAttemptsFlag, 20, "Number of failed runs before a notification is dead lettered, 0 for no limit")
+	flag.Int(MaxAgeFlag, 86400, "Secs after its first attempt before a failing notification is dead lettered, 0 for no limit")
+	flag.Int(BackoffInitialFlag, 30, "Secs to wait before sending to a failing subscription again, doubled for each failure in a row, 0 to disable")
+	flag.Int(BackoffMaxFlag, 3600, "Maximum secs to wait before sending to a failing subscription again")
 	flag.SortFlags = false
 }
 
+// checkWebhookNotifyConfig validates the flags specific to this command
+func checkWebhookNotifyConfig(v *viper.Viper) error {
+	for _, flagName := range []string{MaxAttemptsFlag, MaxAgeFlag, BackoffInitialFlag, BackoffMaxFlag} {
+		if v.GetInt(flagName) < 0 {
+			return fmt.Errorf("%s must not be negative", flagName)
+		}
+	}
+	if v.GetInt(BackoffMaxFlag) < v.GetInt(BackoffInitialFlag) {
+		return fmt.Errorf("%s must be at least %s", BackoffMaxFlag, BackoffInitialFlag)
+	}
+	return nil
+}
+
 func webhookNotify(cmd *cobra.Command, args []string) error {
 	v := viper.New()
 
@@ -38,6 +64,11 @@ func webhookNotify(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	err = checkWebhookNotifyConfig(v)
+	if err != nil {
+		return err
+	}
+
 	// Validate all arguments passed in including DB, CAC, etc...
 	// Also this opens the db connection and creates a logger
 	db, logger, err := InitRootConfig(v)
@@ -65,12 +96,16 @@ func webhookNotify(cmd *cobra.Command, args []string) error {
 
 	// Create a webhook engine
 	webhookEngine := webhook.Engine{
-		Client:              runtime,
-		PeriodInSeconds:     v.GetInt(PeriodFlag),
-		MaxImmediateRetries: v.GetInt(MaxRetriesFlag),
-		SeverityThresholds:  []int{60},
-		QuitChannel:         make(chan os.Signal, 1),
-		DoneChannel:         make(chan bool, 1),
+		Client:                runtime,
+		PeriodInSeconds:       v.GetInt(PeriodFlag),
+		MaxImmediateRetries:   v.GetInt(MaxRetriesFlag),
+		MaxAttempts:           v.GetInt(MaxAttemptsFlag),
+		MaxAgeSeconds:         v.GetInt(MaxAgeFlag),
+		BackoffInitialSeconds: v.GetInt(BackoffInitialFlag),
+		BackoffMaxSeconds:     v.GetInt(BackoffMaxFlag),
+		SeverityThresholds:    []int{60},
+		QuitChannel:           make(chan os.Signal, 1),
+		DoneChannel:           make(chan bool, 1),
 	}
 
 	appCtx := appcontext.NewAppContext(db, logger, nil, nil)
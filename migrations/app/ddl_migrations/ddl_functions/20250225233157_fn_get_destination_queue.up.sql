@@ -5,6 +5,8 @@
 -- B-23545 - Daniel Jordan updating returns to use destination, filtering adjustments, removing gbloc return
 -- B-23739 - Daniel Jordan updating returns to consider lock_expires_at
 -- B-22759 - Paul Stonebraker add SIT extensions as part of the mto_shipments
+-- limit the queue to the moves the office user may see when access_office_user_id is set
+-- continue after a pagination cursor's sort values when after_sort_values is set, and return each move's sort values
 
 -- database function that returns a list of moves that have destination requests
 -- this includes shipment address update requests, destination SIT, & destination shuttle
@@ -27,7 +29,9 @@ CREATE OR REPLACE FUNCTION get_destination_queue(
     page INTEGER DEFAULT 1,
     per_page INTEGER DEFAULT 20,
     sort TEXT DEFAULT NULL,
-    sort_direction TEXT DEFAULT NULL
+    sort_direction TEXT DEFAULT NULL,
+    access_office_user_id UUID DEFAULT NULL,
+    after_sort_values TEXT[] DEFAULT NULL
 )
 RETURNS TABLE (
     id UUID,
@@ -45,6 +49,7 @@ RETURNS TABLE (
     counseling_transportation_office JSONB,
     too_destination_assigned JSONB,
     mto_service_items JSONB,
+    sort_values JSONB,
     total_count BIGINT
 ) AS $$
 DECLARE
@@ -52,6 +57,9 @@ DECLARE
     offset_value INTEGER;
     sort_column TEXT;
     sort_order TEXT;
+    sort_expressions TEXT[];
+    sort_descending BOOLEAN[];
+    sort_keys TEXT[];
 BEGIN
     IF page < 1 THEN
         page := 1;
@@ -63,6 +71,46 @@ BEGIN
 
     offset_value := (page - 1) * per_page;
 
+    -- default sorting values if none are provided (move.id)
+    sort_column := 'moves.id';
+    sort_order := 'ASC';
+
+    IF sort IS NOT NULL THEN
+        CASE sort
+            WHEN 'locator' THEN sort_column := 'moves.locator';
+            WHEN 'status' THEN sort_column := 'moves.status';
+            WHEN 'customerName' THEN sort_column := 'service_members.last_name, service_members.first_name';
+            WHEN 'edipi' THEN sort_column := 'service_members.edipi';
+            WHEN 'emplid' THEN sort_column := 'service_members.emplid';
+            WHEN 'requestedMoveDate' THEN   sort_column := 'COALESCE(' || 'MIN(mto_shipments.requested_pickup_date),' || 'MIN(ppm_shipments.expected_departure_date),' || 'MIN(mto_shipments.requested_delivery_date)' || ')';
+            WHEN 'appearedInTooAt' THEN sort_column := 'COALESCE(moves.submitted_at, moves.approvals_requested_at)';
+            WHEN 'branch' THEN sort_column := 'service_members.affiliation';
+            WHEN 'destinationDutyLocation' THEN sort_column := 'new_duty_locations.name';
+            WHEN 'counselingOffice' THEN sort_column := 'counseling_offices.name';
+            WHEN 'assignedTo' THEN sort_column := 'too_user.last_name';
+            ELSE
+                sort_column := 'moves.id';
+        END CASE;
+    END IF;
+
+    IF sort_direction IS NOT NULL THEN
+        IF LOWER(sort_direction) = 'desc' THEN
+            sort_order := 'DESC';
+        ELSE
+            sort_order := 'ASC';
+        END IF;
+    END IF;
+
+    -- the expressions the queue is ordered by, which a cursor holds the values of
+    IF sort = 'customerName' THEN
+        sort_expressions := ARRAY['service_members.last_name', 'service_members.first_name', 'moves.locator'];
+        sort_descending := ARRAY[sort_order = 'DESC', sort_order = 'DESC', FALSE];
+    ELSE
+        sort_expressions := ARRAY[sort_column, 'moves.locator'];
+        sort_descending := ARRAY[sort_order = 'DESC', FALSE];
+    END IF;
+    sort_keys := ARRAY(SELECT 'queue.sort_key_' || n FROM generate_series(1, cardinality(sort_expressions)) AS n);
+
     sql_query := '
         SELECT
             moves.id AS id,
@@ -163,7 +211,8 @@ BEGIN
                 ),
                 ''[]''
             )::JSONB as mto_service_items,
-            COUNT(*) OVER() AS total_count
+            COUNT(*) OVER() AS total_count,
+            ' || (SELECT string_agg(format('%s AS sort_key_%s', e, n), ', ' ORDER BY n) FROM unnest(sort_expressions) WITH ORDINALITY AS u(e, n)) || '
         FROM moves
         JOIN orders ON moves.orders_id = orders.id
         JOIN mto_shipments ON mto_shipments.move_id = moves.id
@@ -242,6 +291,10 @@ BEGIN
     sql_query := sql_query || ' AND orders.orders_type != ''SAFETY'' ';
    END IF;
 
+   IF access_office_user_id IS NOT NULL THEN
+       sql_query := sql_query || ' AND office_user_can_see_move($16, moves.id) ';
+   END IF;
+
     -- add destination queue-specific filters (pending dest address requests, pending dest SIT extension requests when there are dest SIT service items, submitted dest SIT & dest shuttle service items)
     sql_query := sql_query || '
         AND (
@@ -257,36 +310,6 @@ BEGIN
         )
     ';
 
-    -- default sorting values if none are provided (move.id)
-    sort_column := 'id';
-    sort_order := 'ASC';
-
-    IF sort IS NOT NULL THEN
-        CASE sort
-            WHEN 'locator' THEN sort_column := 'moves.locator';
-            WHEN 'status' THEN sort_column := 'moves.status';
-            WHEN 'customerName' THEN sort_column := 'service_members.last_name, service_members.first_name';
-            WHEN 'edipi' THEN sort_column := 'service_members.edipi';
-            WHEN 'emplid' THEN sort_column := 'service_members.emplid';
-            WHEN 'requestedMoveDate' THEN   sort_column := 'COALESCE(' || 'MIN(mto_shipments.requested_pickup_date),' || 'MIN(ppm_shipments.expected_departure_date),' || 'MIN(mto_shipments.requested_delivery_date)' || ')';
-            WHEN 'appearedInTooAt' THEN sort_column := 'COALESCE(moves.submitted_at, moves.approvals_requested_at)';
-            WHEN 'branch' THEN sort_column := 'service_members.affiliation';
-            WHEN 'destinationDutyLocation' THEN sort_column := 'new_duty_locations.name';
-            WHEN 'counselingOffice' THEN sort_column := 'counseling_offices.name';
-            WHEN 'assignedTo' THEN sort_column := 'too_user.last_name';
-            ELSE
-                sort_column := 'moves.id';
-        END CASE;
-    END IF;
-
-    IF sort_direction IS NOT NULL THEN
-        IF LOWER(sort_direction) = 'desc' THEN
-            sort_order := 'DESC';
-        ELSE
-            sort_order := 'ASC';
-        END IF;
-    END IF;
-
     sql_query := sql_query || '
         GROUP BY
             moves.id,
@@ -312,27 +335,29 @@ BEGIN
             too_user.last_name,
             too_user.id';
 
-    -- handling ordering customer name by last, first
-    IF sort = 'customerName' THEN
-      sql_query := sql_query || format(
-        ' ORDER BY service_members.last_name %s, service_members.first_name %s',
-        sort_order, sort_order
-      );
-    ELSE
-      sql_query := sql_query || format(
-        ' ORDER BY %s %s',
-        sort_column, sort_order
-      );
-    END IF;
+    -- the moves are counted before the cursor so the total is the whole queue
+    sql_query := '
+        SELECT
+            id, show, locator, submitted_at, orders_id, status, locked_by, lock_expires_at, too_destination_assigned_id,
+            counseling_transportation_office_id, orders, mto_shipments, counseling_transportation_office,
+            too_destination_assigned, mto_service_items,
+            to_jsonb(ARRAY[' || (SELECT string_agg(k || '::TEXT', ', ' ORDER BY n) FROM unnest(sort_keys) WITH ORDINALITY AS u(k, n)) || ']) AS sort_values,
+            total_count
+        FROM (' || sql_query || ') AS queue ';
 
-    IF sort_column <> 'moves.locator' OR sort <> 'customerName' OR sort <> 'requestedMoveDate' THEN
-      sql_query := sql_query || ', moves.locator ASC';
+    IF cardinality(after_sort_values) > 0 THEN
+        sql_query := sql_query || ' WHERE ' || queue_keyset_condition(sort_keys, sort_descending, after_sort_values);
     END IF;
+
+    sql_query := sql_query || ' ORDER BY ' || (
+        SELECT string_agg(k || CASE WHEN d THEN ' DESC' ELSE ' ASC' END, ', ' ORDER BY n)
+        FROM unnest(sort_keys, sort_descending) WITH ORDINALITY AS u(k, d, n)
+    );
     sql_query := sql_query || ' LIMIT $14 OFFSET $15 ';
 
     RETURN QUERY EXECUTE sql_query
     USING user_gbloc, customer_name, edipi, emplid, m_status, move_code, requested_move_date, date_submitted,
-          branch, new_duty_location, counseling_office, too_assigned_user, has_safety_privilege, per_page, offset_value;
+          branch, new_duty_location, counseling_office, too_assigned_user, has_safety_privilege, per_page, offset_value, access_office_user_id;
 
 END;
 $$ LANGUAGE plpgsql;
@@ -1,9 +1,16 @@
 -- B-22543  Daniel Jordan  initial function creation
+-- limit the queue to the moves the office user may see when access_office_user_id is set
+-- continue after a pagination cursor's sort values when after_sort_values is set, and return each payment request's sort values
 DROP FUNCTION IF EXISTS get_payment_request_queue(
   TEXT, TEXT, TEXT, TEXT, TEXT, TEXT, TEXT,
   payment_request_status[], TIMESTAMP, TEXT, TEXT,
   BOOLEAN, INTEGER, INTEGER, TEXT, TEXT
 );
+DROP FUNCTION IF EXISTS get_payment_request_queue(
+  TEXT, TEXT, TEXT, TEXT, TEXT, TEXT, TEXT,
+  payment_request_status[], TIMESTAMP, TEXT, TEXT,
+  BOOLEAN, INTEGER, INTEGER, TEXT, TEXT, UUID
+);
 
 CREATE OR REPLACE FUNCTION get_payment_request_queue(
   user_gbloc                TEXT                     DEFAULT NULL,
@@ -21,7 +28,9 @@ CREATE OR REPLACE FUNCTION get_payment_request_queue(
   page                      INTEGER                  DEFAULT 1,
   per_page                  INTEGER                  DEFAULT 20,
   sort                      TEXT                     DEFAULT NULL,
-  sort_direction            TEXT                     DEFAULT NULL
+  sort_direction            TEXT                     DEFAULT NULL,
+  access_office_user_id     UUID                     DEFAULT NULL,
+  after_sort_values         TEXT[]                   DEFAULT NULL
 )
 RETURNS TABLE (
   payment_request   JSONB,
@@ -30,6 +39,7 @@ RETURNS TABLE (
   origin_to_office  JSONB,
   tio_user          JSONB,
   counseling_office JSONB,
+  sort_values       JSONB,
   total_count       BIGINT
 ) LANGUAGE plpgsql AS $$
 DECLARE
@@ -37,6 +47,9 @@ DECLARE
   offset_val  INT;
   sort_col    TEXT := 'pr.id';
   sort_ord    TEXT := 'ASC';
+  sort_expressions TEXT[];
+  sort_descending  BOOLEAN[];
+  sort_keys        TEXT[];
 BEGIN
   page     := COALESCE(page,     1);
   per_page := COALESCE(per_page, 20);
@@ -65,6 +78,32 @@ BEGIN
     sort_ord := 'DESC';
   END IF;
 
+  -- the expressions the queue is ordered by, which a cursor holds the values of
+  IF sort IS NULL THEN
+    sort_expressions := ARRAY['pr.created_at'];
+    sort_descending  := ARRAY[FALSE];
+  ELSIF sort IN ('lastName', 'customerName') THEN
+    sort_expressions := ARRAY['sm.last_name', 'sm.first_name'];
+    sort_descending  := ARRAY[sort_ord = 'DESC', sort_ord = 'DESC'];
+  ELSIF sort = 'age' THEN
+    -- the oldest payment requests are the ones with the greatest age
+    sort_expressions := ARRAY['pr.created_at'];
+    sort_descending  := ARRAY[sort_ord = 'ASC'];
+  ELSE
+    sort_expressions := ARRAY[sort_col];
+    sort_descending  := ARRAY[sort_ord = 'DESC'];
+  END IF;
+
+  IF sort IS NULL OR sort <> 'locator' THEN
+    sort_expressions := sort_expressions || 'm.locator'::TEXT;
+    sort_descending  := sort_descending || FALSE;
+  END IF;
+
+  -- a move can have several payment requests, so their ids keep any two from sorting the same
+  sort_expressions := sort_expressions || 'pr.id'::TEXT;
+  sort_descending  := sort_descending || FALSE;
+  sort_keys := ARRAY(SELECT 'queue.sort_key_' || n FROM generate_series(1, cardinality(sort_expressions)) AS n);
+
   sql_query := '
     SELECT
       jsonb_build_object(
@@ -141,7 +180,8 @@ BEGIN
         ''id'',   co.id,
         ''name'', co.name
       )::JSONB AS counseling_office,
-      COUNT(*) OVER() AS total_count
+      COUNT(*) OVER() AS total_count,
+      ' || (SELECT string_agg(format('%s AS sort_key_%s', e, n), ', ' ORDER BY n) FROM unnest(sort_expressions) WITH ORDINALITY AS u(e, n)) || '
     FROM payment_requests pr
     JOIN moves m     ON pr.move_id = m.id
     JOIN orders o    ON m.orders_id = o.id
@@ -220,46 +260,27 @@ BEGIN
     sql_query := sql_query || ' AND o.orders_type != ''SAFETY''';
   END IF;
 
-  IF sort IS NULL THEN
-    sql_query := sql_query || ' ORDER BY pr.created_at ASC';
-  ELSE
-    CASE sort
-      WHEN 'locator' THEN
-        sql_query := sql_query || format(' ORDER BY m.locator %s', sort_ord);
-      WHEN 'lastName', 'customerName' THEN
-        sql_query := sql_query
-          || format(' ORDER BY sm.last_name %1$s, sm.first_name %1$s', sort_ord);
-      WHEN 'edipi' THEN
-        sql_query := sql_query || format(' ORDER BY sm.edipi %s', sort_ord);
-      WHEN 'emplid' THEN
-        sql_query := sql_query || format(' ORDER BY sm.emplid %s', sort_ord);
-      WHEN 'status' THEN
-        sql_query := sql_query || format(' ORDER BY pr.status %s', sort_ord);
-      WHEN 'submittedAt' THEN
-        sql_query := sql_query || format(' ORDER BY pr.created_at %s', sort_ord);
-      WHEN 'branch' THEN
-        sql_query := sql_query || format(' ORDER BY sm.affiliation %s', sort_ord);
-      WHEN 'originDutyLocation' THEN
-        sql_query := sql_query || format(' ORDER BY origin_dl.name %s', sort_ord);
-      WHEN 'assignedTo' THEN
-        sql_query := sql_query || format(' ORDER BY tio.first_name %s', sort_ord);
-      WHEN 'counselingOffice' THEN
-        sql_query := sql_query || format(' ORDER BY co.name %s', sort_ord);
-      WHEN 'age' THEN
-        sql_query := sql_query
-          || format(
-            ' ORDER BY pr.created_at %s',
-            CASE WHEN lower(sort_ord) = 'asc' THEN 'DESC' ELSE 'ASC' END
-          );
-      ELSE
-        sql_query := sql_query || format(' ORDER BY %s %s', sort_col, sort_ord);
-    END CASE;
+  IF access_office_user_id IS NOT NULL THEN
+    sql_query := sql_query || ' AND office_user_can_see_move($12, m.id)';
   END IF;
 
-  IF sort IS NULL OR sort <> 'locator' THEN
-    sql_query := sql_query || ', m.locator ASC';
+  -- the payment requests are counted before the cursor so the total is the whole queue
+  sql_query := '
+    SELECT
+      payment_request, move, orders, origin_to_office, tio_user, counseling_office,
+      to_jsonb(ARRAY[' || (SELECT string_agg(k || '::TEXT', ', ' ORDER BY n) FROM unnest(sort_keys) WITH ORDINALITY AS u(k, n)) || ']) AS sort_values,
+      total_count
+    FROM (' || sql_query || ') AS queue ';
+
+  IF cardinality(after_sort_values) > 0 THEN
+    sql_query := sql_query || ' WHERE ' || queue_keyset_condition(sort_keys, sort_descending, after_sort_values);
   END IF;
 
+  sql_query := sql_query || ' ORDER BY ' || (
+    SELECT string_agg(k || CASE WHEN d THEN ' DESC' ELSE ' ASC' END, ', ' ORDER BY n)
+    FROM unnest(sort_keys, sort_descending) WITH ORDINALITY AS u(k, d, n)
+  );
+
   sql_query := sql_query || format(' LIMIT %s OFFSET %s', per_page, offset_val);
 
   RETURN QUERY EXECUTE sql_query
@@ -274,6 +295,7 @@ BEGIN
     status,                    -- $8
     submitted_at,              -- $9
     tio_assigned_user,         -- $10
-    p_counseling_office;       -- $11
+    p_counseling_office,       -- $11
+    access_office_user_id;     -- $12
 END;
 $$;
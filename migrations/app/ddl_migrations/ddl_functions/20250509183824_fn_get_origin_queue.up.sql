@@ -3,6 +3,8 @@
 -- B-23739 - Daniel Jordan - updating returns to consider lock_expires_at
 -- B-23767  Daniel Jordan - updating query to exclude FULL PPM types that provide SC and null PPM types
 -- B-22712 -- Paul Stonebraker - add move data for excess weight, amended orders; attach diversions and SIT extensions to mto shipments
+-- limit the queue to the moves the office user may see when access_office_user_id is set
+-- continue after a pagination cursor's sort values when after_sort_values is set, and return each move's sort values
 
 DROP FUNCTION IF EXISTS get_origin_queue;
 CREATE OR REPLACE FUNCTION get_origin_queue(
@@ -22,7 +24,9 @@ CREATE OR REPLACE FUNCTION get_origin_queue(
     page INTEGER DEFAULT 1,
     per_page INTEGER DEFAULT 20,
     sort TEXT DEFAULT NULL,
-    sort_direction TEXT DEFAULT NULL
+    sort_direction TEXT DEFAULT NULL,
+    access_office_user_id UUID DEFAULT NULL,
+    after_sort_values TEXT[] DEFAULT NULL
 )
 RETURNS TABLE (
     id UUID,
@@ -44,6 +48,7 @@ RETURNS TABLE (
     excess_weight_acknowledged_at TIMESTAMP WITH TIME ZONE,
     excess_unaccompanied_baggage_weight_qualified_at TIMESTAMP WITH TIME ZONE,
     excess_unaccompanied_baggage_weight_acknowledged_at TIMESTAMP WITH TIME ZONE,
+    sort_values JSONB,
     total_count BIGINT
 ) AS $$
 DECLARE
@@ -51,6 +56,8 @@ DECLARE
     offset_value INTEGER;
     sort_column TEXT;
     sort_order TEXT;
+    sort_expressions TEXT[];
+    sort_descending BOOLEAN[];
     total_count BIGINT;
 BEGIN
     IF page < 1 THEN
@@ -104,6 +111,15 @@ BEGIN
         sort_order := 'ASC';
     END IF;
 
+    -- the expressions ORDER BY sorts on, which a cursor holds the values of
+    IF sort = 'customerName' THEN
+        sort_expressions := ARRAY['base.sm_last_name', 'base.sm_first_name', 'base.locator'];
+        sort_descending := ARRAY[sort_order = 'DESC', sort_order = 'DESC', FALSE];
+    ELSE
+        sort_expressions := ARRAY[sort_column, 'base.locator'];
+        sort_descending := ARRAY[sort_order = 'DESC', FALSE];
+    END IF;
+
     sql_query := '
     WITH base AS (
         SELECT
@@ -323,6 +339,10 @@ BEGIN
         sql_query := sql_query || ' AND orders_type != ''SAFETY'' ';
     END IF;
 
+    IF access_office_user_id IS NOT NULL THEN
+        sql_query := sql_query || ' AND office_user_can_see_move($16, moves.id) ';
+    END IF;
+
     -- we want to omit shipments with ONLY destination queue-specific filters
     -- (pending dest address requests, pending dest SIT extension requests when there are dest SIT service items, submitted dest SIT & dest shuttle service items)
     sql_query := sql_query || '
@@ -434,8 +454,14 @@ BEGIN
         excess_weight_acknowledged_at::TIMESTAMP WITH TIME ZONE,
         excess_unaccompanied_baggage_weight_qualified_at::TIMESTAMP WITH TIME ZONE,
         excess_unaccompanied_baggage_weight_acknowledged_at::TIMESTAMP WITH TIME ZONE,
-        COUNT(*) OVER() AS total_count
-        FROM base ';
+        to_jsonb(ARRAY[' || (SELECT string_agg('(' || e || ')::TEXT', ', ' ORDER BY n) FROM unnest(sort_expressions) WITH ORDINALITY AS u(e, n)) || ']) AS sort_values,
+        full_count AS total_count
+        FROM (SELECT base.*, COUNT(*) OVER() AS full_count FROM base) AS base ';
+
+    -- counted before the cursor so the total is the whole queue
+    IF cardinality(after_sort_values) > 0 THEN
+        sql_query := sql_query || ' WHERE ' || queue_keyset_condition(sort_expressions, sort_descending, after_sort_values);
+    END IF;
 
     IF sort = 'customerName' THEN
         sql_query := sql_query || format(
@@ -450,6 +476,6 @@ BEGIN
 
     RETURN QUERY EXECUTE sql_query
     USING user_gbloc, customer_name, edipi, emplid, m_status, move_code, requested_move_date, date_submitted,
-          branch, origin_duty_location, counseling_office, too_assigned_user, has_safety_privilege, per_page, offset_value;
+          branch, origin_duty_location, counseling_office, too_assigned_user, has_safety_privilege, per_page, offset_value, access_office_user_id;
 END;
 $$ LANGUAGE plpgsql;
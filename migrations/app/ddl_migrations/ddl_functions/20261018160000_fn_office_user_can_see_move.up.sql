@@ -0,0 +1,43 @@
+-- office_user_can_see_move decides whether an office user outside the national roles may see a move.
+-- They see the moves in the GBLOCs of their transportation offices (the origin duty location, the
+-- destination duty location, the shipment pickup and destination addresses and the closeout office),
+-- moves counseled or closed out by those offices, moves assigned to them and, for the USMC, NAVY,
+-- TVCB and USCG offices, the moves of the affiliation the office owns.
+
+CREATE OR REPLACE FUNCTION office_user_can_see_move(
+    p_office_user_id UUID,
+    p_move_id UUID
+)
+RETURNS BOOLEAN AS $$
+    SELECT
+        p_office_user_id IN (
+            moves.sc_assigned_id, moves.sc_counseling_assigned_id, moves.sc_closeout_assigned_id, moves.too_assigned_id,
+            moves.too_task_order_assigned_id, moves.too_destination_assigned_id, moves.tio_assigned_id
+        ) IS TRUE
+        OR EXISTS (
+            SELECT 1
+            FROM transportation_offices access_offices
+            WHERE access_offices.id IN (
+                SELECT transportation_office_id FROM office_users WHERE id = p_office_user_id
+                UNION
+                SELECT transportation_office_id FROM transportation_office_assignments WHERE id = p_office_user_id
+            )
+            AND (
+                access_offices.id IN (moves.counseling_transportation_office_id, moves.closeout_office_id)
+                OR access_offices.gbloc IN (
+                    SELECT orders.gbloc::TEXT FROM orders WHERE orders.id = moves.orders_id
+                    UNION SELECT orders.destination_gbloc::TEXT FROM orders WHERE orders.id = moves.orders_id
+                    UNION SELECT closeout_offices.gbloc::TEXT FROM transportation_offices closeout_offices WHERE closeout_offices.id = moves.closeout_office_id
+                    UNION SELECT move_to_gbloc.gbloc::TEXT FROM move_to_gbloc WHERE move_to_gbloc.move_id = moves.id
+                    UNION SELECT move_to_dest_gbloc.gbloc::TEXT FROM move_to_dest_gbloc WHERE move_to_dest_gbloc.move_id = moves.id
+                )
+                OR (access_offices.gbloc, service_members.affiliation::TEXT) IN (
+                    VALUES ('USMC', 'MARINES'), ('TVCB', 'MARINES'), ('NAVY', 'NAVY'), ('USCG', 'COAST_GUARD')
+                )
+            )
+        )
+    FROM moves
+    JOIN orders ON orders.id = moves.orders_id
+    JOIN service_members ON service_members.id = orders.service_member_id
+    WHERE moves.id = p_move_id;
+$$ LANGUAGE sql STABLE;
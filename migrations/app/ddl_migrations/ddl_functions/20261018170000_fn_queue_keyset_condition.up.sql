@@ -0,0 +1,50 @@
+-- queue_keyset_condition returns the condition a queue function adds to keep only the rows that sort after
+-- a pagination cursor. sort_expressions are the trusted SQL expressions the queue orders by, ending with a
+-- unique one, and after_values are their values on the cursor's row as text. NULLs sort the way Postgres
+-- sorts them by default: last when ascending and first when descending. The values are quoted as untyped
+-- literals so each one is read as the type of the expression it is compared with.
+
+CREATE OR REPLACE FUNCTION queue_keyset_condition(
+    sort_expressions TEXT[],
+    sort_descending BOOLEAN[],
+    after_values TEXT[]
+)
+RETURNS TEXT AS $$
+DECLARE
+    conditions TEXT[] := '{}';
+    terms TEXT[];
+BEGIN
+    IF cardinality(after_values) <> cardinality(sort_expressions) THEN
+        RAISE EXCEPTION 'pagination cursor has % values for % sort expressions', cardinality(after_values), cardinality(sort_expressions)
+            USING ERRCODE = 'invalid_parameter_value';
+    END IF;
+
+    FOR i IN 1..cardinality(sort_expressions) LOOP
+        terms := '{}';
+        FOR j IN 1..i - 1 LOOP
+            IF after_values[j] IS NULL THEN
+                terms := terms || format('(%s) IS NULL', sort_expressions[j]);
+            ELSE
+                terms := terms || format('(%s) = %L', sort_expressions[j], after_values[j]);
+            END IF;
+        END LOOP;
+
+        IF after_values[i] IS NULL THEN
+            -- nothing sorts after NULL when ascending
+            CONTINUE WHEN NOT sort_descending[i];
+            terms := terms || format('(%s) IS NOT NULL', sort_expressions[i]);
+        ELSIF sort_descending[i] THEN
+            terms := terms || format('(%s) < %L', sort_expressions[i], after_values[i]);
+        ELSE
+            terms := terms || format('((%s) > %L OR (%s) IS NULL)', sort_expressions[i], after_values[i], sort_expressions[i]);
+        END IF;
+
+        conditions := conditions || ('(' || array_to_string(terms, ' AND ') || ')');
+    END LOOP;
+
+    IF cardinality(conditions) = 0 THEN
+        RETURN 'FALSE';
+    END IF;
+    RETURN '(' || array_to_string(conditions, ' OR ') || ')';
+END;
+$$ LANGUAGE plpgsql IMMUTABLE;
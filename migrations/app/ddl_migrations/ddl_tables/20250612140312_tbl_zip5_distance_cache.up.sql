@@ -0,0 +1,17 @@
+-- Cache of DTOD zip5 to zip5 mileage so repeated pricing of the same zip pair doesn't call DTOD every time
+
+CREATE TABLE IF NOT EXISTS public.zip5_distance_cache (
+    id              uuid        NOT NULL PRIMARY KEY,
+    from_zip5       varchar(5)  NOT NULL,
+    to_zip5         varchar(5)  NOT NULL,
+    distance_miles  integer     NOT NULL,
+    created_at      timestamp   NOT NULL DEFAULT NOW(),
+    updated_at      timestamp   NOT NULL DEFAULT NOW(),
+    CONSTRAINT unique_zip5_distance_cache_key UNIQUE (from_zip5, to_zip5)
+);
+
+COMMENT ON TABLE zip5_distance_cache IS 'Stores the last known DTOD mileage between two zip5s';
+COMMENT ON COLUMN zip5_distance_cache.from_zip5 IS 'Origin zip5 sent to DTOD';
+COMMENT ON COLUMN zip5_distance_cache.to_zip5 IS 'Destination zip5 sent to DTOD';
+COMMENT ON COLUMN zip5_distance_cache.distance_miles IS 'Mileage returned by DTOD';
+COMMENT ON COLUMN zip5_distance_cache.updated_at IS 'When DTOD last returned this mileage, used to expire cached values';
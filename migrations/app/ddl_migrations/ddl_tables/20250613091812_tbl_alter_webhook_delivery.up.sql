@@ -0,0 +1,15 @@
+-- Track delivery attempts and per-subscription backoff for the webhook client
+
+ALTER TABLE webhook_notifications
+    ADD COLUMN IF NOT EXISTS attempt_count integer NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS last_error text;
+
+COMMENT ON COLUMN webhook_notifications.attempt_count IS 'Number of delivery attempts that have failed for this notification';
+COMMENT ON COLUMN webhook_notifications.last_error IS 'Error from the most recent failed delivery attempt';
+
+ALTER TABLE webhook_subscriptions
+    ADD COLUMN IF NOT EXISTS consecutive_failures integer NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS next_attempt_at timestamp without time zone;
+
+COMMENT ON COLUMN webhook_subscriptions.consecutive_failures IS 'Number of delivery attempts to this subscription that have failed since the last success';
+COMMENT ON COLUMN webhook_subscriptions.next_attempt_at IS 'Delivery to this subscription is paused until this time while it backs off';
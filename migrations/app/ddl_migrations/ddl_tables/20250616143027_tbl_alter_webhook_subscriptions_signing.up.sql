@@ -0,0 +1,11 @@
+-- Per subscription secrets used to sign webhook deliveries. The previous secret keeps signing deliveries
+-- until it expires so subscribers have time to switch to a rotated secret.
+
+ALTER TABLE webhook_subscriptions
+    ADD COLUMN IF NOT EXISTS signing_secret text,
+    ADD COLUMN IF NOT EXISTS previous_signing_secret text,
+    ADD COLUMN IF NOT EXISTS previous_signing_secret_expires_at timestamp without time zone;
+
+COMMENT ON COLUMN webhook_subscriptions.signing_secret IS 'Secret used to sign the HMAC-SHA256 signature sent with each delivery';
+COMMENT ON COLUMN webhook_subscriptions.previous_signing_secret IS 'Secret that was replaced by the last rotation, still used to sign deliveries until it expires';
+COMMENT ON COLUMN webhook_subscriptions.previous_signing_secret_expires_at IS 'When deliveries stop being signed with the previous secret';
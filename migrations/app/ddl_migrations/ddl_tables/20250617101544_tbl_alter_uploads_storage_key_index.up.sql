@@ -0,0 +1,5 @@
+-- Content-addressed uploads share a storage key, so count references to a key quickly
+
+CREATE INDEX IF NOT EXISTS uploads_storage_key_idx ON uploads (storage_key) WHERE deleted_at IS NULL;
+
+COMMENT ON COLUMN uploads.storage_key IS 'The resulting path to where the upload is on S3. Keys under content/sha256/ are derived from the checksum and may be shared by several uploads';
@@ -0,0 +1,10 @@
+-- Record virus scan results on uploads that are scanned by the application instead of by S3 tagging
+
+ALTER TABLE uploads
+    ADD COLUMN IF NOT EXISTS scan_status upload_scan_status,
+    ADD COLUMN IF NOT EXISTS scan_signature text,
+    ADD COLUMN IF NOT EXISTS scanned_at timestamp without time zone;
+
+COMMENT ON COLUMN uploads.scan_status IS 'Status of the virus scan run by the application. NULL when the anti-virus status comes from the tags on the stored object';
+COMMENT ON COLUMN uploads.scan_signature IS 'Name of the signature that matched when the scan found the file infected';
+COMMENT ON COLUMN uploads.scanned_at IS 'When the virus scan finished';
@@ -0,0 +1,20 @@
+-- Audit trail of office users acquiring, releasing and taking over the lock on a move
+
+CREATE TABLE IF NOT EXISTS public.move_lock_events (
+    id                      uuid                  NOT NULL PRIMARY KEY,
+    move_id                 uuid                  NOT NULL REFERENCES moves (id),
+    event_type              move_lock_event_type  NOT NULL,
+    office_user_id          uuid                  NULL REFERENCES office_users (id),
+    previous_office_user_id uuid                  NULL REFERENCES office_users (id),
+    lock_expires_at         timestamptz           NULL,
+    created_at              timestamp             NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS move_lock_events_move_id_created_at_idx ON move_lock_events (move_id, created_at);
+CREATE INDEX IF NOT EXISTS moves_lock_expires_at_idx ON moves (lock_expires_at) WHERE locked_by IS NOT NULL;
+
+COMMENT ON TABLE move_lock_events IS 'Records every time the lock on a move is acquired, released, taken over or expires';
+COMMENT ON COLUMN move_lock_events.event_type IS 'LOCKED, RELEASED, STOLEN when a supervisor takes over the lock, or EXPIRED';
+COMMENT ON COLUMN move_lock_events.office_user_id IS 'Office user that locked, released or took over the move. Null for expired locks';
+COMMENT ON COLUMN move_lock_events.previous_office_user_id IS 'Office user that held the lock before it was taken over or expired';
+COMMENT ON COLUMN move_lock_events.lock_expires_at IS 'Expiration of the lock after the event, or of the lock that expired';
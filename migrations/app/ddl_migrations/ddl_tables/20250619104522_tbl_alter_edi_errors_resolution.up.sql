@@ -0,0 +1,11 @@
+-- Track which EDI errors have been dealt with so a payment request that is resubmitted and rejected
+-- again only shows its new errors
+
+ALTER TABLE edi_errors
+    ADD COLUMN IF NOT EXISTS resolved_at timestamp without time zone,
+    ADD COLUMN IF NOT EXISTS resolved_by_office_user_id uuid REFERENCES office_users (id);
+
+CREATE INDEX IF NOT EXISTS edi_errors_unresolved_payment_request_id_idx ON edi_errors (payment_request_id) WHERE resolved_at IS NULL;
+
+COMMENT ON COLUMN edi_errors.resolved_at IS 'When the payment request was resubmitted to Syncada after this error';
+COMMENT ON COLUMN edi_errors.resolved_by_office_user_id IS 'The office user who resubmitted the payment request';
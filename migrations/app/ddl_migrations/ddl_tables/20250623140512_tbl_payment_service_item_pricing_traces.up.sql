@@ -0,0 +1,13 @@
+-- How the rate engine derived the price of each payment service item
+
+CREATE TABLE IF NOT EXISTS public.payment_service_item_pricing_traces (
+    id                      uuid        NOT NULL PRIMARY KEY,
+    payment_service_item_id uuid        NOT NULL REFERENCES payment_service_items (id),
+    trace                   jsonb       NOT NULL,
+    created_at              timestamp   NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS payment_service_item_pricing_traces_payment_service_item_id_idx ON payment_service_item_pricing_traces (payment_service_item_id, created_at);
+
+COMMENT ON TABLE payment_service_item_pricing_traces IS 'Calculation traces recorded by the rate engine each time it prices a payment service item';
+COMMENT ON COLUMN payment_service_item_pricing_traces.trace IS 'Params the price was calculated from, the re_* price and contract year rows used, the calculation steps and the resulting pricer params';
@@ -0,0 +1,14 @@
+-- Let several pricing contracts be loaded at once. The contract that prices a move is the one whose
+-- contract years cover the date and whose effective window includes it; contracts without a window
+-- keep being picked by their contract years alone.
+
+ALTER TABLE re_contracts
+    ADD COLUMN IF NOT EXISTS effective_date date,
+    ADD COLUMN IF NOT EXISTS expiration_date date;
+
+ALTER TABLE re_contracts
+    DROP CONSTRAINT IF EXISTS re_contracts_effective_window_check,
+    ADD CONSTRAINT re_contracts_effective_window_check CHECK (expiration_date IS NULL OR effective_date IS NULL OR expiration_date >= effective_date);
+
+COMMENT ON COLUMN re_contracts.effective_date IS 'First day the contract is used to price moves; when several contracts cover a date the one that took effect most recently is used';
+COMMENT ON COLUMN re_contracts.expiration_date IS 'Last day the contract is used to price moves';
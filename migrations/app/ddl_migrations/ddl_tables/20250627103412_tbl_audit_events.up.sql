@@ -0,0 +1,47 @@
+-- Persisted audit records of the changes admin and office users make, replacing log-only audit capture
+
+CREATE TABLE IF NOT EXISTS public.audit_events (
+    id                     uuid        NOT NULL PRIMARY KEY,
+    event_type             text        NOT NULL,
+    record_type            text        NULL,
+    record_id              uuid        NULL,
+    responsible_user_id    uuid        NULL,
+    responsible_user_email text        NULL,
+    responsible_user_name  text        NULL,
+    fields_changed         text        NULL,
+    payload                jsonb       NULL,
+    active_value           boolean     NULL,
+    before_data            jsonb       NULL,
+    after_data             jsonb       NULL,
+    transaction_id         bigint      NOT NULL,
+    created_at             timestamp   NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS audit_events_created_at_idx ON audit_events (created_at);
+CREATE INDEX IF NOT EXISTS audit_events_responsible_user_id_created_at_idx ON audit_events (responsible_user_id, created_at);
+CREATE INDEX IF NOT EXISTS audit_events_record_type_record_id_created_at_idx ON audit_events (record_type, record_id, created_at);
+CREATE INDEX IF NOT EXISTS audit_events_event_type_created_at_idx ON audit_events (event_type, created_at);
+CREATE INDEX IF NOT EXISTS audit_events_transaction_id_idx ON audit_events (transaction_id);
+
+-- audit events are correlated with the audit_history rows of the transaction that made the change
+CREATE INDEX CONCURRENTLY IF NOT EXISTS audit_history_transaction_id_idx ON audit_history (transaction_id);
+
+-- so the before and after of admin changes to users and their roles and privileges are recorded
+SELECT add_audit_history_table(target_table := 'users', audit_rows := BOOLEAN 't', audit_query_text := BOOLEAN 't', ignored_cols := ARRAY['created_at', 'updated_at', 'current_mil_session_id', 'current_admin_session_id', 'current_office_session_id']);
+SELECT add_audit_history_table(target_table := 'office_users', audit_rows := BOOLEAN 't', audit_query_text := BOOLEAN 't', ignored_cols := ARRAY['created_at', 'updated_at']);
+SELECT add_audit_history_table(target_table := 'admin_users', audit_rows := BOOLEAN 't', audit_query_text := BOOLEAN 't', ignored_cols := ARRAY['created_at', 'updated_at']);
+SELECT add_audit_history_table(target_table := 'users_roles', audit_rows := BOOLEAN 't', audit_query_text := BOOLEAN 't', ignored_cols := ARRAY['created_at', 'updated_at']);
+SELECT add_audit_history_table(target_table := 'users_privileges', audit_rows := BOOLEAN 't', audit_query_text := BOOLEAN 't', ignored_cols := ARRAY['created_at', 'updated_at']);
+SELECT add_audit_history_table(target_table := 'client_certs', audit_rows := BOOLEAN 't', audit_query_text := BOOLEAN 't', ignored_cols := ARRAY['created_at', 'updated_at']);
+
+COMMENT ON TABLE audit_events IS 'Audit records of the changes admin and office users make, written by audit.Capture with the before and after from the audit_history rows of the transaction that made the change';
+COMMENT ON COLUMN audit_events.event_type IS 'Event derived from the request method and path, e.g. audit_patch_office_users';
+COMMENT ON COLUMN audit_events.record_type IS 'Model the change was made to, e.g. OfficeUser';
+COMMENT ON COLUMN audit_events.record_id IS 'Id of the record the change was made to';
+COMMENT ON COLUMN audit_events.responsible_user_id IS 'User that made the change';
+COMMENT ON COLUMN audit_events.fields_changed IS 'Comma separated fields set in the request payload';
+COMMENT ON COLUMN audit_events.payload IS 'Request payload of the change';
+COMMENT ON COLUMN audit_events.active_value IS 'Whether the account was enabled or disabled, for account status events';
+COMMENT ON COLUMN audit_events.before_data IS 'Values before the change of the columns the transaction changed, by table name then object id, from audit_history';
+COMMENT ON COLUMN audit_events.after_data IS 'Values after the change of the columns the transaction changed, by table name then object id, from audit_history. Deleted rows are null';
+COMMENT ON COLUMN audit_events.transaction_id IS 'Id of the transaction that made the change, matching audit_history.transaction_id';
@@ -0,0 +1,32 @@
+-- Office permissions and the roles they are granted to, replacing the RolePermissions lists in the authentication package
+
+CREATE TABLE IF NOT EXISTS public.permissions (
+    id             uuid      NOT NULL PRIMARY KEY,
+    permission_key text      NOT NULL,
+    description    text      NULL,
+    created_at     timestamp NOT NULL DEFAULT NOW(),
+    updated_at     timestamp NOT NULL DEFAULT NOW(),
+    CONSTRAINT permissions_permission_key_key UNIQUE (permission_key)
+);
+
+CREATE TABLE IF NOT EXISTS public.roles_permissions (
+    id            uuid      NOT NULL PRIMARY KEY,
+    role_id       uuid      NOT NULL REFERENCES roles (id),
+    permission_id uuid      NOT NULL REFERENCES permissions (id),
+    created_at    timestamp NOT NULL DEFAULT NOW(),
+    updated_at    timestamp NOT NULL DEFAULT NOW(),
+    CONSTRAINT roles_permissions_role_id_permission_id_key UNIQUE (role_id, permission_id)
+);
+
+CREATE INDEX IF NOT EXISTS roles_permissions_permission_id_idx ON roles_permissions (permission_id);
+
+-- so changes made to the mappings through the admin app can be traced
+SELECT add_audit_history_table(target_table := 'permissions', audit_rows := BOOLEAN 't', audit_query_text := BOOLEAN 't', ignored_cols := ARRAY['created_at', 'updated_at']);
+SELECT add_audit_history_table(target_table := 'roles_permissions', audit_rows := BOOLEAN 't', audit_query_text := BOOLEAN 't', ignored_cols := ARRAY['created_at', 'updated_at']);
+
+COMMENT ON TABLE permissions IS 'Permissions checked against the x-permissions of office API operations';
+COMMENT ON COLUMN permissions.permission_key IS 'Key used in x-permissions and the session, e.g. update.move';
+COMMENT ON COLUMN permissions.description IS 'What the permission allows, shown in the admin app';
+COMMENT ON TABLE roles_permissions IS 'Permissions granted to each role. Sessions pick these up at sign in and when the active role is switched';
+COMMENT ON COLUMN roles_permissions.role_id IS 'Role the permission is granted to';
+COMMENT ON COLUMN roles_permissions.permission_id IS 'Permission granted';
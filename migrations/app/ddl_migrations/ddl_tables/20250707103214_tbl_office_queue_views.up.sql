@@ -0,0 +1,30 @@
+-- Named queue views office users save for the office queues and can share within their transportation office
+
+CREATE TABLE IF NOT EXISTS public.office_queue_views (
+    id                       uuid         NOT NULL PRIMARY KEY,
+    office_user_id           uuid         NOT NULL REFERENCES office_users (id),
+    transportation_office_id uuid         NULL REFERENCES transportation_offices (id),
+    queue_type               varchar(50)  NOT NULL,
+    name                     varchar(255) NOT NULL,
+    filters                  jsonb        NOT NULL DEFAULT '{}'::jsonb,
+    sort                     varchar(255) NULL,
+    sort_order               varchar(4)   NULL,
+    columns                  text[]       NOT NULL DEFAULT '{}',
+    created_at               timestamp    NOT NULL DEFAULT NOW(),
+    updated_at               timestamp    NOT NULL DEFAULT NOW(),
+    CONSTRAINT office_queue_views_office_user_id_queue_type_name_key UNIQUE (office_user_id, queue_type, name),
+    CONSTRAINT office_queue_views_queue_type_check CHECK (queue_type IN ('COUNSELING', 'CLOSEOUT', 'TASK_ORDER', 'PAYMENT_REQUEST', 'DESTINATION_REQUESTS')),
+    CONSTRAINT office_queue_views_sort_order_check CHECK (sort_order IN ('asc', 'desc'))
+);
+
+CREATE INDEX IF NOT EXISTS office_queue_views_transportation_office_id_queue_type_idx ON office_queue_views (transportation_office_id, queue_type);
+
+COMMENT ON TABLE office_queue_views IS 'Named filters, sort and columns saved for an office queue';
+COMMENT ON COLUMN office_queue_views.office_user_id IS 'Office user who saved the view and may change it';
+COMMENT ON COLUMN office_queue_views.transportation_office_id IS 'Transportation office the view is shared with, NULL when only the owner sees it';
+COMMENT ON COLUMN office_queue_views.queue_type IS 'Queue the view applies to';
+COMMENT ON COLUMN office_queue_views.name IS 'Name shown in the queue view picker, unique per office user and queue';
+COMMENT ON COLUMN office_queue_views.filters IS 'Queue filter values keyed by the queue query parameter names';
+COMMENT ON COLUMN office_queue_views.sort IS 'Queue column the view sorts by';
+COMMENT ON COLUMN office_queue_views.sort_order IS 'Sort direction, asc or desc';
+COMMENT ON COLUMN office_queue_views.columns IS 'Queue columns shown and exported, in order. Empty means all columns';
@@ -0,0 +1,4 @@
+-- Notifications that have used up their retries are parked so they can be replayed once the subscriber is fixed
+
+ALTER TYPE webhook_notifications_status
+ADD VALUE IF NOT EXISTS 'DEAD_LETTER';
@@ -0,0 +1,13 @@
+-- Status of the in-process virus scan of an upload
+DO $$
+BEGIN
+    IF NOT EXISTS (SELECT 1 FROM pg_type WHERE typname = 'upload_scan_status') THEN
+        CREATE TYPE upload_scan_status AS ENUM (
+            'PENDING',
+            'SCANNING',
+            'CLEAN',
+            'INFECTED',
+            'FAILED'
+        );
+    END IF;
+END $$;
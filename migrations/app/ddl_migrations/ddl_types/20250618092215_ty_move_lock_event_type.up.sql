@@ -0,0 +1,12 @@
+-- Kinds of changes recorded in the move lock audit trail
+DO $$
+BEGIN
+    IF NOT EXISTS (SELECT 1 FROM pg_type WHERE typname = 'move_lock_event_type') THEN
+        CREATE TYPE move_lock_event_type AS ENUM (
+            'LOCKED',
+            'RELEASED',
+            'STOLEN',
+            'EXPIRED'
+        );
+    END IF;
+END $$;
@@ -0,0 +1,5 @@
+-- Give existing webhook subscriptions a signing secret. gen_random_uuid is backed by a cryptographically
+-- secure random source, so hashing two of them gives well over 128 random bits.
+UPDATE webhook_subscriptions
+SET signing_secret = encode(sha256((gen_random_uuid()::text || gen_random_uuid()::text)::bytea), 'hex')
+WHERE signing_secret IS NULL;
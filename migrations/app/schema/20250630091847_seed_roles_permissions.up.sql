@@ -0,0 +1,131 @@
+-- Seed the permissions and role mappings that were hardcoded in pkg/handlers/authentication/permissions.go
+INSERT INTO permissions (id, permission_key, description, created_at, updated_at)
+SELECT gen_random_uuid(), p.permission_key, p.description, NOW(), NOW()
+FROM (VALUES
+    ('create.serviceItem', 'Create service items on a move'),
+    ('create.shipmentDiversionRequest', 'Request a shipment diversion'),
+    ('create.reweighRequest', 'Request a shipment reweigh'),
+    ('create.shipmentCancellation', 'Request a shipment cancellation'),
+    ('create.shipmentTermination', 'Terminate a shipment for cause or convenience'),
+    ('create.SITExtension', 'Create SIT extensions'),
+    ('create.supportingDocuments', 'Upload supporting documents to a move'),
+    ('create.TXOShipment', 'Create shipments from the TOO move pages'),
+    ('create.reportViolation', 'Add PWS violations to evaluation reports'),
+    ('create.evaluationReport', 'Create QAE evaluation reports'),
+    ('read.paymentRequest', 'View payment requests'),
+    ('read.shipmentsPaymentSITBalance', 'View the SIT balance of shipments on a payment request'),
+    ('read.paymentServiceItemStatus', 'View payment service item statuses'),
+    ('read.pricingSimulation', 'Estimate shipment costs with the pricing simulator'),
+    ('read.ediErrorTriage', 'View the EDI error triage queue'),
+    ('read.tppsReconciliation', 'View the TPPS payment reconciliation report'),
+    ('read.paymentServiceItemPricingTrace', 'View the pricing traces of payment service items'),
+    ('view.closeoutOffice', 'View the PPM closeout office of a move'),
+    ('update.move', 'Update moves'),
+    ('update.shipment', 'Update shipments'),
+    ('update.financialReviewFlag', 'Flag a move for financial review'),
+    ('update.orders', 'Update orders'),
+    ('update.allowances', 'Update allowances'),
+    ('update.billableWeight', 'Update billable weight'),
+    ('update.maxBillableWeight', 'Update the maximum billable weight'),
+    ('update.SITExtension', 'Approve or deny SIT extensions'),
+    ('update.MTOServiceItem', 'Update MTO service items'),
+    ('update.excessWeightRisk', 'Acknowledge excess weight risks'),
+    ('update.customer', 'Update customer details'),
+    ('update.closeoutOffice', 'Update the PPM closeout office of a move'),
+    ('update.MTOPage', 'Edit the MTO page'),
+    ('update.cancelMoveFlag', 'Cancel moves'),
+    ('update.paymentRequest', 'Review payment requests'),
+    ('update.paymentRequestResubmission', 'Resubmit payment requests rejected by EDI'),
+    ('update.paymentServiceItemStatus', 'Approve or deny payment service items'),
+    ('update.evaluationReport', 'Update and appeal evaluation reports'),
+    ('delete.evaluationReport', 'Delete evaluation reports')
+) AS p (permission_key, description)
+ON CONFLICT (permission_key) DO NOTHING;
+
+INSERT INTO roles_permissions (id, role_id, permission_id, created_at, updated_at)
+SELECT gen_random_uuid(), roles.id, permissions.id, NOW(), NOW()
+FROM (VALUES
+    ('task_ordering_officer', 'create.serviceItem'),
+    ('task_ordering_officer', 'create.shipmentDiversionRequest'),
+    ('task_ordering_officer', 'create.reweighRequest'),
+    ('task_ordering_officer', 'create.shipmentCancellation'),
+    ('task_ordering_officer', 'create.SITExtension'),
+    ('task_ordering_officer', 'create.supportingDocuments'),
+    ('task_ordering_officer', 'read.paymentRequest'),
+    ('task_ordering_officer', 'read.shipmentsPaymentSITBalance'),
+    ('task_ordering_officer', 'read.paymentServiceItemStatus'),
+    ('task_ordering_officer', 'update.move'),
+    ('task_ordering_officer', 'update.shipment'),
+    ('task_ordering_officer', 'update.financialReviewFlag'),
+    ('task_ordering_officer', 'update.orders'),
+    ('task_ordering_officer', 'update.allowances'),
+    ('task_ordering_officer', 'update.billableWeight'),
+    ('task_ordering_officer', 'update.SITExtension'),
+    ('task_ordering_officer', 'update.MTOServiceItem'),
+    ('task_ordering_officer', 'update.excessWeightRisk'),
+    ('task_ordering_officer', 'update.customer'),
+    ('task_ordering_officer', 'view.closeoutOffice'),
+    ('task_ordering_officer', 'update.closeoutOffice'),
+    ('task_ordering_officer', 'update.MTOPage'),
+    ('task_ordering_officer', 'create.TXOShipment'),
+    ('task_ordering_officer', 'update.cancelMoveFlag'),
+    ('headquarters', 'read.paymentRequest'),
+    ('headquarters', 'read.shipmentsPaymentSITBalance'),
+    ('headquarters', 'read.paymentServiceItemStatus'),
+    ('headquarters', 'view.closeoutOffice'),
+    ('task_invoicing_officer', 'create.serviceItem'),
+    ('task_invoicing_officer', 'create.supportingDocuments'),
+    ('task_invoicing_officer', 'read.paymentRequest'),
+    ('task_invoicing_officer', 'read.shipmentsPaymentSITBalance'),
+    ('task_invoicing_officer', 'read.pricingSimulation'),
+    ('task_invoicing_officer', 'read.ediErrorTriage'),
+    ('task_invoicing_officer', 'read.tppsReconciliation'),
+    ('task_invoicing_officer', 'read.paymentServiceItemPricingTrace'),
+    ('task_invoicing_officer', 'update.paymentRequestResubmission'),
+    ('task_invoicing_officer', 'update.financialReviewFlag'),
+    ('task_invoicing_officer', 'update.orders'),
+    ('task_invoicing_officer', 'update.billableWeight'),
+    ('task_invoicing_officer', 'update.maxBillableWeight'),
+    ('task_invoicing_officer', 'update.paymentRequest'),
+    ('task_invoicing_officer', 'update.paymentServiceItemStatus'),
+    ('task_invoicing_officer', 'update.MTOPage'),
+    ('task_invoicing_officer', 'update.customer'),
+    ('services_counselor', 'create.shipmentDiversionRequest'),
+    ('services_counselor', 'create.reweighRequest'),
+    ('services_counselor', 'create.supportingDocuments'),
+    ('services_counselor', 'update.financialReviewFlag'),
+    ('services_counselor', 'update.shipment'),
+    ('services_counselor', 'update.orders'),
+    ('services_counselor', 'update.allowances'),
+    ('services_counselor', 'update.billableWeight'),
+    ('services_counselor', 'update.MTOServiceItem'),
+    ('services_counselor', 'update.customer'),
+    ('services_counselor', 'update.closeoutOffice'),
+    ('services_counselor', 'view.closeoutOffice'),
+    ('services_counselor', 'update.cancelMoveFlag'),
+    ('services_counselor', 'read.pricingSimulation'),
+    ('qae', 'create.reportViolation'),
+    ('qae', 'create.evaluationReport'),
+    ('qae', 'read.paymentRequest'),
+    ('qae', 'update.evaluationReport'),
+    ('qae', 'delete.evaluationReport'),
+    ('qae', 'view.closeoutOffice'),
+    ('qae', 'read.shipmentsPaymentSITBalance'),
+    ('contracting_officer', 'create.shipmentTermination'),
+    ('contracting_officer', 'read.paymentRequest'),
+    ('contracting_officer', 'view.closeoutOffice'),
+    ('contracting_officer', 'read.shipmentsPaymentSITBalance'),
+    ('customer_service_representative', 'read.paymentRequest'),
+    ('customer_service_representative', 'view.closeoutOffice'),
+    ('customer_service_representative', 'read.shipmentsPaymentSITBalance'),
+    ('gsr', 'create.reportViolation'),
+    ('gsr', 'create.evaluationReport'),
+    ('gsr', 'read.paymentRequest'),
+    ('gsr', 'update.evaluationReport'),
+    ('gsr', 'delete.evaluationReport'),
+    ('gsr', 'view.closeoutOffice'),
+    ('gsr', 'read.shipmentsPaymentSITBalance')
+) AS rp (role_type, permission_key)
+JOIN roles ON roles.role_type = rp.role_type
+JOIN permissions ON permissions.permission_key = rp.permission_key
+ON CONFLICT (role_id, permission_id) DO NOTHING;
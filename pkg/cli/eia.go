@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"os"
 
 	"github.com/spf13/pflag"
 	"github.com/spf13/viper"
@@ -12,16 +13,27 @@ const (
 	EIAKeyFlag string = "eia-key"
 	// EIAURLFlag is the EIA URL Flag
 	EIAURLFlag string = "eia-url"
+	// EIAFixtureFileFlag is the EIA Fixture File Flag
+	EIAFixtureFileFlag string = "eia-fixture-file"
 )
 
 // InitEIAFlags initializes EIA command line flags
 func InitEIAFlags(flag *pflag.FlagSet) {
 	flag.String(EIAURLFlag, "https://api.eia.gov/v2/seriesid/PET.EMD_EPD2D_PTE_NUS_DPG.W", "URL for Energy Information Administration (EIA) Open Data API")
 	flag.String(EIAKeyFlag, "", "Key for Energy Information Administration (EIA) Open Data API")
+	flag.String(EIAFixtureFileFlag, "", "Saved EIA Open Data API response to read fuel prices from instead of calling the API")
 }
 
 // CheckEIA validates EIA command line flags
 func CheckEIA(v *viper.Viper) error {
+	// the API isn't called when prices are read from a fixture
+	if fixtureFile := v.GetString(EIAFixtureFileFlag); fixtureFile != "" {
+		if _, err := os.Stat(fixtureFile); err != nil {
+			return fmt.Errorf("invalid EIA fixture file %s: %w", fixtureFile, err)
+		}
+		return nil
+	}
+
 	eiaURL := v.GetString(EIAURLFlag)
 	if eiaURL != "https://api.eia.gov/v2/seriesid/PET.EMD_EPD2D_PTE_NUS_DPG.W" {
 		return fmt.Errorf("invalid EIA Open Data URL %s, expecting https://api.eia.gov/v2/seriesid/PET.EMD_EPD2D_PTE_NUS_DPG.W", eiaURL)
@@ -4,3 +4,11 @@ func (suite *cliTestSuite) TestConfigEIA() {
 	suite.Setup(InitEIAFlags, []string{})
 	suite.NoError(CheckEIA(suite.viper))
 }
+
+func (suite *cliTestSuite) TestConfigEIAFixtureFile() {
+	suite.Setup(InitEIAFlags, []string{"--eia-fixture-file", "../services/ghcdieselfuelprice/fixtures/eia_weekly_diesel_prices.json", "--eia-key", ""})
+	suite.NoError(CheckEIA(suite.viper))
+
+	suite.Setup(InitEIAFlags, []string{"--eia-fixture-file", "does_not_exist.json"})
+	suite.Error(CheckEIA(suite.viper))
+}
@@ -39,6 +39,19 @@ const (
 	GEXSFTP997PickupDirectory string = "gex-sftp-997-pickup-directory"
 	// GEXSFTP824PickupDirectory is the ENV var for the directory where GEX delivers responses
 	GEXSFTP824PickupDirectory string = "gex-sftp-824-pickup-directory"
+	// GEXSFTPTPPSPickupDirectory is the ENV var for the directory where TPPS paid invoice reports are delivered
+	GEXSFTPTPPSPickupDirectory string = "gex-sftp-tpps-pickup-directory"
+	// GEXSyncadaTransportFlag is the ENV var for how files are exchanged with Syncada
+	GEXSyncadaTransportFlag string = "gex-syncada-transport"
+	// GEXSyncadaDirectoryFlag is the ENV var for the local directory used by the directory transport
+	GEXSyncadaDirectoryFlag string = "gex-syncada-directory"
+)
+
+const (
+	// SyncadaTransportSFTP exchanges files with Syncada's SFTP server
+	SyncadaTransportSFTP string = "sftp"
+	// SyncadaTransportDirectory exchanges files through a local directory, such as one answered by the Syncada stand-in
+	SyncadaTransportDirectory string = "directory"
 )
 
 // InitGEXSFTPFlags initializes GEX SFTP command line flags
@@ -51,10 +64,36 @@ func InitGEXSFTPFlags(flag *pflag.FlagSet) {
 	flag.String(GEXSFTPHostKeyFlag, "", "GEX SFTP Host Key")
 	flag.String(GEXSFTP997PickupDirectory, "", "GEX 997 SFTP Pickup Directory")
 	flag.String(GEXSFTP824PickupDirectory, "", "GEX 834 SFTP Pickup Directory")
+	flag.String(GEXSFTPTPPSPickupDirectory, "", "GEX TPPS paid invoice report SFTP Pickup Directory")
+	flag.String(GEXSyncadaTransportFlag, SyncadaTransportSFTP, "How files are exchanged with Syncada: sftp or directory")
+	flag.String(GEXSyncadaDirectoryFlag, "", "Local directory standing in for the Syncada SFTP server when using the directory transport")
 }
 
 // CheckGEXSFTP validates GEX SFTP command line flags
 func CheckGEXSFTP(v *viper.Viper) error {
+	switch transport := v.GetString(GEXSyncadaTransportFlag); transport {
+	case SyncadaTransportSFTP:
+	case SyncadaTransportDirectory:
+		allowedEnvironments := []string{
+			EnvironmentDevelopment,
+			EnvironmentTest,
+			EnvironmentReview,
+			EnvironmentLoadtest,
+		}
+		if environment := v.GetString(EnvironmentFlag); !stringSliceContains(allowedEnvironments, environment) {
+			return fmt.Errorf("cannot use the %s Syncada transport with the '%s' environment, only in %v", transport, environment, allowedEnvironments)
+		}
+		if v.GetString(GEXSyncadaDirectoryFlag) == "" {
+			return fmt.Errorf("Invalid configuration missing GEX_SYNCADA_DIRECTORY for the directory transport")
+		}
+		if v.GetString(GEXSFTPUserIDFlag) == "" {
+			return fmt.Errorf("Invalid credentials SFTP missing GEX_SFTP_USER_ID")
+		}
+		// the SFTP credentials are not used
+		return nil
+	default:
+		return fmt.Errorf("Invalid GEX_SYNCADA_TRANSPORT %s, must be %s or %s", transport, SyncadaTransportSFTP, SyncadaTransportDirectory)
+	}
 
 	port := v.GetString(GEXSFTPPortFlag)
 	if port == "" {
@@ -5,6 +5,8 @@ import (
 	"crypto/rsa"
 	"crypto/x509"
 	"encoding/pem"
+
+	"github.com/spf13/pflag"
 )
 
 func (suite *cliTestSuite) TestConfigGEXSFTP() {
@@ -54,3 +56,35 @@ func (suite *cliTestSuite) TestConfigGEXSFTP() {
 		suite.Error(CheckGEXSFTP(suite.viper), "no key found")
 	})
 }
+
+func (suite *cliTestSuite) TestConfigGEXSyncadaDirectoryTransport() {
+	initGEXSFTPAndEnvironmentFlags := func(flag *pflag.FlagSet) {
+		InitGEXSFTPFlags(flag)
+		InitEnvironmentFlags(flag)
+	}
+	suite.Setup(initGEXSFTPAndEnvironmentFlags, []string{})
+
+	suite.T().Setenv("ENVIRONMENT", EnvironmentDevelopment)
+	suite.T().Setenv("GEX_SYNCADA_TRANSPORT", SyncadaTransportDirectory)
+	suite.T().Setenv("GEX_SFTP_USER_ID", "FAKE_USER_ID")
+
+	suite.Run("fail with an error when the directory is missing", func() {
+		suite.Error(CheckGEXSFTP(suite.viper))
+	})
+
+	suite.Run("pass without SFTP credentials when the directory is set", func() {
+		suite.T().Setenv("GEX_SYNCADA_DIRECTORY", suite.T().TempDir())
+		suite.NoError(CheckGEXSFTP(suite.viper))
+	})
+
+	suite.Run("fail with an error in production", func() {
+		suite.T().Setenv("GEX_SYNCADA_DIRECTORY", suite.T().TempDir())
+		suite.T().Setenv("ENVIRONMENT", EnvironmentPrd)
+		suite.Error(CheckGEXSFTP(suite.viper))
+	})
+
+	suite.Run("fail with an error for an unknown transport", func() {
+		suite.T().Setenv("GEX_SYNCADA_TRANSPORT", "carrier-pigeon")
+		suite.Error(CheckGEXSFTP(suite.viper))
+	})
+}
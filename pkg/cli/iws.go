@@ -1,6 +1,9 @@
 package cli
 
 import (
+	"net"
+
+	"github.com/pkg/errors"
 	"github.com/spf13/pflag"
 	"github.com/spf13/viper"
 )
@@ -10,15 +13,38 @@ const (
 	IWSRBSHostFlag string = "iws-rbs-host"
 	// IWSRBSEnabledFlag is the IWS RBS Enabled Flag
 	IWSRBSEnabledFlag string = "iws-rbs-enabled"
+	// IWSRBSStandInAddressFlag is the IWS RBS Stand-In Address Flag
+	IWSRBSStandInAddressFlag string = "iws-rbs-stand-in-address"
 )
 
 // InitIWSFlags initializes CSRF command line flags
 func InitIWSFlags(flag *pflag.FlagSet) {
 	flag.String(IWSRBSHostFlag, "", "Hostname for the IWS RBS")
 	flag.Bool(IWSRBSEnabledFlag, false, "enable the IWS RBS integration")
+	flag.String(IWSRBSStandInAddressFlag, "", "Address, such as localhost:8099, of a local IWS RBS stand-in to use instead of the IWS RBS")
 }
 
 // CheckIWS validates IWS command line flags
 func CheckIWS(v *viper.Viper) error {
-	return ValidateHost(v, IWSRBSHostFlag)
+	if err := ValidateHost(v, IWSRBSHostFlag); err != nil {
+		return err
+	}
+
+	if address := v.GetString(IWSRBSStandInAddressFlag); address != "" {
+		if _, _, err := net.SplitHostPort(address); err != nil {
+			return errors.Wrapf(err, "%s is invalid", IWSRBSStandInAddressFlag)
+		}
+
+		allowedEnvironments := []string{
+			EnvironmentDevelopment,
+			EnvironmentTest,
+			EnvironmentReview,
+			EnvironmentLoadtest,
+		}
+		if environment := v.GetString(EnvironmentFlag); !stringSliceContains(allowedEnvironments, environment) {
+			return errors.Errorf("cannot use the IWS RBS stand-in with the '%s' environment, only in %v", environment, allowedEnvironments)
+		}
+	}
+
+	return nil
 }
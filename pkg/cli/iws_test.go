@@ -1,6 +1,32 @@
 package cli
 
+import (
+	"github.com/spf13/pflag"
+)
+
 func (suite *cliTestSuite) TestConfigIWS() {
 	suite.Setup(InitIWSFlags, []string{})
 	suite.NoError(CheckIWS(suite.viper))
 }
+
+func (suite *cliTestSuite) TestConfigIWSStandIn() {
+	initIWSAndEnvironmentFlags := func(flag *pflag.FlagSet) {
+		InitIWSFlags(flag)
+		InitEnvironmentFlags(flag)
+	}
+
+	suite.Run("stand-in in development", func() {
+		suite.Setup(initIWSAndEnvironmentFlags, []string{"--iws-rbs-host", "pkict.example.mil", "--iws-rbs-stand-in-address", "localhost:8099", "--environment", EnvironmentDevelopment})
+		suite.NoError(CheckIWS(suite.viper))
+	})
+
+	suite.Run("stand-in address needs a port", func() {
+		suite.Setup(initIWSAndEnvironmentFlags, []string{"--iws-rbs-host", "pkict.example.mil", "--iws-rbs-stand-in-address", "localhost", "--environment", EnvironmentDevelopment})
+		suite.Error(CheckIWS(suite.viper))
+	})
+
+	suite.Run("stand-in is not allowed in production", func() {
+		suite.Setup(initIWSAndEnvironmentFlags, []string{"--iws-rbs-host", "pkict.example.mil", "--iws-rbs-stand-in-address", "localhost:8099", "--environment", EnvironmentPrd})
+		suite.Error(CheckIWS(suite.viper))
+	})
+}
@@ -0,0 +1,42 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+)
+
+const (
+	// PubSubBackendFlag is the PubSub Backend Flag
+	PubSubBackendFlag string = "pubsub-backend"
+)
+
+// InitPubSubFlags initializes PubSub command line flags
+func InitPubSubFlags(flag *pflag.FlagSet) {
+	flag.String(PubSubBackendFlag, "memory", "PubSub backend used to notify other servers, either memory, postgres or sns_sqs. The sns_sqs backend uses the SNS flags of the notification receiver.")
+}
+
+// CheckPubSub validates PubSub command line flags
+func CheckPubSub(v *viper.Viper) error {
+
+	pubSubBackend := v.GetString(PubSubBackendFlag)
+	if !stringSliceContains([]string{"memory", "postgres", "sns_sqs"}, pubSubBackend) {
+		return fmt.Errorf("invalid pubsub-backend %s, expecting memory, postgres or sns_sqs", pubSubBackend)
+	}
+
+	// The postgres listener connects with a password rather than an IAM token
+	if pubSubBackend == "postgres" && v.GetBool(DbIamFlag) {
+		return fmt.Errorf("pubsub-backend postgres cannot be used with %s", DbIamFlag)
+	}
+
+	if pubSubBackend == "sns_sqs" {
+		for _, flag := range []string{SNSRegionFlag, SNSTagsUpdatedTopicFlag, SNSAccountId} {
+			if v.GetString(flag) == "" {
+				return fmt.Errorf("invalid value for %s: %s", flag, v.GetString(flag))
+			}
+		}
+	}
+
+	return nil
+}
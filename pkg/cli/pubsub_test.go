@@ -0,0 +1,15 @@
+package cli
+
+func (suite *cliTestSuite) TestConfigPubSub() {
+	suite.Setup(InitPubSubFlags, []string{})
+	suite.NoError(CheckPubSub(suite.viper))
+
+	suite.Setup(InitPubSubFlags, []string{"--pubsub-backend", "postgres"})
+	suite.NoError(CheckPubSub(suite.viper))
+
+	suite.Setup(InitPubSubFlags, []string{"--pubsub-backend", "sns_sqs"})
+	suite.Error(CheckPubSub(suite.viper))
+
+	suite.Setup(InitPubSubFlags, []string{"--pubsub-backend", "redis"})
+	suite.Error(CheckPubSub(suite.viper))
+}
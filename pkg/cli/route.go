@@ -1,6 +1,8 @@
 package cli
 
 import (
+	"time"
+
 	"github.com/pkg/errors"
 	"github.com/spf13/pflag"
 	"github.com/spf13/viper"
@@ -30,6 +32,20 @@ const (
 
 	// DTODSimulateOutage is the DTOD Use Mock Flag
 	DTODSimulateOutageFlag string = "dtod-simulate-outage"
+
+	// DTODUseLocalFlag is the DTOD Use Local Flag
+	DTODUseLocalFlag string = "dtod-use-local"
+	// DTODLocalDistanceTableFlag is the DTOD Local Distance Table Flag
+	DTODLocalDistanceTableFlag string = "dtod-local-distance-table"
+	// DTODLocalRoadGraphFlag is the DTOD Local Road Graph Flag
+	DTODLocalRoadGraphFlag string = "dtod-local-road-graph"
+
+	// DTODCacheTTLFlag is the DTOD Cache TTL Flag
+	DTODCacheTTLFlag string = "dtod-cache-ttl"
+	// DTODCircuitBreakerThresholdFlag is the DTOD Circuit Breaker Threshold Flag
+	DTODCircuitBreakerThresholdFlag string = "dtod-circuit-breaker-threshold"
+	// DTODCircuitBreakerCooldownFlag is the DTOD Circuit Breaker Cooldown Flag
+	DTODCircuitBreakerCooldownFlag string = "dtod-circuit-breaker-cooldown"
 )
 
 // InitRouteFlags initializes Route command line flags
@@ -47,6 +63,14 @@ func InitRouteFlags(flag *pflag.FlagSet) {
 	flag.Bool(DTODUseMockFlag, false, "Whether to use a mocked version of DTOD")
 
 	flag.Bool(DTODSimulateOutageFlag, false, "Simulates the DTOD service being unnavailable")
+
+	flag.Bool(DTODUseLocalFlag, false, "Whether to calculate DTOD mileage from a local distance table and/or road graph")
+	flag.String(DTODLocalDistanceTableFlag, "", "Path to a CSV of precomputed zip5 to zip5 distances used when calculating DTOD mileage locally")
+	flag.String(DTODLocalRoadGraphFlag, "", "Path to a CSV road network used when calculating DTOD mileage locally")
+
+	flag.Duration(DTODCacheTTLFlag, 30*24*time.Hour, "How long DTOD mileage for a zip pair is cached before DTOD is asked again, 0 disables the cache")
+	flag.Int(DTODCircuitBreakerThresholdFlag, 5, "Number of consecutive DTOD failures before calls to DTOD are paused")
+	flag.Duration(DTODCircuitBreakerCooldownFlag, time.Minute, "How long calls to DTOD are paused after too many failures")
 }
 
 // CheckRoute validates Route command line flags
@@ -82,6 +106,25 @@ func CheckRoute(v *viper.Viper) error {
 		}
 	}
 
+	if v.GetBool(DTODUseLocalFlag) {
+		if v.GetBool(DTODUseMockFlag) {
+			return errors.Errorf("%s and %s cannot both be enabled", DTODUseLocalFlag, DTODUseMockFlag)
+		}
+		if len(v.GetString(DTODLocalDistanceTableFlag)) == 0 && len(v.GetString(DTODLocalRoadGraphFlag)) == 0 {
+			return errors.Errorf("%s requires %s or %s to be set", DTODUseLocalFlag, DTODLocalDistanceTableFlag, DTODLocalRoadGraphFlag)
+		}
+	}
+
+	if v.GetDuration(DTODCacheTTLFlag) < 0 {
+		return errors.Errorf("%s must not be negative", DTODCacheTTLFlag)
+	}
+	if v.GetInt(DTODCircuitBreakerThresholdFlag) < 1 {
+		return errors.Errorf("%s must be at least 1", DTODCircuitBreakerThresholdFlag)
+	}
+	if v.GetDuration(DTODCircuitBreakerCooldownFlag) <= 0 {
+		return errors.Errorf("%s must be greater than 0", DTODCircuitBreakerCooldownFlag)
+	}
+
 	// TODO: Removing this check for now to see how Circle reacts.
 	//if len(v.GetString(DTODApiUsernameFlag)) == 0 {
 	//	return errors.Errorf("%s is missing", DTODApiUsernameFlag)
@@ -4,3 +4,15 @@ func (suite *cliTestSuite) TestConfigRoute() {
 	suite.Setup(InitRouteFlags, []string{})
 	suite.NoError(CheckRoute(suite.viper))
 }
+
+func (suite *cliTestSuite) TestConfigRouteLocal() {
+	suite.Run("local mileage requires distance data", func() {
+		suite.Setup(InitRouteFlags, []string{"--dtod-use-local"})
+		suite.Error(CheckRoute(suite.viper))
+	})
+
+	suite.Run("local mileage with a distance table", func() {
+		suite.Setup(InitRouteFlags, []string{"--dtod-use-local", "--dtod-local-distance-table", "distances.csv"})
+		suite.NoError(CheckRoute(suite.viper))
+	})
+}
@@ -0,0 +1,48 @@
+package cli
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+)
+
+const (
+	// UploadScannerFlag is the Upload Scanner Flag
+	UploadScannerFlag string = "upload-scanner"
+	// ClamdAddressFlag is the Clamd Address Flag
+	ClamdAddressFlag string = "clamd-address"
+	// ClamdTimeoutFlag is the Clamd Timeout Flag
+	ClamdTimeoutFlag string = "clamd-timeout"
+)
+
+// InitScannerFlags initializes Upload Scanner command line flags
+func InitScannerFlags(flag *pflag.FlagSet) {
+	flag.String(UploadScannerFlag, "none", "Virus scanner for new uploads, either none, stub or clamd. Use none when S3 tags report the anti-virus status.")
+	flag.String(ClamdAddressFlag, "tcp://localhost:3310", "Address of the clamd daemon, as tcp://host:port or unix:///path/to/clamd.sock")
+	flag.Duration(ClamdTimeoutFlag, 0, "Timeout for a single clamd scan. Zero uses the scanner default.")
+}
+
+// CheckScanner validates Upload Scanner command line flags
+func CheckScanner(v *viper.Viper) error {
+
+	uploadScanner := v.GetString(UploadScannerFlag)
+	if !stringSliceContains([]string{"none", "stub", "clamd"}, uploadScanner) {
+		return fmt.Errorf("invalid upload-scanner %s, expecting none, stub or clamd", uploadScanner)
+	}
+
+	if uploadScanner == "clamd" {
+		address := v.GetString(ClamdAddressFlag)
+		u, err := url.Parse(address)
+		if err != nil || !stringSliceContains([]string{"tcp", "unix"}, u.Scheme) {
+			return fmt.Errorf("invalid value for %s: %s", ClamdAddressFlag, address)
+		}
+	}
+
+	if v.GetDuration(ClamdTimeoutFlag) < 0 {
+		return fmt.Errorf("invalid value for %s: must not be negative", ClamdTimeoutFlag)
+	}
+
+	return nil
+}
@@ -0,0 +1,15 @@
+package cli
+
+func (suite *cliTestSuite) TestConfigScanner() {
+	suite.Setup(InitScannerFlags, []string{})
+	suite.NoError(CheckScanner(suite.viper))
+
+	suite.Setup(InitScannerFlags, []string{"--upload-scanner", "clamd", "--clamd-address", "unix:///var/run/clamav/clamd.sock"})
+	suite.NoError(CheckScanner(suite.viper))
+
+	suite.Setup(InitScannerFlags, []string{"--upload-scanner", "clamd", "--clamd-address", "localhost:3310"})
+	suite.Error(CheckScanner(suite.viper))
+
+	suite.Setup(InitScannerFlags, []string{"--upload-scanner", "lambda"})
+	suite.Error(CheckScanner(suite.viper))
+}
@@ -21,6 +21,8 @@ const (
 	AWSS3RegionFlag string = "aws-s3-region"
 	// AWSS3KeyNamespaceFlag is the AWS S3 Key Namespace Flag
 	AWSS3KeyNamespaceFlag string = "aws-s3-key-namespace"
+	// StorageContentAddressedFlag is the Storage Content Addressed Flag
+	StorageContentAddressedFlag string = "storage-content-addressed"
 )
 
 // InitStorageFlags initializes Storage command line flags
@@ -31,6 +33,7 @@ func InitStorageFlags(flag *pflag.FlagSet) {
 	flag.String(AWSS3BucketNameFlag, "", "S3 bucket used for file storage")
 	flag.String(AWSS3RegionFlag, "", "AWS region used for S3 file storage")
 	flag.String(AWSS3KeyNamespaceFlag, "", "Key prefix for all objects written to S3")
+	flag.Bool(StorageContentAddressedFlag, false, "Store new uploads under a key derived from their SHA256 checksum so duplicate files share one object.")
 }
 
 // CheckStorage validates Storage command line flags
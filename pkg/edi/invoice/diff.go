@@ -0,0 +1,156 @@
+package ediinvoice
+
+import (
+	"fmt"
+	"strings"
+
+	edisegment "github.com/transcom/mymove/pkg/edi/segment"
+)
+
+// SegmentDifference describes one way two 858s differ. Segments are matched by their place in the
+// invoice rather than their line number, so an extra segment in one invoice does not shift the rest.
+type SegmentDifference struct {
+	// Segment names where the segment sits in the invoice, e.g. "Header.ContractCode" or "ServiceItem[1234-5678-a1b2c3d4].L1"
+	Segment string
+	// Element is the X12 reference of the differing element, e.g. "N902". It is empty when the
+	// whole segment is only in one of the invoices.
+	Element string
+	Left    string
+	Right   string
+}
+
+func (d SegmentDifference) String() string {
+	if d.Element == "" {
+		if d.Left == "" {
+			return fmt.Sprintf("%s: only in right: %s", d.Segment, d.Right)
+		}
+		return fmt.Sprintf("%s: only in left: %s", d.Segment, d.Left)
+	}
+	return fmt.Sprintf("%s %s: %q != %q", d.Segment, d.Element, d.Left, d.Right)
+}
+
+// labeledRecord is a segment written out as elements, along with where it sits in the invoice
+type labeledRecord struct {
+	label    string
+	elements []string
+}
+
+// labeledRecords lists every segment of the invoice with a label that identifies it independent of
+// the segments around it. Service items are labeled by their N9 reference ID, which is the payment service
+// item's, and FA2s by their detail code. Adding a service item renumbers the HL and lading line items after
+// it, so those show up as changed elements rather than shifting the labels.
+func (invoice Invoice858C) labeledRecords() []labeledRecord {
+	records := []labeledRecord{
+		{"ISA", invoice.ISA.StringArray()},
+		{"GS", invoice.GS.StringArray()},
+		{"ST", invoice.ST.StringArray()},
+	}
+
+	for _, f := range invoice.Header.nonEmptyNamedSegments() {
+		records = append(records, labeledRecord{"Header." + f.name, f.segment.StringArray()})
+	}
+
+	seenItems := map[string]int{}
+	for _, item := range invoice.ServiceItems {
+		id := item.N9.ReferenceIdentification
+		if id == "" {
+			id = "HL" + item.HL.HierarchicalIDNumber
+		}
+		seenItems[id]++
+		prefix := fmt.Sprintf("ServiceItem[%s]", id)
+		if seenItems[id] > 1 {
+			prefix = fmt.Sprintf("ServiceItem[%s#%d]", id, seenItems[id])
+		}
+		records = append(records,
+			labeledRecord{prefix + ".HL", item.HL.StringArray()},
+			labeledRecord{prefix + ".N9", item.N9.StringArray()},
+			labeledRecord{prefix + ".L5", item.L5.StringArray()},
+			labeledRecord{prefix + ".L0", item.L0.StringArray()},
+			labeledRecord{prefix + ".L1", item.L1.StringArray()},
+			labeledRecord{prefix + ".FA1", item.FA1.StringArray()},
+		)
+
+		seen := map[edisegment.FA2DetailCode]int{}
+		for _, fa2 := range item.FA2s {
+			code := fa2.BreakdownStructureDetailCode
+			seen[code]++
+			label := fmt.Sprintf("%s.FA2[%s]", prefix, code)
+			if seen[code] > 1 {
+				label = fmt.Sprintf("%s.FA2[%s#%d]", prefix, code, seen[code])
+			}
+			records = append(records, labeledRecord{label, fa2.StringArray()})
+		}
+	}
+
+	records = append(records,
+		labeledRecord{"L3", invoice.L3.StringArray()},
+		labeledRecord{"SE", invoice.SE.StringArray()},
+		labeledRecord{"GE", invoice.GE.StringArray()},
+		labeledRecord{"IEA", invoice.IEA.StringArray()},
+	)
+	return records
+}
+
+// Diff compares two 858s segment by segment and element by element. Differences are returned in the
+// order of the left invoice, followed by segments only found in the right invoice.
+func Diff(left Invoice858C, right Invoice858C) []SegmentDifference {
+	leftRecords := left.labeledRecords()
+	rightRecords := right.labeledRecords()
+
+	rightByLabel := make(map[string][]string, len(rightRecords))
+	for _, record := range rightRecords {
+		rightByLabel[record.label] = record.elements
+	}
+	leftLabels := make(map[string]bool, len(leftRecords))
+
+	var differences []SegmentDifference
+	for _, record := range leftRecords {
+		leftLabels[record.label] = true
+		rightElements, ok := rightByLabel[record.label]
+		if !ok {
+			differences = append(differences, SegmentDifference{Segment: record.label, Left: segmentString(record.elements)})
+			continue
+		}
+		differences = append(differences, diffElements(record.label, record.elements, rightElements)...)
+	}
+
+	for _, record := range rightRecords {
+		if !leftLabels[record.label] {
+			differences = append(differences, SegmentDifference{Segment: record.label, Right: segmentString(record.elements)})
+		}
+	}
+
+	return differences
+}
+
+// diffElements compares two versions of the same segment. The first element is the segment ID.
+func diffElements(label string, left []string, right []string) []SegmentDifference {
+	var differences []SegmentDifference
+	length := len(left)
+	if len(right) > length {
+		length = len(right)
+	}
+	for i := 1; i < length; i++ {
+		leftElement, rightElement := elementAt(left, i), elementAt(right, i)
+		if leftElement != rightElement {
+			differences = append(differences, SegmentDifference{
+				Segment: label,
+				Element: fmt.Sprintf("%s%02d", left[0], i),
+				Left:    leftElement,
+				Right:   rightElement,
+			})
+		}
+	}
+	return differences
+}
+
+func elementAt(elements []string, i int) string {
+	if i < len(elements) {
+		return elements[i]
+	}
+	return ""
+}
+
+func segmentString(elements []string) string {
+	return strings.Join(elements, "*")
+}
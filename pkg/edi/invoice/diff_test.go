@@ -0,0 +1,96 @@
+package ediinvoice
+
+import (
+	edisegment "github.com/transcom/mymove/pkg/edi/segment"
+)
+
+func (suite *InvoiceSuite) TestDiff() {
+	suite.Run("identical invoices have no differences", func() {
+		suite.Empty(Diff(MakeValidEdi(), MakeValidEdi()))
+	})
+
+	suite.Run("changed elements are reported by segment and element", func() {
+		left := MakeValidEdi()
+		right := MakeValidEdi()
+		right.Header.ContractCode.ReferenceIdentification = "OTHER_CONTRACT"
+		right.ServiceItems[0].L1.Charge = 200
+
+		differences := Diff(left, right)
+		suite.Equal([]SegmentDifference{
+			{Segment: "Header.ContractCode", Element: "N902", Left: "TRUSS_TEST", Right: "OTHER_CONTRACT"},
+			{Segment: "ServiceItem[3351-1123c].L1", Element: "L104", Left: "100", Right: "200"},
+		}, differences)
+		suite.Equal(`ServiceItem[3351-1123c].L1 L104: "100" != "200"`, differences[1].String())
+	})
+
+	suite.Run("an extra segment does not shift the segments after it", func() {
+		left := MakeValidEdi()
+		right := MakeValidEdi()
+		right.Header.OriginPhone = &edisegment.PER{ContactFunctionCode: "CN", Name: "Origin Contact"}
+		right.ServiceItems[0].FA2s = append(right.ServiceItems[0].FA2s, edisegment.FA2{
+			BreakdownStructureDetailCode: edisegment.FA2DetailCodeA1,
+			FinancialInformationCode:     "97",
+		})
+
+		differences := Diff(left, right)
+		suite.Equal([]SegmentDifference{
+			{Segment: "Header.OriginPhone", Right: "PER*CN*Origin Contact**"},
+			{Segment: "ServiceItem[3351-1123c].FA2[A1]", Right: "FA2*A1*97"},
+		}, differences)
+		suite.Equal("Header.OriginPhone: only in right: PER*CN*Origin Contact**", differences[0].String())
+	})
+
+	suite.Run("service items only in one invoice are reported", func() {
+		left := MakeValidEdi()
+		right := MakeValidEdi()
+		left.ServiceItems = append(left.ServiceItems, left.ServiceItems[0])
+		left.ServiceItems[1].HL.HierarchicalIDNumber = "2"
+		left.ServiceItems[1].N9.ReferenceIdentification = "3351-1123d"
+
+		differences := Diff(left, right)
+		suite.Len(differences, 7)
+		for _, difference := range differences {
+			suite.Contains(difference.Segment, "ServiceItem[3351-1123d]")
+			suite.Empty(difference.Right)
+		}
+	})
+
+	suite.Run("inserting a service item does not shift the labels of the others", func() {
+		left := MakeValidEdi()
+		right := MakeValidEdi()
+		inserted := right.ServiceItems[0]
+		inserted.N9.ReferenceIdentification = "3351-1123d"
+		moved := right.ServiceItems[0]
+		moved.HL.HierarchicalIDNumber = "2"
+		moved.L5.LadingLineItemNumber = 2
+		moved.L0.LadingLineItemNumber = 2
+		moved.L1.LadingLineItemNumber = 2
+		right.ServiceItems = []ServiceItemSegments{inserted, moved}
+
+		differences := Diff(left, right)
+		suite.Len(differences, 11)
+		suite.Equal([]SegmentDifference{
+			{Segment: "ServiceItem[3351-1123c].HL", Element: "HL01", Left: "1", Right: "2"},
+			{Segment: "ServiceItem[3351-1123c].L5", Element: "L501", Left: "1", Right: "2"},
+			{Segment: "ServiceItem[3351-1123c].L0", Element: "L001", Left: "1", Right: "2"},
+			{Segment: "ServiceItem[3351-1123c].L1", Element: "L101", Left: "1", Right: "2"},
+		}, differences[:4])
+		for _, difference := range differences[4:] {
+			suite.Contains(difference.Segment, "ServiceItem[3351-1123d]")
+			suite.Empty(difference.Left)
+		}
+	})
+
+	suite.Run("service items with the same reference ID are told apart by their order", func() {
+		left := MakeValidEdi()
+		right := MakeValidEdi()
+		right.ServiceItems = append(right.ServiceItems, right.ServiceItems[0])
+		right.ServiceItems[1].HL.HierarchicalIDNumber = "2"
+
+		differences := Diff(left, right)
+		suite.Len(differences, 7)
+		for _, difference := range differences {
+			suite.Contains(difference.Segment, "ServiceItem[3351-1123c#2]")
+		}
+	})
+}
@@ -81,48 +81,65 @@ type ServiceItemSegments struct {
 	FA2s []edisegment.FA2
 }
 
-// NonEmptySegments produces an array of all of the fields
-// in an InvoiceHeader that are not nil
-func (ih *InvoiceHeader) NonEmptySegments() []edisegment.Segment {
-	var result []edisegment.Segment
+// namedSegment is a segment of an invoice along with the name of the field that holds it
+type namedSegment struct {
+	name    string
+	segment edisegment.Segment
+}
 
+// namedSegments lists every field of an InvoiceHeader in the order they are written
+func (ih *InvoiceHeader) namedSegments() []namedSegment {
 	// This array should contain every field of InvoiceHeader
-	fields := []edisegment.Segment{
-		&ih.ShipmentInformation,
-		&ih.PaymentRequestNumber,
-		&ih.ContractCode,
-		&ih.ServiceMemberName,
-		&ih.OrderPayGrade,
-		&ih.ServiceMemberBranch,
-		&ih.ServiceMemberID,
-		&ih.MoveCode,
-		&ih.Currency,
-		ih.RequestedPickupDate,
-		ih.ScheduledPickupDate,
-		ih.ActualPickupDate,
-		&ih.BuyerOrganizationName,
-		&ih.SellerOrganizationName,
-		&ih.DestinationName,
-		ih.DestinationStreetAddress,
-		&ih.DestinationPostalDetails,
-		ih.DestinationPhone,
-		&ih.OriginName,
-		ih.OriginStreetAddress,
-		&ih.OriginPostalDetails,
-		ih.OriginPhone,
+	return []namedSegment{
+		{"ShipmentInformation", &ih.ShipmentInformation},
+		{"PaymentRequestNumber", &ih.PaymentRequestNumber},
+		{"ContractCode", &ih.ContractCode},
+		{"ServiceMemberName", &ih.ServiceMemberName},
+		{"OrderPayGrade", &ih.OrderPayGrade},
+		{"ServiceMemberBranch", &ih.ServiceMemberBranch},
+		{"ServiceMemberID", &ih.ServiceMemberID},
+		{"MoveCode", &ih.MoveCode},
+		{"Currency", &ih.Currency},
+		{"RequestedPickupDate", ih.RequestedPickupDate},
+		{"ScheduledPickupDate", ih.ScheduledPickupDate},
+		{"ActualPickupDate", ih.ActualPickupDate},
+		{"BuyerOrganizationName", &ih.BuyerOrganizationName},
+		{"SellerOrganizationName", &ih.SellerOrganizationName},
+		{"DestinationName", &ih.DestinationName},
+		{"DestinationStreetAddress", ih.DestinationStreetAddress},
+		{"DestinationPostalDetails", &ih.DestinationPostalDetails},
+		{"DestinationPhone", ih.DestinationPhone},
+		{"OriginName", &ih.OriginName},
+		{"OriginStreetAddress", ih.OriginStreetAddress},
+		{"OriginPostalDetails", &ih.OriginPostalDetails},
+		{"OriginPhone", ih.OriginPhone},
 	}
+}
 
-	for _, f := range fields {
+// nonEmptyNamedSegments returns the named segments of an InvoiceHeader that are not nil
+func (ih *InvoiceHeader) nonEmptyNamedSegments() []namedSegment {
+	var result []namedSegment
+	for _, f := range ih.namedSegments() {
 		// An interface value holding a nil pointer is not nil, so we have to use
 		// reflect here instead of just checking f != nil
-		if !(reflect.ValueOf(f).Kind() == reflect.Ptr &&
-			reflect.ValueOf(f).IsNil()) {
+		if !(reflect.ValueOf(f.segment).Kind() == reflect.Ptr &&
+			reflect.ValueOf(f.segment).IsNil()) {
 			result = append(result, f)
 		}
 	}
 	return result
 }
 
+// NonEmptySegments produces an array of all of the fields
+// in an InvoiceHeader that are not nil
+func (ih *InvoiceHeader) NonEmptySegments() []edisegment.Segment {
+	var result []edisegment.Segment
+	for _, f := range ih.nonEmptyNamedSegments() {
+		result = append(result, f.segment)
+	}
+	return result
+}
+
 // Size returns the number of fields in an InvoiceHeader that are not nil
 func (ih *InvoiceHeader) Size() int {
 	return len(ih.NonEmptySegments())
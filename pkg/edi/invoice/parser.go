@@ -0,0 +1,225 @@
+package ediinvoice
+
+import (
+	"bufio"
+	"fmt"
+	"strings"
+
+	"github.com/transcom/mymove/pkg/edi"
+	edisegment "github.com/transcom/mymove/pkg/edi/segment"
+)
+
+// Parse takes in a string representation of an 858 EDI file and reads it into the Invoice858C struct.
+// Header segments are matched to their InvoiceHeader field by qualifier, N3, N4 and PER segments belong
+// to the N1 before them, and every HL starts a new service item.
+func (invoice *Invoice858C) Parse(ediString string) error {
+	var err error
+	// the N1 entity that following N3, N4 and PER segments describe
+	party := ""
+
+	scanner := bufio.NewScanner(strings.NewReader(ediString))
+	scanner.Split(edi.SplitLines)
+	for scanner.Scan() {
+		record := strings.Split(scanner.Text(), "*")
+
+		if len(record) == 0 || len(strings.TrimSpace(record[0])) == 0 {
+			continue
+		}
+		serviceItem := invoice.currentServiceItem()
+
+		switch record[0] {
+		case "ISA":
+			err = invoice.ISA.Parse(record[1:])
+		case "GS":
+			err = invoice.GS.Parse(record[1:])
+		case "ST":
+			err = invoice.ST.Parse(record[1:])
+		case "BX":
+			err = invoice.Header.ShipmentInformation.Parse(record[1:])
+		case "N9":
+			if serviceItem != nil {
+				err = serviceItem.N9.Parse(record[1:])
+				break
+			}
+			var n9 edisegment.N9
+			err = n9.Parse(record[1:])
+			if err == nil {
+				err = invoice.Header.setN9(n9)
+			}
+		case "C3":
+			err = invoice.Header.Currency.Parse(record[1:])
+		case "G62":
+			var g62 edisegment.G62
+			err = g62.Parse(record[1:])
+			if err == nil {
+				err = invoice.Header.setG62(g62)
+			}
+		case "N1":
+			var n1 edisegment.N1
+			err = n1.Parse(record[1:])
+			if err == nil {
+				party = n1.EntityIdentifierCode
+				err = invoice.Header.setN1(n1)
+			}
+		case "N3":
+			var n3 edisegment.N3
+			err = n3.Parse(record[1:])
+			if err == nil {
+				err = invoice.Header.setN3(party, n3)
+			}
+		case "N4":
+			var n4 edisegment.N4
+			err = n4.Parse(record[1:])
+			if err == nil {
+				err = invoice.Header.setN4(party, n4)
+			}
+		case "PER":
+			var per edisegment.PER
+			err = per.Parse(record[1:])
+			if err == nil {
+				err = invoice.Header.setPER(party, per)
+			}
+		case "HL":
+			item := ServiceItemSegments{}
+			err = item.HL.Parse(record[1:])
+			invoice.ServiceItems = append(invoice.ServiceItems, item)
+		case "L5", "L0", "L1", "FA1", "FA2":
+			if serviceItem == nil {
+				return fmt.Errorf("858 failed to parse: %s segment found before the first HL segment", record[0])
+			}
+			err = serviceItem.parseSegment(record)
+		case "L3":
+			err = invoice.L3.Parse(record[1:])
+		case "SE":
+			err = invoice.SE.Parse(record[1:])
+		case "GE":
+			err = invoice.GE.Parse(record[1:])
+		case "IEA":
+			err = invoice.IEA.Parse(record[1:])
+		default:
+			return fmt.Errorf("unexpected row for EDI 858, do not know how to parse: %s with %d parts", strings.Join(record, " "), len(record))
+		}
+		if err != nil {
+			return fmt.Errorf("858 failed to parse %w", err)
+		}
+	}
+
+	return scanner.Err()
+}
+
+// currentServiceItem returns the service item the last HL segment started, or nil while parsing the header
+func (invoice *Invoice858C) currentServiceItem() *ServiceItemSegments {
+	if len(invoice.ServiceItems) == 0 {
+		return nil
+	}
+	return &invoice.ServiceItems[len(invoice.ServiceItems)-1]
+}
+
+func (s *ServiceItemSegments) parseSegment(record []string) error {
+	switch record[0] {
+	case "L5":
+		return s.L5.Parse(record[1:])
+	case "L0":
+		return s.L0.Parse(record[1:])
+	case "L1":
+		return s.L1.Parse(record[1:])
+	case "FA1":
+		return s.FA1.Parse(record[1:])
+	case "FA2":
+		var fa2 edisegment.FA2
+		if err := fa2.Parse(record[1:]); err != nil {
+			return err
+		}
+		s.FA2s = append(s.FA2s, fa2)
+		return nil
+	}
+	return fmt.Errorf("%s is not a service item segment", record[0])
+}
+
+func (ih *InvoiceHeader) setN9(n9 edisegment.N9) error {
+	switch n9.ReferenceIdentificationQualifier {
+	case "CN":
+		ih.PaymentRequestNumber = n9
+	case "CT":
+		ih.ContractCode = n9
+	case "1W":
+		ih.ServiceMemberName = n9
+	case "ML":
+		ih.OrderPayGrade = n9
+	case "3L":
+		ih.ServiceMemberBranch = n9
+	case "4A":
+		ih.ServiceMemberID = n9
+	case "CMN":
+		ih.MoveCode = n9
+	default:
+		return fmt.Errorf("unexpected N9 qualifier %s in the invoice header", n9.ReferenceIdentificationQualifier)
+	}
+	return nil
+}
+
+func (ih *InvoiceHeader) setG62(g62 edisegment.G62) error {
+	switch g62.DateQualifier {
+	case 10:
+		ih.RequestedPickupDate = &g62
+	case 76:
+		ih.ScheduledPickupDate = &g62
+	case 86:
+		ih.ActualPickupDate = &g62
+	default:
+		return fmt.Errorf("unexpected G62 date qualifier %d", g62.DateQualifier)
+	}
+	return nil
+}
+
+func (ih *InvoiceHeader) setN1(n1 edisegment.N1) error {
+	switch n1.EntityIdentifierCode {
+	case "BY":
+		ih.BuyerOrganizationName = n1
+	case "SE":
+		ih.SellerOrganizationName = n1
+	case "ST":
+		ih.DestinationName = n1
+	case "SF":
+		ih.OriginName = n1
+	default:
+		return fmt.Errorf("unexpected N1 entity identifier code %s", n1.EntityIdentifierCode)
+	}
+	return nil
+}
+
+func (ih *InvoiceHeader) setN3(party string, n3 edisegment.N3) error {
+	switch party {
+	case "ST":
+		ih.DestinationStreetAddress = &n3
+	case "SF":
+		ih.OriginStreetAddress = &n3
+	default:
+		return fmt.Errorf("N3 segment must follow an N1 for the origin or destination")
+	}
+	return nil
+}
+
+func (ih *InvoiceHeader) setN4(party string, n4 edisegment.N4) error {
+	switch party {
+	case "ST":
+		ih.DestinationPostalDetails = n4
+	case "SF":
+		ih.OriginPostalDetails = n4
+	default:
+		return fmt.Errorf("N4 segment must follow an N1 for the origin or destination")
+	}
+	return nil
+}
+
+func (ih *InvoiceHeader) setPER(party string, per edisegment.PER) error {
+	switch party {
+	case "ST":
+		ih.DestinationPhone = &per
+	case "SF":
+		ih.OriginPhone = &per
+	default:
+		return fmt.Errorf("PER segment must follow an N1 for the origin or destination")
+	}
+	return nil
+}
@@ -0,0 +1,57 @@
+package ediinvoice
+
+import (
+	edisegment "github.com/transcom/mymove/pkg/edi/segment"
+)
+
+func (suite *InvoiceSuite) TestParse() {
+	suite.Run("parsing a generated 858 rebuilds the same invoice", func() {
+		invoice := MakeValidEdi()
+		ediString, err := invoice.EDIString(suite.Logger())
+		suite.NoError(err)
+
+		var parsed Invoice858C
+		err = parsed.Parse(ediString)
+		suite.NoError(err)
+		suite.NoError(parsed.Validate())
+		suite.Equal(invoice, parsed)
+
+		reserialized, err := parsed.EDIString(suite.Logger())
+		suite.NoError(err)
+		suite.Equal(ediString, reserialized)
+	})
+
+	suite.Run("street addresses and phones are matched to the origin or destination before them", func() {
+		invoice := MakeValidEdi()
+		invoice.Header.OriginStreetAddress = &edisegment.N3{AddressInformation1: "987 Other Avenue", AddressInformation2: "P.O. Box 1234"}
+		invoice.Header.OriginPhone = &edisegment.PER{ContactFunctionCode: "CN", Name: "Origin Contact", CommunicationNumberQualifier: "TE", CommunicationNumber: "5551234567"}
+		invoice.Header.DestinationStreetAddress = &edisegment.N3{AddressInformation1: "Fort Eisenhower"}
+		ediString, err := invoice.EDIString(suite.Logger())
+		suite.NoError(err)
+
+		var parsed Invoice858C
+		suite.NoError(parsed.Parse(ediString))
+		suite.Equal(invoice.Header.OriginStreetAddress, parsed.Header.OriginStreetAddress)
+		suite.Equal(invoice.Header.OriginPhone, parsed.Header.OriginPhone)
+		suite.Equal(invoice.Header.DestinationStreetAddress, parsed.Header.DestinationStreetAddress)
+		suite.Nil(parsed.Header.DestinationPhone)
+	})
+
+	suite.Run("service item segments before the first HL are rejected", func() {
+		var parsed Invoice858C
+		err := parsed.Parse("ST*858*0001\nL5*1*CS*TBD*D**\n")
+		suite.ErrorContains(err, "before the first HL segment")
+	})
+
+	suite.Run("unknown segments are rejected", func() {
+		var parsed Invoice858C
+		err := parsed.Parse("ST*858*0001\nZZZ*1\n")
+		suite.ErrorContains(err, "do not know how to parse")
+	})
+
+	suite.Run("unknown header qualifiers are rejected", func() {
+		var parsed Invoice858C
+		err := parsed.Parse("N9*XX*123**\n")
+		suite.ErrorContains(err, "unexpected N9 qualifier XX")
+	})
+}
@@ -0,0 +1,221 @@
+package ediinvoice
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	edisegment "github.com/transcom/mymove/pkg/edi/segment"
+)
+
+// RuleViolation is a business rule an 858 breaks. The code is the TED application error condition
+// code Syncada would send back in an 824 for the same problem.
+type RuleViolation struct {
+	Code    string
+	Segment string
+	Message string
+}
+
+func (v RuleViolation) String() string {
+	return fmt.Sprintf("%s %s: %s", v.Code, v.Segment, v.Message)
+}
+
+// TED returns the violation as it would appear in an 824
+func (v RuleViolation) TED() edisegment.TED {
+	message := fmt.Sprintf("%s %s", v.Segment, v.Message)
+	// TED02 is limited to 60 characters
+	if len(message) > 60 {
+		message = message[:60]
+	}
+	return edisegment.TED{
+		ApplicationErrorConditionCode: v.Code,
+		FreeFormMessage:               message,
+	}
+}
+
+// BusinessRuleError is returned when an 858 breaks one or more business rules
+type BusinessRuleError struct {
+	Violations []RuleViolation
+}
+
+func (e BusinessRuleError) Error() string {
+	messages := make([]string, len(e.Violations))
+	for i, violation := range e.Violations {
+		messages[i] = violation.String()
+	}
+	return fmt.Sprintf("EDI failed business rules: %s", strings.Join(messages, "; "))
+}
+
+// BusinessRule checks an 858 for one kind of problem Syncada would reject it for
+type BusinessRule func(invoice Invoice858C) []RuleViolation
+
+// DefaultBusinessRules are the rules checked before an 858 is sent to Syncada
+var DefaultBusinessRules = []BusinessRule{
+	CheckLineOfAccounting,
+	CheckTotals,
+	CheckHierarchy,
+	CheckDates,
+}
+
+// CheckBusinessRules runs the rules against the invoice, or the DefaultBusinessRules when none are
+// given. Unlike Validate, which checks each segment on its own, the rules check that segments agree
+// with each other. A BusinessRuleError listing every violation is returned if any rule fails.
+func (invoice Invoice858C) CheckBusinessRules(rules ...BusinessRule) error {
+	if len(rules) == 0 {
+		rules = DefaultBusinessRules
+	}
+
+	var violations []RuleViolation
+	for _, rule := range rules {
+		violations = append(violations, rule(invoice)...)
+	}
+	if len(violations) > 0 {
+		return BusinessRuleError{Violations: violations}
+	}
+	return nil
+}
+
+// CheckLineOfAccounting checks that every service item has an FA1 and a TAC, and that no line of
+// accounting element is sent twice for the same service item
+func CheckLineOfAccounting(invoice Invoice858C) []RuleViolation {
+	var violations []RuleViolation
+	for _, item := range invoice.ServiceItems {
+		prefix := fmt.Sprintf("ServiceItem[%s]", item.HL.HierarchicalIDNumber)
+
+		if item.FA1.AgencyQualifierCode == "" {
+			violations = append(violations, RuleViolation{edisegment.TEDErrorCodeMissingData, prefix + ".FA1", "agency qualifier code is missing"})
+		}
+
+		seen := map[edisegment.FA2DetailCode]bool{}
+		for _, fa2 := range item.FA2s {
+			code := fa2.BreakdownStructureDetailCode
+			if seen[code] {
+				violations = append(violations, RuleViolation{edisegment.TEDErrorCodeDuplicate, fmt.Sprintf("%s.FA2[%s]", prefix, code), "detail code is sent more than once"})
+			}
+			seen[code] = true
+			if strings.TrimSpace(fa2.FinancialInformationCode) == "" {
+				violations = append(violations, RuleViolation{edisegment.TEDErrorCodeMissingData, fmt.Sprintf("%s.FA2[%s]", prefix, code), "financial information code is missing"})
+			}
+		}
+		if !seen[edisegment.FA2DetailCodeTA] {
+			violations = append(violations, RuleViolation{edisegment.TEDErrorCodeMissingData, prefix + ".FA2[TA]", "TAC is missing"})
+		}
+	}
+	return violations
+}
+
+// CheckTotals checks that the L3 total matches the L1 charges of the service items, and that no
+// service item is billed for more weight than the L3 total weight
+func CheckTotals(invoice Invoice858C) []RuleViolation {
+	var violations []RuleViolation
+
+	var totalCharges int64
+	for _, item := range invoice.ServiceItems {
+		totalCharges += item.L1.Charge
+
+		if item.L0.Weight > invoice.L3.Weight {
+			violations = append(violations, RuleViolation{
+				edisegment.TEDErrorCodeMutuallyDefined,
+				fmt.Sprintf("ServiceItem[%s].L0", item.HL.HierarchicalIDNumber),
+				fmt.Sprintf("weight %.0f is more than the L3 weight %.0f", item.L0.Weight, invoice.L3.Weight),
+			})
+		}
+	}
+
+	if totalCharges != invoice.L3.PriceCents {
+		violations = append(violations, RuleViolation{
+			edisegment.TEDErrorCodeMutuallyDefined,
+			"L3",
+			fmt.Sprintf("total %d does not match the L1 charges %d", invoice.L3.PriceCents, totalCharges),
+		})
+	}
+	return violations
+}
+
+// CheckHierarchy checks that the HL segments are numbered 1, 2, 3... without gaps or duplicates, that
+// any parent is an earlier HL, and that the lading line items of a service item use its HL number
+func CheckHierarchy(invoice Invoice858C) []RuleViolation {
+	var violations []RuleViolation
+	seen := map[string]bool{}
+
+	for i, item := range invoice.ServiceItems {
+		id := item.HL.HierarchicalIDNumber
+		prefix := fmt.Sprintf("ServiceItem[%s]", id)
+
+		if seen[id] {
+			violations = append(violations, RuleViolation{edisegment.TEDErrorCodeDuplicate, prefix + ".HL", "hierarchical ID is used more than once"})
+		} else if id != strconv.Itoa(i+1) {
+			violations = append(violations, RuleViolation{edisegment.TEDErrorCodeInvalidIdentificationCode, prefix + ".HL", fmt.Sprintf("hierarchical ID should be %d", i+1)})
+		}
+
+		parent := item.HL.HierarchicalParentIDNumber
+		if parent != "" && !seen[parent] {
+			violations = append(violations, RuleViolation{edisegment.TEDErrorCodeInvalidIdentificationCode, prefix + ".HL", fmt.Sprintf("parent %s is not an earlier HL", parent)})
+		}
+		seen[id] = true
+
+		if item.N9.ReferenceIdentification == "" {
+			violations = append(violations, RuleViolation{edisegment.TEDErrorCodeMissingData, prefix + ".N9", "service item reference ID is missing"})
+		}
+
+		lineItems := []struct {
+			segment string
+			number  int
+		}{
+			{"L5", item.L5.LadingLineItemNumber},
+			{"L0", item.L0.LadingLineItemNumber},
+			{"L1", item.L1.LadingLineItemNumber},
+		}
+		for _, lineItem := range lineItems {
+			if strconv.Itoa(lineItem.number) != id {
+				violations = append(violations, RuleViolation{
+					edisegment.TEDErrorCodeInvalidIdentificationCode,
+					prefix + "." + lineItem.segment,
+					fmt.Sprintf("lading line item %d does not match HL %s", lineItem.number, id),
+				})
+			}
+		}
+	}
+	return violations
+}
+
+// CheckDates checks that the interchange and group dates are valid and that payment was not requested
+// before the actual pickup. Invoices billing more than management and counseling services need a
+// pickup date.
+func CheckDates(invoice Invoice858C) []RuleViolation {
+	var violations []RuleViolation
+	if _, err := time.Parse("060102", invoice.ISA.InterchangeDate); err != nil {
+		violations = append(violations, RuleViolation{edisegment.TEDErrorCodeMissingData, "ISA", "interchange date is missing or invalid"})
+	}
+
+	requestedDate, err := time.Parse("20060102", invoice.GS.Date)
+	if err != nil {
+		return append(violations, RuleViolation{edisegment.TEDErrorCodeMissingData, "GS", "date is missing or invalid"})
+	}
+
+	header := invoice.Header
+	if header.ActualPickupDate != nil {
+		actualPickupDate, err := time.Parse("20060102", header.ActualPickupDate.Date)
+		if err != nil {
+			violations = append(violations, RuleViolation{edisegment.TEDErrorCodeMissingData, "Header.ActualPickupDate", "date is missing or invalid"})
+		} else if actualPickupDate.After(requestedDate) {
+			violations = append(violations, RuleViolation{edisegment.TEDErrorCodeMutuallyDefined, "Header.ActualPickupDate", "pickup is after payment was requested"})
+		}
+	}
+
+	if header.RequestedPickupDate == nil && header.ScheduledPickupDate == nil && header.ActualPickupDate == nil && billsShipmentServices(invoice) {
+		violations = append(violations, RuleViolation{edisegment.TEDErrorCodeMissingData, "Header.G62", "a pickup date is required"})
+	}
+	return violations
+}
+
+// billsShipmentServices returns true if the invoice bills anything other than management and counseling services
+func billsShipmentServices(invoice Invoice858C) bool {
+	for _, item := range invoice.ServiceItems {
+		if code := item.L5.LadingDescription; code != "MS" && code != "CS" {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,159 @@
+package ediinvoice
+
+import (
+	"errors"
+	"strings"
+
+	edisegment "github.com/transcom/mymove/pkg/edi/segment"
+)
+
+func (suite *InvoiceSuite) TestCheckBusinessRules() {
+	violationsOf := func(err error) []RuleViolation {
+		var ruleErr BusinessRuleError
+		suite.True(errors.As(err, &ruleErr), "expected a BusinessRuleError, got %v", err)
+		return ruleErr.Violations
+	}
+
+	suite.Run("a valid invoice passes every rule", func() {
+		invoice := MakeValidEdi()
+		suite.NoError(invoice.CheckBusinessRules())
+	})
+
+	suite.Run("only the given rules are checked", func() {
+		invoice := MakeValidEdi()
+		invoice.L3.PriceCents = 200
+		suite.NoError(invoice.CheckBusinessRules(CheckLineOfAccounting))
+		suite.Error(invoice.CheckBusinessRules(CheckTotals))
+	})
+
+	suite.Run("L3 total must match the L1 charges", func() {
+		invoice := MakeValidEdi()
+		invoice.L3.PriceCents = 200
+
+		violations := violationsOf(invoice.CheckBusinessRules())
+		suite.Len(violations, 1)
+		suite.Equal(edisegment.TEDErrorCodeMutuallyDefined, violations[0].Code)
+		suite.Equal("L3", violations[0].Segment)
+		suite.Contains(violations[0].Message, "total 200 does not match the L1 charges 100")
+	})
+
+	suite.Run("service item weight cannot exceed the L3 weight", func() {
+		invoice := MakeValidEdi()
+		invoice.ServiceItems[0].L0.Weight = 400
+
+		violations := violationsOf(invoice.CheckBusinessRules())
+		suite.Len(violations, 1)
+		suite.Equal("ServiceItem[1].L0", violations[0].Segment)
+	})
+
+	suite.Run("service items with weight need an L3 weight", func() {
+		invoice := MakeValidEdi()
+		invoice.ServiceItems[0].L0.Weight = 200
+		invoice.L3.Weight = 0
+		invoice.L3.WeightQualifier = ""
+
+		violations := violationsOf(invoice.CheckBusinessRules())
+		suite.Len(violations, 1)
+		suite.Equal("ServiceItem[1].L0", violations[0].Segment)
+		suite.Contains(violations[0].Message, "weight 200 is more than the L3 weight 0")
+	})
+
+	suite.Run("a TAC is required", func() {
+		invoice := MakeValidEdi()
+		invoice.ServiceItems[0].FA2s = nil
+
+		violations := violationsOf(invoice.CheckBusinessRules())
+		suite.Len(violations, 1)
+		suite.Equal(edisegment.TEDErrorCodeMissingData, violations[0].Code)
+		suite.Equal("ServiceItem[1].FA2[TA]", violations[0].Segment)
+	})
+
+	suite.Run("line of accounting elements cannot repeat", func() {
+		invoice := MakeValidEdi()
+		invoice.ServiceItems[0].FA2s = append(invoice.ServiceItems[0].FA2s, edisegment.FA2{
+			BreakdownStructureDetailCode: edisegment.FA2DetailCodeTA,
+			FinancialInformationCode:     "5678",
+		})
+
+		violations := violationsOf(invoice.CheckBusinessRules())
+		suite.Len(violations, 1)
+		suite.Equal(edisegment.TEDErrorCodeDuplicate, violations[0].Code)
+	})
+
+	suite.Run("HL numbers must count up from 1", func() {
+		invoice := MakeValidEdi()
+		second := invoice.ServiceItems[0]
+		second.HL.HierarchicalIDNumber = "3"
+		second.L5.LadingLineItemNumber = 3
+		second.L0.LadingLineItemNumber = 3
+		second.L1.LadingLineItemNumber = 3
+		invoice.ServiceItems = append(invoice.ServiceItems, second)
+		invoice.L3.PriceCents = 200
+
+		violations := violationsOf(invoice.CheckBusinessRules(CheckHierarchy))
+		suite.Len(violations, 1)
+		suite.Equal(edisegment.TEDErrorCodeInvalidIdentificationCode, violations[0].Code)
+		suite.Equal("ServiceItem[3].HL", violations[0].Segment)
+	})
+
+	suite.Run("HL numbers cannot repeat", func() {
+		invoice := MakeValidEdi()
+		invoice.ServiceItems = append(invoice.ServiceItems, invoice.ServiceItems[0])
+
+		violations := violationsOf(invoice.CheckBusinessRules(CheckHierarchy))
+		suite.Equal(edisegment.TEDErrorCodeDuplicate, violations[0].Code)
+	})
+
+	suite.Run("lading line items must match the HL number", func() {
+		invoice := MakeValidEdi()
+		invoice.ServiceItems[0].L1.LadingLineItemNumber = 2
+
+		violations := violationsOf(invoice.CheckBusinessRules())
+		suite.Len(violations, 1)
+		suite.Equal("ServiceItem[1].L1", violations[0].Segment)
+	})
+
+	suite.Run("pickup cannot be after payment was requested", func() {
+		invoice := MakeValidEdi()
+		invoice.Header.ActualPickupDate = &edisegment.G62{DateQualifier: 86, Date: "20190904"}
+
+		violations := violationsOf(invoice.CheckBusinessRules())
+		suite.Len(violations, 1)
+		suite.Equal("Header.ActualPickupDate", violations[0].Segment)
+	})
+
+	suite.Run("a pickup date is only required when billing shipment services", func() {
+		invoice := MakeValidEdi()
+		invoice.Header.RequestedPickupDate = nil
+		suite.NoError(invoice.CheckBusinessRules())
+
+		invoice.ServiceItems[0].L5.LadingDescription = "DLH"
+		violations := violationsOf(invoice.CheckBusinessRules())
+		suite.Len(violations, 1)
+		suite.Equal("Header.G62", violations[0].Segment)
+	})
+
+	suite.Run("every violation is reported", func() {
+		invoice := MakeValidEdi()
+		invoice.L3.PriceCents = 200
+		invoice.ServiceItems[0].FA1.AgencyQualifierCode = ""
+		invoice.GS.Date = ""
+
+		err := invoice.CheckBusinessRules()
+		suite.Len(violationsOf(err), 3)
+		suite.Contains(err.Error(), "EDI failed business rules")
+	})
+
+	suite.Run("violations fit in a TED segment", func() {
+		violation := RuleViolation{
+			Code:    edisegment.TEDErrorCodeMutuallyDefined,
+			Segment: "L3",
+			Message: strings.Repeat("x", 100),
+		}
+
+		ted := violation.TED()
+		suite.Equal(edisegment.TEDErrorCodeMutuallyDefined, ted.ApplicationErrorConditionCode)
+		suite.Len(ted.FreeFormMessage, 60)
+		suite.NoError(validate.Struct(ted))
+	})
+}
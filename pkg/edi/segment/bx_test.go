@@ -56,4 +56,11 @@ func (suite *SegmentSuite) TestValidateBX() {
 		suite.ValidateError(err, "StandardCarrierAlphaCode", "max")
 		suite.ValidateErrorLen(err, 2)
 	})
+
+	suite.Run("parse success", func() {
+		var bx BX
+		err := bx.Parse(validBX.StringArray()[1:])
+		suite.NoError(err)
+		suite.Equal(validBX, bx)
+	})
 }
@@ -48,9 +48,12 @@ func (s *G62) Parse(elements []string) error {
 		return err
 	}
 	s.Date = elements[1]
-	s.TimeQualifier, err = strconv.Atoi(elements[2])
-	if err != nil {
-		return err
+	// the time is optional and written as two empty elements when it is not set
+	if elements[2] != "" {
+		s.TimeQualifier, err = strconv.Atoi(elements[2])
+		if err != nil {
+			return err
+		}
 	}
 	s.Time = elements[3]
 	return nil
@@ -42,4 +42,11 @@ func (suite *SegmentSuite) TestValidateG62() {
 		suite.ValidateError(err, "Time", "datetime")
 		suite.ValidateErrorLen(err, 4)
 	})
+
+	suite.Run("parse a date without a time", func() {
+		var g62 G62
+		err := g62.Parse(validG62ScheduledPickupDate.StringArray()[1:])
+		suite.NoError(err)
+		suite.Equal(validG62ScheduledPickupDate, g62)
+	})
 }
@@ -77,24 +77,24 @@ func (s *L0) Parse(parts []string) error {
 	if err != nil {
 		return err
 	}
-	s.BilledRatedAsQuantity, err = strconv.ParseFloat(parts[1], 64)
+	s.BilledRatedAsQuantity, err = parseOptionalFloat(parts[1])
 	if err != nil {
 		return err
 	}
 	s.BilledRatedAsQualifier = parts[2]
 
-	if numElements == 9 {
-		s.Weight, err = strconv.ParseFloat(parts[3], 64)
+	if numElements > 3 {
+		s.Weight, err = parseOptionalFloat(parts[3])
 		if err != nil {
 			return err
 		}
 		s.WeightQualifier = parts[4]
-		s.Volume, err = strconv.ParseFloat(parts[5], 64)
+		s.Volume, err = parseOptionalFloat(parts[5])
 		if err != nil {
 			return err
 		}
 		s.VolumeUnitQualifier = parts[6]
-		s.LadingQuantity, err = strconv.Atoi(parts[7])
+		s.LadingQuantity, err = parseOptionalInt(parts[7])
 		if err != nil {
 			return err
 		}
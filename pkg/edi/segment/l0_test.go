@@ -109,4 +109,26 @@ func (suite *SegmentSuite) TestValidateL0() {
 		suite.ValidateError(err, "LadingQuantity", "max")
 		suite.ValidateErrorLen(err, 1)
 	})
+
+	suite.Run("parse empty numeric elements as zero", func() {
+		l0 := L0{
+			LadingLineItemNumber:   1,
+			BilledRatedAsQuantity:  373,
+			BilledRatedAsQualifier: "DM",
+			Weight:                 1349,
+			WeightQualifier:        "B",
+			WeightUnitCode:         "L",
+		}
+
+		var parsed L0
+		err := parsed.Parse(l0.StringArray()[1:])
+		suite.NoError(err)
+		suite.Equal(l0, parsed)
+
+		emptyL0 := L0{LadingLineItemNumber: 2}
+		var parsedEmpty L0
+		err = parsedEmpty.Parse(emptyL0.StringArray()[1:])
+		suite.NoError(err)
+		suite.Equal(emptyL0, parsedEmpty)
+	})
 }
@@ -32,7 +32,7 @@ func (s *L1) StringArray() []string {
 
 // Parse parses an X12 string that's split into an array into the L1 struct
 func (s *L1) Parse(elements []string) error {
-	expectedNumElements := 12
+	expectedNumElements := 4
 	if len(elements) != expectedNumElements {
 		return fmt.Errorf("L1: Wrong number of elements, expected %d, got %d", expectedNumElements, len(elements))
 	}
@@ -42,15 +42,15 @@ func (s *L1) Parse(elements []string) error {
 	if err != nil {
 		return err
 	}
-	freightRate, err := strconv.ParseFloat(elements[1], 64)
-	if err != nil {
-		return err
+	s.FreightRate = nil
+	if elements[1] != "" {
+		freightRate, err := strconv.ParseFloat(elements[1], 64)
+		if err != nil {
+			return err
+		}
+		s.FreightRate = &freightRate
 	}
-	s.FreightRate = &freightRate
 	s.RateValueQualifier = elements[2]
 	s.Charge, err = strconv.ParseInt(elements[3], 10, 64)
-	if err != nil {
-		return err
-	}
 	return err
 }
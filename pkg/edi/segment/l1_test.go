@@ -66,4 +66,16 @@ func (suite *SegmentSuite) TestValidateL1() {
 		suite.ValidateError(err, "Charge", "min")
 		suite.ValidateErrorLen(err, 3)
 	})
+
+	suite.Run("parse success", func() {
+		var l1 L1
+		err := l1.Parse(validL1.StringArray()[1:])
+		suite.NoError(err)
+		suite.Equal(validL1, l1)
+
+		var altL1 L1
+		err = altL1.Parse(altValidL1.StringArray()[1:])
+		suite.NoError(err)
+		suite.Equal(altValidL1, altL1)
+	})
 }
@@ -40,7 +40,7 @@ func (s *L3) Parse(parts []string) error {
 
 	var err error
 
-	s.Weight, err = strconv.ParseFloat(parts[0], 64)
+	s.Weight, err = parseOptionalFloat(parts[0])
 	if err != nil {
 		return err
 	}
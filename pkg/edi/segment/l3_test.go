@@ -60,4 +60,13 @@ func (suite *SegmentSuite) TestValidateL3() {
 		suite.ValidateError(err, "PriceCents", "min")
 		suite.ValidateErrorLen(err, 3)
 	})
+
+	suite.Run("parse without a weight", func() {
+		l3 := L3{PriceCents: 45423}
+
+		var parsed L3
+		err := parsed.Parse(l3.StringArray()[1:])
+		suite.NoError(err)
+		suite.Equal(l3, parsed)
+	})
 }
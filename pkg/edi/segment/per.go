@@ -32,8 +32,14 @@ func (s *PER) Parse(parts []string) error {
 	}
 
 	s.ContactFunctionCode = parts[0]
-	s.Name = parts[1]
-	s.CommunicationNumberQualifier = parts[2]
-	s.CommunicationNumber = parts[3]
+	if numElements > 1 {
+		s.Name = parts[1]
+	}
+	if numElements > 2 {
+		s.CommunicationNumberQualifier = parts[2]
+	}
+	if numElements > 3 {
+		s.CommunicationNumber = parts[3]
+	}
 	return nil
 }
@@ -29,3 +29,19 @@ func NxToFloat(s string, x int) (float64, error) {
 func FloatToNx(n float64, x int) string {
 	return strconv.FormatFloat(n*math.Pow10(x), 'f', 0, 64)
 }
+
+// parseOptionalFloat parses a numeric element that is left empty when its value is zero
+func parseOptionalFloat(s string) (float64, error) {
+	if s == "" {
+		return 0, nil
+	}
+	return strconv.ParseFloat(s, 64)
+}
+
+// parseOptionalInt parses an integer element that is left empty when its value is zero
+func parseOptionalInt(s string) (int, error) {
+	if s == "" {
+		return 0, nil
+	}
+	return strconv.Atoi(s)
+}
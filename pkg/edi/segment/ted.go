@@ -4,6 +4,20 @@ import (
 	"fmt"
 )
 
+// Application error condition codes Syncada sends in the TED segments of an 824
+const (
+	// TEDErrorCodeMissingData is sent when a required element or segment is missing
+	TEDErrorCodeMissingData = "007"
+	// TEDErrorCodeDuplicate is sent when a segment or invoice is duplicated
+	TEDErrorCodeDuplicate = "DUP"
+	// TEDErrorCodeInvalidIdentificationCode is sent when an identifier does not match what it refers to
+	TEDErrorCodeInvalidIdentificationCode = "IID"
+	// TEDErrorCodePreviouslyPaid is sent when the invoice has already been paid
+	TEDErrorCodePreviouslyPaid = "PPD"
+	// TEDErrorCodeMutuallyDefined is sent for any other business rule failure, described by the free form message
+	TEDErrorCodeMutuallyDefined = "ZZZ"
+)
+
 // TED represents the TED EDI segment
 type TED struct {
 	ApplicationErrorConditionCode string `validate:"oneof=007 812 832 DUP IID INC K MJ PPD T ZZZ"`
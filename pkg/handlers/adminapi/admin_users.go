@@ -37,6 +37,7 @@ type IndexAdminUsersHandler struct {
 	services.AdminUserListFetcher
 	services.NewQueryFilter
 	services.NewPagination
+	services.NewCursorPagination
 }
 
 // Handle retrieves a list of admin users
@@ -47,11 +48,17 @@ func (h IndexAdminUsersHandler) Handle(params adminuserop.IndexAdminUsersParams)
 			// Here is where NewQueryFilter will be used to create Filters from the 'filter' query param
 			queryFilters := []services.QueryFilter{}
 
-			pagination := h.NewPagination(params.Page, params.PerPage)
+			pagination, err := listPagination(h.NewPagination, h.NewCursorPagination, params.Page, params.PerPage, params.Cursor)
+			if err != nil {
+				return adminuserop.NewIndexAdminUsersBadRequest(), err
+			}
 			ordering := query.NewQueryOrder(params.Sort, params.Order)
 
 			adminUsers, err := h.AdminUserListFetcher.FetchAdminUserList(appCtx, queryFilters, nil, pagination, ordering)
 			if err != nil {
+				if isCursorSortError(err) {
+					return adminuserop.NewIndexAdminUsersBadRequest(), err
+				}
 				return handlers.ResponseForError(appCtx.Logger(), err), err
 			}
 
@@ -68,7 +75,11 @@ func (h IndexAdminUsersHandler) Handle(params adminuserop.IndexAdminUsersParams)
 				payload[i] = payloadForAdminUserModel(s)
 			}
 
-			return adminuserop.NewIndexAdminUsersOK().WithContentRange(fmt.Sprintf("admin users %d-%d/%d", pagination.Offset(), pagination.Offset()+queriedAdminUsersCount, totalAdminUsersCount)).WithPayload(payload), nil
+			response := adminuserop.NewIndexAdminUsersOK().WithContentRange(fmt.Sprintf("admin users %d-%d/%d", pagination.Offset(), pagination.Offset()+queriedAdminUsersCount, totalAdminUsersCount)).WithPayload(payload)
+			if nextCursor := query.NextCursor(&adminUsers, pagination, ordering); nextCursor != nil {
+				response = response.WithNextCursor(*nextCursor)
+			}
+			return response, nil
 		})
 }
 
@@ -4,6 +4,7 @@ import (
 	"log"
 
 	"github.com/go-openapi/loads"
+	"github.com/go-openapi/runtime"
 
 	"github.com/transcom/mymove/pkg/gen/adminapi"
 	adminops "github.com/transcom/mymove/pkg/gen/adminapi/adminoperations"
@@ -15,6 +16,8 @@ import (
 	electronicorder "github.com/transcom/mymove/pkg/services/electronic_order"
 	fetch "github.com/transcom/mymove/pkg/services/fetch"
 	"github.com/transcom/mymove/pkg/services/ghcrateengine"
+	"github.com/transcom/mymove/pkg/services/invoice"
+	lockmove "github.com/transcom/mymove/pkg/services/lock_move"
 	move "github.com/transcom/mymove/pkg/services/move"
 	movetaskorder "github.com/transcom/mymove/pkg/services/move_task_order"
 	mtoserviceitem "github.com/transcom/mymove/pkg/services/mto_service_item"
@@ -54,6 +57,7 @@ func NewAdminAPI(handlerConfig handlers.HandlerConfig) *adminops.MymoveAPI {
 	ppmEstimator := ppmshipment.NewEstimatePPM(handlerConfig.DTODPlanner(), &paymentrequest.RequestPaymentHelper{})
 
 	adminAPI.ServeError = handlers.ServeCustomError
+	adminAPI.CsvProducer = runtime.CSVProducer() // ExportTPPSReconciliation produces CSV
 
 	transportationOfficeFetcher := transportationoffice.NewTransportationOfficesFetcher()
 	userRolesCreator := usersroles.NewUsersRolesCreator()
@@ -64,6 +68,7 @@ func NewAdminAPI(handlerConfig handlers.HandlerConfig) *adminops.MymoveAPI {
 		requestedofficeusers.NewRequestedOfficeUsersListFetcher(queryBuilder),
 		query.NewQueryFilter,
 		pagination.NewPagination,
+		pagination.NewCursorPagination,
 		transportationOfficeFetcher,
 		newRolesFetcher,
 	}
@@ -87,6 +92,7 @@ func NewAdminAPI(handlerConfig handlers.HandlerConfig) *adminops.MymoveAPI {
 		rejectedofficeusers.NewRejectedOfficeUsersListFetcher(queryBuilder),
 		query.NewQueryFilter,
 		pagination.NewPagination,
+		pagination.NewCursorPagination,
 	}
 
 	adminAPI.RejectedOfficeUsersGetRejectedOfficeUserHandler = GetRejectedOfficeUserHandler{
@@ -101,6 +107,7 @@ func NewAdminAPI(handlerConfig handlers.HandlerConfig) *adminops.MymoveAPI {
 		officeuser.NewOfficeUsersListFetcher(queryBuilder),
 		query.NewQueryFilter,
 		pagination.NewPagination,
+		pagination.NewCursorPagination,
 	}
 
 	adminAPI.OfficeUsersGetOfficeUserHandler = GetOfficeUserHandler{
@@ -147,6 +154,7 @@ func NewAdminAPI(handlerConfig handlers.HandlerConfig) *adminops.MymoveAPI {
 		office.NewOfficeListFetcher(queryBuilder),
 		query.NewQueryFilter,
 		pagination.NewPagination,
+		pagination.NewCursorPagination,
 	}
 
 	adminAPI.TransportationOfficesGetOfficeByIDHandler = GetOfficeByIdHandler{
@@ -160,6 +168,7 @@ func NewAdminAPI(handlerConfig handlers.HandlerConfig) *adminops.MymoveAPI {
 		organization.NewOrganizationListFetcher(queryBuilder),
 		query.NewQueryFilter,
 		pagination.NewPagination,
+		pagination.NewCursorPagination,
 	}
 
 	adminAPI.ElectronicOrdersIndexElectronicOrdersHandler = IndexElectronicOrdersHandler{
@@ -167,6 +176,7 @@ func NewAdminAPI(handlerConfig handlers.HandlerConfig) *adminops.MymoveAPI {
 		electronicorder.NewElectronicOrderListFetcher(queryBuilder),
 		query.NewQueryFilter,
 		pagination.NewPagination,
+		pagination.NewCursorPagination,
 	}
 
 	adminAPI.ElectronicOrdersGetElectronicOrdersTotalsHandler = GetElectronicOrdersTotalsHandler{
@@ -180,6 +190,7 @@ func NewAdminAPI(handlerConfig handlers.HandlerConfig) *adminops.MymoveAPI {
 		adminuser.NewAdminUserListFetcher(queryBuilder),
 		query.NewQueryFilter,
 		pagination.NewPagination,
+		pagination.NewCursorPagination,
 	}
 
 	adminAPI.UsersUpdateUserHandler = UpdateUserHandler{
@@ -223,6 +234,7 @@ func NewAdminAPI(handlerConfig handlers.HandlerConfig) *adminops.MymoveAPI {
 		fetch.NewListFetcher(queryBuilder),
 		query.NewQueryFilter,
 		pagination.NewPagination,
+		pagination.NewCursorPagination,
 	}
 	adminAPI.UploadsGetUploadHandler = GetUploadHandler{
 		handlerConfig,
@@ -234,6 +246,36 @@ func NewAdminAPI(handlerConfig handlers.HandlerConfig) *adminops.MymoveAPI {
 		fetch.NewListFetcher(queryBuilder),
 		query.NewQueryFilter,
 		pagination.NewPagination,
+		pagination.NewCursorPagination,
+	}
+
+	adminAPI.AuditEventsIndexAuditEventsHandler = IndexAuditEventsHandler{
+		handlerConfig,
+		fetch.NewListFetcher(queryBuilder),
+		query.NewQueryFilter,
+		pagination.NewPagination,
+		pagination.NewCursorPagination,
+	}
+
+	rolePermissionsFetcher := roles.NewRolePermissionsFetcher()
+	adminAPI.PermissionsIndexPermissionsHandler = IndexPermissionsHandler{
+		handlerConfig,
+		rolePermissionsFetcher,
+	}
+
+	adminAPI.PermissionsGetRolePermissionsHandler = GetRolePermissionsHandler{
+		handlerConfig,
+		rolePermissionsFetcher,
+	}
+
+	adminAPI.PermissionsUpdateRolePermissionsHandler = UpdateRolePermissionsHandler{
+		handlerConfig,
+		roles.NewRolePermissionsUpdater(),
+	}
+
+	adminAPI.PermissionsSimulatePermissionHandler = SimulatePermissionHandler{
+		handlerConfig,
+		roles.NewPermissionSimulator(lockmove.NewMoveLockChecker(), move.NewMoveAccessChecker()),
 	}
 
 	adminAPI.MovesIndexMovesHandler = IndexMovesHandler{
@@ -241,6 +283,7 @@ func NewAdminAPI(handlerConfig handlers.HandlerConfig) *adminops.MymoveAPI {
 		move.NewMoveListFetcher(queryBuilder),
 		query.NewQueryFilter,
 		pagination.NewPagination,
+		pagination.NewCursorPagination,
 	}
 
 	moveRouter := move.NewMoveRouter(transportationoffice.NewTransportationOfficesFetcher())
@@ -264,6 +307,7 @@ func NewAdminAPI(handlerConfig handlers.HandlerConfig) *adminops.MymoveAPI {
 		clientcert.NewClientCertListFetcher(queryBuilder),
 		query.NewQueryFilter,
 		pagination.NewPagination,
+		pagination.NewCursorPagination,
 	}
 
 	adminAPI.ClientCertificatesGetClientCertificateHandler = GetClientCertHandler{
@@ -295,6 +339,7 @@ func NewAdminAPI(handlerConfig handlers.HandlerConfig) *adminops.MymoveAPI {
 		fetch.NewListFetcher(queryBuilder),
 		query.NewQueryFilter,
 		pagination.NewPagination,
+		pagination.NewCursorPagination,
 	}
 
 	adminAPI.WebhookSubscriptionsGetWebhookSubscriptionHandler = GetWebhookSubscriptionHandler{
@@ -315,6 +360,16 @@ func NewAdminAPI(handlerConfig handlers.HandlerConfig) *adminops.MymoveAPI {
 		query.NewQueryFilter,
 	}
 
+	adminAPI.WebhookSubscriptionsReplayWebhookSubscriptionHandler = ReplayWebhookSubscriptionHandler{
+		handlerConfig,
+		webhooksubscription.NewWebhookNotificationReplayer(queryBuilder),
+	}
+
+	adminAPI.WebhookSubscriptionsRotateWebhookSubscriptionSecretHandler = RotateWebhookSubscriptionSecretHandler{
+		handlerConfig,
+		webhooksubscription.NewWebhookSubscriptionSecretRotator(queryBuilder),
+	}
+
 	adminAPI.UserGetLoggedInAdminUserHandler = GetLoggedInUserHandler{
 		handlerConfig,
 		adminuser.NewAdminUserFetcher(queryBuilder),
@@ -326,6 +381,7 @@ func NewAdminAPI(handlerConfig handlers.HandlerConfig) *adminops.MymoveAPI {
 		fetch.NewListFetcher(queryBuilder),
 		query.NewQueryFilter,
 		pagination.NewPagination,
+		pagination.NewCursorPagination,
 	}
 
 	adminAPI.PaymentRequestSyncadaFilePaymentRequestSyncadaFileHandler = GetPaymentRequestSyncadaFileHandler{
@@ -335,9 +391,10 @@ func NewAdminAPI(handlerConfig handlers.HandlerConfig) *adminops.MymoveAPI {
 	}
 
 	adminAPI.EdiErrorsFetchEdiErrorsHandler = FetchEdiErrorsHandler{
-		HandlerConfig:   handlerConfig,
-		ediErrorFetcher: edierrors.NewEDIErrorFetcher(),
-		NewPagination:   pagination.NewPagination,
+		HandlerConfig:       handlerConfig,
+		ediErrorFetcher:     edierrors.NewEDIErrorFetcher(),
+		NewPagination:       pagination.NewPagination,
+		NewCursorPagination: pagination.NewCursorPagination,
 	}
 
 	adminAPI.SingleediErrorGetEdiErrorHandler = GetEdiErrorHandler{
@@ -345,5 +402,10 @@ func NewAdminAPI(handlerConfig handlers.HandlerConfig) *adminops.MymoveAPI {
 		ediErrorFetcher: edierrors.NewEDIErrorFetcher(),
 	}
 
+	adminAPI.TppsReconciliationExportTPPSReconciliationHandler = ExportTPPSReconciliationHandler{
+		handlerConfig,
+		invoice.NewTPPSReconciler(),
+	}
+
 	return adminAPI
 }
@@ -0,0 +1,121 @@
+package adminapi
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/transcom/mymove/pkg/appcontext"
+	auditeventsop "github.com/transcom/mymove/pkg/gen/adminapi/adminoperations/audit_events"
+	"github.com/transcom/mymove/pkg/gen/adminmessages"
+	"github.com/transcom/mymove/pkg/handlers"
+	"github.com/transcom/mymove/pkg/models"
+	"github.com/transcom/mymove/pkg/services"
+	"github.com/transcom/mymove/pkg/services/query"
+)
+
+func payloadForAuditEventModel(e models.AuditEvent) *adminmessages.AuditEvent {
+	payload := &adminmessages.AuditEvent{
+		ID:                   handlers.FmtUUID(e.ID),
+		EventType:            handlers.FmtString(e.EventType),
+		RecordType:           e.RecordType,
+		RecordID:             handlers.FmtUUIDPtr(e.RecordID),
+		ResponsibleUserID:    handlers.FmtUUIDPtr(e.ResponsibleUserID),
+		ResponsibleUserEmail: e.ResponsibleUserEmail,
+		ResponsibleUserName:  e.ResponsibleUserName,
+		FieldsChanged:        []string{},
+		ActiveValue:          e.ActiveValue,
+		TransactionID:        handlers.FmtInt64(e.TransactionID),
+		CreatedAt:            *handlers.FmtDateTime(e.CreatedAt),
+	}
+	if e.FieldsChanged != nil && *e.FieldsChanged != "" {
+		payload.FieldsChanged = strings.Split(*e.FieldsChanged, ",")
+	}
+	if e.Payload != nil {
+		payload.Payload = map[string]interface{}(e.Payload)
+	}
+	if e.BeforeData != nil {
+		payload.BeforeData = map[string]interface{}(e.BeforeData)
+	}
+	if e.AfterData != nil {
+		payload.AfterData = map[string]interface{}(e.AfterData)
+	}
+	return payload
+}
+
+// IndexAuditEventsHandler searches the persisted audit events
+type IndexAuditEventsHandler struct {
+	handlers.HandlerConfig
+	services.ListFetcher
+	services.NewQueryFilter
+	services.NewPagination
+	services.NewCursorPagination
+}
+
+var auditEventsFilterConverters = map[string]func(string) []services.QueryFilter{
+	"responsibleUserId": func(content string) []services.QueryFilter {
+		return []services.QueryFilter{query.NewQueryFilter("responsible_user_id", "=", content)}
+	},
+	"recordType": func(content string) []services.QueryFilter {
+		return []services.QueryFilter{query.NewQueryFilter("record_type", "=", content)}
+	},
+	"recordId": func(content string) []services.QueryFilter {
+		return []services.QueryFilter{query.NewQueryFilter("record_id", "=", content)}
+	},
+	"eventType": func(content string) []services.QueryFilter {
+		return []services.QueryFilter{query.NewQueryFilter("event_type", "=", content)}
+	},
+	"createdFrom": func(content string) []services.QueryFilter {
+		return []services.QueryFilter{query.NewQueryFilter("created_at", ">", content)}
+	},
+	"createdTo": func(content string) []services.QueryFilter {
+		return []services.QueryFilter{query.NewQueryFilter("created_at", "<", content)}
+	},
+}
+
+// Handle searches the audit events, newest first unless another order is asked for
+func (h IndexAuditEventsHandler) Handle(params auditeventsop.IndexAuditEventsParams) middleware.Responder {
+	return h.AuditableAppContextFromRequestWithErrors(params.HTTPRequest,
+		func(appCtx appcontext.AppContext) (middleware.Responder, error) {
+			queryFilters := generateQueryFilters(appCtx.Logger(), params.Filter, auditEventsFilterConverters)
+			pagination, err := listPagination(h.NewPagination, h.NewCursorPagination, params.Page, params.PerPage, params.Cursor)
+			if err != nil {
+				return auditeventsop.NewIndexAuditEventsBadRequest(), err
+			}
+			associations := query.NewQueryAssociations([]services.QueryAssociation{})
+
+			sort, order := params.Sort, params.Order
+			if sort == nil {
+				sort, order = models.StringPointer("created_at"), models.BoolPointer(false)
+			}
+			ordering := query.NewQueryOrder(sort, order)
+
+			var auditEvents models.AuditEvents
+			err = h.ListFetcher.FetchRecordList(appCtx, &auditEvents, queryFilters, associations, pagination, ordering)
+			if err != nil {
+				if isCursorSortError(err) {
+					return auditeventsop.NewIndexAuditEventsBadRequest(), err
+				}
+				return handlers.ResponseForError(appCtx.Logger(), err), err
+			}
+
+			totalAuditEventsCount, err := h.ListFetcher.FetchRecordCount(appCtx, &auditEvents, queryFilters)
+			if err != nil {
+				return handlers.ResponseForError(appCtx.Logger(), err), err
+			}
+
+			queriedAuditEventsCount := len(auditEvents)
+
+			payload := make(adminmessages.AuditEvents, queriedAuditEventsCount)
+			for i, e := range auditEvents {
+				payload[i] = payloadForAuditEventModel(e)
+			}
+
+			response := auditeventsop.NewIndexAuditEventsOK().WithContentRange(fmt.Sprintf("audit-events %d-%d/%d", pagination.Offset(), pagination.Offset()+queriedAuditEventsCount, totalAuditEventsCount)).WithPayload(payload)
+			if nextCursor := query.NextCursor(&auditEvents, pagination, ordering); nextCursor != nil {
+				response = response.WithNextCursor(*nextCursor)
+			}
+			return response, nil
+		})
+}
@@ -0,0 +1,127 @@
+package adminapi
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/gofrs/uuid"
+
+	"github.com/transcom/mymove/pkg/factory"
+	auditeventsop "github.com/transcom/mymove/pkg/gen/adminapi/adminoperations/audit_events"
+	"github.com/transcom/mymove/pkg/models"
+	fetch "github.com/transcom/mymove/pkg/services/fetch"
+	"github.com/transcom/mymove/pkg/services/pagination"
+	"github.com/transcom/mymove/pkg/services/query"
+)
+
+func (suite *HandlerSuite) TestIndexAuditEventsHandler() {
+	setupHandler := func() IndexAuditEventsHandler {
+		return IndexAuditEventsHandler{
+			HandlerConfig:  suite.NewHandlerConfig(),
+			NewQueryFilter: query.NewQueryFilter,
+			ListFetcher:    fetch.NewListFetcher(query.NewQueryBuilder()),
+			NewPagination:  pagination.NewPagination,
+		}
+	}
+
+	createAuditEvent := func(event models.AuditEvent) models.AuditEvent {
+		event.TransactionID = 1
+		suite.MustCreate(&event)
+		return event
+	}
+
+	suite.Run("finds who changed an office user's roles, newest first", func() {
+		officeUser := factory.BuildOfficeUser(suite.DB(), nil, nil)
+		adminUserID := uuid.Must(uuid.NewV4())
+		roleID := uuid.Must(uuid.NewV4()).String()
+		earlier := createAuditEvent(models.AuditEvent{
+			EventType:         "audit_patch_office_users",
+			RecordType:        models.StringPointer("OfficeUser"),
+			RecordID:          &officeUser.ID,
+			ResponsibleUserID: &adminUserID,
+			FieldsChanged:     models.StringPointer("roles"),
+			CreatedAt:         time.Now().Add(-time.Hour),
+		})
+		later := createAuditEvent(models.AuditEvent{
+			EventType:         "audit_patch_office_users",
+			RecordType:        models.StringPointer("OfficeUser"),
+			RecordID:          &officeUser.ID,
+			ResponsibleUserID: &adminUserID,
+			FieldsChanged:     models.StringPointer("first_name,roles"),
+			AfterData: models.JSONMap{
+				"users_roles": map[string]interface{}{
+					uuid.Must(uuid.NewV4()).String(): map[string]interface{}{"role_id": roleID},
+				},
+			},
+		})
+		// a change to another record
+		createAuditEvent(models.AuditEvent{
+			EventType:         "audit_patch_office_users",
+			RecordType:        models.StringPointer("OfficeUser"),
+			RecordID:          models.UUIDPointer(uuid.Must(uuid.NewV4())),
+			ResponsibleUserID: &adminUserID,
+		})
+
+		filter := fmt.Sprintf(`{"recordType": "OfficeUser", "recordId": "%s"}`, officeUser.ID)
+		params := auditeventsop.IndexAuditEventsParams{
+			HTTPRequest: suite.setupAuthenticatedRequest("GET", "/audit-events"),
+			Filter:      &filter,
+		}
+
+		response := setupHandler().Handle(params)
+
+		suite.IsType(&auditeventsop.IndexAuditEventsOK{}, response)
+		okResponse := response.(*auditeventsop.IndexAuditEventsOK)
+		suite.Len(okResponse.Payload, 2)
+		suite.Equal(later.ID.String(), okResponse.Payload[0].ID.String())
+		suite.Equal(earlier.ID.String(), okResponse.Payload[1].ID.String())
+		suite.Equal(adminUserID.String(), okResponse.Payload[0].ResponsibleUserID.String())
+		suite.Equal([]string{"first_name", "roles"}, okResponse.Payload[0].FieldsChanged)
+		suite.Contains(okResponse.Payload[0].AfterData, "users_roles")
+		suite.Nil(okResponse.Payload[1].AfterData)
+		suite.Equal("audit-events 0-2/2", okResponse.ContentRange)
+	})
+
+	suite.Run("filters by responsible user, event type and date range", func() {
+		adminUserID := uuid.Must(uuid.NewV4())
+		now := time.Now()
+		match := createAuditEvent(models.AuditEvent{
+			EventType:         "audit_post_admin_users",
+			ResponsibleUserID: &adminUserID,
+			CreatedAt:         now.Add(-2 * time.Hour),
+		})
+		// too old
+		createAuditEvent(models.AuditEvent{
+			EventType:         "audit_post_admin_users",
+			ResponsibleUserID: &adminUserID,
+			CreatedAt:         now.Add(-48 * time.Hour),
+		})
+		// another event type
+		createAuditEvent(models.AuditEvent{
+			EventType:         "audit_patch_admin_users",
+			ResponsibleUserID: &adminUserID,
+			CreatedAt:         now.Add(-2 * time.Hour),
+		})
+		// another user
+		createAuditEvent(models.AuditEvent{
+			EventType:         "audit_post_admin_users",
+			ResponsibleUserID: models.UUIDPointer(uuid.Must(uuid.NewV4())),
+			CreatedAt:         now.Add(-2 * time.Hour),
+		})
+
+		filter := fmt.Sprintf(`{"responsibleUserId": "%s", "eventType": "audit_post_admin_users", "createdFrom": "%s", "createdTo": "%s"}`,
+			adminUserID, now.Add(-24*time.Hour).Format(time.RFC3339), now.Format(time.RFC3339))
+		params := auditeventsop.IndexAuditEventsParams{
+			HTTPRequest: suite.setupAuthenticatedRequest("GET", "/audit-events"),
+			Filter:      &filter,
+		}
+
+		response := setupHandler().Handle(params)
+
+		suite.IsType(&auditeventsop.IndexAuditEventsOK{}, response)
+		okResponse := response.(*auditeventsop.IndexAuditEventsOK)
+		if suite.Len(okResponse.Payload, 1) {
+			suite.Equal(match.ID.String(), okResponse.Payload[0].ID.String())
+		}
+	})
+}
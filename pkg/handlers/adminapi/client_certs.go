@@ -49,6 +49,7 @@ type IndexClientCertsHandler struct {
 	services.ClientCertListFetcher
 	services.NewQueryFilter
 	services.NewPagination
+	services.NewCursorPagination
 }
 
 // Handle retrieves a list of client certificates.
@@ -60,12 +61,18 @@ func (h IndexClientCertsHandler) Handle(params clientcertop.IndexClientCertifica
 			// Here is where NewQueryFilter will be used to create Filters from the 'filter' query param
 			queryFilters := generateQueryFilters(appCtx.Logger(), params.Filter, clientCertFilterConverters)
 
-			pagination := h.NewPagination(params.Page, params.PerPage)
+			pagination, err := listPagination(h.NewPagination, h.NewCursorPagination, params.Page, params.PerPage, params.Cursor)
+			if err != nil {
+				return clientcertop.NewIndexClientCertificatesBadRequest(), err
+			}
 			ordering := query.NewQueryOrder(params.Sort, params.Order)
 
 			var clientCerts []models.ClientCert
-			clientCerts, err := h.ClientCertListFetcher.FetchClientCertList(appCtx, queryFilters, nil, pagination, ordering)
+			clientCerts, err = h.ClientCertListFetcher.FetchClientCertList(appCtx, queryFilters, nil, pagination, ordering)
 			if err != nil {
+				if isCursorSortError(err) {
+					return clientcertop.NewIndexClientCertificatesBadRequest(), err
+				}
 				return handlers.ResponseForError(appCtx.Logger(), err), err
 			}
 
@@ -82,7 +89,11 @@ func (h IndexClientCertsHandler) Handle(params clientcertop.IndexClientCertifica
 				payload[i] = payloadForClientCertModel(s)
 			}
 
-			return clientcertop.NewIndexClientCertificatesOK().WithContentRange(fmt.Sprintf("office users %d-%d/%d", pagination.Offset(), pagination.Offset()+queriedClientCertsCount, totalOfficeUsersCount)).WithPayload(payload), nil
+			response := clientcertop.NewIndexClientCertificatesOK().WithContentRange(fmt.Sprintf("office users %d-%d/%d", pagination.Offset(), pagination.Offset()+queriedClientCertsCount, totalOfficeUsersCount)).WithPayload(payload)
+			if nextCursor := query.NextCursor(&clientCerts, pagination, ordering); nextCursor != nil {
+				response = response.WithNextCursor(*nextCursor)
+			}
+			return response, nil
 
 		})
 }
@@ -14,6 +14,8 @@ import (
 	"github.com/transcom/mymove/pkg/handlers"
 	"github.com/transcom/mymove/pkg/models"
 	"github.com/transcom/mymove/pkg/services"
+	edierrors "github.com/transcom/mymove/pkg/services/edi_errors"
+	"github.com/transcom/mymove/pkg/services/query"
 )
 
 func payloadForEdiErrorModel(e models.EdiError) *adminmessages.EdiError {
@@ -32,15 +34,22 @@ type FetchEdiErrorsHandler struct {
 	handlers.HandlerConfig
 	ediErrorFetcher services.EDIErrorFetcher
 	services.NewPagination
+	services.NewCursorPagination
 }
 
 // Handle retrieves a list of edi errors
 func (h FetchEdiErrorsHandler) Handle(params edierrorsop.FetchEdiErrorsParams) middleware.Responder {
 	return h.AuditableAppContextFromRequestWithErrors(params.HTTPRequest, func(appCtx appcontext.AppContext) (middleware.Responder, error) {
-		pagination := h.NewPagination(params.Page, params.PerPage)
+		pagination, err := listPagination(h.NewPagination, h.NewCursorPagination, params.Page, params.PerPage, params.Cursor)
+		if err != nil {
+			return edierrorsop.NewFetchEdiErrorsBadRequest(), err
+		}
 
 		ediErrors, totalCount, err := h.ediErrorFetcher.FetchEdiErrors(appCtx, pagination)
 		if err != nil {
+			if isCursorSortError(err) {
+				return edierrorsop.NewFetchEdiErrorsBadRequest(), err
+			}
 			return handlers.ResponseForError(appCtx.Logger(), err), err
 		}
 
@@ -54,9 +63,13 @@ func (h FetchEdiErrorsHandler) Handle(params edierrorsop.FetchEdiErrorsParams) m
 
 		contentRange := fmt.Sprintf("edi_errors %d-%d/%d", start, end, totalCount)
 
-		return edierrorsop.NewFetchEdiErrorsOK().
+		response := edierrorsop.NewFetchEdiErrorsOK().
 			WithContentRange(contentRange).
-			WithPayload(payload), nil
+			WithPayload(payload)
+		if nextCursor := query.NextCursor(&ediErrors, pagination, edierrors.ListOrder); nextCursor != nil {
+			response = response.WithNextCursor(*nextCursor)
+		}
+		return response, nil
 	})
 }
 
@@ -31,6 +31,7 @@ type IndexElectronicOrdersHandler struct {
 	services.ElectronicOrderListFetcher
 	services.NewQueryFilter
 	services.NewPagination
+	services.NewCursorPagination
 }
 
 // Handle returns an index of electronic orders
@@ -39,11 +40,17 @@ func (h IndexElectronicOrdersHandler) Handle(params electronicorderop.IndexElect
 		func(appCtx appcontext.AppContext) (middleware.Responder, error) {
 			queryFilters := []services.QueryFilter{}
 
-			pagination := h.NewPagination(params.Page, params.PerPage)
+			pagination, err := listPagination(h.NewPagination, h.NewCursorPagination, params.Page, params.PerPage, params.Cursor)
+			if err != nil {
+				return electronicorderop.NewIndexElectronicOrdersBadRequest(), err
+			}
 			ordering := query.NewQueryOrder(params.Sort, params.Order)
 
 			electronicOrders, err := h.ElectronicOrderListFetcher.FetchElectronicOrderList(appCtx, queryFilters, nil, pagination, ordering)
 			if err != nil {
+				if isCursorSortError(err) {
+					return electronicorderop.NewIndexElectronicOrdersBadRequest(), err
+				}
 				return handlers.ResponseForError(appCtx.Logger(), err), err
 			}
 
@@ -59,7 +66,11 @@ func (h IndexElectronicOrdersHandler) Handle(params electronicorderop.IndexElect
 				payload[i] = payloadForElectronicOrderModel(s)
 			}
 
-			return electronicorderop.NewIndexElectronicOrdersOK().WithContentRange(fmt.Sprintf("electronic_orders %d-%d/%d", pagination.Offset(), pagination.Offset()+queriedOfficeUsersCount, totalElectronicOrdersCount)).WithPayload(payload), nil
+			response := electronicorderop.NewIndexElectronicOrdersOK().WithContentRange(fmt.Sprintf("electronic_orders %d-%d/%d", pagination.Offset(), pagination.Offset()+queriedOfficeUsersCount, totalElectronicOrdersCount)).WithPayload(payload)
+			if nextCursor := query.NextCursor(&electronicOrders, pagination, ordering); nextCursor != nil {
+				response = response.WithNextCursor(*nextCursor)
+			}
+			return response, nil
 		})
 }
 
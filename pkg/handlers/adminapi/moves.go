@@ -25,6 +25,7 @@ type IndexMovesHandler struct {
 	services.MoveListFetcher
 	services.NewQueryFilter
 	services.NewPagination
+	services.NewCursorPagination
 }
 
 func payloadForMoveModel(move models.Move) *adminmessages.Move {
@@ -62,7 +63,10 @@ func (h IndexMovesHandler) Handle(params moveop.IndexMovesParams) middleware.Res
 	return h.AuditableAppContextFromRequestWithErrors(params.HTTPRequest,
 		func(appCtx appcontext.AppContext) (middleware.Responder, error) {
 
-			pagination := h.NewPagination(params.Page, params.PerPage)
+			pagination, err := listPagination(h.NewPagination, h.NewCursorPagination, params.Page, params.PerPage, params.Cursor)
+			if err != nil {
+				return moveop.NewIndexMovesBadRequest(), err
+			}
 			queryFilters := generateQueryFilters(appCtx.Logger(), params.Filter, locatorFilterConverters)
 			queryAssociations := []services.QueryAssociation{
 				query.NewQueryAssociation("Orders.ServiceMember"),
@@ -72,6 +76,9 @@ func (h IndexMovesHandler) Handle(params moveop.IndexMovesParams) middleware.Res
 			associations := query.NewQueryAssociationsPreload(queryAssociations)
 			moves, err := h.MoveListFetcher.FetchMoveList(appCtx, queryFilters, associations, pagination, ordering)
 			if err != nil {
+				if isCursorSortError(err) {
+					return moveop.NewIndexMovesBadRequest(), err
+				}
 				return handlers.ResponseForError(appCtx.Logger(), err), err
 			}
 			movesCount := len(moves)
@@ -86,7 +93,11 @@ func (h IndexMovesHandler) Handle(params moveop.IndexMovesParams) middleware.Res
 				payload[i] = payloadForMoveModel(s)
 			}
 
-			return moveop.NewIndexMovesOK().WithContentRange(fmt.Sprintf("moves %d-%d/%d", pagination.Offset(), pagination.Offset()+movesCount, totalMoveCount)).WithPayload(payload), nil
+			response := moveop.NewIndexMovesOK().WithContentRange(fmt.Sprintf("moves %d-%d/%d", pagination.Offset(), pagination.Offset()+movesCount, totalMoveCount)).WithPayload(payload)
+			if nextCursor := query.NextCursor(&moves, pagination, ordering); nextCursor != nil {
+				response = response.WithNextCursor(*nextCursor)
+			}
+			return response, nil
 		})
 }
 
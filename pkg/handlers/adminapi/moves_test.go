@@ -71,6 +71,60 @@ func (suite *HandlerSuite) TestIndexMovesHandler() {
 
 		suite.CheckErrorResponse(response, http.StatusNotFound, expectedError.Error())
 	})
+	suite.Run("pages by cursor", func() {
+		for i := 0; i < 3; i++ {
+			factory.BuildMove(suite.DB(), nil, nil)
+		}
+		handler := IndexMovesHandler{
+			HandlerConfig:       suite.NewHandlerConfig(),
+			NewQueryFilter:      query.NewQueryFilter,
+			MoveListFetcher:     move.NewMoveListFetcher(query.NewQueryBuilder()),
+			NewPagination:       pagination.NewPagination,
+			NewCursorPagination: pagination.NewCursorPagination,
+		}
+
+		var ids []string
+		params := moveop.IndexMovesParams{
+			HTTPRequest: suite.setupAuthenticatedRequest("GET", "/moves"),
+			PerPage:     models.Int64Pointer(2),
+			Sort:        models.StringPointer("locator"),
+			Order:       models.BoolPointer(true),
+			Cursor:      models.StringPointer(""),
+		}
+		response := handler.Handle(params)
+		suite.IsType(&moveop.IndexMovesOK{}, response)
+		okResponse := response.(*moveop.IndexMovesOK)
+		suite.Len(okResponse.Payload, 2)
+		suite.NotEmpty(okResponse.NextCursor)
+		for _, m := range okResponse.Payload {
+			ids = append(ids, m.ID.String())
+		}
+
+		params.Cursor = &okResponse.NextCursor
+		response = handler.Handle(params)
+		suite.IsType(&moveop.IndexMovesOK{}, response)
+		okResponse = response.(*moveop.IndexMovesOK)
+		suite.Len(okResponse.Payload, 1)
+		suite.Empty(okResponse.NextCursor)
+		suite.NotContains(ids, okResponse.Payload[0].ID.String())
+	})
+	suite.Run("rejects a cursor it didn't make", func() {
+		handler := IndexMovesHandler{
+			HandlerConfig:       suite.NewHandlerConfig(),
+			NewQueryFilter:      query.NewQueryFilter,
+			MoveListFetcher:     move.NewMoveListFetcher(query.NewQueryBuilder()),
+			NewPagination:       pagination.NewPagination,
+			NewCursorPagination: pagination.NewCursorPagination,
+		}
+		params := moveop.IndexMovesParams{
+			HTTPRequest: suite.setupAuthenticatedRequest("GET", "/moves"),
+			Cursor:      models.StringPointer("not a cursor!"),
+		}
+
+		response := handler.Handle(params)
+
+		suite.IsType(&moveop.IndexMovesBadRequest{}, response)
+	})
 }
 
 func (suite *HandlerSuite) TestIndexMovesHandlerHelpers() {
@@ -31,6 +31,7 @@ type IndexNotificationsHandler struct {
 	services.ListFetcher
 	services.NewQueryFilter
 	services.NewPagination
+	services.NewCursorPagination
 }
 
 var notificationsFilterConverters = map[string]func(string) []services.QueryFilter{
@@ -44,7 +45,10 @@ func (h IndexNotificationsHandler) Handle(params notificationsop.IndexNotificati
 	return h.AuditableAppContextFromRequestWithErrors(params.HTTPRequest,
 		func(appCtx appcontext.AppContext) (middleware.Responder, error) {
 			queryFilters := generateQueryFilters(appCtx.Logger(), params.Filter, notificationsFilterConverters)
-			pagination := h.NewPagination(params.Page, params.PerPage)
+			pagination, err := listPagination(h.NewPagination, h.NewCursorPagination, params.Page, params.PerPage, params.Cursor)
+			if err != nil {
+				return notificationsop.NewIndexNotificationsBadRequest(), err
+			}
 			queryAssociations := []services.QueryAssociation{
 				query.NewQueryAssociation("ServiceMember.User"),
 			}
@@ -52,8 +56,11 @@ func (h IndexNotificationsHandler) Handle(params notificationsop.IndexNotificati
 			ordering := query.NewQueryOrder(params.Sort, params.Order)
 
 			var notifications []models.Notification
-			err := h.ListFetcher.FetchRecordList(appCtx, &notifications, queryFilters, associations, pagination, ordering)
+			err = h.ListFetcher.FetchRecordList(appCtx, &notifications, queryFilters, associations, pagination, ordering)
 			if err != nil {
+				if isCursorSortError(err) {
+					return notificationsop.NewIndexNotificationsBadRequest(), err
+				}
 				return handlers.ResponseForError(appCtx.Logger(), err), err
 			}
 
@@ -70,6 +77,10 @@ func (h IndexNotificationsHandler) Handle(params notificationsop.IndexNotificati
 				payload[i] = payloadForNotificationModel(s)
 			}
 
-			return notificationsop.NewIndexNotificationsOK().WithContentRange(fmt.Sprintf("notifications %d-%d/%d", pagination.Offset(), pagination.Offset()+queriedNotificationsCount, totalNotificationsCount)).WithPayload(payload), nil
+			response := notificationsop.NewIndexNotificationsOK().WithContentRange(fmt.Sprintf("notifications %d-%d/%d", pagination.Offset(), pagination.Offset()+queriedNotificationsCount, totalNotificationsCount)).WithPayload(payload)
+			if nextCursor := query.NextCursor(&notifications, pagination, ordering); nextCursor != nil {
+				response = response.WithNextCursor(*nextCursor)
+			}
+			return response, nil
 		})
 }
@@ -145,6 +145,7 @@ type IndexOfficeUsersHandler struct {
 	services.OfficeUserListFetcher
 	services.NewQueryFilter
 	services.NewPagination
+	services.NewCursorPagination
 }
 
 var officeUserFilterConverters = map[string]func(string) func(*pop.Query){
@@ -210,11 +211,17 @@ func (h IndexOfficeUsersHandler) Handle(params officeuserop.IndexOfficeUsersPara
 				}
 			}
 
-			pagination := h.NewPagination(params.Page, params.PerPage)
+			pagination, err := listPagination(h.NewPagination, h.NewCursorPagination, params.Page, params.PerPage, params.Cursor)
+			if err != nil {
+				return officeuserop.NewIndexOfficeUsersBadRequest(), err
+			}
 			ordering := query.NewQueryOrder(params.Sort, params.Order)
 
 			officeUsers, count, err := h.OfficeUserListFetcher.FetchOfficeUsersList(appCtx, filterFuncs, pagination, ordering)
 			if err != nil {
+				if isCursorSortError(err) {
+					return officeuserop.NewIndexOfficeUsersBadRequest(), err
+				}
 				return handlers.ResponseForError(appCtx.Logger(), err), err
 			}
 
@@ -226,7 +233,11 @@ func (h IndexOfficeUsersHandler) Handle(params officeuserop.IndexOfficeUsersPara
 				payload[i] = payloadForOfficeUserModel(s)
 			}
 
-			return officeuserop.NewIndexOfficeUsersOK().WithContentRange(fmt.Sprintf("office users %d-%d/%d", pagination.Offset(), pagination.Offset()+queriedOfficeUsersCount, count)).WithPayload(payload), nil
+			response := officeuserop.NewIndexOfficeUsersOK().WithContentRange(fmt.Sprintf("office users %d-%d/%d", pagination.Offset(), pagination.Offset()+queriedOfficeUsersCount, count)).WithPayload(payload)
+			if nextCursor := query.NextCursor(&officeUsers, pagination, ordering); nextCursor != nil {
+				response = response.WithNextCursor(*nextCursor)
+			}
+			return response, nil
 		})
 }
 
@@ -298,6 +298,44 @@ func (suite *HandlerSuite) TestIndexOfficeUsersHandler() {
 		suite.Equal(strfmt.UUID(transportationOffice2.ID.String()), *okResponse.Payload[0].TransportationOfficeID)
 
 	})
+
+	suite.Run("pages by cursor", func() {
+		for i := 0; i < 3; i++ {
+			factory.BuildOfficeUserWithRoles(suite.DB(), factory.GetTraitApprovedOfficeUser(), []roles.RoleType{roles.RoleTypeQae})
+		}
+		handler := IndexOfficeUsersHandler{
+			HandlerConfig:         suite.NewHandlerConfig(),
+			NewQueryFilter:        query.NewQueryFilter,
+			OfficeUserListFetcher: officeuser.NewOfficeUsersListFetcher(query.NewQueryBuilder()),
+			NewPagination:         pagination.NewPagination,
+			NewCursorPagination:   pagination.NewCursorPagination,
+		}
+
+		var ids []string
+		params := officeuserop.IndexOfficeUsersParams{
+			HTTPRequest: suite.setupAuthenticatedRequest("GET", "/office_users"),
+			PerPage:     models.Int64Pointer(2),
+			Sort:        models.StringPointer("email"),
+			Order:       models.BoolPointer(true),
+			Cursor:      models.StringPointer(""),
+		}
+		response := handler.Handle(params)
+		suite.IsType(&officeuserop.IndexOfficeUsersOK{}, response)
+		okResponse := response.(*officeuserop.IndexOfficeUsersOK)
+		suite.Len(okResponse.Payload, 2)
+		suite.NotEmpty(okResponse.NextCursor)
+		for _, officeUser := range okResponse.Payload {
+			ids = append(ids, officeUser.ID.String())
+		}
+
+		params.Cursor = &okResponse.NextCursor
+		response = handler.Handle(params)
+		suite.IsType(&officeuserop.IndexOfficeUsersOK{}, response)
+		okResponse = response.(*officeuserop.IndexOfficeUsersOK)
+		suite.Len(okResponse.Payload, 1)
+		suite.Empty(okResponse.NextCursor)
+		suite.NotContains(ids, okResponse.Payload[0].ID.String())
+	})
 }
 
 func (suite *HandlerSuite) TestGetOfficeUserHandler() {
@@ -945,6 +983,17 @@ func (suite *HandlerSuite) TestUpdateOfficeUserHandler() {
 		suite.Equal(supervisorPrivilegeName, okResponse.Payload.Privileges[0].PrivilegeName)
 		suite.Equal(officeUser.LastName, *okResponse.Payload.LastName) // should not have been updated
 		suite.Equal(officeUser.Email, *okResponse.Payload.Email)       // should not have been updated
+
+		// the audit event records the columns the update changed. The office user was created in the
+		// same test transaction, so there is no before for it.
+		var event models.AuditEvent
+		suite.NoError(suite.DB().Where("record_id = ?", officeUser.ID).First(&event))
+		suite.NotZero(event.TransactionID)
+		after := event.AfterData["office_users"].(map[string]interface{})[officeUser.ID.String()].(map[string]interface{})
+		suite.Equal(firstName, after["first_name"])
+		suite.Equal(middleInitials, after["middle_initials"])
+		suite.Equal(telephone, after["telephone"])
+		suite.Equal(transportationOffice.ID.String(), after["transportation_office_id"])
 	})
 
 	suite.Run("Update fails due to bad Transportation Office", func() {
@@ -31,6 +31,7 @@ type IndexOfficesHandler struct {
 	services.OfficeListFetcher
 	services.NewQueryFilter
 	services.NewPagination
+	services.NewCursorPagination
 }
 
 var officesFilterConverters = map[string]func(string) []services.QueryFilter{
@@ -47,11 +48,17 @@ func (h IndexOfficesHandler) Handle(params transportation_officesop.IndexOffices
 			// Here is where NewQueryFilter will be used to create Filters from the 'filter' query param
 			queryFilters := generateQueryFilters(appCtx.Logger(), params.Filter, officesFilterConverters)
 
-			pagination := h.NewPagination(params.Page, params.PerPage)
+			pagination, err := listPagination(h.NewPagination, h.NewCursorPagination, params.Page, params.PerPage, params.Cursor)
+			if err != nil {
+				return transportation_officesop.NewIndexOfficesBadRequest(), err
+			}
 			ordering := query.NewQueryOrder(params.Sort, params.Order)
 
 			offices, err := h.OfficeListFetcher.FetchOfficeList(appCtx, queryFilters, nil, pagination, ordering)
 			if err != nil {
+				if isCursorSortError(err) {
+					return transportation_officesop.NewIndexOfficesBadRequest(), err
+				}
 				return handlers.ResponseForError(appCtx.Logger(), err), err
 			}
 
@@ -67,7 +74,11 @@ func (h IndexOfficesHandler) Handle(params transportation_officesop.IndexOffices
 				payload[i] = payloadForOfficeModel(s)
 			}
 
-			return transportation_officesop.NewIndexOfficesOK().WithContentRange(fmt.Sprintf("offices %d-%d/%d", pagination.Offset(), pagination.Offset()+queriedOfficesCount, totalOfficesCount)).WithPayload(payload), nil
+			response := transportation_officesop.NewIndexOfficesOK().WithContentRange(fmt.Sprintf("offices %d-%d/%d", pagination.Offset(), pagination.Offset()+queriedOfficesCount, totalOfficesCount)).WithPayload(payload)
+			if nextCursor := query.NextCursor(&offices, pagination, ordering); nextCursor != nil {
+				response = response.WithNextCursor(*nextCursor)
+			}
+			return response, nil
 		})
 }
 
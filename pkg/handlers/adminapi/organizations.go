@@ -31,6 +31,7 @@ type IndexOrganizationsHandler struct {
 	services.OrganizationListFetcher
 	services.NewQueryFilter
 	services.NewPagination
+	services.NewCursorPagination
 }
 
 // Handle retrieves a list of organizations
@@ -40,11 +41,17 @@ func (h IndexOrganizationsHandler) Handle(params organizationop.IndexOrganizatio
 			// Here is where NewQueryFilter will be used to create Filters from the 'filter' query param
 			queryFilters := []services.QueryFilter{}
 
-			pagination := h.NewPagination(params.Page, params.PerPage)
+			pagination, err := listPagination(h.NewPagination, h.NewCursorPagination, params.Page, params.PerPage, params.Cursor)
+			if err != nil {
+				return organizationop.NewIndexOrganizationsBadRequest(), err
+			}
 			ordering := query.NewQueryOrder(params.Sort, params.Order)
 
 			organizations, err := h.OrganizationListFetcher.FetchOrganizationList(appCtx, queryFilters, nil, pagination, ordering)
 			if err != nil {
+				if isCursorSortError(err) {
+					return organizationop.NewIndexOrganizationsBadRequest(), err
+				}
 				return handlers.ResponseForError(appCtx.Logger(), err), err
 			}
 
@@ -60,6 +67,10 @@ func (h IndexOrganizationsHandler) Handle(params organizationop.IndexOrganizatio
 				payload[i] = payloadForOrganizationModel(s)
 			}
 
-			return organizationop.NewIndexOrganizationsOK().WithContentRange(fmt.Sprintf("organizations %d-%d/%d", pagination.Offset(), pagination.Offset()+queriedOrganizationsCount, totalOrganizationsCount)).WithPayload(payload), nil
+			response := organizationop.NewIndexOrganizationsOK().WithContentRange(fmt.Sprintf("organizations %d-%d/%d", pagination.Offset(), pagination.Offset()+queriedOrganizationsCount, totalOrganizationsCount)).WithPayload(payload)
+			if nextCursor := query.NextCursor(&organizations, pagination, ordering); nextCursor != nil {
+				response = response.WithNextCursor(*nextCursor)
+			}
+			return response, nil
 		})
 }
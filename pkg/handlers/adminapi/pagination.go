@@ -0,0 +1,21 @@
+package adminapi
+
+import (
+	"github.com/transcom/mymove/pkg/apperror"
+	"github.com/transcom/mymove/pkg/services"
+)
+
+// listPagination returns the pagination an index endpoint was asked for: the records after the cursor when
+// one is given, otherwise the page of perPage records
+func listPagination(newPagination services.NewPagination, newCursorPagination services.NewCursorPagination, page *int64, perPage *int64, cursor *string) (services.Pagination, error) {
+	if cursor != nil {
+		return newCursorPagination(cursor, perPage)
+	}
+	return newPagination(page, perPage), nil
+}
+
+// isCursorSortError reports whether fetching a list failed because its cursor was made for another sort order
+func isCursorSortError(err error) bool {
+	_, ok := err.(apperror.InvalidInputError)
+	return ok
+}
@@ -15,14 +15,18 @@ func WebhookSubscriptionPayload(sub models.WebhookSubscription) *adminmessages.W
 	status := adminmessages.WebhookSubscriptionStatus(sub.Status)
 
 	return &adminmessages.WebhookSubscription{
-		ID:           *handlers.FmtUUID(sub.ID),
-		SubscriberID: handlers.FmtUUID(sub.SubscriberID),
-		CallbackURL:  &sub.CallbackURL,
-		Severity:     &severity,
-		EventKey:     &sub.EventKey,
-		Status:       &status,
-		CreatedAt:    strfmt.DateTime(sub.CreatedAt),
-		UpdatedAt:    strfmt.DateTime(sub.UpdatedAt),
-		ETag:         etag.GenerateEtag(sub.UpdatedAt),
+		ID:                  *handlers.FmtUUID(sub.ID),
+		SubscriberID:        handlers.FmtUUID(sub.SubscriberID),
+		CallbackURL:         &sub.CallbackURL,
+		Severity:            &severity,
+		EventKey:            &sub.EventKey,
+		Status:              &status,
+		ConsecutiveFailures: int64(sub.ConsecutiveFailures),
+		NextAttemptAt:       handlers.FmtDateTimePtr(sub.NextAttemptAt),
+		CreatedAt:           strfmt.DateTime(sub.CreatedAt),
+		UpdatedAt:           strfmt.DateTime(sub.UpdatedAt),
+		ETag:                etag.GenerateEtag(sub.UpdatedAt),
+
+		PreviousSigningSecretExpiresAt: handlers.FmtDateTimePtr(sub.PreviousSigningSecretExpiresAt),
 	}
 }
@@ -21,6 +21,7 @@ type IndexPaymentRequestSyncadaFilesHandler struct {
 	services.ListFetcher
 	services.NewQueryFilter
 	services.NewPagination
+	services.NewCursorPagination
 }
 
 func (h IndexPaymentRequestSyncadaFilesHandler) Handle(params pre.IndexPaymentRequestSyncadaFilesParams) middleware.Responder {
@@ -28,10 +29,16 @@ func (h IndexPaymentRequestSyncadaFilesHandler) Handle(params pre.IndexPaymentRe
 		func(appCtx appcontext.AppContext) (middleware.Responder, error) {
 			queryFilters := generateQueryFilters(appCtx.Logger(), params.Filter, paymentRequestNumberFilter)
 			ordering := query.NewQueryOrder(params.Sort, params.Order)
-			pagination := h.NewPagination(params.Page, params.PerPage)
+			pagination, err := listPagination(h.NewPagination, h.NewCursorPagination, params.Page, params.PerPage, params.Cursor)
+			if err != nil {
+				return pre.NewIndexPaymentRequestSyncadaFilesBadRequest(), err
+			}
 			var paymentRequestEdiFiles models.PaymentRequestEdiFiles
-			err := h.ListFetcher.FetchRecordList(appCtx, &paymentRequestEdiFiles, queryFilters, nil, pagination, ordering)
+			err = h.ListFetcher.FetchRecordList(appCtx, &paymentRequestEdiFiles, queryFilters, nil, pagination, ordering)
 			if err != nil {
+				if isCursorSortError(err) {
+					return pre.NewIndexPaymentRequestSyncadaFilesBadRequest(), err
+				}
 				return handlers.ResponseForError(appCtx.Logger(), err), err
 			}
 			totalPaymentRequestSyncadaFilesCount, err := h.ListFetcher.FetchRecordCount(appCtx, &paymentRequestEdiFiles, queryFilters)
@@ -43,7 +50,11 @@ func (h IndexPaymentRequestSyncadaFilesHandler) Handle(params pre.IndexPaymentRe
 			for i, paymentRequestEdiFile := range paymentRequestEdiFiles {
 				payload[i] = payloadForPaymentRequestEdiFile(paymentRequestEdiFile)
 			}
-			return pre.NewIndexPaymentRequestSyncadaFilesOK().WithContentRange(fmt.Sprintf("payment-request-syncada-files %d-%d/%d", pagination.Offset(), pagination.Offset()+queriedPaymentRequestEdiFilesCount, totalPaymentRequestSyncadaFilesCount)).WithPayload(payload), nil
+			response := pre.NewIndexPaymentRequestSyncadaFilesOK().WithContentRange(fmt.Sprintf("payment-request-syncada-files %d-%d/%d", pagination.Offset(), pagination.Offset()+queriedPaymentRequestEdiFilesCount, totalPaymentRequestSyncadaFilesCount)).WithPayload(payload)
+			if nextCursor := query.NextCursor(&paymentRequestEdiFiles, pagination, ordering); nextCursor != nil {
+				response = response.WithNextCursor(*nextCursor)
+			}
+			return response, nil
 		})
 }
 
@@ -0,0 +1,192 @@
+package adminapi
+
+import (
+	"sort"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/gofrs/uuid"
+	"go.uber.org/zap"
+
+	"github.com/transcom/mymove/pkg/appcontext"
+	"github.com/transcom/mymove/pkg/apperror"
+	permissionsop "github.com/transcom/mymove/pkg/gen/adminapi/adminoperations/permissions"
+	"github.com/transcom/mymove/pkg/gen/adminmessages"
+	"github.com/transcom/mymove/pkg/handlers"
+	"github.com/transcom/mymove/pkg/models/roles"
+	"github.com/transcom/mymove/pkg/services"
+	"github.com/transcom/mymove/pkg/services/audit"
+)
+
+// moveLockFeatureFlagName is the feature flag that turns move locking on for office users
+const moveLockFeatureFlagName = "move_lock"
+
+func payloadForPermissionModel(p roles.Permission) *adminmessages.Permission {
+	roleTypes := make([]string, 0, len(p.Roles))
+	for _, role := range p.Roles {
+		roleTypes = append(roleTypes, string(role.RoleType))
+	}
+	sort.Strings(roleTypes)
+
+	return &adminmessages.Permission{
+		ID:            handlers.FmtUUID(p.ID),
+		PermissionKey: handlers.FmtString(p.PermissionKey),
+		Description:   p.Description,
+		RoleTypes:     roleTypes,
+	}
+}
+
+func payloadForRolePermissions(roleType roles.RoleType, permissions roles.Permissions) *adminmessages.RolePermissions {
+	return &adminmessages.RolePermissions{
+		RoleType:       handlers.FmtString(string(roleType)),
+		PermissionKeys: permissions.Keys(),
+	}
+}
+
+func payloadForPermissionSimulation(params services.PermissionSimulationParams, simulation services.PermissionSimulation) *adminmessages.PermissionSimulation {
+	roleTypes := make([]string, 0, len(simulation.GrantingRoleTypes))
+	for _, roleType := range simulation.GrantingRoleTypes {
+		roleTypes = append(roleTypes, string(roleType))
+	}
+
+	return &adminmessages.PermissionSimulation{
+		OfficeUserID:      handlers.FmtUUID(params.OfficeUserID),
+		PermissionKey:     handlers.FmtString(params.PermissionKey),
+		MoveID:            handlers.FmtUUIDPtr(params.MoveID),
+		Allowed:           handlers.FmtBool(simulation.Allowed),
+		GrantingRoleTypes: roleTypes,
+		Reasons:           simulation.Reasons,
+	}
+}
+
+// IndexPermissionsHandler lists the office permissions via GET /permissions
+type IndexPermissionsHandler struct {
+	handlers.HandlerConfig
+	services.RolePermissionsFetcher
+}
+
+// Handle lists every permission with the roles it is granted to
+func (h IndexPermissionsHandler) Handle(params permissionsop.IndexPermissionsParams) middleware.Responder {
+	return h.AuditableAppContextFromRequestWithErrors(params.HTTPRequest,
+		func(appCtx appcontext.AppContext) (middleware.Responder, error) {
+			permissions, err := h.RolePermissionsFetcher.FetchPermissions(appCtx)
+			if err != nil {
+				appCtx.Logger().Error("Error fetching permissions", zap.Error(err))
+				return permissionsop.NewIndexPermissionsInternalServerError(), err
+			}
+
+			payload := make(adminmessages.Permissions, len(permissions))
+			for i, p := range permissions {
+				payload[i] = payloadForPermissionModel(p)
+			}
+
+			return permissionsop.NewIndexPermissionsOK().WithPayload(payload), nil
+		})
+}
+
+// GetRolePermissionsHandler returns the permissions of a role via GET /roles/:roleType/permissions
+type GetRolePermissionsHandler struct {
+	handlers.HandlerConfig
+	services.RolePermissionsFetcher
+}
+
+// Handle returns the permissions granted to a role
+func (h GetRolePermissionsHandler) Handle(params permissionsop.GetRolePermissionsParams) middleware.Responder {
+	return h.AuditableAppContextFromRequestWithErrors(params.HTTPRequest,
+		func(appCtx appcontext.AppContext) (middleware.Responder, error) {
+			roleType := roles.RoleType(params.RoleType)
+			permissions, err := h.RolePermissionsFetcher.FetchPermissionsForRole(appCtx, roleType)
+			if err != nil {
+				switch err.(type) {
+				case apperror.NotFoundError:
+					return permissionsop.NewGetRolePermissionsNotFound(), err
+				default:
+					appCtx.Logger().Error("Error fetching role permissions", zap.String("roleType", params.RoleType), zap.Error(err))
+					return permissionsop.NewGetRolePermissionsInternalServerError(), err
+				}
+			}
+
+			return permissionsop.NewGetRolePermissionsOK().WithPayload(payloadForRolePermissions(roleType, permissions)), nil
+		})
+}
+
+// UpdateRolePermissionsHandler replaces the permissions of a role via PUT /roles/:roleType/permissions
+type UpdateRolePermissionsHandler struct {
+	handlers.HandlerConfig
+	services.RolePermissionsUpdater
+}
+
+// Handle replaces the permissions granted to a role and audits the change
+func (h UpdateRolePermissionsHandler) Handle(params permissionsop.UpdateRolePermissionsParams) middleware.Responder {
+	return h.AuditableAppContextFromRequestWithErrors(params.HTTPRequest,
+		func(appCtx appcontext.AppContext) (middleware.Responder, error) {
+			roleType := roles.RoleType(params.RoleType)
+			role, permissions, err := h.RolePermissionsUpdater.UpdateRolePermissions(appCtx, roleType, params.Body.PermissionKeys)
+			if err != nil {
+				switch e := err.(type) {
+				case apperror.NotFoundError:
+					return permissionsop.NewUpdateRolePermissionsNotFound(), err
+				case apperror.InvalidInputError:
+					validationError := &adminmessages.ValidationError{
+						InvalidFields: handlers.NewValidationErrorsResponse(e.ValidationErrors).Errors,
+						ClientError: adminmessages.ClientError{
+							Title:    handlers.FmtString(handlers.ValidationErrMessage),
+							Detail:   handlers.FmtString(e.Error()),
+							Instance: handlers.FmtUUID(h.GetTraceIDFromRequest(params.HTTPRequest)),
+						},
+					}
+					return permissionsop.NewUpdateRolePermissionsUnprocessableEntity().WithPayload(validationError), err
+				default:
+					appCtx.Logger().Error("Error updating role permissions", zap.String("roleType", params.RoleType), zap.Error(err))
+					return permissionsop.NewUpdateRolePermissionsInternalServerError(), err
+				}
+			}
+
+			_, err = audit.Capture(appCtx, role, params.Body, params.HTTPRequest)
+			if err != nil {
+				appCtx.Logger().Error("Error capturing audit record", zap.Error(err))
+			}
+
+			return permissionsop.NewUpdateRolePermissionsOK().WithPayload(payloadForRolePermissions(roleType, permissions)), nil
+		})
+}
+
+// SimulatePermissionHandler checks whether an office user could take an action via GET /permissions/simulation
+type SimulatePermissionHandler struct {
+	handlers.HandlerConfig
+	services.PermissionSimulator
+}
+
+// Handle answers whether the office user could use the permission, on the move when one is given
+func (h SimulatePermissionHandler) Handle(params permissionsop.SimulatePermissionParams) middleware.Responder {
+	return h.AuditableAppContextFromRequestWithErrors(params.HTTPRequest,
+		func(appCtx appcontext.AppContext) (middleware.Responder, error) {
+			simulationParams := services.PermissionSimulationParams{
+				OfficeUserID:  uuid.FromStringOrNil(params.OfficeUserID.String()),
+				PermissionKey: params.PermissionKey,
+			}
+			if params.MoveID != nil {
+				moveID := uuid.FromStringOrNil(params.MoveID.String())
+				simulationParams.MoveID = &moveID
+			}
+
+			flag, err := h.FeatureFlagFetcher().GetBooleanFlag(params.HTTPRequest.Context(), appCtx.Logger(), simulationParams.OfficeUserID.String(), moveLockFeatureFlagName, map[string]string{})
+			if err != nil {
+				appCtx.Logger().Error("Error fetching move_lock feature flag", zap.String("featureFlagKey", moveLockFeatureFlagName), zap.Error(err))
+			} else {
+				simulationParams.CheckMoveLocks = flag.Match
+			}
+
+			simulation, err := h.PermissionSimulator.SimulatePermission(appCtx, simulationParams)
+			if err != nil {
+				switch err.(type) {
+				case apperror.NotFoundError:
+					return permissionsop.NewSimulatePermissionNotFound(), err
+				default:
+					appCtx.Logger().Error("Error simulating permission", zap.Error(err))
+					return permissionsop.NewSimulatePermissionInternalServerError(), err
+				}
+			}
+
+			return permissionsop.NewSimulatePermissionOK().WithPayload(payloadForPermissionSimulation(simulationParams, *simulation)), nil
+		})
+}
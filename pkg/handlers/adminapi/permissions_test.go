@@ -0,0 +1,218 @@
+package adminapi
+
+import (
+	"fmt"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/transcom/mymove/pkg/apperror"
+	"github.com/transcom/mymove/pkg/factory"
+	permissionsop "github.com/transcom/mymove/pkg/gen/adminapi/adminoperations/permissions"
+	"github.com/transcom/mymove/pkg/gen/adminmessages"
+	"github.com/transcom/mymove/pkg/handlers"
+	"github.com/transcom/mymove/pkg/models"
+	"github.com/transcom/mymove/pkg/models/roles"
+	"github.com/transcom/mymove/pkg/services"
+	"github.com/transcom/mymove/pkg/services/mocks"
+	rolesservice "github.com/transcom/mymove/pkg/services/roles"
+)
+
+func (suite *HandlerSuite) TestIndexPermissionsHandler() {
+	suite.Run("lists the permissions with the roles they are granted to", func() {
+		handler := IndexPermissionsHandler{
+			HandlerConfig:          suite.NewHandlerConfig(),
+			RolePermissionsFetcher: rolesservice.NewRolePermissionsFetcher(),
+		}
+		params := permissionsop.IndexPermissionsParams{
+			HTTPRequest: suite.setupAuthenticatedRequest("GET", "/permissions"),
+		}
+
+		response := handler.Handle(params)
+
+		suite.IsType(&permissionsop.IndexPermissionsOK{}, response)
+		okResponse := response.(*permissionsop.IndexPermissionsOK)
+		suite.NotEmpty(okResponse.Payload)
+		for _, permission := range okResponse.Payload {
+			if *permission.PermissionKey == "update.paymentRequest" {
+				suite.Equal([]string{string(roles.RoleTypeTIO)}, permission.RoleTypes)
+			}
+		}
+	})
+}
+
+func (suite *HandlerSuite) TestGetRolePermissionsHandler() {
+	setupHandler := func() GetRolePermissionsHandler {
+		return GetRolePermissionsHandler{
+			HandlerConfig:          suite.NewHandlerConfig(),
+			RolePermissionsFetcher: rolesservice.NewRolePermissionsFetcher(),
+		}
+	}
+
+	suite.Run("returns the permissions of a role", func() {
+		params := permissionsop.GetRolePermissionsParams{
+			HTTPRequest: suite.setupAuthenticatedRequest("GET", "/roles/qae/permissions"),
+			RoleType:    string(roles.RoleTypeQae),
+		}
+
+		response := setupHandler().Handle(params)
+
+		suite.IsType(&permissionsop.GetRolePermissionsOK{}, response)
+		okResponse := response.(*permissionsop.GetRolePermissionsOK)
+		suite.Equal(string(roles.RoleTypeQae), *okResponse.Payload.RoleType)
+		suite.Contains(okResponse.Payload.PermissionKeys, "create.evaluationReport")
+	})
+
+	suite.Run("returns not found for an unknown role", func() {
+		params := permissionsop.GetRolePermissionsParams{
+			HTTPRequest: suite.setupAuthenticatedRequest("GET", "/roles/not_a_role/permissions"),
+			RoleType:    "not_a_role",
+		}
+
+		response := setupHandler().Handle(params)
+
+		suite.IsType(&permissionsop.GetRolePermissionsNotFound{}, response)
+	})
+}
+
+func (suite *HandlerSuite) TestUpdateRolePermissionsHandler() {
+	suite.Run("replaces the permissions of a role and audits the change", func() {
+		handler := UpdateRolePermissionsHandler{
+			HandlerConfig:          suite.NewHandlerConfig(),
+			RolePermissionsUpdater: rolesservice.NewRolePermissionsUpdater(),
+		}
+		params := permissionsop.UpdateRolePermissionsParams{
+			HTTPRequest: suite.setupAuthenticatedRequest("PUT", "/roles/headquarters/permissions"),
+			RoleType:    string(roles.RoleTypeHQ),
+			Body: &adminmessages.RolePermissionsUpdatePayload{
+				PermissionKeys: []string{"read.paymentRequest", "read.tppsReconciliation"},
+			},
+		}
+
+		response := handler.Handle(params)
+
+		suite.IsType(&permissionsop.UpdateRolePermissionsOK{}, response)
+		okResponse := response.(*permissionsop.UpdateRolePermissionsOK)
+		suite.Equal([]string{"read.paymentRequest", "read.tppsReconciliation"}, okResponse.Payload.PermissionKeys)
+
+		var role roles.Role
+		suite.NoError(suite.DB().Where("role_type = ?", roles.RoleTypeHQ).First(&role))
+		var events models.AuditEvents
+		suite.NoError(suite.DB().Where("record_id = ?", role.ID).All(&events))
+		if suite.Len(events, 1) {
+			suite.Equal("Role", *events[0].RecordType)
+			suite.Equal("permission_keys", *events[0].FieldsChanged)
+		}
+	})
+
+	suite.Run("returns unprocessable entity for unknown permissions", func() {
+		handler := UpdateRolePermissionsHandler{
+			HandlerConfig:          suite.NewHandlerConfig(),
+			RolePermissionsUpdater: rolesservice.NewRolePermissionsUpdater(),
+		}
+		params := permissionsop.UpdateRolePermissionsParams{
+			HTTPRequest: suite.setupAuthenticatedRequest("PUT", "/roles/headquarters/permissions"),
+			RoleType:    string(roles.RoleTypeHQ),
+			Body: &adminmessages.RolePermissionsUpdatePayload{
+				PermissionKeys: []string{"update.everything"},
+			},
+		}
+
+		response := handler.Handle(params)
+
+		suite.IsType(&permissionsop.UpdateRolePermissionsUnprocessableEntity{}, response)
+		errResponse := response.(*permissionsop.UpdateRolePermissionsUnprocessableEntity)
+		suite.Contains(errResponse.Payload.InvalidFields["permission_keys"], "update.everything is not a permission")
+	})
+
+	suite.Run("returns not found for an unknown role", func() {
+		updater := &mocks.RolePermissionsUpdater{}
+		updater.On("UpdateRolePermissions",
+			mock.AnythingOfType("*appcontext.appContext"),
+			roles.RoleType("not_a_role"),
+			[]string{"update.move"},
+		).Return(nil, nil, apperror.NewNotFoundError(uuid.Nil, "role type not_a_role"))
+		handler := UpdateRolePermissionsHandler{
+			HandlerConfig:          suite.NewHandlerConfig(),
+			RolePermissionsUpdater: updater,
+		}
+		params := permissionsop.UpdateRolePermissionsParams{
+			HTTPRequest: suite.setupAuthenticatedRequest("PUT", "/roles/not_a_role/permissions"),
+			RoleType:    "not_a_role",
+			Body: &adminmessages.RolePermissionsUpdatePayload{
+				PermissionKeys: []string{"update.move"},
+			},
+		}
+
+		response := handler.Handle(params)
+
+		suite.IsType(&permissionsop.UpdateRolePermissionsNotFound{}, response)
+	})
+}
+
+func (suite *HandlerSuite) TestSimulatePermissionHandler() {
+	suite.Run("answers whether the office user could take the action on the move", func() {
+		officeUser := factory.BuildOfficeUserWithRoles(suite.DB(), factory.GetTraitActiveOfficeUser(),
+			[]roles.RoleType{roles.RoleTypeTIO})
+		move := factory.BuildMove(suite.DB(), nil, nil)
+		// the test handler config turns move locking on
+		moveLockChecker := &mocks.MoveLockChecker{}
+		moveLockChecker.On("CheckMoveLocks",
+			mock.AnythingOfType("*appcontext.appContext"),
+			[]uuid.UUID{move.ID},
+			officeUser.ID,
+		).Return(nil)
+		moveAccessChecker := &mocks.MoveAccessChecker{}
+		moveAccessChecker.On("CheckMoveAccess",
+			mock.AnythingOfType("*appcontext.appContext"),
+			[]uuid.UUID{move.ID},
+			officeUser.ID,
+			roles.RoleTypeTIO,
+		).Return(nil)
+		handler := SimulatePermissionHandler{
+			HandlerConfig:       suite.NewHandlerConfig(),
+			PermissionSimulator: rolesservice.NewPermissionSimulator(moveLockChecker, moveAccessChecker),
+		}
+		params := permissionsop.SimulatePermissionParams{
+			HTTPRequest:   suite.setupAuthenticatedRequest("GET", fmt.Sprintf("/permissions/simulation?officeUserId=%s", officeUser.ID)),
+			OfficeUserID:  strfmt.UUID(officeUser.ID.String()),
+			PermissionKey: "update.paymentRequest",
+			MoveID:        handlers.FmtUUID(move.ID),
+		}
+
+		response := handler.Handle(params)
+
+		suite.IsType(&permissionsop.SimulatePermissionOK{}, response)
+		okResponse := response.(*permissionsop.SimulatePermissionOK)
+		suite.True(*okResponse.Payload.Allowed)
+		suite.Equal([]string{string(roles.RoleTypeTIO)}, okResponse.Payload.GrantingRoleTypes)
+		suite.Equal(move.ID.String(), okResponse.Payload.MoveID.String())
+		suite.Empty(okResponse.Payload.Reasons)
+		moveLockChecker.AssertExpectations(suite.T())
+	})
+
+	suite.Run("returns not found for an unknown office user", func() {
+		officeUserID := uuid.Must(uuid.NewV4())
+		simulator := &mocks.PermissionSimulator{}
+		simulator.On("SimulatePermission",
+			mock.AnythingOfType("*appcontext.appContext"),
+			mock.MatchedBy(func(params services.PermissionSimulationParams) bool {
+				return params.OfficeUserID == officeUserID && params.MoveID == nil
+			}),
+		).Return(nil, apperror.NewNotFoundError(officeUserID, "looking for OfficeUser"))
+		handler := SimulatePermissionHandler{
+			HandlerConfig:       suite.NewHandlerConfig(),
+			PermissionSimulator: simulator,
+		}
+		params := permissionsop.SimulatePermissionParams{
+			HTTPRequest:   suite.setupAuthenticatedRequest("GET", "/permissions/simulation"),
+			OfficeUserID:  strfmt.UUID(officeUserID.String()),
+			PermissionKey: "update.move",
+		}
+
+		response := handler.Handle(params)
+
+		suite.IsType(&permissionsop.SimulatePermissionNotFound{}, response)
+	})
+}
@@ -63,6 +63,7 @@ type IndexRejectedOfficeUsersHandler struct {
 	services.RejectedOfficeUserListFetcher
 	services.NewQueryFilter
 	services.NewPagination
+	services.NewCursorPagination
 }
 
 var rejectedOfficeUserFilterConverters = map[string]func(string) func(*pop.Query){
@@ -136,11 +137,17 @@ func (h IndexRejectedOfficeUsersHandler) Handle(params rejected_office_users.Ind
 				}
 			}
 
-			pagination := h.NewPagination(params.Page, params.PerPage)
+			pagination, err := listPagination(h.NewPagination, h.NewCursorPagination, params.Page, params.PerPage, params.Cursor)
+			if err != nil {
+				return rejected_office_users.NewIndexRejectedOfficeUsersBadRequest(), err
+			}
 			ordering := query.NewQueryOrder(params.Sort, params.Order)
 
 			officeUsers, count, err := h.RejectedOfficeUserListFetcher.FetchRejectedOfficeUsersList(appCtx, filterFuncs, pagination, ordering)
 			if err != nil {
+				if isCursorSortError(err) {
+					return rejected_office_users.NewIndexRejectedOfficeUsersBadRequest(), err
+				}
 				return handlers.ResponseForError(appCtx.Logger(), err), err
 			}
 
@@ -152,7 +159,11 @@ func (h IndexRejectedOfficeUsersHandler) Handle(params rejected_office_users.Ind
 				payload[i] = payloadForRejectedOfficeUserModel(officeUser)
 			}
 
-			return rejected_office_users.NewIndexRejectedOfficeUsersOK().WithContentRange(fmt.Sprintf("rejected office users %d-%d/%d", pagination.Offset(), pagination.Offset()+queriedOfficeUsersCount, count)).WithPayload(payload), nil
+			response := rejected_office_users.NewIndexRejectedOfficeUsersOK().WithContentRange(fmt.Sprintf("rejected office users %d-%d/%d", pagination.Offset(), pagination.Offset()+queriedOfficeUsersCount, count)).WithPayload(payload)
+			if nextCursor := query.NextCursor(&officeUsers, pagination, ordering); nextCursor != nil {
+				response = response.WithNextCursor(*nextCursor)
+			}
+			return response, nil
 		})
 }
 
@@ -158,6 +158,7 @@ type IndexRequestedOfficeUsersHandler struct {
 	services.RequestedOfficeUserListFetcher
 	services.NewQueryFilter
 	services.NewPagination
+	services.NewCursorPagination
 	services.TransportationOfficesFetcher
 	services.RoleAssociater
 }
@@ -227,11 +228,17 @@ func (h IndexRequestedOfficeUsersHandler) Handle(params requested_office_users.I
 				}
 			}
 
-			pagination := h.NewPagination(params.Page, params.PerPage)
+			pagination, err := listPagination(h.NewPagination, h.NewCursorPagination, params.Page, params.PerPage, params.Cursor)
+			if err != nil {
+				return requested_office_users.NewIndexRequestedOfficeUsersBadRequest(), err
+			}
 			ordering := query.NewQueryOrder(params.Sort, params.Order)
 
 			officeUsers, count, err := h.RequestedOfficeUserListFetcher.FetchRequestedOfficeUsersList(appCtx, filterFuncs, pagination, ordering)
 			if err != nil {
+				if isCursorSortError(err) {
+					return requested_office_users.NewIndexRequestedOfficeUsersBadRequest(), err
+				}
 				return handlers.ResponseForError(appCtx.Logger(), err), err
 			}
 
@@ -243,7 +250,11 @@ func (h IndexRequestedOfficeUsersHandler) Handle(params requested_office_users.I
 				payload[i] = payloadForRequestedOfficeUserModel(s)
 			}
 
-			return requested_office_users.NewIndexRequestedOfficeUsersOK().WithContentRange(fmt.Sprintf("requested office users %d-%d/%d", pagination.Offset(), pagination.Offset()+queriedOfficeUsersCount, count)).WithPayload(payload), nil
+			response := requested_office_users.NewIndexRequestedOfficeUsersOK().WithContentRange(fmt.Sprintf("requested office users %d-%d/%d", pagination.Offset(), pagination.Offset()+queriedOfficeUsersCount, count)).WithPayload(payload)
+			if nextCursor := query.NextCursor(&officeUsers, pagination, ordering); nextCursor != nil {
+				response = response.WithNextCursor(*nextCursor)
+			}
+			return response, nil
 		})
 }
 
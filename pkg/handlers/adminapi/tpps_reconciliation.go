@@ -0,0 +1,113 @@
+package adminapi
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/transcom/mymove/pkg/appcontext"
+	"github.com/transcom/mymove/pkg/apperror"
+	tppsreconciliationop "github.com/transcom/mymove/pkg/gen/adminapi/adminoperations/t_p_p_s_reconciliation"
+	"github.com/transcom/mymove/pkg/handlers"
+	"github.com/transcom/mymove/pkg/services"
+	"github.com/transcom/mymove/pkg/unit"
+)
+
+var tppsReconciliationCSVHeader = []string{
+	"Payment Request Number",
+	"Reference ID",
+	"Service Code",
+	"Billed",
+	"Paid",
+	"Variance",
+	"Seller Paid Date",
+	"Status",
+}
+
+// ExportTPPSReconciliationHandler exports a TPPS reconciliation report as CSV
+type ExportTPPSReconciliationHandler struct {
+	handlers.HandlerConfig
+	services.TPPSReconciler
+}
+
+// Handle reconciles the TPPS payments between the dates and writes every line as CSV
+func (h ExportTPPSReconciliationHandler) Handle(params tppsreconciliationop.ExportTPPSReconciliationParams) middleware.Responder {
+	return h.AuditableAppContextFromRequestWithErrors(params.HTTPRequest, func(appCtx appcontext.AppContext) (middleware.Responder, error) {
+		reconciliationParams := services.TPPSReconciliationParams{
+			StartDate: time.Time(params.StartDate),
+			EndDate:   time.Time(params.EndDate),
+		}
+		if params.ToleranceCents != nil {
+			reconciliationParams.ToleranceCents = unit.Cents(*params.ToleranceCents)
+		}
+
+		report, err := h.ReconcileTPPSPayments(appCtx, reconciliationParams)
+		if err != nil {
+			if _, ok := err.(apperror.InvalidInputError); ok {
+				return tppsreconciliationop.NewExportTPPSReconciliationUnprocessableEntity(), err
+			}
+			return handlers.ResponseForError(appCtx.Logger(), err), err
+		}
+
+		buf := &bytes.Buffer{}
+		if err = writeTPPSReconciliationCSV(buf, report); err != nil {
+			return handlers.ResponseForError(appCtx.Logger(), err), err
+		}
+
+		filename := fmt.Sprintf("attachment; filename=\"tpps-reconciliation-%s-%s.csv\"",
+			params.StartDate.String(), params.EndDate.String())
+		return tppsreconciliationop.NewExportTPPSReconciliationOK().
+			WithContentDisposition(filename).
+			WithPayload(io.NopCloser(buf)), nil
+	})
+}
+
+// writeTPPSReconciliationCSV writes the report lines followed by a totals row, with amounts in dollars
+// so the file can be opened directly in a spreadsheet
+func writeTPPSReconciliationCSV(w io.Writer, report *services.TPPSReconciliationReport) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(tppsReconciliationCSVHeader); err != nil {
+		return err
+	}
+
+	for _, line := range report.Lines {
+		sellerPaidDate := ""
+		if line.SellerPaidDate != nil {
+			sellerPaidDate = line.SellerPaidDate.Format("2006-01-02")
+		}
+		record := []string{
+			line.PaymentRequestNumber,
+			line.ReferenceID,
+			line.ServiceCode,
+			csvDollars(line.BilledCents),
+			csvDollars(line.PaidCents),
+			csvDollars(&line.VarianceCents),
+			sellerPaidDate,
+			string(line.Status),
+		}
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+
+	variance := report.TotalPaidCents - report.TotalBilledCents
+	totals := []string{"Total", "", "", csvDollars(&report.TotalBilledCents), csvDollars(&report.TotalPaidCents), csvDollars(&variance), "", ""}
+	if err := writer.Write(totals); err != nil {
+		return err
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+func csvDollars(cents *unit.Cents) string {
+	if cents == nil {
+		return ""
+	}
+	return strconv.FormatFloat(cents.ToDollarFloatNoRound(), 'f', 2, 64)
+}
@@ -0,0 +1,126 @@
+package adminapi
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/gobuffalo/validate/v3"
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/transcom/mymove/pkg/apperror"
+	tppsreconciliationop "github.com/transcom/mymove/pkg/gen/adminapi/adminoperations/t_p_p_s_reconciliation"
+	"github.com/transcom/mymove/pkg/handlers"
+	"github.com/transcom/mymove/pkg/services"
+	"github.com/transcom/mymove/pkg/services/mocks"
+	"github.com/transcom/mymove/pkg/unit"
+)
+
+func (suite *HandlerSuite) TestExportTPPSReconciliationHandler() {
+	startDate := time.Date(2025, time.February, 1, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2025, time.February, 28, 0, 0, 0, 0, time.UTC)
+
+	suite.Run("Successfully exports the reconciliation as CSV", func() {
+		billed := unit.Cents(12345)
+		paid := unit.Cents(12000)
+		sellerPaidDate := time.Date(2025, time.February, 10, 0, 0, 0, 0, time.UTC)
+		tolerance := int64(100)
+
+		mockReconciler := &mocks.TPPSReconciler{}
+		mockReconciler.On("ReconcileTPPSPayments", mock.Anything, services.TPPSReconciliationParams{
+			StartDate:      startDate,
+			EndDate:        endDate,
+			ToleranceCents: unit.Cents(tolerance),
+		}).Return(&services.TPPSReconciliationReport{
+			StartDate:        startDate,
+			EndDate:          endDate,
+			TotalBilledCents: billed,
+			TotalPaidCents:   paid,
+			Lines: []services.TPPSReconciliationLine{
+				{
+					PaymentRequestID:     uuid.Must(uuid.NewV4()),
+					PaymentRequestNumber: "1234-5678-1",
+					ReferenceID:          "1234-5678-a1b2c3d4",
+					ServiceCode:          "DLH",
+					BilledCents:          &billed,
+					PaidCents:            &paid,
+					VarianceCents:        paid - billed,
+					SellerPaidDate:       &sellerPaidDate,
+					Status:               services.TPPSReconciliationStatusUnderpaid,
+				},
+			},
+		}, nil)
+
+		handler := ExportTPPSReconciliationHandler{
+			HandlerConfig:  suite.NewHandlerConfig(),
+			TPPSReconciler: mockReconciler,
+		}
+
+		params := tppsreconciliationop.ExportTPPSReconciliationParams{
+			HTTPRequest:    suite.setupAuthenticatedRequest("GET", "/tpps-reconciliation"),
+			StartDate:      strfmt.Date(startDate),
+			EndDate:        strfmt.Date(endDate),
+			ToleranceCents: &tolerance,
+		}
+
+		response := handler.Handle(params)
+		suite.IsType(&tppsreconciliationop.ExportTPPSReconciliationOK{}, response)
+		okResp := response.(*tppsreconciliationop.ExportTPPSReconciliationOK)
+		suite.Equal("attachment; filename=\"tpps-reconciliation-2025-02-01-2025-02-28.csv\"", okResp.ContentDisposition)
+
+		content, err := io.ReadAll(okResp.Payload)
+		suite.NoError(err)
+		records, err := csv.NewReader(bytes.NewReader(content)).ReadAll()
+		suite.NoError(err)
+		suite.Len(records, 3)
+		suite.Equal(tppsReconciliationCSVHeader, records[0])
+		suite.Equal([]string{"1234-5678-1", "1234-5678-a1b2c3d4", "DLH", "123.45", "120.00", "-3.45", "2025-02-10", "UNDERPAID"}, records[1])
+		suite.Equal([]string{"Total", "", "", "123.45", "120.00", "-3.45", "", ""}, records[2])
+	})
+
+	suite.Run("Invalid dates return an error response", func() {
+		mockReconciler := &mocks.TPPSReconciler{}
+		mockReconciler.On("ReconcileTPPSPayments", mock.Anything, mock.Anything).
+			Return(nil, apperror.NewInvalidInputError(uuid.Nil, nil, validate.NewErrors(), "invalid TPPS reconciliation parameters"))
+
+		handler := ExportTPPSReconciliationHandler{
+			HandlerConfig:  suite.NewHandlerConfig(),
+			TPPSReconciler: mockReconciler,
+		}
+
+		params := tppsreconciliationop.ExportTPPSReconciliationParams{
+			HTTPRequest: suite.setupAuthenticatedRequest("GET", "/tpps-reconciliation"),
+			StartDate:   strfmt.Date(endDate),
+			EndDate:     strfmt.Date(startDate),
+		}
+
+		response := handler.Handle(params)
+		suite.IsType(&tppsreconciliationop.ExportTPPSReconciliationUnprocessableEntity{}, response)
+	})
+
+	suite.Run("Query failures return an internal server error", func() {
+		mockReconciler := &mocks.TPPSReconciler{}
+		mockReconciler.On("ReconcileTPPSPayments", mock.Anything, mock.Anything).
+			Return(nil, apperror.NewQueryError("TPPSPaidInvoiceReportEntry", errors.New("DB failure"), ""))
+
+		handler := ExportTPPSReconciliationHandler{
+			HandlerConfig:  suite.NewHandlerConfig(),
+			TPPSReconciler: mockReconciler,
+		}
+
+		params := tppsreconciliationop.ExportTPPSReconciliationParams{
+			HTTPRequest: suite.setupAuthenticatedRequest("GET", "/tpps-reconciliation"),
+			StartDate:   strfmt.Date(startDate),
+			EndDate:     strfmt.Date(endDate),
+		}
+
+		response := handler.Handle(params)
+		suite.IsType(&handlers.ErrResponse{}, response)
+		suite.Equal(http.StatusInternalServerError, response.(*handlers.ErrResponse).Code)
+	})
+}
@@ -61,6 +61,7 @@ type IndexUsersHandler struct {
 	services.ListFetcher
 	services.NewQueryFilter
 	services.NewPagination
+	services.NewCursorPagination
 }
 
 var usersFilterConverters = map[string]func(string) []services.QueryFilter{
@@ -81,11 +82,17 @@ func (h IndexUsersHandler) Handle(params userop.IndexUsersParams) middleware.Res
 			queryFilters := generateQueryFilters(appCtx.Logger(), params.Filter, usersFilterConverters)
 
 			ordering := query.NewQueryOrder(params.Sort, params.Order)
-			pagination := h.NewPagination(params.Page, params.PerPage)
+			pagination, err := listPagination(h.NewPagination, h.NewCursorPagination, params.Page, params.PerPage, params.Cursor)
+			if err != nil {
+				return userop.NewIndexUsersBadRequest(), err
+			}
 
 			var users models.Users
-			err := h.ListFetcher.FetchRecordList(appCtx, &users, queryFilters, nil, pagination, ordering)
+			err = h.ListFetcher.FetchRecordList(appCtx, &users, queryFilters, nil, pagination, ordering)
 			if err != nil {
+				if isCursorSortError(err) {
+					return userop.NewIndexUsersBadRequest(), err
+				}
 				return handlers.ResponseForError(appCtx.Logger(), err), err
 			}
 
@@ -102,7 +109,11 @@ func (h IndexUsersHandler) Handle(params userop.IndexUsersParams) middleware.Res
 				payload[i] = payloadForUserModel(s)
 			}
 
-			return userop.NewIndexUsersOK().WithContentRange(fmt.Sprintf("users %d-%d/%d", pagination.Offset(), pagination.Offset()+queriedUsersCount, totalUsersCount)).WithPayload(payload), nil
+			response := userop.NewIndexUsersOK().WithContentRange(fmt.Sprintf("users %d-%d/%d", pagination.Offset(), pagination.Offset()+queriedUsersCount, totalUsersCount)).WithPayload(payload)
+			if nextCursor := query.NextCursor(&users, pagination, ordering); nextCursor != nil {
+				response = response.WithNextCursor(*nextCursor)
+			}
+			return response, nil
 		})
 }
 
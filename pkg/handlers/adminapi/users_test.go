@@ -151,6 +151,36 @@ func (suite *HandlerSuite) TestIndexUsersHandler() {
 		}
 		suite.Equal(expectedResponse, response)
 	})
+
+	suite.Run("rejects a cursor made for another sort order", func() {
+		factory.BuildDefaultUser(suite.DB())
+		factory.BuildDefaultUser(suite.DB())
+		handler := IndexUsersHandler{
+			HandlerConfig:       suite.NewHandlerConfig(),
+			NewQueryFilter:      query.NewQueryFilter,
+			ListFetcher:         fetch.NewListFetcher(query.NewQueryBuilder()),
+			NewPagination:       pagination.NewPagination,
+			NewCursorPagination: pagination.NewCursorPagination,
+		}
+
+		params := userop.IndexUsersParams{
+			HTTPRequest: suite.setupAuthenticatedRequest("GET", "/users"),
+			PerPage:     models.Int64Pointer(1),
+			Sort:        models.StringPointer("okta_email"),
+			Order:       models.BoolPointer(true),
+			Cursor:      models.StringPointer(""),
+		}
+		response := handler.Handle(params)
+		suite.IsType(&userop.IndexUsersOK{}, response)
+		okResponse := response.(*userop.IndexUsersOK)
+		suite.NotEmpty(okResponse.NextCursor)
+
+		params.Cursor = &okResponse.NextCursor
+		params.Sort = models.StringPointer("created_at")
+		response = handler.Handle(params)
+
+		suite.IsType(&userop.IndexUsersBadRequest{}, response)
+	})
 }
 
 func (suite *HandlerSuite) TestUpdateUserHandler() {
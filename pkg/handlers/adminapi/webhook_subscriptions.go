@@ -2,6 +2,7 @@ package adminapi
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/gofrs/uuid"
@@ -24,6 +25,7 @@ type IndexWebhookSubscriptionsHandler struct {
 	services.ListFetcher
 	services.NewQueryFilter
 	services.NewPagination
+	services.NewCursorPagination
 }
 
 // Handle retrieves a list of webhook subscriptions
@@ -34,11 +36,17 @@ func (h IndexWebhookSubscriptionsHandler) Handle(params webhooksubscriptionop.In
 			queryFilters := []services.QueryFilter{}
 
 			ordering := query.NewQueryOrder(params.Sort, params.Order)
-			pagination := h.NewPagination(params.Page, params.PerPage)
+			pagination, err := listPagination(h.NewPagination, h.NewCursorPagination, params.Page, params.PerPage, params.Cursor)
+			if err != nil {
+				return webhooksubscriptionop.NewIndexWebhookSubscriptionsBadRequest(), err
+			}
 
 			var webhookSubscriptions models.WebhookSubscriptions
-			err := h.ListFetcher.FetchRecordList(appCtx, &webhookSubscriptions, queryFilters, nil, pagination, ordering)
+			err = h.ListFetcher.FetchRecordList(appCtx, &webhookSubscriptions, queryFilters, nil, pagination, ordering)
 			if err != nil {
+				if isCursorSortError(err) {
+					return webhooksubscriptionop.NewIndexWebhookSubscriptionsBadRequest(), err
+				}
 				return handlers.ResponseForError(appCtx.Logger(), err), err
 			}
 
@@ -55,7 +63,11 @@ func (h IndexWebhookSubscriptionsHandler) Handle(params webhooksubscriptionop.In
 				payload[i] = payloads.WebhookSubscriptionPayload(s)
 			}
 
-			return webhooksubscriptionop.NewIndexWebhookSubscriptionsOK().WithContentRange(fmt.Sprintf("webhookSubscriptions %d-%d/%d", pagination.Offset(), pagination.Offset()+queriedWebhookSubscriptionsCount, totalWebhookSubscriptionsCount)).WithPayload(payload), nil
+			response := webhooksubscriptionop.NewIndexWebhookSubscriptionsOK().WithContentRange(fmt.Sprintf("webhookSubscriptions %d-%d/%d", pagination.Offset(), pagination.Offset()+queriedWebhookSubscriptionsCount, totalWebhookSubscriptionsCount)).WithPayload(payload)
+			if nextCursor := query.NextCursor(&webhookSubscriptions, pagination, ordering); nextCursor != nil {
+				response = response.WithNextCursor(*nextCursor)
+			}
+			return response, nil
 		})
 }
 
@@ -121,6 +133,8 @@ func (h CreateWebhookSubscriptionHandler) Handle(params webhooksubscriptionop.Cr
 			}
 
 			returnPayload := payloads.WebhookSubscriptionPayload(*createdWebhookSubscription)
+			// The signing secret is only ever handed out here and when it is rotated
+			returnPayload.SigningSecret = createdWebhookSubscription.SigningSecret
 			return webhooksubscriptionop.NewCreateWebhookSubscriptionCreated().WithPayload(returnPayload), nil
 		})
 }
@@ -175,3 +189,66 @@ func (h UpdateWebhookSubscriptionHandler) Handle(params webhooksubscriptionop.Up
 			return webhooksubscriptionop.NewUpdateWebhookSubscriptionOK().WithPayload(payload), nil
 		})
 }
+
+// ReplayWebhookSubscriptionHandler queues a webhook subscription's dead lettered notifications to be sent again via
+// POST /webhook-subscriptions/:ID/replay
+type ReplayWebhookSubscriptionHandler struct {
+	handlers.HandlerConfig
+	services.WebhookNotificationReplayer
+}
+
+// Handle replays the dead lettered notifications for a webhook subscription
+func (h ReplayWebhookSubscriptionHandler) Handle(params webhooksubscriptionop.ReplayWebhookSubscriptionParams) middleware.Responder {
+	return h.AuditableAppContextFromRequestWithErrors(params.HTTPRequest,
+		func(appCtx appcontext.AppContext) (middleware.Responder, error) {
+			webhookSubscriptionID := uuid.FromStringOrNil(params.WebhookSubscriptionID.String())
+
+			replayed, err := h.WebhookNotificationReplayer.ReplayDeadLetteredNotifications(appCtx, webhookSubscriptionID)
+			if err != nil {
+				if err.Error() == models.RecordNotFoundErrorString {
+					appCtx.Logger().Error("Error finding webhookSubscription to replay")
+					return webhooksubscriptionop.NewReplayWebhookSubscriptionNotFound(), err
+				}
+				appCtx.Logger().Error(fmt.Sprintf("Error replaying webhookSubscription %s", params.WebhookSubscriptionID.String()), zap.Error(err))
+				return handlers.ResponseForError(appCtx.Logger(), err), err
+			}
+
+			replayedCount := int64(replayed)
+			payload := &adminmessages.WebhookNotificationReplay{ReplayedCount: &replayedCount}
+			return webhooksubscriptionop.NewReplayWebhookSubscriptionOK().WithPayload(payload), nil
+		})
+}
+
+// RotateWebhookSubscriptionSecretHandler gives a webhook subscription a new signing secret via
+// POST /webhook-subscriptions/:ID/rotate-secret
+type RotateWebhookSubscriptionSecretHandler struct {
+	handlers.HandlerConfig
+	services.WebhookSubscriptionSecretRotator
+}
+
+// Handle rotates the signing secret of a webhook subscription
+func (h RotateWebhookSubscriptionSecretHandler) Handle(params webhooksubscriptionop.RotateWebhookSubscriptionSecretParams) middleware.Responder {
+	return h.AuditableAppContextFromRequestWithErrors(params.HTTPRequest,
+		func(appCtx appcontext.AppContext) (middleware.Responder, error) {
+			webhookSubscriptionID := uuid.FromStringOrNil(params.WebhookSubscriptionID.String())
+
+			var overlap time.Duration
+			if params.OverlapMinutes != nil {
+				overlap = time.Duration(*params.OverlapMinutes) * time.Minute
+			}
+
+			rotatedWebhookSubscription, err := h.WebhookSubscriptionSecretRotator.RotateWebhookSubscriptionSecret(appCtx, webhookSubscriptionID, overlap)
+			if err != nil {
+				if err.Error() == models.RecordNotFoundErrorString {
+					appCtx.Logger().Error("Error finding webhookSubscription to rotate secret")
+					return webhooksubscriptionop.NewRotateWebhookSubscriptionSecretNotFound(), err
+				}
+				appCtx.Logger().Error(fmt.Sprintf("Error rotating secret of webhookSubscription %s", params.WebhookSubscriptionID.String()), zap.Error(err))
+				return handlers.ResponseForError(appCtx.Logger(), err), err
+			}
+
+			payload := payloads.WebhookSubscriptionPayload(*rotatedWebhookSubscription)
+			payload.SigningSecret = rotatedWebhookSubscription.SigningSecret
+			return webhooksubscriptionop.NewRotateWebhookSubscriptionSecretOK().WithPayload(payload), nil
+		})
+}
@@ -1,6 +1,7 @@
 package adminapi
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 	"time"
@@ -189,6 +190,8 @@ func (suite *HandlerSuite) TestCreateWebhookSubscriptionHandler() {
 
 		subscriptionCreated := response.(*webhooksubscriptionop.CreateWebhookSubscriptionCreated)
 		suite.NotEqual(subscriptionCreated.Payload.ID.String(), "00000000-0000-0000-0000-000000000000")
+		suite.NotNil(subscriptionCreated.Payload.SigningSecret)
+		suite.Len(*subscriptionCreated.Payload.SigningSecret, 64)
 	})
 
 	suite.Run("400 - Invalid Request", func() {
@@ -352,3 +355,174 @@ func (suite *HandlerSuite) TestUpdateWebhookSubscriptionHandler() {
 		suite.IsType(&webhooksubscriptionop.UpdateWebhookSubscriptionPreconditionFailed{}, response)
 	})
 }
+
+func (suite *HandlerSuite) TestReplayWebhookSubscriptionHandler() {
+	suite.Run("200 - OK, Successfully replayed dead lettered notifications", func() {
+		// Testing:           ReplayWebhookSubscriptionHandler, WebhookNotificationReplayer
+		// Set up:            Provide a valid request with the id of a subscription whose event
+		//                    has a dead lettered notification
+		// Expected Outcome:  The notification is PENDING again and we receive a 200 OK with the count
+		webhookSubscription := testdatagen.MakeDefaultWebhookSubscription(suite.DB())
+		deadLetter := factory.BuildWebhookNotification(suite.DB(), []factory.Customization{
+			{
+				Model: models.WebhookNotification{
+					EventKey: webhookSubscription.EventKey,
+					Status:   models.WebhookNotificationDeadLetter,
+				},
+			},
+		}, nil)
+		params := webhooksubscriptionop.ReplayWebhookSubscriptionParams{
+			HTTPRequest:           suite.setupAuthenticatedRequest("POST", fmt.Sprintf("/webhook-subscriptions/%s/replay", webhookSubscription.ID)),
+			WebhookSubscriptionID: strfmt.UUID(webhookSubscription.ID.String()),
+		}
+
+		queryBuilder := query.NewQueryBuilder()
+		handler := ReplayWebhookSubscriptionHandler{
+			suite.NewHandlerConfig(),
+			webhooksubscription.NewWebhookNotificationReplayer(queryBuilder),
+		}
+
+		response := handler.Handle(params)
+
+		suite.IsType(&webhooksubscriptionop.ReplayWebhookSubscriptionOK{}, response)
+		okResponse := response.(*webhooksubscriptionop.ReplayWebhookSubscriptionOK)
+		suite.Equal(int64(1), *okResponse.Payload.ReplayedCount)
+
+		suite.NoError(suite.DB().Find(&deadLetter, deadLetter.ID))
+		suite.Equal(models.WebhookNotificationPending, deadLetter.Status)
+	})
+
+	suite.Run("404 - Not Found", func() {
+		// Testing:           ReplayWebhookSubscriptionHandler, WebhookNotificationReplayer
+		// Set up:            Provide a request with an ID that doesn't exist
+		// Expected Outcome:  We receive a 404 Not Found error.
+		fakeID, err := uuid.NewV4()
+		suite.NoError(err)
+		params := webhooksubscriptionop.ReplayWebhookSubscriptionParams{
+			HTTPRequest:           suite.setupAuthenticatedRequest("POST", fmt.Sprintf("/webhook-subscriptions/%s/replay", fakeID)),
+			WebhookSubscriptionID: strfmt.UUID(fakeID.String()),
+		}
+
+		queryBuilder := query.NewQueryBuilder()
+		handler := ReplayWebhookSubscriptionHandler{
+			suite.NewHandlerConfig(),
+			webhooksubscription.NewWebhookNotificationReplayer(queryBuilder),
+		}
+
+		response := handler.Handle(params)
+		suite.IsType(&webhooksubscriptionop.ReplayWebhookSubscriptionNotFound{}, response)
+	})
+
+	suite.Run("500 - Replay fails", func() {
+		// Testing:           ReplayWebhookSubscriptionHandler
+		// Mocks:             WebhookNotificationReplayer
+		// Set up:            Mock the replayer to return an unexpected error
+		// Expected Outcome:  We receive a 500 error.
+		webhookSubscriptionID, err := uuid.NewV4()
+		suite.NoError(err)
+		params := webhooksubscriptionop.ReplayWebhookSubscriptionParams{
+			HTTPRequest:           suite.setupAuthenticatedRequest("POST", fmt.Sprintf("/webhook-subscriptions/%s/replay", webhookSubscriptionID)),
+			WebhookSubscriptionID: strfmt.UUID(webhookSubscriptionID.String()),
+		}
+
+		replayer := &mocks.WebhookNotificationReplayer{}
+		replayer.On("ReplayDeadLetteredNotifications",
+			mock.AnythingOfType("*appcontext.appContext"),
+			webhookSubscriptionID,
+		).Return(0, errors.New("database is down")).Once()
+
+		handler := ReplayWebhookSubscriptionHandler{
+			suite.NewHandlerConfig(),
+			replayer,
+		}
+
+		response := handler.Handle(params)
+		suite.IsType(&handlers.ErrResponse{}, response)
+		suite.Equal(http.StatusInternalServerError, response.(*handlers.ErrResponse).Code)
+	})
+}
+
+func (suite *HandlerSuite) TestRotateWebhookSubscriptionSecretHandler() {
+	suite.Run("200 - OK, Successfully rotated the signing secret", func() {
+		// Testing:           RotateWebhookSubscriptionSecretHandler, WebhookSubscriptionSecretRotator
+		// Set up:            Provide a valid request with the id of a subscription and an overlap
+		// Expected Outcome:  The subscription has a new secret, the old one is kept until the overlap
+		//                    has passed and we receive a 200 OK with the new secret
+		webhookSubscription := testdatagen.MakeDefaultWebhookSubscription(suite.DB())
+		overlapMinutes := int64(60)
+		params := webhooksubscriptionop.RotateWebhookSubscriptionSecretParams{
+			HTTPRequest:           suite.setupAuthenticatedRequest("POST", fmt.Sprintf("/webhook-subscriptions/%s/rotate-secret", webhookSubscription.ID)),
+			WebhookSubscriptionID: strfmt.UUID(webhookSubscription.ID.String()),
+			OverlapMinutes:        &overlapMinutes,
+		}
+
+		queryBuilder := query.NewQueryBuilder()
+		handler := RotateWebhookSubscriptionSecretHandler{
+			suite.NewHandlerConfig(),
+			webhooksubscription.NewWebhookSubscriptionSecretRotator(queryBuilder),
+		}
+
+		response := handler.Handle(params)
+
+		suite.IsType(&webhooksubscriptionop.RotateWebhookSubscriptionSecretOK{}, response)
+		okResponse := response.(*webhooksubscriptionop.RotateWebhookSubscriptionSecretOK)
+		suite.NotNil(okResponse.Payload.SigningSecret)
+		suite.NotEqual(*webhookSubscription.SigningSecret, *okResponse.Payload.SigningSecret)
+		suite.NotNil(okResponse.Payload.PreviousSigningSecretExpiresAt)
+
+		var rotated models.WebhookSubscription
+		suite.NoError(suite.DB().Find(&rotated, webhookSubscription.ID))
+		suite.Equal(*okResponse.Payload.SigningSecret, *rotated.SigningSecret)
+		suite.Equal(*webhookSubscription.SigningSecret, *rotated.PreviousSigningSecret)
+	})
+
+	suite.Run("404 - Not Found", func() {
+		// Testing:           RotateWebhookSubscriptionSecretHandler, WebhookSubscriptionSecretRotator
+		// Set up:            Provide a request with an ID that doesn't exist
+		// Expected Outcome:  We receive a 404 Not Found error.
+		fakeID, err := uuid.NewV4()
+		suite.NoError(err)
+		params := webhooksubscriptionop.RotateWebhookSubscriptionSecretParams{
+			HTTPRequest:           suite.setupAuthenticatedRequest("POST", fmt.Sprintf("/webhook-subscriptions/%s/rotate-secret", fakeID)),
+			WebhookSubscriptionID: strfmt.UUID(fakeID.String()),
+		}
+
+		queryBuilder := query.NewQueryBuilder()
+		handler := RotateWebhookSubscriptionSecretHandler{
+			suite.NewHandlerConfig(),
+			webhooksubscription.NewWebhookSubscriptionSecretRotator(queryBuilder),
+		}
+
+		response := handler.Handle(params)
+		suite.IsType(&webhooksubscriptionop.RotateWebhookSubscriptionSecretNotFound{}, response)
+	})
+
+	suite.Run("500 - Rotation fails", func() {
+		// Testing:           RotateWebhookSubscriptionSecretHandler
+		// Mocks:             WebhookSubscriptionSecretRotator
+		// Set up:            Mock the rotator to return an unexpected error
+		// Expected Outcome:  We receive a 500 error.
+		webhookSubscriptionID, err := uuid.NewV4()
+		suite.NoError(err)
+		params := webhooksubscriptionop.RotateWebhookSubscriptionSecretParams{
+			HTTPRequest:           suite.setupAuthenticatedRequest("POST", fmt.Sprintf("/webhook-subscriptions/%s/rotate-secret", webhookSubscriptionID)),
+			WebhookSubscriptionID: strfmt.UUID(webhookSubscriptionID.String()),
+		}
+
+		rotator := &mocks.WebhookSubscriptionSecretRotator{}
+		rotator.On("RotateWebhookSubscriptionSecret",
+			mock.AnythingOfType("*appcontext.appContext"),
+			webhookSubscriptionID,
+			time.Duration(0),
+		).Return(nil, errors.New("database is down")).Once()
+
+		handler := RotateWebhookSubscriptionSecretHandler{
+			suite.NewHandlerConfig(),
+			rotator,
+		}
+
+		response := handler.Handle(params)
+		suite.IsType(&handlers.ErrResponse{}, response)
+		suite.Equal(http.StatusInternalServerError, response.(*handlers.ErrResponse).Code)
+	})
+}
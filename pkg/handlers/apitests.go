@@ -24,6 +24,7 @@ import (
 	"github.com/transcom/mymove/pkg/models"
 	"github.com/transcom/mymove/pkg/models/roles"
 	"github.com/transcom/mymove/pkg/notifications"
+	"github.com/transcom/mymove/pkg/pubsub"
 	"github.com/transcom/mymove/pkg/services"
 	"github.com/transcom/mymove/pkg/services/mocks"
 	"github.com/transcom/mymove/pkg/testingsuite"
@@ -121,6 +122,7 @@ func (suite *BaseHandlerTestSuite) NewHandlerConfig() *Config {
 		logger:             suite.Logger(),
 		appNames:           ApplicationTestServername(),
 		notificationSender: suite.TestNotificationSender(),
+		pubSub:             pubsub.NewMemoryPubSub(),
 		sessionManagers:    setupSessionManagers(),
 		featureFlagFetcher: mockFeatureFlagFetcher,
 	}
@@ -736,7 +736,14 @@ func (h ActiveRoleUpdateHandler) ServeHTTP(w http.ResponseWriter, r *http.Reques
 	}
 
 	// We have the role, now fetch the permissions
-	newPermissions := GetPermissionsForRole(userNewlyAssignedActiveRole.RoleType)
+	newPermissions, err := GetPermissionsForRole(appCtx, userNewlyAssignedActiveRole.RoleType)
+	if err != nil {
+		appCtx.Logger().Error("Error fetching permissions for role", zap.Error(err),
+			zap.String("requestedRole", string(requestedRole)),
+			zap.String("userID", string(appCtx.Session().UserID.String())))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
 
 	sessionManager := h.SessionManagers().SessionManagerForApplication(appCtx.Session().ApplicationName)
 	if sessionManager == nil {
@@ -1199,7 +1206,13 @@ func AuthorizeKnownUser(ctx context.Context, appCtx appcontext.AppContext, userI
 		appCtx.Session().ActiveRole = *defaultRole
 	}
 
-	appCtx.Session().Permissions = getPermissionsForUser(appCtx)
+	permissions, err := getPermissionsForUser(appCtx)
+	if err != nil {
+		appCtx.Logger().Error("Error fetching permissions for active role", zap.Error(err),
+			zap.String("user_id", appCtx.Session().UserID.String()))
+		return authorizationResultError
+	}
+	appCtx.Session().Permissions = permissions
 
 	appCtx.Session().UserID = userIdentity.ID
 	if appCtx.Session().IsMilApp() && userIdentity.ServiceMemberID != nil {
@@ -1469,7 +1482,12 @@ func authorizeUnknownUser(ctx context.Context, appCtx appcontext.AppContext, okt
 	} else {
 		appCtx.Session().ActiveRole = *defaultRole
 	}
-	appCtx.Session().Permissions = getPermissionsForUser(appCtx)
+	permissions, err := getPermissionsForUser(appCtx)
+	if err != nil {
+		appCtx.Logger().Error("Authenticating unknown user, cannot fetch permissions for active role", zap.Error(err))
+		return authorizationResultError
+	}
+	appCtx.Session().Permissions = permissions
 
 	if sessionManager == nil {
 		appCtx.Logger().Error("Authenticating user, cannot get session manager from request")
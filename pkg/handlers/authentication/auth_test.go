@@ -460,12 +460,13 @@ func (suite *AuthSuite) TestRequirePermissionsMiddlewareAuthorized() {
 
 	var activeRole roles.Role
 	for _, userRole := range tooOfficeUser.User.Roles {
-		if userRole.RoleType == TOO.RoleType {
+		if userRole.RoleType == roles.RoleTypeTOO {
 			activeRole = userRole
 		}
 	}
 	suite.NotEmpty(activeRole)
-	tooPerms := GetPermissionsForRole(TOO.RoleType)
+	tooPerms, err := GetPermissionsForRole(suite.AppContextForTest(), roles.RoleTypeTOO)
+	suite.NoError(err)
 	suite.NotEmpty(tooPerms)
 
 	// And: the context contains the auth values
@@ -739,7 +740,9 @@ func (suite *AuthSuite) TestAuthKnownSingleRoleOffice() {
 	suite.True(hasRole)
 	suite.Equal(userRole.ID, sessionRole.ID)
 	suite.NotEmpty(session.Permissions)
-	suite.ElementsMatch(TIO.Permissions, session.Permissions)
+	tioPerms, err := GetPermissionsForRole(suite.AppContextForTest(), roles.RoleTypeTIO)
+	suite.NoError(err)
+	suite.ElementsMatch(tioPerms, session.Permissions)
 }
 
 func (suite *AuthSuite) TestAuthSameKnownUserForAllApps() {
@@ -1646,7 +1649,9 @@ func (suite *AuthSuite) TestAuthorizeUnknownUserOfficeLogsInWithPermissions() {
 	suite.True(hasRole)
 	suite.Equal(userRole.ID, sessionRole.ID)
 	suite.NotEmpty(session.Permissions)
-	suite.ElementsMatch(QAE.Permissions, session.Permissions)
+	qaePerms, err := GetPermissionsForRole(suite.AppContextForTest(), roles.RoleTypeQae)
+	suite.NoError(err)
+	suite.ElementsMatch(qaePerms, session.Permissions)
 }
 
 func (suite *AuthSuite) TestAuthorizeUnknownUserAdminDeactivated() {
@@ -1256,7 +1256,10 @@ func createSession(h devlocalAuthHandler, user *models.User, userType string, _
 	}
 
 	session.ActiveRole = defaultRole
-	session.Permissions = getPermissionsForUser(appCtx)
+	session.Permissions, err = getPermissionsForUser(appCtx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Unable to fetch permissions for role %s", defaultRole.RoleType)
+	}
 
 	// Assign user identity to session
 	session.IDToken = "devlocal"
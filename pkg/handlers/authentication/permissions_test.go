@@ -15,7 +15,9 @@ import (
 )
 
 func (suite *AuthSuite) TestSwitchRolesSuccess() {
-	tooPerms := GetPermissionsForRole(roles.RoleTypeTOO)
+	tooPerms, err := GetPermissionsForRole(suite.AppContextForTest(), roles.RoleTypeTOO)
+	suite.FatalNoError(err)
+	suite.NotEmpty(tooPerms)
 
 	setupOfficeUserAndIdentity := func(userRoles []roles.RoleType) (models.OfficeUser, roles.Role) {
 		officeUser := factory.BuildOfficeUserWithRoles(
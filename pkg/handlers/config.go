@@ -18,6 +18,7 @@ import (
 	"github.com/transcom/mymove/pkg/iws"
 	"github.com/transcom/mymove/pkg/logging"
 	"github.com/transcom/mymove/pkg/notifications"
+	"github.com/transcom/mymove/pkg/pubsub"
 	"github.com/transcom/mymove/pkg/route"
 	"github.com/transcom/mymove/pkg/services"
 	"github.com/transcom/mymove/pkg/storage"
@@ -40,6 +41,7 @@ type HandlerConfig interface {
 	FileStorer() storage.FileStorer
 	NotificationSender() notifications.NotificationSender
 	NotificationReceiver() notifications.NotificationReceiver
+	PubSub() pubsub.PubSub
 	HHGPlanner() route.Planner
 	DTODPlanner() route.Planner
 	CookieSecret() string
@@ -68,6 +70,7 @@ type Config struct {
 	storage               storage.FileStorer
 	notificationSender    notifications.NotificationSender
 	notificationReceiver  notifications.NotificationReceiver
+	pubSub                pubsub.PubSub
 	iwsPersonLookup       iws.PersonLookup
 	sendProductionInvoice bool
 	senderToGex           services.GexSender
@@ -89,6 +92,7 @@ func NewHandlerConfig(
 	storage storage.FileStorer,
 	notificationSender notifications.NotificationSender,
 	notificationReceiver notifications.NotificationReceiver,
+	pubSub pubsub.PubSub,
 	iwsPersonLookup iws.PersonLookup,
 	sendProductionInvoice bool,
 	senderToGex services.GexSender,
@@ -107,6 +111,7 @@ func NewHandlerConfig(
 		storage:               storage,
 		notificationSender:    notificationSender,
 		notificationReceiver:  notificationReceiver,
+		pubSub:                pubSub,
 		iwsPersonLookup:       iwsPersonLookup,
 		sendProductionInvoice: sendProductionInvoice,
 		senderToGex:           senderToGex,
@@ -263,6 +268,16 @@ func (c *Config) SetNotificationReceiver(receiver notifications.NotificationRece
 	c.notificationReceiver = receiver
 }
 
+// PubSub returns the pubsub used to notify other servers
+func (c *Config) PubSub() pubsub.PubSub {
+	return c.pubSub
+}
+
+// SetPubSub is a simple setter for the pubsub private field
+func (c *Config) SetPubSub(pubSub pubsub.PubSub) {
+	c.pubSub = pubSub
+}
+
 // SetPlanner is a simple setter for the route.Planner private field
 func (c *Config) SetPlanner(planner route.Planner) {
 	c.planner = planner
@@ -15,10 +15,12 @@ import (
 	boatshipment "github.com/transcom/mymove/pkg/services/boat_shipment"
 	dateservice "github.com/transcom/mymove/pkg/services/calendar"
 	customerserviceremarks "github.com/transcom/mymove/pkg/services/customer_support_remarks"
+	edierrors "github.com/transcom/mymove/pkg/services/edi_errors"
 	"github.com/transcom/mymove/pkg/services/entitlements"
 	evaluationreport "github.com/transcom/mymove/pkg/services/evaluation_report"
 	"github.com/transcom/mymove/pkg/services/fetch"
 	"github.com/transcom/mymove/pkg/services/ghcrateengine"
+	"github.com/transcom/mymove/pkg/services/invoice"
 	lineofaccounting "github.com/transcom/mymove/pkg/services/line_of_accounting"
 	movelocker "github.com/transcom/mymove/pkg/services/lock_move"
 	mobileHomeShipment "github.com/transcom/mymove/pkg/services/mobile_home_shipment"
@@ -38,9 +40,11 @@ import (
 	portlocation "github.com/transcom/mymove/pkg/services/port_location"
 	ppmcloseout "github.com/transcom/mymove/pkg/services/ppm_closeout"
 	ppmshipment "github.com/transcom/mymove/pkg/services/ppmshipment"
+	pricingsimulator "github.com/transcom/mymove/pkg/services/pricing_simulator"
 	progear "github.com/transcom/mymove/pkg/services/progear_weight_ticket"
 	pwsviolation "github.com/transcom/mymove/pkg/services/pws_violation"
 	"github.com/transcom/mymove/pkg/services/query"
+	queueview "github.com/transcom/mymove/pkg/services/queue_view"
 	reportviolation "github.com/transcom/mymove/pkg/services/report_violation"
 	"github.com/transcom/mymove/pkg/services/roles"
 	serviceitem "github.com/transcom/mymove/pkg/services/service_item"
@@ -307,6 +311,11 @@ func NewGhcAPIHandler(handlerConfig handlers.HandlerConfig) *ghcops.MymoveAPI {
 		PaymentServiceItemStatusUpdater: paymentserviceitem.NewPaymentServiceItemStatusUpdater(),
 	}
 
+	ghcAPI.PaymentServiceItemGetPaymentServiceItemPricingTracesHandler = GetPaymentServiceItemPricingTracesHandler{
+		HandlerConfig:                         handlerConfig,
+		PaymentServiceItemPricingTraceFetcher: paymentserviceitem.NewPaymentServiceItemPricingTraceFetcher(),
+	}
+
 	ghcAPI.MoveTaskOrderGetMoveTaskOrderHandler = GetMoveTaskOrderHandler{
 		handlerConfig,
 		movetaskorder.NewMoveTaskOrderFetcher(waf),
@@ -610,6 +619,7 @@ func NewGhcAPIHandler(handlerConfig handlers.HandlerConfig) *ghcops.MymoveAPI {
 		order.NewOrderFetcher(waf),
 		movelocker.NewMoveUnlocker(),
 		officeuser.NewOfficeUserFetcherPop(),
+		queueview.NewQueueViewFetcher(),
 	}
 
 	ghcAPI.QueuesGetDestinationRequestsQueueHandler = GetDestinationRequestsQueueHandler{
@@ -617,6 +627,7 @@ func NewGhcAPIHandler(handlerConfig handlers.HandlerConfig) *ghcops.MymoveAPI {
 		order.NewOrderFetcher(waf),
 		movelocker.NewMoveUnlocker(),
 		officeuser.NewOfficeUserFetcherPop(),
+		queueview.NewQueueViewFetcher(),
 	}
 
 	ghcAPI.QueuesListPrimeMovesHandler = ListPrimeMovesHandler{
@@ -629,6 +640,7 @@ func NewGhcAPIHandler(handlerConfig handlers.HandlerConfig) *ghcops.MymoveAPI {
 		paymentrequest.NewPaymentRequestListFetcher(),
 		movelocker.NewMoveUnlocker(),
 		officeuser.NewOfficeUserFetcherPop(),
+		queueview.NewQueueViewFetcher(),
 	}
 
 	ghcAPI.QueuesGetServicesCounselingQueueHandler = GetServicesCounselingQueueHandler{
@@ -636,6 +648,35 @@ func NewGhcAPIHandler(handlerConfig handlers.HandlerConfig) *ghcops.MymoveAPI {
 		order.NewOrderFetcher(waf),
 		movelocker.NewMoveUnlocker(),
 		officeuser.NewOfficeUserFetcherPop(),
+		queueview.NewQueueViewFetcher(),
+	}
+
+	ghcAPI.QueuesListQueueViewsHandler = ListQueueViewsHandler{
+		handlerConfig,
+		queueview.NewQueueViewFetcher(),
+	}
+
+	ghcAPI.QueuesCreateQueueViewHandler = CreateQueueViewHandler{
+		handlerConfig,
+		queueview.NewQueueViewCreator(),
+	}
+
+	ghcAPI.QueuesUpdateQueueViewHandler = UpdateQueueViewHandler{
+		handlerConfig,
+		queueview.NewQueueViewUpdater(),
+	}
+
+	ghcAPI.QueuesDeleteQueueViewHandler = DeleteQueueViewHandler{
+		handlerConfig,
+		queueview.NewQueueViewDeleter(),
+	}
+
+	ghcAPI.QueuesExportQueueHandler = ExportQueueHandler{
+		handlerConfig,
+		queueview.NewQueueViewFetcher(),
+		order.NewOrderFetcher(waf),
+		paymentrequest.NewPaymentRequestListFetcher(),
+		officeuser.NewOfficeUserFetcherPop(),
 	}
 
 	ghcAPI.QueuesGetServicesCounselingOriginListHandler = GetServicesCounselingOriginListHandler{
@@ -792,6 +833,8 @@ func NewGhcAPIHandler(handlerConfig handlers.HandlerConfig) *ghcops.MymoveAPI {
 	ghcAPI.UploadsDeleteUploadHandler = DeleteUploadHandler{handlerConfig, upload.NewUploadInformationFetcher()}
 	ghcAPI.UploadsGetUploadStatusHandler = GetUploadStatusHandler{handlerConfig, upload.NewUploadInformationFetcher()}
 	ghcAPI.TextEventStreamProducer = runtime.ByteStreamProducer() // GetUploadStatus produces Event Stream
+	ghcAPI.CsvProducer = runtime.CSVProducer()                    // ExportQueue produces CSV
+	ghcAPI.XMLProducer = runtime.ByteStreamProducer()             // ExportQueue produces XLSX
 
 	ghcAPI.CustomerSearchCustomersHandler = SearchCustomersHandler{
 		HandlerConfig:    handlerConfig,
@@ -845,6 +888,16 @@ func NewGhcAPIHandler(handlerConfig handlers.HandlerConfig) *ghcops.MymoveAPI {
 		MoveUnlocker:  movelocker.NewMoveUnlocker(),
 	}
 
+	ghcAPI.MoveRenewMoveLockHandler = RenewMoveLockHandler{
+		HandlerConfig: handlerConfig,
+		MoveLocker:    moveLocker,
+	}
+
+	ghcAPI.MoveTakeOverMoveLockHandler = TakeOverMoveLockHandler{
+		HandlerConfig: handlerConfig,
+		MoveLocker:    moveLocker,
+	}
+
 	ghcAPI.ReServiceItemsGetAllReServiceItemsHandler = GetReServiceItemsHandler{
 		handlerConfig,
 		serviceItemFetcher,
@@ -860,5 +913,25 @@ func NewGhcAPIHandler(handlerConfig handlers.HandlerConfig) *ghcops.MymoveAPI {
 		countrySearcher,
 	}
 
+	ghcAPI.PricingSimulatePricingHandler = SimulatePricingHandler{
+		handlerConfig,
+		pricingsimulator.NewPricingSimulator(handlerConfig.HHGPlanner()),
+	}
+
+	ediErrorTriager := edierrors.NewEDIErrorTriager()
+	ghcAPI.EdiErrorsTriageEdiErrorsHandler = TriageEdiErrorsHandler{
+		handlerConfig,
+		ediErrorTriager,
+	}
+	ghcAPI.PaymentRequestsResubmitPaymentRequestHandler = ResubmitPaymentRequestHandler{
+		handlerConfig,
+		ediErrorTriager,
+	}
+
+	ghcAPI.TppsReconciliationGetTPPSReconciliationHandler = GetTPPSReconciliationHandler{
+		handlerConfig,
+		invoice.NewTPPSReconciler(),
+	}
+
 	return ghcAPI
 }
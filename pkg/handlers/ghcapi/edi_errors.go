@@ -0,0 +1,82 @@
+package ghcapi
+
+import (
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/gobuffalo/validate/v3"
+	"github.com/gofrs/uuid"
+	"go.uber.org/zap"
+
+	"github.com/transcom/mymove/pkg/appcontext"
+	"github.com/transcom/mymove/pkg/apperror"
+	edierrorsop "github.com/transcom/mymove/pkg/gen/ghcapi/ghcoperations/edi_errors"
+	paymentrequestop "github.com/transcom/mymove/pkg/gen/ghcapi/ghcoperations/payment_requests"
+	"github.com/transcom/mymove/pkg/gen/ghcmessages"
+	"github.com/transcom/mymove/pkg/handlers"
+	"github.com/transcom/mymove/pkg/handlers/ghcapi/internal/payloads"
+	"github.com/transcom/mymove/pkg/services"
+)
+
+// TriageEdiErrorsHandler groups the open EDI errors of rejected payment requests
+type TriageEdiErrorsHandler struct {
+	handlers.HandlerConfig
+	services.EDIErrorTriager
+}
+
+// Handle returns the EDI error groups, largest first
+func (h TriageEdiErrorsHandler) Handle(params edierrorsop.TriageEdiErrorsParams) middleware.Responder {
+	return h.AuditableAppContextFromRequestWithErrors(params.HTTPRequest,
+		func(appCtx appcontext.AppContext) (middleware.Responder, error) {
+			if !appCtx.Session().IsOfficeUser() {
+				return edierrorsop.NewTriageEdiErrorsForbidden(), apperror.NewForbiddenError("only office users may triage EDI errors")
+			}
+
+			groups, err := h.TriageEdiErrors(appCtx)
+			if err != nil {
+				appCtx.Logger().Error("TriageEdiErrorsHandler error", zap.Error(err))
+				return edierrorsop.NewTriageEdiErrorsInternalServerError(), err
+			}
+
+			return edierrorsop.NewTriageEdiErrorsOK().WithPayload(payloads.EdiErrorGroups(groups)), nil
+		})
+}
+
+// ResubmitPaymentRequestHandler sends a payment request Syncada rejected again
+type ResubmitPaymentRequestHandler struct {
+	handlers.HandlerConfig
+	services.EDIErrorTriager
+}
+
+// Handle resolves the payment request's EDI errors and returns it to REVIEWED status
+func (h ResubmitPaymentRequestHandler) Handle(params paymentrequestop.ResubmitPaymentRequestParams) middleware.Responder {
+	return h.AuditableAppContextFromRequestWithErrors(params.HTTPRequest,
+		func(appCtx appcontext.AppContext) (middleware.Responder, error) {
+			if !appCtx.Session().IsOfficeUser() {
+				return paymentrequestop.NewResubmitPaymentRequestForbidden(), apperror.NewForbiddenError("only office users may resubmit payment requests")
+			}
+
+			paymentRequestID := uuid.FromStringOrNil(params.PaymentRequestID.String())
+			paymentRequest, err := h.ResubmitPaymentRequest(appCtx, paymentRequestID, params.IfMatch)
+			if err != nil {
+				appCtx.Logger().Error("ResubmitPaymentRequestHandler error", zap.Error(err))
+				switch err.(type) {
+				case apperror.NotFoundError:
+					return paymentrequestop.NewResubmitPaymentRequestNotFound().WithPayload(&ghcmessages.Error{Message: handlers.FmtString(err.Error())}), err
+				case apperror.ConflictError:
+					return paymentrequestop.NewResubmitPaymentRequestConflict().WithPayload(&ghcmessages.Error{Message: handlers.FmtString(err.Error())}), err
+				case apperror.PreconditionFailedError:
+					return paymentrequestop.NewResubmitPaymentRequestPreconditionFailed().WithPayload(&ghcmessages.Error{Message: handlers.FmtString(err.Error())}), err
+				case apperror.UnprocessableEntityError, apperror.InvalidInputError:
+					payload := payloadForValidationError("Unable to resubmit payment request", err.Error(), h.GetTraceIDFromRequest(params.HTTPRequest), validate.NewErrors())
+					return paymentrequestop.NewResubmitPaymentRequestUnprocessableEntity().WithPayload(payload), err
+				default:
+					return paymentrequestop.NewResubmitPaymentRequestInternalServerError(), err
+				}
+			}
+
+			payload, err := payloads.PaymentRequest(appCtx, paymentRequest, h.FileStorer())
+			if err != nil {
+				return paymentrequestop.NewResubmitPaymentRequestInternalServerError(), err
+			}
+			return paymentrequestop.NewResubmitPaymentRequestOK().WithPayload(payload), nil
+		})
+}
@@ -0,0 +1,121 @@
+package ghcapi
+
+import (
+	"net/http/httptest"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/transcom/mymove/pkg/apperror"
+	"github.com/transcom/mymove/pkg/factory"
+	edierrorsop "github.com/transcom/mymove/pkg/gen/ghcapi/ghcoperations/edi_errors"
+	paymentrequestop "github.com/transcom/mymove/pkg/gen/ghcapi/ghcoperations/payment_requests"
+	"github.com/transcom/mymove/pkg/models"
+	"github.com/transcom/mymove/pkg/models/roles"
+	"github.com/transcom/mymove/pkg/services"
+	"github.com/transcom/mymove/pkg/services/mocks"
+)
+
+func (suite *HandlerSuite) TestTriageEdiErrorsHandler() {
+	setupTestData := func() (*mocks.EDIErrorTriager, TriageEdiErrorsHandler, edierrorsop.TriageEdiErrorsParams) {
+		officeUser := factory.BuildOfficeUserWithRoles(suite.DB(), nil, []roles.RoleType{roles.RoleTypeTIO})
+		req := httptest.NewRequest("GET", "/edi-errors/triage", nil)
+		req = suite.AuthenticateOfficeRequest(req, officeUser)
+
+		mockTriager := &mocks.EDIErrorTriager{}
+		handler := TriageEdiErrorsHandler{
+			HandlerConfig:   suite.NewHandlerConfig(),
+			EDIErrorTriager: mockTriager,
+		}
+		return mockTriager, handler, edierrorsop.TriageEdiErrorsParams{HTTPRequest: req}
+	}
+
+	suite.Run("returns the error groups", func() {
+		mockTriager, handler, params := setupTestData()
+		paymentRequestID := uuid.Must(uuid.NewV4())
+		mockTriager.On("TriageEdiErrors", mock.AnythingOfType("*appcontext.appContext")).Return([]services.EDIErrorGroup{
+			{
+				EDIType:           models.EDIType824,
+				Code:              "007",
+				Count:             2,
+				PaymentRequestIDs: []uuid.UUID{paymentRequestID},
+				ProposedFix:       "Add the TAC",
+				Resubmittable:     true,
+			},
+		}, nil)
+
+		response := handler.Handle(params)
+		suite.IsType(&edierrorsop.TriageEdiErrorsOK{}, response)
+		payload := response.(*edierrorsop.TriageEdiErrorsOK).Payload
+		suite.NoError(payload.Validate(strfmt.Default))
+		suite.Len(payload, 1)
+		suite.Equal("824", payload[0].EdiType)
+		suite.Equal("007", payload[0].Code)
+		suite.Equal(int64(2), payload[0].Count)
+		suite.Equal(strfmt.UUID(paymentRequestID.String()), payload[0].PaymentRequestIDs[0])
+		suite.True(payload[0].Resubmittable)
+	})
+
+	suite.Run("query failures return an internal server error", func() {
+		mockTriager, handler, params := setupTestData()
+		mockTriager.On("TriageEdiErrors", mock.AnythingOfType("*appcontext.appContext")).Return(nil, apperror.NewQueryError("EdiErrors", nil, ""))
+
+		response := handler.Handle(params)
+		suite.IsType(&edierrorsop.TriageEdiErrorsInternalServerError{}, response)
+	})
+}
+
+func (suite *HandlerSuite) TestResubmitPaymentRequestHandler() {
+	setupTestData := func() (*mocks.EDIErrorTriager, ResubmitPaymentRequestHandler, paymentrequestop.ResubmitPaymentRequestParams, models.PaymentRequest) {
+		officeUser := factory.BuildOfficeUserWithRoles(suite.DB(), nil, []roles.RoleType{roles.RoleTypeTIO})
+		paymentRequest := factory.BuildPaymentRequest(suite.DB(), nil, nil)
+		req := httptest.NewRequest("POST", "/payment-requests/"+paymentRequest.ID.String()+"/resubmit", nil)
+		req = suite.AuthenticateOfficeRequest(req, officeUser)
+
+		mockTriager := &mocks.EDIErrorTriager{}
+		handler := ResubmitPaymentRequestHandler{
+			HandlerConfig:   suite.NewHandlerConfig(),
+			EDIErrorTriager: mockTriager,
+		}
+		params := paymentrequestop.ResubmitPaymentRequestParams{
+			HTTPRequest:      req,
+			PaymentRequestID: strfmt.UUID(paymentRequest.ID.String()),
+			IfMatch:          "etag",
+		}
+		return mockTriager, handler, params, paymentRequest
+	}
+
+	suite.Run("returns the resubmitted payment request", func() {
+		mockTriager, handler, params, paymentRequest := setupTestData()
+		paymentRequest.Status = models.PaymentRequestStatusReviewed
+		mockTriager.On("ResubmitPaymentRequest", mock.AnythingOfType("*appcontext.appContext"), paymentRequest.ID, "etag").Return(&paymentRequest, nil)
+
+		response := handler.Handle(params)
+		suite.IsType(&paymentrequestop.ResubmitPaymentRequestOK{}, response)
+		payload := response.(*paymentrequestop.ResubmitPaymentRequestOK).Payload
+		suite.Equal(strfmt.UUID(paymentRequest.ID.String()), payload.ID)
+		suite.Equal("REVIEWED", string(payload.Status))
+	})
+
+	errorCases := []struct {
+		name     string
+		err      error
+		response interface{}
+	}{
+		{"not found", apperror.NewNotFoundError(uuid.Nil, ""), &paymentrequestop.ResubmitPaymentRequestNotFound{}},
+		{"wrong status", apperror.NewConflictError(uuid.Nil, "not in EDI_ERROR"), &paymentrequestop.ResubmitPaymentRequestConflict{}},
+		{"stale eTag", apperror.NewPreconditionFailedError(uuid.Nil, nil), &paymentrequestop.ResubmitPaymentRequestPreconditionFailed{}},
+		{"previously paid", apperror.NewUnprocessableEntityError("already paid"), &paymentrequestop.ResubmitPaymentRequestUnprocessableEntity{}},
+	}
+	for _, errorCase := range errorCases {
+		errorCase := errorCase
+		suite.Run(errorCase.name, func() {
+			mockTriager, handler, params, paymentRequest := setupTestData()
+			mockTriager.On("ResubmitPaymentRequest", mock.AnythingOfType("*appcontext.appContext"), paymentRequest.ID, "etag").Return(nil, errorCase.err)
+
+			response := handler.Handle(params)
+			suite.IsType(errorCase.response, response)
+		})
+	}
+}
@@ -24,6 +24,7 @@ import (
 	mtoshipment "github.com/transcom/mymove/pkg/services/mto_shipment"
 	"github.com/transcom/mymove/pkg/storage"
 	"github.com/transcom/mymove/pkg/unit"
+	"github.com/transcom/mymove/pkg/uploader"
 )
 
 // Contractor payload
@@ -2137,12 +2138,7 @@ func Upload(storer storage.FileStorer, upload models.Upload, url string) *ghcmes
 		uploadPayload.Rotation = *upload.Rotation
 	}
 
-	tags, err := storer.Tags(upload.StorageKey)
-	if err != nil {
-		uploadPayload.Status = string(models.AVStatusPROCESSING)
-	} else {
-		uploadPayload.Status = string(models.GetAVStatusFromTags(tags))
-	}
+	uploadPayload.Status = string(uploader.AVStatus(storer, upload))
 	return uploadPayload
 }
 
@@ -2159,12 +2155,7 @@ func WeightTicketUpload(storer storage.FileStorer, upload models.Upload, url str
 		UpdatedAt:      strfmt.DateTime(upload.UpdatedAt),
 		IsWeightTicket: isWeightTicket,
 	}
-	tags, err := storer.Tags(upload.StorageKey)
-	if err != nil {
-		uploadPayload.Status = string(models.AVStatusPROCESSING)
-	} else {
-		uploadPayload.Status = string(models.GetAVStatusFromTags(tags))
-	}
+	uploadPayload.Status = string(uploader.AVStatus(storer, upload))
 	return uploadPayload
 }
 
@@ -2215,12 +2206,7 @@ func PayloadForUploadModel(
 		uploadPayload.Rotation = *upload.Rotation
 	}
 
-	tags, err := storer.Tags(upload.StorageKey)
-	if err != nil {
-		uploadPayload.Status = string(models.AVStatusPROCESSING)
-	} else {
-		uploadPayload.Status = string(models.GetAVStatusFromTags(tags))
-	}
+	uploadPayload.Status = string(uploader.AVStatus(storer, upload))
 	return uploadPayload
 }
 
@@ -2737,6 +2723,71 @@ func QueuePaymentRequests(paymentRequests *models.PaymentRequests, officeUsers [
 	return &queuePaymentRequests
 }
 
+// QueueViewFilters payload
+func QueueViewFilters(filters models.QueueViewFilters) *ghcmessages.QueueViewFilters {
+	return &ghcmessages.QueueViewFilters{
+		Branch:                  filters.Branch,
+		Locator:                 filters.Locator,
+		Edipi:                   filters.Edipi,
+		Emplid:                  filters.Emplid,
+		CustomerName:            filters.CustomerName,
+		OriginDutyLocation:      filters.OriginDutyLocation,
+		DestinationDutyLocation: filters.DestinationDutyLocation,
+		OriginGBLOC:             filters.OriginGBLOC,
+		SubmittedAt:             handlers.FmtDateTimePtr(filters.SubmittedAt),
+		AppearedInTooAt:         handlers.FmtDateTimePtr(filters.AppearedInTOOAt),
+		RequestedMoveDate:       filters.RequestedMoveDate,
+		Status:                  filters.Status,
+		OrderType:               filters.OrderType,
+		PpmType:                 filters.PPMType,
+		PpmStatus:               filters.PPMStatus,
+		CloseoutInitiated:       handlers.FmtDateTimePtr(filters.CloseoutInitiated),
+		CloseoutLocation:        filters.CloseoutLocation,
+		CounselingOffice:        filters.CounselingOffice,
+		AssignedTo:              filters.AssignedTo,
+		ViewAsGBLOC:             filters.ViewAsGBLOC,
+	}
+}
+
+// QueueView payload, with IsOwner set for the office user the view is returned to
+func QueueView(view *models.OfficeQueueView, officeUserID uuid.UUID) *ghcmessages.QueueView {
+	if view == nil {
+		return nil
+	}
+
+	queueType := ghcmessages.QueueType(view.QueueType)
+	payload := &ghcmessages.QueueView{
+		ID:        handlers.FmtUUID(view.ID),
+		QueueType: &queueType,
+		Name:      handlers.FmtString(view.Name),
+		Filters:   QueueViewFilters(view.Filters),
+		Sort:      view.Sort,
+		Order:     view.Order,
+		Columns:   view.Columns,
+		Shared:    handlers.FmtBool(view.IsShared()),
+		IsOwner:   handlers.FmtBool(view.OfficeUserID == officeUserID),
+		CreatedAt: strfmt.DateTime(view.CreatedAt),
+		UpdatedAt: strfmt.DateTime(view.UpdatedAt),
+		ETag:      handlers.FmtString(etag.GenerateEtag(view.UpdatedAt)),
+	}
+	if payload.Columns == nil {
+		payload.Columns = []string{}
+	}
+	if view.OfficeUser.ID != uuid.Nil {
+		payload.OwnerName = fmt.Sprintf("%s, %s", view.OfficeUser.LastName, view.OfficeUser.FirstName)
+	}
+	return payload
+}
+
+// QueueViews payload
+func QueueViews(views models.OfficeQueueViews, officeUserID uuid.UUID) ghcmessages.QueueViews {
+	payload := make(ghcmessages.QueueViews, len(views))
+	for i := range views {
+		payload[i] = QueueView(&views[i], officeUserID)
+	}
+	return payload
+}
+
 // Reweigh payload
 func Reweigh(reweigh *models.Reweigh, _ *ghcmessages.SITStatus) *ghcmessages.Reweigh {
 	if reweigh == nil || reweigh.ID == uuid.Nil {
@@ -3006,3 +3057,127 @@ func Countries(countries models.Countries) ghcmessages.Countries {
 	}
 	return payload
 }
+
+// PricingSimulation payload
+func PricingSimulation(simulation *services.PricingSimulation) *ghcmessages.PricingSimulation {
+	if simulation == nil {
+		return nil
+	}
+
+	serviceItems := make([]*ghcmessages.SimulatedServiceItemPrice, len(simulation.ServiceItems))
+	for i, serviceItem := range simulation.ServiceItems {
+		params := make([]*ghcmessages.SimulatedPricingParam, len(serviceItem.PricingParams))
+		for j, param := range serviceItem.PricingParams {
+			params[j] = &ghcmessages.SimulatedPricingParam{
+				Key:   param.Key.String(),
+				Value: param.Value,
+			}
+		}
+		serviceItems[i] = &ghcmessages.SimulatedServiceItemPrice{
+			ServiceCode: serviceItem.ServiceCode.String(),
+			PriceCents:  serviceItem.PriceCents.Int64(),
+			Params:      params,
+		}
+	}
+
+	return &ghcmessages.PricingSimulation{
+		ContractCode:    simulation.ContractCode,
+		Distance:        int64(simulation.Distance),
+		TotalPriceCents: simulation.TotalPriceCents.Int64(),
+		ServiceItems:    serviceItems,
+	}
+}
+
+// EdiErrorGroups payload
+func EdiErrorGroups(groups []services.EDIErrorGroup) ghcmessages.EdiErrorGroups {
+	payload := make(ghcmessages.EdiErrorGroups, len(groups))
+	for i, group := range groups {
+		paymentRequestIDs := make([]strfmt.UUID, len(group.PaymentRequestIDs))
+		for j, id := range group.PaymentRequestIDs {
+			paymentRequestIDs[j] = strfmt.UUID(id.String())
+		}
+		payload[i] = &ghcmessages.EdiErrorGroup{
+			EdiType:           group.EDIType.String(),
+			Code:              group.Code,
+			Count:             int64(group.Count),
+			PaymentRequestIDs: paymentRequestIDs,
+			ProposedFix:       group.ProposedFix,
+			Resubmittable:     group.Resubmittable,
+		}
+	}
+	return payload
+}
+
+// TPPSReconciliationReport payload
+func TPPSReconciliationReport(report *services.TPPSReconciliationReport) *ghcmessages.TPPSReconciliationReport {
+	if report == nil {
+		return nil
+	}
+
+	lines := make([]*ghcmessages.TPPSReconciliationLine, len(report.Lines))
+	for i, line := range report.Lines {
+		lines[i] = &ghcmessages.TPPSReconciliationLine{
+			PaymentRequestID:     strfmt.UUID(line.PaymentRequestID.String()),
+			PaymentRequestNumber: line.PaymentRequestNumber,
+			PaymentServiceItemID: handlers.FmtUUIDPtr(line.PaymentServiceItemID),
+			ReferenceID:          line.ReferenceID,
+			ServiceCode:          line.ServiceCode,
+			BilledCents:          handlers.FmtCost(line.BilledCents),
+			PaidCents:            handlers.FmtCost(line.PaidCents),
+			VarianceCents:        line.VarianceCents.Int64(),
+			SellerPaidDate:       handlers.FmtDatePtr(line.SellerPaidDate),
+			Status:               string(line.Status),
+		}
+		// TPPS invoices we have no payment request for have no id
+		if line.PaymentRequestID == uuid.Nil {
+			lines[i].PaymentRequestID = ""
+		}
+	}
+
+	return &ghcmessages.TPPSReconciliationReport{
+		StartDate:        strfmt.Date(report.StartDate),
+		EndDate:          strfmt.Date(report.EndDate),
+		TotalBilledCents: report.TotalBilledCents.Int64(),
+		TotalPaidCents:   report.TotalPaidCents.Int64(),
+		Lines:            lines,
+	}
+}
+
+func pricingTraceParams(params []models.PricingTraceParam) []*ghcmessages.PricingTraceParam {
+	payload := make([]*ghcmessages.PricingTraceParam, len(params))
+	for i, param := range params {
+		payload[i] = &ghcmessages.PricingTraceParam{
+			Key:   param.Key,
+			Value: param.Value,
+		}
+	}
+	return payload
+}
+
+// PaymentServiceItemPricingTraces payload
+func PaymentServiceItemPricingTraces(traces models.PaymentServiceItemPricingTraces) ghcmessages.PaymentServiceItemPricingTraces {
+	payload := make(ghcmessages.PaymentServiceItemPricingTraces, len(traces))
+	for i, trace := range traces {
+		priceRows := make([]*ghcmessages.PricingTraceRow, len(trace.Trace.PriceRows))
+		for j, row := range trace.Trace.PriceRows {
+			priceRows[j] = &ghcmessages.PricingTraceRow{
+				Table:  row.Table,
+				ID:     *handlers.FmtUUID(row.ID),
+				Values: pricingTraceParams(row.Values),
+			}
+		}
+		payload[i] = &ghcmessages.PaymentServiceItemPricingTrace{
+			ID:                   *handlers.FmtUUID(trace.ID),
+			PaymentServiceItemID: *handlers.FmtUUID(trace.PaymentServiceItemID),
+			PaymentRequestNumber: trace.PaymentServiceItem.PaymentRequest.PaymentRequestNumber,
+			ServiceCode:          string(trace.Trace.ServiceCode),
+			PriceCents:           trace.Trace.PriceCents.Int64(),
+			Inputs:               pricingTraceParams(trace.Trace.Inputs),
+			PriceRows:            priceRows,
+			Steps:                pricingTraceParams(trace.Trace.Steps),
+			Results:              pricingTraceParams(trace.Trace.Results),
+			CreatedAt:            strfmt.DateTime(trace.CreatedAt),
+		}
+	}
+	return payload
+}
@@ -1027,3 +1027,75 @@ func OfficeUserModelFromUpdate(payload *ghcmessages.OfficeUserUpdate, officeUser
 	}
 	return officeUser
 }
+
+// QueueViewFiltersModel converts the queue view filters payload to the stored filters
+func QueueViewFiltersModel(payload *ghcmessages.QueueViewFilters) models.QueueViewFilters {
+	if payload == nil {
+		return models.QueueViewFilters{}
+	}
+
+	return models.QueueViewFilters{
+		Branch:                  payload.Branch,
+		Locator:                 payload.Locator,
+		Edipi:                   payload.Edipi,
+		Emplid:                  payload.Emplid,
+		CustomerName:            payload.CustomerName,
+		OriginDutyLocation:      payload.OriginDutyLocation,
+		DestinationDutyLocation: payload.DestinationDutyLocation,
+		OriginGBLOC:             payload.OriginGBLOC,
+		SubmittedAt:             handlers.FmtDateTimePtrToPopPtr(payload.SubmittedAt),
+		AppearedInTOOAt:         handlers.FmtDateTimePtrToPopPtr(payload.AppearedInTooAt),
+		RequestedMoveDate:       payload.RequestedMoveDate,
+		Status:                  payload.Status,
+		OrderType:               payload.OrderType,
+		PPMType:                 payload.PpmType,
+		PPMStatus:               payload.PpmStatus,
+		CloseoutInitiated:       handlers.FmtDateTimePtrToPopPtr(payload.CloseoutInitiated),
+		CloseoutLocation:        payload.CloseoutLocation,
+		CounselingOffice:        payload.CounselingOffice,
+		AssignedTo:              payload.AssignedTo,
+		ViewAsGBLOC:             payload.ViewAsGBLOC,
+	}
+}
+
+// QueueViewModelFromCreate converts the payload for saving a queue view to a view owned by the office user
+func QueueViewModelFromCreate(payload *ghcmessages.CreateQueueViewPayload, officeUserID uuid.UUID) *models.OfficeQueueView {
+	if payload == nil {
+		return nil
+	}
+
+	view := &models.OfficeQueueView{
+		OfficeUserID: officeUserID,
+		Filters:      QueueViewFiltersModel(payload.Filters),
+		Sort:         payload.Sort,
+		Order:        payload.Order,
+		Columns:      payload.Columns,
+	}
+	if payload.QueueType != nil {
+		view.QueueType = models.QueueType(*payload.QueueType)
+	}
+	if payload.Name != nil {
+		view.Name = *payload.Name
+	}
+	return view
+}
+
+// QueueViewModelFromUpdate converts the payload for updating a queue view to the changes the office user asked for
+func QueueViewModelFromUpdate(payload *ghcmessages.UpdateQueueViewPayload, viewID uuid.UUID, officeUserID uuid.UUID) *models.OfficeQueueView {
+	if payload == nil {
+		return nil
+	}
+
+	view := &models.OfficeQueueView{
+		ID:           viewID,
+		OfficeUserID: officeUserID,
+		Filters:      QueueViewFiltersModel(payload.Filters),
+		Sort:         payload.Sort,
+		Order:        payload.Order,
+		Columns:      payload.Columns,
+	}
+	if payload.Name != nil {
+		view.Name = *payload.Name
+	}
+	return view
+}
@@ -70,8 +70,14 @@ func (h GetMoveHandler) Handle(params moveop.GetMoveParams) middleware.Responder
 			now := time.Now()
 			if appCtx.Session().IsOfficeUser() {
 				if move.LockedByOfficeUserID == nil && move.LockExpiresAt == nil || (lockExpiresAt != nil && now.After(*lockExpiresAt)) || (*lockedOfficeUserID == officeUserID && lockedOfficeUserID != nil) {
-					move, err = h.LockMove(appCtx, move, officeUserID)
-					if err != nil {
+					lockedMove, err := h.LockMove(appCtx, move, officeUserID)
+					switch err.(type) {
+					case nil:
+						move = lockedMove
+					case apperror.ConflictError:
+						// another office user locked the move first, so this user views it read-only
+						appCtx.Logger().Info("move was locked by another office user", zap.String("moveID", move.ID.String()))
+					default:
 						return moveop.NewGetMoveInternalServerError(), err
 					}
 				}
@@ -355,6 +361,78 @@ func (h CheckForLockedMovesAndUnlockHandler) Handle(params moveop.CheckForLocked
 		})
 }
 
+// RenewMoveLockHandler extends the lock the office user holds on a move
+type RenewMoveLockHandler struct {
+	handlers.HandlerConfig
+	services.MoveLocker
+}
+
+// Handle handles the heartbeat the office UI sends while a move is open
+func (h RenewMoveLockHandler) Handle(params moveop.RenewMoveLockParams) middleware.Responder {
+	return h.AuditableAppContextFromRequestWithErrors(params.HTTPRequest,
+		func(appCtx appcontext.AppContext) (middleware.Responder, error) {
+			if !appCtx.Session().IsOfficeUser() {
+				return moveop.NewRenewMoveLockForbidden(), apperror.NewForbiddenError("only office users may lock moves")
+			}
+
+			moveID := uuid.FromStringOrNil(params.MoveID.String())
+			move, err := h.RenewMoveLock(appCtx, moveID, appCtx.Session().OfficeUserID)
+			if err != nil {
+				appCtx.Logger().Error("RenewMoveLockHandler error", zap.Error(err))
+				switch err.(type) {
+				case apperror.NotFoundError:
+					return moveop.NewRenewMoveLockNotFound(), err
+				case apperror.ConflictError:
+					return moveop.NewRenewMoveLockConflict().WithPayload(&ghcmessages.Error{Message: handlers.FmtString(err.Error())}), err
+				default:
+					return moveop.NewRenewMoveLockInternalServerError(), err
+				}
+			}
+
+			payload, err := payloads.Move(move, h.FileStorer())
+			if err != nil {
+				return nil, err
+			}
+			return moveop.NewRenewMoveLockOK().WithPayload(payload), nil
+		})
+}
+
+// TakeOverMoveLockHandler gives a supervisor the lock on a move held by another office user
+type TakeOverMoveLockHandler struct {
+	handlers.HandlerConfig
+	services.MoveLocker
+}
+
+// Handle handles the take over move lock request
+func (h TakeOverMoveLockHandler) Handle(params moveop.TakeOverMoveLockParams) middleware.Responder {
+	return h.AuditableAppContextFromRequestWithErrors(params.HTTPRequest,
+		func(appCtx appcontext.AppContext) (middleware.Responder, error) {
+			if !appCtx.Session().IsOfficeUser() {
+				return moveop.NewTakeOverMoveLockForbidden(), apperror.NewForbiddenError("only office users may lock moves")
+			}
+
+			moveID := uuid.FromStringOrNil(params.MoveID.String())
+			move, err := h.TakeOverMoveLock(appCtx, moveID, appCtx.Session().OfficeUserID)
+			if err != nil {
+				appCtx.Logger().Error("TakeOverMoveLockHandler error", zap.Error(err))
+				switch err.(type) {
+				case apperror.NotFoundError:
+					return moveop.NewTakeOverMoveLockNotFound(), err
+				case apperror.ForbiddenError:
+					return moveop.NewTakeOverMoveLockForbidden(), err
+				default:
+					return moveop.NewTakeOverMoveLockInternalServerError(), err
+				}
+			}
+
+			payload, err := payloads.Move(move, h.FileStorer())
+			if err != nil {
+				return nil, err
+			}
+			return moveop.NewTakeOverMoveLockOK().WithPayload(payload), nil
+		})
+}
+
 type DeleteAssignedOfficeUserHandler struct {
 	handlers.HandlerConfig
 	services.MoveAssignedOfficeUserUpdater
@@ -428,12 +506,7 @@ func payloadForUploadModelFromAdditionalDocumentsUpload(storer storage.FileStore
 		CreatedAt:   strfmt.DateTime(upload.CreatedAt),
 		UpdatedAt:   strfmt.DateTime(upload.UpdatedAt),
 	}
-	tags, err := storer.Tags(upload.StorageKey)
-	if err != nil {
-		uploadPayload.Status = string(models.AVStatusPROCESSING)
-	} else {
-		uploadPayload.Status = string(models.GetAVStatusFromTags(tags))
-	}
+	uploadPayload.Status = string(uploader.AVStatus(storer, upload))
 	return uploadPayload, nil
 }
 
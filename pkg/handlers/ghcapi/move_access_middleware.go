@@ -0,0 +1,72 @@
+package ghcapi
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/gofrs/uuid"
+	"go.uber.org/zap"
+
+	"github.com/transcom/mymove/pkg/apperror"
+	"github.com/transcom/mymove/pkg/auth"
+	"github.com/transcom/mymove/pkg/handlers"
+	"github.com/transcom/mymove/pkg/services"
+)
+
+// MoveAccessMiddleware keeps office users from reading moves outside their area. The move is
+// found from the path and query parameters the same way as for move locks, so every read of a move
+// or of something on it is checked before the handler fetches any data. The checker logs each
+// denial. Queues and searches, which read many moves, are limited to the office user's area by
+// their fetchers instead.
+func MoveAccessMiddleware(handlerConfig handlers.HandlerConfig, api interface{ Context() *middleware.Context }, checker services.MoveAccessChecker) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		mw := func(w http.ResponseWriter, r *http.Request) {
+			switch r.Method {
+			case http.MethodGet, http.MethodHead:
+			default:
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			session := auth.SessionFromRequestContext(r)
+			if session == nil || !session.IsOfficeUser() || session.OfficeUserID == uuid.Nil {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			route, r, _ := api.Context().RouteInfo(r)
+			if route == nil {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			// reads can also name a move in their query, such as a queue filtered to one locator
+			params := append(middleware.RouteParams{}, route.Params...)
+			for name, values := range r.URL.Query() {
+				for _, value := range values {
+					params = append(params, middleware.RouteParam{Name: name, Value: value})
+				}
+			}
+
+			appCtx := handlerConfig.AppContextFromRequest(r)
+			moveIDs, err := moveIDsForRoute(appCtx, params)
+			if err == nil {
+				err = checker.CheckMoveAccess(appCtx, moveIDs, session.OfficeUserID, session.ActiveRole.RoleType)
+			}
+			if err != nil {
+				switch err.(type) {
+				case apperror.ForbiddenError:
+					writeMoveMiddlewareError(appCtx, w, http.StatusForbidden, "This move is outside your area")
+				default:
+					appCtx.Logger().Error("Error checking move access", zap.Error(err))
+					writeMoveMiddlewareError(appCtx, w, http.StatusInternalServerError, http.StatusText(http.StatusInternalServerError))
+				}
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		}
+
+		return http.HandlerFunc(mw)
+	}
+}
@@ -0,0 +1,173 @@
+package ghcapi
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/transcom/mymove/pkg/apperror"
+	"github.com/transcom/mymove/pkg/factory"
+	"github.com/transcom/mymove/pkg/models/roles"
+	"github.com/transcom/mymove/pkg/services/mocks"
+)
+
+func (suite *HandlerSuite) TestMoveAccessMiddleware() {
+	setUpHandlerAndMiddleware := func(checker *mocks.MoveAccessChecker, called *bool) http.Handler {
+		handlerConfig := suite.NewHandlerConfig()
+		api := NewGhcAPIHandler(handlerConfig)
+		middleware := MoveAccessMiddleware(handlerConfig, api, checker)
+
+		// serving the api builds the router the middleware looks routes up with
+		root := chi.NewRouter()
+		root.Mount("/ghc/v1", api.Serve(middleware))
+
+		return middleware(http.HandlerFunc(func(_ http.ResponseWriter, _ *http.Request) {
+			*called = true
+		}))
+	}
+
+	suite.Run("rejects reads of a move outside the office user's area", func() {
+		officeUser := factory.BuildOfficeUserWithRoles(suite.DB(), nil, []roles.RoleType{roles.RoleTypeTOO})
+		move := factory.BuildMove(suite.DB(), nil, nil)
+
+		checker := &mocks.MoveAccessChecker{}
+		checker.On("CheckMoveAccess", mock.AnythingOfType("*appcontext.appContext"), []uuid.UUID{move.ID}, officeUser.ID, roles.RoleTypeTOO).
+			Return(apperror.NewForbiddenError("move is outside the office user's area"))
+
+		called := false
+		handler := setUpHandlerAndMiddleware(checker, &called)
+
+		req := httptest.NewRequest("GET", fmt.Sprintf("/ghc/v1/move/%s", move.Locator), nil)
+		req = suite.AuthenticateOfficeRequest(req, officeUser)
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, req)
+
+		suite.Equal(http.StatusForbidden, rr.Code)
+		suite.False(called)
+		checker.AssertExpectations(suite.T())
+	})
+
+	suite.Run("rejects reads that name a move outside the office user's area in the query", func() {
+		officeUser := factory.BuildOfficeUserWithRoles(suite.DB(), nil, []roles.RoleType{roles.RoleTypeTOO})
+		move := factory.BuildMove(suite.DB(), nil, nil)
+
+		checker := &mocks.MoveAccessChecker{}
+		checker.On("CheckMoveAccess", mock.AnythingOfType("*appcontext.appContext"), []uuid.UUID{move.ID}, officeUser.ID, roles.RoleTypeTOO).
+			Return(apperror.NewForbiddenError("move is outside the office user's area"))
+
+		called := false
+		handler := setUpHandlerAndMiddleware(checker, &called)
+
+		req := httptest.NewRequest("GET", fmt.Sprintf("/ghc/v1/queues/moves?locator=%s", move.Locator), nil)
+		req = suite.AuthenticateOfficeRequest(req, officeUser)
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, req)
+
+		suite.Equal(http.StatusForbidden, rr.Code)
+		suite.False(called)
+		checker.AssertExpectations(suite.T())
+	})
+
+	suite.Run("allows reads of a move in the office user's area", func() {
+		officeUser := factory.BuildOfficeUserWithRoles(suite.DB(), nil, []roles.RoleType{roles.RoleTypeTOO})
+		move := factory.BuildMove(suite.DB(), nil, nil)
+
+		checker := &mocks.MoveAccessChecker{}
+		checker.On("CheckMoveAccess", mock.AnythingOfType("*appcontext.appContext"), []uuid.UUID{move.ID}, officeUser.ID, roles.RoleTypeTOO).Return(nil)
+
+		called := false
+		handler := setUpHandlerAndMiddleware(checker, &called)
+
+		req := httptest.NewRequest("GET", fmt.Sprintf("/ghc/v1/moves/%s/shipment-evaluation-reports-list", move.ID), nil)
+		req = suite.AuthenticateOfficeRequest(req, officeUser)
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, req)
+
+		suite.True(called)
+		checker.AssertExpectations(suite.T())
+	})
+
+	suite.Run("checks reads of a customer against the customer's moves", func() {
+		officeUser := factory.BuildOfficeUserWithRoles(suite.DB(), nil, []roles.RoleType{roles.RoleTypeTOO})
+		move := factory.BuildMove(suite.DB(), nil, nil)
+
+		checker := &mocks.MoveAccessChecker{}
+		checker.On("CheckMoveAccess", mock.AnythingOfType("*appcontext.appContext"), []uuid.UUID{move.ID}, officeUser.ID, roles.RoleTypeTOO).
+			Return(apperror.NewForbiddenError("move is outside the office user's area"))
+
+		called := false
+		handler := setUpHandlerAndMiddleware(checker, &called)
+
+		req := httptest.NewRequest("GET", fmt.Sprintf("/ghc/v1/customer/%s", move.Orders.ServiceMemberID), nil)
+		req = suite.AuthenticateOfficeRequest(req, officeUser)
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, req)
+
+		suite.Equal(http.StatusForbidden, rr.Code)
+		suite.False(called)
+		checker.AssertExpectations(suite.T())
+	})
+
+	suite.Run("checks reads of uploaded orders against their move", func() {
+		officeUser := factory.BuildOfficeUserWithRoles(suite.DB(), nil, []roles.RoleType{roles.RoleTypeTOO})
+		move := factory.BuildMove(suite.DB(), nil, nil)
+
+		checker := &mocks.MoveAccessChecker{}
+		checker.On("CheckMoveAccess", mock.AnythingOfType("*appcontext.appContext"), []uuid.UUID{move.ID}, officeUser.ID, roles.RoleTypeTOO).
+			Return(apperror.NewForbiddenError("move is outside the office user's area"))
+
+		called := false
+		handler := setUpHandlerAndMiddleware(checker, &called)
+
+		req := httptest.NewRequest("GET", fmt.Sprintf("/ghc/v1/documents/%s", move.Orders.UploadedOrdersID), nil)
+		req = suite.AuthenticateOfficeRequest(req, officeUser)
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, req)
+
+		suite.Equal(http.StatusForbidden, rr.Code)
+		suite.False(called)
+		checker.AssertExpectations(suite.T())
+	})
+
+	suite.Run("checks reads of PPM weight ticket documents against their move", func() {
+		officeUser := factory.BuildOfficeUserWithRoles(suite.DB(), nil, []roles.RoleType{roles.RoleTypeTOO})
+		weightTicket := factory.BuildWeightTicket(suite.DB(), nil, nil)
+		moveID := weightTicket.PPMShipment.Shipment.MoveTaskOrderID
+
+		checker := &mocks.MoveAccessChecker{}
+		checker.On("CheckMoveAccess", mock.AnythingOfType("*appcontext.appContext"), []uuid.UUID{moveID}, officeUser.ID, roles.RoleTypeTOO).Return(nil)
+
+		called := false
+		handler := setUpHandlerAndMiddleware(checker, &called)
+
+		req := httptest.NewRequest("GET", fmt.Sprintf("/ghc/v1/documents/%s", weightTicket.FullDocumentID), nil)
+		req = suite.AuthenticateOfficeRequest(req, officeUser)
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, req)
+
+		suite.True(called)
+		checker.AssertExpectations(suite.T())
+	})
+
+	suite.Run("does not check changes", func() {
+		officeUser := factory.BuildOfficeUserWithRoles(suite.DB(), nil, []roles.RoleType{roles.RoleTypeTOO})
+		move := factory.BuildMove(suite.DB(), nil, nil)
+
+		checker := &mocks.MoveAccessChecker{}
+
+		called := false
+		handler := setUpHandlerAndMiddleware(checker, &called)
+
+		req := httptest.NewRequest("POST", fmt.Sprintf("/ghc/v1/moves/%s/cancel", move.ID), nil)
+		req = suite.AuthenticateOfficeRequest(req, officeUser)
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, req)
+
+		suite.True(called)
+		checker.AssertNotCalled(suite.T(), "CheckMoveAccess", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
@@ -32,6 +32,7 @@ func (h GetMoveHistoryHandler) Handle(params moveop.GetMoveHistoryParams) middle
 				Locator: params.Locator,
 				Page:    params.Page,
 				PerPage: params.PerPage,
+				Cursor:  params.Cursor,
 			}
 
 			const featureFlagName = "move_history_proc_replacement"
@@ -46,6 +47,8 @@ func (h GetMoveHistoryHandler) Handle(params moveop.GetMoveHistoryParams) middle
 				switch err.(type) {
 				case apperror.NotFoundError:
 					return moveop.NewGetMoveHistoryNotFound(), err
+				case *apperror.BadDataError, apperror.InvalidInputError:
+					return moveop.NewGetMoveHistoryBadRequest(), err
 				default:
 					return moveop.NewGetMoveHistoryInternalServerError(), err
 				}
@@ -57,6 +60,7 @@ func (h GetMoveHistoryHandler) Handle(params moveop.GetMoveHistoryParams) middle
 				Page:           *moveHistoryRequestParams.Page,
 				PerPage:        *moveHistoryRequestParams.PerPage,
 				TotalCount:     totalCount,
+				NextCursor:     moveHistoryRequestParams.NextCursor,
 				ID:             historyRecords.ID,
 				HistoryRecords: historyRecords.HistoryRecords,
 				Locator:        historyRecords.Locator,
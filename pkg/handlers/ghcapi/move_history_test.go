@@ -14,6 +14,7 @@ import (
 	"github.com/transcom/mymove/pkg/handlers"
 	"github.com/transcom/mymove/pkg/models"
 	"github.com/transcom/mymove/pkg/models/roles"
+	"github.com/transcom/mymove/pkg/services"
 	"github.com/transcom/mymove/pkg/services/mocks"
 	movehistory "github.com/transcom/mymove/pkg/services/move_history"
 )
@@ -254,4 +255,66 @@ func (suite *HandlerSuite) TestMockGetMoveHistoryHandler() {
 		// Returned row count of 2 (since page size = 2)
 		suite.Len(payload.HistoryRecords, 2)
 	})
+
+	suite.Run("Cursor paginated move history fetch results", func() {
+		mockHistoryFetcher := mocks.MoveHistoryFetcher{}
+		requestUser := factory.BuildUser(nil, nil, nil)
+		req := httptest.NewRequest("GET", "/move/#{move.locator}", nil)
+		req = suite.AuthenticateUserRequest(req, requestUser)
+		params := moveops.GetMoveHistoryParams{
+			HTTPRequest: req,
+			Locator:     "ABCD1234",
+			PerPage:     models.Int64Pointer(20),
+			Cursor:      models.StringPointer("previous"),
+		}
+
+		handler := GetMoveHistoryHandler{
+			HandlerConfig:      suite.NewHandlerConfig(),
+			MoveHistoryFetcher: &mockHistoryFetcher,
+		}
+
+		mockHistoryFetcher.On("FetchMoveHistory",
+			mock.AnythingOfType("*appcontext.appContext"),
+			mock.MatchedBy(func(params *services.FetchMoveHistoryParams) bool {
+				return params.Cursor != nil && *params.Cursor == "previous"
+			}),
+			mock.AnythingOfType("bool"),
+		).Run(func(args mock.Arguments) {
+			params := args.Get(1).(*services.FetchMoveHistoryParams)
+			params.Page = models.Int64Pointer(1)
+			params.NextCursor = models.StringPointer("next")
+		}).Return(&moveHistory, int64(1), nil)
+
+		response := handler.Handle(params)
+		suite.IsType(&moveops.GetMoveHistoryOK{}, response)
+		payload := response.(*moveops.GetMoveHistoryOK).Payload
+		suite.NoError(payload.Validate(strfmt.Default))
+		suite.Equal("next", *payload.NextCursor)
+	})
+
+	suite.Run("Unsuccessful move history fetch - invalid cursor", func() {
+		mockHistoryFetcher := mocks.MoveHistoryFetcher{}
+		requestUser := factory.BuildUser(nil, nil, nil)
+		req := httptest.NewRequest("GET", "/move/#{move.locator}", nil)
+		req = suite.AuthenticateUserRequest(req, requestUser)
+		params := moveops.GetMoveHistoryParams{
+			HTTPRequest: req,
+			Locator:     "ABCD1234",
+			Cursor:      models.StringPointer("not a cursor!"),
+		}
+
+		handler := GetMoveHistoryHandler{
+			HandlerConfig:      suite.NewHandlerConfig(),
+			MoveHistoryFetcher: &mockHistoryFetcher,
+		}
+
+		mockHistoryFetcher.On("FetchMoveHistory",
+			mock.AnythingOfType("*appcontext.appContext"),
+			mock.AnythingOfType("*services.FetchMoveHistoryParams"),
+			mock.AnythingOfType("bool"),
+		).Return(&models.MoveHistory{}, int64(0), apperror.NewBadDataError("invalid pagination cursor"))
+
+		response := handler.Handle(params)
+		suite.IsType(&moveops.GetMoveHistoryBadRequest{}, response)
+	})
 }
@@ -0,0 +1,171 @@
+package ghcapi
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/gofrs/uuid"
+	"go.uber.org/zap"
+
+	"github.com/transcom/mymove/pkg/appcontext"
+	"github.com/transcom/mymove/pkg/apperror"
+	"github.com/transcom/mymove/pkg/auth"
+	"github.com/transcom/mymove/pkg/gen/ghcmessages"
+	"github.com/transcom/mymove/pkg/handlers"
+	"github.com/transcom/mymove/pkg/services"
+)
+
+// moveLockFeatureFlagName is the feature flag that turns move locking on for office users
+const moveLockFeatureFlagName = "move_lock"
+
+// moveLockExemptExtension marks operations that may run while another office user holds the lock
+const moveLockExemptExtension = "x-move-lock-exempt"
+
+// moveIDQueries finds the move a path parameter belongs to. Parameters that are not
+// listed here do not identify a move and are not checked.
+var moveIDQueries = map[string]string{
+	"moveID":                  "SELECT id FROM moves WHERE id = ?",
+	"moveTaskOrderID":         "SELECT id FROM moves WHERE id = ?",
+	"locator":                 "SELECT id FROM moves WHERE locator = ?",
+	"orderID":                 "SELECT id FROM moves WHERE orders_id = ?",
+	"shipmentID":              "SELECT move_id FROM mto_shipments WHERE id = ?",
+	"ppmShipmentId":           "SELECT mto_shipments.move_id FROM ppm_shipments JOIN mto_shipments ON mto_shipments.id = ppm_shipments.shipment_id WHERE ppm_shipments.id = ?",
+	"mtoServiceItemID":        "SELECT move_id FROM mto_service_items WHERE id = ?",
+	"paymentRequestID":        "SELECT move_id FROM payment_requests WHERE id = ?",
+	"paymentServiceItemID":    "SELECT payment_requests.move_id FROM payment_service_items JOIN payment_requests ON payment_requests.id = payment_service_items.payment_request_id WHERE payment_service_items.id = ?",
+	"sitExtensionID":          "SELECT mto_shipments.move_id FROM sit_extensions JOIN mto_shipments ON mto_shipments.id = sit_extensions.mto_shipment_id WHERE sit_extensions.id = ?",
+	"customerSupportRemarkID": "SELECT move_id FROM customer_support_remarks WHERE id = ?",
+	"reportID":                "SELECT move_id FROM evaluation_reports WHERE id = ?",
+	"customerID":              "SELECT moves.id FROM moves JOIN orders ON orders.id = moves.orders_id WHERE orders.service_member_id = ?",
+	"documentId":              documentMoveIDQuery,
+}
+
+// documentMoveIDQuery finds the moves whose orders or PPM shipments hold a document
+const documentMoveIDQuery = `WITH document AS (SELECT ?::uuid AS id)
+	SELECT moves.id FROM document
+		JOIN orders ON document.id IN (orders.uploaded_orders_id, orders.uploaded_amended_orders_id)
+		JOIN moves ON moves.orders_id = orders.id
+	UNION
+	SELECT mto_shipments.move_id FROM document
+		JOIN ppm_shipments ON document.id IN (ppm_shipments.aoa_packet_id, ppm_shipments.payment_packet_id)
+		JOIN mto_shipments ON mto_shipments.id = ppm_shipments.shipment_id
+	UNION
+	SELECT mto_shipments.move_id FROM document
+		JOIN weight_tickets ON document.id IN (weight_tickets.empty_document_id, weight_tickets.full_document_id, weight_tickets.proof_of_trailer_ownership_document_id)
+		JOIN ppm_shipments ON ppm_shipments.id = weight_tickets.ppm_shipment_id
+		JOIN mto_shipments ON mto_shipments.id = ppm_shipments.shipment_id
+	UNION
+	SELECT mto_shipments.move_id FROM document
+		JOIN progear_weight_tickets ON progear_weight_tickets.document_id = document.id
+		JOIN ppm_shipments ON ppm_shipments.id = progear_weight_tickets.ppm_shipment_id
+		JOIN mto_shipments ON mto_shipments.id = ppm_shipments.shipment_id
+	UNION
+	SELECT mto_shipments.move_id FROM document
+		JOIN moving_expenses ON moving_expenses.document_id = document.id
+		JOIN ppm_shipments ON ppm_shipments.id = moving_expenses.ppm_shipment_id
+		JOIN mto_shipments ON mto_shipments.id = ppm_shipments.shipment_id`
+
+// moveLockStringParams are the path parameters that hold a locator rather than a uuid
+var moveLockStringParams = map[string]bool{
+	"locator": true,
+}
+
+// MoveLockMiddleware rejects requests that would change a move while another office
+// user holds its lock. Reads are always allowed, as are operations marked with the
+// x-move-lock-exempt vendor extension.
+func MoveLockMiddleware(handlerConfig handlers.HandlerConfig, api interface{ Context() *middleware.Context }, checker services.MoveLockChecker) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		mw := func(w http.ResponseWriter, r *http.Request) {
+			switch r.Method {
+			case http.MethodGet, http.MethodHead, http.MethodOptions:
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			session := auth.SessionFromRequestContext(r)
+			if session == nil || !session.IsOfficeUser() || session.OfficeUserID == uuid.Nil {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			route, r, _ := api.Context().RouteInfo(r)
+			if route == nil {
+				next.ServeHTTP(w, r)
+				return
+			}
+			if exempt, ok := route.Operation.VendorExtensible.Extensions.GetBool(moveLockExemptExtension); ok && exempt {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			appCtx := handlerConfig.AppContextFromRequest(r)
+			flag, err := handlerConfig.FeatureFlagFetcher().GetBooleanFlagForUser(r.Context(), appCtx, moveLockFeatureFlagName, map[string]string{})
+			if err != nil {
+				appCtx.Logger().Error("Error fetching move_lock feature flag", zap.String("featureFlagKey", moveLockFeatureFlagName), zap.Error(err))
+				next.ServeHTTP(w, r)
+				return
+			}
+			if !flag.Match {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			moveIDs, err := moveIDsForRoute(appCtx, route.Params)
+			if err == nil {
+				err = checker.CheckMoveLocks(appCtx, moveIDs, session.OfficeUserID)
+			}
+			if err != nil {
+				switch err.(type) {
+				case apperror.ConflictError:
+					appCtx.Logger().Warn("Rejected change to a move locked by another office user", zap.Error(err))
+					writeMoveMiddlewareError(appCtx, w, http.StatusConflict, "This move is locked by another office user")
+				default:
+					appCtx.Logger().Error("Error checking move locks", zap.Error(err))
+					writeMoveMiddlewareError(appCtx, w, http.StatusInternalServerError, http.StatusText(http.StatusInternalServerError))
+				}
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		}
+
+		return http.HandlerFunc(mw)
+	}
+}
+
+// moveIDsForRoute returns the moves identified by the path parameters of a request
+func moveIDsForRoute(appCtx appcontext.AppContext, params middleware.RouteParams) ([]uuid.UUID, error) {
+	var moveIDs []uuid.UUID
+	for _, param := range params {
+		query, ok := moveIDQueries[param.Name]
+		if !ok {
+			continue
+		}
+
+		var value interface{} = param.Value
+		if !moveLockStringParams[param.Name] {
+			id, err := uuid.FromString(param.Value)
+			if err != nil {
+				// the handler rejects the malformed parameter
+				continue
+			}
+			value = id
+		}
+
+		var ids []uuid.UUID
+		if err := appCtx.DB().RawQuery(query, value).All(&ids); err != nil {
+			return nil, apperror.NewQueryError("Move", err, "could not find move for "+param.Name)
+		}
+		moveIDs = append(moveIDs, ids...)
+	}
+	return moveIDs, nil
+}
+
+func writeMoveMiddlewareError(appCtx appcontext.AppContext, w http.ResponseWriter, status int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(ghcmessages.Error{Message: &message}); err != nil {
+		appCtx.Logger().Error("Failed encoding move middleware error response", zap.Error(err))
+	}
+}
@@ -0,0 +1,98 @@
+package ghcapi
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/transcom/mymove/pkg/apperror"
+	"github.com/transcom/mymove/pkg/factory"
+	"github.com/transcom/mymove/pkg/models/roles"
+	"github.com/transcom/mymove/pkg/services/mocks"
+)
+
+func (suite *HandlerSuite) TestMoveLockMiddleware() {
+	setUpHandlerAndMiddleware := func(checker *mocks.MoveLockChecker, called *bool) http.Handler {
+		handlerConfig := suite.NewHandlerConfig()
+		api := NewGhcAPIHandler(handlerConfig)
+		middleware := MoveLockMiddleware(handlerConfig, api, checker)
+
+		// serving the api builds the router the middleware looks routes up with
+		root := chi.NewRouter()
+		root.Mount("/ghc/v1", api.Serve(middleware))
+
+		return middleware(http.HandlerFunc(func(_ http.ResponseWriter, _ *http.Request) {
+			*called = true
+		}))
+	}
+
+	suite.Run("rejects changes to a move locked by another office user", func() {
+		officeUser := factory.BuildOfficeUserWithRoles(suite.DB(), nil, []roles.RoleType{roles.RoleTypeTOO})
+		move := factory.BuildMove(suite.DB(), nil, nil)
+
+		checker := &mocks.MoveLockChecker{}
+		checker.On("CheckMoveLocks", mock.AnythingOfType("*appcontext.appContext"), []uuid.UUID{move.ID}, officeUser.ID).
+			Return(apperror.NewConflictError(move.ID, "move is locked by another office user"))
+
+		called := false
+		handler := setUpHandlerAndMiddleware(checker, &called)
+
+		req := httptest.NewRequest("POST", fmt.Sprintf("/ghc/v1/moves/%s/cancel", move.ID), nil)
+		req = suite.AuthenticateOfficeRequest(req, officeUser)
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, req)
+
+		suite.Equal(http.StatusConflict, rr.Code)
+		suite.False(called)
+		checker.AssertExpectations(suite.T())
+	})
+
+	suite.Run("allows changes by the lock holder", func() {
+		officeUser := factory.BuildOfficeUserWithRoles(suite.DB(), nil, []roles.RoleType{roles.RoleTypeTOO})
+		move := factory.BuildMove(suite.DB(), nil, nil)
+
+		checker := &mocks.MoveLockChecker{}
+		checker.On("CheckMoveLocks", mock.AnythingOfType("*appcontext.appContext"), []uuid.UUID{move.ID}, officeUser.ID).Return(nil)
+
+		called := false
+		handler := setUpHandlerAndMiddleware(checker, &called)
+
+		req := httptest.NewRequest("POST", fmt.Sprintf("/ghc/v1/moves/%s/cancel", move.ID), nil)
+		req = suite.AuthenticateOfficeRequest(req, officeUser)
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, req)
+
+		suite.True(called)
+		checker.AssertExpectations(suite.T())
+	})
+
+	suite.Run("does not check reads or exempt operations", func() {
+		officeUser := factory.BuildOfficeUserWithRoles(suite.DB(), nil, []roles.RoleType{roles.RoleTypeTOO})
+		move := factory.BuildMove(suite.DB(), nil, nil)
+
+		checker := &mocks.MoveLockChecker{}
+
+		for _, request := range []struct {
+			method string
+			path   string
+		}{
+			{"GET", fmt.Sprintf("/ghc/v1/move/%s", move.Locator)},
+			{"POST", fmt.Sprintf("/ghc/v1/moves/%s/lock/take-over", move.ID)},
+		} {
+			called := false
+			handler := setUpHandlerAndMiddleware(checker, &called)
+
+			req := httptest.NewRequest(request.method, request.path, nil)
+			req = suite.AuthenticateOfficeRequest(req, officeUser)
+			rr := httptest.NewRecorder()
+			handler.ServeHTTP(rr, req)
+
+			suite.True(called, request.path)
+		}
+		checker.AssertNotCalled(suite.T(), "CheckMoveLocks", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
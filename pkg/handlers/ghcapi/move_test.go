@@ -1314,6 +1314,99 @@ func (suite *HandlerSuite) TestCheckForLockedMovesAndUnlockHandler() {
 		suite.Nil(payload)
 	})
 }
+func (suite *HandlerSuite) TestRenewMoveLockHandler() {
+	setupTestData := func() (*http.Request, models.OfficeUser, *mocks.MoveLocker, RenewMoveLockHandler) {
+		officeUser := factory.BuildOfficeUserWithRoles(suite.DB(), nil, []roles.RoleType{roles.RoleTypeTOO})
+		req := httptest.NewRequest("POST", "/moves/{moveID}/lock/heartbeat", nil)
+		req = suite.AuthenticateOfficeRequest(req, officeUser)
+
+		mockLocker := &mocks.MoveLocker{}
+		handler := RenewMoveLockHandler{
+			HandlerConfig: suite.NewHandlerConfig(),
+			MoveLocker:    mockLocker,
+		}
+		return req, officeUser, mockLocker, handler
+	}
+
+	suite.Run("Successful renewal of the move lock", func() {
+		req, officeUser, mockLocker, handler := setupTestData()
+		expiresAt := time.Now().Add(movelocker.MoveLockDuration)
+		move := factory.BuildMove(nil, nil, nil)
+		move.LockedByOfficeUserID = &officeUser.ID
+		move.LockExpiresAt = &expiresAt
+
+		mockLocker.On("RenewMoveLock", mock.AnythingOfType("*appcontext.appContext"), move.ID, officeUser.ID).Return(&move, nil)
+
+		params := moveops.RenewMoveLockParams{
+			HTTPRequest: req,
+			MoveID:      strfmt.UUID(move.ID.String()),
+		}
+		response := handler.Handle(params)
+		suite.IsType(&moveops.RenewMoveLockOK{}, response)
+		payload := response.(*moveops.RenewMoveLockOK).Payload
+		suite.NoError(payload.Validate(strfmt.Default))
+		suite.Equal(strfmt.UUID(officeUser.ID.String()), *payload.LockedByOfficeUserID)
+	})
+
+	suite.Run("Conflict when another office user holds the lock", func() {
+		req, officeUser, mockLocker, handler := setupTestData()
+		moveID := uuid.Must(uuid.NewV4())
+
+		mockLocker.On("RenewMoveLock", mock.AnythingOfType("*appcontext.appContext"), moveID, officeUser.ID).Return(nil, apperror.NewConflictError(moveID, "move is locked by another office user"))
+
+		params := moveops.RenewMoveLockParams{
+			HTTPRequest: req,
+			MoveID:      strfmt.UUID(moveID.String()),
+		}
+		response := handler.Handle(params)
+		suite.IsType(&moveops.RenewMoveLockConflict{}, response)
+	})
+}
+
+func (suite *HandlerSuite) TestTakeOverMoveLockHandler() {
+	setupTestData := func() (*http.Request, models.OfficeUser, *mocks.MoveLocker, TakeOverMoveLockHandler) {
+		officeUser := factory.BuildOfficeUserWithRoles(suite.DB(), nil, []roles.RoleType{roles.RoleTypeTOO})
+		req := httptest.NewRequest("POST", "/moves/{moveID}/lock/take-over", nil)
+		req = suite.AuthenticateOfficeRequest(req, officeUser)
+
+		mockLocker := &mocks.MoveLocker{}
+		handler := TakeOverMoveLockHandler{
+			HandlerConfig: suite.NewHandlerConfig(),
+			MoveLocker:    mockLocker,
+		}
+		return req, officeUser, mockLocker, handler
+	}
+
+	suite.Run("Successful take over of the move lock", func() {
+		req, officeUser, mockLocker, handler := setupTestData()
+		move := factory.BuildMove(nil, nil, nil)
+		move.LockedByOfficeUserID = &officeUser.ID
+
+		mockLocker.On("TakeOverMoveLock", mock.AnythingOfType("*appcontext.appContext"), move.ID, officeUser.ID).Return(&move, nil)
+
+		params := moveops.TakeOverMoveLockParams{
+			HTTPRequest: req,
+			MoveID:      strfmt.UUID(move.ID.String()),
+		}
+		response := handler.Handle(params)
+		suite.IsType(&moveops.TakeOverMoveLockOK{}, response)
+	})
+
+	suite.Run("Forbidden for office users who are not supervisors", func() {
+		req, officeUser, mockLocker, handler := setupTestData()
+		moveID := uuid.Must(uuid.NewV4())
+
+		mockLocker.On("TakeOverMoveLock", mock.AnythingOfType("*appcontext.appContext"), moveID, officeUser.ID).Return(nil, apperror.NewForbiddenError("only supervisors may take over the lock on a move"))
+
+		params := moveops.TakeOverMoveLockParams{
+			HTTPRequest: req,
+			MoveID:      strfmt.UUID(moveID.String()),
+		}
+		response := handler.Handle(params)
+		suite.IsType(&moveops.TakeOverMoveLockForbidden{}, response)
+	})
+}
+
 func (suite *HandlerSuite) TestGetQueue() {
 	testCases := []struct {
 		name     string
@@ -1396,11 +1396,35 @@ func (h ReviewShipmentAddressUpdateHandler) Handle(params shipmentops.ReviewShip
 			if err != nil {
 				return handleError(err)
 			}
+			h.triggerReviewShipmentAddressUpdateEvent(appCtx, response, params)
+
 			payload := payloads.ShipmentAddressUpdate(response)
 			return shipmentops.NewReviewShipmentAddressUpdateOK().WithPayload(payload), nil
 		})
 }
 
+func (h ReviewShipmentAddressUpdateHandler) triggerReviewShipmentAddressUpdateEvent(appCtx appcontext.AppContext, addressUpdate *models.ShipmentAddressUpdate, params shipmentops.ReviewShipmentAddressUpdateParams) {
+	eventKey := event.ShipmentAddressUpdateApproveEventKey
+	if addressUpdate.Status == models.ShipmentAddressUpdateStatusRejected {
+		eventKey = event.ShipmentAddressUpdateRejectEventKey
+	}
+
+	_, err := event.TriggerEvent(event.Event{
+		EndpointKey: event.GhcReviewShipmentAddressUpdateEndpointKey,
+		// Endpoint that is being handled
+		EventKey:        eventKey,                               // Event that you want to trigger
+		UpdatedObjectID: addressUpdate.ID,                       // ID of the updated logical object
+		MtoID:           addressUpdate.Shipment.MoveTaskOrderID, // ID of the associated Move
+		AppContext:      appCtx,
+		TraceID:         h.GetTraceIDFromRequest(params.HTTPRequest),
+	})
+
+	// If the event trigger fails, just log the error.
+	if err != nil {
+		appCtx.Logger().Error("ghcapi.ReviewShipmentAddressUpdateHandler could not generate the event", zap.Error(err))
+	}
+}
+
 // ApproveSITExtensionHandler approves a SIT extension
 type ApproveSITExtensionHandler struct {
 	handlers.HandlerConfig
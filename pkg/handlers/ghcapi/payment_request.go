@@ -214,6 +214,20 @@ func (h UpdatePaymentRequestStatusHandler) Handle(
 					Error("ghcapi.UpdatePaymentRequestStatusHandler could not generate the event")
 			}
 
+			// The status can only be set to one of the reviewed statuses, so the review is always complete here
+			_, err = event.TriggerEvent(event.Event{
+				EventKey:        event.PaymentRequestReviewEventKey,
+				MtoID:           updatedPaymentRequest.MoveTaskOrderID,
+				UpdatedObjectID: updatedPaymentRequest.ID,
+				EndpointKey:     event.GhcUpdatePaymentRequestStatusEndpointKey,
+				AppContext:      appCtx,
+				TraceID:         h.GetTraceIDFromRequest(params.HTTPRequest),
+			})
+			if err != nil {
+				appCtx.Logger().
+					Error("ghcapi.UpdatePaymentRequestStatusHandler could not generate the review event", zap.Error(err))
+			}
+
 			returnPayload, err := payloads.PaymentRequest(appCtx, updatedPaymentRequest, h.FileStorer())
 			if err != nil {
 				return paymentrequestop.NewGetPaymentRequestInternalServerError(), err
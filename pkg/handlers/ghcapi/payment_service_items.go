@@ -99,3 +99,35 @@ func (h UpdatePaymentServiceItemStatusHandler) Handle(
 			return paymentServiceItemOp.NewUpdatePaymentServiceItemStatusOK().WithPayload(payload), nil
 		})
 }
+
+// GetPaymentServiceItemPricingTracesHandler returns how the rate engine priced a payment service item
+type GetPaymentServiceItemPricingTracesHandler struct {
+	handlers.HandlerConfig
+	services.PaymentServiceItemPricingTraceFetcher
+}
+
+// Handle returns the pricing traces of the payment service item and the ones it replaced
+func (h GetPaymentServiceItemPricingTracesHandler) Handle(
+	params paymentServiceItemOp.GetPaymentServiceItemPricingTracesParams,
+) middleware.Responder {
+	return h.AuditableAppContextFromRequestWithErrors(params.HTTPRequest,
+		func(appCtx appcontext.AppContext) (middleware.Responder, error) {
+			if !appCtx.Session().IsOfficeUser() {
+				return paymentServiceItemOp.NewGetPaymentServiceItemPricingTracesForbidden(), apperror.NewForbiddenError("only office users may view pricing traces")
+			}
+
+			paymentServiceItemID := uuid.FromStringOrNil(params.PaymentServiceItemID.String())
+			traces, err := h.FetchPricingTraces(appCtx, paymentServiceItemID)
+			if err != nil {
+				appCtx.Logger().Error("Error fetching payment service item pricing traces", zap.Error(err))
+				switch err.(type) {
+				case apperror.NotFoundError:
+					return paymentServiceItemOp.NewGetPaymentServiceItemPricingTracesNotFound().WithPayload(&ghcmessages.Error{Message: handlers.FmtString(err.Error())}), err
+				default:
+					return paymentServiceItemOp.NewGetPaymentServiceItemPricingTracesInternalServerError(), err
+				}
+			}
+
+			return paymentServiceItemOp.NewGetPaymentServiceItemPricingTracesOK().WithPayload(modelToPayload.PaymentServiceItemPricingTraces(traces)), nil
+		})
+}
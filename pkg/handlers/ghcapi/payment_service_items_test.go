@@ -6,15 +6,20 @@ import (
 
 	"github.com/go-openapi/strfmt"
 	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/mock"
 
+	"github.com/transcom/mymove/pkg/apperror"
 	"github.com/transcom/mymove/pkg/etag"
 	"github.com/transcom/mymove/pkg/factory"
 	paymentServiceItemOp "github.com/transcom/mymove/pkg/gen/ghcapi/ghcoperations/payment_service_item"
 	"github.com/transcom/mymove/pkg/gen/ghcmessages"
 	"github.com/transcom/mymove/pkg/handlers"
 	"github.com/transcom/mymove/pkg/models"
+	"github.com/transcom/mymove/pkg/models/roles"
+	"github.com/transcom/mymove/pkg/services/mocks"
 	paymentServiceItemService "github.com/transcom/mymove/pkg/services/payment_service_item"
 	"github.com/transcom/mymove/pkg/trace"
+	"github.com/transcom/mymove/pkg/unit"
 )
 
 type updatePaymentSubtestData struct {
@@ -227,3 +232,74 @@ func (suite *HandlerSuite) TestUpdatePaymentServiceItemHandler() {
 		suite.HasWebhookNotification(availablePaymentServiceItem.PaymentRequestID, traceID)
 	})
 }
+
+func (suite *HandlerSuite) TestGetPaymentServiceItemPricingTracesHandler() {
+	setupTestData := func() (*mocks.PaymentServiceItemPricingTraceFetcher, GetPaymentServiceItemPricingTracesHandler, paymentServiceItemOp.GetPaymentServiceItemPricingTracesParams, uuid.UUID) {
+		officeUser := factory.BuildOfficeUserWithRoles(suite.DB(), nil, []roles.RoleType{roles.RoleTypeTIO})
+		paymentServiceItemID := uuid.Must(uuid.NewV4())
+		req := httptest.NewRequest("GET", fmt.Sprintf("/payment-service-items/%s/pricing-traces", paymentServiceItemID), nil)
+		req = suite.AuthenticateOfficeRequest(req, officeUser)
+
+		fetcher := &mocks.PaymentServiceItemPricingTraceFetcher{}
+		handler := GetPaymentServiceItemPricingTracesHandler{
+			HandlerConfig:                         suite.NewHandlerConfig(),
+			PaymentServiceItemPricingTraceFetcher: fetcher,
+		}
+		params := paymentServiceItemOp.GetPaymentServiceItemPricingTracesParams{
+			HTTPRequest:          req,
+			PaymentServiceItemID: strfmt.UUID(paymentServiceItemID.String()),
+		}
+		return fetcher, handler, params, paymentServiceItemID
+	}
+
+	suite.Run("returns the pricing traces", func() {
+		fetcher, handler, params, paymentServiceItemID := setupTestData()
+		priceRowID := uuid.Must(uuid.NewV4())
+		fetcher.On("FetchPricingTraces", mock.AnythingOfType("*appcontext.appContext"), paymentServiceItemID).Return(models.PaymentServiceItemPricingTraces{
+			{
+				ID:                   uuid.Must(uuid.NewV4()),
+				PaymentServiceItemID: paymentServiceItemID,
+				PaymentServiceItem: models.PaymentServiceItem{
+					PaymentRequest: models.PaymentRequest{PaymentRequestNumber: "1234-5678-2"},
+				},
+				Trace: models.PricingTrace{
+					ServiceCode: models.ReServiceCodeDLH,
+					PriceCents:  unit.Cents(20724832),
+					Inputs:      []models.PricingTraceParam{{Key: "WeightBilled", Value: "4001"}},
+					PriceRows: []models.PricingTraceRow{
+						{
+							Table:  "re_domestic_linehaul_prices",
+							ID:     priceRowID,
+							Values: []models.PricingTraceParam{{Key: "price_millicents", Value: "388600"}},
+						},
+					},
+					Steps:   []models.PricingTraceParam{{Key: "escalated price in cents", Value: "431.3"}},
+					Results: []models.PricingTraceParam{{Key: "EscalationCompounded", Value: "1.11000"}},
+				},
+			},
+		}, nil)
+
+		response := handler.Handle(params)
+		suite.IsType(&paymentServiceItemOp.GetPaymentServiceItemPricingTracesOK{}, response)
+		payload := response.(*paymentServiceItemOp.GetPaymentServiceItemPricingTracesOK).Payload
+		suite.NoError(payload.Validate(strfmt.Default))
+		suite.Len(payload, 1)
+		suite.Equal("1234-5678-2", payload[0].PaymentRequestNumber)
+		suite.Equal("DLH", payload[0].ServiceCode)
+		suite.Equal(int64(20724832), payload[0].PriceCents)
+		suite.Equal("WeightBilled", payload[0].Inputs[0].Key)
+		suite.Equal(strfmt.UUID(priceRowID.String()), payload[0].PriceRows[0].ID)
+		suite.Equal("388600", payload[0].PriceRows[0].Values[0].Value)
+		suite.Equal("431.3", payload[0].Steps[0].Value)
+		suite.Equal("EscalationCompounded", payload[0].Results[0].Key)
+	})
+
+	suite.Run("unknown payment service items are not found", func() {
+		fetcher, handler, params, paymentServiceItemID := setupTestData()
+		fetcher.On("FetchPricingTraces", mock.AnythingOfType("*appcontext.appContext"), paymentServiceItemID).
+			Return(nil, apperror.NewNotFoundError(paymentServiceItemID, "looking for PaymentServiceItem"))
+
+		response := handler.Handle(params)
+		suite.IsType(&paymentServiceItemOp.GetPaymentServiceItemPricingTracesNotFound{}, response)
+	})
+}
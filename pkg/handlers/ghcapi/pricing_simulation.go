@@ -0,0 +1,82 @@
+package ghcapi
+
+import (
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/gobuffalo/validate/v3"
+	"go.uber.org/zap"
+
+	"github.com/transcom/mymove/pkg/appcontext"
+	"github.com/transcom/mymove/pkg/apperror"
+	pricingop "github.com/transcom/mymove/pkg/gen/ghcapi/ghcoperations/pricing"
+	"github.com/transcom/mymove/pkg/gen/ghcmessages"
+	"github.com/transcom/mymove/pkg/handlers"
+	"github.com/transcom/mymove/pkg/handlers/ghcapi/internal/payloads"
+	"github.com/transcom/mymove/pkg/services"
+	"github.com/transcom/mymove/pkg/unit"
+)
+
+// SimulatePricingHandler prices a hypothetical shipment
+type SimulatePricingHandler struct {
+	handlers.HandlerConfig
+	services.PricingSimulator
+}
+
+// Handle prices the services of the shipment described in the request body
+func (h SimulatePricingHandler) Handle(params pricingop.SimulatePricingParams) middleware.Responder {
+	return h.AuditableAppContextFromRequestWithErrors(params.HTTPRequest,
+		func(appCtx appcontext.AppContext) (middleware.Responder, error) {
+			if !appCtx.Session().IsOfficeUser() {
+				return pricingop.NewSimulatePricingForbidden(), apperror.NewForbiddenError("only office users may simulate pricing")
+			}
+
+			simulation, err := h.SimulatePricing(appCtx, pricingSimulationParams(params.Body))
+			if err != nil {
+				appCtx.Logger().Error("SimulatePricingHandler error", zap.Error(err))
+				switch e := err.(type) {
+				case apperror.InvalidInputError:
+					verrs := e.ValidationErrors
+					if verrs == nil {
+						verrs = validate.NewErrors()
+					}
+					payload := payloadForValidationError("Invalid pricing simulation", err.Error(), h.GetTraceIDFromRequest(params.HTTPRequest), verrs)
+					return pricingop.NewSimulatePricingUnprocessableEntity().WithPayload(payload), err
+				case apperror.UnprocessableEntityError:
+					payload := payloadForValidationError("Unable to price shipment", err.Error(), h.GetTraceIDFromRequest(params.HTTPRequest), validate.NewErrors())
+					return pricingop.NewSimulatePricingUnprocessableEntity().WithPayload(payload), err
+				case apperror.NotFoundError:
+					return pricingop.NewSimulatePricingNotFound().WithPayload(&ghcmessages.Error{Message: handlers.FmtString(err.Error())}), err
+				default:
+					return pricingop.NewSimulatePricingInternalServerError(), err
+				}
+			}
+
+			return pricingop.NewSimulatePricingOK().WithPayload(payloads.PricingSimulation(simulation)), nil
+		})
+}
+
+func pricingSimulationParams(body *ghcmessages.PricingSimulationRequest) services.PricingSimulationParams {
+	params := services.PricingSimulationParams{
+		ContractCode: body.ContractCode,
+	}
+	if body.PickupPostalCode != nil {
+		params.PickupPostalCode = *body.PickupPostalCode
+	}
+	if body.DestinationPostalCode != nil {
+		params.DestinationPostalCode = *body.DestinationPostalCode
+	}
+	if body.Weight != nil {
+		params.Weight = unit.Pound(*body.Weight)
+	}
+	if body.PickupDate != nil {
+		params.PickupDate = time.Time(*body.PickupDate)
+	}
+	if body.OriginSITDays != nil {
+		params.OriginSITDays = int(*body.OriginSITDays)
+	}
+	if body.DestinationSITDays != nil {
+		params.DestinationSITDays = int(*body.DestinationSITDays)
+	}
+	return params
+}
@@ -0,0 +1,108 @@
+package ghcapi
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/gobuffalo/validate/v3"
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/transcom/mymove/pkg/apperror"
+	"github.com/transcom/mymove/pkg/factory"
+	pricingop "github.com/transcom/mymove/pkg/gen/ghcapi/ghcoperations/pricing"
+	"github.com/transcom/mymove/pkg/gen/ghcmessages"
+	"github.com/transcom/mymove/pkg/models"
+	"github.com/transcom/mymove/pkg/models/roles"
+	"github.com/transcom/mymove/pkg/services"
+	"github.com/transcom/mymove/pkg/services/mocks"
+	"github.com/transcom/mymove/pkg/unit"
+)
+
+func (suite *HandlerSuite) TestSimulatePricingHandler() {
+	pickupDate := time.Date(2020, time.March, 15, 0, 0, 0, 0, time.UTC)
+	expectedParams := services.PricingSimulationParams{
+		PickupPostalCode:      "50309",
+		DestinationPostalCode: "30813",
+		Weight:                unit.Pound(4000),
+		PickupDate:            pickupDate,
+		DestinationSITDays:    10,
+	}
+
+	setupTestData := func() (*http.Request, *mocks.PricingSimulator, SimulatePricingHandler, pricingop.SimulatePricingParams) {
+		officeUser := factory.BuildOfficeUserWithRoles(suite.DB(), nil, []roles.RoleType{roles.RoleTypeServicesCounselor})
+		req := httptest.NewRequest("POST", "/pricing/simulate", nil)
+		req = suite.AuthenticateOfficeRequest(req, officeUser)
+
+		mockSimulator := &mocks.PricingSimulator{}
+		handler := SimulatePricingHandler{
+			HandlerConfig:    suite.NewHandlerConfig(),
+			PricingSimulator: mockSimulator,
+		}
+
+		date := strfmt.Date(pickupDate)
+		params := pricingop.SimulatePricingParams{
+			HTTPRequest: req,
+			Body: &ghcmessages.PricingSimulationRequest{
+				PickupPostalCode:      models.StringPointer("50309"),
+				DestinationPostalCode: models.StringPointer("30813"),
+				Weight:                models.Int64Pointer(4000),
+				PickupDate:            &date,
+				DestinationSITDays:    models.Int64Pointer(10),
+			},
+		}
+		return req, mockSimulator, handler, params
+	}
+
+	suite.Run("Successful simulation returns the priced service items", func() {
+		_, mockSimulator, handler, params := setupTestData()
+		simulation := &services.PricingSimulation{
+			ContractCode:    "TRUSS_TEST",
+			Distance:        unit.Miles(2361),
+			TotalPriceCents: unit.Cents(123456),
+			ServiceItems: []services.SimulatedServiceItemPrice{
+				{
+					ServiceCode: models.ReServiceCodeDLH,
+					PriceCents:  unit.Cents(123456),
+					PricingParams: services.PricingDisplayParams{
+						{Key: models.ServiceItemParamNameContractYearName, Value: "TRUSS_TEST"},
+					},
+				},
+			},
+		}
+		mockSimulator.On("SimulatePricing", mock.AnythingOfType("*appcontext.appContext"), expectedParams).Return(simulation, nil)
+
+		response := handler.Handle(params)
+		suite.IsType(&pricingop.SimulatePricingOK{}, response)
+		payload := response.(*pricingop.SimulatePricingOK).Payload
+		suite.NoError(payload.Validate(strfmt.Default))
+		suite.Equal("TRUSS_TEST", payload.ContractCode)
+		suite.Equal(int64(2361), payload.Distance)
+		suite.Equal(int64(123456), payload.TotalPriceCents)
+		suite.Len(payload.ServiceItems, 1)
+		suite.Equal("DLH", payload.ServiceItems[0].ServiceCode)
+		suite.Equal("ContractYearName", payload.ServiceItems[0].Params[0].Key)
+	})
+
+	suite.Run("Invalid parameters return unprocessable entity", func() {
+		_, mockSimulator, handler, params := setupTestData()
+		verrs := validate.NewErrors()
+		verrs.Add("Weight", "Weight must be greater than 0")
+		mockSimulator.On("SimulatePricing", mock.AnythingOfType("*appcontext.appContext"), expectedParams).Return(nil, apperror.NewInvalidInputError(uuid.Nil, nil, verrs, "invalid pricing simulation parameters"))
+
+		response := handler.Handle(params)
+		suite.IsType(&pricingop.SimulatePricingUnprocessableEntity{}, response)
+		payload := response.(*pricingop.SimulatePricingUnprocessableEntity).Payload
+		suite.Contains(payload.InvalidFields, "Weight")
+	})
+
+	suite.Run("Unknown contract returns not found", func() {
+		_, mockSimulator, handler, params := setupTestData()
+		mockSimulator.On("SimulatePricing", mock.AnythingOfType("*appcontext.appContext"), expectedParams).Return(nil, apperror.NewNotFoundError(uuid.Nil, "no contract found"))
+
+		response := handler.Handle(params)
+		suite.IsType(&pricingop.SimulatePricingNotFound{}, response)
+	})
+}
@@ -0,0 +1,550 @@
+package ghcapi
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/gofrs/uuid"
+	"github.com/xuri/excelize/v2"
+	"go.uber.org/zap"
+
+	"github.com/transcom/mymove/pkg/appcontext"
+	"github.com/transcom/mymove/pkg/apperror"
+	"github.com/transcom/mymove/pkg/gen/ghcapi/ghcoperations/queues"
+	"github.com/transcom/mymove/pkg/gen/ghcmessages"
+	"github.com/transcom/mymove/pkg/handlers"
+	"github.com/transcom/mymove/pkg/handlers/ghcapi/internal/payloads"
+	"github.com/transcom/mymove/pkg/models"
+	"github.com/transcom/mymove/pkg/models/roles"
+	"github.com/transcom/mymove/pkg/services"
+)
+
+const (
+	// queueExportPerPage is how many rows are fetched per query while streaming an export
+	queueExportPerPage = 500
+
+	queueExportFormatXLSX = "xlsx"
+
+	queueExportContentTypeCSV  = "text/csv"
+	queueExportContentTypeXLSX = "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+)
+
+// queueExportPage fetches a page of export rows. done is true once there are no pages after it.
+type queueExportPage func(appCtx appcontext.AppContext, page int64) (rows [][]string, done bool, err error)
+
+// queueExportColumn is a column of a move queue export
+type queueExportColumn struct {
+	key    string
+	header string
+	value  func(*ghcmessages.QueueMove) string
+}
+
+// paymentRequestQueueExportColumn is a column of a payment request queue export
+type paymentRequestQueueExportColumn struct {
+	key    string
+	header string
+	value  func(*ghcmessages.QueuePaymentRequest) string
+}
+
+// queueExportColumns are the columns of the move queues in the order the queues show them. The keys
+// match the filter names so the columns saved with a queue view line up with its filters.
+var queueExportColumns = []queueExportColumn{
+	{"customerName", "Customer name", func(m *ghcmessages.QueueMove) string { return queueExportCustomerName(m.Customer) }},
+	{"edipi", "DoD ID", func(m *ghcmessages.QueueMove) string { return queueExportEdipi(m.Customer) }},
+	{"emplid", "EMPLID", func(m *ghcmessages.QueueMove) string { return queueExportEmplid(m.Customer) }},
+	{"locator", "Move code", func(m *ghcmessages.QueueMove) string { return m.Locator }},
+	{"branch", "Branch", func(m *ghcmessages.QueueMove) string { return queueExportBranch(m.Customer) }},
+	{"status", "Status", func(m *ghcmessages.QueueMove) string { return string(m.Status) }},
+	{"requestedMoveDate", "Requested move date", func(m *ghcmessages.QueueMove) string {
+		if m.RequestedMoveDates != nil {
+			return *m.RequestedMoveDates
+		}
+		if m.RequestedMoveDate != nil {
+			return m.RequestedMoveDate.String()
+		}
+		return ""
+	}},
+	{"submittedAt", "Date submitted", func(m *ghcmessages.QueueMove) string {
+		if m.SubmittedAt == nil {
+			return ""
+		}
+		return m.SubmittedAt.String()
+	}},
+	{"appearedInTooAt", "Date appeared in TOO queue", func(m *ghcmessages.QueueMove) string {
+		if m.AppearedInTooAt == nil {
+			return ""
+		}
+		return m.AppearedInTooAt.String()
+	}},
+	{"originDutyLocation", "Origin duty location", func(m *ghcmessages.QueueMove) string { return queueExportDutyLocation(m.OriginDutyLocation) }},
+	{"destinationDutyLocation", "Destination duty location", func(m *ghcmessages.QueueMove) string {
+		return queueExportDutyLocation(m.DestinationDutyLocation)
+	}},
+	{"originGBLOC", "Origin GBLOC", func(m *ghcmessages.QueueMove) string { return string(m.OriginGBLOC) }},
+	{"counselingOffice", "Counseling office", func(m *ghcmessages.QueueMove) string { return queueExportString(m.CounselingOffice) }},
+	{"assignedTo", "Assigned", func(m *ghcmessages.QueueMove) string { return queueExportAssignedTo(m.AssignedTo) }},
+	{"orderType", "Order type", func(m *ghcmessages.QueueMove) string { return queueExportString(m.OrderType) }},
+	{"ppmType", "PPM type", func(m *ghcmessages.QueueMove) string { return queueExportString(m.PpmType) }},
+	{"ppmStatus", "PPM status", func(m *ghcmessages.QueueMove) string { return string(m.PpmStatus) }},
+	{"closeoutInitiated", "Closeout initiated", func(m *ghcmessages.QueueMove) string {
+		if m.CloseoutInitiated == nil {
+			return ""
+		}
+		return m.CloseoutInitiated.String()
+	}},
+	{"closeoutLocation", "Closeout location", func(m *ghcmessages.QueueMove) string { return queueExportString(m.CloseoutLocation) }},
+}
+
+// paymentRequestQueueExportColumns are the columns of the payment request queue in the order the queue shows them
+var paymentRequestQueueExportColumns = []paymentRequestQueueExportColumn{
+	{"customerName", "Customer name", func(p *ghcmessages.QueuePaymentRequest) string { return queueExportCustomerName(p.Customer) }},
+	{"edipi", "DoD ID", func(p *ghcmessages.QueuePaymentRequest) string { return queueExportEdipi(p.Customer) }},
+	{"emplid", "EMPLID", func(p *ghcmessages.QueuePaymentRequest) string { return queueExportEmplid(p.Customer) }},
+	{"locator", "Move code", func(p *ghcmessages.QueuePaymentRequest) string { return p.Locator }},
+	{"branch", "Branch", func(p *ghcmessages.QueuePaymentRequest) string { return queueExportBranch(p.Customer) }},
+	{"status", "Status", func(p *ghcmessages.QueuePaymentRequest) string { return string(p.Status) }},
+	{"age", "Age (days)", func(p *ghcmessages.QueuePaymentRequest) string { return strconv.FormatFloat(p.Age, 'f', -1, 64) }},
+	{"submittedAt", "Date submitted", func(p *ghcmessages.QueuePaymentRequest) string { return p.SubmittedAt.String() }},
+	{"originGBLOC", "Origin GBLOC", func(p *ghcmessages.QueuePaymentRequest) string { return string(p.OriginGBLOC) }},
+	{"originDutyLocation", "Origin duty location", func(p *ghcmessages.QueuePaymentRequest) string {
+		return queueExportDutyLocation(p.OriginDutyLocation)
+	}},
+	{"counselingOffice", "Counseling office", func(p *ghcmessages.QueuePaymentRequest) string { return queueExportString(p.CounselingOffice) }},
+	{"assignedTo", "Assigned", func(p *ghcmessages.QueuePaymentRequest) string { return queueExportAssignedTo(p.AssignedTo) }},
+	{"orderType", "Order type", func(p *ghcmessages.QueuePaymentRequest) string { return queueExportString(p.OrderType) }},
+}
+
+// ExportQueueHandler exports an office queue as CSV or XLSX via POST /queues/export
+type ExportQueueHandler struct {
+	handlers.HandlerConfig
+	services.QueueViewFetcher
+	services.OrderFetcher
+	services.PaymentRequestListFetcher
+	services.OfficeUserFetcherPop
+}
+
+// Handle writes every row of the queue, filtered by a saved view or the filters in the request, as a spreadsheet
+func (h ExportQueueHandler) Handle(params queues.ExportQueueParams) middleware.Responder {
+	return h.AuditableAppContextFromRequestWithErrors(params.HTTPRequest,
+		func(appCtx appcontext.AppContext) (middleware.Responder, error) {
+			queueType := models.QueueType(*params.Body.QueueType)
+			if !appCtx.Session().IsOfficeUser() || !queueExportRoleIsAllowed(appCtx.Session().ActiveRole.RoleType, queueType) {
+				forbiddenErr := apperror.NewForbiddenError(
+					fmt.Sprintf("user's active role may not export the %s queue", queueType),
+				)
+				appCtx.Logger().Error(forbiddenErr.Error())
+				return queues.NewExportQueueForbidden(), forbiddenErr
+			}
+
+			view, err := h.queueExportView(appCtx, params.Body, queueType)
+			if err != nil {
+				appCtx.Logger().Error("error fetching queue view for export", zap.Error(err))
+				switch err.(type) {
+				case apperror.NotFoundError:
+					return queues.NewExportQueueNotFound(), err
+				case apperror.InvalidInputError:
+					payload := payloadForValidationError("Unable to export queue", err.Error(), h.GetTraceIDFromRequest(params.HTTPRequest), nil)
+					return queues.NewExportQueueUnprocessableEntity().WithPayload(payload), err
+				default:
+					return queues.NewExportQueueInternalServerError(), err
+				}
+			}
+
+			if !queueViewColumnsAreKnown(queueType, view.Columns) {
+				err = apperror.NewInvalidInputError(uuid.Nil, nil, nil, fmt.Sprintf("unknown column for the %s queue", queueType))
+				payload := payloadForValidationError("Unable to export queue", err.Error(), h.GetTraceIDFromRequest(params.HTTPRequest), nil)
+				return queues.NewExportQueueUnprocessableEntity().WithPayload(payload), err
+			}
+
+			officeUser, err := h.OfficeUserFetcherPop.FetchOfficeUserByIDWithTransportationOfficeAssignments(appCtx, appCtx.Session().OfficeUserID)
+			if err != nil {
+				appCtx.Logger().Error("Error retrieving office_user", zap.Error(err))
+				return queues.NewExportQueueInternalServerError(), err
+			}
+			viewAsGBLOC := view.Filters.ViewAsGBLOC
+			if viewAsGBLOC != nil && appCtx.Session().ActiveRole.RoleType != roles.RoleTypeHQ &&
+				!slices.Contains(models.GetAssignedGBLOCs(officeUser), *viewAsGBLOC) {
+				viewAsGBLOC = nil
+			}
+
+			var header []string
+			var fetchPage queueExportPage
+			if queueType == models.QueueTypePaymentRequest {
+				header, fetchPage = h.paymentRequestQueueExport(view, viewAsGBLOC)
+			} else {
+				header, fetchPage = h.moveQueueExport(view, viewAsGBLOC)
+			}
+
+			// the first page is fetched before anything is written so a bad filter still gets an error response
+			firstRows, done, err := fetchPage(appCtx, 1)
+			if err != nil {
+				appCtx.Logger().Error("error fetching queue rows for export", zap.Error(err))
+				if _, ok := err.(apperror.InvalidInputError); ok {
+					payload := payloadForValidationError("Unable to export queue", err.Error(), h.GetTraceIDFromRequest(params.HTTPRequest), nil)
+					return queues.NewExportQueueUnprocessableEntity().WithPayload(payload), err
+				}
+				return queues.NewExportQueueInternalServerError(), err
+			}
+
+			format := *params.Body.Format
+			filename := fmt.Sprintf("attachment; filename=\"%s-queue-%s.%s\"",
+				strings.ToLower(string(queueType)), time.Now().Format("2006-01-02"), format)
+
+			// the rest of the pages are fetched while the response is written, after this request's
+			// transaction is done, so they're read with their own app context
+			streamAppCtx := h.AppContextFromRequest(params.HTTPRequest)
+			return middleware.ResponderFunc(func(rw http.ResponseWriter, _ runtime.Producer) {
+				var writer queueExportWriter
+				var err error
+				if format == queueExportFormatXLSX {
+					rw.Header().Set("Content-Type", queueExportContentTypeXLSX)
+					writer, err = newXLSXQueueExportWriter(rw)
+				} else {
+					rw.Header().Set("Content-Type", queueExportContentTypeCSV)
+					writer = newCSVQueueExportWriter(rw)
+				}
+				if err != nil {
+					streamAppCtx.Logger().Error("error starting queue export", zap.Error(err))
+					rw.WriteHeader(http.StatusInternalServerError)
+					return
+				}
+				rw.Header().Set("Content-Disposition", filename)
+				rw.WriteHeader(http.StatusOK)
+
+				// the status has already been sent, so an error part way through can only be logged
+				if err := writeQueueExport(streamAppCtx, writer, header, firstRows, done, fetchPage); err != nil {
+					streamAppCtx.Logger().Error("error streaming queue export", zap.Error(err))
+				}
+			}), nil
+		})
+}
+
+// queueExportView returns the settings to export with: the saved view the request names, or a view
+// built from the filters, sort and columns in the request
+func (h ExportQueueHandler) queueExportView(appCtx appcontext.AppContext, payload *ghcmessages.ExportQueuePayload, queueType models.QueueType) (*models.OfficeQueueView, error) {
+	if payload.ViewID != nil {
+		view, err := h.FetchQueueView(appCtx, appCtx.Session().OfficeUserID, uuid.FromStringOrNil(payload.ViewID.String()))
+		if err != nil {
+			return nil, err
+		}
+		if view.QueueType != queueType {
+			return nil, apperror.NewInvalidInputError(view.ID, nil, nil, fmt.Sprintf("queue view is saved for the %s queue", view.QueueType))
+		}
+		return view, nil
+	}
+
+	return &models.OfficeQueueView{
+		QueueType: queueType,
+		Filters:   payloads.QueueViewFiltersModel(payload.Filters),
+		Sort:      payload.Sort,
+		Order:     payload.Order,
+		Columns:   payload.Columns,
+	}, nil
+}
+
+// moveQueueExport returns the header of the selected columns and a fetcher for pages of the moves in the queue
+func (h ExportQueueHandler) moveQueueExport(view *models.OfficeQueueView, viewAsGBLOC *string) ([]string, queueExportPage) {
+	listOrderParams := services.ListOrderParams{ViewAsGBLOC: viewAsGBLOC}
+	applyQueueViewToListOrderParams(&listOrderParams, view)
+
+	var requestedPpmStatus *models.PPMShipmentStatus
+	switch view.QueueType {
+	case models.QueueTypeTaskOrder:
+		if len(listOrderParams.Status) == 0 {
+			listOrderParams.Status = []string{string(models.MoveStatusServiceCounselingCompleted), string(models.MoveStatusAPPROVALSREQUESTED), string(models.MoveStatusSUBMITTED)}
+		}
+	case models.QueueTypeDestinationRequest:
+		if len(listOrderParams.Status) == 0 {
+			listOrderParams.Status = []string{string(models.MoveStatusAPPROVALSREQUESTED)}
+		}
+	case models.QueueTypeCloseout:
+		needsCloseout := models.PPMShipmentStatusNeedsCloseout
+		requestedPpmStatus = &needsCloseout
+		listOrderParams.NeedsPPMCloseout = models.BoolPointer(true)
+		listOrderParams.Status = []string{string(models.MoveStatusAPPROVED), string(models.MoveStatusServiceCounselingCompleted)}
+	default:
+		if len(listOrderParams.Status) == 0 {
+			listOrderParams.Status = []string{string(models.MoveStatusNeedsServiceCounseling)}
+		}
+	}
+
+	var columns []queueExportColumn
+	for _, column := range queueExportColumns {
+		if len(view.Columns) == 0 || slices.Contains(view.Columns, column.key) {
+			columns = append(columns, column)
+		}
+	}
+	header := make([]string, len(columns))
+	for i, column := range columns {
+		header[i] = column.header
+	}
+
+	listOrderParams.PerPage = models.Int64Pointer(queueExportPerPage)
+	return header, func(appCtx appcontext.AppContext, page int64) ([][]string, bool, error) {
+		listOrderParams.Page = models.Int64Pointer(page)
+
+		var moves []models.Move
+		var count int
+		var err error
+		officeUserID := appCtx.Session().OfficeUserID
+		switch view.QueueType {
+		case models.QueueTypeTaskOrder:
+			moves, count, err = h.ListOriginRequestsOrders(appCtx, officeUserID, &listOrderParams)
+		case models.QueueTypeDestinationRequest:
+			moves, count, err = h.ListDestinationRequestsOrders(appCtx, officeUserID, roles.RoleTypeTOO, &listOrderParams)
+		default:
+			moves, count, err = h.ListOrders(appCtx, officeUserID, roles.RoleTypeServicesCounselor, &listOrderParams)
+		}
+		if err != nil {
+			return nil, false, err
+		}
+
+		queueMoves := payloads.QueueMoves(moves, nil, requestedPpmStatus, models.OfficeUser{}, nil, "", string(view.QueueType))
+		rows := make([][]string, 0, len(*queueMoves))
+		for _, queueMove := range *queueMoves {
+			row := make([]string, len(columns))
+			for i, column := range columns {
+				row[i] = column.value(queueMove)
+			}
+			rows = append(rows, row)
+		}
+
+		return rows, len(moves) < queueExportPerPage || page*queueExportPerPage >= int64(count), nil
+	}
+}
+
+// paymentRequestQueueExport returns the header of the selected columns and a fetcher for pages of the payment
+// requests in the queue
+func (h ExportQueueHandler) paymentRequestQueueExport(view *models.OfficeQueueView, viewAsGBLOC *string) ([]string, queueExportPage) {
+	listParams := services.FetchPaymentRequestListParams{ViewAsGBLOC: viewAsGBLOC}
+	applyQueueViewToPaymentRequestListParams(&listParams, view)
+	listParams.Status = []string{string(models.PaymentRequestStatusPending)}
+
+	var columns []paymentRequestQueueExportColumn
+	for _, column := range paymentRequestQueueExportColumns {
+		if len(view.Columns) == 0 || slices.Contains(view.Columns, column.key) {
+			columns = append(columns, column)
+		}
+	}
+	header := make([]string, len(columns))
+	for i, column := range columns {
+		header[i] = column.header
+	}
+
+	listParams.PerPage = models.Int64Pointer(queueExportPerPage)
+	return header, func(appCtx appcontext.AppContext, page int64) ([][]string, bool, error) {
+		listParams.Page = models.Int64Pointer(page)
+
+		paymentRequests, count, err := h.FetchPaymentRequestList(appCtx, appCtx.Session().OfficeUserID, &listParams)
+		if err != nil {
+			return nil, false, err
+		}
+
+		queuePaymentRequests := payloads.QueuePaymentRequests(paymentRequests, nil, models.OfficeUser{}, nil, "")
+		rows := make([][]string, 0, len(*queuePaymentRequests))
+		for _, queuePaymentRequest := range *queuePaymentRequests {
+			row := make([]string, len(columns))
+			for i, column := range columns {
+				row[i] = column.value(queuePaymentRequest)
+			}
+			rows = append(rows, row)
+		}
+
+		return rows, len(*paymentRequests) < queueExportPerPage || page*queueExportPerPage >= int64(count), nil
+	}
+}
+
+// queueExportRoleIsAllowed reports whether the role may see, and so export, the queue
+func queueExportRoleIsAllowed(role roles.RoleType, queueType models.QueueType) bool {
+	switch queueType {
+	case models.QueueTypeTaskOrder:
+		return role == roles.RoleTypeTOO || role == roles.RoleTypeHQ
+	case models.QueueTypeDestinationRequest:
+		return role == roles.RoleTypeTOO
+	case models.QueueTypeCounseling, models.QueueTypeCloseout:
+		return role == roles.RoleTypeServicesCounselor || role == roles.RoleTypeHQ
+	case models.QueueTypePaymentRequest:
+		return role == roles.RoleTypeTIO || role == roles.RoleTypeHQ
+	}
+	return false
+}
+
+// queueViewColumnsAreKnown reports whether every column is one the queue can export
+func queueViewColumnsAreKnown(queueType models.QueueType, columns []string) bool {
+	var keys []string
+	if queueType == models.QueueTypePaymentRequest {
+		for _, column := range paymentRequestQueueExportColumns {
+			keys = append(keys, column.key)
+		}
+	} else {
+		for _, column := range queueExportColumns {
+			keys = append(keys, column.key)
+		}
+	}
+
+	for _, column := range columns {
+		if !slices.Contains(keys, column) {
+			return false
+		}
+	}
+	return true
+}
+
+// writeQueueExport writes the header and the first page, then fetches and writes the rest of the pages
+func writeQueueExport(appCtx appcontext.AppContext, writer queueExportWriter, header []string, firstRows [][]string, done bool, fetchPage queueExportPage) error {
+	if err := writer.WriteRow(header); err != nil {
+		return err
+	}
+
+	rows := firstRows
+	for page := int64(1); ; page++ {
+		for _, row := range rows {
+			if err := writer.WriteRow(row); err != nil {
+				return err
+			}
+		}
+		if done {
+			return writer.Close()
+		}
+
+		var err error
+		rows, done, err = fetchPage(appCtx, page+1)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// queueExportWriter writes the rows of an export to the response as they're fetched
+type queueExportWriter interface {
+	WriteRow(row []string) error
+	Close() error
+}
+
+type csvQueueExportWriter struct {
+	writer *csv.Writer
+}
+
+func newCSVQueueExportWriter(w io.Writer) queueExportWriter {
+	return &csvQueueExportWriter{writer: csv.NewWriter(w)}
+}
+
+func (c *csvQueueExportWriter) WriteRow(row []string) error {
+	return c.writer.Write(queueExportCells(row))
+}
+
+func (c *csvQueueExportWriter) Close() error {
+	c.writer.Flush()
+	return c.writer.Error()
+}
+
+// xlsxQueueExportWriter writes rows with an excelize stream writer, which spills them to a temporary file
+// rather than holding the sheet in memory. The workbook can only be written out once every row is in.
+type xlsxQueueExportWriter struct {
+	w      io.Writer
+	file   *excelize.File
+	stream *excelize.StreamWriter
+	rows   int
+}
+
+func newXLSXQueueExportWriter(w io.Writer) (queueExportWriter, error) {
+	file := excelize.NewFile()
+	stream, err := file.NewStreamWriter(file.GetSheetName(0))
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+	return &xlsxQueueExportWriter{w: w, file: file, stream: stream}, nil
+}
+
+func (x *xlsxQueueExportWriter) WriteRow(values []string) error {
+	x.rows++
+	cell, err := excelize.CoordinatesToCellName(1, x.rows)
+	if err != nil {
+		return err
+	}
+	row := make([]interface{}, len(values))
+	for i, value := range queueExportCells(values) {
+		row[i] = value
+	}
+	return x.stream.SetRow(cell, row)
+}
+
+// queueExportCells keeps spreadsheet programs from running customer entered values as formulas by prefixing
+// any value that starts like one with an apostrophe
+func queueExportCells(values []string) []string {
+	cells := make([]string, len(values))
+	for i, value := range values {
+		if value != "" && strings.ContainsAny(value[:1], "=+-@\t\r") {
+			value = "'" + value
+		}
+		cells[i] = value
+	}
+	return cells
+}
+
+func (x *xlsxQueueExportWriter) Close() error {
+	defer x.file.Close()
+	if err := x.stream.Flush(); err != nil {
+		return err
+	}
+	_, err := x.file.WriteTo(x.w)
+	return err
+}
+
+func queueExportCustomerName(customer *ghcmessages.Customer) string {
+	if customer == nil {
+		return ""
+	}
+	return fmt.Sprintf("%s, %s", customer.LastName, customer.FirstName)
+}
+
+func queueExportEdipi(customer *ghcmessages.Customer) string {
+	if customer == nil {
+		return ""
+	}
+	return customer.Edipi
+}
+
+func queueExportEmplid(customer *ghcmessages.Customer) string {
+	if customer == nil {
+		return ""
+	}
+	return queueExportString(customer.Emplid)
+}
+
+func queueExportBranch(customer *ghcmessages.Customer) string {
+	if customer == nil {
+		return ""
+	}
+	return customer.Agency
+}
+
+func queueExportDutyLocation(dutyLocation *ghcmessages.DutyLocation) string {
+	if dutyLocation == nil {
+		return ""
+	}
+	return dutyLocation.Name
+}
+
+func queueExportAssignedTo(assignedTo *ghcmessages.AssignedOfficeUser) string {
+	if assignedTo == nil {
+		return ""
+	}
+	return fmt.Sprintf("%s, %s", assignedTo.LastName, assignedTo.FirstName)
+}
+
+func queueExportString(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
@@ -0,0 +1,286 @@
+package ghcapi
+
+import (
+	"bytes"
+	"encoding/csv"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/xuri/excelize/v2"
+
+	"github.com/transcom/mymove/pkg/factory"
+	"github.com/transcom/mymove/pkg/gen/ghcapi/ghcoperations/queues"
+	"github.com/transcom/mymove/pkg/gen/ghcmessages"
+	"github.com/transcom/mymove/pkg/models"
+	"github.com/transcom/mymove/pkg/models/roles"
+	"github.com/transcom/mymove/pkg/services"
+	"github.com/transcom/mymove/pkg/services/mocks"
+	officeusercreator "github.com/transcom/mymove/pkg/services/office_user"
+)
+
+func (suite *HandlerSuite) TestExportQueueHandler() {
+	setupParams := func(officeUser models.OfficeUser, body *ghcmessages.ExportQueuePayload) queues.ExportQueueParams {
+		request := suite.AuthenticateOfficeRequest(httptest.NewRequest("POST", "/queues/export", nil), officeUser)
+		return queues.ExportQueueParams{
+			HTTPRequest: request,
+			Body:        body,
+		}
+	}
+
+	buildMove := func() models.Move {
+		return factory.BuildMove(nil, []factory.Customization{
+			{
+				Model: models.Move{
+					Locator: "EXPRT1",
+				},
+			},
+			{
+				Model: models.ServiceMember{
+					FirstName: models.StringPointer("Jane"),
+					LastName:  models.StringPointer("Doe"),
+				},
+			},
+		}, []factory.Trait{factory.GetTraitSubmittedMove})
+	}
+
+	writeExport := func(response middleware.Responder) *httptest.ResponseRecorder {
+		recorder := httptest.NewRecorder()
+		response.WriteResponse(recorder, runtime.ByteStreamProducer())
+		return recorder
+	}
+
+	suite.Run("exports the selected columns of the filtered queue as CSV", func() {
+		officeUser := factory.BuildOfficeUserWithRoles(suite.DB(), nil, []roles.RoleType{roles.RoleTypeTOO})
+		move := buildMove()
+
+		orderFetcher := &mocks.OrderFetcher{}
+		orderFetcher.On("ListOriginRequestsOrders", mock.Anything, officeUser.ID,
+			mock.MatchedBy(func(params *services.ListOrderParams) bool {
+				return *params.Branch == "ARMY" && len(params.Status) == 3
+			}),
+		).Return([]models.Move{move}, 1, nil)
+
+		handler := ExportQueueHandler{
+			suite.NewHandlerConfig(),
+			&mocks.QueueViewFetcher{},
+			orderFetcher,
+			&mocks.PaymentRequestListFetcher{},
+			officeusercreator.NewOfficeUserFetcherPop(),
+		}
+		queueType := ghcmessages.QueueTypeTASKORDER
+		response := handler.Handle(setupParams(officeUser, &ghcmessages.ExportQueuePayload{
+			QueueType: &queueType,
+			Format:    models.StringPointer("csv"),
+			Filters:   &ghcmessages.QueueViewFilters{Branch: models.StringPointer("ARMY")},
+			Columns:   []string{"customerName", "locator"},
+		}))
+
+		recorder := writeExport(response)
+		suite.Equal(http.StatusOK, recorder.Code)
+		suite.Equal("text/csv", recorder.Header().Get("Content-Type"))
+		suite.Contains(recorder.Header().Get("Content-Disposition"), "task_order-queue-")
+		suite.Contains(recorder.Header().Get("Content-Disposition"), ".csv")
+
+		records, err := csv.NewReader(recorder.Body).ReadAll()
+		suite.NoError(err)
+		suite.Equal([][]string{
+			{"Customer name", "Move code"},
+			{"Doe, Jane", "EXPRT1"},
+		}, records)
+	})
+
+	suite.Run("streams every page of a queue larger than a page", func() {
+		officeUser := factory.BuildOfficeUserWithRoles(suite.DB(), nil, []roles.RoleType{roles.RoleTypeTOO})
+		move := buildMove()
+		firstPage := make([]models.Move, queueExportPerPage)
+		for i := range firstPage {
+			firstPage[i] = move
+		}
+		onPage := func(page int64) interface{} {
+			return mock.MatchedBy(func(params *services.ListOrderParams) bool {
+				return *params.Page == page && *params.PerPage == queueExportPerPage
+			})
+		}
+
+		// the queue is bigger than any single query, so it has to come over more than one page
+		count := 20*queueExportPerPage + 1
+		orderFetcher := &mocks.OrderFetcher{}
+		orderFetcher.On("ListOriginRequestsOrders", mock.Anything, officeUser.ID, onPage(1)).Return(firstPage, count, nil).Once()
+		for page := int64(2); page <= 20; page++ {
+			orderFetcher.On("ListOriginRequestsOrders", mock.Anything, officeUser.ID, onPage(page)).Return(firstPage, count, nil).Once()
+		}
+		orderFetcher.On("ListOriginRequestsOrders", mock.Anything, officeUser.ID, onPage(21)).Return([]models.Move{move}, count, nil).Once()
+
+		handler := ExportQueueHandler{
+			suite.NewHandlerConfig(),
+			&mocks.QueueViewFetcher{},
+			orderFetcher,
+			&mocks.PaymentRequestListFetcher{},
+			officeusercreator.NewOfficeUserFetcherPop(),
+		}
+		queueType := ghcmessages.QueueTypeTASKORDER
+		response := handler.Handle(setupParams(officeUser, &ghcmessages.ExportQueuePayload{
+			QueueType: &queueType,
+			Format:    models.StringPointer("csv"),
+			Columns:   []string{"locator"},
+		}))
+
+		recorder := writeExport(response)
+		suite.Equal(http.StatusOK, recorder.Code)
+		records, err := csv.NewReader(recorder.Body).ReadAll()
+		suite.NoError(err)
+		suite.Len(records, count+1)
+		suite.Equal([]string{"Move code"}, records[0])
+		suite.Equal([]string{"EXPRT1"}, records[count])
+		orderFetcher.AssertNumberOfCalls(suite.T(), "ListOriginRequestsOrders", 21)
+	})
+
+	suite.Run("exports a saved view as XLSX", func() {
+		officeUser := factory.BuildOfficeUserWithRoles(suite.DB(), nil, []roles.RoleType{roles.RoleTypeServicesCounselor})
+		move := buildMove()
+		viewID := uuid.Must(uuid.NewV4())
+
+		viewFetcher := &mocks.QueueViewFetcher{}
+		viewFetcher.On("FetchQueueView", mock.Anything, officeUser.ID, viewID).Return(&models.OfficeQueueView{
+			ID:           viewID,
+			OfficeUserID: officeUser.ID,
+			QueueType:    models.QueueTypeCounseling,
+			Name:         "Mine",
+			Columns:      []string{"locator"},
+		}, nil)
+		orderFetcher := &mocks.OrderFetcher{}
+		orderFetcher.On("ListOrders", mock.Anything, officeUser.ID, roles.RoleTypeServicesCounselor, mock.Anything).
+			Return([]models.Move{move}, 1, nil)
+
+		handler := ExportQueueHandler{
+			suite.NewHandlerConfig(),
+			viewFetcher,
+			orderFetcher,
+			&mocks.PaymentRequestListFetcher{},
+			officeusercreator.NewOfficeUserFetcherPop(),
+		}
+		queueType := ghcmessages.QueueTypeCOUNSELING
+		viewUUID := strfmt.UUID(viewID.String())
+		response := handler.Handle(setupParams(officeUser, &ghcmessages.ExportQueuePayload{
+			QueueType: &queueType,
+			Format:    models.StringPointer("xlsx"),
+			ViewID:    &viewUUID,
+		}))
+
+		recorder := writeExport(response)
+		suite.Equal(http.StatusOK, recorder.Code)
+		suite.Contains(recorder.Header().Get("Content-Disposition"), ".xlsx")
+
+		file, err := excelize.OpenReader(recorder.Body)
+		suite.NoError(err)
+		rows, err := file.GetRows(file.GetSheetName(0))
+		suite.NoError(err)
+		suite.Equal([][]string{{"Move code"}, {"EXPRT1"}}, rows)
+	})
+
+	suite.Run("rejects a view saved for another queue", func() {
+		officeUser := factory.BuildOfficeUserWithRoles(suite.DB(), nil, []roles.RoleType{roles.RoleTypeTOO})
+		viewID := uuid.Must(uuid.NewV4())
+
+		viewFetcher := &mocks.QueueViewFetcher{}
+		viewFetcher.On("FetchQueueView", mock.Anything, officeUser.ID, viewID).Return(&models.OfficeQueueView{
+			ID:        viewID,
+			QueueType: models.QueueTypeCounseling,
+		}, nil)
+
+		handler := ExportQueueHandler{
+			suite.NewHandlerConfig(),
+			viewFetcher,
+			&mocks.OrderFetcher{},
+			&mocks.PaymentRequestListFetcher{},
+			officeusercreator.NewOfficeUserFetcherPop(),
+		}
+		queueType := ghcmessages.QueueTypeTASKORDER
+		viewUUID := strfmt.UUID(viewID.String())
+		response := handler.Handle(setupParams(officeUser, &ghcmessages.ExportQueuePayload{
+			QueueType: &queueType,
+			Format:    models.StringPointer("csv"),
+			ViewID:    &viewUUID,
+		}))
+		suite.IsType(&queues.ExportQueueUnprocessableEntity{}, response)
+	})
+
+	suite.Run("rejects unknown columns", func() {
+		officeUser := factory.BuildOfficeUserWithRoles(suite.DB(), nil, []roles.RoleType{roles.RoleTypeTIO})
+
+		handler := ExportQueueHandler{
+			suite.NewHandlerConfig(),
+			&mocks.QueueViewFetcher{},
+			&mocks.OrderFetcher{},
+			&mocks.PaymentRequestListFetcher{},
+			officeusercreator.NewOfficeUserFetcherPop(),
+		}
+		queueType := ghcmessages.QueueTypePAYMENTREQUEST
+		response := handler.Handle(setupParams(officeUser, &ghcmessages.ExportQueuePayload{
+			QueueType: &queueType,
+			Format:    models.StringPointer("csv"),
+			Columns:   []string{"ppmType"},
+		}))
+		suite.IsType(&queues.ExportQueueUnprocessableEntity{}, response)
+	})
+
+	suite.Run("is forbidden for queues the active role can't see", func() {
+		officeUser := factory.BuildOfficeUserWithRoles(suite.DB(), nil, []roles.RoleType{roles.RoleTypeTIO})
+
+		handler := ExportQueueHandler{
+			suite.NewHandlerConfig(),
+			&mocks.QueueViewFetcher{},
+			&mocks.OrderFetcher{},
+			&mocks.PaymentRequestListFetcher{},
+			officeusercreator.NewOfficeUserFetcherPop(),
+		}
+		queueType := ghcmessages.QueueTypeTASKORDER
+		response := handler.Handle(setupParams(officeUser, &ghcmessages.ExportQueuePayload{
+			QueueType: &queueType,
+			Format:    models.StringPointer("csv"),
+		}))
+		suite.IsType(&queues.ExportQueueForbidden{}, response)
+	})
+}
+
+func (suite *HandlerSuite) TestQueueExportWritersNeutralizeFormulas() {
+	row := []string{"=HYPERLINK(\"http://example.com\")", "+1", "-1", "@SUM(A1)", "\tTab", "\rReturn", "Plain", ""}
+	expected := []string{"'=HYPERLINK(\"http://example.com\")", "'+1", "'-1", "'@SUM(A1)", "'\tTab", "'\rReturn", "Plain", ""}
+
+	suite.Run("CSV", func() {
+		var buf bytes.Buffer
+		writer := newCSVQueueExportWriter(&buf)
+		suite.NoError(writer.WriteRow(row))
+		suite.NoError(writer.Close())
+
+		records, err := csv.NewReader(&buf).ReadAll()
+		suite.NoError(err)
+		suite.Equal([][]string{expected}, records)
+	})
+
+	suite.Run("XLSX", func() {
+		var buf bytes.Buffer
+		writer, err := newXLSXQueueExportWriter(&buf)
+		suite.NoError(err)
+		suite.NoError(writer.WriteRow(row))
+		suite.NoError(writer.Close())
+
+		file, err := excelize.OpenReader(&buf)
+		suite.NoError(err)
+		for i, value := range expected[:len(expected)-1] {
+			cell, err := excelize.CoordinatesToCellName(i+1, 1)
+			suite.NoError(err)
+			cellValue, err := file.GetCellValue(file.GetSheetName(0), cell)
+			suite.NoError(err)
+			suite.Equal(value, cellValue)
+			formula, err := file.GetCellFormula(file.GetSheetName(0), cell)
+			suite.NoError(err)
+			suite.Empty(formula)
+		}
+	})
+}
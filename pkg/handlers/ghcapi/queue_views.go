@@ -0,0 +1,259 @@
+package ghcapi
+
+import (
+	"fmt"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/gofrs/uuid"
+	"go.uber.org/zap"
+
+	"github.com/transcom/mymove/pkg/appcontext"
+	"github.com/transcom/mymove/pkg/apperror"
+	"github.com/transcom/mymove/pkg/gen/ghcapi/ghcoperations/queues"
+	"github.com/transcom/mymove/pkg/gen/ghcmessages"
+	"github.com/transcom/mymove/pkg/handlers"
+	"github.com/transcom/mymove/pkg/handlers/ghcapi/internal/payloads"
+	"github.com/transcom/mymove/pkg/models"
+	"github.com/transcom/mymove/pkg/services"
+)
+
+// ListQueueViewsHandler lists the saved queue views an office user can use via GET /queues/views
+type ListQueueViewsHandler struct {
+	handlers.HandlerConfig
+	services.QueueViewFetcher
+}
+
+// Handle returns the office user's views and the views shared with their transportation offices
+func (h ListQueueViewsHandler) Handle(params queues.ListQueueViewsParams) middleware.Responder {
+	return h.AuditableAppContextFromRequestWithErrors(params.HTTPRequest,
+		func(appCtx appcontext.AppContext) (middleware.Responder, error) {
+			if !appCtx.Session().IsOfficeUser() {
+				forbiddenErr := apperror.NewForbiddenError("user is not authenticated with an office role")
+				appCtx.Logger().Error(forbiddenErr.Error())
+				return queues.NewListQueueViewsForbidden(), forbiddenErr
+			}
+
+			var queueType *models.QueueType
+			if params.QueueType != nil {
+				qt := models.QueueType(*params.QueueType)
+				queueType = &qt
+			}
+
+			views, err := h.FetchQueueViews(appCtx, appCtx.Session().OfficeUserID, queueType)
+			if err != nil {
+				appCtx.Logger().Error("error fetching queue views", zap.Error(err))
+				return queues.NewListQueueViewsInternalServerError(), err
+			}
+
+			return queues.NewListQueueViewsOK().WithPayload(payloads.QueueViews(views, appCtx.Session().OfficeUserID)), nil
+		})
+}
+
+// CreateQueueViewHandler saves a queue view for an office user via POST /queues/views
+type CreateQueueViewHandler struct {
+	handlers.HandlerConfig
+	services.QueueViewCreator
+}
+
+// Handle saves the queue view, shared with the office user's primary transportation office when asked
+func (h CreateQueueViewHandler) Handle(params queues.CreateQueueViewParams) middleware.Responder {
+	return h.AuditableAppContextFromRequestWithErrors(params.HTTPRequest,
+		func(appCtx appcontext.AppContext) (middleware.Responder, error) {
+			if !appCtx.Session().IsOfficeUser() {
+				forbiddenErr := apperror.NewForbiddenError("user is not authenticated with an office role")
+				appCtx.Logger().Error(forbiddenErr.Error())
+				return queues.NewCreateQueueViewForbidden(), forbiddenErr
+			}
+
+			view := payloads.QueueViewModelFromCreate(params.Body, appCtx.Session().OfficeUserID)
+			createdView, err := h.CreateQueueView(appCtx, view, params.Body.Shared)
+			if err != nil {
+				appCtx.Logger().Error("error saving queue view", zap.Error(err))
+				switch e := err.(type) {
+				case apperror.InvalidInputError:
+					payload := payloadForValidationError("Unable to save queue view", err.Error(), h.GetTraceIDFromRequest(params.HTTPRequest), e.ValidationErrors)
+					return queues.NewCreateQueueViewUnprocessableEntity().WithPayload(payload), err
+				default:
+					return queues.NewCreateQueueViewInternalServerError(), err
+				}
+			}
+
+			return queues.NewCreateQueueViewCreated().WithPayload(payloads.QueueView(createdView, appCtx.Session().OfficeUserID)), nil
+		})
+}
+
+// UpdateQueueViewHandler updates a queue view via PUT /queues/views/{viewID}
+type UpdateQueueViewHandler struct {
+	handlers.HandlerConfig
+	services.QueueViewUpdater
+}
+
+// Handle replaces the settings of a queue view the office user saved
+func (h UpdateQueueViewHandler) Handle(params queues.UpdateQueueViewParams) middleware.Responder {
+	return h.AuditableAppContextFromRequestWithErrors(params.HTTPRequest,
+		func(appCtx appcontext.AppContext) (middleware.Responder, error) {
+			if !appCtx.Session().IsOfficeUser() {
+				forbiddenErr := apperror.NewForbiddenError("user is not authenticated with an office role")
+				appCtx.Logger().Error(forbiddenErr.Error())
+				return queues.NewUpdateQueueViewForbidden(), forbiddenErr
+			}
+
+			viewID := uuid.FromStringOrNil(params.ViewID.String())
+			view := payloads.QueueViewModelFromUpdate(params.Body, viewID, appCtx.Session().OfficeUserID)
+			updatedView, err := h.UpdateQueueView(appCtx, view, params.Body.Shared, params.IfMatch)
+			if err != nil {
+				appCtx.Logger().Error("error updating queue view", zap.Error(err))
+				switch e := err.(type) {
+				case apperror.NotFoundError:
+					return queues.NewUpdateQueueViewNotFound(), err
+				case apperror.ForbiddenError:
+					return queues.NewUpdateQueueViewForbidden(), err
+				case apperror.PreconditionFailedError:
+					return queues.NewUpdateQueueViewPreconditionFailed().WithPayload(&ghcmessages.Error{Message: handlers.FmtString(err.Error())}), err
+				case apperror.InvalidInputError:
+					payload := payloadForValidationError("Unable to update queue view", err.Error(), h.GetTraceIDFromRequest(params.HTTPRequest), e.ValidationErrors)
+					return queues.NewUpdateQueueViewUnprocessableEntity().WithPayload(payload), err
+				default:
+					return queues.NewUpdateQueueViewInternalServerError(), err
+				}
+			}
+
+			return queues.NewUpdateQueueViewOK().WithPayload(payloads.QueueView(updatedView, appCtx.Session().OfficeUserID)), nil
+		})
+}
+
+// DeleteQueueViewHandler deletes a queue view via DELETE /queues/views/{viewID}
+type DeleteQueueViewHandler struct {
+	handlers.HandlerConfig
+	services.QueueViewDeleter
+}
+
+// Handle deletes a queue view the office user saved
+func (h DeleteQueueViewHandler) Handle(params queues.DeleteQueueViewParams) middleware.Responder {
+	return h.AuditableAppContextFromRequestWithErrors(params.HTTPRequest,
+		func(appCtx appcontext.AppContext) (middleware.Responder, error) {
+			if !appCtx.Session().IsOfficeUser() {
+				forbiddenErr := apperror.NewForbiddenError("user is not authenticated with an office role")
+				appCtx.Logger().Error(forbiddenErr.Error())
+				return queues.NewDeleteQueueViewForbidden(), forbiddenErr
+			}
+
+			viewID := uuid.FromStringOrNil(params.ViewID.String())
+			err := h.DeleteQueueView(appCtx, appCtx.Session().OfficeUserID, viewID)
+			if err != nil {
+				appCtx.Logger().Error("error deleting queue view", zap.Error(err))
+				switch err.(type) {
+				case apperror.NotFoundError:
+					return queues.NewDeleteQueueViewNotFound(), err
+				case apperror.ForbiddenError:
+					return queues.NewDeleteQueueViewForbidden(), err
+				default:
+					return queues.NewDeleteQueueViewInternalServerError(), err
+				}
+			}
+
+			return queues.NewDeleteQueueViewNoContent(), nil
+		})
+}
+
+// fetchQueueViewForQueue returns the saved view a queue request names, or nil when it doesn't name one.
+// A view saved for another queue is not found, so its filters are never applied to the wrong queue.
+func fetchQueueViewForQueue(appCtx appcontext.AppContext, fetcher services.QueueViewFetcher, viewID *strfmt.UUID, queueType models.QueueType) (*models.OfficeQueueView, error) {
+	if viewID == nil {
+		return nil, nil
+	}
+
+	id := uuid.FromStringOrNil(viewID.String())
+	view, err := fetcher.FetchQueueView(appCtx, appCtx.Session().OfficeUserID, id)
+	if err != nil {
+		return nil, err
+	}
+	if view.QueueType != queueType {
+		return nil, apperror.NewNotFoundError(id, fmt.Sprintf("while looking for a %s queue view", queueType))
+	}
+	return view, nil
+}
+
+// applyQueueViewToListOrderParams fills the filters, sort and order the request left out from a saved
+// view. The view's viewAsGBLOC is left to the caller, which checks the office user may use it.
+func applyQueueViewToListOrderParams(params *services.ListOrderParams, view *models.OfficeQueueView) {
+	if view == nil {
+		return
+	}
+
+	filters := view.Filters
+	fillStringPtr(&params.Branch, filters.Branch)
+	fillStringPtr(&params.Locator, filters.Locator)
+	fillStringPtr(&params.Edipi, filters.Edipi)
+	fillStringPtr(&params.Emplid, filters.Emplid)
+	fillStringPtr(&params.CustomerName, filters.CustomerName)
+	fillStringPtr(&params.DestinationDutyLocation, filters.DestinationDutyLocation)
+	fillStringPtr(&params.OriginGBLOC, filters.OriginGBLOC)
+	fillStringPtr(&params.RequestedMoveDate, filters.RequestedMoveDate)
+	fillStringPtr(&params.OrderType, filters.OrderType)
+	fillStringPtr(&params.PPMType, filters.PPMType)
+	fillStringPtr(&params.PPMStatus, filters.PPMStatus)
+	fillStringPtr(&params.CloseoutLocation, filters.CloseoutLocation)
+	fillStringPtr(&params.CounselingOffice, filters.CounselingOffice)
+	fillStringPtr(&params.AssignedTo, filters.AssignedTo)
+	fillStringPtr(&params.Sort, view.Sort)
+	fillStringPtr(&params.Order, view.Order)
+	if len(params.OriginDutyLocation) == 0 {
+		params.OriginDutyLocation = filters.OriginDutyLocation
+	}
+	if len(params.Status) == 0 {
+		params.Status = filters.Status
+	}
+	if params.SubmittedAt == nil {
+		params.SubmittedAt = filters.SubmittedAt
+	}
+	if params.AppearedInTOOAt == nil {
+		params.AppearedInTOOAt = filters.AppearedInTOOAt
+	}
+	if params.CloseoutInitiated == nil {
+		params.CloseoutInitiated = filters.CloseoutInitiated
+	}
+}
+
+// applyQueueViewToPaymentRequestListParams fills the filters, sort and order the request left out from
+// a saved payment request queue view
+func applyQueueViewToPaymentRequestListParams(params *services.FetchPaymentRequestListParams, view *models.OfficeQueueView) {
+	if view == nil {
+		return
+	}
+
+	filters := view.Filters
+	fillStringPtr(&params.Branch, filters.Branch)
+	fillStringPtr(&params.Locator, filters.Locator)
+	fillStringPtr(&params.Edipi, filters.Edipi)
+	fillStringPtr(&params.Emplid, filters.Emplid)
+	fillStringPtr(&params.CustomerName, filters.CustomerName)
+	fillStringPtr(&params.DestinationDutyLocation, filters.DestinationDutyLocation)
+	fillStringPtr(&params.OrderType, filters.OrderType)
+	fillStringPtr(&params.TIOAssignedUser, filters.AssignedTo)
+	fillStringPtr(&params.CounselingOffice, filters.CounselingOffice)
+	fillStringPtr(&params.Sort, view.Sort)
+	fillStringPtr(&params.Order, view.Order)
+	// the payment request queue filters on a single origin duty location
+	if params.OriginDutyLocation == nil && len(filters.OriginDutyLocation) > 0 {
+		params.OriginDutyLocation = &filters.OriginDutyLocation[0]
+	}
+	if params.SubmittedAt == nil {
+		params.SubmittedAt = filters.SubmittedAt
+	}
+}
+
+// queueViewAsGBLOC returns the GBLOC the request or the saved view asks to view the queue as
+func queueViewAsGBLOC(requested *string, view *models.OfficeQueueView) *string {
+	if requested == nil && view != nil {
+		return view.Filters.ViewAsGBLOC
+	}
+	return requested
+}
+
+func fillStringPtr(dst **string, src *string) {
+	if *dst == nil && src != nil {
+		*dst = src
+	}
+}
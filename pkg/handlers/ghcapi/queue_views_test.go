@@ -0,0 +1,195 @@
+package ghcapi
+
+import (
+	"net/http/httptest"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/transcom/mymove/pkg/apperror"
+	"github.com/transcom/mymove/pkg/etag"
+	"github.com/transcom/mymove/pkg/factory"
+	"github.com/transcom/mymove/pkg/gen/ghcapi/ghcoperations/queues"
+	"github.com/transcom/mymove/pkg/gen/ghcmessages"
+	"github.com/transcom/mymove/pkg/models"
+	"github.com/transcom/mymove/pkg/models/roles"
+	"github.com/transcom/mymove/pkg/services/mocks"
+	queueview "github.com/transcom/mymove/pkg/services/queue_view"
+)
+
+func (suite *HandlerSuite) TestListQueueViewsHandler() {
+	suite.Run("returns the office user's views", func() {
+		officeUser := factory.BuildOfficeUserWithRoles(suite.DB(), nil, []roles.RoleType{roles.RoleTypeTOO})
+		view := models.OfficeQueueView{
+			ID:           uuid.Must(uuid.NewV4()),
+			OfficeUserID: officeUser.ID,
+			OfficeUser:   officeUser,
+			QueueType:    models.QueueTypeTaskOrder,
+			Name:         "Army moves",
+			Filters:      models.QueueViewFilters{Branch: models.StringPointer("ARMY")},
+		}
+		queueType := models.QueueTypeTaskOrder
+
+		fetcher := &mocks.QueueViewFetcher{}
+		fetcher.On("FetchQueueViews", mock.Anything, officeUser.ID, &queueType).Return(models.OfficeQueueViews{view}, nil)
+
+		request := suite.AuthenticateOfficeRequest(httptest.NewRequest("GET", "/queues/views", nil), officeUser)
+		params := queues.ListQueueViewsParams{
+			HTTPRequest: request,
+			QueueType:   models.StringPointer(string(queueType)),
+		}
+		handler := ListQueueViewsHandler{suite.NewHandlerConfig(), fetcher}
+
+		response := handler.Handle(params)
+		suite.IsType(&queues.ListQueueViewsOK{}, response)
+		payload := response.(*queues.ListQueueViewsOK).Payload
+		suite.NoError(payload.Validate(strfmt.Default))
+		suite.Len(payload, 1)
+		suite.Equal("Army moves", *payload[0].Name)
+		suite.Equal("ARMY", *payload[0].Filters.Branch)
+		suite.True(*payload[0].IsOwner)
+		suite.False(*payload[0].Shared)
+	})
+
+	suite.Run("is forbidden to customers", func() {
+		serviceMember := factory.BuildServiceMember(suite.DB(), nil, nil)
+		request := suite.AuthenticateRequest(httptest.NewRequest("GET", "/queues/views", nil), serviceMember)
+		handler := ListQueueViewsHandler{suite.NewHandlerConfig(), &mocks.QueueViewFetcher{}}
+
+		response := handler.Handle(queues.ListQueueViewsParams{HTTPRequest: request})
+		suite.IsType(&queues.ListQueueViewsForbidden{}, response)
+	})
+}
+
+func (suite *HandlerSuite) TestCreateQueueViewHandler() {
+	suite.Run("saves a view shared with the office user's office", func() {
+		officeUser := factory.BuildOfficeUserWithRoles(suite.DB(), nil, []roles.RoleType{roles.RoleTypeServicesCounselor})
+		queueType := ghcmessages.QueueTypeCOUNSELING
+
+		request := suite.AuthenticateOfficeRequest(httptest.NewRequest("POST", "/queues/views", nil), officeUser)
+		params := queues.CreateQueueViewParams{
+			HTTPRequest: request,
+			Body: &ghcmessages.CreateQueueViewPayload{
+				Name:      models.StringPointer("Navy counseling"),
+				QueueType: &queueType,
+				Filters:   &ghcmessages.QueueViewFilters{Branch: models.StringPointer("NAVY")},
+				Sort:      models.StringPointer("submittedAt"),
+				Order:     models.StringPointer("desc"),
+				Columns:   []string{"customerName", "locator"},
+				Shared:    true,
+			},
+		}
+		handler := CreateQueueViewHandler{suite.NewHandlerConfig(), queueview.NewQueueViewCreator()}
+
+		response := handler.Handle(params)
+		suite.IsType(&queues.CreateQueueViewCreated{}, response)
+		payload := response.(*queues.CreateQueueViewCreated).Payload
+		suite.NoError(payload.Validate(strfmt.Default))
+		suite.Equal("Navy counseling", *payload.Name)
+		suite.True(*payload.Shared)
+		suite.True(*payload.IsOwner)
+		suite.Equal([]string{"customerName", "locator"}, payload.Columns)
+	})
+
+	suite.Run("rejects a second view with the same name", func() {
+		officeUser := factory.BuildOfficeUserWithRoles(suite.DB(), nil, []roles.RoleType{roles.RoleTypeTOO})
+		queueType := ghcmessages.QueueTypeTASKORDER
+		body := &ghcmessages.CreateQueueViewPayload{
+			Name:      models.StringPointer("Mine"),
+			QueueType: &queueType,
+		}
+		handler := CreateQueueViewHandler{suite.NewHandlerConfig(), queueview.NewQueueViewCreator()}
+
+		request := suite.AuthenticateOfficeRequest(httptest.NewRequest("POST", "/queues/views", nil), officeUser)
+		response := handler.Handle(queues.CreateQueueViewParams{HTTPRequest: request, Body: body})
+		suite.IsType(&queues.CreateQueueViewCreated{}, response)
+
+		response = handler.Handle(queues.CreateQueueViewParams{HTTPRequest: request, Body: body})
+		suite.IsType(&queues.CreateQueueViewUnprocessableEntity{}, response)
+	})
+}
+
+func (suite *HandlerSuite) TestUpdateQueueViewHandler() {
+	officeUser := factory.BuildOfficeUserWithRoles(suite.DB(), nil, []roles.RoleType{roles.RoleTypeTIO})
+	viewID := uuid.Must(uuid.NewV4())
+	body := &ghcmessages.UpdateQueueViewPayload{
+		Name: models.StringPointer("Renamed"),
+	}
+
+	setupParams := func() queues.UpdateQueueViewParams {
+		request := suite.AuthenticateOfficeRequest(httptest.NewRequest("PUT", "/queues/views/"+viewID.String(), nil), officeUser)
+		return queues.UpdateQueueViewParams{
+			HTTPRequest: request,
+			ViewID:      strfmt.UUID(viewID.String()),
+			IfMatch:     "stale",
+			Body:        body,
+		}
+	}
+
+	suite.Run("returns the updated view", func() {
+		updated := models.OfficeQueueView{
+			ID:           viewID,
+			OfficeUserID: officeUser.ID,
+			OfficeUser:   officeUser,
+			QueueType:    models.QueueTypePaymentRequest,
+			Name:         "Renamed",
+		}
+		updater := &mocks.QueueViewUpdater{}
+		updater.On("UpdateQueueView", mock.Anything, mock.AnythingOfType("*models.OfficeQueueView"), false, "stale").Return(&updated, nil)
+
+		response := UpdateQueueViewHandler{suite.NewHandlerConfig(), updater}.Handle(setupParams())
+		suite.IsType(&queues.UpdateQueueViewOK{}, response)
+		payload := response.(*queues.UpdateQueueViewOK).Payload
+		suite.Equal("Renamed", *payload.Name)
+		suite.Equal(etag.GenerateEtag(updated.UpdatedAt), *payload.ETag)
+	})
+
+	suite.Run("maps service errors to responses", func() {
+		testCases := []struct {
+			err      error
+			response interface{}
+		}{
+			{apperror.NewNotFoundError(viewID, ""), &queues.UpdateQueueViewNotFound{}},
+			{apperror.NewForbiddenError("not the owner"), &queues.UpdateQueueViewForbidden{}},
+			{apperror.NewPreconditionFailedError(viewID, nil), &queues.UpdateQueueViewPreconditionFailed{}},
+			{apperror.NewInvalidInputError(viewID, nil, nil, ""), &queues.UpdateQueueViewUnprocessableEntity{}},
+		}
+		for _, tc := range testCases {
+			updater := &mocks.QueueViewUpdater{}
+			updater.On("UpdateQueueView", mock.Anything, mock.Anything, false, "stale").Return(nil, tc.err)
+
+			response := UpdateQueueViewHandler{suite.NewHandlerConfig(), updater}.Handle(setupParams())
+			suite.IsType(tc.response, response)
+		}
+	})
+}
+
+func (suite *HandlerSuite) TestDeleteQueueViewHandler() {
+	officeUser := factory.BuildOfficeUserWithRoles(suite.DB(), nil, []roles.RoleType{roles.RoleTypeTOO})
+	viewID := uuid.Must(uuid.NewV4())
+
+	setupParams := func() queues.DeleteQueueViewParams {
+		request := suite.AuthenticateOfficeRequest(httptest.NewRequest("DELETE", "/queues/views/"+viewID.String(), nil), officeUser)
+		return queues.DeleteQueueViewParams{
+			HTTPRequest: request,
+			ViewID:      strfmt.UUID(viewID.String()),
+		}
+	}
+
+	suite.Run("deletes the view", func() {
+		deleter := &mocks.QueueViewDeleter{}
+		deleter.On("DeleteQueueView", mock.Anything, officeUser.ID, viewID).Return(nil)
+
+		response := DeleteQueueViewHandler{suite.NewHandlerConfig(), deleter}.Handle(setupParams())
+		suite.IsType(&queues.DeleteQueueViewNoContent{}, response)
+	})
+
+	suite.Run("is forbidden for views the office user doesn't own", func() {
+		deleter := &mocks.QueueViewDeleter{}
+		deleter.On("DeleteQueueView", mock.Anything, officeUser.ID, viewID).Return(apperror.NewForbiddenError("not the owner"))
+
+		response := DeleteQueueViewHandler{suite.NewHandlerConfig(), deleter}.Handle(setupParams())
+		suite.IsType(&queues.DeleteQueueViewForbidden{}, response)
+	})
+}
@@ -26,6 +26,7 @@ type GetMovesQueueHandler struct {
 	services.OrderFetcher
 	services.MoveUnlocker
 	services.OfficeUserFetcherPop
+	services.QueueViewFetcher
 }
 
 // FilterOption defines the type for the functional arguments used for private functions in OrderFetcher
@@ -57,6 +58,7 @@ func (h GetMovesQueueHandler) Handle(params queues.GetMovesQueueParams) middlewa
 				Status:                  params.Status,
 				Page:                    params.Page,
 				PerPage:                 params.PerPage,
+				Cursor:                  params.Cursor,
 				Sort:                    params.Sort,
 				Order:                   params.Order,
 				OrderType:               params.OrderType,
@@ -64,13 +66,23 @@ func (h GetMovesQueueHandler) Handle(params queues.GetMovesQueueParams) middlewa
 				CounselingOffice:        params.CounselingOffice,
 			}
 
+			queueView, err := fetchQueueViewForQueue(appCtx, h.QueueViewFetcher, params.ViewID, models.QueueTypeTaskOrder)
+			if err != nil {
+				appCtx.Logger().Error("error fetching queue view", zap.Error(err))
+				if _, ok := err.(apperror.NotFoundError); ok {
+					return queues.NewGetMovesQueueNotFound(), err
+				}
+				return queues.NewGetMovesQueueInternalServerError(), err
+			}
+			applyQueueViewToListOrderParams(&ListOrderParams, queueView)
+
 			var activeRole string
 			if params.ActiveRole != nil {
 				activeRole = *params.ActiveRole
 			}
 
 			// When no status filter applied, TOO should only see moves with status of New Move, Service Counseling Completed, or Approvals Requested
-			if params.Status == nil {
+			if ListOrderParams.Status == nil {
 				ListOrderParams.Status = []string{string(models.MoveStatusServiceCounselingCompleted), string(models.MoveStatusAPPROVALSREQUESTED), string(models.MoveStatusSUBMITTED)}
 			}
 
@@ -85,7 +97,6 @@ func (h GetMovesQueueHandler) Handle(params queues.GetMovesQueueParams) middlewa
 
 			var officeUser models.OfficeUser
 			var assignedGblocs []string
-			var err error
 			if appCtx.Session().OfficeUserID != uuid.Nil {
 				officeUser, err = h.OfficeUserFetcherPop.FetchOfficeUserByIDWithTransportationOfficeAssignments(appCtx, appCtx.Session().OfficeUserID)
 				if err != nil {
@@ -96,8 +107,9 @@ func (h GetMovesQueueHandler) Handle(params queues.GetMovesQueueParams) middlewa
 				assignedGblocs = models.GetAssignedGBLOCs(officeUser)
 			}
 
-			if params.ViewAsGBLOC != nil && ((appCtx.Session().ActiveRole.RoleType == roles.RoleTypeHQ) || slices.Contains(assignedGblocs, *params.ViewAsGBLOC)) {
-				ListOrderParams.ViewAsGBLOC = params.ViewAsGBLOC
+			viewAsGBLOC := queueViewAsGBLOC(params.ViewAsGBLOC, queueView)
+			if viewAsGBLOC != nil && ((appCtx.Session().ActiveRole.RoleType == roles.RoleTypeHQ) || slices.Contains(assignedGblocs, *viewAsGBLOC)) {
+				ListOrderParams.ViewAsGBLOC = viewAsGBLOC
 			}
 
 			privileges, err := roles.FetchPrivilegesForUser(appCtx.DB(), appCtx.Session().UserID)
@@ -144,7 +156,12 @@ func (h GetMovesQueueHandler) Handle(params queues.GetMovesQueueParams) middlewa
 			if err != nil {
 				appCtx.Logger().
 					Error("error fetching list of moves for office user", zap.Error(err))
-				return queues.NewGetMovesQueueInternalServerError(), err
+				switch err.(type) {
+				case *apperror.BadDataError, apperror.InvalidInputError:
+					return queues.NewGetMovesQueueBadRequest(), err
+				default:
+					return queues.NewGetMovesQueueInternalServerError(), err
+				}
 			}
 
 			// if the TOO/office user is accessing the queue, we need to unlock move/moves they have locked
@@ -174,6 +191,7 @@ func (h GetMovesQueueHandler) Handle(params queues.GetMovesQueueParams) middlewa
 				Page:       *ListOrderParams.Page,
 				PerPage:    *ListOrderParams.PerPage,
 				TotalCount: int64(count),
+				NextCursor: ListOrderParams.NextCursor,
 				QueueMoves: *queueMoves,
 			}
 
@@ -187,6 +205,7 @@ type GetDestinationRequestsQueueHandler struct {
 	services.OrderFetcher
 	services.MoveUnlocker
 	services.OfficeUserFetcherPop
+	services.QueueViewFetcher
 }
 
 // Handle returns the paginated list of moves with destination requests for a TOO user
@@ -215,6 +234,7 @@ func (h GetDestinationRequestsQueueHandler) Handle(params queues.GetDestinationR
 				Status:                  params.Status,
 				Page:                    params.Page,
 				PerPage:                 params.PerPage,
+				Cursor:                  params.Cursor,
 				Sort:                    params.Sort,
 				Order:                   params.Order,
 				OrderType:               params.OrderType,
@@ -222,12 +242,22 @@ func (h GetDestinationRequestsQueueHandler) Handle(params queues.GetDestinationR
 				CounselingOffice:        params.CounselingOffice,
 			}
 
+			queueView, err := fetchQueueViewForQueue(appCtx, h.QueueViewFetcher, params.ViewID, models.QueueTypeDestinationRequest)
+			if err != nil {
+				appCtx.Logger().Error("error fetching queue view", zap.Error(err))
+				if _, ok := err.(apperror.NotFoundError); ok {
+					return queues.NewGetDestinationRequestsQueueNotFound(), err
+				}
+				return queues.NewGetDestinationRequestsQueueInternalServerError(), err
+			}
+			applyQueueViewToListOrderParams(&ListOrderParams, queueView)
+
 			var activeRole string
 			if params.ActiveRole != nil {
 				activeRole = *params.ActiveRole
 			}
 			// we only care about moves in APPROVALS REQUESTED status
-			if params.Status == nil {
+			if ListOrderParams.Status == nil {
 				ListOrderParams.Status = []string{string(models.MoveStatusAPPROVALSREQUESTED)}
 			}
 
@@ -241,7 +271,6 @@ func (h GetDestinationRequestsQueueHandler) Handle(params queues.GetDestinationR
 
 			var officeUser models.OfficeUser
 			var assignedGblocs []string
-			var err error
 			if appCtx.Session().OfficeUserID != uuid.Nil {
 				officeUser, err = h.OfficeUserFetcherPop.FetchOfficeUserByIDWithTransportationOfficeAssignments(appCtx, appCtx.Session().OfficeUserID)
 				if err != nil {
@@ -252,8 +281,9 @@ func (h GetDestinationRequestsQueueHandler) Handle(params queues.GetDestinationR
 				assignedGblocs = models.GetAssignedGBLOCs(officeUser)
 			}
 
-			if params.ViewAsGBLOC != nil && ((appCtx.Session().ActiveRole.RoleType == roles.RoleTypeHQ) || slices.Contains(assignedGblocs, *params.ViewAsGBLOC)) {
-				ListOrderParams.ViewAsGBLOC = params.ViewAsGBLOC
+			viewAsGBLOC := queueViewAsGBLOC(params.ViewAsGBLOC, queueView)
+			if viewAsGBLOC != nil && ((appCtx.Session().ActiveRole.RoleType == roles.RoleTypeHQ) || slices.Contains(assignedGblocs, *viewAsGBLOC)) {
+				ListOrderParams.ViewAsGBLOC = viewAsGBLOC
 			}
 
 			moves, count, err := h.OrderFetcher.ListDestinationRequestsOrders(
@@ -265,7 +295,12 @@ func (h GetDestinationRequestsQueueHandler) Handle(params queues.GetDestinationR
 			if err != nil {
 				appCtx.Logger().
 					Error("error fetching destinaton queue for office user", zap.Error(err))
-				return queues.NewGetDestinationRequestsQueueInternalServerError(), err
+				switch err.(type) {
+				case *apperror.BadDataError, apperror.InvalidInputError:
+					return queues.NewGetDestinationRequestsQueueBadRequest(), err
+				default:
+					return queues.NewGetDestinationRequestsQueueInternalServerError(), err
+				}
 			}
 
 			privileges, err := roles.FetchPrivilegesForUser(appCtx.DB(), appCtx.Session().UserID)
@@ -328,6 +363,7 @@ func (h GetDestinationRequestsQueueHandler) Handle(params queues.GetDestinationR
 				Page:       *ListOrderParams.Page,
 				PerPage:    *ListOrderParams.PerPage,
 				TotalCount: int64(count),
+				NextCursor: ListOrderParams.NextCursor,
 				QueueMoves: *queueMoves,
 			}
 
@@ -392,6 +428,7 @@ type GetPaymentRequestsQueueHandler struct {
 	services.PaymentRequestListFetcher
 	services.MoveUnlocker
 	services.OfficeUserFetcherPop
+	services.QueueViewFetcher
 }
 
 // Handle returns the paginated list of payment requests for the TIO user
@@ -419,6 +456,7 @@ func (h GetPaymentRequestsQueueHandler) Handle(
 				Status:                  params.Status,
 				Page:                    params.Page,
 				PerPage:                 params.PerPage,
+				Cursor:                  params.Cursor,
 				SubmittedAt:             handlers.FmtDateTimePtrToPopPtr(params.SubmittedAt),
 				Sort:                    params.Sort,
 				Order:                   params.Order,
@@ -428,6 +466,16 @@ func (h GetPaymentRequestsQueueHandler) Handle(
 				CounselingOffice:        params.CounselingOffice,
 			}
 
+			queueView, err := fetchQueueViewForQueue(appCtx, h.QueueViewFetcher, params.ViewID, models.QueueTypePaymentRequest)
+			if err != nil {
+				appCtx.Logger().Error("error fetching queue view", zap.Error(err))
+				if _, ok := err.(apperror.NotFoundError); ok {
+					return queues.NewGetPaymentRequestsQueueNotFound(), err
+				}
+				return queues.NewGetPaymentRequestsQueueInternalServerError(), err
+			}
+			applyQueueViewToPaymentRequestListParams(&listPaymentRequestParams, queueView)
+
 			var activeRole string
 			if params.ActiveRole != nil {
 				activeRole = *params.ActiveRole
@@ -447,7 +495,6 @@ func (h GetPaymentRequestsQueueHandler) Handle(
 
 			var officeUser models.OfficeUser
 			var assignedGblocs []string
-			var err error
 			if appCtx.Session().OfficeUserID != uuid.Nil {
 				officeUser, err = h.OfficeUserFetcherPop.FetchOfficeUserByIDWithTransportationOfficeAssignments(appCtx, appCtx.Session().OfficeUserID)
 				if err != nil {
@@ -458,8 +505,9 @@ func (h GetPaymentRequestsQueueHandler) Handle(
 				assignedGblocs = models.GetAssignedGBLOCs(officeUser)
 			}
 
-			if params.ViewAsGBLOC != nil && ((appCtx.Session().ActiveRole.RoleType == roles.RoleTypeHQ) || slices.Contains(assignedGblocs, *params.ViewAsGBLOC)) {
-				listPaymentRequestParams.ViewAsGBLOC = params.ViewAsGBLOC
+			viewAsGBLOC := queueViewAsGBLOC(params.ViewAsGBLOC, queueView)
+			if viewAsGBLOC != nil && ((appCtx.Session().ActiveRole.RoleType == roles.RoleTypeHQ) || slices.Contains(assignedGblocs, *viewAsGBLOC)) {
+				listPaymentRequestParams.ViewAsGBLOC = viewAsGBLOC
 			}
 
 			privileges, err := roles.FetchPrivilegesForUser(appCtx.DB(), appCtx.Session().UserID)
@@ -505,7 +553,12 @@ func (h GetPaymentRequestsQueueHandler) Handle(
 			if err != nil {
 				appCtx.Logger().
 					Error("payment requests queue", zap.String("office_user_id", appCtx.Session().OfficeUserID.String()), zap.Error(err))
-				return queues.NewGetPaymentRequestsQueueInternalServerError(), err
+				switch err.(type) {
+				case *apperror.BadDataError, apperror.InvalidInputError:
+					return queues.NewGetPaymentRequestsQueueBadRequest(), err
+				default:
+					return queues.NewGetPaymentRequestsQueueInternalServerError(), err
+				}
 			}
 
 			// if this TIO/office user is accessing the queue, we need to unlock move/moves they have locked
@@ -535,6 +588,7 @@ func (h GetPaymentRequestsQueueHandler) Handle(
 				TotalCount:           int64(count),
 				Page:                 int64(*listPaymentRequestParams.Page),
 				PerPage:              int64(*listPaymentRequestParams.PerPage),
+				NextCursor:           listPaymentRequestParams.NextCursor,
 				QueuePaymentRequests: *queuePaymentRequests,
 			}
 
@@ -548,6 +602,7 @@ type GetServicesCounselingQueueHandler struct {
 	services.OrderFetcher
 	services.MoveUnlocker
 	services.OfficeUserFetcherPop
+	services.QueueViewFetcher
 }
 
 // Handle returns the paginated list of moves for the services counselor
@@ -588,8 +643,25 @@ func (h GetServicesCounselingQueueHandler) Handle(
 				PPMStatus:               params.PpmStatus,
 				CounselingOffice:        params.CounselingOffice,
 				AssignedTo:              params.AssignedTo,
+				Status:                  params.Status,
+				Cursor:                  params.Cursor,
 			}
 
+			queueType := models.QueueTypeCounseling
+			if params.NeedsPPMCloseout != nil && *params.NeedsPPMCloseout {
+				queueType = models.QueueTypeCloseout
+			}
+
+			queueView, err := fetchQueueViewForQueue(appCtx, h.QueueViewFetcher, params.ViewID, queueType)
+			if err != nil {
+				appCtx.Logger().Error("error fetching queue view", zap.Error(err))
+				if _, ok := err.(apperror.NotFoundError); ok {
+					return queues.NewGetServicesCounselingQueueNotFound(), err
+				}
+				return queues.NewGetServicesCounselingQueueInternalServerError(), err
+			}
+			applyQueueViewToListOrderParams(&ListOrderParams, queueView)
+
 			var activeRole string
 			if params.ActiveRole != nil {
 				activeRole = *params.ActiveRole
@@ -599,10 +671,8 @@ func (h GetServicesCounselingQueueHandler) Handle(
 			if params.NeedsPPMCloseout != nil && *params.NeedsPPMCloseout {
 				requestedPpmStatus = models.PPMShipmentStatusNeedsCloseout
 				ListOrderParams.Status = []string{string(models.MoveStatusAPPROVED), string(models.MoveStatusServiceCounselingCompleted)}
-			} else if len(params.Status) == 0 {
+			} else if len(ListOrderParams.Status) == 0 {
 				ListOrderParams.Status = []string{string(models.MoveStatusNeedsServiceCounseling)}
-			} else {
-				ListOrderParams.Status = params.Status
 			}
 
 			// Let's set default values for page and perPage if we don't get arguments for them. We'll use 1 for page and 20
@@ -617,7 +687,6 @@ func (h GetServicesCounselingQueueHandler) Handle(
 
 			var officeUser models.OfficeUser
 			var assignedGblocs []string
-			var err error
 			if appCtx.Session().OfficeUserID != uuid.Nil {
 				officeUser, err = h.OfficeUserFetcherPop.FetchOfficeUserByIDWithTransportationOfficeAssignments(appCtx, appCtx.Session().OfficeUserID)
 				if err != nil {
@@ -628,8 +697,9 @@ func (h GetServicesCounselingQueueHandler) Handle(
 				assignedGblocs = models.GetAssignedGBLOCs(officeUser)
 			}
 
-			if params.ViewAsGBLOC != nil && ((appCtx.Session().ActiveRole.RoleType == roles.RoleTypeHQ) || slices.Contains(assignedGblocs, *params.ViewAsGBLOC)) {
-				ListOrderParams.ViewAsGBLOC = params.ViewAsGBLOC
+			viewAsGBLOC := queueViewAsGBLOC(params.ViewAsGBLOC, queueView)
+			if viewAsGBLOC != nil && ((appCtx.Session().ActiveRole.RoleType == roles.RoleTypeHQ) || slices.Contains(assignedGblocs, *viewAsGBLOC)) {
+				ListOrderParams.ViewAsGBLOC = viewAsGBLOC
 			}
 
 			privileges, err := roles.FetchPrivilegesForUser(appCtx.DB(), appCtx.Session().UserID)
@@ -679,7 +749,12 @@ func (h GetServicesCounselingQueueHandler) Handle(
 			if err != nil {
 				appCtx.Logger().
 					Error("error fetching list of moves for office user", zap.Error(err))
-				return queues.NewGetServicesCounselingQueueInternalServerError(), err
+				switch err.(type) {
+				case *apperror.BadDataError, apperror.InvalidInputError:
+					return queues.NewGetServicesCounselingQueueBadRequest(), err
+				default:
+					return queues.NewGetServicesCounselingQueueInternalServerError(), err
+				}
 			}
 
 			// if the SC/office user is accessing the queue, we need to unlock move/moves they have locked
@@ -703,17 +778,13 @@ func (h GetServicesCounselingQueueHandler) Handle(
 				}
 			}
 
-			queueType := string(models.QueueTypeCounseling)
-			if params.NeedsPPMCloseout != nil && *params.NeedsPPMCloseout {
-				queueType = string(models.QueueTypeCloseout)
-			}
-
-			queueMoves := payloads.QueueMoves(moves, officeUsers, &requestedPpmStatus, officeUser, officeUsersSafety, activeRole, queueType)
+			queueMoves := payloads.QueueMoves(moves, officeUsers, &requestedPpmStatus, officeUser, officeUsersSafety, activeRole, string(queueType))
 
 			result := &ghcmessages.QueueMovesResult{
 				Page:       *ListOrderParams.Page,
 				PerPage:    *ListOrderParams.PerPage,
 				TotalCount: int64(count),
+				NextCursor: ListOrderParams.NextCursor,
 				QueueMoves: *queueMoves,
 			}
 
@@ -24,6 +24,7 @@ import (
 	officeusercreator "github.com/transcom/mymove/pkg/services/office_user"
 	order "github.com/transcom/mymove/pkg/services/order"
 	paymentrequest "github.com/transcom/mymove/pkg/services/payment_request"
+	queueview "github.com/transcom/mymove/pkg/services/queue_view"
 )
 
 func (suite *HandlerSuite) TestGetMoveQueuesHandler() {
@@ -86,6 +87,7 @@ func (suite *HandlerSuite) TestGetMoveQueuesHandler() {
 		order.NewOrderFetcher(waf),
 		mockUnlocker,
 		officeusercreator.NewOfficeUserFetcherPop(),
+		queueview.NewQueueViewFetcher(),
 	}
 
 	// Validate incoming payload: no body to validate
@@ -215,6 +217,7 @@ func (suite *HandlerSuite) TestGetMoveQueuesHandlerMoveInfo() {
 			&orderFetcher,
 			mockUnlocker,
 			officeusercreator.NewOfficeUserFetcherPop(),
+			queueview.NewQueueViewFetcher(),
 		}
 
 		// Validate incoming payload: no body to validate
@@ -290,6 +293,7 @@ func (suite *HandlerSuite) TestGetMoveQueuesBranchFilter() {
 		order.NewOrderFetcher(waf),
 		mockUnlocker,
 		officeusercreator.NewOfficeUserFetcherPop(),
+		queueview.NewQueueViewFetcher(),
 	}
 
 	// Validate incoming payload: no body to validate
@@ -379,6 +383,7 @@ func (suite *HandlerSuite) TestGetMoveQueuesHandlerStatuses() {
 		order.NewOrderFetcher(waf),
 		mockUnlocker,
 		officeusercreator.NewOfficeUserFetcherPop(),
+		queueview.NewQueueViewFetcher(),
 	}
 
 	// Validate incoming payload: no body to validate
@@ -538,6 +543,7 @@ func (suite *HandlerSuite) TestGetMoveQueuesHandlerFilters() {
 		order.NewOrderFetcher(waf),
 		mockUnlocker,
 		officeusercreator.NewOfficeUserFetcherPop(),
+		queueview.NewQueueViewFetcher(),
 	}
 
 	suite.Run("loads results with all statuses selected", func() {
@@ -796,6 +802,7 @@ func (suite *HandlerSuite) TestGetMoveQueuesHandlerCustomerInfoFilters() {
 		order.NewOrderFetcher(waf),
 		mockUnlocker,
 		officeusercreator.NewOfficeUserFetcherPop(),
+		queueview.NewQueueViewFetcher(),
 	}
 
 	suite.Run("returns unfiltered results", func() {
@@ -943,6 +950,7 @@ func (suite *HandlerSuite) TestGetMoveQueuesHandlerUnauthorizedRole() {
 		order.NewOrderFetcher(waf),
 		mockUnlocker,
 		officeusercreator.NewOfficeUserFetcherPop(),
+		queueview.NewQueueViewFetcher(),
 	}
 
 	// Validate incoming payload: no body to validate
@@ -975,6 +983,7 @@ func (suite *HandlerSuite) TestGetMoveQueuesHandlerUnauthorizedUser() {
 		order.NewOrderFetcher(waf),
 		mockUnlocker,
 		officeusercreator.NewOfficeUserFetcherPop(),
+		queueview.NewQueueViewFetcher(),
 	}
 
 	// Validate incoming payload: no body to validate
@@ -1028,6 +1037,7 @@ func (suite *HandlerSuite) TestGetMoveQueuesHandlerEmptyResults() {
 		order.NewOrderFetcher(waf),
 		mockUnlocker,
 		officeusercreator.NewOfficeUserFetcherPop(),
+		queueview.NewQueueViewFetcher(),
 	}
 
 	// Validate incoming payload: no body to validate
@@ -1043,6 +1053,83 @@ func (suite *HandlerSuite) TestGetMoveQueuesHandlerEmptyResults() {
 	suite.Len(payload.QueueMoves, 0)
 }
 
+func (suite *HandlerSuite) TestGetMoveQueuesHandlerCursor() {
+	officeUser := factory.BuildOfficeUserWithRoles(suite.DB(), factory.GetTraitActiveOfficeUser(), []roles.RoleType{roles.RoleTypeTOO})
+
+	// Default Origin Duty Location GBLOC is KKFA
+	for i := 0; i < 3; i++ {
+		move := factory.BuildSubmittedMove(suite.DB(), nil, nil)
+		factory.BuildMTOShipment(suite.DB(), []factory.Customization{
+			{
+				Model:    move,
+				LinkOnly: true,
+			},
+			{
+				Model: models.MTOShipment{
+					Status: models.MTOShipmentStatusSubmitted,
+				},
+			},
+		}, nil)
+	}
+
+	request := httptest.NewRequest("GET", "/queues/moves", nil)
+	request = suite.AuthenticateOfficeRequest(request, officeUser)
+	handler := GetMovesQueueHandler{
+		suite.NewHandlerConfig(),
+		order.NewOrderFetcher(entitlements.NewWeightAllotmentFetcher()),
+		movelocker.NewMoveUnlocker(),
+		officeusercreator.NewOfficeUserFetcherPop(),
+		queueview.NewQueueViewFetcher(),
+	}
+
+	suite.Run("pages through the queue by cursor", func() {
+		params := queues.GetMovesQueueParams{
+			HTTPRequest: request,
+			Sort:        models.StringPointer("customerName"),
+			Order:       models.StringPointer("asc"),
+			PerPage:     models.Int64Pointer(1),
+			Cursor:      models.StringPointer(""),
+		}
+
+		seen := map[string]bool{}
+		var totalCount int64
+		for params.Cursor != nil {
+			response := handler.Handle(params)
+			suite.IsNotErrResponse(response)
+			suite.IsType(&queues.GetMovesQueueOK{}, response)
+			payload := response.(*queues.GetMovesQueueOK).Payload
+			suite.NoError(payload.Validate(strfmt.Default))
+			suite.LessOrEqual(len(payload.QueueMoves), 1)
+
+			for _, move := range payload.QueueMoves {
+				suite.False(seen[move.Locator], "move %s was returned on two pages", move.Locator)
+				seen[move.Locator] = true
+			}
+			totalCount = payload.TotalCount
+			params.Cursor = payload.NextCursor
+		}
+		suite.Equal(int64(3), totalCount)
+		suite.Len(seen, 3)
+	})
+
+	suite.Run("responds with bad request for a cursor made for another sort", func() {
+		params := queues.GetMovesQueueParams{
+			HTTPRequest: request,
+			Sort:        models.StringPointer("locator"),
+			PerPage:     models.Int64Pointer(1),
+			Cursor:      models.StringPointer(""),
+		}
+		response := handler.Handle(params)
+		suite.IsType(&queues.GetMovesQueueOK{}, response)
+		params.Cursor = response.(*queues.GetMovesQueueOK).Payload.NextCursor
+		suite.NotNil(params.Cursor)
+
+		params.Sort = models.StringPointer("customerName")
+		response = handler.Handle(params)
+		suite.IsType(&queues.GetMovesQueueBadRequest{}, response)
+	})
+}
+
 func (suite *HandlerSuite) TestGetPaymentRequestsQueueHandler() {
 	officeUser := factory.BuildOfficeUserWithRoles(suite.DB(), factory.GetTraitActiveOfficeUser(), []roles.RoleType{roles.RoleTypeTIO})
 	factory.BuildOfficeUserWithRoles(suite.DB(), factory.GetTraitActiveOfficeUser(), []roles.RoleType{roles.RoleTypeTOO})
@@ -1078,6 +1165,7 @@ func (suite *HandlerSuite) TestGetPaymentRequestsQueueHandler() {
 		paymentrequest.NewPaymentRequestListFetcher(),
 		mockUnlocker,
 		officeusercreator.NewOfficeUserFetcherPop(),
+		queueview.NewQueueViewFetcher(),
 	}
 
 	// Validate incoming payload: no body to validate
@@ -1112,6 +1200,66 @@ func (suite *HandlerSuite) TestGetPaymentRequestsQueueHandler() {
 	suite.Equal(*hhgMove.Orders.DepartmentIndicator, string(deptIndicator))
 }
 
+func (suite *HandlerSuite) TestGetPaymentRequestsQueueHandlerCursor() {
+	officeUser := factory.BuildOfficeUserWithRoles(suite.DB(), factory.GetTraitActiveOfficeUser(), []roles.RoleType{roles.RoleTypeTIO})
+
+	// Default Origin Duty Location GBLOC is KKFA
+	for i := 0; i < 3; i++ {
+		factory.BuildPaymentRequest(suite.DB(), nil, nil)
+	}
+
+	request := httptest.NewRequest("GET", "/queues/payment-requests", nil)
+	request = suite.AuthenticateOfficeRequest(request, officeUser)
+	handler := GetPaymentRequestsQueueHandler{
+		suite.NewHandlerConfig(),
+		paymentrequest.NewPaymentRequestListFetcher(),
+		movelocker.NewMoveUnlocker(),
+		officeusercreator.NewOfficeUserFetcherPop(),
+		queueview.NewQueueViewFetcher(),
+	}
+
+	suite.Run("pages through the queue by cursor", func() {
+		params := queues.GetPaymentRequestsQueueParams{
+			HTTPRequest: request,
+			Sort:        models.StringPointer("age"),
+			Order:       models.StringPointer("desc"),
+			PerPage:     models.Int64Pointer(1),
+			Cursor:      models.StringPointer(""),
+		}
+
+		seen := map[string]bool{}
+		var totalCount int64
+		for params.Cursor != nil {
+			response := handler.Handle(params)
+			suite.IsNotErrResponse(response)
+			suite.IsType(&queues.GetPaymentRequestsQueueOK{}, response)
+			payload := response.(*queues.GetPaymentRequestsQueueOK).Payload
+			suite.NoError(payload.Validate(strfmt.Default))
+			suite.LessOrEqual(len(payload.QueuePaymentRequests), 1)
+
+			for _, paymentRequest := range payload.QueuePaymentRequests {
+				id := paymentRequest.ID.String()
+				suite.False(seen[id], "payment request %s was returned on two pages", id)
+				seen[id] = true
+			}
+			totalCount = payload.TotalCount
+			params.Cursor = payload.NextCursor
+		}
+		suite.Equal(int64(3), totalCount)
+		suite.Len(seen, 3)
+	})
+
+	suite.Run("responds with bad request for a cursor it didn't make", func() {
+		params := queues.GetPaymentRequestsQueueParams{
+			HTTPRequest: request,
+			Cursor:      models.StringPointer("not a cursor!"),
+		}
+
+		response := handler.Handle(params)
+		suite.IsType(&queues.GetPaymentRequestsQueueBadRequest{}, response)
+	})
+}
+
 func (suite *HandlerSuite) TestGetPaymentRequestsQueueSubmittedAtFilter() {
 	officeUser := factory.BuildOfficeUserWithRoles(suite.DB(), nil, []roles.RoleType{roles.RoleTypeTIO})
 
@@ -1155,6 +1303,7 @@ func (suite *HandlerSuite) TestGetPaymentRequestsQueueSubmittedAtFilter() {
 		paymentrequest.NewPaymentRequestListFetcher(),
 		mockUnlocker,
 		officeusercreator.NewOfficeUserFetcherPop(),
+		queueview.NewQueueViewFetcher(),
 	}
 	suite.Run("returns unfiltered results", func() {
 		params := queues.GetPaymentRequestsQueueParams{
@@ -1235,6 +1384,7 @@ func (suite *HandlerSuite) TestGetPaymentRequestsQueueHandlerUnauthorizedRole()
 		paymentrequest.NewPaymentRequestListFetcher(),
 		mockUnlocker,
 		officeusercreator.NewOfficeUserFetcherPop(),
+		queueview.NewQueueViewFetcher(),
 	}
 
 	// Validate incoming payload: no body to validate
@@ -1271,6 +1421,7 @@ func (suite *HandlerSuite) TestGetPaymentRequestsQueueHandlerServerError() {
 		&paymentRequestListFetcher,
 		mockUnlocker,
 		officeusercreator.NewOfficeUserFetcherPop(),
+		queueview.NewQueueViewFetcher(),
 	}
 
 	// Validate incoming payload: no body to validate
@@ -1308,6 +1459,7 @@ func (suite *HandlerSuite) TestGetPaymentRequestsQueueHandlerEmptyResults() {
 		&paymentRequestListFetcher,
 		mockUnlocker,
 		officeusercreator.NewOfficeUserFetcherPop(),
+		queueview.NewQueueViewFetcher(),
 	}
 
 	// Validate incoming payload: no body to validate
@@ -1538,6 +1690,7 @@ func (suite *HandlerSuite) makeServicesCounselingSubtestData() (subtestData *ser
 		order.NewOrderFetcher(waf),
 		mockUnlocker,
 		officeusercreator.NewOfficeUserFetcherPop(),
+		queueview.NewQueueViewFetcher(),
 	}
 
 	return subtestData
@@ -1663,6 +1816,48 @@ func (suite *HandlerSuite) TestGetServicesCounselingQueueHandler() {
 		// Validate outgoing payload: nil payload
 		suite.Nil(payload)
 	})
+	suite.Run("pages through the queue by cursor", func() {
+		subtestData := suite.makeServicesCounselingSubtestData()
+
+		params := queues.GetServicesCounselingQueueParams{
+			HTTPRequest: subtestData.request,
+			Sort:        models.StringPointer("locator"),
+			Order:       models.StringPointer("asc"),
+			PerPage:     models.Int64Pointer(1),
+			Cursor:      models.StringPointer(""),
+		}
+
+		seen := map[string]bool{}
+		var totalCount int64
+		for pages := 0; params.Cursor != nil; pages++ {
+			response := subtestData.handler.Handle(params)
+			suite.IsNotErrResponse(response)
+			suite.IsType(&queues.GetServicesCounselingQueueOK{}, response)
+			payload := response.(*queues.GetServicesCounselingQueueOK).Payload
+			suite.NoError(payload.Validate(strfmt.Default))
+			suite.LessOrEqual(len(payload.QueueMoves), 1)
+
+			for _, move := range payload.QueueMoves {
+				suite.False(seen[move.Locator], "move %s was returned on two pages", move.Locator)
+				seen[move.Locator] = true
+			}
+			totalCount = payload.TotalCount
+			params.Cursor = payload.NextCursor
+		}
+		suite.Len(seen, int(totalCount))
+	})
+
+	suite.Run("responds with bad request for a cursor it didn't make", func() {
+		subtestData := suite.makeServicesCounselingSubtestData()
+
+		params := queues.GetServicesCounselingQueueParams{
+			HTTPRequest: subtestData.request,
+			Cursor:      models.StringPointer("not a cursor!"),
+		}
+
+		response := subtestData.handler.Handle(params)
+		suite.IsType(&queues.GetServicesCounselingQueueBadRequest{}, response)
+	})
 }
 
 func (suite *HandlerSuite) TestGetBulkAssignmentDataHandler() {
@@ -2339,6 +2534,7 @@ func (suite *HandlerSuite) TestAvailableOfficeUsers() {
 			order.NewOrderFetcher(waf),
 			mockUnlocker,
 			officeusercreator.NewOfficeUserFetcherPop(),
+			queueview.NewQueueViewFetcher(),
 		}
 
 		response := handler.Handle(params)
@@ -2383,6 +2579,7 @@ func (suite *HandlerSuite) TestAvailableOfficeUsers() {
 			order.NewOrderFetcher(waf),
 			mockUnlocker,
 			officeusercreator.NewOfficeUserFetcherPop(),
+			queueview.NewQueueViewFetcher(),
 		}
 
 		response := handler.Handle(params)
@@ -2420,6 +2617,7 @@ func (suite *HandlerSuite) TestAvailableOfficeUsers() {
 			paymentrequest.NewPaymentRequestListFetcher(),
 			mockUnlocker,
 			officeusercreator.NewOfficeUserFetcherPop(),
+			queueview.NewQueueViewFetcher(),
 		}
 
 		response := handler.Handle(params)
@@ -2824,6 +3022,7 @@ func (suite *HandlerSuite) TestGetDestinationRequestsQueuesHandler() {
 		order.NewOrderFetcher(waf),
 		mockUnlocker,
 		officeusercreator.NewOfficeUserFetcherPop(),
+		queueview.NewQueueViewFetcher(),
 	}
 
 	response := handler.Handle(params)
@@ -2961,6 +3160,7 @@ func (suite *HandlerSuite) TestGetDestinationRequestsQueueAssignedUser() {
 			order.NewOrderFetcher(waf),
 			mockUnlocker,
 			officeusercreator.NewOfficeUserFetcherPop(),
+			queueview.NewQueueViewFetcher(),
 		}
 
 		response := handler.Handle(params)
@@ -3095,6 +3295,7 @@ func (suite *HandlerSuite) TestGetDestinationRequestsQueueAssignedUser() {
 			order.NewOrderFetcher(waf),
 			mockUnlocker,
 			officeusercreator.NewOfficeUserFetcherPop(),
+			queueview.NewQueueViewFetcher(),
 		}
 
 		response := handler.Handle(params)
@@ -3105,3 +3306,87 @@ func (suite *HandlerSuite) TestGetDestinationRequestsQueueAssignedUser() {
 		suite.Len(payload.QueueMoves[0].AvailableOfficeUsers, 2)
 	})
 }
+
+func (suite *HandlerSuite) TestGetMoveQueuesSavedView() {
+	officeUser := factory.BuildOfficeUserWithRoles(suite.DB(), nil, []roles.RoleType{roles.RoleTypeTOO})
+	waf := entitlements.NewWeightAllotmentFetcher()
+
+	move := models.Move{
+		Status: models.MoveStatusSUBMITTED,
+	}
+	shipment := models.MTOShipment{
+		Status: models.MTOShipmentStatusSubmitted,
+	}
+
+	// Create an order where the service member has an ARMY affiliation (default)
+	factory.BuildMTOShipment(suite.DB(), []factory.Customization{
+		{
+			Model: move,
+		},
+		{
+			Model: shipment,
+		},
+	}, nil)
+
+	// Create an order where the service member has an AIR_FORCE affiliation
+	airForce := models.AffiliationAIRFORCE
+	factory.BuildMTOShipment(suite.DB(), []factory.Customization{
+		{
+			Model: shipment,
+		},
+		{
+			Model: move,
+		},
+		{
+			Model: models.ServiceMember{
+				Affiliation: &airForce,
+			},
+		},
+	}, nil)
+
+	saveView := func(queueType models.QueueType, name string) models.OfficeQueueView {
+		view := models.OfficeQueueView{
+			OfficeUserID: officeUser.ID,
+			QueueType:    queueType,
+			Name:         name,
+			Filters:      models.QueueViewFilters{Branch: models.StringPointer("AIR_FORCE")},
+		}
+		suite.MustCreate(&view)
+		return view
+	}
+
+	handler := GetMovesQueueHandler{
+		suite.NewHandlerConfig(),
+		order.NewOrderFetcher(waf),
+		movelocker.NewMoveUnlocker(),
+		officeusercreator.NewOfficeUserFetcherPop(),
+		queueview.NewQueueViewFetcher(),
+	}
+
+	suite.Run("applies the filters of the saved view", func() {
+		view := saveView(models.QueueTypeTaskOrder, "Air Force")
+		viewID := strfmt.UUID(view.ID.String())
+
+		request := suite.AuthenticateOfficeRequest(httptest.NewRequest("GET", "/queues/moves", nil), officeUser)
+		response := handler.Handle(queues.GetMovesQueueParams{
+			HTTPRequest: request,
+			ViewID:      &viewID,
+		})
+		suite.IsType(&queues.GetMovesQueueOK{}, response)
+		payload := response.(*queues.GetMovesQueueOK).Payload
+		suite.Len(payload.QueueMoves, 1)
+		suite.Equal("AIR_FORCE", payload.QueueMoves[0].Customer.Agency)
+	})
+
+	suite.Run("doesn't find a view saved for another queue", func() {
+		view := saveView(models.QueueTypePaymentRequest, "Payment requests")
+		viewID := strfmt.UUID(view.ID.String())
+
+		request := suite.AuthenticateOfficeRequest(httptest.NewRequest("GET", "/queues/moves", nil), officeUser)
+		response := handler.Handle(queues.GetMovesQueueParams{
+			HTTPRequest: request,
+			ViewID:      &viewID,
+		})
+		suite.IsType(&queues.GetMovesQueueNotFound{}, response)
+	})
+}
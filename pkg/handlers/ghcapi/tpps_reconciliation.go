@@ -0,0 +1,54 @@
+package ghcapi
+
+import (
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"go.uber.org/zap"
+
+	"github.com/transcom/mymove/pkg/appcontext"
+	"github.com/transcom/mymove/pkg/apperror"
+	tppsreconciliationop "github.com/transcom/mymove/pkg/gen/ghcapi/ghcoperations/tpps_reconciliation"
+	"github.com/transcom/mymove/pkg/handlers"
+	"github.com/transcom/mymove/pkg/handlers/ghcapi/internal/payloads"
+	"github.com/transcom/mymove/pkg/services"
+	"github.com/transcom/mymove/pkg/unit"
+)
+
+// GetTPPSReconciliationHandler compares TPPS payments with what was billed
+type GetTPPSReconciliationHandler struct {
+	handlers.HandlerConfig
+	services.TPPSReconciler
+}
+
+// Handle returns the TPPS reconciliation report for the dates
+func (h GetTPPSReconciliationHandler) Handle(params tppsreconciliationop.GetTPPSReconciliationParams) middleware.Responder {
+	return h.AuditableAppContextFromRequestWithErrors(params.HTTPRequest,
+		func(appCtx appcontext.AppContext) (middleware.Responder, error) {
+			if !appCtx.Session().IsOfficeUser() {
+				return tppsreconciliationop.NewGetTPPSReconciliationForbidden(), apperror.NewForbiddenError("only office users may reconcile TPPS payments")
+			}
+
+			reconciliationParams := services.TPPSReconciliationParams{
+				StartDate: time.Time(params.StartDate),
+				EndDate:   time.Time(params.EndDate),
+			}
+			if params.ToleranceCents != nil {
+				reconciliationParams.ToleranceCents = unit.Cents(*params.ToleranceCents)
+			}
+
+			report, err := h.ReconcileTPPSPayments(appCtx, reconciliationParams)
+			if err != nil {
+				appCtx.Logger().Error("GetTPPSReconciliationHandler error", zap.Error(err))
+				switch e := err.(type) {
+				case apperror.InvalidInputError:
+					payload := payloadForValidationError("Unable to reconcile TPPS payments", err.Error(), h.GetTraceIDFromRequest(params.HTTPRequest), e.ValidationErrors)
+					return tppsreconciliationop.NewGetTPPSReconciliationUnprocessableEntity().WithPayload(payload), err
+				default:
+					return tppsreconciliationop.NewGetTPPSReconciliationInternalServerError(), err
+				}
+			}
+
+			return tppsreconciliationop.NewGetTPPSReconciliationOK().WithPayload(payloads.TPPSReconciliationReport(report)), nil
+		})
+}
@@ -0,0 +1,112 @@
+package ghcapi
+
+import (
+	"errors"
+	"net/http/httptest"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/gobuffalo/validate/v3"
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/transcom/mymove/pkg/apperror"
+	"github.com/transcom/mymove/pkg/factory"
+	tppsreconciliationop "github.com/transcom/mymove/pkg/gen/ghcapi/ghcoperations/tpps_reconciliation"
+	"github.com/transcom/mymove/pkg/models/roles"
+	"github.com/transcom/mymove/pkg/services"
+	"github.com/transcom/mymove/pkg/services/mocks"
+	"github.com/transcom/mymove/pkg/unit"
+)
+
+func (suite *HandlerSuite) TestGetTPPSReconciliationHandler() {
+	startDate := time.Date(2025, time.February, 1, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2025, time.February, 28, 0, 0, 0, 0, time.UTC)
+
+	setupTestData := func() (*mocks.TPPSReconciler, GetTPPSReconciliationHandler, tppsreconciliationop.GetTPPSReconciliationParams) {
+		officeUser := factory.BuildOfficeUserWithRoles(suite.DB(), nil, []roles.RoleType{roles.RoleTypeTIO})
+		req := httptest.NewRequest("GET", "/tpps-reconciliation", nil)
+		req = suite.AuthenticateOfficeRequest(req, officeUser)
+
+		mockReconciler := &mocks.TPPSReconciler{}
+		handler := GetTPPSReconciliationHandler{
+			HandlerConfig:  suite.NewHandlerConfig(),
+			TPPSReconciler: mockReconciler,
+		}
+		return mockReconciler, handler, tppsreconciliationop.GetTPPSReconciliationParams{
+			HTTPRequest: req,
+			StartDate:   strfmt.Date(startDate),
+			EndDate:     strfmt.Date(endDate),
+		}
+	}
+
+	suite.Run("returns the reconciliation report", func() {
+		mockReconciler, handler, params := setupTestData()
+		tolerance := int64(50)
+		params.ToleranceCents = &tolerance
+
+		paymentRequestID := uuid.Must(uuid.NewV4())
+		paymentServiceItemID := uuid.Must(uuid.NewV4())
+		billed := unit.Cents(10000)
+		paid := unit.Cents(10500)
+		sellerPaidDate := time.Date(2025, time.February, 10, 0, 0, 0, 0, time.UTC)
+		mockReconciler.On("ReconcileTPPSPayments", mock.AnythingOfType("*appcontext.appContext"), services.TPPSReconciliationParams{
+			StartDate:      startDate,
+			EndDate:        endDate,
+			ToleranceCents: unit.Cents(tolerance),
+		}).Return(&services.TPPSReconciliationReport{
+			StartDate:        startDate,
+			EndDate:          endDate,
+			TotalBilledCents: billed,
+			TotalPaidCents:   paid,
+			Lines: []services.TPPSReconciliationLine{
+				{
+					PaymentRequestID:     paymentRequestID,
+					PaymentRequestNumber: "1234-5678-1",
+					PaymentServiceItemID: &paymentServiceItemID,
+					ReferenceID:          "1234-5678-a1b2c3d4",
+					ServiceCode:          "DLH",
+					BilledCents:          &billed,
+					PaidCents:            &paid,
+					VarianceCents:        paid - billed,
+					SellerPaidDate:       &sellerPaidDate,
+					Status:               services.TPPSReconciliationStatusOverpaid,
+				},
+			},
+		}, nil)
+
+		response := handler.Handle(params)
+		suite.IsType(&tppsreconciliationop.GetTPPSReconciliationOK{}, response)
+		payload := response.(*tppsreconciliationop.GetTPPSReconciliationOK).Payload
+		suite.NoError(payload.Validate(strfmt.Default))
+		suite.Equal(int64(10000), payload.TotalBilledCents)
+		suite.Equal(int64(10500), payload.TotalPaidCents)
+		suite.Len(payload.Lines, 1)
+		suite.Equal(strfmt.UUID(paymentRequestID.String()), payload.Lines[0].PaymentRequestID)
+		suite.Equal(strfmt.UUID(paymentServiceItemID.String()), *payload.Lines[0].PaymentServiceItemID)
+		suite.Equal("1234-5678-a1b2c3d4", payload.Lines[0].ReferenceID)
+		suite.Equal(int64(500), payload.Lines[0].VarianceCents)
+		suite.Equal("2025-02-10", payload.Lines[0].SellerPaidDate.String())
+		suite.Equal("OVERPAID", payload.Lines[0].Status)
+	})
+
+	suite.Run("invalid dates return an unprocessable entity", func() {
+		mockReconciler, handler, params := setupTestData()
+		verrs := validate.NewErrors()
+		verrs.Add("endDate", "end date must not be before the start date")
+		mockReconciler.On("ReconcileTPPSPayments", mock.AnythingOfType("*appcontext.appContext"), mock.Anything).
+			Return(nil, apperror.NewInvalidInputError(uuid.Nil, nil, verrs, "invalid TPPS reconciliation parameters"))
+
+		response := handler.Handle(params)
+		suite.IsType(&tppsreconciliationop.GetTPPSReconciliationUnprocessableEntity{}, response)
+	})
+
+	suite.Run("query failures return an internal server error", func() {
+		mockReconciler, handler, params := setupTestData()
+		mockReconciler.On("ReconcileTPPSPayments", mock.AnythingOfType("*appcontext.appContext"), mock.Anything).
+			Return(nil, apperror.NewQueryError("TPPSPaidInvoiceReportEntry", errors.New("DB failure"), ""))
+
+		response := handler.Handle(params)
+		suite.IsType(&tppsreconciliationop.GetTPPSReconciliationInternalServerError{}, response)
+	})
+}
@@ -26,7 +26,7 @@ import (
 	"github.com/transcom/mymove/pkg/handlers"
 	"github.com/transcom/mymove/pkg/handlers/ghcapi/internal/payloads"
 	"github.com/transcom/mymove/pkg/models"
-	"github.com/transcom/mymove/pkg/notifications"
+	"github.com/transcom/mymove/pkg/pubsub"
 	"github.com/transcom/mymove/pkg/services"
 	"github.com/transcom/mymove/pkg/services/ppmshipment"
 	"github.com/transcom/mymove/pkg/services/upload"
@@ -185,11 +185,19 @@ type GetUploadStatusHandler struct {
 	services.UploadInformationFetcher
 }
 
+const (
+	// uploadStatusStreamTimeout is how long a client is sent status changes for
+	uploadStatusStreamTimeout = 120 * time.Second
+	// uploadStatusRecheckInterval bounds the delay caused by a missed notification
+	uploadStatusRecheckInterval = 5 * time.Second
+)
+
 type CustomGetUploadStatusResponse struct {
 	params     uploadop.GetUploadStatusParams
 	storageKey string
+	uploadID   uuid.UUID
 	appCtx     appcontext.AppContext
-	receiver   notifications.NotificationReceiver
+	pubSub     pubsub.PubSub
 	storer     storage.FileStorer
 }
 
@@ -203,126 +211,65 @@ func (o *CustomGetUploadStatusResponse) writeEventStreamMessage(rw http.Response
 	}
 }
 
-func (o *CustomGetUploadStatusResponse) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
-
-	// Check current tag before event-driven wait for anti-virus
-	tags, err := o.storer.Tags(o.storageKey)
-	var uploadStatus models.AVStatusType
+// uploadStatus reads the current anti-virus status of the upload
+func (o *CustomGetUploadStatusResponse) uploadStatus() models.AVStatusType {
+	upload, err := models.FetchUpload(o.appCtx.DB(), o.uploadID)
 	if err != nil {
-		uploadStatus = models.AVStatusPROCESSING
-	} else {
-		uploadStatus = models.GetAVStatusFromTags(tags)
+		o.appCtx.Logger().Error("could not fetch upload status", zap.Error(err))
+		return models.AVStatusPROCESSING
 	}
+	return uploaderpkg.AVStatus(o.storer, *upload)
+}
 
+// WriteResponse streams the status of the upload until anti-virus finishes with it. The
+// status is checked again whenever a change is published for the stored object, and
+// periodically in case a notification is missed.
+func (o *CustomGetUploadStatusResponse) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
 	// Limitation: once the status code header has been written (first response), we are not able to update the status for subsequent responses.
 	// Standard 200 OK used with common SSE paradigm
 	rw.WriteHeader(http.StatusOK)
-	if uploadStatus == models.AVStatusCLEAN || uploadStatus == models.AVStatusINFECTED {
-		o.writeEventStreamMessage(rw, producer, 0, "message", string(uploadStatus))
-		o.writeEventStreamMessage(rw, producer, 1, "close", "Connection closed")
-		return // skip notification loop since object already tagged from anti-virus
-	} else {
-		o.writeEventStreamMessage(rw, producer, 0, "message", string(uploadStatus))
-	}
-
-	// Start waiting for tag updates
-	topicName, err := o.receiver.GetDefaultTopic()
-	if err != nil {
-		o.appCtx.Logger().Error(err.Error())
-	}
-
-	filterPolicy := fmt.Sprintf(`{
-		"detail": {
-				"object": {
-					"key": [
-						{"suffix": "%s"}
-					]
-				}
-			}
-	}`, o.params.UploadID)
 
-	notificationParams := notifications.NotificationQueueParams{
-		SubscriptionTopicName: topicName,
-		NamePrefix:            notifications.QueuePrefixObjectTagsAdded,
-		FilterPolicy:          filterPolicy,
-	}
+	ctx, cancel := context.WithTimeout(o.params.HTTPRequest.Context(), uploadStatusStreamTimeout)
+	defer cancel()
 
-	queueUrl, err := o.receiver.CreateQueueWithSubscription(o.appCtx, notificationParams)
-	if err != nil {
-		o.appCtx.Logger().Error(err.Error())
-	}
-
-	id_counter := 1
-
-	// For loop over 120 seconds, cancel context when done and it breaks the loop
-	totalReceiverContext, totalReceiverContextCancelFunc := context.WithTimeout(context.Background(), 120*time.Second)
+	idCounter := 0
 	defer func() {
-		id_counter++
-		o.writeEventStreamMessage(rw, producer, id_counter, "close", "Connection closed")
-		totalReceiverContextCancelFunc()
+		o.writeEventStreamMessage(rw, producer, idCounter, "close", "Connection closed")
 	}()
 
-	// Cleanup if client closes connection
-	go func() {
-		<-o.params.HTTPRequest.Context().Done()
-		totalReceiverContextCancelFunc()
-	}()
+	// Subscribe before the first check so a change made in between is not missed
+	var changes <-chan pubsub.Message
+	subscription, err := o.pubSub.Subscribe(ctx, pubsub.UploadStatusTopic(o.storageKey))
+	if err != nil {
+		o.appCtx.Logger().Error("could not subscribe to upload status", zap.Error(err))
+	} else {
+		defer subscription.Close()
+		changes = subscription.Messages()
+	}
 
-	// Cleanup at end of work
-	go func() {
-		<-totalReceiverContext.Done()
-		_ = o.receiver.CloseoutQueue(o.appCtx, queueUrl)
-	}()
+	recheck := time.NewTicker(uploadStatusRecheckInterval)
+	defer recheck.Stop()
 
+	var lastStatus models.AVStatusType
 	for {
-		o.appCtx.Logger().Info("Receiving Messages...")
-		messages, errs := o.receiver.ReceiveMessages(o.appCtx, queueUrl, totalReceiverContext)
-
-		if errors.Is(errs, context.Canceled) || errors.Is(errs, context.DeadlineExceeded) {
-			return
+		uploadStatus := o.uploadStatus()
+		if uploadStatus != lastStatus {
+			o.writeEventStreamMessage(rw, producer, idCounter, "message", string(uploadStatus))
+			idCounter++
+			lastStatus = uploadStatus
 		}
-		if errs != nil {
-			o.appCtx.Logger().Error(err.Error())
+		if uploadStatus == models.AVStatusCLEAN || uploadStatus == models.AVStatusINFECTED {
 			return
 		}
 
-		if len(messages) != 0 {
-			errTransaction := o.appCtx.NewTransaction(func(txnAppCtx appcontext.AppContext) error {
-
-				tags, err := o.storer.Tags(o.storageKey)
-
-				if err != nil {
-					uploadStatus = models.AVStatusPROCESSING
-				} else {
-					uploadStatus = models.GetAVStatusFromTags(tags)
-				}
-
-				o.writeEventStreamMessage(rw, producer, id_counter, "message", string(uploadStatus))
-
-				if uploadStatus == models.AVStatusCLEAN || uploadStatus == models.AVStatusINFECTED {
-					return errors.New("connection_closed")
-				}
-
-				return err
-			})
-
-			if errTransaction != nil && errTransaction.Error() == "connection_closed" {
-				return
-			}
-
-			if errTransaction != nil {
-				o.appCtx.Logger().Error(err.Error())
-				return
-			}
-		}
-		id_counter++
-
 		select {
-		case <-totalReceiverContext.Done():
+		case <-ctx.Done():
 			return
-		default:
-			time.Sleep(1 * time.Second) // Throttle as a precaution against hounding of the SDK
-			continue
+		case _, ok := <-changes:
+			if !ok {
+				changes = nil
+			}
+		case <-recheck.C:
 		}
 	}
 }
@@ -358,8 +305,9 @@ func (h GetUploadStatusHandler) Handle(params uploadop.GetUploadStatusParams) mi
 			return &CustomGetUploadStatusResponse{
 				params:     params,
 				storageKey: uploaded.Upload.StorageKey,
+				uploadID:   uploaded.Upload.ID,
 				appCtx:     h.AppContextFromRequest(params.HTTPRequest),
-				receiver:   h.NotificationReceiver(),
+				pubSub:     h.PubSub(),
 				storer:     h.FileStorer(),
 			}, nil
 		})
@@ -1,11 +1,15 @@
 package ghcapi
 
 import (
+	"context"
 	"fmt"
 	"net/http"
+	"net/http/httptest"
 	"net/url"
 	"strconv"
+	"time"
 
+	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/go-openapi/strfmt"
 	"github.com/gofrs/uuid"
@@ -17,8 +21,8 @@ import (
 	"github.com/transcom/mymove/pkg/handlers"
 	"github.com/transcom/mymove/pkg/models"
 	"github.com/transcom/mymove/pkg/models/roles"
-	"github.com/transcom/mymove/pkg/notifications"
 	paperworkgenerator "github.com/transcom/mymove/pkg/paperwork"
+	"github.com/transcom/mymove/pkg/pubsub"
 	"github.com/transcom/mymove/pkg/services/upload"
 	weightticketparser "github.com/transcom/mymove/pkg/services/weight_ticket_parser"
 	storageTest "github.com/transcom/mymove/pkg/storage/test"
@@ -261,7 +265,6 @@ func (suite *HandlerSuite) TestCreateUploadsHandlerFailure() {
 
 func (suite *HandlerSuite) TestGetUploadStatusHandlerSuccess() {
 	fakeS3 := storageTest.NewFakeS3Storage(true)
-	localReceiver := notifications.StubNotificationReceiver{}
 
 	orders := factory.BuildOrder(suite.DB(), nil, nil)
 	uploadUser1 := factory.BuildUserUpload(suite.DB(), []factory.Customization{
@@ -291,7 +294,7 @@ func (suite *HandlerSuite) TestGetUploadStatusHandlerSuccess() {
 
 	handlerConfig := suite.NewHandlerConfig()
 	handlerConfig.SetFileStorer(fakeS3)
-	handlerConfig.SetNotificationReceiver(localReceiver)
+	handlerConfig.SetPubSub(pubsub.NewMemoryPubSub())
 	uploadInformationFetcher := upload.NewUploadInformationFetcher()
 	handler := GetUploadStatusHandler{handlerConfig, uploadInformationFetcher}
 
@@ -304,6 +307,68 @@ func (suite *HandlerSuite) TestGetUploadStatusHandlerSuccess() {
 	suite.NoError(err)
 }
 
+func (suite *HandlerSuite) TestGetUploadStatusHandlerStreamsPublishedStatus() {
+	// The first status check finds no tags and later checks find the upload clean
+	fakeS3 := storageTest.NewFakeS3Storage(true)
+	fakeS3.EmptyTags = true
+	pubSub := pubsub.NewMemoryPubSub()
+
+	orders := factory.BuildOrder(suite.DB(), nil, nil)
+	uploadUser1 := factory.BuildUserUpload(suite.DB(), []factory.Customization{
+		{
+			Model:    orders.UploadedOrders,
+			LinkOnly: true,
+		},
+		{
+			Model: models.Upload{
+				Filename:    "FileName",
+				Bytes:       int64(15),
+				ContentType: uploader.FileTypePDF,
+			},
+		},
+	}, nil)
+
+	params := uploadop.NewGetUploadStatusParams()
+	params.UploadID = strfmt.UUID(uploadUser1.Upload.ID.String())
+
+	req := &http.Request{}
+	req = suite.AuthenticateRequest(req, uploadUser1.Document.ServiceMember)
+	params.HTTPRequest = req
+
+	handlerConfig := suite.NewHandlerConfig()
+	handlerConfig.SetFileStorer(fakeS3)
+	handlerConfig.SetPubSub(pubSub)
+	uploadInformationFetcher := upload.NewUploadInformationFetcher()
+	handler := GetUploadStatusHandler{handlerConfig, uploadInformationFetcher}
+
+	response := handler.Handle(params)
+	statusResponse, ok := response.(*CustomGetUploadStatusResponse)
+	suite.True(ok)
+
+	rr := httptest.NewRecorder()
+	done := make(chan struct{})
+	go func() {
+		statusResponse.WriteResponse(rr, runtime.ByteStreamProducer())
+		close(done)
+	}()
+
+	// Keep publishing until the stream ends, since it may not have subscribed yet
+	suite.Eventually(func() bool {
+		suite.NoError(pubSub.Publish(context.Background(), pubsub.UploadStatusTopic(uploadUser1.Upload.StorageKey), nil))
+		select {
+		case <-done:
+			return true
+		default:
+			return false
+		}
+	}, 3*time.Second, 50*time.Millisecond)
+
+	body := rr.Body.String()
+	suite.Contains(body, "id: 0\nevent: message\ndata: PROCESSING")
+	suite.Contains(body, "id: 1\nevent: message\ndata: CLEAN")
+	suite.Contains(body, "id: 2\nevent: close")
+}
+
 func (suite *HandlerSuite) TestGetUploadStatusHandlerFailure() {
 	suite.Run("Error on no match for uploadId", func() {
 		orders := factory.BuildOrder(suite.DB(), factory.GetTraitActiveServiceMemberUser(), nil)
@@ -318,11 +383,10 @@ func (suite *HandlerSuite) TestGetUploadStatusHandlerFailure() {
 		params.HTTPRequest = req
 
 		fakeS3 := storageTest.NewFakeS3Storage(true)
-		localReceiver := notifications.StubNotificationReceiver{}
 
 		handlerConfig := suite.NewHandlerConfig()
 		handlerConfig.SetFileStorer(fakeS3)
-		handlerConfig.SetNotificationReceiver(localReceiver)
+		handlerConfig.SetPubSub(pubsub.NewMemoryPubSub())
 		uploadInformationFetcher := upload.NewUploadInformationFetcher()
 		handler := GetUploadStatusHandler{handlerConfig, uploadInformationFetcher}
 
@@ -337,7 +401,6 @@ func (suite *HandlerSuite) TestGetUploadStatusHandlerFailure() {
 
 	suite.Run("Error when attempting access to another service member's upload", func() {
 		fakeS3 := storageTest.NewFakeS3Storage(true)
-		localReceiver := notifications.StubNotificationReceiver{}
 
 		otherServiceMember := factory.BuildServiceMember(suite.DB(), nil, nil)
 
@@ -369,7 +432,7 @@ func (suite *HandlerSuite) TestGetUploadStatusHandlerFailure() {
 
 		handlerConfig := suite.NewHandlerConfig()
 		handlerConfig.SetFileStorer(fakeS3)
-		handlerConfig.SetNotificationReceiver(localReceiver)
+		handlerConfig.SetPubSub(pubsub.NewMemoryPubSub())
 		uploadInformationFetcher := upload.NewUploadInformationFetcher()
 		handler := GetUploadStatusHandler{handlerConfig, uploadInformationFetcher}
 
@@ -20,6 +20,7 @@ import (
 	"github.com/transcom/mymove/pkg/services/entitlements"
 	"github.com/transcom/mymove/pkg/services/fetch"
 	"github.com/transcom/mymove/pkg/services/ghcrateengine"
+	identityverification "github.com/transcom/mymove/pkg/services/identity_verification"
 	mobilehomeshipment "github.com/transcom/mymove/pkg/services/mobile_home_shipment"
 	move "github.com/transcom/mymove/pkg/services/move"
 	movetaskorder "github.com/transcom/mymove/pkg/services/move_task_order"
@@ -111,7 +112,10 @@ func NewInternalAPI(handlerConfig handlers.HandlerConfig) *internalops.MymoveAPI
 	if err != nil {
 		log.Fatalln(err)
 	}
-	internalAPI.RegistrationCustomerRegistrationHandler = CustomerRegistrationHandler{handlerConfig}
+	internalAPI.RegistrationCustomerRegistrationHandler = CustomerRegistrationHandler{
+		handlerConfig,
+		identityverification.NewIdentityVerifier(handlerConfig.IWSPersonLookup()),
+	}
 	internalAPI.FeatureFlagsBooleanFeatureFlagUnauthenticatedHandler = BooleanFeatureFlagsUnauthenticatedHandler{handlerConfig}
 	internalAPI.FeatureFlagsBooleanFeatureFlagForUserHandler = BooleanFeatureFlagsForUserHandler{handlerConfig}
 	internalAPI.FeatureFlagsVariantFeatureFlagForUserHandler = VariantFeatureFlagsForUserHandler{handlerConfig}
@@ -167,6 +171,10 @@ func NewInternalAPI(handlerConfig handlers.HandlerConfig) *internalops.MymoveAPI
 	internalAPI.ServiceMembersPatchServiceMemberHandler = PatchServiceMemberHandler{handlerConfig}
 	internalAPI.ServiceMembersShowServiceMemberHandler = ShowServiceMemberHandler{handlerConfig}
 	internalAPI.ServiceMembersShowServiceMemberOrdersHandler = ShowServiceMemberOrdersHandler{handlerConfig}
+	internalAPI.ServiceMembersShowServiceMemberIdentityVerificationHandler = ShowServiceMemberIdentityVerificationHandler{
+		handlerConfig,
+		identityverification.NewIdentityVerifier(handlerConfig.IWSPersonLookup()),
+	}
 
 	internalAPI.BackupContactsIndexServiceMemberBackupContactsHandler = IndexBackupContactsHandler{handlerConfig}
 	internalAPI.BackupContactsCreateServiceMemberBackupContactHandler = CreateBackupContactHandler{handlerConfig}
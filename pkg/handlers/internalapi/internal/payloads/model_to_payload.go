@@ -13,6 +13,7 @@ import (
 	"github.com/transcom/mymove/pkg/handlers"
 	"github.com/transcom/mymove/pkg/models"
 	"github.com/transcom/mymove/pkg/storage"
+	"github.com/transcom/mymove/pkg/uploader"
 )
 
 // Country payload
@@ -455,12 +456,7 @@ func PayloadForUploadModel(
 		UpdatedAt:   strfmt.DateTime(upload.UpdatedAt),
 	}
 
-	tags, err := storer.Tags(upload.StorageKey)
-	if err != nil {
-		uploadPayload.Status = string(models.AVStatusPROCESSING)
-	} else {
-		uploadPayload.Status = string(models.GetAVStatusFromTags(tags))
-	}
+	uploadPayload.Status = string(uploader.AVStatus(storer, upload))
 
 	return uploadPayload
 }
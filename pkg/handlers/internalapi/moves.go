@@ -598,11 +598,6 @@ func payloadForUploadModelFromAdditionalDocumentsUpload(storer storage.FileStore
 		CreatedAt:   strfmt.DateTime(upload.CreatedAt),
 		UpdatedAt:   strfmt.DateTime(upload.UpdatedAt),
 	}
-	tags, err := storer.Tags(upload.StorageKey)
-	if err != nil {
-		uploadPayload.Status = string(models.AVStatusPROCESSING)
-	} else {
-		uploadPayload.Status = string(models.GetAVStatusFromTags(tags))
-	}
+	uploadPayload.Status = string(uploader.AVStatus(storer, upload))
 	return uploadPayload, nil
 }
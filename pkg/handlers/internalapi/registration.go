@@ -13,15 +13,18 @@ import (
 	"github.com/transcom/mymove/pkg/apperror"
 	"github.com/transcom/mymove/pkg/cli"
 	registrationop "github.com/transcom/mymove/pkg/gen/internalapi/internaloperations/registration"
+	"github.com/transcom/mymove/pkg/gen/internalmessages"
 	"github.com/transcom/mymove/pkg/handlers"
 	"github.com/transcom/mymove/pkg/handlers/authentication/okta"
 	"github.com/transcom/mymove/pkg/handlers/internalapi/internal/payloads"
 	"github.com/transcom/mymove/pkg/models"
+	"github.com/transcom/mymove/pkg/services"
 )
 
 // CustomerRegistrationHandler creates a MilMove and Okta profile allowing for self registration of service members
 type CustomerRegistrationHandler struct {
 	handlers.HandlerConfig
+	services.IdentityVerifier
 }
 
 func (h CustomerRegistrationHandler) Handle(params registrationop.CustomerRegistrationParams) middleware.Responder {
@@ -32,6 +35,23 @@ func (h CustomerRegistrationHandler) Handle(params registrationop.CustomerRegist
 				return registrationop.NewCustomerRegistrationUnprocessableEntity(), apperror.NewSessionError("Request is not from the customer app")
 			}
 
+			// when identity verification is on, the DoD ID and last name have to match DEERS before we
+			// create anything. The response never includes the DEERS record since this endpoint is unauthenticated.
+			identityFeatureFlagName := "identity_verification"
+			identityFlag, err := h.FeatureFlagFetcher().GetBooleanFlag(params.HTTPRequest.Context(), appCtx.Logger(), "customer", identityFeatureFlagName, map[string]string{})
+			if err != nil {
+				appCtx.Logger().Error("Error fetching identity_verification feature flag", zap.String("featureFlagKey", identityFeatureFlagName), zap.Error(err))
+			} else if identityFlag.Match {
+				if verifyErr := verifyRegistrationIdentity(appCtx, h.IdentityVerifier, params.Registration); verifyErr != nil {
+					errPayload := payloads.ValidationError(
+						verifyErr.Error(),
+						h.GetTraceIDFromRequest(params.HTTPRequest),
+						nil,
+					)
+					return registrationop.NewCustomerRegistrationUnprocessableEntity().WithPayload(errPayload), verifyErr
+				}
+			}
+
 			oktaUser, oktaErr := fetchOrCreateOktaProfile(appCtx, params)
 			if oktaErr != nil || oktaUser == nil {
 				appCtx.Logger().Error("error creating okta profile", zap.Error(oktaErr))
@@ -149,6 +169,41 @@ func getCustomerGroupID() (apiKey, customerGroupID string) {
 	return v.GetString(cli.OktaAPIKeyFlag), v.GetString(cli.OktaCustomerGroupIDFlag)
 }
 
+// errRegistrationIdentityNotVerified is returned for every identity verification failure, so the unauthenticated
+// registration endpoint doesn't reveal whether a DoD ID is in DEERS or which detail didn't match
+var errRegistrationIdentityNotVerified = apperror.NewBadDataError("we couldn't verify your identity with the DoD ID and name provided, please check them and try again")
+
+// verifyRegistrationIdentity checks the DoD ID and last name being registered against DEERS. A first name
+// that doesn't match doesn't block registration since nicknames and middle names are common, the service
+// member can review it against DEERS once they're signed in.
+func verifyRegistrationIdentity(appCtx appcontext.AppContext, verifier services.IdentityVerifier, payload *internalmessages.CreateOktaAndMilMoveUser) error {
+	if payload.Edipi == nil {
+		return errRegistrationIdentityNotVerified
+	}
+
+	verification, err := verifier.VerifyIdentity(appCtx, services.IdentityVerificationParams{
+		Edipi:     *payload.Edipi,
+		FirstName: payload.FirstName,
+		LastName:  payload.LastName,
+	})
+	if err != nil {
+		appCtx.Logger().Error("error verifying registration identity", zap.Error(err))
+		return errRegistrationIdentityNotVerified
+	}
+
+	if !verification.EdipiFound {
+		appCtx.Logger().Info("registration DoD ID not found in DEERS")
+		return errRegistrationIdentityNotVerified
+	}
+	for _, mismatch := range verification.Mismatches {
+		if mismatch == services.IdentityMismatchLastName {
+			appCtx.Logger().Info("registration last name doesn't match DEERS")
+			return errRegistrationIdentityNotVerified
+		}
+	}
+	return nil
+}
+
 // fetchOrCreateOktaProfile send some requests to the Okta Users API
 // handles seeing if an okta user already exists with the form data, if not - it will then create one
 // this creates a user in Okta assigned to the customer group (allowing access to the customer application)
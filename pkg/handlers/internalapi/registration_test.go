@@ -7,6 +7,7 @@ import (
 
 	"github.com/jarcoal/httpmock"
 	"github.com/markbates/goth"
+	"github.com/stretchr/testify/mock"
 
 	"github.com/transcom/mymove/pkg/auth"
 	"github.com/transcom/mymove/pkg/factory"
@@ -14,7 +15,11 @@ import (
 	"github.com/transcom/mymove/pkg/gen/internalmessages"
 	"github.com/transcom/mymove/pkg/handlers"
 	"github.com/transcom/mymove/pkg/handlers/authentication/okta"
+	"github.com/transcom/mymove/pkg/iws"
 	"github.com/transcom/mymove/pkg/models"
+	"github.com/transcom/mymove/pkg/services"
+	identityverification "github.com/transcom/mymove/pkg/services/identity_verification"
+	"github.com/transcom/mymove/pkg/services/mocks"
 )
 
 const milProviderName = "milProvider"
@@ -63,6 +68,7 @@ func (suite *HandlerSuite) TestCustomerRegistrationHandler() {
 		handlerConfig := suite.NewHandlerConfig()
 		handler := CustomerRegistrationHandler{
 			handlerConfig,
+			&mocks.IdentityVerifier{},
 		}
 
 		response := handler.Handle(params)
@@ -122,6 +128,7 @@ func (suite *HandlerSuite) TestCustomerRegistrationHandler() {
 		handlerConfig := suite.NewHandlerConfig()
 		handler := CustomerRegistrationHandler{
 			handlerConfig,
+			&mocks.IdentityVerifier{},
 		}
 
 		response := handler.Handle(params)
@@ -165,7 +172,7 @@ func (suite *HandlerSuite) TestCustomerRegistrationHandler() {
 			Registration: body,
 		}
 		handlerConfig := suite.NewHandlerConfig()
-		handler := CustomerRegistrationHandler{handlerConfig}
+		handler := CustomerRegistrationHandler{handlerConfig, &mocks.IdentityVerifier{}}
 
 		response := handler.Handle(params)
 		suite.IsNotErrResponse(response)
@@ -202,7 +209,7 @@ func (suite *HandlerSuite) TestCustomerRegistrationHandler() {
 			Registration: body,
 		}
 		handlerConfig := suite.NewHandlerConfig()
-		handler := CustomerRegistrationHandler{handlerConfig}
+		handler := CustomerRegistrationHandler{handlerConfig, &mocks.IdentityVerifier{}}
 
 		response := handler.Handle(params)
 		suite.IsNotErrResponse(response)
@@ -243,7 +250,7 @@ func (suite *HandlerSuite) TestCustomerRegistrationHandler() {
 			Registration: body,
 		}
 		handlerConfig := suite.NewHandlerConfig()
-		handler := CustomerRegistrationHandler{handlerConfig}
+		handler := CustomerRegistrationHandler{handlerConfig, &mocks.IdentityVerifier{}}
 
 		response := handler.Handle(params)
 		suite.IsNotErrResponse(response)
@@ -284,7 +291,7 @@ func (suite *HandlerSuite) TestCustomerRegistrationHandler() {
 			Registration: body,
 		}
 		handlerConfig := suite.NewHandlerConfig()
-		handler := CustomerRegistrationHandler{handlerConfig}
+		handler := CustomerRegistrationHandler{handlerConfig, &mocks.IdentityVerifier{}}
 
 		response := handler.Handle(params)
 		suite.IsNotErrResponse(response)
@@ -325,7 +332,7 @@ func (suite *HandlerSuite) TestCustomerRegistrationHandler() {
 			Registration: body,
 		}
 		handlerConfig := suite.NewHandlerConfig()
-		handler := CustomerRegistrationHandler{handlerConfig}
+		handler := CustomerRegistrationHandler{handlerConfig, &mocks.IdentityVerifier{}}
 
 		response := handler.Handle(params)
 		suite.IsNotErrResponse(response)
@@ -366,7 +373,7 @@ func (suite *HandlerSuite) TestCustomerRegistrationHandler() {
 			Registration: body,
 		}
 		handlerConfig := suite.NewHandlerConfig()
-		handler := CustomerRegistrationHandler{handlerConfig}
+		handler := CustomerRegistrationHandler{handlerConfig, &mocks.IdentityVerifier{}}
 
 		response := handler.Handle(params)
 		suite.IsNotErrResponse(response)
@@ -410,7 +417,7 @@ func (suite *HandlerSuite) TestCustomerRegistrationHandler() {
 			Registration: body,
 		}
 		handlerConfig := suite.NewHandlerConfig()
-		handler := CustomerRegistrationHandler{handlerConfig}
+		handler := CustomerRegistrationHandler{handlerConfig, &mocks.IdentityVerifier{}}
 
 		response := handler.Handle(params)
 		suite.IsNotErrResponse(response)
@@ -428,7 +435,7 @@ func (suite *HandlerSuite) TestCustomerRegistrationHandler() {
 		}
 
 		handlerConfig := suite.NewHandlerConfig()
-		handler := CustomerRegistrationHandler{handlerConfig}
+		handler := CustomerRegistrationHandler{handlerConfig, &mocks.IdentityVerifier{}}
 
 		response := handler.Handle(params)
 		suite.IsNotErrResponse(response)
@@ -463,12 +470,90 @@ func (suite *HandlerSuite) TestCustomerRegistrationHandler() {
 			Registration: body,
 		}
 		handlerConfig := suite.NewHandlerConfig()
-		handler := CustomerRegistrationHandler{handlerConfig}
+		handler := CustomerRegistrationHandler{handlerConfig, &mocks.IdentityVerifier{}}
 
 		response := handler.Handle(params)
 		suite.IsNotErrResponse(response)
 		suite.Assertions.IsType(&registrationop.CustomerRegistrationUnprocessableEntity{}, response)
 	})
+
+	suite.Run("Fail when last name doesn't match DEERS and identity_verification flag is on", func() {
+		os.Setenv("FEATURE_FLAG_IDENTITY_VERIFICATION", "true")
+		defer os.Unsetenv("FEATURE_FLAG_IDENTITY_VERIFICATION")
+
+		body := &internalmessages.CreateOktaAndMilMoveUser{
+			FirstName:        "Testy",
+			LastName:         "Smith",
+			Telephone:        "555-555-5555",
+			Affiliation:      &affiliation,
+			Edipi:            models.StringPointer("1234567890"),
+			Email:            "testy@example.com",
+			PhoneIsPreferred: false,
+			EmailIsPreferred: true,
+		}
+
+		request := httptest.NewRequest("POST", "/open/register", nil)
+		session := &auth.Session{
+			ApplicationName: auth.MilApp,
+		}
+		ctx := auth.SetSessionInRequestContext(request, session)
+		params := registrationop.CustomerRegistrationParams{
+			HTTPRequest:  request.WithContext(ctx),
+			Registration: body,
+		}
+		handlerConfig := suite.NewHandlerConfig()
+		handler := CustomerRegistrationHandler{
+			handlerConfig,
+			identityverification.NewIdentityVerifier(iws.TestingPersonLookup{}),
+		}
+
+		// no Okta endpoints are mocked, the request has to be rejected before Okta is called
+		response := handler.Handle(params)
+		suite.IsNotErrResponse(response)
+		suite.Assertions.IsType(&registrationop.CustomerRegistrationUnprocessableEntity{}, response)
+		errPayload := response.(*registrationop.CustomerRegistrationUnprocessableEntity).Payload
+		suite.Contains(*errPayload.Detail, "couldn't verify your identity")
+		suite.NotContains(*errPayload.Detail, "McTestface")
+	})
+
+	suite.Run("DoD IDs not in DEERS fail with the same error as a mismatched last name", func() {
+		os.Setenv("FEATURE_FLAG_IDENTITY_VERIFICATION", "true")
+		defer os.Unsetenv("FEATURE_FLAG_IDENTITY_VERIFICATION")
+
+		registerWith := func(verification services.IdentityVerification) *registrationop.CustomerRegistrationUnprocessableEntity {
+			body := &internalmessages.CreateOktaAndMilMoveUser{
+				FirstName:   "Testy",
+				LastName:    "Smith",
+				Telephone:   "555-555-5555",
+				Affiliation: &affiliation,
+				Edipi:       models.StringPointer("1234567890"),
+				Email:       "testy@example.com",
+			}
+			request := httptest.NewRequest("POST", "/open/register", nil)
+			ctx := auth.SetSessionInRequestContext(request, &auth.Session{ApplicationName: auth.MilApp})
+			params := registrationop.CustomerRegistrationParams{
+				HTTPRequest:  request.WithContext(ctx),
+				Registration: body,
+			}
+
+			verifier := &mocks.IdentityVerifier{}
+			verifier.On("VerifyIdentity", mock.AnythingOfType("*appcontext.appContext"), mock.AnythingOfType("services.IdentityVerificationParams")).
+				Return(&verification, nil)
+			handler := CustomerRegistrationHandler{suite.NewHandlerConfig(), verifier}
+
+			response := handler.Handle(params)
+			suite.IsNotErrResponse(response)
+			suite.Assertions.IsType(&registrationop.CustomerRegistrationUnprocessableEntity{}, response)
+			return response.(*registrationop.CustomerRegistrationUnprocessableEntity)
+		}
+
+		notFound := registerWith(services.IdentityVerification{EdipiFound: false})
+		mismatched := registerWith(services.IdentityVerification{
+			EdipiFound: true,
+			Mismatches: []services.IdentityMismatch{services.IdentityMismatchLastName},
+		})
+		suite.Equal(*notFound.Payload.Detail, *mismatched.Payload.Detail)
+	})
 }
 
 // Generate and activate Okta endpoints that will be using during the auth handlers.
@@ -5,6 +5,7 @@ import (
 	"github.com/gobuffalo/validate/v3"
 	"github.com/gofrs/uuid"
 	"github.com/pkg/errors"
+	"go.uber.org/zap"
 
 	"github.com/transcom/mymove/pkg/appcontext"
 	servicememberop "github.com/transcom/mymove/pkg/gen/internalapi/internaloperations/service_members"
@@ -12,6 +13,7 @@ import (
 	"github.com/transcom/mymove/pkg/handlers"
 	"github.com/transcom/mymove/pkg/handlers/internalapi/internal/payloads"
 	"github.com/transcom/mymove/pkg/models"
+	"github.com/transcom/mymove/pkg/services"
 	"github.com/transcom/mymove/pkg/storage"
 )
 
@@ -140,6 +142,68 @@ func (h ShowServiceMemberHandler) Handle(params servicememberop.ShowServiceMembe
 		})
 }
 
+func payloadForIdentityVerification(verification services.IdentityVerification) *internalmessages.IdentityVerification {
+	mismatches := make([]string, len(verification.Mismatches))
+	for i, mismatch := range verification.Mismatches {
+		mismatches[i] = string(mismatch)
+	}
+
+	return &internalmessages.IdentityVerification{
+		Verified:   models.BoolPointer(verification.Verified()),
+		Mismatches: mismatches,
+	}
+}
+
+// ShowServiceMemberIdentityVerificationHandler compares a service member's profile with their DEERS record
+type ShowServiceMemberIdentityVerificationHandler struct {
+	handlers.HandlerConfig
+	services.IdentityVerifier
+}
+
+// Handle looks the logged in service member's DoD ID up in DEERS to verify their profile. The DoD ID is one the
+// customer entered, so only whether the profile matches is returned, never the DEERS record.
+func (h ShowServiceMemberIdentityVerificationHandler) Handle(params servicememberop.ShowServiceMemberIdentityVerificationParams) middleware.Responder {
+	return h.AuditableAppContextFromRequestWithErrors(params.HTTPRequest,
+		func(appCtx appcontext.AppContext) (middleware.Responder, error) {
+
+			identityFeatureFlagName := "identity_verification"
+			identityFlag, err := h.FeatureFlagFetcher().GetBooleanFlagForUser(params.HTTPRequest.Context(), appCtx, identityFeatureFlagName, map[string]string{})
+			if err != nil {
+				appCtx.Logger().Error("Error fetching identity_verification feature flag", zap.String("featureFlagKey", identityFeatureFlagName), zap.Error(err))
+				return servicememberop.NewShowServiceMemberIdentityVerificationNotFound(), err
+			}
+			if !identityFlag.Match {
+				return servicememberop.NewShowServiceMemberIdentityVerificationNotFound(), nil
+			}
+
+			serviceMemberID, _ := uuid.FromString(params.ServiceMemberID.String())
+
+			serviceMember, err := models.FetchServiceMemberForUser(appCtx.DB(), appCtx.Session(), serviceMemberID)
+			if err != nil {
+				return handlers.ResponseForError(appCtx.Logger(), err), err
+			}
+
+			if serviceMember.Edipi == nil || *serviceMember.Edipi == "" {
+				return servicememberop.NewShowServiceMemberIdentityVerificationUnprocessableEntity(), nil
+			}
+
+			params := services.IdentityVerificationParams{Edipi: *serviceMember.Edipi}
+			if serviceMember.FirstName != nil {
+				params.FirstName = *serviceMember.FirstName
+			}
+			if serviceMember.LastName != nil {
+				params.LastName = *serviceMember.LastName
+			}
+
+			verification, err := h.VerifyIdentity(appCtx, params)
+			if err != nil {
+				return handlers.ResponseForError(appCtx.Logger(), err), err
+			}
+
+			return servicememberop.NewShowServiceMemberIdentityVerificationOK().WithPayload(payloadForIdentityVerification(*verification)), nil
+		})
+}
+
 // PatchServiceMemberHandler patches a serviceMember via PATCH /serviceMembers/{serviceMemberId}
 type PatchServiceMemberHandler struct {
 	handlers.HandlerConfig
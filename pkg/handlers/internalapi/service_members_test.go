@@ -16,12 +16,17 @@ import (
 
 	"github.com/go-openapi/strfmt"
 	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/mock"
 
 	"github.com/transcom/mymove/pkg/factory"
 	servicememberop "github.com/transcom/mymove/pkg/gen/internalapi/internaloperations/service_members"
 	"github.com/transcom/mymove/pkg/gen/internalmessages"
 	"github.com/transcom/mymove/pkg/handlers"
+	"github.com/transcom/mymove/pkg/iws"
 	"github.com/transcom/mymove/pkg/models"
+	"github.com/transcom/mymove/pkg/services"
+	identityverification "github.com/transcom/mymove/pkg/services/identity_verification"
+	"github.com/transcom/mymove/pkg/services/mocks"
 	moverouter "github.com/transcom/mymove/pkg/services/move"
 	transportationoffice "github.com/transcom/mymove/pkg/services/transportation_office"
 	storageTest "github.com/transcom/mymove/pkg/storage/test"
@@ -80,6 +85,133 @@ func (suite *HandlerSuite) TestShowServiceMemberWrongUser() {
 	suite.Assertions.Equal(http.StatusForbidden, errResponse.Code)
 }
 
+func (suite *HandlerSuite) TestShowServiceMemberIdentityVerificationHandler() {
+	handlerConfigWithIdentityFlag := func(enabled bool) handlers.HandlerConfig {
+		handlerConfig := suite.NewHandlerConfig()
+		mockFeatureFlagFetcher := &mocks.FeatureFlagFetcher{}
+		mockFeatureFlagFetcher.On("GetBooleanFlagForUser",
+			mock.Anything,
+			mock.AnythingOfType("*appcontext.appContext"),
+			"identity_verification",
+			mock.Anything,
+		).Return(services.FeatureFlag{Key: "identity_verification", Match: enabled}, nil)
+		handlerConfig.SetFeatureFlagFetcher(mockFeatureFlagFetcher)
+		return handlerConfig
+	}
+
+	buildServiceMemberAndParams := func() (models.ServiceMember, servicememberop.ShowServiceMemberIdentityVerificationParams) {
+		serviceMember := factory.BuildServiceMember(suite.DB(), []factory.Customization{
+			{
+				Model: models.ServiceMember{
+					Edipi:     models.StringPointer("1234567890"),
+					FirstName: models.StringPointer("Testy"),
+					LastName:  models.StringPointer("Smith"),
+				},
+			},
+		}, nil)
+
+		req := httptest.NewRequest("GET", fmt.Sprintf("/service_members/%s/identity_verification", serviceMember.ID.String()), nil)
+		req = suite.AuthenticateRequest(req, serviceMember)
+		return serviceMember, servicememberop.ShowServiceMemberIdentityVerificationParams{
+			HTTPRequest:     req,
+			ServiceMemberID: strfmt.UUID(serviceMember.ID.String()),
+		}
+	}
+
+	suite.Run("returns only whether the profile matches DEERS", func() {
+		_, params := buildServiceMemberAndParams()
+
+		verifier := &mocks.IdentityVerifier{}
+		verifier.On("VerifyIdentity", mock.Anything, services.IdentityVerificationParams{
+			Edipi:     "1234567890",
+			FirstName: "Testy",
+			LastName:  "Smith",
+		}).Return(&services.IdentityVerification{
+			Edipi:      "1234567890",
+			EdipiFound: true,
+			Mismatches: []services.IdentityMismatch{services.IdentityMismatchLastName},
+		}, nil)
+
+		handler := ShowServiceMemberIdentityVerificationHandler{
+			handlerConfigWithIdentityFlag(true),
+			verifier,
+		}
+		response := handler.Handle(params)
+
+		suite.IsType(&servicememberop.ShowServiceMemberIdentityVerificationOK{}, response)
+		payload := response.(*servicememberop.ShowServiceMemberIdentityVerificationOK).Payload
+		suite.NoError(payload.Validate(strfmt.Default))
+		suite.False(*payload.Verified)
+		suite.Equal([]string{"last_name"}, payload.Mismatches)
+	})
+
+	suite.Run("404 when identity verification is turned off", func() {
+		_, params := buildServiceMemberAndParams()
+
+		handler := ShowServiceMemberIdentityVerificationHandler{
+			handlerConfigWithIdentityFlag(false),
+			&mocks.IdentityVerifier{},
+		}
+		response := handler.Handle(params)
+
+		suite.IsType(&servicememberop.ShowServiceMemberIdentityVerificationNotFound{}, response)
+	})
+
+	suite.Run("refuses to verify with the testing person lookup", func() {
+		_, params := buildServiceMemberAndParams()
+
+		handler := ShowServiceMemberIdentityVerificationHandler{
+			handlerConfigWithIdentityFlag(true),
+			identityverification.NewIdentityVerifier(iws.TestingPersonLookup{}),
+		}
+		response := handler.Handle(params)
+
+		suite.IsType(&handlers.ErrResponse{}, response)
+	})
+
+	suite.Run("422 when the service member has no DoD ID", func() {
+		serviceMember := factory.BuildServiceMember(suite.DB(), nil, nil)
+		serviceMember.Edipi = nil
+		suite.MustSave(&serviceMember)
+
+		req := httptest.NewRequest("GET", fmt.Sprintf("/service_members/%s/identity_verification", serviceMember.ID.String()), nil)
+		req = suite.AuthenticateRequest(req, serviceMember)
+		params := servicememberop.ShowServiceMemberIdentityVerificationParams{
+			HTTPRequest:     req,
+			ServiceMemberID: strfmt.UUID(serviceMember.ID.String()),
+		}
+
+		handler := ShowServiceMemberIdentityVerificationHandler{
+			handlerConfigWithIdentityFlag(true),
+			&mocks.IdentityVerifier{},
+		}
+		response := handler.Handle(params)
+
+		suite.IsType(&servicememberop.ShowServiceMemberIdentityVerificationUnprocessableEntity{}, response)
+	})
+
+	suite.Run("can't verify another service member", func() {
+		notLoggedInUser := factory.BuildServiceMember(suite.DB(), nil, nil)
+		loggedInUser := factory.BuildServiceMember(suite.DB(), nil, nil)
+
+		req := httptest.NewRequest("GET", fmt.Sprintf("/service_members/%s/identity_verification", notLoggedInUser.ID.String()), nil)
+		req = suite.AuthenticateRequest(req, loggedInUser)
+		params := servicememberop.ShowServiceMemberIdentityVerificationParams{
+			HTTPRequest:     req,
+			ServiceMemberID: strfmt.UUID(notLoggedInUser.ID.String()),
+		}
+
+		handler := ShowServiceMemberIdentityVerificationHandler{
+			handlerConfigWithIdentityFlag(true),
+			&mocks.IdentityVerifier{},
+		}
+		response := handler.Handle(params)
+
+		suite.IsType(&handlers.ErrResponse{}, response)
+		suite.Equal(http.StatusForbidden, response.(*handlers.ErrResponse).Code)
+	})
+}
+
 func (suite *HandlerSuite) TestSubmitServiceMemberHandlerNoValues() {
 	// Given: A logged-in user
 	user := factory.BuildDefaultUser(suite.DB())
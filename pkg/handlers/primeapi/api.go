@@ -21,6 +21,7 @@ import (
 	mtoserviceitem "github.com/transcom/mymove/pkg/services/mto_service_item"
 	mtoshipment "github.com/transcom/mymove/pkg/services/mto_shipment"
 	order "github.com/transcom/mymove/pkg/services/order"
+	"github.com/transcom/mymove/pkg/services/pagination"
 	paperwork_service "github.com/transcom/mymove/pkg/services/paperwork"
 	paymentrequest "github.com/transcom/mymove/pkg/services/payment_request"
 	portlocation "github.com/transcom/mymove/pkg/services/port_location"
@@ -88,6 +89,7 @@ func NewPrimeAPI(handlerConfig handlers.HandlerConfig) *primeoperations.MymoveAP
 	primeAPI.MoveTaskOrderListMovesHandler = ListMovesHandler{
 		handlerConfig,
 		movetaskorder.NewMoveTaskOrderFetcher(waf),
+		pagination.NewCursorPagination,
 	}
 
 	primeAPI.MoveTaskOrderGetMoveTaskOrderHandler = GetMoveTaskOrderHandler{
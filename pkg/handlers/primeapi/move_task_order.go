@@ -20,12 +20,15 @@ import (
 	"github.com/transcom/mymove/pkg/models"
 	"github.com/transcom/mymove/pkg/notifications"
 	"github.com/transcom/mymove/pkg/services"
+	movetaskorder "github.com/transcom/mymove/pkg/services/move_task_order"
+	"github.com/transcom/mymove/pkg/services/query"
 )
 
 // ListMovesHandler lists moves with the option to filter since a particular date. Optimized ver.
 type ListMovesHandler struct {
 	handlers.HandlerConfig
 	services.MoveTaskOrderFetcher
+	services.NewCursorPagination
 }
 
 // Handle fetches all moves with the option to filter since a particular date. Optimized version.
@@ -42,16 +45,38 @@ func (h ListMovesHandler) Handle(params movetaskorderops.ListMovesParams) middle
 			searchParams.AcknowledgedAfter = handlers.FmtDateTimePtrToPopPtr(params.AcknowledgedAfter)
 			searchParams.AcknowledgedBefore = handlers.FmtDateTimePtrToPopPtr(params.AcknowledgedBefore)
 
+			var pagination services.Pagination
+			if params.Cursor != nil {
+				var err error
+				pagination, err = h.NewCursorPagination(params.Cursor, params.PerPage)
+				if err != nil {
+					return movetaskorderops.NewListMovesBadRequest().WithPayload(payloads.ClientError(handlers.BadRequestErrMessage,
+						err.Error(), h.GetTraceIDFromRequest(params.HTTPRequest))), err
+				}
+				perPage := int64(pagination.PerPage())
+				searchParams.Cursor = pagination.Cursor()
+				searchParams.PerPage = &perPage
+			}
+
 			mtos, amendmentCountInfo, err := h.MoveTaskOrderFetcher.ListPrimeMoveTaskOrdersAmendments(appCtx, &searchParams)
 
 			if err != nil {
+				// a cursor made for another sort order
+				if _, ok := err.(apperror.InvalidInputError); ok {
+					return movetaskorderops.NewListMovesBadRequest().WithPayload(payloads.ClientError(handlers.BadRequestErrMessage,
+						err.Error(), h.GetTraceIDFromRequest(params.HTTPRequest))), err
+				}
 				appCtx.Logger().Error("Unexpected error while fetching moves:", zap.Error(err))
 				return movetaskorderops.NewListMovesInternalServerError().WithPayload(payloads.InternalServerError(nil, h.GetTraceIDFromRequest(params.HTTPRequest))), err
 			}
 
 			payload := payloads.ListMoves(&mtos, appCtx, amendmentCountInfo)
 
-			return movetaskorderops.NewListMovesOK().WithPayload(payload), nil
+			response := movetaskorderops.NewListMovesOK().WithPayload(payload)
+			if nextCursor := query.NextCursor(&mtos, pagination, movetaskorder.ListPrimeMovesOrder); nextCursor != nil {
+				response = response.WithNextCursor(*nextCursor)
+			}
+			return response, nil
 		})
 }
 
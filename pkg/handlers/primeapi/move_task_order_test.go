@@ -29,6 +29,7 @@ import (
 	moverouter "github.com/transcom/mymove/pkg/services/move"
 	movetaskorder "github.com/transcom/mymove/pkg/services/move_task_order"
 	mtoserviceitem "github.com/transcom/mymove/pkg/services/mto_service_item"
+	"github.com/transcom/mymove/pkg/services/pagination"
 	"github.com/transcom/mymove/pkg/services/query"
 	transportationoffice "github.com/transcom/mymove/pkg/services/transportation_office"
 	"github.com/transcom/mymove/pkg/services/upload"
@@ -292,6 +293,53 @@ func (suite *HandlerSuite) TestListMovesHandler() {
 		suite.Equal(move2.ID.String(), movesList[0].ID.String())
 		suite.Equal(move2.PrimeAcknowledgedAt.UTC().Truncate(time.Millisecond), handlers.FmtDateTimePtrToPop(movesList[0].PrimeAcknowledgedAt).UTC().Truncate(time.Millisecond))
 	})
+
+	suite.Run("Test pages moves by cursor", func() {
+		for i := 0; i < 3; i++ {
+			factory.BuildAvailableToPrimeMove(suite.DB(), nil, nil)
+		}
+		handler := ListMovesHandler{
+			HandlerConfig:        suite.NewHandlerConfig(),
+			MoveTaskOrderFetcher: movetaskorder.NewMoveTaskOrderFetcher(waf),
+			NewCursorPagination:  pagination.NewCursorPagination,
+		}
+
+		params := movetaskorderops.ListMovesParams{
+			HTTPRequest: httptest.NewRequest("GET", "/moves?cursor=&perPage=2", nil),
+			PerPage:     models.Int64Pointer(2),
+			Cursor:      models.StringPointer(""),
+		}
+		response := handler.Handle(params)
+		suite.IsNotErrResponse(response)
+		listMovesResponse := response.(*movetaskorderops.ListMovesOK)
+		suite.Len(listMovesResponse.Payload, 2)
+		suite.NotEmpty(listMovesResponse.NextCursor)
+		firstPage := []string{listMovesResponse.Payload[0].ID.String(), listMovesResponse.Payload[1].ID.String()}
+
+		params.Cursor = &listMovesResponse.NextCursor
+		response = handler.Handle(params)
+		suite.IsNotErrResponse(response)
+		listMovesResponse = response.(*movetaskorderops.ListMovesOK)
+		suite.Len(listMovesResponse.Payload, 1)
+		suite.Empty(listMovesResponse.NextCursor)
+		suite.NotContains(firstPage, listMovesResponse.Payload[0].ID.String())
+	})
+
+	suite.Run("Test rejects a cursor it didn't make", func() {
+		handler := ListMovesHandler{
+			HandlerConfig:        suite.NewHandlerConfig(),
+			MoveTaskOrderFetcher: movetaskorder.NewMoveTaskOrderFetcher(waf),
+			NewCursorPagination:  pagination.NewCursorPagination,
+		}
+
+		params := movetaskorderops.ListMovesParams{
+			HTTPRequest: httptest.NewRequest("GET", "/moves?cursor=nope", nil),
+			Cursor:      models.StringPointer("not a cursor!"),
+		}
+		response := handler.Handle(params)
+
+		suite.IsType(&movetaskorderops.ListMovesBadRequest{}, response)
+	})
 }
 
 func (suite *HandlerSuite) TestGetMoveTaskOrder() {
@@ -17,6 +17,7 @@ import (
 	"github.com/transcom/mymove/pkg/models"
 	"github.com/transcom/mymove/pkg/services"
 	"github.com/transcom/mymove/pkg/storage"
+	"github.com/transcom/mymove/pkg/uploader"
 )
 
 // MoveTaskOrder payload
@@ -1049,16 +1050,7 @@ func Upload(appCtx appcontext.AppContext, storer storage.FileStorer, upload *mod
 		appCtx.Logger().Error("primeapi error with getting url for Upload payload", zap.Error(err))
 	}
 
-	tags, err := storer.Tags(upload.StorageKey)
-	if err != nil || tags == nil {
-		payload.Status = "PROCESSING"
-	} else {
-		status, ok := tags["av-status"]
-		if !ok {
-			status = "PROCESSING"
-		}
-		payload.Status = status
-	}
+	payload.Status = string(uploader.AVStatus(storer, *upload))
 
 	return payload
 }
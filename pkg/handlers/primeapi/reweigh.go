@@ -9,7 +9,9 @@ import (
 	mtoshipmentops "github.com/transcom/mymove/pkg/gen/primeapi/primeoperations/mto_shipment"
 	"github.com/transcom/mymove/pkg/handlers"
 	"github.com/transcom/mymove/pkg/handlers/primeapi/payloads"
+	"github.com/transcom/mymove/pkg/models"
 	"github.com/transcom/mymove/pkg/services"
+	"github.com/transcom/mymove/pkg/services/event"
 )
 
 // UpdateReweighHandler is the handler to update a reweigh
@@ -30,6 +32,14 @@ func (h UpdateReweighHandler) Handle(params mtoshipmentops.UpdateReweighParams)
 			// Get the new reweigh model
 			newReweigh := payloads.ReweighModelFromUpdate(payload, params.ReweighID, params.MtoShipmentID)
 
+			// Remember whether the reweigh was already complete so later corrections don't announce it again.
+			// A missing reweigh is reported by the updater below.
+			var previousReweigh models.Reweigh
+			wasComplete := false
+			if findErr := appCtx.DB().Find(&previousReweigh, newReweigh.ID); findErr == nil {
+				wasComplete = reweighIsComplete(previousReweigh)
+			}
+
 			// Call the service object
 			updatedReweigh, err := h.ReweighUpdater.UpdateReweighCheck(appCtx, newReweigh, eTag)
 
@@ -68,8 +78,28 @@ func (h UpdateReweighHandler) Handle(params mtoshipmentops.UpdateReweighParams)
 
 			}
 
+			if !wasComplete && reweighIsComplete(*updatedReweigh) {
+				_, err = event.TriggerEvent(event.Event{
+					EndpointKey:     event.PrimeUpdateReweighEndpointKey,
+					EventKey:        event.ReweighCompleteEventKey,
+					UpdatedObjectID: updatedReweigh.ID,
+					MtoID:           updatedReweigh.Shipment.MoveTaskOrderID,
+					AppContext:      appCtx,
+					TraceID:         h.GetTraceIDFromRequest(params.HTTPRequest),
+				})
+				// If the event trigger fails, just log the error.
+				if err != nil {
+					appCtx.Logger().Error("primeapi.UpdateReweighHandler could not generate the event", zap.Error(err))
+				}
+			}
+
 			// If no error, create a successful payload to return
 			reweighPayload := payloads.Reweigh(updatedReweigh)
 			return mtoshipmentops.NewUpdateReweighOK().WithPayload(reweighPayload), nil
 		})
 }
+
+// reweighIsComplete reports whether the Prime has either weighed the shipment or explained why it couldn't
+func reweighIsComplete(reweigh models.Reweigh) bool {
+	return reweigh.Weight != nil || reweigh.VerificationProvidedAt != nil
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/transcom/mymove/pkg/handlers"
 	"github.com/transcom/mymove/pkg/models"
 	routemocks "github.com/transcom/mymove/pkg/route/mocks"
+	"github.com/transcom/mymove/pkg/services/event"
 	"github.com/transcom/mymove/pkg/services/ghcrateengine"
 	movetaskorder "github.com/transcom/mymove/pkg/services/move_task_order"
 	paymentrequest "github.com/transcom/mymove/pkg/services/payment_request"
@@ -137,6 +138,39 @@ func (suite *HandlerSuite) TestUpdateReweighHandler() {
 		suite.Equal(&reason, reweighOk.Payload.VerificationReason)
 	})
 
+	suite.Run("Success 200 - Reweigh completed event fires only when the reweigh is first completed", func() {
+		// Testcase:   reweigh weight is recorded and then corrected
+		// Expected:   only the first update notifies the Prime that the reweigh is complete
+		handler, reweigh := setupTestData()
+
+		updateWeight := func(weight int64, eTag string) *mtoshipmentops.UpdateReweighOK {
+			req := httptest.NewRequest("PATCH", fmt.Sprintf("/mto-shipments/%s/rewighs/%s", reweigh.ShipmentID.String(), reweigh.ID.String()), nil)
+			params := mtoshipmentops.UpdateReweighParams{
+				HTTPRequest:   req,
+				ReweighID:     *handlers.FmtUUID(reweigh.ID),
+				MtoShipmentID: *handlers.FmtUUID(reweigh.ShipmentID),
+				IfMatch:       eTag,
+				Body: &primemessages.UpdateReweigh{
+					Weight: &weight,
+				},
+			}
+			response := handler.Handle(params)
+			suite.IsType(&mtoshipmentops.UpdateReweighOK{}, response)
+			return response.(*mtoshipmentops.UpdateReweighOK)
+		}
+		countCompletedEvents := func() int {
+			count, err := suite.DB().Where("object_id = ? AND event_key = ?", reweigh.ID, string(event.ReweighCompleteEventKey)).Count(&models.WebhookNotification{})
+			suite.NoError(err)
+			return count
+		}
+
+		first := updateWeight(8000, etag.GenerateEtag(reweigh.UpdatedAt))
+		suite.Equal(1, countCompletedEvents())
+
+		updateWeight(8100, first.Payload.ETag)
+		suite.Equal(1, countCompletedEvents())
+	})
+
 	suite.Run("Failure 422 - Failed to update reweigh weight due to bad request - zero reweigh value", func() {
 		// Testcase:   reweigh us updated with the new weight of the shipment
 		// Expected:   Failure 422
@@ -10,6 +10,7 @@ import (
 	"github.com/transcom/mymove/pkg/handlers"
 	"github.com/transcom/mymove/pkg/handlers/primeapi/payloads"
 	"github.com/transcom/mymove/pkg/services"
+	"github.com/transcom/mymove/pkg/services/event"
 )
 
 // CreateSITExtensionHandler is the handler to create a sit extension
@@ -60,6 +61,19 @@ func (h CreateSITExtensionHandler) Handle(params mtoshipmentops.CreateSITExtensi
 				}
 
 			}
+			_, err = event.TriggerEvent(event.Event{
+				EndpointKey:     event.PrimeCreateSITExtensionEndpointKey,
+				EventKey:        event.SITExtensionCreateEventKey,
+				UpdatedObjectID: createdExtension.ID,
+				MtoID:           createdExtension.MTOShipment.MoveTaskOrderID,
+				AppContext:      appCtx,
+				TraceID:         h.GetTraceIDFromRequest(params.HTTPRequest),
+			})
+			// If the event trigger fails, just log the error.
+			if err != nil {
+				appCtx.Logger().Error("primeapi.CreateSITExtensionHandler could not generate the event", zap.Error(err))
+			}
+
 			// If no error, create a successful payload to return
 			payload := payloads.SITDurationUpdate(createdExtension)
 			return mtoshipmentops.NewCreateSITExtensionCreated().WithPayload(payload), nil
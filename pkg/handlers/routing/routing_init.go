@@ -30,6 +30,8 @@ import (
 	"github.com/transcom/mymove/pkg/handlers/testharnessapi"
 	"github.com/transcom/mymove/pkg/logging"
 	"github.com/transcom/mymove/pkg/middleware"
+	movelocker "github.com/transcom/mymove/pkg/services/lock_move"
+	moveservice "github.com/transcom/mymove/pkg/services/move"
 	"github.com/transcom/mymove/pkg/services/roles"
 	"github.com/transcom/mymove/pkg/storage"
 	"github.com/transcom/mymove/pkg/telemetry"
@@ -696,6 +698,10 @@ func mountGHCAPI(appCtx appcontext.AppContext, routingConfig *Config, site chi.R
 				rAuth.Use(middleware.NoCache())
 				permissionsMiddleware := authentication.PermissionsMiddleware(appCtx, api)
 				rAuth.Use(permissionsMiddleware)
+				moveLockMiddleware := ghcapi.MoveLockMiddleware(routingConfig.HandlerConfig, api, movelocker.NewMoveLockChecker())
+				rAuth.Use(moveLockMiddleware)
+				moveAccessMiddleware := ghcapi.MoveAccessMiddleware(routingConfig.HandlerConfig, api, moveservice.NewMoveAccessChecker())
+				rAuth.Use(moveAccessMiddleware)
 				rAuth.Mount("/", api.Serve(tracingMiddleware))
 			})
 		})
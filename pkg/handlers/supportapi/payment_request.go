@@ -312,7 +312,7 @@ func (h ProcessReviewedPaymentRequestsHandler) Handle(params paymentrequestop.Pr
 			}
 
 			if *sendToSyncada {
-				reviewedPaymentRequestProcessor, err := paymentrequest.InitNewPaymentRequestReviewedProcessor(appCtx, true, h.ICNSequencer(), h.GexSender())
+				reviewedPaymentRequestProcessor, err := paymentrequest.InitNewPaymentRequestReviewedProcessor(appCtx, true, h.ICNSequencer(), h.GexSender(), nil)
 				if err != nil {
 					msg := "failed to initialize InitNewPaymentRequestReviewedProcessor"
 					appCtx.Logger().Error(msg, zap.Error(err))
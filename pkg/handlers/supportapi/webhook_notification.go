@@ -1,6 +1,8 @@
 package supportapi
 
 import (
+	"time"
+
 	"github.com/go-openapi/runtime/middleware"
 	"go.uber.org/zap"
 
@@ -11,6 +13,7 @@ import (
 	"github.com/transcom/mymove/pkg/handlers/supportapi/internal/payloads"
 	"github.com/transcom/mymove/pkg/models"
 	"github.com/transcom/mymove/pkg/services/event"
+	"github.com/transcom/mymove/pkg/webhooksignature"
 )
 
 // ReceiveWebhookNotificationHandler passes through a message
@@ -33,6 +36,14 @@ func (h ReceiveWebhookNotificationHandler) Handle(params webhookops.ReceiveWebho
 				mtoID = notif.MoveTaskOrderID.String()
 			}
 
+			if err := verifyWebhookNotificationSignature(appCtx, params); err != nil {
+				appCtx.Logger().Error("Webhook Notification signature could not be verified",
+					zap.String("id", notif.ID.String()),
+					zap.Error(err))
+				return webhookops.NewReceiveWebhookNotificationUnauthorized().WithPayload(
+					payloads.ClientError("Invalid Signature", err.Error(), h.GetTraceIDFromRequest(params.HTTPRequest))), err
+			}
+
 			appCtx.Logger().Info("Received Webhook Notification: ",
 				zap.String("id", notif.ID.String()),
 				zap.String("eventKey", notif.EventKey),
@@ -44,6 +55,36 @@ func (h ReceiveWebhookNotificationHandler) Handle(params webhookops.ReceiveWebho
 		})
 }
 
+// verifyWebhookNotificationSignature checks the notification was signed with the secret of one of the subscriptions
+// to its event. Unsigned notifications are accepted with a warning so subscriptions created before signing was
+// introduced keep working.
+func verifyWebhookNotificationSignature(appCtx appcontext.AppContext, params webhookops.ReceiveWebhookNotificationParams) error {
+	if params.HTTPRequest.Header.Get(webhooksignature.SignatureHeader) == "" {
+		appCtx.Logger().Warn("Received unsigned Webhook Notification", zap.String("id", params.Body.ID.String()))
+		return nil
+	}
+
+	var subscriptions models.WebhookSubscriptions
+	err := appCtx.DB().Where("event_key = ? AND (status = ? OR status = ?)",
+		params.Body.EventKey, models.WebhookSubscriptionStatusActive, models.WebhookSubscriptionStatusFailing).All(&subscriptions)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	var secrets []string
+	for _, sub := range subscriptions {
+		secrets = append(secrets, sub.ActiveSigningSecrets(now)...)
+	}
+
+	// The webhook-client signs the marshaled payload model, so marshaling it again gives back the signed bytes
+	body, err := params.Body.MarshalBinary()
+	if err != nil {
+		return err
+	}
+	return webhooksignature.Verify(params.HTTPRequest.Header, body, secrets, webhooksignature.DefaultTolerance, now)
+}
+
 // CreateWebhookNotificationHandler is the interface to handle the createWebhookNotification
 type CreateWebhookNotificationHandler struct {
 	handlers.HandlerConfig
@@ -2,6 +2,7 @@ package supportapi
 
 import (
 	"net/http/httptest"
+	"time"
 
 	"github.com/go-openapi/strfmt"
 	"github.com/gofrs/uuid"
@@ -11,7 +12,9 @@ import (
 	"github.com/transcom/mymove/pkg/handlers"
 	"github.com/transcom/mymove/pkg/models"
 	"github.com/transcom/mymove/pkg/services/event"
+	"github.com/transcom/mymove/pkg/testdatagen"
 	"github.com/transcom/mymove/pkg/trace"
+	"github.com/transcom/mymove/pkg/webhooksignature"
 )
 
 func (suite *HandlerSuite) TestCreateWebhookNotification() {
@@ -135,3 +138,67 @@ func (suite *HandlerSuite) TestCreateWebhookNotification() {
 
 	})
 }
+
+func (suite *HandlerSuite) TestReceiveWebhookNotification() {
+	setupParams := func(eventKey string) webhookops.ReceiveWebhookNotificationParams {
+		notificationID := uuid.Must(uuid.NewV4())
+		return webhookops.ReceiveWebhookNotificationParams{
+			HTTPRequest: httptest.NewRequest("POST", "/webhook-notify", nil),
+			Body: &supportmessages.WebhookNotification{
+				ID:       strfmt.UUID(notificationID.String()),
+				EventKey: eventKey,
+				Object:   models.StringPointer("{ \"message\": \"This is an example notification.\" } "),
+			},
+		}
+	}
+	sign := func(params webhookops.ReceiveWebhookNotificationParams, secret string, timestamp time.Time) {
+		body, err := params.Body.MarshalBinary()
+		suite.FatalNoError(err)
+		for key, values := range webhooksignature.Headers([]string{secret}, timestamp, body) {
+			params.HTTPRequest.Header[key] = values
+		}
+	}
+
+	suite.Run("Success receiveWebhookNotification 200 OK with a valid signature", func() {
+		subscription := testdatagen.MakeDefaultWebhookSubscription(suite.DB())
+		params := setupParams(subscription.EventKey)
+		sign(params, *subscription.SigningSecret, time.Now())
+
+		handler := ReceiveWebhookNotificationHandler{suite.NewHandlerConfig()}
+		response := handler.Handle(params)
+
+		suite.IsType(webhookops.NewReceiveWebhookNotificationOK(), response)
+	})
+
+	suite.Run("Success receiveWebhookNotification 200 OK when unsigned", func() {
+		subscription := testdatagen.MakeDefaultWebhookSubscription(suite.DB())
+		params := setupParams(subscription.EventKey)
+
+		handler := ReceiveWebhookNotificationHandler{suite.NewHandlerConfig()}
+		response := handler.Handle(params)
+
+		suite.IsType(webhookops.NewReceiveWebhookNotificationOK(), response)
+	})
+
+	suite.Run("Failure receiveWebhookNotification 401 Unauthorized with the wrong secret", func() {
+		subscription := testdatagen.MakeDefaultWebhookSubscription(suite.DB())
+		params := setupParams(subscription.EventKey)
+		sign(params, "not-the-subscription-secret", time.Now())
+
+		handler := ReceiveWebhookNotificationHandler{suite.NewHandlerConfig()}
+		response := handler.Handle(params)
+
+		suite.IsType(webhookops.NewReceiveWebhookNotificationUnauthorized(), response)
+	})
+
+	suite.Run("Failure receiveWebhookNotification 401 Unauthorized with a stale timestamp", func() {
+		subscription := testdatagen.MakeDefaultWebhookSubscription(suite.DB())
+		params := setupParams(subscription.EventKey)
+		sign(params, *subscription.SigningSecret, time.Now().Add(-time.Hour))
+
+		handler := ReceiveWebhookNotificationHandler{suite.NewHandlerConfig()}
+		response := handler.Handle(params)
+
+		suite.IsType(webhookops.NewReceiveWebhookNotificationUnauthorized(), response)
+	})
+}
@@ -3,7 +3,7 @@ package iws
 import "net/url"
 
 func (suite *iwsSuite) TestBuildEdiURL() {
-	urlString, err := buildEdiURL("example.com", "1234", 1234567890)
+	urlString, err := buildEdiURL("https://example.com", "1234", 1234567890)
 	suite.NoError(err)
 	parsedURL, parseErr := url.Parse(urlString)
 	suite.Nil(parseErr)
@@ -13,7 +13,7 @@ func (suite *iwsSuite) TestBuildEdiURL() {
 }
 
 func (suite *iwsSuite) TestBuildEdiURLInvalidEDIPI() {
-	urlString, err := buildEdiURL("example.com", "1234", 10000000000)
+	urlString, err := buildEdiURL("https://example.com", "1234", 10000000000)
 	suite.NotNil(err)
 	suite.Empty(urlString)
 }
@@ -12,6 +12,7 @@ import (
 	"path/filepath"
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/spf13/viper"
 	"go.mozilla.org/pkcs7"
@@ -25,6 +26,8 @@ import (
 type RBSPersonLookup struct {
 	Client http.Client
 	Host   string
+	// Scheme is the URL scheme used to reach the host, https when empty
+	Scheme string
 }
 
 // GetPersonUsingSSNParams contains person-specific query parameters for GetPidsUsingSSN
@@ -41,7 +44,7 @@ var ssnRegex = regexp.MustCompile(`^\d{9}$`)
 // GetPersonUsingEDIPI retrieves personal information through the IWS:RBS REST API using that person's EDIPI (aka DOD ID number).
 // If matched succesfully, it returns the full name and SSN information, as well as the personnel information for each of the organizations the person belongs to
 func (r RBSPersonLookup) GetPersonUsingEDIPI(edipi uint64) (*Person, []Personnel, error) {
-	url, err := buildEdiURL(r.Host, myMoveCustNum, edipi)
+	url, err := buildEdiURL(r.baseURL(), myMoveCustNum, edipi)
 	if err != nil {
 		return nil, []Personnel{}, err
 	}
@@ -57,7 +60,7 @@ func (r RBSPersonLookup) GetPersonUsingEDIPI(edipi uint64) (*Person, []Personnel
 // GetPersonUsingSSN retrieves personal information (including EDIPI) through the IWS:RBS REST API using a SSN, last name, and optionally a first name
 // If matched succesfully, it returns the EDIPI, the full name and SSN information, and the personnel information for each of the organizations the person belongs to
 func (r RBSPersonLookup) GetPersonUsingSSN(params GetPersonUsingSSNParams) (MatchReasonCode, uint64, *Person, []Personnel, error) {
-	url, err := buildPidsURL(r.Host, myMoveCustNum, params.Ssn, params.LastName, params.FirstName)
+	url, err := buildPidsURL(r.baseURL(), myMoveCustNum, params.Ssn, params.LastName, params.FirstName)
 	if err != nil {
 		return MatchReasonCodeNone, 0, nil, []Personnel{}, err
 	}
@@ -73,7 +76,7 @@ func (r RBSPersonLookup) GetPersonUsingSSN(params GetPersonUsingSSNParams) (Matc
 // GetPersonUsingWorkEmail retrieves personal information (including SSN and EDIPI) through the IWS:RBS REST API using a work e-mail address.
 // If matched succesfully, it returns the EDIPI, the full name and SSN information, and the personnel information for each of the organizations the person belongs to
 func (r RBSPersonLookup) GetPersonUsingWorkEmail(workEmail string) (uint64, *Person, []Personnel, error) {
-	url, err := buildWkEmaURL(r.Host, myMoveCustNum, workEmail)
+	url, err := buildWkEmaURL(r.baseURL(), myMoveCustNum, workEmail)
 	if err != nil {
 		return 0, nil, []Personnel{}, err
 	}
@@ -133,6 +136,20 @@ func NewRBSPersonLookup(host string, dodCACertPackage string, certString string,
 	}, nil
 }
 
+// NewRBSStandInPersonLookup creates a new instance of RBSPersonLookup that talks plain HTTP to an RBSStandInServer
+// listening on the address
+func NewRBSStandInPersonLookup(address string) (*RBSPersonLookup, error) {
+	if address == "" {
+		return nil, errors.New("IWS RBS stand-in address is not set")
+	}
+
+	return &RBSPersonLookup{
+		Client: http.Client{Timeout: 10 * time.Second},
+		Host:   address,
+		Scheme: "http",
+	}, nil
+}
+
 func (r RBSPersonLookup) sendGetRequest(url string) ([]byte, error) {
 	var data []byte
 	resp, err := r.Client.Get(url)
@@ -150,17 +167,26 @@ func (r RBSPersonLookup) sendGetRequest(url string) ([]byte, error) {
 	return io.ReadAll(resp.Body)
 }
 
-func buildEdiURL(host string, custNum string, edipi uint64) (string, error) {
+// baseURL returns the scheme and host the RBS REST API is reached at
+func (r RBSPersonLookup) baseURL() string {
+	scheme := r.Scheme
+	if scheme == "" {
+		scheme = "https"
+	}
+	return scheme + "://" + r.Host
+}
+
+func buildEdiURL(baseURL string, custNum string, edipi uint64) (string, error) {
 	if edipi > 9999999999 {
 		return "", errors.New("Invalid EDIPI")
 	}
 
 	return fmt.Sprintf(
-		"https://%s/appj/rbs/rest/op=edi/customer=%s/schemaName=get_cac_data/schemaVersion=1.0/DOD_EDI_PN_ID=%d",
-		host, custNum, edipi), nil
+		"%s/appj/rbs/rest/op=edi/customer=%s/schemaName=get_cac_data/schemaVersion=1.0/DOD_EDI_PN_ID=%d",
+		baseURL, custNum, edipi), nil
 }
 
-func buildWkEmaURL(host string, custNum string, workEmail string) (string, error) {
+func buildWkEmaURL(baseURL string, custNum string, workEmail string) (string, error) {
 	if !emailRegex.MatchString(workEmail) {
 		return "", errors.New("Invalid e-mail address")
 	}
@@ -172,21 +198,21 @@ func buildWkEmaURL(host string, custNum string, workEmail string) (string, error
 	}
 
 	return fmt.Sprintf(
-		"https://%s/appj/rbs/rest/op=wkEma/customer=%s/schemaName=get_cac_data/schemaVersion=1.0/EMA_TX=%s",
-		host, custNum, workEmail[:l]), nil
+		"%s/appj/rbs/rest/op=wkEma/customer=%s/schemaName=get_cac_data/schemaVersion=1.0/EMA_TX=%s",
+		baseURL, custNum, workEmail[:l]), nil
 }
 
-func buildPidsURL(host string, custNum string, ssn string, lastName string, firstName string) (string, error) {
+func buildPidsURL(baseURL string, custNum string, ssn string, lastName string, firstName string) (string, error) {
 	if !ssnRegex.MatchString(ssn) {
 		return "", errors.New("SSN must be exactly 9 digits")
 	}
 
 	var urlBuilder strings.Builder
-	baseURL := fmt.Sprintf("https://%s"+
+	pidsURL := fmt.Sprintf("%s"+
 		"/appj/rbs/rest/op=pids-P/customer=%s"+
 		"/schemaName=get_cac_data/schemaVersion=1.0/PN_ID=%s"+
-		"/PN_ID_TYP_CD=S/PN_LST_NM=", host, custNum, ssn)
-	urlBuilder.WriteString(baseURL)
+		"/PN_ID_TYP_CD=S/PN_LST_NM=", baseURL, custNum, ssn)
+	urlBuilder.WriteString(pidsURL)
 	l := len(lastName)
 	if l > 26 {
 		// Last names are limited to 26 characters in IWS
@@ -262,6 +288,10 @@ func recordFromResponse(data []byte) (Record, error) {
 
 // InitRBSPersonLookup is the RBS Person Lookup service
 func InitRBSPersonLookup(appCtx appcontext.AppContext, v *viper.Viper) (PersonLookup, error) {
+	if address := v.GetString(cli.IWSRBSStandInAddressFlag); address != "" {
+		appCtx.Logger().Debug("Local IWS RBS Stand-In Client Initialized (Fixture data only!)", zap.String("address", address))
+		return NewRBSStandInPersonLookup(address)
+	}
 	if v.GetBool(cli.IWSRBSEnabledFlag) {
 		appCtx.Logger().Debug("Enabling IWS RBS Person Lookup")
 		rbs, err := NewRBSPersonLookup(
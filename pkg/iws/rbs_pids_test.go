@@ -71,7 +71,7 @@ func (suite *iwsSuite) TestParsePidsResponseError() {
 }
 
 func (suite *iwsSuite) TestBuildPidsUrl() {
-	urlString, err := buildPidsURL("example.com", "1234", "000000000", "Last", "First")
+	urlString, err := buildPidsURL("https://example.com", "1234", "000000000", "Last", "First")
 	suite.NotEmpty(urlString)
 	suite.NoError(err)
 	parsedURL, parseErr := url.Parse(urlString)
@@ -82,7 +82,7 @@ func (suite *iwsSuite) TestBuildPidsUrl() {
 }
 
 func (suite *iwsSuite) TestBuildPidsUrlLongNames() {
-	urlString, err := buildPidsURL("example.com", "1234", "000000000", "abcdefghijklmnopqrstuvwxyzyxwvutsrqponmlkjihgfedcba", "abcdefghijklmnopqrstuvwxyz")
+	urlString, err := buildPidsURL("https://example.com", "1234", "000000000", "abcdefghijklmnopqrstuvwxyzyxwvutsrqponmlkjihgfedcba", "abcdefghijklmnopqrstuvwxyz")
 	suite.NotEmpty(urlString)
 	suite.NoError(err)
 	parsedURL, parseErr := url.Parse(urlString)
@@ -93,7 +93,7 @@ func (suite *iwsSuite) TestBuildPidsUrlLongNames() {
 }
 
 func (suite *iwsSuite) TestBuildPidsUrlNoFirstName() {
-	urlString, err := buildPidsURL("example.com", "1234", "000000000", "Last", "")
+	urlString, err := buildPidsURL("https://example.com", "1234", "000000000", "Last", "")
 	suite.NotEmpty(urlString)
 	suite.NoError(err)
 	parsedURL, parseErr := url.Parse(urlString)
@@ -104,7 +104,7 @@ func (suite *iwsSuite) TestBuildPidsUrlNoFirstName() {
 }
 
 func (suite *iwsSuite) TestBuildPidsUrlBadSSN() {
-	urlString, err := buildPidsURL("example.com", "1234", "12345678", "Last", "First")
+	urlString, err := buildPidsURL("https://example.com", "1234", "12345678", "Last", "First")
 	suite.Empty(urlString)
 	suite.NotNil(err)
 }
@@ -0,0 +1,224 @@
+package iws
+
+import (
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"go.uber.org/zap"
+)
+
+const rbsRESTPathPrefix = "/appj/rbs/rest/"
+
+// rbsFaultCodeBadArgument is the fault code RBS answers with when an argument of the request is missing or malformed
+const rbsFaultCodeBadArgument = 14030
+
+// RBSStandInServer serves the Real-Time Broker Service REST API from fixture records, so RBSPersonLookup can
+// be used in local development and integration tests without access to DMDC. Each fixture is the record RBS
+// returns for an op=edi lookup of one person; the pids-P and wkEma responses are built from the same records.
+type RBSStandInServer struct {
+	logger  *zap.Logger
+	records []Record
+}
+
+// NewRBSStandInServer returns a server for the fixture records in the .xml files of a directory
+func NewRBSStandInServer(logger *zap.Logger, fixturesDir string) (*RBSStandInServer, error) {
+	filenames, err := filepath.Glob(filepath.Join(fixturesDir, "*.xml"))
+	if err != nil {
+		return nil, err
+	}
+	if len(filenames) == 0 {
+		return nil, fmt.Errorf("no RBS fixtures found in %s", fixturesDir)
+	}
+
+	server := &RBSStandInServer{logger: logger}
+	for _, filename := range filenames {
+		data, err := os.ReadFile(filepath.Clean(filename))
+		if err != nil {
+			return nil, err
+		}
+		var rec Record
+		if err := xml.Unmarshal(data, &rec); err != nil {
+			return nil, fmt.Errorf("unable to parse RBS fixture %s: %w", filename, err)
+		}
+		if rec.AdrRecord.Edipi == nil || rec.AdrRecord.Person == nil {
+			return nil, fmt.Errorf("RBS fixture %s must have a DOD_EDI_PN_ID and a person", filename)
+		}
+		server.records = append(server.records, rec)
+	}
+
+	return server, nil
+}
+
+// ServeHTTP answers the edi, pids-P and wkEma operations of the RBS REST API
+func (s *RBSStandInServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet || !strings.HasPrefix(r.URL.Path, rbsRESTPathPrefix) {
+		http.NotFound(w, r)
+		return
+	}
+
+	args := parseRBSPathArguments(strings.TrimPrefix(r.URL.Path, rbsRESTPathPrefix))
+	customer, err := strconv.ParseUint(args["customer"], 10, 32)
+	if err != nil {
+		s.writeResponse(w, http.StatusBadRequest, badArgumentError("customer"))
+		return
+	}
+	rule := Rule{
+		Customer:      uint32(customer),
+		SchemaName:    args["schemaName"],
+		SchemaVersion: args["schemaVersion"],
+	}
+
+	var response interface{}
+	switch args["op"] {
+	case "edi":
+		response = s.ediResponse(rule, args)
+	case "pids-P":
+		response = s.pidsResponse(rule, args)
+	case "wkEma":
+		response = s.wkEmaResponse(rule, args)
+	default:
+		http.NotFound(w, r)
+		return
+	}
+
+	status := http.StatusOK
+	if _, ok := response.(*RbsError); ok {
+		status = http.StatusBadRequest
+	}
+	s.logger.Info("answered RBS lookup", zap.String("op", args["op"]), zap.Int("status", status))
+	s.writeResponse(w, status, response)
+}
+
+func (s *RBSStandInServer) ediResponse(rule Rule, args map[string]string) interface{} {
+	edipi, err := strconv.ParseUint(args["DOD_EDI_PN_ID"], 10, 64)
+	if err != nil {
+		return badArgumentError("DOD_EDI_PN_ID")
+	}
+
+	response := Record{
+		Rule:       rule,
+		Identifier: Identifier{Edipi: &edipi},
+	}
+	for _, rec := range s.records {
+		if *rec.AdrRecord.Edipi == edipi {
+			response.AdrRecord = AdrRecord{
+				Edipi:     &edipi,
+				Person:    rec.AdrRecord.Person,
+				Personnel: rec.AdrRecord.Personnel,
+			}
+			break
+		}
+	}
+	return &response
+}
+
+// pidsResponse matches the SSN like RBS does: a match on the SSN alone is limited, and a match that also
+// has the last name is full
+func (s *RBSStandInServer) pidsResponse(rule Rule, args map[string]string) interface{} {
+	ssn := args["PN_ID"]
+	if !ssnRegex.MatchString(ssn) {
+		return badArgumentError("PN_ID")
+	}
+	if args["PN_ID_TYP_CD"] != string(PersonTypeCodeSSN) {
+		return badArgumentError("PN_ID_TYP_CD")
+	}
+	lastName := args["PN_LST_NM"]
+	if lastName == "" {
+		return badArgumentError("PN_LST_NM")
+	}
+
+	response := Record{
+		Rule: rule,
+		Identifier: Identifier{Pids: &Person{
+			ID:        ssn,
+			TypeCode:  PersonTypeCodeSSN,
+			LastName:  lastName,
+			FirstName: args["PN_1ST_NM"],
+		}},
+		AdrRecord: AdrRecord{PidsRecord: &PidsRecord{MtchRsnCd: MatchReasonCodeNone}},
+	}
+
+	var matches []Record
+	for _, rec := range s.records {
+		if rec.AdrRecord.Person.TypeCode == PersonTypeCodeSSN && rec.AdrRecord.Person.ID == ssn {
+			matches = append(matches, rec)
+		}
+	}
+	switch len(matches) {
+	case 0:
+		return &response
+	case 1:
+		match := matches[0]
+		reason := MatchReasonCodeLimited
+		if strings.EqualFold(match.AdrRecord.Person.LastName, lastName) {
+			reason = MatchReasonCodeFull
+		}
+		response.AdrRecord = AdrRecord{
+			PidsRecord: &PidsRecord{MtchRsnCd: reason, Edipi: *match.AdrRecord.Edipi},
+			Person:     match.AdrRecord.Person,
+			Personnel:  match.AdrRecord.Personnel,
+		}
+	default:
+		response.AdrRecord.PidsRecord.MtchRsnCd = MatchReasonCodeMultiple
+	}
+	return &response
+}
+
+func (s *RBSStandInServer) wkEmaResponse(rule Rule, args map[string]string) interface{} {
+	workEmail := args["EMA_TX"]
+	if !emailRegex.MatchString(workEmail) {
+		return badArgumentError("EMA_TX")
+	}
+
+	response := Record{Rule: rule}
+	for _, rec := range s.records {
+		for _, personnel := range rec.AdrRecord.Personnel {
+			if strings.EqualFold(personnel.Email, workEmail) {
+				response.AdrRecord = AdrRecord{
+					WorkEmail: &WkEmaRecord{Edipi: *rec.AdrRecord.Edipi, Email: personnel.Email},
+					Person:    rec.AdrRecord.Person,
+					Personnel: rec.AdrRecord.Personnel,
+				}
+				return &response
+			}
+		}
+	}
+	return &response
+}
+
+func (s *RBSStandInServer) writeResponse(w http.ResponseWriter, status int, response interface{}) {
+	data, err := xml.MarshalIndent(response, "", "  ")
+	if err != nil {
+		s.logger.Error("unable to marshal RBS response", zap.Error(err))
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(status)
+	if _, err := w.Write(append([]byte(xml.Header), data...)); err != nil {
+		s.logger.Error("unable to write RBS response", zap.Error(err))
+	}
+}
+
+// parseRBSPathArguments splits a path such as op=edi/customer=2675/DOD_EDI_PN_ID=1234567890 into its arguments
+func parseRBSPathArguments(path string) map[string]string {
+	args := make(map[string]string)
+	for _, segment := range strings.Split(path, "/") {
+		name, value, found := strings.Cut(segment, "=")
+		if found {
+			args[name] = value
+		}
+	}
+	return args
+}
+
+func badArgumentError(argument string) *RbsError {
+	// RBS fault messages start with a space
+	return &RbsError{FaultCode: rbsFaultCodeBadArgument, FaultMessage: " Problem with this argument: " + argument}
+}
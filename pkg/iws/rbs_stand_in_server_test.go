@@ -0,0 +1,116 @@
+package iws
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"go.uber.org/zap"
+)
+
+func (suite *iwsSuite) setupRBSStandIn() (*httptest.Server, *RBSPersonLookup) {
+	standIn, err := NewRBSStandInServer(zap.NewNop(), "fixtures/rbs")
+	suite.FatalNoError(err)
+	server := httptest.NewServer(standIn)
+	suite.T().Cleanup(server.Close)
+
+	rbs, err := NewRBSStandInPersonLookup(strings.TrimPrefix(server.URL, "http://"))
+	suite.FatalNoError(err)
+	return server, rbs
+}
+
+func (suite *iwsSuite) TestRBSStandInGetPersonUsingEDIPI() {
+	_, rbs := suite.setupRBSStandIn()
+
+	suite.Run("known EDIPI", func() {
+		person, personnel, err := rbs.GetPersonUsingEDIPI(3850947261)
+		suite.NoError(err)
+		if suite.NotNil(person) {
+			suite.Equal("Riley", person.FirstName)
+			suite.Equal("Okafor", person.LastName)
+			suite.Equal("JR", person.CdncyName)
+		}
+		if suite.Len(personnel, 1) {
+			suite.Equal(ServiceCodeArmy, personnel[0].SvcCd)
+		}
+	})
+
+	suite.Run("unknown EDIPI", func() {
+		person, personnel, err := rbs.GetPersonUsingEDIPI(9999999999)
+		suite.NoError(err)
+		suite.Nil(person)
+		suite.Empty(personnel)
+	})
+}
+
+func (suite *iwsSuite) TestRBSStandInGetPersonUsingSSN() {
+	_, rbs := suite.setupRBSStandIn()
+
+	suite.Run("SSN and last name match", func() {
+		reason, edipi, person, _, err := rbs.GetPersonUsingSSN(GetPersonUsingSSNParams{Ssn: "666528193", LastName: "lindqvist"})
+		suite.NoError(err)
+		suite.Equal(MatchReasonCodeFull, reason)
+		suite.Equal(uint64(5096328417), edipi)
+		suite.NotNil(person)
+	})
+
+	suite.Run("SSN matches but last name doesn't", func() {
+		reason, edipi, _, _, err := rbs.GetPersonUsingSSN(GetPersonUsingSSNParams{Ssn: "666528193", LastName: "Smith"})
+		suite.NoError(err)
+		suite.Equal(MatchReasonCodeLimited, reason)
+		suite.Equal(uint64(5096328417), edipi)
+	})
+
+	suite.Run("no match", func() {
+		reason, edipi, person, _, err := rbs.GetPersonUsingSSN(GetPersonUsingSSNParams{Ssn: "666000000", LastName: "Smith"})
+		suite.NoError(err)
+		suite.Equal(MatchReasonCodeNone, reason)
+		suite.Zero(edipi)
+		suite.Nil(person)
+	})
+}
+
+func (suite *iwsSuite) TestRBSStandInGetPersonUsingWorkEmail() {
+	_, rbs := suite.setupRBSStandIn()
+
+	edipi, person, _, err := rbs.GetPersonUsingWorkEmail("Testy.McTestface@example.com")
+	suite.NoError(err)
+	suite.Equal(uint64(1234567890), edipi)
+	if suite.NotNil(person) {
+		suite.Equal(SSN, person.ID)
+	}
+
+	edipi, person, _, err = rbs.GetPersonUsingWorkEmail("nobody@example.com")
+	suite.NoError(err)
+	suite.Zero(edipi)
+	suite.Nil(person)
+}
+
+func (suite *iwsSuite) TestRBSStandInBadArgument() {
+	server, _ := suite.setupRBSStandIn()
+
+	response, err := http.Get(server.URL + "/appj/rbs/rest/op=edi/customer=2675/schemaName=get_cac_data/schemaVersion=1.0/DOD_EDI_PN_ID=abc")
+	suite.FatalNoError(err)
+	defer response.Body.Close()
+	suite.Equal(http.StatusBadRequest, response.StatusCode)
+
+	body, err := io.ReadAll(response.Body)
+	suite.FatalNoError(err)
+	_, _, err = parseEdiResponse(body)
+	rbsErr, ok := err.(*RbsError)
+	if suite.True(ok) {
+		suite.Equal(uint64(rbsFaultCodeBadArgument), rbsErr.FaultCode)
+		suite.Contains(rbsErr.FaultMessage, "DOD_EDI_PN_ID")
+	}
+
+	response, err = http.Get(server.URL + "/appj/rbs/rest/op=unknown/customer=2675")
+	suite.FatalNoError(err)
+	defer response.Body.Close()
+	suite.Equal(http.StatusNotFound, response.StatusCode)
+}
+
+func (suite *iwsSuite) TestNewRBSStandInServerWithoutFixtures() {
+	_, err := NewRBSStandInServer(zap.NewNop(), suite.T().TempDir())
+	suite.Error(err)
+}
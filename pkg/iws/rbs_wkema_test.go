@@ -65,7 +65,7 @@ func (suite *iwsSuite) TestParseWkEmaResponseError() {
 }
 
 func (suite *iwsSuite) TestBuildWkEmaURL() {
-	urlString, err := buildWkEmaURL("example.com", "1234", "test@example.com")
+	urlString, err := buildWkEmaURL("https://example.com", "1234", "test@example.com")
 	suite.NotEmpty(urlString)
 	suite.NoError(err)
 	parsedURL, parseErr := url.Parse(urlString)
@@ -76,13 +76,13 @@ func (suite *iwsSuite) TestBuildWkEmaURL() {
 }
 
 func (suite *iwsSuite) TestBuildWkEmaURLEmailInvalid() {
-	u, err := buildWkEmaURL("example.com", "1234", "invalid@")
+	u, err := buildWkEmaURL("https://example.com", "1234", "invalid@")
 	suite.NotNil(err)
 	suite.Empty(u)
 }
 
 func (suite *iwsSuite) TestBuildWkEmaURLLongEmail() {
-	urlString, err := buildWkEmaURL("example.com", "1234", "pneumonoultramicroscopicsilicovolcanoconiosis_is_a_terrible_way_to_expire@unpronounceablediseases.org")
+	urlString, err := buildWkEmaURL("https://example.com", "1234", "pneumonoultramicroscopicsilicovolcanoconiosis_is_a_terrible_way_to_expire@unpronounceablediseases.org")
 	suite.NotEmpty(urlString)
 	suite.NoError(err)
 	parsedURL, parseErr := url.Parse(urlString)
@@ -0,0 +1,44 @@
+package models
+
+import (
+	"time"
+
+	"github.com/gobuffalo/pop/v6"
+	"github.com/gobuffalo/validate/v3"
+	"github.com/gobuffalo/validate/v3/validators"
+	"github.com/gofrs/uuid"
+)
+
+// AuditEvent is a persisted audit record of a change an admin or office user made. TransactionID is the
+// transaction that made the change, matching the audit_history rows the change produced.
+type AuditEvent struct {
+	ID                   uuid.UUID  `json:"id" db:"id"`
+	EventType            string     `json:"event_type" db:"event_type"`
+	RecordType           *string    `json:"record_type" db:"record_type"`
+	RecordID             *uuid.UUID `json:"record_id" db:"record_id"`
+	ResponsibleUserID    *uuid.UUID `json:"responsible_user_id" db:"responsible_user_id"`
+	ResponsibleUserEmail *string    `json:"responsible_user_email" db:"responsible_user_email"`
+	ResponsibleUserName  *string    `json:"responsible_user_name" db:"responsible_user_name"`
+	FieldsChanged        *string    `json:"fields_changed" db:"fields_changed"`
+	Payload              JSONMap    `json:"payload" db:"payload"`
+	ActiveValue          *bool      `json:"active_value" db:"active_value"`
+	// BeforeData and AfterData hold the changed columns by table name, then object id
+	BeforeData    JSONMap   `json:"before_data" db:"before_data"`
+	AfterData     JSONMap   `json:"after_data" db:"after_data"`
+	TransactionID int64     `json:"transaction_id" db:"transaction_id"`
+	CreatedAt     time.Time `json:"created_at" db:"created_at"`
+}
+
+// TableName overrides the table name used by Pop.
+func (a AuditEvent) TableName() string {
+	return "audit_events"
+}
+
+type AuditEvents []AuditEvent
+
+// Validate gets run every time you call a "pop.Validate*" (pop.ValidateAndSave, pop.ValidateAndCreate, pop.ValidateAndUpdate) method.
+func (a *AuditEvent) Validate(_ *pop.Connection) (*validate.Errors, error) {
+	return validate.Validate(
+		&validators.StringIsPresent{Field: a.EventType, Name: "EventType"},
+	), nil
+}
@@ -0,0 +1,25 @@
+package models_test
+
+import (
+	"github.com/transcom/mymove/pkg/models"
+)
+
+func (suite *ModelSuite) TestAuditEventValidation() {
+	suite.Run("test valid AuditEvent", func() {
+		event := models.AuditEvent{
+			EventType: "audit_patch_office_users",
+		}
+
+		expErrors := map[string][]string{}
+		suite.verifyValidationErrors(&event, expErrors, nil)
+	})
+
+	suite.Run("test empty AuditEvent", func() {
+		event := models.AuditEvent{}
+
+		expErrors := map[string][]string{
+			"event_type": {"EventType can not be blank."},
+		}
+		suite.verifyValidationErrors(&event, expErrors, nil)
+	})
+}
@@ -74,6 +74,10 @@ func (jm JSONMap) Value() (driver.Value, error) {
 
 // Scan reads a data type and update the JSONMap to represent the value read from JSON
 func (jm *JSONMap) Scan(value interface{}) error {
+	if value == nil {
+		*jm = nil
+		return nil
+	}
 	b, ok := value.([]byte)
 	if !ok {
 		return errors.New("type assertion to []byte failed")
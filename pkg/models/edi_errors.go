@@ -22,6 +22,8 @@ type EdiError struct {
 	Code                       *string                                  `json:"code" db:"code"`
 	Description                *string                                  `json:"description" db:"description"`
 	EDIType                    EDIType                                  `json:"edi_type" db:"edi_type"`
+	ResolvedAt                 *time.Time                               `json:"resolved_at" db:"resolved_at"`
+	ResolvedByOfficeUserID     *uuid.UUID                               `json:"resolved_by_office_user_id" db:"resolved_by_office_user_id"`
 }
 
 // TableName overrides the table name used by Pop.
@@ -1,6 +1,7 @@
 package models
 
 import (
+	"database/sql"
 	"time"
 
 	"github.com/gobuffalo/pop/v6"
@@ -34,3 +35,16 @@ func (g *GHCDieselFuelPrice) Validate(_ *pop.Connection) (*validate.Errors, erro
 		&validators.TimeIsPresent{Field: g.PublicationDate, Name: "PublicationDate"},
 	), nil
 }
+
+// FetchGHCDieselFuelPriceForPickupDate returns the diesel fuel price a fuel surcharge for a pickup on the date is
+// priced with: the first price published for the pickup week, or, when that week is missing, the latest price
+// published before the date. usedEarlierPrice reports the second case.
+func FetchGHCDieselFuelPriceForPickupDate(db *pop.Connection, pickupDate time.Time) (price GHCDieselFuelPrice, usedEarlierPrice bool, err error) {
+	err = db.Where("? BETWEEN effective_date and end_date", pickupDate).Order("publication_date DESC").First(&price) //only want the first published price per week
+	if err != sql.ErrNoRows {
+		return price, false, err
+	}
+
+	err = db.Where("publication_date <= ?", pickupDate).Order("publication_date DESC").Last(&price)
+	return price, true, err
+}
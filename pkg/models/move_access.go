@@ -0,0 +1,34 @@
+package models
+
+import (
+	"slices"
+
+	"github.com/gofrs/uuid"
+
+	"github.com/transcom/mymove/pkg/auth"
+	"github.com/transcom/mymove/pkg/models/roles"
+)
+
+// MoveAccessNationalRoles see moves in every area, the same as in move search
+var MoveAccessNationalRoles = []roles.RoleType{
+	roles.RoleTypeHQ,
+	roles.RoleTypeQae,
+	roles.RoleTypeCustomerServiceRepresentative,
+	roles.RoleTypeGSR,
+	roles.RoleTypeContractingOfficer,
+	roles.RoleTypePrimeSimulator,
+}
+
+// OfficeUserCanSeeMoveCondition matches the moves an office user may see. Its only argument is the
+// office user's id; the rules live in the office_user_can_see_move database function.
+const OfficeUserCanSeeMoveCondition = "office_user_can_see_move(?, moves.id)"
+
+// MoveAccessOfficeUserID returns the office user whose area limits the moves a session may read, or
+// nil when the session is not an office user's or acts in a national role.
+func MoveAccessOfficeUserID(session *auth.Session) *uuid.UUID {
+	if !session.IsOfficeUser() || slices.Contains(MoveAccessNationalRoles, session.ActiveRole.RoleType) {
+		return nil
+	}
+	officeUserID := session.OfficeUserID
+	return &officeUserID
+}
@@ -0,0 +1,65 @@
+package models
+
+import (
+	"time"
+
+	"github.com/gobuffalo/pop/v6"
+	"github.com/gobuffalo/validate/v3"
+	"github.com/gobuffalo/validate/v3/validators"
+	"github.com/gofrs/uuid"
+)
+
+// MoveLockEventType is the kind of change made to the lock on a move
+type MoveLockEventType string
+
+const (
+	// MoveLockEventTypeLOCKED is recorded when an office user acquires the lock
+	MoveLockEventTypeLOCKED MoveLockEventType = "LOCKED"
+	// MoveLockEventTypeRELEASED is recorded when the holder gives up the lock
+	MoveLockEventTypeRELEASED MoveLockEventType = "RELEASED"
+	// MoveLockEventTypeSTOLEN is recorded when a supervisor takes over another user's lock
+	MoveLockEventTypeSTOLEN MoveLockEventType = "STOLEN"
+	// MoveLockEventTypeEXPIRED is recorded when a lock is cleared because it was not renewed
+	MoveLockEventTypeEXPIRED MoveLockEventType = "EXPIRED"
+)
+
+// MoveLockEvent is an entry in the audit trail of the lock on a move
+type MoveLockEvent struct {
+	ID                   uuid.UUID         `db:"id"`
+	MoveID               uuid.UUID         `db:"move_id"`
+	EventType            MoveLockEventType `db:"event_type"`
+	OfficeUserID         *uuid.UUID        `db:"office_user_id"`
+	PreviousOfficeUserID *uuid.UUID        `db:"previous_office_user_id"`
+	LockExpiresAt        *time.Time        `db:"lock_expires_at"`
+	CreatedAt            time.Time         `db:"created_at"`
+}
+
+// TableName overrides the table name used by Pop.
+func (m MoveLockEvent) TableName() string {
+	return "move_lock_events"
+}
+
+type MoveLockEvents []MoveLockEvent
+
+// Validate gets run every time you call a "pop.Validate*" (pop.ValidateAndSave, pop.ValidateAndCreate, pop.ValidateAndUpdate) method.
+func (m *MoveLockEvent) Validate(_ *pop.Connection) (*validate.Errors, error) {
+	return validate.Validate(
+		&validators.UUIDIsPresent{Field: m.MoveID, Name: "MoveID"},
+		&validators.StringInclusion{Field: string(m.EventType), Name: "EventType", List: []string{
+			string(MoveLockEventTypeLOCKED),
+			string(MoveLockEventTypeRELEASED),
+			string(MoveLockEventTypeSTOLEN),
+			string(MoveLockEventTypeEXPIRED),
+		}},
+	), nil
+}
+
+// FetchMoveLockEvents returns the lock audit trail of a move, oldest first
+func FetchMoveLockEvents(db *pop.Connection, moveID uuid.UUID) (MoveLockEvents, error) {
+	var events MoveLockEvents
+	err := db.Where("move_id = ?", moveID).Order("created_at ASC").All(&events)
+	if err != nil {
+		return nil, err
+	}
+	return events, nil
+}
@@ -0,0 +1,52 @@
+package models_test
+
+import (
+	"github.com/gofrs/uuid"
+
+	"github.com/transcom/mymove/pkg/factory"
+	"github.com/transcom/mymove/pkg/models"
+)
+
+func (suite *ModelSuite) TestMoveLockEventValidation() {
+	suite.Run("test valid MoveLockEvent", func() {
+		officeUserID := uuid.Must(uuid.NewV4())
+		event := models.MoveLockEvent{
+			MoveID:       uuid.Must(uuid.NewV4()),
+			EventType:    models.MoveLockEventTypeLOCKED,
+			OfficeUserID: &officeUserID,
+		}
+
+		expErrors := map[string][]string{}
+		suite.verifyValidationErrors(&event, expErrors, nil)
+	})
+
+	suite.Run("test empty MoveLockEvent", func() {
+		event := models.MoveLockEvent{}
+
+		expErrors := map[string][]string{
+			"move_id":    {"MoveID can not be blank."},
+			"event_type": {"EventType is not in the list [LOCKED, RELEASED, STOLEN, EXPIRED]."},
+		}
+		suite.verifyValidationErrors(&event, expErrors, nil)
+	})
+}
+
+func (suite *ModelSuite) TestFetchMoveLockEvents() {
+	move := factory.BuildMove(suite.DB(), nil, nil)
+	officeUser := factory.BuildOfficeUser(suite.DB(), nil, nil)
+
+	for _, eventType := range []models.MoveLockEventType{models.MoveLockEventTypeLOCKED, models.MoveLockEventTypeRELEASED} {
+		event := models.MoveLockEvent{
+			MoveID:       move.ID,
+			EventType:    eventType,
+			OfficeUserID: &officeUser.ID,
+		}
+		suite.MustCreate(&event)
+	}
+
+	events, err := models.FetchMoveLockEvents(suite.DB(), move.ID)
+	suite.NoError(err)
+	suite.Len(events, 2)
+	suite.Equal(models.MoveLockEventTypeLOCKED, events[0].EventType)
+	suite.Equal(models.MoveLockEventTypeRELEASED, events[1].EventType)
+}
@@ -0,0 +1,108 @@
+package models
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/gobuffalo/pop/v6"
+	"github.com/gobuffalo/validate/v3"
+	"github.com/gobuffalo/validate/v3/validators"
+	"github.com/gofrs/uuid"
+	"github.com/lib/pq"
+)
+
+// AllowedQueueTypes are the office queues a queue view can be saved for
+var AllowedQueueTypes = []string{
+	string(QueueTypeCounseling),
+	string(QueueTypeCloseout),
+	string(QueueTypeTaskOrder),
+	string(QueueTypePaymentRequest),
+	string(QueueTypeDestinationRequest),
+}
+
+// QueueViewFilters are the filter values of a queue view. The JSON keys are the query parameter
+// names of the queue endpoints so a saved view reads the same as the request it was built from.
+type QueueViewFilters struct {
+	Branch                  *string    `json:"branch,omitempty"`
+	Locator                 *string    `json:"locator,omitempty"`
+	Edipi                   *string    `json:"edipi,omitempty"`
+	Emplid                  *string    `json:"emplid,omitempty"`
+	CustomerName            *string    `json:"customerName,omitempty"`
+	OriginDutyLocation      []string   `json:"originDutyLocation,omitempty"`
+	DestinationDutyLocation *string    `json:"destinationDutyLocation,omitempty"`
+	OriginGBLOC             *string    `json:"originGBLOC,omitempty"`
+	SubmittedAt             *time.Time `json:"submittedAt,omitempty"`
+	AppearedInTOOAt         *time.Time `json:"appearedInTooAt,omitempty"`
+	RequestedMoveDate       *string    `json:"requestedMoveDate,omitempty"`
+	Status                  []string   `json:"status,omitempty"`
+	OrderType               *string    `json:"orderType,omitempty"`
+	PPMType                 *string    `json:"ppmType,omitempty"`
+	PPMStatus               *string    `json:"ppmStatus,omitempty"`
+	CloseoutInitiated       *time.Time `json:"closeoutInitiated,omitempty"`
+	CloseoutLocation        *string    `json:"closeoutLocation,omitempty"`
+	CounselingOffice        *string    `json:"counselingOffice,omitempty"`
+	AssignedTo              *string    `json:"assignedTo,omitempty"`
+	ViewAsGBLOC             *string    `json:"viewAsGBLOC,omitempty"`
+}
+
+// Value returns the filters as a JSON value
+func (f QueueViewFilters) Value() (driver.Value, error) {
+	return json.Marshal(f)
+}
+
+// Scan reads the filters from a JSON value
+func (f *QueueViewFilters) Scan(value interface{}) error {
+	if value == nil {
+		*f = QueueViewFilters{}
+		return nil
+	}
+	b, ok := value.([]byte)
+	if !ok {
+		return errors.New("type assertion to []byte failed")
+	}
+	return json.Unmarshal(b, f)
+}
+
+// OfficeQueueView is a named set of filters, sort and columns an office user saved for a queue.
+// Views with a TransportationOfficeID are shared with everyone working for that office.
+type OfficeQueueView struct {
+	ID                     uuid.UUID             `json:"id" db:"id"`
+	OfficeUserID           uuid.UUID             `json:"office_user_id" db:"office_user_id"`
+	OfficeUser             OfficeUser            `belongs_to:"office_users" fk_id:"office_user_id"`
+	TransportationOfficeID *uuid.UUID            `json:"transportation_office_id" db:"transportation_office_id"`
+	TransportationOffice   *TransportationOffice `belongs_to:"transportation_offices" fk_id:"transportation_office_id"`
+	QueueType              QueueType             `json:"queue_type" db:"queue_type"`
+	Name                   string                `json:"name" db:"name"`
+	Filters                QueueViewFilters      `json:"filters" db:"filters"`
+	Sort                   *string               `json:"sort" db:"sort"`
+	Order                  *string               `json:"sort_order" db:"sort_order"`
+	Columns                pq.StringArray        `json:"columns" db:"columns"`
+	CreatedAt              time.Time             `json:"created_at" db:"created_at"`
+	UpdatedAt              time.Time             `json:"updated_at" db:"updated_at"`
+}
+
+// TableName overrides the table name used by Pop.
+func (v OfficeQueueView) TableName() string {
+	return "office_queue_views"
+}
+
+type OfficeQueueViews []OfficeQueueView
+
+// IsShared reports whether the view is shared with a transportation office
+func (v OfficeQueueView) IsShared() bool {
+	return v.TransportationOfficeID != nil
+}
+
+// Validate gets run every time you call a "pop.Validate*" (pop.ValidateAndSave, pop.ValidateAndCreate, pop.ValidateAndUpdate) method.
+func (v *OfficeQueueView) Validate(_ *pop.Connection) (*validate.Errors, error) {
+	return validate.Validate(
+		&validators.UUIDIsPresent{Field: v.OfficeUserID, Name: "OfficeUserID"},
+		&OptionalUUIDIsPresent{Field: v.TransportationOfficeID, Name: "TransportationOfficeID"},
+		&validators.StringInclusion{Field: string(v.QueueType), Name: "QueueType", List: AllowedQueueTypes},
+		&validators.StringIsPresent{Field: v.Name, Name: "Name"},
+		&validators.StringLengthInRange{Field: v.Name, Name: "Name", Max: 255},
+		&OptionalStringInclusion{Field: v.Order, Name: "Order", List: []string{"asc", "desc"}},
+	), nil
+}
@@ -0,0 +1,72 @@
+package models_test
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+
+	"github.com/transcom/mymove/pkg/models"
+)
+
+func (suite *ModelSuite) TestOfficeQueueViewValidation() {
+	validQueueTypes := strings.Join(models.AllowedQueueTypes, ", ")
+
+	testCases := map[string]struct {
+		view         models.OfficeQueueView
+		expectedErrs map[string][]string
+	}{
+		"Successful Minimal Validation": {
+			view: models.OfficeQueueView{
+				OfficeUserID: uuid.Must(uuid.NewV4()),
+				QueueType:    models.QueueTypeTaskOrder,
+				Name:         "Navy moves",
+			},
+			expectedErrs: nil,
+		},
+		"Missing Required Fields": {
+			view: models.OfficeQueueView{
+				QueueType: "SEARCH",
+				Order:     models.StringPointer("up"),
+			},
+			expectedErrs: map[string][]string{
+				"office_user_id": {"OfficeUserID can not be blank."},
+				"queue_type":     {fmt.Sprintf("QueueType is not in the list [%s].", validQueueTypes)},
+				"name":           {"Name can not be blank."},
+				"order":          {"Order is not in the list [asc, desc]."},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		suite.Run(name, func() {
+			suite.verifyValidationErrors(&testCase.view, testCase.expectedErrs, nil)
+		})
+	}
+}
+
+func (suite *ModelSuite) TestQueueViewFiltersRoundTrip() {
+	appearedInTOOAt := time.Date(2025, time.July, 1, 0, 0, 0, 0, time.UTC)
+	filters := models.QueueViewFilters{
+		Branch:             models.StringPointer("NAVY"),
+		OriginDutyLocation: []string{"Fort Eisenhower, GA 30813"},
+		AppearedInTOOAt:    &appearedInTOOAt,
+		Status:             []string{string(models.MoveStatusSUBMITTED)},
+	}
+
+	value, err := filters.Value()
+	suite.NoError(err)
+	suite.JSONEq(`{
+		"branch": "NAVY",
+		"originDutyLocation": ["Fort Eisenhower, GA 30813"],
+		"appearedInTooAt": "2025-07-01T00:00:00Z",
+		"status": ["SUBMITTED"]
+	}`, string(value.([]byte)))
+
+	var scanned models.QueueViewFilters
+	suite.NoError(scanned.Scan(value))
+	suite.Equal(filters, scanned)
+}
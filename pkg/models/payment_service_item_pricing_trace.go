@@ -0,0 +1,77 @@
+package models
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"time"
+
+	"github.com/gobuffalo/pop/v6"
+	"github.com/gobuffalo/validate/v3"
+	"github.com/gobuffalo/validate/v3/validators"
+	"github.com/gofrs/uuid"
+	"github.com/pkg/errors"
+
+	"github.com/transcom/mymove/pkg/unit"
+)
+
+// PricingTraceParam is a named value used or produced while pricing a service item
+type PricingTraceParam struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// PricingTraceRow is a row of a re_* pricing table the rate engine priced a service item from
+type PricingTraceRow struct {
+	Table  string              `json:"table"`
+	ID     uuid.UUID           `json:"id"`
+	Values []PricingTraceParam `json:"values"`
+}
+
+// PricingTrace records how the rate engine derived the price of a service item: the params it was given,
+// the price and contract year rows it used, each calculation step and the params it returned
+type PricingTrace struct {
+	ServiceCode ReServiceCode       `json:"serviceCode"`
+	PriceCents  unit.Cents          `json:"priceCents"`
+	Inputs      []PricingTraceParam `json:"inputs"`
+	PriceRows   []PricingTraceRow   `json:"priceRows"`
+	Steps       []PricingTraceParam `json:"steps"`
+	Results     []PricingTraceParam `json:"results"`
+}
+
+// Value returns the trace as JSON
+func (t PricingTrace) Value() (driver.Value, error) {
+	return json.Marshal(t)
+}
+
+// Scan reads a trace from JSON
+func (t *PricingTrace) Scan(value interface{}) error {
+	b, ok := value.([]byte)
+	if !ok {
+		return errors.New("type assertion to []byte failed")
+	}
+	return json.Unmarshal(b, t)
+}
+
+// PaymentServiceItemPricingTrace is the calculation trace recorded when a payment service item was priced
+type PaymentServiceItemPricingTrace struct {
+	ID                   uuid.UUID          `db:"id"`
+	PaymentServiceItemID uuid.UUID          `db:"payment_service_item_id"`
+	PaymentServiceItem   PaymentServiceItem `belongs_to:"payment_service_item" fk_id:"payment_service_item_id"`
+	Trace                PricingTrace       `db:"trace"`
+	CreatedAt            time.Time          `db:"created_at"`
+}
+
+// TableName overrides the table name used by Pop.
+func (p PaymentServiceItemPricingTrace) TableName() string {
+	return "payment_service_item_pricing_traces"
+}
+
+type PaymentServiceItemPricingTraces []PaymentServiceItemPricingTrace
+
+// Validate gets run every time you call a "pop.Validate*" (pop.ValidateAndSave, pop.ValidateAndCreate, pop.ValidateAndUpdate) method.
+func (p *PaymentServiceItemPricingTrace) Validate(_ *pop.Connection) (*validate.Errors, error) {
+	return validate.Validate(
+		&validators.UUIDIsPresent{Field: p.PaymentServiceItemID, Name: "PaymentServiceItemID"},
+		&validators.StringIsPresent{Field: string(p.Trace.ServiceCode), Name: "ServiceCode"},
+	), nil
+}
@@ -252,3 +252,39 @@ func (suite *ModelSuite) TestGeneratePSIReferenceID() {
 		suite.Equal("cannot find unique PSI reference ID", err.Error())
 	})
 }
+
+func (suite *ModelSuite) TestPaymentServiceItemPricingTraceValidation() {
+	suite.Run("test valid PaymentServiceItemPricingTrace", func() {
+		pricingTrace := models.PaymentServiceItemPricingTrace{
+			PaymentServiceItemID: uuid.Must(uuid.NewV4()),
+			Trace:                models.PricingTrace{ServiceCode: models.ReServiceCodeDLH},
+		}
+
+		expErrors := map[string][]string{}
+		suite.verifyValidationErrors(&pricingTrace, expErrors, nil)
+	})
+
+	suite.Run("test empty PaymentServiceItemPricingTrace", func() {
+		pricingTrace := models.PaymentServiceItemPricingTrace{}
+
+		expErrors := map[string][]string{
+			"payment_service_item_id": {"PaymentServiceItemID can not be blank."},
+			"service_code":            {"ServiceCode can not be blank."},
+		}
+		suite.verifyValidationErrors(&pricingTrace, expErrors, nil)
+	})
+
+	suite.Run("test trace round trips through JSON", func() {
+		trace := models.PricingTrace{
+			ServiceCode: models.ReServiceCodeFSC,
+			PriceCents:  unit.Cents(1234),
+			Inputs:      []models.PricingTraceParam{{Key: "EIAFuelPrice", Value: "281400"}},
+		}
+		value, err := trace.Value()
+		suite.NoError(err)
+
+		var scanned models.PricingTrace
+		suite.NoError(scanned.Scan(value))
+		suite.Equal(trace, scanned)
+	})
+}
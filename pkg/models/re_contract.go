@@ -16,11 +16,13 @@ import (
 
 // ReContract represents a contract with pricing information
 type ReContract struct {
-	ID        uuid.UUID `json:"id" db:"id"`
-	Code      string    `json:"code" db:"code"`
-	Name      string    `json:"name" db:"name"`
-	CreatedAt time.Time `json:"created_at" db:"created_at"`
-	UpdatedAt time.Time `json:"updated_at" db:"updated_at"`
+	ID             uuid.UUID  `json:"id" db:"id"`
+	Code           string     `json:"code" db:"code"`
+	Name           string     `json:"name" db:"name"`
+	EffectiveDate  *time.Time `json:"effective_date" db:"effective_date"`
+	ExpirationDate *time.Time `json:"expiration_date" db:"expiration_date"`
+	CreatedAt      time.Time  `json:"created_at" db:"created_at"`
+	UpdatedAt      time.Time  `json:"updated_at" db:"updated_at"`
 }
 
 // TableName overrides the table name used by Pop.
@@ -32,10 +34,37 @@ type ReContracts []ReContract
 
 // Validate gets run every time you call a "pop.Validate*" (pop.ValidateAndSave, pop.ValidateAndCreate, pop.ValidateAndUpdate) method.
 func (r *ReContract) Validate(_ *pop.Connection) (*validate.Errors, error) {
-	return validate.Validate(
+	vs := []validate.Validator{
 		&validators.StringIsPresent{Field: r.Code, Name: "Code"},
 		&validators.StringIsPresent{Field: r.Name, Name: "Name"},
-	), nil
+	}
+	if r.EffectiveDate != nil && r.ExpirationDate != nil {
+		vs = append(vs, &validators.TimeAfterTime{FirstTime: *r.ExpirationDate, FirstName: "ExpirationDate", SecondTime: *r.EffectiveDate, SecondName: "EffectiveDate"})
+	}
+	return validate.Validate(vs...), nil
+}
+
+// FetchContractInEffect returns the contract that prices services on the given date. Several
+// contracts may have contract years covering the same date, e.g. when a modified rate table has
+// been loaded to compare against the current one, so only contracts whose effective window
+// includes the date are considered and the one that took effect most recently wins.
+func FetchContractInEffect(appCtx appcontext.AppContext, date time.Time) (ReContract, error) {
+	var contract ReContract
+	err := appCtx.DB().Q().
+		Join("re_contract_years", "re_contract_years.contract_id = re_contracts.id").
+		Where("? between re_contract_years.start_date and re_contract_years.end_date", date).
+		Where("(re_contracts.effective_date IS NULL OR re_contracts.effective_date <= ?::date)", date).
+		Where("(re_contracts.expiration_date IS NULL OR re_contracts.expiration_date >= ?::date)", date).
+		Order("re_contracts.effective_date DESC NULLS LAST, re_contract_years.start_date DESC").
+		First(&contract)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return ReContract{}, apperror.NewNotFoundError(uuid.Nil, fmt.Sprintf("no contract year found for %s", date.String()))
+		}
+		return ReContract{}, err
+	}
+
+	return contract, nil
 }
 
 func FetchContractForMove(appCtx appcontext.AppContext, moveID uuid.UUID) (ReContract, error) {
@@ -52,15 +81,5 @@ func FetchContractForMove(appCtx appcontext.AppContext, moveID uuid.UUID) (ReCon
 		return ReContract{}, apperror.NewConflictError(moveID, "unable to pick contract because move is not available to prime")
 	}
 
-	var contractYear ReContractYear
-	err = appCtx.DB().EagerPreload("Contract").Where("? between start_date and end_date", move.AvailableToPrimeAt).
-		First(&contractYear)
-	if err != nil {
-		if err == sql.ErrNoRows {
-			return ReContract{}, apperror.NewNotFoundError(uuid.Nil, fmt.Sprintf("no contract year found for %s", move.AvailableToPrimeAt.String()))
-		}
-		return ReContract{}, err
-	}
-
-	return contractYear.Contract, nil
+	return FetchContractInEffect(appCtx, *move.AvailableToPrimeAt)
 }
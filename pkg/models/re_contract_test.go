@@ -25,6 +25,34 @@ func (suite *ModelSuite) TestReContractValidations() {
 		}
 		suite.verifyValidationErrors(&emptyReContract, expErrors, nil)
 	})
+
+	suite.Run("test ReContract with an effective window", func() {
+		effectiveDate := time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC)
+		expirationDate := time.Date(2026, time.February, 28, 0, 0, 0, 0, time.UTC)
+		reContract := models.ReContract{
+			Code:           "ABC",
+			Name:           "ABC, Inc.",
+			EffectiveDate:  &effectiveDate,
+			ExpirationDate: &expirationDate,
+		}
+		expErrors := map[string][]string{}
+		suite.verifyValidationErrors(&reContract, expErrors, nil)
+	})
+
+	suite.Run("test ReContract that expires before it takes effect", func() {
+		effectiveDate := time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC)
+		expirationDate := effectiveDate.AddDate(0, 0, -1)
+		reContract := models.ReContract{
+			Code:           "ABC",
+			Name:           "ABC, Inc.",
+			EffectiveDate:  &effectiveDate,
+			ExpirationDate: &expirationDate,
+		}
+		expErrors := map[string][]string{
+			"expiration_date": {"ExpirationDate must be after EffectiveDate."},
+		}
+		suite.verifyValidationErrors(&reContract, expErrors, nil)
+	})
 }
 
 func (suite *ModelSuite) TestFetchContractForMove() {
@@ -0,0 +1,57 @@
+package roles
+
+import (
+	"time"
+
+	"github.com/gobuffalo/pop/v6"
+	"github.com/gobuffalo/validate/v3"
+	"github.com/gobuffalo/validate/v3/validators"
+	"github.com/gofrs/uuid"
+)
+
+// Permission represents an action office users may take, checked against the
+// x-permissions of the office API operations
+type Permission struct {
+	ID            uuid.UUID `json:"id" db:"id"`
+	PermissionKey string    `json:"permission_key" db:"permission_key"`
+	Description   *string   `json:"description" db:"description"`
+	CreatedAt     time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at" db:"updated_at"`
+	Roles         Roles     `json:"roles" many_to_many:"roles_permissions"`
+}
+
+// TableName overrides the table name used by Pop.
+func (p Permission) TableName() string {
+	return "permissions"
+}
+
+// Permissions is a slice of Permission objects
+type Permissions []Permission
+
+// Keys returns the permission keys, as stored in the session
+func (ps Permissions) Keys() []string {
+	keys := make([]string, 0, len(ps))
+	for _, p := range ps {
+		keys = append(keys, p.PermissionKey)
+	}
+	return keys
+}
+
+// Validate gets run every time you call a "pop.Validate*" (pop.ValidateAndSave, pop.ValidateAndCreate, pop.ValidateAndUpdate) method.
+func (p *Permission) Validate(_ *pop.Connection) (*validate.Errors, error) {
+	return validate.Validate(
+		&validators.StringIsPresent{Field: p.PermissionKey, Name: "PermissionKey"},
+	), nil
+}
+
+// FetchPermissionsForRole gets the permissions granted to a role type
+func FetchPermissionsForRole(db *pop.Connection, roleType RoleType) (Permissions, error) {
+	var permissions Permissions
+
+	err := db.Q().Join("roles_permissions", "roles_permissions.permission_id = permissions.id").
+		Join("roles", "roles.id = roles_permissions.role_id").
+		Where("roles.role_type = ?", roleType).
+		Order("permissions.permission_key ASC").
+		All(&permissions)
+	return permissions, err
+}
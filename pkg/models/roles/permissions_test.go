@@ -0,0 +1,38 @@
+package roles_test
+
+import (
+	"github.com/transcom/mymove/pkg/models/roles"
+)
+
+func (suite *RolesSuite) TestFetchPermissionsForRole() {
+	suite.Run("returns the seeded permissions of a role", func() {
+		permissions, err := roles.FetchPermissionsForRole(suite.DB(), roles.RoleTypeTOO)
+		suite.NoError(err)
+		suite.Contains(permissions.Keys(), "update.move")
+		suite.NotContains(permissions.Keys(), "update.paymentRequest")
+	})
+
+	suite.Run("returns nothing for a role without office permissions", func() {
+		permissions, err := roles.FetchPermissionsForRole(suite.DB(), roles.RoleTypePrime)
+		suite.NoError(err)
+		suite.Empty(permissions)
+	})
+}
+
+func (suite *RolesSuite) TestPermissionValidation() {
+	suite.Run("permission key is required", func() {
+		permission := roles.Permission{}
+		verrs, err := permission.Validate(nil)
+		suite.NoError(err)
+		suite.True(verrs.HasAny())
+		suite.NotEmpty(verrs.Get("permission_key"))
+	})
+
+	suite.Run("keys are returned in order", func() {
+		permissions := roles.Permissions{
+			{PermissionKey: "read.paymentRequest"},
+			{PermissionKey: "update.move"},
+		}
+		suite.Equal([]string{"read.paymentRequest", "update.move"}, permissions.Keys())
+	})
+}
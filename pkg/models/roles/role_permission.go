@@ -0,0 +1,37 @@
+package roles
+
+import (
+	"time"
+
+	"github.com/gobuffalo/pop/v6"
+	"github.com/gobuffalo/validate/v3"
+	"github.com/gobuffalo/validate/v3/validators"
+	"github.com/gofrs/uuid"
+)
+
+// RolePermission represents a role->permission mapping
+type RolePermission struct {
+	ID           uuid.UUID  `db:"id"`
+	RoleID       uuid.UUID  `db:"role_id"`
+	Role         Role       `belongs_to:"roles" fk_id:"role_id"`
+	PermissionID uuid.UUID  `db:"permission_id"`
+	Permission   Permission `belongs_to:"permissions" fk_id:"permission_id"`
+	CreatedAt    time.Time  `db:"created_at"`
+	UpdatedAt    time.Time  `db:"updated_at"`
+}
+
+// TableName overrides the table name used by Pop.
+func (rp RolePermission) TableName() string {
+	return "roles_permissions"
+}
+
+// RolePermissions is a slice of RolePermission objects
+type RolePermissions []RolePermission
+
+// Validate gets run every time you call a "pop.Validate*" (pop.ValidateAndSave, pop.ValidateAndCreate, pop.ValidateAndUpdate) method.
+func (rp *RolePermission) Validate(_ *pop.Connection) (*validate.Errors, error) {
+	return validate.Validate(
+		&validators.UUIDIsPresent{Field: rp.RoleID, Name: "RoleID"},
+		&validators.UUIDIsPresent{Field: rp.PermissionID, Name: "PermissionID"},
+	), nil
+}
@@ -33,6 +33,34 @@ func GetAVStatusFromTags(tags map[string]string) AVStatusType {
 	return AVStatusType(AVStatusPROCESSING)
 }
 
+// UploadScanStatus represents the state of a virus scan run by the application on an upload
+type UploadScanStatus string
+
+const (
+	// UploadScanStatusPENDING is waiting to be scanned
+	UploadScanStatusPENDING UploadScanStatus = "PENDING"
+	// UploadScanStatusSCANNING is being scanned
+	UploadScanStatusSCANNING UploadScanStatus = "SCANNING"
+	// UploadScanStatusCLEAN was scanned and no threat was found
+	UploadScanStatusCLEAN UploadScanStatus = "CLEAN"
+	// UploadScanStatusINFECTED was scanned and a threat was found
+	UploadScanStatusINFECTED UploadScanStatus = "INFECTED"
+	// UploadScanStatusFAILED could not be scanned and can be retried
+	UploadScanStatusFAILED UploadScanStatus = "FAILED"
+)
+
+// AVStatus returns the anti-virus status reported to clients for the scan status
+func (s UploadScanStatus) AVStatus() AVStatusType {
+	switch s {
+	case UploadScanStatusCLEAN:
+		return AVStatusCLEAN
+	case UploadScanStatusINFECTED:
+		return AVStatusINFECTED
+	default:
+		return AVStatusPROCESSING
+	}
+}
+
 // UploadType represents the type of upload this is, whether is it uploaded for a User or for the Prime
 type UploadType string
 
@@ -58,6 +86,11 @@ type Upload struct {
 	CreatedAt   time.Time  `db:"created_at"`
 	UpdatedAt   time.Time  `db:"updated_at"`
 	DeletedAt   *time.Time `db:"deleted_at"`
+
+	// ScanStatus is nil when the anti-virus status comes from the tags on the stored object
+	ScanStatus    *UploadScanStatus `db:"scan_status"`
+	ScanSignature *string           `db:"scan_signature"`
+	ScannedAt     *time.Time        `db:"scanned_at"`
 }
 
 // TableName overrides the table name used by Pop.
@@ -81,9 +114,70 @@ func (u *Upload) Validate(_ *pop.Connection) (*validate.Errors, error) {
 		&validators.StringIsPresent{Field: u.ContentType, Name: "ContentType"},
 		&validators.StringIsPresent{Field: u.Checksum, Name: "Checksum"},
 	)
+	if u.ScanStatus != nil {
+		vs = append(vs, &validators.StringInclusion{Field: string(*u.ScanStatus), Name: "ScanStatus", List: []string{
+			string(UploadScanStatusPENDING),
+			string(UploadScanStatusSCANNING),
+			string(UploadScanStatusCLEAN),
+			string(UploadScanStatusINFECTED),
+			string(UploadScanStatusFAILED),
+		}})
+	}
 	return validate.Validate(vs...), nil
 }
 
+// State Machine
+// Avoid calling Upload.ScanStatus = ... ever. Use these methods to change the state.
+
+// QueueScan marks a new upload as waiting to be scanned
+func (u *Upload) QueueScan() error {
+	if u.ScanStatus != nil {
+		return errors.Wrap(ErrInvalidTransition, "QueueScan")
+	}
+
+	status := UploadScanStatusPENDING
+	u.ScanStatus = &status
+	return nil
+}
+
+// StartScan marks the upload as being scanned. Scans that failed can be started again.
+func (u *Upload) StartScan() error {
+	if u.ScanStatus == nil || (*u.ScanStatus != UploadScanStatusPENDING && *u.ScanStatus != UploadScanStatusFAILED) {
+		return errors.Wrap(ErrInvalidTransition, "StartScan")
+	}
+
+	status := UploadScanStatusSCANNING
+	u.ScanStatus = &status
+	return nil
+}
+
+// FinishScanClean records that no threat was found
+func (u *Upload) FinishScanClean() error {
+	return u.finishScan("FinishScanClean", UploadScanStatusCLEAN, nil)
+}
+
+// FinishScanInfected records that a threat matching the signature was found
+func (u *Upload) FinishScanInfected(signature string) error {
+	return u.finishScan("FinishScanInfected", UploadScanStatusINFECTED, &signature)
+}
+
+// FinishScanFailed records that the scan could not complete
+func (u *Upload) FinishScanFailed() error {
+	return u.finishScan("FinishScanFailed", UploadScanStatusFAILED, nil)
+}
+
+func (u *Upload) finishScan(transition string, status UploadScanStatus, signature *string) error {
+	if u.ScanStatus == nil || *u.ScanStatus != UploadScanStatusSCANNING {
+		return errors.Wrap(ErrInvalidTransition, transition)
+	}
+
+	now := time.Now()
+	u.ScanStatus = &status
+	u.ScanSignature = signature
+	u.ScannedAt = &now
+	return nil
+}
+
 // BeforeCreate populates the StorageKey on a newly created UserUpload
 func (u *Upload) BeforeCreate(_ *pop.Connection) error {
 	// Populate ID if not exists
@@ -112,6 +206,26 @@ func FetchUpload(dbConn *pop.Connection, uploadID uuid.UUID) (*Upload, error) {
 	return &upload, nil
 }
 
+// CountUploadStorageKeyReferences returns the number of uploads that have not been deleted
+// and whose file is stored at the given key. Content-addressed keys can be shared by many
+// uploads, so the stored object is only safe to remove once this reaches zero.
+func CountUploadStorageKeyReferences(dbConn *pop.Connection, storageKey string) (int, error) {
+	count, err := dbConn.Q().
+		Where("storage_key = ? AND deleted_at IS NULL", storageKey).
+		Count(&Upload{})
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// LockUploadStorageKey takes a transaction-scoped advisory lock on the storage key so that
+// checking a key's references and storing or deleting its object can't interleave with
+// another transaction doing the same for that key.
+func LockUploadStorageKey(dbConn *pop.Connection, storageKey string) error {
+	return dbConn.RawQuery("SELECT pg_advisory_xact_lock(hashtextextended(?, 0))", storageKey).Exec()
+}
+
 // DeleteUpload deletes an upload from the database
 func DeleteUpload(dbConn *pop.Connection, upload *Upload) error {
 	if dbConn.TX != nil {
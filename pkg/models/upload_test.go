@@ -34,3 +34,49 @@ func (suite *ModelSuite) Test_UploadValidationErrors() {
 
 	suite.verifyValidationErrors(upload, expErrors, nil)
 }
+
+func (suite *ModelSuite) Test_UploadScanStatusTransitions() {
+	upload := models.Upload{}
+
+	suite.Error(upload.StartScan(), "an upload that was never queued cannot be scanned")
+
+	suite.NoError(upload.QueueScan())
+	suite.Equal(models.UploadScanStatusPENDING, *upload.ScanStatus)
+	suite.Equal(models.AVStatusPROCESSING, upload.ScanStatus.AVStatus())
+	suite.Error(upload.QueueScan())
+	suite.Error(upload.FinishScanClean(), "a scan cannot finish before it starts")
+
+	suite.NoError(upload.StartScan())
+	suite.NoError(upload.FinishScanFailed())
+	suite.Equal(models.UploadScanStatusFAILED, *upload.ScanStatus)
+
+	// Failed scans can be retried
+	suite.NoError(upload.StartScan())
+	suite.NoError(upload.FinishScanInfected("Eicar-Test-Signature"))
+	suite.Equal(models.UploadScanStatusINFECTED, *upload.ScanStatus)
+	suite.Equal(models.AVStatusINFECTED, upload.ScanStatus.AVStatus())
+	suite.Equal("Eicar-Test-Signature", *upload.ScanSignature)
+	suite.NotNil(upload.ScannedAt)
+
+	// Finished scans are final
+	suite.Error(upload.StartScan())
+	suite.Error(upload.FinishScanClean())
+}
+
+func (suite *ModelSuite) Test_UploadScanStatusValidation() {
+	status := models.UploadScanStatus("MAYBE")
+	upload := models.Upload{
+		ID:          uuid.Must(uuid.NewV4()),
+		Filename:    "test.pdf",
+		Bytes:       1048576,
+		ContentType: uploader.FileTypePDF,
+		Checksum:    "ImGQ2Ush0bDHsaQthV5BnQ==",
+		UploadType:  models.UploadTypeUSER,
+		ScanStatus:  &status,
+	}
+
+	var expErrors = map[string][]string{
+		"scan_status": {"ScanStatus is not in the list [PENDING, SCANNING, CLEAN, INFECTED, FAILED]."},
+	}
+	suite.verifyValidationErrors(&upload, expErrors, nil)
+}
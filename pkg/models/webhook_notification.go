@@ -28,6 +28,9 @@ const (
 	WebhookNotificationFailing WebhookNotificationStatus = "FAILING"
 	// WebhookNotificationFailed is the failed status type for a WebhookNotification
 	WebhookNotificationFailed WebhookNotificationStatus = "FAILED"
+	// WebhookNotificationDeadLetter is the dead letter status type for a WebhookNotification
+	// - indicates we gave up retrying the send, it will only be sent again if it is replayed
+	WebhookNotificationDeadLetter WebhookNotificationStatus = "DEAD_LETTER"
 )
 
 // WebhookNotification is used by pop to map your webhook_notifications database table to your go code.
@@ -43,6 +46,8 @@ type WebhookNotification struct {
 	CreatedAt        time.Time                 `db:"created_at"`
 	UpdatedAt        time.Time                 `db:"updated_at"`
 	FirstAttemptedAt *time.Time                `db:"first_attempted_at"`
+	AttemptCount     int                       `db:"attempt_count"`
+	LastError        *string                   `db:"last_error"`
 }
 
 // TableName overrides the table name used by Pop.
@@ -74,6 +79,8 @@ func (w *WebhookNotification) Validate(_ *pop.Connection) (*validate.Errors, err
 			string(WebhookNotificationSkipped),
 			string(WebhookNotificationFailing),
 			string(WebhookNotificationFailed),
+			string(WebhookNotificationDeadLetter),
 		}},
+		&validators.IntIsGreaterThan{Field: w.AttemptCount, Name: "AttemptCount", Compared: -1},
 	), nil
 }
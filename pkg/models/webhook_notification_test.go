@@ -55,14 +55,16 @@ func (suite *ModelSuite) TestWebhookNotification() {
 	suite.Run("test notification with validation errors", func() {
 		trace := uuid.Must(uuid.NewV4())
 		newNotification := models.WebhookNotification{
-			EventKey: "",
-			TraceID:  &trace,
-			Payload:  "",
-			Status:   "NEW",
+			EventKey:     "",
+			TraceID:      &trace,
+			Payload:      "",
+			Status:       "NEW",
+			AttemptCount: -1,
 		}
 
 		expErrors := map[string][]string{}
-		expErrors["status"] = []string{"Status is not in the list [PENDING, SENT, SKIPPED, FAILING, FAILED]."}
+		expErrors["status"] = []string{"Status is not in the list [PENDING, SENT, SKIPPED, FAILING, FAILED, DEAD_LETTER]."}
+		expErrors["attempt_count"] = []string{"-1 is not greater than -1."}
 		expErrors["event_key"] = []string{"Eventkey should be in Subject.Action format."}
 		expErrors["payload"] = []string{"Payload can not be blank."}
 
@@ -25,15 +25,21 @@ type WebhookSubscriptionStatus string
 
 // A WebhookSubscription represents a webhook subscription
 type WebhookSubscription struct {
-	ID           uuid.UUID                 `db:"id"`
-	Subscriber   Contractor                `belongs_to:"contractors" fk_id:"subscriber_id"`
-	SubscriberID uuid.UUID                 `db:"subscriber_id"`
-	Status       WebhookSubscriptionStatus `db:"status"`
-	Severity     int                       `db:"severity"` // Zero indicates no severity value, 1 is highest
-	EventKey     string                    `db:"event_key"`
-	CallbackURL  string                    `db:"callback_url"`
-	CreatedAt    time.Time                 `db:"created_at"`
-	UpdatedAt    time.Time                 `db:"updated_at"`
+	ID                  uuid.UUID                 `db:"id"`
+	Subscriber          Contractor                `belongs_to:"contractors" fk_id:"subscriber_id"`
+	SubscriberID        uuid.UUID                 `db:"subscriber_id"`
+	Status              WebhookSubscriptionStatus `db:"status"`
+	Severity            int                       `db:"severity"` // Zero indicates no severity value, 1 is highest
+	EventKey            string                    `db:"event_key"`
+	CallbackURL         string                    `db:"callback_url"`
+	ConsecutiveFailures int                       `db:"consecutive_failures"`
+	NextAttemptAt       *time.Time                `db:"next_attempt_at"` // Nil unless the subscription is backing off
+	// Secrets are kept out of JSON so they can't end up in logs
+	SigningSecret                  *string    `json:"-" db:"signing_secret"`
+	PreviousSigningSecret          *string    `json:"-" db:"previous_signing_secret"`
+	PreviousSigningSecretExpiresAt *time.Time `db:"previous_signing_secret_expires_at"`
+	CreatedAt                      time.Time  `db:"created_at"`
+	UpdatedAt                      time.Time  `db:"updated_at"`
 }
 
 // TableName overrides the table name used by Pop.
@@ -55,5 +61,20 @@ func (wS *WebhookSubscription) Validate(_ *pop.Connection) (*validate.Errors, er
 			string(WebhookSubscriptionStatusDisabled),
 			string(WebhookSubscriptionStatusFailing),
 		}},
+		&validators.IntIsGreaterThan{Field: wS.ConsecutiveFailures, Name: "ConsecutiveFailures", Compared: -1},
 	), nil
 }
+
+// ActiveSigningSecrets returns the secrets deliveries should be signed with, newest first. The previous secret is
+// included until it expires so the subscriber can rotate without dropping deliveries.
+func (wS *WebhookSubscription) ActiveSigningSecrets(now time.Time) []string {
+	var secrets []string
+	if wS.SigningSecret != nil && *wS.SigningSecret != "" {
+		secrets = append(secrets, *wS.SigningSecret)
+	}
+	if wS.PreviousSigningSecret != nil && *wS.PreviousSigningSecret != "" &&
+		wS.PreviousSigningSecretExpiresAt != nil && now.Before(*wS.PreviousSigningSecretExpiresAt) {
+		secrets = append(secrets, *wS.PreviousSigningSecret)
+	}
+	return secrets
+}
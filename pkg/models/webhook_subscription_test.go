@@ -1,6 +1,8 @@
 package models_test
 
 import (
+	"time"
+
 	"github.com/transcom/mymove/pkg/models"
 	"github.com/transcom/mymove/pkg/testdatagen"
 )
@@ -53,3 +55,26 @@ func (suite *ModelSuite) TestWebhookSubscription_Instantiation() {
 	})
 
 }
+
+func (suite *ModelSuite) TestWebhookSubscription_ActiveSigningSecrets() {
+	now := time.Now()
+	later := now.Add(time.Hour)
+	earlier := now.Add(-time.Hour)
+
+	suite.Run("No secrets", func() {
+		webhookSubscription := models.WebhookSubscription{}
+		suite.Empty(webhookSubscription.ActiveSigningSecrets(now))
+	})
+
+	suite.Run("Previous secret is used until it expires", func() {
+		webhookSubscription := models.WebhookSubscription{
+			SigningSecret:                  models.StringPointer("new"),
+			PreviousSigningSecret:          models.StringPointer("old"),
+			PreviousSigningSecretExpiresAt: &later,
+		}
+		suite.Equal([]string{"new", "old"}, webhookSubscription.ActiveSigningSecrets(now))
+
+		webhookSubscription.PreviousSigningSecretExpiresAt = &earlier
+		suite.Equal([]string{"new"}, webhookSubscription.ActiveSigningSecrets(now))
+	})
+}
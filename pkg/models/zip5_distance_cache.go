@@ -0,0 +1,39 @@
+package models
+
+import (
+	"time"
+
+	"github.com/gobuffalo/pop/v6"
+	"github.com/gobuffalo/validate/v3"
+	"github.com/gobuffalo/validate/v3/validators"
+	"github.com/gofrs/uuid"
+)
+
+// Zip5DistanceCache holds the last known DTOD mileage between two zip5s
+type Zip5DistanceCache struct {
+	ID            uuid.UUID `json:"id" db:"id"`
+	FromZip5      string    `json:"from_zip5" db:"from_zip5"`
+	ToZip5        string    `json:"to_zip5" db:"to_zip5"`
+	DistanceMiles int       `json:"distance_miles" db:"distance_miles"`
+	CreatedAt     time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at" db:"updated_at"`
+}
+
+// TableName overrides the table name used by Pop.
+func (z Zip5DistanceCache) TableName() string {
+	return "zip5_distance_cache"
+}
+
+// Validate gets run every time you call a "pop.Validate*" (pop.ValidateAndSave, pop.ValidateAndCreate, pop.ValidateAndUpdate) method.
+func (z *Zip5DistanceCache) Validate(_ *pop.Connection) (*validate.Errors, error) {
+	return validate.Validate(
+		&validators.StringLengthInRange{Field: z.FromZip5, Name: "FromZip5", Min: 5, Max: 5},
+		&validators.StringLengthInRange{Field: z.ToZip5, Name: "ToZip5", Min: 5, Max: 5},
+		&validators.IntIsGreaterThan{Field: z.DistanceMiles, Name: "DistanceMiles", Compared: 0},
+	), nil
+}
+
+// IsExpired returns true if the cached mileage is older than the ttl
+func (z Zip5DistanceCache) IsExpired(now time.Time, ttl time.Duration) bool {
+	return now.After(z.UpdatedAt.Add(ttl))
+}
@@ -0,0 +1,37 @@
+package models_test
+
+import (
+	"time"
+
+	"github.com/transcom/mymove/pkg/models"
+)
+
+func (suite *ModelSuite) TestZip5DistanceCacheValidations() {
+	suite.Run("test valid Zip5DistanceCache", func() {
+		validZip5DistanceCache := models.Zip5DistanceCache{
+			FromZip5:      "30907",
+			ToZip5:        "29212",
+			DistanceMiles: 69,
+		}
+		expErrors := map[string][]string{}
+		suite.verifyValidationErrors(&validZip5DistanceCache, expErrors, nil)
+	})
+
+	suite.Run("test invalid Zip5DistanceCache", func() {
+		emptyZip5DistanceCache := models.Zip5DistanceCache{}
+		expErrors := map[string][]string{
+			"from_zip5":      {"FromZip5 not in range(5, 5)"},
+			"to_zip5":        {"ToZip5 not in range(5, 5)"},
+			"distance_miles": {"0 is not greater than 0."},
+		}
+		suite.verifyValidationErrors(&emptyZip5DistanceCache, expErrors, nil)
+	})
+}
+
+func (suite *ModelSuite) TestZip5DistanceCacheIsExpired() {
+	now := time.Now()
+	cached := models.Zip5DistanceCache{UpdatedAt: now.Add(-2 * time.Hour)}
+
+	suite.False(cached.IsExpired(now, 3*time.Hour))
+	suite.True(cached.IsExpired(now, time.Hour))
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/gofrs/uuid"
+	"go.uber.org/zap"
 
 	"github.com/transcom/mymove/pkg/appcontext"
 	"github.com/transcom/mymove/pkg/apperror"
@@ -26,27 +27,28 @@ func (r EIAFuelPriceLookup) lookup(appCtx appcontext.AppContext, _ *ServiceItemP
 	}
 
 	// Find the GHCDieselFuelPrice object effective before the shipment's ActualPickupDate and ends after the ActualPickupDate
-	var ghcDieselFuelPrice models.GHCDieselFuelPrice
-	err := db.Where("? BETWEEN effective_date and end_date", actualPickupDate).Order("publication_date DESC").First(&ghcDieselFuelPrice) //only want the first published price per week
+	ghcDieselFuelPrice, usedEarlierPrice, err := models.FetchGHCDieselFuelPriceForPickupDate(db, *actualPickupDate)
 	if err != nil {
 		switch err {
 		case sql.ErrNoRows:
-			// If no published price is found, look for the first published price after the actual pickup date
-			err = db.Where("publication_date <= ?", actualPickupDate).Order("publication_date DESC").Last(&ghcDieselFuelPrice)
-			if err != nil {
-				switch err {
-				case sql.ErrNoRows:
-					return "", apperror.NewNotFoundError(uuid.Nil, "Looking for GHCDieselFuelPrice")
-				default:
-					return "", apperror.NewQueryError("GHCDieselFuelPrice", err, "")
-				}
-			}
+			return "", apperror.NewNotFoundError(uuid.Nil, "Looking for GHCDieselFuelPrice")
 		default:
 			return "", apperror.NewQueryError("GHCDieselFuelPrice", err, "")
 		}
 	}
+	if usedEarlierPrice {
+		// a missing week means the fuel surcharge is priced with an older price; backfill the week with
+		// save-ghc-fuel-price-data to fix it
+		appCtx.Logger().Warn("no diesel fuel price covers the pickup date, using an earlier price",
+			zap.Time("actual_pickup_date", *actualPickupDate),
+			zap.Time("publication_date", ghcDieselFuelPrice.PublicationDate))
+	}
 
 	value := fmt.Sprintf("%d", ghcDieselFuelPrice.FuelPriceInMillicents.Int())
 
 	return value, nil
 }
+
+func (r EIAFuelPriceLookup) ParamValue(appCtx appcontext.AppContext) (string, error) {
+	return r.lookup(appCtx, nil)
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/transcom/mymove/pkg/appcontext"
 	"github.com/transcom/mymove/pkg/models"
+	"github.com/transcom/mymove/pkg/unit"
 )
 
 const weightBasedDistanceMultiplierLevelOne = "0.000417"
@@ -29,14 +30,19 @@ func (r FSCWeightBasedDistanceMultiplierLookup) lookup(appCtx appcontext.AppCont
 		return "", fmt.Errorf("could not convert WeightBilledLookup [%s] to integer", weight)
 	}
 
+	return FSCWeightBasedDistanceMultiplier(unit.Pound(weightBilled)), nil
+}
+
+// FSCWeightBasedDistanceMultiplier returns the fuel surcharge weight based distance multiplier for a billed weight
+func FSCWeightBasedDistanceMultiplier(weightBilled unit.Pound) string {
 	if weightBilled <= 5000 {
-		return weightBasedDistanceMultiplierLevelOne, nil
+		return weightBasedDistanceMultiplierLevelOne
 	} else if weightBilled <= 10000 {
-		return weightBasedDistanceMultiplierLevelTwo, nil
+		return weightBasedDistanceMultiplierLevelTwo
 	} else if weightBilled <= 24000 {
-		return weightBasedDistanceMultiplierLevelThree, nil
+		return weightBasedDistanceMultiplierLevelThree
 		//nolint:revive
 	} else {
-		return weightBasedDistanceMultiplierLevelFour, nil
+		return weightBasedDistanceMultiplierLevelFour
 	}
 }
@@ -691,15 +691,5 @@ func fetchContractForMove(appCtx appcontext.AppContext, moveID uuid.UUID) (model
 }
 
 func FetchContract(appCtx appcontext.AppContext, date time.Time) (models.ReContract, error) {
-	var contractYear models.ReContractYear
-	err := appCtx.DB().EagerPreload("Contract").Where("? between start_date and end_date", date).
-		First(&contractYear)
-	if err != nil {
-		if err == sql.ErrNoRows {
-			return models.ReContract{}, apperror.NewNotFoundError(uuid.Nil, fmt.Sprintf("no contract year found for %s", date.String()))
-		}
-		return models.ReContract{}, err
-	}
-
-	return contractYear.Contract, nil
+	return models.FetchContractInEffect(appCtx, date)
 }
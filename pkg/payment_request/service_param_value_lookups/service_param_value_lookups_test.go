@@ -953,6 +953,22 @@ func (suite *ServiceParamValueLookupsSuite) TestFetchContract() {
 			},
 			ReContract: secondContract,
 		})
+
+		// a modified rate table for the second year of the first contract that takes effect part way through it
+		modifiedEffectiveDate := time.Date(2022, 3, 1, 0, 0, 0, 0, time.UTC)
+		modifiedContract := testdatagen.MakeReContract(suite.DB(), testdatagen.Assertions{
+			ReContract: models.ReContract{
+				Code:          "modified",
+				EffectiveDate: &modifiedEffectiveDate,
+			},
+		})
+		testdatagen.MakeReContractYear(suite.DB(), testdatagen.Assertions{
+			ReContractYear: models.ReContractYear{
+				StartDate: time.Date(2021, 9, 1, 0, 0, 0, 0, time.UTC),
+				EndDate:   time.Date(2022, 8, 31, 0, 0, 0, 0, time.UTC),
+			},
+			ReContract: modifiedContract,
+		})
 	}
 	type testCase struct {
 		date                 time.Time
@@ -984,6 +1000,24 @@ func (suite *ServiceParamValueLookupsSuite) TestFetchContract() {
 			expectedError:        nil,
 			description:          "second year of first contract",
 		},
+		{
+			date:                 time.Date(2022, 2, 28, 12, 0, 0, 0, time.UTC),
+			expectedContractCode: "first",
+			expectedError:        nil,
+			description:          "day before an overlapping contract takes effect",
+		},
+		{
+			date:                 time.Date(2022, 3, 1, 0, 0, 0, 0, time.UTC),
+			expectedContractCode: "modified",
+			expectedError:        nil,
+			description:          "overlapping contract that has taken effect",
+		},
+		{
+			date:                 time.Date(2022, 9, 1, 0, 0, 0, 0, time.UTC),
+			expectedContractCode: "second",
+			expectedError:        nil,
+			description:          "first year of second contract",
+		},
 		{
 			date:          time.Date(2023, 9, 1, 0, 0, 0, 0, time.UTC),
 			expectedError: apperror.NotFoundError{},
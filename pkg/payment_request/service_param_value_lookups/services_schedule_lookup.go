@@ -21,3 +21,7 @@ func (s ServicesScheduleLookup) lookup(appCtx appcontext.AppContext, keyData *Se
 
 	return strconv.Itoa(domesticServiceArea.ServicesSchedule), err
 }
+
+func (s ServicesScheduleLookup) ParamValue(appCtx appcontext.AppContext, contractCode string) (string, error) {
+	return s.lookup(appCtx, &ServiceItemParamKeyData{ContractCode: contractCode})
+}
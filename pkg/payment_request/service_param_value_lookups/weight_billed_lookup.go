@@ -198,6 +198,12 @@ func calculateMinimumBillableWeight(appCtx appcontext.AppContext, shipment model
 
 // Looks at code and applies minimum if necessary, otherwise returns weight passed in
 func applyMinimum(code models.ReServiceCode, shipmentType models.MTOShipmentType, weight int) string {
+	return fmt.Sprintf("%d", ApplyMinimumWeight(code, shipmentType, unit.Pound(weight)).Int())
+}
+
+// ApplyMinimumWeight returns the weight a service item is billed for, raising the weight to the minimum the
+// service is priced at for the shipment type
+func ApplyMinimumWeight(code models.ReServiceCode, shipmentType models.MTOShipmentType, weight unit.Pound) unit.Pound {
 	result := weight
 	switch shipmentType {
 	case models.MTOShipmentTypeUnaccompaniedBaggage:
@@ -255,5 +261,5 @@ func applyMinimum(code models.ReServiceCode, shipmentType models.MTOShipmentType
 			}
 		}
 	}
-	return fmt.Sprintf("%d", result)
+	return result
 }
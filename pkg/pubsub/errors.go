@@ -0,0 +1,6 @@
+package pubsub
+
+import "errors"
+
+// ErrClosed is returned when publishing or subscribing after the PubSub was closed
+var ErrClosed = errors.New("pubsub is closed")
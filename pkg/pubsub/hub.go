@@ -0,0 +1,113 @@
+package pubsub
+
+import (
+	"context"
+	"sync"
+)
+
+// subscriptionBufferSize is the number of undelivered messages held for a subscriber
+// before newer messages are dropped
+const subscriptionBufferSize = 16
+
+// hub fans messages out to the subscribers on this server. Every backend uses one and
+// only differs in how messages reach it.
+type hub struct {
+	mu          sync.Mutex
+	subscribers map[string]map[*subscription]struct{}
+	closed      bool
+}
+
+func newHub() *hub {
+	return &hub{
+		subscribers: make(map[string]map[*subscription]struct{}),
+	}
+}
+
+type subscription struct {
+	hub      *hub
+	topic    string
+	messages chan Message
+	done     chan struct{}
+	once     sync.Once
+}
+
+func (h *hub) subscribe(ctx context.Context, topic string) (*subscription, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.closed {
+		return nil, ErrClosed
+	}
+
+	sub := &subscription{
+		hub:      h,
+		topic:    topic,
+		messages: make(chan Message, subscriptionBufferSize),
+		done:     make(chan struct{}),
+	}
+	if h.subscribers[topic] == nil {
+		h.subscribers[topic] = make(map[*subscription]struct{})
+	}
+	h.subscribers[topic][sub] = struct{}{}
+
+	go func() {
+		select {
+		case <-ctx.Done():
+			sub.Close()
+		case <-sub.done:
+		}
+	}()
+
+	return sub, nil
+}
+
+// deliver hands the message to every subscriber of its topic without blocking
+func (h *hub) deliver(msg Message) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for sub := range h.subscribers[msg.Topic] {
+		select {
+		case sub.messages <- msg:
+		default:
+		}
+	}
+}
+
+// close closes every subscription and rejects new ones
+func (h *hub) close() {
+	h.mu.Lock()
+	subs := []*subscription{}
+	for _, topicSubs := range h.subscribers {
+		for sub := range topicSubs {
+			subs = append(subs, sub)
+		}
+	}
+	h.closed = true
+	h.mu.Unlock()
+
+	for _, sub := range subs {
+		sub.Close()
+	}
+}
+
+// Messages returns the channel messages are delivered on. It is closed with the
+// subscription.
+func (s *subscription) Messages() <-chan Message {
+	return s.messages
+}
+
+// Close stops delivery to the subscription
+func (s *subscription) Close() {
+	s.once.Do(func() {
+		s.hub.mu.Lock()
+		defer s.hub.mu.Unlock()
+
+		delete(s.hub.subscribers[s.topic], s)
+		if len(s.hub.subscribers[s.topic]) == 0 {
+			delete(s.hub.subscribers, s.topic)
+		}
+		close(s.done)
+		close(s.messages)
+	})
+}
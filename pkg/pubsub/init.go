@@ -0,0 +1,44 @@
+package pubsub
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/sns"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+	"github.com/gobuffalo/pop/v6"
+	"github.com/pkg/errors"
+	"github.com/spf13/viper"
+	"go.uber.org/zap"
+
+	"github.com/transcom/mymove/pkg/cli"
+)
+
+// InitPubSub initializes the pubsub backend, only call this once
+func InitPubSub(v *viper.Viper, logger *zap.Logger, db *pop.Connection) (PubSub, error) {
+	backend := v.GetString(cli.PubSubBackendFlag)
+
+	switch backend {
+	case "postgres":
+		logger.Info("Using postgres pubsub backend")
+		return NewPostgresPubSub(db, logger)
+	case "sns_sqs":
+		region := v.GetString(cli.SNSRegionFlag)
+		logger.Info("Using aws sns_sqs pubsub backend", zap.String("region", region))
+
+		cfg, err := config.LoadDefaultConfig(context.Background(), config.WithRegion(region))
+		if err != nil {
+			return nil, errors.Wrap(err, "could not load aws config for pubsub")
+		}
+
+		return NewSNSSQSPubSub(sns.NewFromConfig(cfg), sqs.NewFromConfig(cfg), SNSSQSParams{
+			Region:         region,
+			AccountID:      v.GetString(cli.SNSAccountId),
+			TopicName:      v.GetString(cli.SNSTagsUpdatedTopicFlag),
+			S3KeyNamespace: v.GetString(cli.AWSS3KeyNamespaceFlag),
+		}, logger)
+	}
+
+	logger.Info("Using memory pubsub backend", zap.String("pubsub_backend", backend))
+	return NewMemoryPubSub(), nil
+}
@@ -0,0 +1,33 @@
+package pubsub
+
+import (
+	"context"
+)
+
+// MemoryPubSub delivers messages within a single server. It is intended for
+// development and tests, or deployments that run one server.
+type MemoryPubSub struct {
+	hub *hub
+}
+
+// NewMemoryPubSub returns a new MemoryPubSub
+func NewMemoryPubSub() *MemoryPubSub {
+	return &MemoryPubSub{hub: newHub()}
+}
+
+// Publish delivers the payload to the subscribers of the topic
+func (m *MemoryPubSub) Publish(_ context.Context, topic string, payload []byte) error {
+	m.hub.deliver(Message{Topic: topic, Payload: payload})
+	return nil
+}
+
+// Subscribe subscribes to the topic
+func (m *MemoryPubSub) Subscribe(ctx context.Context, topic string) (Subscription, error) {
+	return m.hub.subscribe(ctx, topic)
+}
+
+// Close closes all subscriptions
+func (m *MemoryPubSub) Close() error {
+	m.hub.close()
+	return nil
+}
@@ -0,0 +1,112 @@
+package pubsub
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func receive(t *testing.T, sub Subscription) (Message, bool) {
+	t.Helper()
+	select {
+	case msg, ok := <-sub.Messages():
+		return msg, ok
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for message")
+		return Message{}, false
+	}
+}
+
+func TestMemoryPubSubDeliversToTopicSubscribers(t *testing.T) {
+	ps := NewMemoryPubSub()
+	defer ps.Close()
+
+	first, err := ps.Subscribe(context.Background(), "a")
+	if err != nil {
+		t.Fatalf("could not subscribe: %s", err)
+	}
+	second, err := ps.Subscribe(context.Background(), "a")
+	if err != nil {
+		t.Fatalf("could not subscribe: %s", err)
+	}
+	other, err := ps.Subscribe(context.Background(), "b")
+	if err != nil {
+		t.Fatalf("could not subscribe: %s", err)
+	}
+
+	if err := ps.Publish(context.Background(), "a", []byte("hello")); err != nil {
+		t.Fatalf("could not publish: %s", err)
+	}
+
+	for _, sub := range []Subscription{first, second} {
+		msg, ok := receive(t, sub)
+		if !ok || msg.Topic != "a" || string(msg.Payload) != "hello" {
+			t.Errorf("wrong message: got %+v", msg)
+		}
+	}
+
+	select {
+	case msg := <-other.Messages():
+		t.Errorf("message delivered to another topic: %+v", msg)
+	default:
+	}
+}
+
+func TestMemoryPubSubClosesSubscriptionWithContext(t *testing.T) {
+	ps := NewMemoryPubSub()
+	defer ps.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	sub, err := ps.Subscribe(ctx, "a")
+	if err != nil {
+		t.Fatalf("could not subscribe: %s", err)
+	}
+	cancel()
+
+	if _, ok := receive(t, sub); ok {
+		t.Error("expected subscription to be closed")
+	}
+	// Publishing to a topic without subscribers is not an error
+	if err := ps.Publish(context.Background(), "a", nil); err != nil {
+		t.Errorf("could not publish: %s", err)
+	}
+}
+
+func TestMemoryPubSubDropsMessagesForSlowSubscribers(t *testing.T) {
+	ps := NewMemoryPubSub()
+	defer ps.Close()
+
+	sub, err := ps.Subscribe(context.Background(), "a")
+	if err != nil {
+		t.Fatalf("could not subscribe: %s", err)
+	}
+
+	// Publishing never blocks on a subscriber that is not reading
+	for i := 0; i < subscriptionBufferSize*2; i++ {
+		if err := ps.Publish(context.Background(), "a", nil); err != nil {
+			t.Fatalf("could not publish: %s", err)
+		}
+	}
+	if len(sub.Messages()) != subscriptionBufferSize {
+		t.Errorf("wrong number of buffered messages: expected %d, got %d", subscriptionBufferSize, len(sub.Messages()))
+	}
+}
+
+func TestMemoryPubSubClose(t *testing.T) {
+	ps := NewMemoryPubSub()
+
+	sub, err := ps.Subscribe(context.Background(), "a")
+	if err != nil {
+		t.Fatalf("could not subscribe: %s", err)
+	}
+	if err := ps.Close(); err != nil {
+		t.Fatalf("could not close: %s", err)
+	}
+
+	if _, ok := receive(t, sub); ok {
+		t.Error("expected subscription to be closed")
+	}
+	if _, err := ps.Subscribe(context.Background(), "a"); err != ErrClosed {
+		t.Errorf("expected ErrClosed, got %v", err)
+	}
+}
@@ -0,0 +1,127 @@
+package pubsub
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/gobuffalo/pop/v6"
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+const (
+	// postgresChannel is the LISTEN/NOTIFY channel that carries every topic
+	postgresChannel = "milmove_pubsub"
+	// postgresMaxPayloadSize is kept below the 8000 byte limit Postgres places on a
+	// NOTIFY payload
+	postgresMaxPayloadSize = 7900
+	// postgresPingInterval is how often an idle listener checks its connection
+	postgresPingInterval = 90 * time.Second
+)
+
+// PostgresPubSub delivers messages between servers sharing a database with
+// LISTEN/NOTIFY. Notifications published with a transaction from ContextWithConnection
+// are only delivered once it commits.
+type PostgresPubSub struct {
+	db       *pop.Connection
+	listener *pq.Listener
+	hub      *hub
+	logger   *zap.Logger
+	done     chan struct{}
+}
+
+// NewPostgresPubSub listens for notifications on a dedicated connection to the database
+// of db. It cannot be used with IAM authentication because the listener connects with
+// lib/pq directly.
+func NewPostgresPubSub(db *pop.Connection, logger *zap.Logger) (*PostgresPubSub, error) {
+	p := &PostgresPubSub{
+		db:     db,
+		hub:    newHub(),
+		logger: logger,
+		done:   make(chan struct{}),
+	}
+
+	p.listener = pq.NewListener(db.Dialect.URL(), 10*time.Second, time.Minute, p.listenerEvent)
+	if err := p.listener.Listen(postgresChannel); err != nil {
+		_ = p.listener.Close()
+		return nil, errors.Wrap(err, "could not listen for pubsub notifications")
+	}
+
+	go p.run()
+	return p, nil
+}
+
+func (p *PostgresPubSub) listenerEvent(event pq.ListenerEventType, err error) {
+	switch event {
+	case pq.ListenerEventDisconnected, pq.ListenerEventConnectionAttemptFailed:
+		p.logger.Warn("pubsub listener lost its database connection", zap.Error(err))
+	case pq.ListenerEventReconnected:
+		p.logger.Info("pubsub listener reconnected to the database")
+	}
+}
+
+func (p *PostgresPubSub) run() {
+	for {
+		select {
+		case <-p.done:
+			return
+		case notification, ok := <-p.listener.Notify:
+			if !ok {
+				return
+			}
+			// A nil notification follows a reconnect, and anything sent while the
+			// listener was disconnected is lost
+			if notification == nil {
+				continue
+			}
+			var msg Message
+			if err := json.Unmarshal([]byte(notification.Extra), &msg); err != nil {
+				p.logger.Error("could not decode pubsub notification", zap.Error(err))
+				continue
+			}
+			p.hub.deliver(msg)
+		case <-time.After(postgresPingInterval):
+			go func() {
+				if err := p.listener.Ping(); err != nil {
+					p.logger.Warn("pubsub listener ping failed", zap.Error(err))
+				}
+			}()
+		}
+	}
+}
+
+// Publish sends the payload to the subscribers of the topic on every server. The
+// notification is sent on the connection from ContextWithConnection, if there is one.
+func (p *PostgresPubSub) Publish(ctx context.Context, topic string, payload []byte) error {
+	body, err := json.Marshal(Message{Topic: topic, Payload: payload})
+	if err != nil {
+		return errors.Wrap(err, "could not encode pubsub message")
+	}
+	if len(body) > postgresMaxPayloadSize {
+		return errors.Errorf("pubsub message of %d bytes is larger than the %d byte limit", len(body), postgresMaxPayloadSize)
+	}
+
+	db := p.db
+	if conn := connectionFromContext(ctx); conn != nil {
+		db = conn
+	}
+	err = db.WithContext(ctx).RawQuery("SELECT pg_notify(?, ?)", postgresChannel, string(body)).Exec()
+	if err != nil {
+		return errors.Wrap(err, "could not publish pubsub message")
+	}
+	return nil
+}
+
+// Subscribe subscribes to the topic
+func (p *PostgresPubSub) Subscribe(ctx context.Context, topic string) (Subscription, error) {
+	return p.hub.subscribe(ctx, topic)
+}
+
+// Close stops listening and closes all subscriptions
+func (p *PostgresPubSub) Close() error {
+	close(p.done)
+	p.hub.close()
+	return p.listener.Close()
+}
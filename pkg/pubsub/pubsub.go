@@ -0,0 +1,54 @@
+// Package pubsub delivers short-lived notifications between the servers running the
+// application, so that a request served by one server can stream changes made by
+// another, for example over server-sent events.
+package pubsub
+
+import (
+	"context"
+
+	"github.com/gobuffalo/pop/v6"
+)
+
+// Message is a payload published to a topic
+type Message struct {
+	Topic   string `json:"topic"`
+	Payload []byte `json:"payload"`
+}
+
+// Subscription delivers the messages published to a topic after the subscription was
+// created. Delivery is best effort: a subscriber that falls behind misses messages, so
+// messages should signal that something changed rather than carry state that cannot be
+// read again.
+type Subscription interface {
+	Messages() <-chan Message
+	Close()
+}
+
+// PubSub publishes messages to topics and delivers them to every subscriber of the topic
+type PubSub interface {
+	Publish(ctx context.Context, topic string, payload []byte) error
+	// Subscribe returns a subscription that is closed when ctx is done or Close is called
+	Subscribe(ctx context.Context, topic string) (Subscription, error)
+	Close() error
+}
+
+type connectionContextKey struct{}
+
+// ContextWithConnection returns a copy of ctx whose messages are published through db.
+// Backends that publish through the database only deliver messages published with a
+// transaction once it commits.
+func ContextWithConnection(ctx context.Context, db *pop.Connection) context.Context {
+	return context.WithValue(ctx, connectionContextKey{}, db)
+}
+
+// connectionFromContext returns the connection set by ContextWithConnection, if any
+func connectionFromContext(ctx context.Context) *pop.Connection {
+	db, _ := ctx.Value(connectionContextKey{}).(*pop.Connection)
+	return db
+}
+
+// UploadStatusTopic is the topic for changes to the anti-virus status of the object
+// stored at the key
+func UploadStatusTopic(storageKey string) string {
+	return "upload-status:" + storageKey
+}
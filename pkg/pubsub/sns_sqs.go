@@ -0,0 +1,273 @@
+package pubsub
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sns"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+	sqstypes "github.com/aws/aws-sdk-go-v2/service/sqs/types"
+	"github.com/gofrs/uuid"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+// SNSSQSQueuePrefix starts the name of the queue each server creates to receive
+// messages
+const SNSSQSQueuePrefix = "PubSub"
+
+// SNSClient is the part of the SNS API used by SNSSQSPubSub
+type SNSClient interface {
+	Publish(ctx context.Context, params *sns.PublishInput, optFns ...func(*sns.Options)) (*sns.PublishOutput, error)
+	Subscribe(ctx context.Context, params *sns.SubscribeInput, optFns ...func(*sns.Options)) (*sns.SubscribeOutput, error)
+	Unsubscribe(ctx context.Context, params *sns.UnsubscribeInput, optFns ...func(*sns.Options)) (*sns.UnsubscribeOutput, error)
+}
+
+// SQSClient is the part of the SQS API used by SNSSQSPubSub
+type SQSClient interface {
+	CreateQueue(ctx context.Context, params *sqs.CreateQueueInput, optFns ...func(*sqs.Options)) (*sqs.CreateQueueOutput, error)
+	ReceiveMessage(ctx context.Context, params *sqs.ReceiveMessageInput, optFns ...func(*sqs.Options)) (*sqs.ReceiveMessageOutput, error)
+	DeleteMessageBatch(ctx context.Context, params *sqs.DeleteMessageBatchInput, optFns ...func(*sqs.Options)) (*sqs.DeleteMessageBatchOutput, error)
+	DeleteQueue(ctx context.Context, params *sqs.DeleteQueueInput, optFns ...func(*sqs.Options)) (*sqs.DeleteQueueOutput, error)
+}
+
+// SNSSQSParams configures an SNSSQSPubSub
+type SNSSQSParams struct {
+	Region    string
+	AccountID string
+	// TopicName is the SNS topic messages are published to. S3 also publishes the
+	// "Object Tags Added" events of the upload bucket to it.
+	TopicName string
+	// S3KeyNamespace is stripped from the keys of S3 events to get the storage key
+	S3KeyNamespace string
+}
+
+// SNSSQSPubSub delivers messages between servers through an SNS topic. Each server
+// subscribes one SQS queue to the topic when it starts and removes it when it stops,
+// rather than creating a queue for every request that waits on a notification.
+type SNSSQSPubSub struct {
+	sns             SNSClient
+	sqs             SQSClient
+	params          SNSSQSParams
+	topicArn        string
+	queueURL        string
+	subscriptionArn string
+	hub             *hub
+	logger          *zap.Logger
+	cancel          context.CancelFunc
+	stopped         sync.WaitGroup
+}
+
+// NewSNSSQSPubSub creates this server's queue, subscribes it to the topic and starts
+// polling it
+func NewSNSSQSPubSub(snsClient SNSClient, sqsClient SQSClient, params SNSSQSParams, logger *zap.Logger) (*SNSSQSPubSub, error) {
+	p := &SNSSQSPubSub{
+		sns:      snsClient,
+		sqs:      sqsClient,
+		params:   params,
+		topicArn: constructArn("sns", params.Region, params.AccountID, params.TopicName),
+		hub:      newHub(),
+		logger:   logger,
+	}
+
+	queueName := fmt.Sprintf("%s_%s", SNSSQSQueuePrefix, uuid.Must(uuid.NewV4()))
+	queueArn := constructArn("sqs", params.Region, params.AccountID, queueName)
+
+	queue, err := sqsClient.CreateQueue(context.Background(), &sqs.CreateQueueInput{
+		QueueName: &queueName,
+		Attributes: map[string]string{
+			"MessageRetentionPeriod": "120",
+			"Policy":                 queueAccessPolicy(queueArn, p.topicArn),
+		},
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "could not create pubsub queue")
+	}
+	p.queueURL = *queue.QueueUrl
+
+	subscription, err := snsClient.Subscribe(context.Background(), &sns.SubscribeInput{
+		TopicArn: &p.topicArn,
+		Protocol: aws.String("sqs"),
+		Endpoint: &queueArn,
+		Attributes: map[string]string{
+			"RawMessageDelivery": "true",
+		},
+	})
+	if err != nil {
+		_, _ = sqsClient.DeleteQueue(context.Background(), &sqs.DeleteQueueInput{QueueUrl: &p.queueURL})
+		return nil, errors.Wrap(err, "could not subscribe pubsub queue to topic")
+	}
+	p.subscriptionArn = *subscription.SubscriptionArn
+
+	ctx, cancel := context.WithCancel(context.Background())
+	p.cancel = cancel
+	p.stopped.Add(1)
+	go p.run(ctx)
+
+	logger.Info("Subscribed pubsub queue", zap.String("queue_url", p.queueURL), zap.String("topic_arn", p.topicArn))
+	return p, nil
+}
+
+func (p *SNSSQSPubSub) run(ctx context.Context) {
+	defer p.stopped.Done()
+
+	for ctx.Err() == nil {
+		output, err := p.sqs.ReceiveMessage(ctx, &sqs.ReceiveMessageInput{
+			QueueUrl:            &p.queueURL,
+			MaxNumberOfMessages: 10,
+			WaitTimeSeconds:     20,
+		})
+		if ctx.Err() != nil {
+			return
+		}
+		if err != nil {
+			p.logger.Error("could not receive pubsub messages", zap.Error(err))
+			// Back off so an unavailable queue is not polled in a tight loop
+			select {
+			case <-ctx.Done():
+			case <-time.After(5 * time.Second):
+			}
+			continue
+		}
+		if len(output.Messages) == 0 {
+			continue
+		}
+
+		entries := make([]sqstypes.DeleteMessageBatchRequestEntry, 0, len(output.Messages))
+		for i, received := range output.Messages {
+			if received.Body != nil {
+				if msg, ok := p.decode(*received.Body); ok {
+					p.hub.deliver(msg)
+				}
+			}
+			entries = append(entries, sqstypes.DeleteMessageBatchRequestEntry{
+				Id:            aws.String(fmt.Sprint(i)),
+				ReceiptHandle: received.ReceiptHandle,
+			})
+		}
+
+		_, err = p.sqs.DeleteMessageBatch(ctx, &sqs.DeleteMessageBatchInput{
+			QueueUrl: &p.queueURL,
+			Entries:  entries,
+		})
+		if err != nil && ctx.Err() == nil {
+			p.logger.Warn("could not delete pubsub messages", zap.Error(err))
+		}
+	}
+}
+
+// s3Event is the part of an S3 event delivered through EventBridge that identifies
+// the object
+type s3Event struct {
+	DetailType string `json:"detail-type"`
+	Detail     struct {
+		Object struct {
+			Key string `json:"key"`
+		} `json:"object"`
+	} `json:"detail"`
+}
+
+// decode reads a message published by a server, or translates an S3 tag event into a
+// message on the upload status topic of the object
+func (p *SNSSQSPubSub) decode(body string) (Message, bool) {
+	var msg Message
+	if err := json.Unmarshal([]byte(body), &msg); err == nil && msg.Topic != "" {
+		return msg, true
+	}
+
+	var event s3Event
+	if err := json.Unmarshal([]byte(body), &event); err == nil && event.Detail.Object.Key != "" {
+		key := event.Detail.Object.Key
+		if p.params.S3KeyNamespace != "" {
+			key = strings.TrimPrefix(key, strings.TrimSuffix(p.params.S3KeyNamespace, "/")+"/")
+		}
+		return Message{Topic: UploadStatusTopic(key), Payload: []byte(body)}, true
+	}
+
+	p.logger.Warn("ignoring unrecognized pubsub message")
+	return Message{}, false
+}
+
+// Publish sends the payload to the subscribers of the topic on every server
+func (p *SNSSQSPubSub) Publish(ctx context.Context, topic string, payload []byte) error {
+	body, err := json.Marshal(Message{Topic: topic, Payload: payload})
+	if err != nil {
+		return errors.Wrap(err, "could not encode pubsub message")
+	}
+
+	_, err = p.sns.Publish(ctx, &sns.PublishInput{
+		TopicArn: &p.topicArn,
+		Message:  aws.String(string(body)),
+	})
+	if err != nil {
+		return errors.Wrap(err, "could not publish pubsub message")
+	}
+	return nil
+}
+
+// Subscribe subscribes to the topic
+func (p *SNSSQSPubSub) Subscribe(ctx context.Context, topic string) (Subscription, error) {
+	return p.hub.subscribe(ctx, topic)
+}
+
+// Close stops polling, closes all subscriptions and removes this server's queue
+func (p *SNSSQSPubSub) Close() error {
+	p.cancel()
+	p.stopped.Wait()
+	p.hub.close()
+
+	_, err := p.sns.Unsubscribe(context.Background(), &sns.UnsubscribeInput{
+		SubscriptionArn: &p.subscriptionArn,
+	})
+	if err != nil {
+		return errors.Wrap(err, "could not unsubscribe pubsub queue")
+	}
+	_, err = p.sqs.DeleteQueue(context.Background(), &sqs.DeleteQueueInput{
+		QueueUrl: &p.queueURL,
+	})
+	if err != nil {
+		return errors.Wrap(err, "could not delete pubsub queue")
+	}
+	return nil
+}
+
+func constructArn(awsService string, region string, accountID string, endpointName string) string {
+	return fmt.Sprintf("arn:aws-us-gov:%s:%s:%s:%s", awsService, region, accountID, endpointName)
+}
+
+// queueAccessPolicy allows the topic to send to the queue over TLS only
+func queueAccessPolicy(queueArn string, topicArn string) string {
+	return fmt.Sprintf(`{
+		"Version": "2012-10-17",
+		"Statement": [{
+			"Sid": "AllowSNSPublish",
+			"Effect": "Allow",
+			"Principal": {
+				"Service": "sns.amazonaws.com"
+			},
+			"Action": ["sqs:SendMessage"],
+			"Resource": "%s",
+			"Condition": {
+				"ArnEquals": {
+					"aws:SourceArn": "%s"
+				}
+			}
+		}, {
+			"Sid": "DenyNonSSLAccess",
+			"Effect": "Deny",
+			"Principal": "*",
+			"Action": "sqs:*",
+			"Resource": "%s",
+			"Condition": {
+				"Bool": {
+					"aws:SecureTransport": "false"
+				}
+			}
+		}]
+	}`, queueArn, topicArn, queueArn)
+}
@@ -0,0 +1,28 @@
+package pubsub
+
+import (
+	"testing"
+
+	"go.uber.org/zap"
+)
+
+func TestSNSSQSDecode(t *testing.T) {
+	p := &SNSSQSPubSub{
+		params: SNSSQSParams{S3KeyNamespace: "app"},
+		logger: zap.NewNop(),
+	}
+
+	msg, ok := p.decode(`{"topic":"move-lock:123","payload":"aGVsbG8="}`)
+	if !ok || msg.Topic != "move-lock:123" || string(msg.Payload) != "hello" {
+		t.Errorf("wrong published message: got %+v", msg)
+	}
+
+	msg, ok = p.decode(`{"detail-type":"Object Tags Added","detail":{"object":{"key":"app/user/abc/uploads/def"}}}`)
+	if !ok || msg.Topic != UploadStatusTopic("user/abc/uploads/def") {
+		t.Errorf("wrong topic for S3 event: got %s", msg.Topic)
+	}
+
+	if _, ok := p.decode(`{"something":"else"}`); ok {
+		t.Error("expected unrecognized message to be ignored")
+	}
+}
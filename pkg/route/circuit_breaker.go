@@ -0,0 +1,79 @@
+package route
+
+import (
+	"sync"
+	"time"
+)
+
+// circuitBreaker stops calls to a failing service for a cooldown period once it has failed too many times in a row.
+// After the cooldown a single trial call is let through; if it succeeds the breaker closes again, otherwise it
+// stays open for another cooldown.
+type circuitBreaker struct {
+	mu                  sync.Mutex
+	failureThreshold    int
+	cooldown            time.Duration
+	consecutiveFailures int
+	openedAt            time.Time
+	trialInFlight       bool
+	now                 func() time.Time
+}
+
+func newCircuitBreaker(failureThreshold int, cooldown time.Duration) *circuitBreaker {
+	return &circuitBreaker{
+		failureThreshold: failureThreshold,
+		cooldown:         cooldown,
+		now:              time.Now,
+	}
+}
+
+// Allow returns true if a call to the service should be attempted
+func (b *circuitBreaker) Allow() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.openedAt.IsZero() {
+		return true
+	}
+	if b.trialInFlight || b.now().Before(b.openedAt.Add(b.cooldown)) {
+		return false
+	}
+	b.trialInFlight = true
+	return true
+}
+
+// RecordSuccess closes the breaker
+func (b *circuitBreaker) RecordSuccess() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.consecutiveFailures = 0
+	b.openedAt = time.Time{}
+	b.trialInFlight = false
+}
+
+// RecordFailure counts a failed call and returns true if it caused the breaker to open
+func (b *circuitBreaker) RecordFailure() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.consecutiveFailures++
+	if b.trialInFlight {
+		// The trial call after the cooldown failed, so wait out another cooldown
+		b.trialInFlight = false
+		b.openedAt = b.now()
+		return false
+	}
+	if b.openedAt.IsZero() && b.consecutiveFailures >= b.failureThreshold {
+		b.openedAt = b.now()
+		return true
+	}
+	return false
+}
+
+// IsOpen returns true if calls are currently being blocked
+func (b *circuitBreaker) IsOpen() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return !b.openedAt.IsZero()
+}
@@ -0,0 +1,62 @@
+package route
+
+import (
+	"time"
+)
+
+func (suite *GHCTestSuite) TestCircuitBreaker() {
+	now := time.Now()
+	newTestBreaker := func() *circuitBreaker {
+		breaker := newCircuitBreaker(2, time.Minute)
+		breaker.now = func() time.Time { return now }
+		return breaker
+	}
+
+	suite.Run("stays closed until the threshold is reached", func() {
+		breaker := newTestBreaker()
+		suite.True(breaker.Allow())
+		suite.False(breaker.RecordFailure())
+		suite.True(breaker.Allow())
+		suite.True(breaker.RecordFailure())
+		suite.True(breaker.IsOpen())
+		suite.False(breaker.Allow())
+	})
+
+	suite.Run("a success resets the failure count", func() {
+		breaker := newTestBreaker()
+		breaker.RecordFailure()
+		breaker.RecordSuccess()
+		suite.False(breaker.RecordFailure())
+		suite.False(breaker.IsOpen())
+	})
+
+	suite.Run("allows a single trial call after the cooldown", func() {
+		breaker := newTestBreaker()
+		breaker.RecordFailure()
+		breaker.RecordFailure()
+
+		breaker.now = func() time.Time { return now.Add(2 * time.Minute) }
+		suite.True(breaker.Allow())
+		suite.False(breaker.Allow())
+
+		breaker.RecordSuccess()
+		suite.False(breaker.IsOpen())
+		suite.True(breaker.Allow())
+	})
+
+	suite.Run("a failed trial call waits out another cooldown", func() {
+		breaker := newTestBreaker()
+		breaker.RecordFailure()
+		breaker.RecordFailure()
+
+		later := now.Add(2 * time.Minute)
+		breaker.now = func() time.Time { return later }
+		suite.True(breaker.Allow())
+		suite.False(breaker.RecordFailure())
+		suite.True(breaker.IsOpen())
+		suite.False(breaker.Allow())
+
+		breaker.now = func() time.Time { return later.Add(2 * time.Minute) }
+		suite.True(breaker.Allow())
+	})
+}
@@ -0,0 +1,144 @@
+package route
+
+import (
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"go.uber.org/zap"
+
+	"github.com/transcom/mymove/pkg/appcontext"
+	"github.com/transcom/mymove/pkg/apperror"
+	"github.com/transcom/mymove/pkg/models"
+	"github.com/transcom/mymove/pkg/notifications"
+	"github.com/transcom/mymove/pkg/telemetry"
+)
+
+type cachingDTODZip5DistanceInfo struct {
+	dtodPlannerMileage DTODPlannerMileage
+	ttl                time.Duration
+	breaker            *circuitBreaker
+	telemetry          *telemetry.RouteTelemetry
+	now                func() time.Time
+}
+
+// NewCachingDTODZip5Distance wraps a DTODPlannerMileage with a persistent cache of zip5 pair mileage and a circuit
+// breaker. Cached mileage newer than the ttl is used without calling DTOD. Once DTOD fails failureThreshold times in a
+// row it is not called again until the cooldown passes, and the last known mileage is served in the meantime.
+func NewCachingDTODZip5Distance(dtodPlannerMileage DTODPlannerMileage, ttl time.Duration, failureThreshold int, cooldown time.Duration, routeTelemetry *telemetry.RouteTelemetry) DTODPlannerMileage {
+	return &cachingDTODZip5DistanceInfo{
+		dtodPlannerMileage: dtodPlannerMileage,
+		ttl:                ttl,
+		breaker:            newCircuitBreaker(failureThreshold, cooldown),
+		telemetry:          routeTelemetry,
+		now:                time.Now,
+	}
+}
+
+// DTODZip5Distance returns the distance in miles between the pickup and destination zips, using cached mileage when
+// it is fresh or when DTOD is unavailable
+func (c *cachingDTODZip5DistanceInfo) DTODZip5Distance(appCtx appcontext.AppContext, pickupZip string, destinationZip string) (int, error) {
+	logger := appCtx.Logger().With(zap.String("pickupZip", pickupZip), zap.String("destinationZip", destinationZip))
+
+	// Only cache lookups that are clearly a pair of zip5s
+	pickupZip5 := formatZip5(pickupZip)
+	destinationZip5 := formatZip5(destinationZip)
+	cacheable := len(pickupZip5) == 5 && len(destinationZip5) == 5
+
+	var cached *models.Zip5DistanceCache
+	if cacheable {
+		var err error
+		cached, err = fetchCachedZip5Distance(appCtx, pickupZip5, destinationZip5)
+		if err != nil {
+			// The cache is only an optimization, so keep going and ask DTOD
+			logger.Error("failed to fetch cached DTOD mileage", zap.Error(err))
+		}
+	}
+
+	if cached != nil && !cached.IsExpired(c.now(), c.ttl) {
+		c.telemetry.IncrementDistanceCacheLookup(appCtx.DB().Context(), telemetry.DistanceCacheHit)
+		return cached.DistanceMiles, nil
+	}
+
+	if !c.breaker.Allow() {
+		if cached != nil {
+			logger.Warn("DTOD circuit breaker is open, using expired cached mileage", zap.Time("cachedAt", cached.UpdatedAt))
+			c.telemetry.IncrementDistanceCacheLookup(appCtx.DB().Context(), telemetry.DistanceCacheStale)
+			return cached.DistanceMiles, nil
+		}
+		if appCtx.Session().IsServiceMember() {
+			return 0, nil
+		}
+		return 0, apperror.NewEventError(notifications.DTODDownErrorMessage, nil)
+	}
+
+	c.telemetry.IncrementDistanceCacheLookup(appCtx.DB().Context(), telemetry.DistanceCacheMiss)
+	distance, err := c.dtodPlannerMileage.DTODZip5Distance(appCtx, pickupZip, destinationZip)
+	if err != nil {
+		// DTOD rejecting the zips means it's up, so don't count that against it
+		if err.Error() == notifications.DTODFailureErrorMessage {
+			c.breaker.RecordSuccess()
+		} else if c.breaker.RecordFailure() {
+			logger.Error("DTOD circuit breaker opened", zap.Error(err))
+			c.telemetry.IncrementCircuitOpen(appCtx.DB().Context())
+		}
+
+		if cached != nil {
+			logger.Warn("DTOD call failed, using expired cached mileage", zap.Error(err), zap.Time("cachedAt", cached.UpdatedAt))
+			c.telemetry.IncrementDistanceCacheLookup(appCtx.DB().Context(), telemetry.DistanceCacheStale)
+			return cached.DistanceMiles, nil
+		}
+		return distance, err
+	}
+
+	// DTOD hides outages from service members by returning zero, which is only a real distance for identical zips
+	if distance == 0 && formatZip5(pickupZip) != formatZip5(destinationZip) {
+		if c.breaker.RecordFailure() {
+			logger.Error("DTOD circuit breaker opened after a zero distance")
+			c.telemetry.IncrementCircuitOpen(appCtx.DB().Context())
+		}
+
+		if cached != nil {
+			logger.Warn("DTOD returned a zero distance, using expired cached mileage", zap.Time("cachedAt", cached.UpdatedAt))
+			c.telemetry.IncrementDistanceCacheLookup(appCtx.DB().Context(), telemetry.DistanceCacheStale)
+			return cached.DistanceMiles, nil
+		}
+		return distance, nil
+	}
+	c.breaker.RecordSuccess()
+
+	// DTOD returns zero for identical zips, which we don't want cached
+	if cacheable && distance > 0 {
+		if err := storeCachedZip5Distance(appCtx, pickupZip5, destinationZip5, distance, c.now()); err != nil {
+			logger.Error("failed to cache DTOD mileage", zap.Error(err))
+		}
+	}
+
+	return distance, nil
+}
+
+// fetchCachedZip5Distance returns the cached mileage for the zip pair, or nil if there isn't any
+func fetchCachedZip5Distance(appCtx appcontext.AppContext, pickupZip string, destinationZip string) (*models.Zip5DistanceCache, error) {
+	var cached models.Zip5DistanceCache
+	err := appCtx.DB().Where("from_zip5 = ? and to_zip5 = ?", pickupZip, destinationZip).First(&cached)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &cached, nil
+}
+
+// storeCachedZip5Distance upserts the mileage for the zip pair. This is done in a single statement so a conflicting
+// insert can't abort a surrounding transaction.
+func storeCachedZip5Distance(appCtx appcontext.AppContext, pickupZip string, destinationZip string, distance int, now time.Time) error {
+	return appCtx.DB().RawQuery(`
+		INSERT INTO zip5_distance_cache (id, from_zip5, to_zip5, distance_miles, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?)
+		ON CONFLICT (from_zip5, to_zip5)
+		DO UPDATE SET distance_miles = EXCLUDED.distance_miles, updated_at = EXCLUDED.updated_at`,
+		uuid.Must(uuid.NewV4()), pickupZip, destinationZip, distance, now, now,
+	).Exec()
+}
@@ -0,0 +1,146 @@
+package route
+
+import (
+	"errors"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/transcom/mymove/pkg/apperror"
+	"github.com/transcom/mymove/pkg/auth"
+	"github.com/transcom/mymove/pkg/models"
+	"github.com/transcom/mymove/pkg/notifications"
+	"github.com/transcom/mymove/pkg/route/ghcmocks"
+)
+
+func (suite *GHCTestSuite) TestCachingDTODZip5Distance() {
+	const pickupZip = "30907"
+	const destinationZip = "29212"
+
+	newCachingPlanner := func(dtod DTODPlannerMileage) *cachingDTODZip5DistanceInfo {
+		return NewCachingDTODZip5Distance(dtod, time.Hour, 1, time.Minute, nil).(*cachingDTODZip5DistanceInfo)
+	}
+
+	cacheDistance := func(distance int, updatedAt time.Time) {
+		suite.NoError(storeCachedZip5Distance(suite.AppContextForTest(), pickupZip, destinationZip, distance, updatedAt))
+	}
+
+	suite.Run("calls DTOD and caches the mileage", func() {
+		dtod := &ghcmocks.DTODPlannerMileage{}
+		dtod.On("DTODZip5Distance", mock.Anything, pickupZip, destinationZip).Return(69, nil).Once()
+		planner := newCachingPlanner(dtod)
+
+		distance, err := planner.DTODZip5Distance(suite.AppContextForTest(), pickupZip, destinationZip)
+		suite.NoError(err)
+		suite.Equal(69, distance)
+
+		// The second lookup should come from the cache
+		distance, err = planner.DTODZip5Distance(suite.AppContextForTest(), pickupZip, destinationZip)
+		suite.NoError(err)
+		suite.Equal(69, distance)
+		dtod.AssertNumberOfCalls(suite.T(), "DTODZip5Distance", 1)
+
+		var cached models.Zip5DistanceCache
+		suite.NoError(suite.DB().Where("from_zip5 = ? and to_zip5 = ?", pickupZip, destinationZip).First(&cached))
+		suite.Equal(69, cached.DistanceMiles)
+	})
+
+	suite.Run("refreshes expired mileage", func() {
+		cacheDistance(50, time.Now().Add(-2*time.Hour))
+
+		dtod := &ghcmocks.DTODPlannerMileage{}
+		dtod.On("DTODZip5Distance", mock.Anything, pickupZip, destinationZip).Return(69, nil).Once()
+		planner := newCachingPlanner(dtod)
+
+		distance, err := planner.DTODZip5Distance(suite.AppContextForTest(), pickupZip, destinationZip)
+		suite.NoError(err)
+		suite.Equal(69, distance)
+
+		cached, err := fetchCachedZip5Distance(suite.AppContextForTest(), pickupZip, destinationZip)
+		suite.NoError(err)
+		suite.Equal(69, cached.DistanceMiles)
+	})
+
+	suite.Run("serves expired mileage when DTOD fails", func() {
+		cacheDistance(50, time.Now().Add(-2*time.Hour))
+
+		dtod := &ghcmocks.DTODPlannerMileage{}
+		dtod.On("DTODZip5Distance", mock.Anything, pickupZip, destinationZip).Return(0, errors.New("call error")).Once()
+		planner := newCachingPlanner(dtod)
+
+		distance, err := planner.DTODZip5Distance(suite.AppContextForTest(), pickupZip, destinationZip)
+		suite.NoError(err)
+		suite.Equal(50, distance)
+		suite.True(planner.breaker.IsOpen())
+
+		// With the breaker open DTOD isn't called at all
+		distance, err = planner.DTODZip5Distance(suite.AppContextForTest(), pickupZip, destinationZip)
+		suite.NoError(err)
+		suite.Equal(50, distance)
+		dtod.AssertNumberOfCalls(suite.T(), "DTODZip5Distance", 1)
+	})
+
+	suite.Run("returns the DTOD error when nothing is cached", func() {
+		dtod := &ghcmocks.DTODPlannerMileage{}
+		dtod.On("DTODZip5Distance", mock.Anything, pickupZip, destinationZip).Return(0, errors.New("call error")).Once()
+		planner := newCachingPlanner(dtod)
+
+		_, err := planner.DTODZip5Distance(suite.AppContextForTest(), pickupZip, destinationZip)
+		suite.Error(err)
+		suite.Equal("call error", err.Error())
+
+		_, err = planner.DTODZip5Distance(suite.AppContextForTest(), pickupZip, destinationZip)
+		suite.Error(err)
+		suite.Equal(notifications.DTODDownErrorMessage, err.Error())
+		dtod.AssertNumberOfCalls(suite.T(), "DTODZip5Distance", 1)
+	})
+
+	suite.Run("invalid zips don't open the breaker", func() {
+		dtod := &ghcmocks.DTODPlannerMileage{}
+		dtod.On("DTODZip5Distance", mock.Anything, pickupZip, destinationZip).
+			Return(0, apperror.NewEventError(notifications.DTODFailureErrorMessage, nil))
+		planner := newCachingPlanner(dtod)
+
+		_, err := planner.DTODZip5Distance(suite.AppContextForTest(), pickupZip, destinationZip)
+		suite.Error(err)
+		suite.False(planner.breaker.IsOpen())
+	})
+
+	suite.Run("treats a zero distance during an outage as a failure for service members", func() {
+		cacheDistance(50, time.Now().Add(-2*time.Hour))
+
+		// DTOD returns zero with no error for service members when it's down
+		soapClient := &ghcmocks.SoapCaller{}
+		soapClient.On("Call", mock.Anything, mock.Anything).Return(soapResponseForDistance("-1"), nil)
+		planner := newCachingPlanner(NewDTODZip5Distance(fakeUsername, fakePassword, soapClient, true))
+		appCtx := suite.AppContextWithSessionForTest(&auth.Session{
+			ApplicationName: auth.MilApp,
+			ServiceMemberID: uuid.Must(uuid.NewV4()),
+		})
+
+		distance, err := planner.DTODZip5Distance(appCtx, pickupZip, destinationZip)
+		suite.NoError(err)
+		suite.Equal(50, distance)
+		suite.True(planner.breaker.IsOpen())
+
+		// the expired mileage isn't overwritten with the zero
+		cached, err := fetchCachedZip5Distance(suite.AppContextForTest(), pickupZip, destinationZip)
+		suite.NoError(err)
+		suite.Equal(50, cached.DistanceMiles)
+	})
+
+	suite.Run("doesn't cache zero mileage", func() {
+		dtod := &ghcmocks.DTODPlannerMileage{}
+		dtod.On("DTODZip5Distance", mock.Anything, pickupZip, destinationZip).Return(0, nil)
+		planner := newCachingPlanner(dtod)
+
+		distance, err := planner.DTODZip5Distance(suite.AppContextForTest(), pickupZip, destinationZip)
+		suite.NoError(err)
+		suite.Equal(0, distance)
+
+		cached, err := fetchCachedZip5Distance(suite.AppContextForTest(), pickupZip, destinationZip)
+		suite.NoError(err)
+		suite.Nil(cached)
+	})
+}
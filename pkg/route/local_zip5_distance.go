@@ -0,0 +1,118 @@
+package route
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"go.uber.org/zap"
+
+	"github.com/transcom/mymove/pkg/appcontext"
+)
+
+type localZip5DistanceInfo struct {
+	table *ZipDistanceTable
+	graph *RoadGraph
+}
+
+// NewLocalZip5Distance returns an implementation of DTODPlannerMileage that calculates mileage from locally loaded
+// data rather than calling DTOD. The precomputed table is checked first and the road graph is used for any zip pair
+// the table doesn't have. Either may be nil, but not both.
+func NewLocalZip5Distance(table *ZipDistanceTable, graph *RoadGraph) DTODPlannerMileage {
+	return &localZip5DistanceInfo{
+		table: table,
+		graph: graph,
+	}
+}
+
+// LoadLocalZip5Distance reads the distance table and road graph at the given paths and returns a
+// DTODPlannerMileage backed by them. Either path may be empty, but not both.
+func LoadLocalZip5Distance(tablePath string, graphPath string) (DTODPlannerMileage, error) {
+	if tablePath == "" && graphPath == "" {
+		return nil, fmt.Errorf("a zip distance table or road graph is required for local mileage")
+	}
+
+	var table *ZipDistanceTable
+	if tablePath != "" {
+		f, err := os.Open(filepath.Clean(tablePath))
+		if err != nil {
+			return nil, fmt.Errorf("unable to open zip distance table: %w", err)
+		}
+		defer f.Close()
+
+		table, err = LoadZipDistanceTable(f)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var graph *RoadGraph
+	if graphPath != "" {
+		f, err := os.Open(filepath.Clean(graphPath))
+		if err != nil {
+			return nil, fmt.Errorf("unable to open road graph: %w", err)
+		}
+		defer f.Close()
+
+		graph, err = LoadRoadGraph(f)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return NewLocalZip5Distance(table, graph), nil
+}
+
+// DTODZip5Distance returns the distance in miles between the pickup and destination zips using local data
+func (l *localZip5DistanceInfo) DTODZip5Distance(appCtx appcontext.AppContext, pickupZip string, destinationZip string) (int, error) {
+	// Match DTOD by returning -1 for the distance when there are errors
+	if len(pickupZip) < 5 {
+		return -1, fmt.Errorf("pickup zip must be at least 5 digits")
+	}
+	if len(destinationZip) < 5 {
+		return -1, fmt.Errorf("destination zip must be at least 5 digits")
+	}
+	pickupZip5, err := normalizeZip5(pickupZip[0:5])
+	if err != nil {
+		return -1, err
+	}
+	destinationZip5, err := normalizeZip5(destinationZip[0:5])
+	if err != nil {
+		return -1, err
+	}
+
+	// If the zip5 values are the same, just return a distance of zero like DTOD
+	if pickupZip5 == destinationZip5 {
+		return 0, nil
+	}
+
+	if l.table != nil {
+		if distance, ok := l.table.Distance(pickupZip5, destinationZip5); ok {
+			appCtx.Logger().Debug("local distance table result", zap.String("pickupZip", pickupZip5), zap.String("destinationZip", destinationZip5), zap.Int("distance", distance))
+			return distance, nil
+		}
+	}
+
+	if l.graph != nil {
+		source, err := Zip5ToLatLong(pickupZip5)
+		if err != nil {
+			return -1, err
+		}
+		destination, err := Zip5ToLatLong(destinationZip5)
+		if err != nil {
+			return -1, err
+		}
+
+		miles, err := l.graph.Distance(source, destination)
+		if err != nil {
+			return -1, err
+		}
+
+		// Round like we do for DTOD results
+		distance := int(miles + 0.5)
+		appCtx.Logger().Debug("local road graph result", zap.String("pickupZip", pickupZip5), zap.String("destinationZip", destinationZip5), zap.Int("distance", distance))
+		return distance, nil
+	}
+
+	return -1, fmt.Errorf("no local distance found using pickup %s and destination %s", pickupZip5, destinationZip5)
+}
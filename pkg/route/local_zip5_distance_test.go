@@ -0,0 +1,118 @@
+package route
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const testZipDistanceTable = `origin_zip5,destination_zip5,miles
+30907,29212,69.6
+30907,30901,7
+`
+
+func (suite *GHCTestSuite) TestLoadZipDistanceTable() {
+	suite.Run("loads distances and skips the header", func() {
+		table, err := LoadZipDistanceTable(strings.NewReader(testZipDistanceTable))
+		suite.NoError(err)
+		suite.Equal(2, table.Len())
+
+		distance, ok := table.Distance("30907", "29212")
+		suite.True(ok)
+		suite.Equal(70, distance)
+	})
+
+	suite.Run("pads zips that lost their leading zero", func() {
+		table, err := LoadZipDistanceTable(strings.NewReader("2807,30907,1000\n"))
+		suite.NoError(err)
+
+		distance, ok := table.Distance("02807", "30907")
+		suite.True(ok)
+		suite.Equal(1000, distance)
+	})
+
+	suite.Run("fails on an invalid zip", func() {
+		_, err := LoadZipDistanceTable(strings.NewReader("3090x,29212,69\n"))
+		suite.Error(err)
+		suite.Contains(err.Error(), "must be numeric")
+	})
+
+	suite.Run("fails on invalid miles", func() {
+		_, err := LoadZipDistanceTable(strings.NewReader("30907,29212,far\n"))
+		suite.Error(err)
+		suite.Contains(err.Error(), "invalid miles")
+	})
+}
+
+func (suite *GHCTestSuite) TestLocalZip5Distance() {
+	table, err := LoadZipDistanceTable(strings.NewReader(testZipDistanceTable))
+	suite.FatalNoError(err)
+	graph, err := LoadRoadGraph(strings.NewReader(testRoadGraph))
+	suite.FatalNoError(err)
+
+	tests := []struct {
+		name             string
+		table            *ZipDistanceTable
+		graph            *RoadGraph
+		pickupZip        string
+		destinationZip   string
+		expectedDistance int
+		shouldError      bool
+		errorMessage     string
+	}{
+		{"table distance", table, graph, "30907", "29212", 70, false, ""},
+		{"table distance reversed", table, graph, "29212", "30907", 70, false, ""},
+		{"table distance with zip4", table, nil, "30907-1234", "30901", 7, false, ""},
+		{"road graph distance", nil, graph, "30907", "29212", 75, false, ""},
+		{"falls back to the road graph", table, graph, "30901", "29212", 81, false, ""},
+		{"zips are identical", table, graph, "30907", "30907", 0, false, ""},
+		{"no local distance", table, nil, "30901", "29212", -1, true, "no local distance found"},
+		{"too short pickup zip", table, graph, "3090", "29212", -1, true, "pickup zip must be at least 5 digits"},
+		{"too short destination zip", table, graph, "30907", "2921", -1, true, "destination zip must be at least 5 digits"},
+		{"invalid pickup zip", table, graph, "3090x", "29212", -1, true, "must be numeric"},
+	}
+
+	for _, test := range tests {
+		suite.Run("local distance: "+test.name, func() {
+			local := NewLocalZip5Distance(test.table, test.graph)
+			distance, err := local.DTODZip5Distance(suite.AppContextForTest(), test.pickupZip, test.destinationZip)
+
+			if test.shouldError {
+				suite.Error(err)
+				suite.Contains(err.Error(), test.errorMessage)
+			} else {
+				suite.NoError(err)
+			}
+
+			suite.Equal(test.expectedDistance, distance)
+		})
+	}
+}
+
+func (suite *GHCTestSuite) TestLoadLocalZip5Distance() {
+	dir := suite.T().TempDir()
+	tablePath := filepath.Join(dir, "distances.csv")
+	suite.FatalNoError(os.WriteFile(tablePath, []byte(testZipDistanceTable), 0600))
+	graphPath := filepath.Join(dir, "roads.csv")
+	suite.FatalNoError(os.WriteFile(graphPath, []byte(testRoadGraph), 0600))
+
+	suite.Run("loads both files", func() {
+		local, err := LoadLocalZip5Distance(tablePath, graphPath)
+		suite.NoError(err)
+
+		distance, err := local.DTODZip5Distance(suite.AppContextForTest(), "30901", "29212")
+		suite.NoError(err)
+		suite.Equal(81, distance)
+	})
+
+	suite.Run("requires at least one file", func() {
+		_, err := LoadLocalZip5Distance("", "")
+		suite.Error(err)
+	})
+
+	suite.Run("fails when a file is missing", func() {
+		_, err := LoadLocalZip5Distance(filepath.Join(dir, "missing.csv"), "")
+		suite.Error(err)
+		suite.Contains(err.Error(), "unable to open zip distance table")
+	})
+}
@@ -10,10 +10,12 @@ import (
 
 	"github.com/spf13/viper"
 	"github.com/tiaguinho/gosoap"
+	"go.uber.org/zap"
 
 	"github.com/transcom/mymove/pkg/appcontext"
 	"github.com/transcom/mymove/pkg/cli"
 	"github.com/transcom/mymove/pkg/models"
+	"github.com/transcom/mymove/pkg/telemetry"
 )
 
 const (
@@ -158,11 +160,19 @@ func InitDTODRoutePlanner(appCtx appcontext.AppContext, v *viper.Viper, tlsConfi
 
 func initDTODPlannerMileage(appCtx appcontext.AppContext, v *viper.Viper, tlsConfig *tls.Config, plannerType string) (DTODPlannerMileage, error) {
 	dtodUseMock := v.GetBool(cli.DTODUseMockFlag)
+	dtodUseLocal := v.GetBool(cli.DTODUseLocalFlag)
 
 	var dtodPlannerMileage DTODPlannerMileage
 	if dtodUseMock {
 		appCtx.Logger().Info(fmt.Sprintf("Using mocked DTOD for %s route planner", plannerType))
 		dtodPlannerMileage = NewMockDTODZip5Distance()
+	} else if dtodUseLocal {
+		appCtx.Logger().Info(fmt.Sprintf("Using local distance data for %s route planner", plannerType))
+		localPlannerMileage, err := LoadLocalZip5Distance(v.GetString(cli.DTODLocalDistanceTableFlag), v.GetString(cli.DTODLocalRoadGraphFlag))
+		if err != nil {
+			return nil, fmt.Errorf("unable to load local distance data: %w", err)
+		}
+		dtodPlannerMileage = localPlannerMileage
 	} else {
 		appCtx.Logger().Info(fmt.Sprintf("Using real DTOD for %s route planner", plannerType))
 		tr := &http.Transport{TLSClientConfig: tlsConfig}
@@ -180,6 +190,17 @@ func initDTODPlannerMileage(appCtx appcontext.AppContext, v *viper.Viper, tlsCon
 		soapClient.URL = dtodURL
 
 		dtodPlannerMileage = NewDTODZip5Distance(dtodAPIUsername, dtodAPIPassword, soapClient, v.GetBool(cli.DTODSimulateOutageFlag))
+
+		if dtodCacheTTL := v.GetDuration(cli.DTODCacheTTLFlag); dtodCacheTTL > 0 {
+			appCtx.Logger().Info(fmt.Sprintf("Caching DTOD mileage for %s route planner", plannerType), zap.Duration("ttl", dtodCacheTTL))
+			dtodPlannerMileage = NewCachingDTODZip5Distance(
+				dtodPlannerMileage,
+				dtodCacheTTL,
+				v.GetInt(cli.DTODCircuitBreakerThresholdFlag),
+				v.GetDuration(cli.DTODCircuitBreakerCooldownFlag),
+				telemetry.NewRouteTelemetry(appCtx.Logger()),
+			)
+		}
 	}
 
 	return dtodPlannerMileage, nil
@@ -0,0 +1,214 @@
+package route
+
+import (
+	"container/heap"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+)
+
+const (
+	// earthRadiusMiles is the mean radius of the earth used for great-circle distances
+	earthRadiusMiles = 3958.8
+
+	roadGraphNodeRecord = "node"
+	roadGraphEdgeRecord = "edge"
+)
+
+// roadGraphEdge is a directed connection to another node in the road graph
+type roadGraphEdge struct {
+	to    int
+	miles float64
+}
+
+// RoadGraph is an in-memory road network used to calculate driving distances without calling out to DTOD.
+// Locations are snapped to the closest node in the graph and the shortest path between those nodes is used.
+type RoadGraph struct {
+	nodeIDs   map[string]int
+	locations []LatLong
+	edges     [][]roadGraphEdge
+}
+
+// LoadRoadGraph reads a road network from CSV. Nodes are declared with "node,<id>,<latitude>,<longitude>"
+// and roads between them with "edge,<from id>,<to id>,<miles>". Roads are treated as two-way.
+// Lines starting with # are skipped.
+func LoadRoadGraph(r io.Reader) (*RoadGraph, error) {
+	reader := csv.NewReader(r)
+	reader.Comment = '#'
+	reader.FieldsPerRecord = 4
+	reader.TrimLeadingSpace = true
+
+	graph := &RoadGraph{nodeIDs: make(map[string]int)}
+	type pendingEdge struct {
+		line  int
+		from  string
+		to    string
+		miles float64
+	}
+	var pendingEdges []pendingEdge
+
+	for line := 1; ; line++ {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("unable to read road graph: %w", err)
+		}
+
+		switch strings.ToLower(strings.TrimSpace(record[0])) {
+		case roadGraphNodeRecord:
+			id := strings.TrimSpace(record[1])
+			if _, exists := graph.nodeIDs[id]; exists {
+				return nil, fmt.Errorf("road graph line %d: duplicate node %q", line, id)
+			}
+			latitude, latErr := strconv.ParseFloat(strings.TrimSpace(record[2]), 32)
+			longitude, longErr := strconv.ParseFloat(strings.TrimSpace(record[3]), 32)
+			if latErr != nil || longErr != nil {
+				return nil, fmt.Errorf("road graph line %d: invalid coordinates for node %q", line, id)
+			}
+			graph.nodeIDs[id] = len(graph.locations)
+			graph.locations = append(graph.locations, LatLong{Latitude: float32(latitude), Longitude: float32(longitude)})
+			graph.edges = append(graph.edges, nil)
+		case roadGraphEdgeRecord:
+			miles, err := strconv.ParseFloat(strings.TrimSpace(record[3]), 64)
+			if err != nil || miles < 0 {
+				return nil, fmt.Errorf("road graph line %d: invalid miles %q", line, record[3])
+			}
+			// Edges may reference nodes declared later in the file, so resolve them once everything is read
+			pendingEdges = append(pendingEdges, pendingEdge{
+				line:  line,
+				from:  strings.TrimSpace(record[1]),
+				to:    strings.TrimSpace(record[2]),
+				miles: miles,
+			})
+		default:
+			return nil, fmt.Errorf("road graph line %d: unknown record type %q", line, record[0])
+		}
+	}
+
+	for _, e := range pendingEdges {
+		from, ok := graph.nodeIDs[e.from]
+		if !ok {
+			return nil, fmt.Errorf("road graph line %d: unknown node %q", e.line, e.from)
+		}
+		to, ok := graph.nodeIDs[e.to]
+		if !ok {
+			return nil, fmt.Errorf("road graph line %d: unknown node %q", e.line, e.to)
+		}
+		graph.edges[from] = append(graph.edges[from], roadGraphEdge{to: to, miles: e.miles})
+		graph.edges[to] = append(graph.edges[to], roadGraphEdge{to: from, miles: e.miles})
+	}
+
+	if len(graph.locations) == 0 {
+		return nil, fmt.Errorf("road graph does not contain any nodes")
+	}
+
+	return graph, nil
+}
+
+// Len returns the number of nodes in the road graph
+func (g *RoadGraph) Len() int {
+	return len(g.locations)
+}
+
+// Distance returns the driving distance in miles between two locations. The straight line distance from each
+// location to its closest node is included so that locations between nodes are not undercounted.
+func (g *RoadGraph) Distance(source LatLong, destination LatLong) (float64, error) {
+	sourceNode, sourceAccess := g.closestNode(source)
+	destinationNode, destinationAccess := g.closestNode(destination)
+
+	roadMiles, ok := g.shortestPath(sourceNode, destinationNode)
+	if !ok {
+		return 0, fmt.Errorf("no road connects source (%s) and destination (%s)", source.Coords(), destination.Coords())
+	}
+
+	return sourceAccess + roadMiles + destinationAccess, nil
+}
+
+// closestNode returns the index of the node closest to the location and the distance to it in miles
+func (g *RoadGraph) closestNode(location LatLong) (int, float64) {
+	closest := 0
+	closestMiles := math.Inf(1)
+	for i, nodeLocation := range g.locations {
+		miles := greatCircleMiles(location, nodeLocation)
+		if miles < closestMiles {
+			closest = i
+			closestMiles = miles
+		}
+	}
+	return closest, closestMiles
+}
+
+// shortestPath runs Dijkstra's algorithm between two nodes
+func (g *RoadGraph) shortestPath(from int, to int) (float64, bool) {
+	distances := make([]float64, len(g.locations))
+	for i := range distances {
+		distances[i] = math.Inf(1)
+	}
+	distances[from] = 0
+
+	queue := &roadGraphQueue{{node: from, miles: 0}}
+	for queue.Len() > 0 {
+		current := heap.Pop(queue).(roadGraphQueueItem)
+		if current.node == to {
+			return current.miles, true
+		}
+		// Skip stale entries for nodes we've already reached by a shorter path
+		if current.miles > distances[current.node] {
+			continue
+		}
+		for _, edge := range g.edges[current.node] {
+			miles := current.miles + edge.miles
+			if miles < distances[edge.to] {
+				distances[edge.to] = miles
+				heap.Push(queue, roadGraphQueueItem{node: edge.to, miles: miles})
+			}
+		}
+	}
+
+	return 0, false
+}
+
+type roadGraphQueueItem struct {
+	node  int
+	miles float64
+}
+
+// roadGraphQueue is a min-heap of nodes ordered by distance, for use with container/heap
+type roadGraphQueue []roadGraphQueueItem
+
+func (q roadGraphQueue) Len() int           { return len(q) }
+func (q roadGraphQueue) Less(i, j int) bool { return q[i].miles < q[j].miles }
+func (q roadGraphQueue) Swap(i, j int)      { q[i], q[j] = q[j], q[i] }
+
+func (q *roadGraphQueue) Push(x any) {
+	*q = append(*q, x.(roadGraphQueueItem))
+}
+
+func (q *roadGraphQueue) Pop() any {
+	old := *q
+	n := len(old)
+	item := old[n-1]
+	*q = old[0 : n-1]
+	return item
+}
+
+// greatCircleMiles returns the haversine distance in miles between two points
+func greatCircleMiles(a LatLong, b LatLong) float64 {
+	toRadians := func(degrees float32) float64 {
+		return float64(degrees) * math.Pi / 180
+	}
+	latA := toRadians(a.Latitude)
+	latB := toRadians(b.Latitude)
+	deltaLat := latB - latA
+	deltaLong := toRadians(b.Longitude) - toRadians(a.Longitude)
+
+	h := math.Sin(deltaLat/2)*math.Sin(deltaLat/2) +
+		math.Cos(latA)*math.Cos(latB)*math.Sin(deltaLong/2)*math.Sin(deltaLong/2)
+	return 2 * earthRadiusMiles * math.Asin(math.Sqrt(h))
+}
@@ -0,0 +1,85 @@
+package route
+
+import (
+	"strings"
+)
+
+const testRoadGraph = `# Augusta, GA to Columbia, SC
+node,augusta,33.457921,-82.068935
+node,aiken,33.560402,-81.719550
+node,columbia,34.020988,-81.197778
+edge,augusta,aiken,40
+edge,aiken,columbia,35
+edge,augusta,columbia,90
+node,isolated,40.000000,-100.000000
+`
+
+func (suite *GHCTestSuite) TestLoadRoadGraph() {
+	suite.Run("loads nodes and edges", func() {
+		graph, err := LoadRoadGraph(strings.NewReader(testRoadGraph))
+		suite.NoError(err)
+		suite.Equal(4, graph.Len())
+	})
+
+	suite.Run("fails on an edge to an unknown node", func() {
+		_, err := LoadRoadGraph(strings.NewReader("node,a,1,1\nedge,a,b,10\n"))
+		suite.Error(err)
+		suite.Contains(err.Error(), "unknown node \"b\"")
+	})
+
+	suite.Run("fails on a duplicate node", func() {
+		_, err := LoadRoadGraph(strings.NewReader("node,a,1,1\nnode,a,2,2\n"))
+		suite.Error(err)
+		suite.Contains(err.Error(), "duplicate node")
+	})
+
+	suite.Run("fails on an unknown record type", func() {
+		_, err := LoadRoadGraph(strings.NewReader("road,a,b,10\n"))
+		suite.Error(err)
+		suite.Contains(err.Error(), "unknown record type")
+	})
+
+	suite.Run("fails on negative miles", func() {
+		_, err := LoadRoadGraph(strings.NewReader("node,a,1,1\nnode,b,2,2\nedge,a,b,-10\n"))
+		suite.Error(err)
+		suite.Contains(err.Error(), "invalid miles")
+	})
+
+	suite.Run("fails when there are no nodes", func() {
+		_, err := LoadRoadGraph(strings.NewReader("# nothing here\n"))
+		suite.Error(err)
+	})
+}
+
+func (suite *GHCTestSuite) TestRoadGraphDistance() {
+	graph, err := LoadRoadGraph(strings.NewReader(testRoadGraph))
+	suite.FatalNoError(err)
+
+	augusta := LatLong{Latitude: 33.457921, Longitude: -82.068935}
+	columbia := LatLong{Latitude: 34.020988, Longitude: -81.197778}
+
+	suite.Run("uses the shortest path between nodes", func() {
+		distance, err := graph.Distance(augusta, columbia)
+		suite.NoError(err)
+		suite.InDelta(75, distance, 0.01)
+	})
+
+	suite.Run("is the same in both directions", func() {
+		distance, err := graph.Distance(columbia, augusta)
+		suite.NoError(err)
+		suite.InDelta(75, distance, 0.01)
+	})
+
+	suite.Run("includes the distance to the closest node", func() {
+		nearAugusta := LatLong{Latitude: 33.5, Longitude: -82.068935}
+		distance, err := graph.Distance(nearAugusta, columbia)
+		suite.NoError(err)
+		suite.InDelta(75+greatCircleMiles(nearAugusta, augusta), distance, 0.01)
+	})
+
+	suite.Run("fails when no road connects the locations", func() {
+		_, err := graph.Distance(augusta, LatLong{Latitude: 40, Longitude: -100})
+		suite.Error(err)
+		suite.Contains(err.Error(), "no road connects")
+	})
+}
@@ -0,0 +1,98 @@
+package route
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// zipPair is an unordered pair of Zip5s used as a key into a ZipDistanceTable
+type zipPair struct {
+	low  string
+	high string
+}
+
+// newZipPair orders the zips so lookups are the same regardless of direction
+func newZipPair(zipA string, zipB string) zipPair {
+	if zipA > zipB {
+		return zipPair{low: zipB, high: zipA}
+	}
+	return zipPair{low: zipA, high: zipB}
+}
+
+// ZipDistanceTable holds precomputed driving distances in miles between pairs of Zip5s.
+// Distances are treated as symmetric, so a single row serves both directions.
+type ZipDistanceTable struct {
+	distances map[zipPair]int
+}
+
+// LoadZipDistanceTable reads a CSV of precomputed Zip5 to Zip5 distances.
+// Each record is "origin_zip5,destination_zip5,miles"; an optional header row and lines starting with # are skipped.
+func LoadZipDistanceTable(r io.Reader) (*ZipDistanceTable, error) {
+	reader := csv.NewReader(r)
+	reader.Comment = '#'
+	reader.FieldsPerRecord = 3
+	reader.TrimLeadingSpace = true
+
+	table := &ZipDistanceTable{distances: make(map[zipPair]int)}
+	for line := 1; ; line++ {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("unable to read zip distance table: %w", err)
+		}
+
+		// Allow a header row
+		if line == 1 && strings.EqualFold(record[0], "origin_zip5") {
+			continue
+		}
+
+		origin, err := normalizeZip5(record[0])
+		if err != nil {
+			return nil, fmt.Errorf("zip distance table line %d: %w", line, err)
+		}
+		destination, err := normalizeZip5(record[1])
+		if err != nil {
+			return nil, fmt.Errorf("zip distance table line %d: %w", line, err)
+		}
+		miles, err := strconv.ParseFloat(strings.TrimSpace(record[2]), 64)
+		if err != nil || miles < 0 {
+			return nil, fmt.Errorf("zip distance table line %d: invalid miles %q", line, record[2])
+		}
+
+		table.distances[newZipPair(origin, destination)] = int(miles + 0.5)
+	}
+
+	return table, nil
+}
+
+// Distance returns the distance in miles between two Zip5s and whether the pair was found in the table
+func (t *ZipDistanceTable) Distance(zipA string, zipB string) (int, bool) {
+	distance, ok := t.distances[newZipPair(zipA, zipB)]
+	return distance, ok
+}
+
+// Len returns the number of zip pairs in the table
+func (t *ZipDistanceTable) Len() int {
+	return len(t.distances)
+}
+
+// normalizeZip5 trims a zip down to its first 5 digits, padding zips that lost their leading zeros
+func normalizeZip5(zip string) (string, error) {
+	zip5 := formatZip5(strings.TrimSpace(zip))
+	if len(zip5) < 5 {
+		zip5 = fmt.Sprintf("%05s", zip5)
+	}
+	if len(zip5) != 5 {
+		return "", fmt.Errorf("zip %q must be 5 digits", zip)
+	}
+	if _, err := strconv.Atoi(zip5); err != nil {
+		return "", fmt.Errorf("zip %q must be numeric", zip)
+	}
+	return zip5, nil
+}
@@ -17,9 +17,11 @@ import (
 
 	"github.com/transcom/mymove/pkg/appcontext"
 	"github.com/transcom/mymove/pkg/auth"
+	"github.com/transcom/mymove/pkg/models"
 )
 
-// Capture captures an audit record
+// Capture captures an audit record. It has to be called in the transaction that made the change, such as the one
+// AuditableAppContextFromRequestWithErrors opens, so the record has the change's before and after.
 func Capture(appCtx appcontext.AppContext, model interface{}, payload interface{}, request *http.Request) ([]zap.Field, error) {
 	var logItems []zap.Field
 	eventType := extractEventType(request)
@@ -28,9 +30,12 @@ func Capture(appCtx appcontext.AppContext, model interface{}, payload interface{
 	logItems = append(logItems, zap.String("event_type", eventType))
 	logItems = append(logItems, extractResponsibleUser(appCtx.Session())...)
 
+	event := newAuditEvent(eventType, appCtx.Session())
+
 	item, err := validateInterface(model)
 	if err == nil && reflect.ValueOf(model).IsValid() && !reflect.ValueOf(model).IsNil() && !reflect.ValueOf(model).IsZero() {
 		logItems = append(logItems, extractRecordInformation(item, model)...)
+		setAuditEventRecord(&event, item, model)
 
 		if payload != nil {
 			_, err = validateInterface(payload)
@@ -50,6 +55,8 @@ func Capture(appCtx appcontext.AppContext, model interface{}, payload interface{
 			}
 
 			logItems = append(logItems, zap.String("fields_changed", strings.Join(payloadFields, ",")))
+			fieldsChanged := strings.Join(payloadFields, ",")
+			event.FieldsChanged = &fieldsChanged
 
 			var payloadJSON []byte
 			payloadJSON, err = json.Marshal(payload)
@@ -59,6 +66,11 @@ func Capture(appCtx appcontext.AppContext, model interface{}, payload interface{
 			}
 
 			appCtx.Logger().Info("Audit patch payload", zap.String("patch_payload", string(payloadJSON)))
+
+			err = json.Unmarshal(payloadJSON, &event.Payload)
+			if err != nil {
+				return nil, err
+			}
 		}
 	} else {
 		msg += " invalid or zero or nil model interface received from request handler"
@@ -69,6 +81,10 @@ func Capture(appCtx appcontext.AppContext, model interface{}, payload interface{
 
 	appCtx.Logger().Info(msg, logItems...)
 
+	if err := saveAuditEvent(appCtx, &event); err != nil {
+		return logItems, err
+	}
+
 	return logItems, nil
 }
 
@@ -80,9 +96,13 @@ func CaptureAccountStatus(appCtx appcontext.AppContext, model interface{}, activ
 	logItems = append(logItems, zap.String("event_type", eventType))
 	logItems = append(logItems, extractResponsibleUser(appCtx.Session())...)
 
+	event := newAuditEvent(eventType, appCtx.Session())
+
 	item, err := validateInterface(model)
 	if err == nil && reflect.ValueOf(model).IsValid() && !reflect.ValueOf(model).IsNil() && !reflect.ValueOf(model).IsZero() {
 		logItems = append(logItems, extractRecordInformation(item, model)...)
+		setAuditEventRecord(&event, item, model)
+		event.ActiveValue = &activeValue
 
 		// Create log message and view value of active
 		activeMessage := "disabled"
@@ -100,6 +120,11 @@ func CaptureAccountStatus(appCtx appcontext.AppContext, model interface{}, activ
 	}
 
 	appCtx.Logger().Info(msg, logItems...)
+
+	if err := saveAuditEvent(appCtx, &event); err != nil {
+		return logItems, err
+	}
+
 	return logItems, nil
 }
 
@@ -118,6 +143,157 @@ func extractResponsibleUser(session *auth.Session) []zap.Field {
 	return logItems
 }
 
+func newAuditEvent(eventType string, session *auth.Session) models.AuditEvent {
+	event := models.AuditEvent{EventType: eventType}
+	if session.UserID != uuid.Nil {
+		userID := session.UserID
+		event.ResponsibleUserID = &userID
+	}
+	if session.Email != "" {
+		email := session.Email
+		event.ResponsibleUserEmail = &email
+	}
+	if session.IsAdminUser() || session.IsOfficeUser() {
+		name := fullName(session.FirstName, session.LastName)
+		event.ResponsibleUserName = &name
+	}
+	return event
+}
+
+func setAuditEventRecord(event *models.AuditEvent, item reflect.Type, model interface{}) {
+	recordType := parseRecordType(item.String())
+	event.RecordType = &recordType
+
+	elem := reflect.ValueOf(model).Elem()
+	if elem.FieldByName("ID").IsValid() {
+		if id, ok := elem.FieldByName("ID").Interface().(uuid.UUID); ok {
+			event.RecordID = &id
+		}
+	}
+}
+
+// saveAuditEvent persists the audit event along with the before and after of every audited row changed by the
+// transaction that made the change, so the event can be searched for and matched with the audit_history rows
+func saveAuditEvent(appCtx appcontext.AppContext, event *models.AuditEvent) error {
+	changes, err := fetchAuditTransactionChanges(appCtx, event)
+	if err != nil {
+		return err
+	}
+	event.BeforeData, event.AfterData, err = diffAuditHistories(changes)
+	if err != nil {
+		return err
+	}
+
+	verrs, err := appCtx.DB().ValidateAndCreate(event)
+	if verrs.HasAny() {
+		return verrs
+	}
+	return err
+}
+
+// fetchAuditTransactionChanges returns the audit_history rows of the transaction that made the change and sets the
+// event's transaction id. Capture has to be called in that transaction, which the auditable handlers open around
+// the change, since the rows can't be told apart from other changes to the record once it has committed.
+func fetchAuditTransactionChanges(appCtx appcontext.AppContext, event *models.AuditEvent) (models.AuditHistories, error) {
+	if appCtx.DB().TX == nil {
+		return nil, errors.New("audit events must be captured in the transaction that made the change")
+	}
+
+	err := appCtx.DB().RawQuery("SELECT txid_current()").First(&event.TransactionID)
+	if err != nil {
+		return nil, err
+	}
+
+	var changes models.AuditHistories
+	err = appCtx.DB().Where("transaction_id = ?", event.TransactionID).Order("action_tstamp_clk ASC").All(&changes)
+	return changes, err
+}
+
+// diffAuditHistories collects the old and new values of the columns changed by the audit_history rows of a
+// transaction, keyed by table name then object id. Rows that were deleted have a nil after value.
+func diffAuditHistories(changes models.AuditHistories) (models.JSONMap, models.JSONMap, error) {
+	if len(changes) == 0 {
+		return nil, nil, nil
+	}
+
+	before := models.JSONMap{}
+	after := models.JSONMap{}
+	inserted := map[string]bool{}
+	for _, change := range changes {
+		var oldData, changedData map[string]interface{}
+		if change.OldData != nil {
+			if err := json.Unmarshal([]byte(*change.OldData), &oldData); err != nil {
+				return nil, nil, err
+			}
+		}
+		if change.ChangedData != nil {
+			if err := json.Unmarshal([]byte(*change.ChangedData), &changedData); err != nil {
+				return nil, nil, err
+			}
+		}
+
+		objectID := ""
+		if change.ObjectID != nil {
+			objectID = change.ObjectID.String()
+		}
+		tableAfter := auditTableData(after, change.AuditedTable)
+
+		switch change.Action {
+		case "INSERT":
+			inserted[change.AuditedTable+objectID] = true
+			rowAfter := auditRowData(tableAfter, objectID)
+			for column, value := range changedData {
+				rowAfter[column] = value
+			}
+		case "UPDATE":
+			rowAfter := auditRowData(tableAfter, objectID)
+			for column, value := range changedData {
+				rowAfter[column] = value
+			}
+			// rows the transaction inserted have nothing before
+			if inserted[change.AuditedTable+objectID] {
+				continue
+			}
+			rowBefore := auditRowData(auditTableData(before, change.AuditedTable), objectID)
+			for column := range changedData {
+				// keep the value from before the first change the transaction made
+				if _, ok := rowBefore[column]; !ok {
+					rowBefore[column] = oldData[column]
+				}
+			}
+		case "DELETE":
+			if inserted[change.AuditedTable+objectID] {
+				delete(tableAfter, objectID)
+				continue
+			}
+			tableBefore := auditTableData(before, change.AuditedTable)
+			if _, ok := tableBefore[objectID]; !ok {
+				tableBefore[objectID] = oldData
+			}
+			tableAfter[objectID] = nil
+		}
+	}
+	return before, after, nil
+}
+
+func auditTableData(data models.JSONMap, table string) map[string]interface{} {
+	tableData, ok := data[table].(map[string]interface{})
+	if !ok {
+		tableData = map[string]interface{}{}
+		data[table] = tableData
+	}
+	return tableData
+}
+
+func auditRowData(tableData map[string]interface{}, objectID string) map[string]interface{} {
+	rowData, ok := tableData[objectID].(map[string]interface{})
+	if !ok {
+		rowData = map[string]interface{}{}
+		tableData[objectID] = rowData
+	}
+	return rowData
+}
+
 func extractRecordInformation(item reflect.Type, model interface{}) []zap.Field {
 	var logItems []zap.Field
 	recordType := parseRecordType(item.String())
@@ -0,0 +1,23 @@
+package audit
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/transcom/mymove/pkg/testingsuite"
+)
+
+type AuditSuite struct {
+	*testingsuite.PopTestSuite
+}
+
+func TestAuditSuite(t *testing.T) {
+
+	ts := &AuditSuite{
+		PopTestSuite: testingsuite.NewPopTestSuite(testingsuite.CurrentPackage(),
+			testingsuite.WithPerTestTransaction()),
+	}
+	suite.Run(t, ts)
+	ts.PopTestSuite.TearDown()
+}
@@ -6,16 +6,18 @@ import (
 	"testing"
 	"time"
 
+	"github.com/gobuffalo/pop/v6"
 	"github.com/gofrs/uuid"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/zap"
 
 	"github.com/transcom/mymove/pkg/appcontext"
 	"github.com/transcom/mymove/pkg/auth"
+	"github.com/transcom/mymove/pkg/factory"
 	"github.com/transcom/mymove/pkg/models"
 )
 
-func TestCapture(t *testing.T) {
+func (suite *AuditSuite) TestCapture() {
 	uuidString := "77c9922f-58c7-45cd-8c10-48f2a52bb55d"
 	officeUserID, _ := uuid.FromString(uuidString)
 	model := models.OfficeUser{
@@ -28,9 +30,8 @@ func TestCapture(t *testing.T) {
 			Path: "",
 		},
 	}
-	logger := zap.NewNop()
 
-	t.Run("success", func(t *testing.T) {
+	suite.Run("success", func() {
 		uuidString := "88c9922f-58c7-45cd-8c10-48f2a52bbabc"
 		adminUserID, _ := uuid.FromString(uuidString)
 
@@ -38,7 +39,7 @@ func TestCapture(t *testing.T) {
 			AdminUserID: adminUserID,
 		}
 
-		appCtx := appcontext.NewAppContext(nil, logger, &session, nil)
+		appCtx := suite.AppContextWithSessionForTest(&session)
 
 		req := &http.Request{
 			URL: &url.URL{
@@ -55,13 +56,13 @@ func TestCapture(t *testing.T) {
 			}
 		}
 
-		if assert.NotEmpty(t, zapFields) {
-			assert.Equal(t, "event_type", zapFields[0].Key)
-			assert.Equal(t, "audit_post_admin_users", eventType)
+		if suite.NotEmpty(zapFields) {
+			suite.Equal("event_type", zapFields[0].Key)
+			suite.Equal("audit_post_admin_users", eventType)
 		}
 	})
 
-	t.Run("success with optional patch payload", func(t *testing.T) {
+	suite.Run("success with optional patch payload", func() {
 		uuidString := "88c9922f-58c7-45cd-8c10-48f2a52bbabc"
 		adminUserID, _ := uuid.FromString(uuidString)
 
@@ -84,7 +85,7 @@ func TestCapture(t *testing.T) {
 			AdminUserID: adminUserID,
 		}
 
-		appCtx := appcontext.NewAppContext(nil, logger, &session, nil)
+		appCtx := suite.AppContextWithSessionForTest(&session)
 
 		req := &http.Request{
 			URL: &url.URL{
@@ -107,13 +108,13 @@ func TestCapture(t *testing.T) {
 			}
 		}
 
-		if assert.NotEmpty(t, zapFields) {
-			assert.Equal(t, "active,first_name,last_name,telephone", fieldsChanged)
-			assert.Equal(t, "audit_patch_admin_users", eventType)
+		if suite.NotEmpty(zapFields) {
+			suite.Equal("active,first_name,last_name,telephone", fieldsChanged)
+			suite.Equal("audit_patch_admin_users", eventType)
 		}
 	})
 
-	t.Run("service member session should not include names", func(t *testing.T) {
+	suite.Run("service member session should not include names", func() {
 		uuidString := "88c9922f-58c7-45cd-8c10-48f2a52bbabc"
 		serviceMemberID, _ := uuid.FromString(uuidString)
 
@@ -121,24 +122,24 @@ func TestCapture(t *testing.T) {
 			ServiceMemberID: serviceMemberID,
 		}
 
-		appCtx := appcontext.NewAppContext(nil, logger, &session, nil)
+		appCtx := suite.AppContextWithSessionForTest(&session)
 
 		zapFields, _ := Capture(appCtx, &model, nil, &dummyRequest)
 
-		if assert.NotEmpty(t, zapFields) {
+		if suite.NotEmpty(zapFields) {
 			var keys []string
 			for _, field := range zapFields {
 				keys = append(keys, field.Key)
 			}
 
-			assert.NotContains(t, "responsible_user_name", keys)
+			suite.NotContains("responsible_user_name", keys)
 		}
 	})
 
-	t.Run("success when a non-pointer is passed in", func(t *testing.T) {
+	suite.Run("success when a non-pointer is passed in", func() {
 		session := auth.Session{}
 
-		appCtx := appcontext.NewAppContext(nil, logger, &session, nil)
+		appCtx := suite.AppContextWithSessionForTest(&session)
 
 		zapFields, err := Capture(appCtx, model, nil, &dummyRequest)
 
@@ -148,17 +149,17 @@ func TestCapture(t *testing.T) {
 				eventType = field.String
 			}
 		}
-		assert.Nil(t, err)
-		if assert.NotEmpty(t, zapFields) {
-			assert.Equal(t, "event_type", zapFields[0].Key)
-			assert.Equal(t, "audit__", eventType)
+		suite.Nil(err)
+		if suite.NotEmpty(zapFields) {
+			suite.Equal("event_type", zapFields[0].Key)
+			suite.Equal("audit__", eventType)
 		}
 	})
 
-	t.Run("success when a non-struct is passed in", func(t *testing.T) {
+	suite.Run("success when a non-struct is passed in", func() {
 		session := auth.Session{}
 
-		appCtx := appcontext.NewAppContext(nil, logger, &session, nil)
+		appCtx := suite.AppContextWithSessionForTest(&session)
 
 		invalidArg := 5
 		zapFields, err := Capture(appCtx, &invalidArg, nil, &dummyRequest)
@@ -169,15 +170,15 @@ func TestCapture(t *testing.T) {
 				eventType = field.String
 			}
 		}
-		assert.Nil(t, err)
-		if assert.NotEmpty(t, zapFields) {
-			assert.Equal(t, "event_type", zapFields[0].Key)
-			assert.Equal(t, "audit__", eventType)
+		suite.Nil(err)
+		if suite.NotEmpty(zapFields) {
+			suite.Equal("event_type", zapFields[0].Key)
+			suite.Equal("audit__", eventType)
 		}
 	})
 }
 
-func TestCaptureAccountStatus(t *testing.T) {
+func (suite *AuditSuite) TestCaptureAccountStatus() {
 	uuidStringOffice := "1127bdbd-0610-4e52-9f10-1fa3c063bad3"
 	officeUserID, _ := uuid.FromString(uuidStringOffice)
 	model := models.OfficeUser{
@@ -185,7 +186,6 @@ func TestCaptureAccountStatus(t *testing.T) {
 		CreatedAt: time.Now(),
 		UpdatedAt: time.Now(),
 	}
-	logger := zap.NewNop()
 
 	uuidStringAdmin := "4ad12fe7-1514-4b6b-a35d-ce68e6c5b1fc"
 	adminUserID, _ := uuid.FromString(uuidStringAdmin)
@@ -194,7 +194,7 @@ func TestCaptureAccountStatus(t *testing.T) {
 		AdminUserID: adminUserID,
 	}
 
-	appCtx := appcontext.NewAppContext(nil, logger, &session, nil)
+	appCtx := suite.AppContextWithSessionForTest(&session)
 
 	req := &http.Request{
 		URL: &url.URL{
@@ -203,33 +203,183 @@ func TestCaptureAccountStatus(t *testing.T) {
 		Method: "POST",
 	}
 
-	t.Run("Sucessfully logs account enabled", func(t *testing.T) {
+	suite.Run("Sucessfully logs account enabled", func() {
 		zapFields, _ := CaptureAccountStatus(appCtx, &model, true, req)
 
-		if assert.NotEmpty(t, zapFields) {
+		if suite.NotEmpty(zapFields) {
 			fieldsMap := map[string]string{}
 			for _, f := range zapFields {
 				fieldsMap[f.Key] = f.String
 			}
 
-			assert.Equal(t, "audit_post_admin_users_active_status_changed", fieldsMap["event_type"])
-			assert.Equal(t, "true", fieldsMap["active_value"])
+			suite.Equal("audit_post_admin_users_active_status_changed", fieldsMap["event_type"])
+			suite.Equal("true", fieldsMap["active_value"])
 		}
 	})
 
-	t.Run("Sucessfully logs account disabled", func(t *testing.T) {
+	suite.Run("Sucessfully logs account disabled", func() {
 		zapFields, _ := CaptureAccountStatus(appCtx, &model, false, req)
 
-		if assert.NotEmpty(t, zapFields) {
+		if suite.NotEmpty(zapFields) {
 			fieldsMap := map[string]string{}
 			for _, f := range zapFields {
 				fieldsMap[f.Key] = f.String
 			}
 
-			assert.Equal(t, "audit_post_admin_users_active_status_changed", fieldsMap["event_type"])
-			assert.Equal(t, "false", fieldsMap["active_value"])
+			suite.Equal("audit_post_admin_users_active_status_changed", fieldsMap["event_type"])
+			suite.Equal("false", fieldsMap["active_value"])
+		}
+	})
+}
+
+func (suite *AuditSuite) TestCapturePersistsAuditEvent() {
+	req := &http.Request{
+		URL: &url.URL{
+			Path: "/admin/v1/office-users/77c9922f-58c7-45cd-8c10-48f2a52bb55d",
+		},
+		Method: "PATCH",
+	}
+
+	suite.Run("saves the event with the before and after of the change", func() {
+		officeUser := factory.BuildOfficeUser(suite.DB(), []factory.Customization{
+			{
+				Model: models.OfficeUser{
+					FirstName: "Leo",
+				},
+			},
+		}, nil)
+		adminUser := factory.BuildDefaultAdminUser(suite.DB())
+		session := auth.Session{
+			ApplicationName: auth.AdminApp,
+			AdminUserID:     adminUser.ID,
+			UserID:          *adminUser.UserID,
+			Email:           adminUser.Email,
+			FirstName:       adminUser.FirstName,
+			LastName:        adminUser.LastName,
+		}
+		appCtx := suite.AppContextWithSessionForTest(&session)
+
+		officeUser.FirstName = "Leonard"
+		suite.MustSave(&officeUser)
+
+		type fakePatchPayload struct {
+			FirstName string `json:"firstName,omitempty"`
 		}
+		_, err := Capture(appCtx, &officeUser, &fakePatchPayload{FirstName: "Leonard"}, req)
+		suite.NoError(err)
+
+		var events models.AuditEvents
+		suite.NoError(suite.DB().Where("record_id = ?", officeUser.ID).All(&events))
+		suite.Len(events, 1)
+		event := events[0]
+		suite.Equal("audit_patch_office_users", event.EventType)
+		suite.Equal("OfficeUser", *event.RecordType)
+		suite.Equal(*adminUser.UserID, *event.ResponsibleUserID)
+		suite.Equal(adminUser.Email, *event.ResponsibleUserEmail)
+		suite.Equal("first_name", *event.FieldsChanged)
+		suite.Equal("Leonard", event.Payload["firstName"])
+		suite.NotZero(event.TransactionID)
+
+		var auditHistoryCount int
+		err = suite.DB().RawQuery("SELECT COUNT(*) FROM audit_history WHERE transaction_id = $1 AND object_id = $2", event.TransactionID, officeUser.ID).First(&auditHistoryCount)
+		suite.NoError(err)
+		suite.NotZero(auditHistoryCount)
+
+		// the office user was created in the same test transaction, so there is no before for it
+		suite.Nil(event.BeforeData["office_users"])
+		after := event.AfterData["office_users"].(map[string]interface{})[officeUser.ID.String()].(map[string]interface{})
+		suite.Equal("Leonard", after["first_name"])
 	})
+
+	suite.Run("saves the active value of account status changes", func() {
+		officeUser := factory.BuildOfficeUser(suite.DB(), nil, nil)
+		appCtx := suite.AppContextWithSessionForTest(&auth.Session{})
+
+		_, err := CaptureAccountStatus(appCtx, &officeUser, false, req)
+		suite.NoError(err)
+
+		var event models.AuditEvent
+		suite.NoError(suite.DB().Where("record_id = ?", officeUser.ID).First(&event))
+		suite.Equal("audit_patch_office_users_active_status_changed", event.EventType)
+		suite.False(*event.ActiveValue)
+		suite.Nil(event.ResponsibleUserID)
+	})
+}
+
+func TestCaptureOutsideTheChangeTransaction(t *testing.T) {
+	req := &http.Request{
+		URL: &url.URL{
+			Path: "/admin/v1/office-users/77c9922f-58c7-45cd-8c10-48f2a52bb55d",
+		},
+		Method: "PATCH",
+	}
+	officeUser := models.OfficeUser{ID: uuid.Must(uuid.NewV4())}
+	// a connection that isn't in a transaction
+	appCtx := appcontext.NewAppContext(&pop.Connection{}, zap.NewNop(), &auth.Session{}, nil)
+
+	_, err := Capture(appCtx, &officeUser, nil, req)
+	assert.ErrorContains(t, err, "in the transaction that made the change")
+}
+
+func TestDiffAuditHistories(t *testing.T) {
+	updatedID := uuid.Must(uuid.NewV4())
+	insertedID := uuid.Must(uuid.NewV4())
+	deletedID := uuid.Must(uuid.NewV4())
+
+	changes := models.AuditHistories{
+		{
+			AuditedTable: "office_users",
+			ObjectID:     &updatedID,
+			Action:       "UPDATE",
+			OldData:      models.StringPointer(`{"first_name": "Leo", "last_name": "Spaceman"}`),
+			ChangedData:  models.StringPointer(`{"first_name": "Leonard"}`),
+		},
+		{
+			AuditedTable: "office_users",
+			ObjectID:     &updatedID,
+			Action:       "UPDATE",
+			OldData:      models.StringPointer(`{"first_name": "Leonard", "last_name": "Spaceman"}`),
+			ChangedData:  models.StringPointer(`{"first_name": "Leon"}`),
+		},
+		{
+			AuditedTable: "users_roles",
+			ObjectID:     &insertedID,
+			Action:       "INSERT",
+			ChangedData:  models.StringPointer(`{"role_id": "a"}`),
+		},
+		{
+			AuditedTable: "users_roles",
+			ObjectID:     &insertedID,
+			Action:       "UPDATE",
+			OldData:      models.StringPointer(`{"role_id": "a"}`),
+			ChangedData:  models.StringPointer(`{"role_id": "b"}`),
+		},
+		{
+			AuditedTable: "users_roles",
+			ObjectID:     &deletedID,
+			Action:       "DELETE",
+			OldData:      models.StringPointer(`{"role_id": "c"}`),
+		},
+	}
+
+	before, after, err := diffAuditHistories(changes)
+	if assert.NoError(t, err) {
+		assert.Equal(t, map[string]interface{}{"first_name": "Leo"}, before["office_users"].(map[string]interface{})[updatedID.String()])
+		assert.Equal(t, map[string]interface{}{"first_name": "Leon"}, after["office_users"].(map[string]interface{})[updatedID.String()])
+
+		beforeRoles := before["users_roles"].(map[string]interface{})
+		afterRoles := after["users_roles"].(map[string]interface{})
+		assert.NotContains(t, beforeRoles, insertedID.String())
+		assert.Equal(t, map[string]interface{}{"role_id": "b"}, afterRoles[insertedID.String()])
+		assert.Equal(t, map[string]interface{}{"role_id": "c"}, beforeRoles[deletedID.String()])
+		assert.Contains(t, afterRoles, deletedID.String())
+		assert.Nil(t, afterRoles[deletedID.String()])
+	}
+
+	before, after, err = diffAuditHistories(nil)
+	assert.NoError(t, err)
+	assert.Nil(t, before)
+	assert.Nil(t, after)
 }
 
 func TestExtractResponsibleUser(t *testing.T) {
@@ -0,0 +1,63 @@
+package services
+
+import (
+	"time"
+
+	"github.com/gofrs/uuid"
+
+	"github.com/transcom/mymove/pkg/appcontext"
+	"github.com/transcom/mymove/pkg/models"
+	"github.com/transcom/mymove/pkg/unit"
+)
+
+// ContractRepricingParams picks the payment requests to reprice and the contract to price them under
+type ContractRepricingParams struct {
+	CandidateContractCode string
+	// PaymentRequestNumbers limits the repricing to these payment requests
+	PaymentRequestNumbers []string
+	// CreatedFrom and CreatedTo limit the repricing to payment requests created within the dates
+	CreatedFrom *time.Time
+	CreatedTo   *time.Time
+}
+
+// RepricedServiceItem compares the billed price of a payment service item with its price under the
+// candidate contract
+type RepricedServiceItem struct {
+	PaymentServiceItemID uuid.UUID
+	ServiceCode          models.ReServiceCode
+	OriginalContractCode string
+	OriginalPriceCents   unit.Cents
+	CandidatePriceCents  unit.Cents
+	DeltaCents           unit.Cents
+	// Error is set when the service item could not be priced under the candidate contract
+	Error string
+}
+
+// RepricedPaymentRequest totals the repriced service items of a payment request
+type RepricedPaymentRequest struct {
+	PaymentRequestID     uuid.UUID
+	PaymentRequestNumber string
+	OriginalTotalCents   unit.Cents
+	CandidateTotalCents  unit.Cents
+	DeltaCents           unit.Cents
+	ServiceItems         []RepricedServiceItem
+}
+
+// ContractRepricingReport is the result of repricing payment requests under a candidate contract.
+// The totals only include service items that could be priced under both contracts.
+type ContractRepricingReport struct {
+	CandidateContractCode string
+	PaymentRequests       []RepricedPaymentRequest
+	OriginalTotalCents    unit.Cents
+	CandidateTotalCents   unit.Cents
+	DeltaCents            unit.Cents
+	RepricedCount         int
+	FailedCount           int
+}
+
+// ContractRepricer prices historical payment requests under a different contract without saving anything
+//
+//go:generate mockery --name ContractRepricer
+type ContractRepricer interface {
+	RepriceUnderContract(appCtx appcontext.AppContext, params ContractRepricingParams) (*ContractRepricingReport, error)
+}
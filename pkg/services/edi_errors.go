@@ -14,3 +14,25 @@ type EDIErrorFetcher interface {
 	FetchEdiErrors(appCtx appcontext.AppContext, pagination Pagination) (models.EdiErrors, int, error)
 	FetchEdiErrorByID(appCtx appcontext.AppContext, id uuid.UUID) (models.EdiError, error)
 }
+
+// EDIErrorGroup is the open EDI errors that share an EDI type and error code
+type EDIErrorGroup struct {
+	EDIType models.EDIType
+	// Code is the TED code from an 824, the AK code from a 997, or empty for errors MilMove found
+	// before sending the 858
+	Code              string
+	Count             int
+	PaymentRequestIDs []uuid.UUID
+	// ProposedFix describes what usually needs to change before the payment requests can be resubmitted
+	ProposedFix string
+	// Resubmittable is false for errors that resubmitting cannot fix, such as invoices Syncada has already paid
+	Resubmittable bool
+}
+
+// EDIErrorTriager is the exported interface for working through payment requests Syncada rejected
+//
+//go:generate mockery --name EDIErrorTriager
+type EDIErrorTriager interface {
+	TriageEdiErrors(appCtx appcontext.AppContext) ([]EDIErrorGroup, error)
+	ResubmitPaymentRequest(appCtx appcontext.AppContext, paymentRequestID uuid.UUID, eTag string) (*models.PaymentRequest, error)
+}
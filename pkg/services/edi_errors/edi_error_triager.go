@@ -0,0 +1,237 @@
+package edi_errors
+
+import (
+	"database/sql"
+	"fmt"
+	"regexp"
+	"sort"
+	"time"
+
+	"github.com/gofrs/uuid"
+
+	"github.com/transcom/mymove/pkg/appcontext"
+	"github.com/transcom/mymove/pkg/apperror"
+	edisegment "github.com/transcom/mymove/pkg/edi/segment"
+	"github.com/transcom/mymove/pkg/etag"
+	"github.com/transcom/mymove/pkg/models"
+	"github.com/transcom/mymove/pkg/services"
+)
+
+type ediErrorTriager struct{}
+
+// NewEDIErrorTriager returns an instance that implements the EDIErrorTriager interface
+func NewEDIErrorTriager() services.EDIErrorTriager {
+	return &ediErrorTriager{}
+}
+
+// proposedFix is the usual way to clear an EDI error
+type proposedFix struct {
+	fix           string
+	resubmittable bool
+}
+
+// tedFixes are the fixes for the TED codes sent in an 824, which the 858 business rules also use
+var tedFixes = map[string]proposedFix{
+	edisegment.TEDErrorCodeMissingData: {
+		fix:           "A required value is missing from the invoice. Check that the orders have a TAC and a complete line of accounting, then resubmit.",
+		resubmittable: true,
+	},
+	edisegment.TEDErrorCodeDuplicate: {
+		fix:           "Syncada already has an invoice with this control number. Resubmitting sends the invoice with a new ICN.",
+		resubmittable: true,
+	},
+	edisegment.TEDErrorCodeInvalidIdentificationCode: {
+		fix:           "An identifier on the invoice was not recognized. Check the TAC, SAC and GBLOC on the orders, then resubmit.",
+		resubmittable: true,
+	},
+	edisegment.TEDErrorCodePreviouslyPaid: {
+		fix:           "Syncada has already paid this invoice. Do not resubmit it.",
+		resubmittable: false,
+	},
+	edisegment.TEDErrorCodeMutuallyDefined: {
+		fix:           "Syncada rejected the invoice for the reason in the description. Correct the move, then resubmit.",
+		resubmittable: true,
+	},
+}
+
+var (
+	missingLineOfAccountingFix = proposedFix{
+		fix:           "The orders are missing line of accounting data. Add the TAC and line of accounting to the orders, then resubmit.",
+		resubmittable: true,
+	}
+	milMoveFix = proposedFix{
+		fix:           "MilMove could not generate or send the 858. Correct the move data named in the description, then resubmit.",
+		resubmittable: true,
+	}
+	invalidResponseFix = proposedFix{
+		fix:           "MilMove could not validate the 824 Syncada sent back. Check the response file before resubmitting.",
+		resubmittable: true,
+	}
+	syntaxFix = proposedFix{
+		fix:           "Syncada rejected the file as malformed. Resubmitting regenerates the 858 with a new ICN.",
+		resubmittable: true,
+	}
+	unknownFix = proposedFix{
+		fix:           "There is no known fix for this error. Review the description before resubmitting.",
+		resubmittable: true,
+	}
+)
+
+var lineOfAccountingPattern = regexp.MustCompile(`(?i)\b(TAC|LOA|line of accounting)\b`)
+
+// proposeFix matches an error to one of the known ways to clear it
+func proposeFix(ediError models.EdiError) proposedFix {
+	code := ""
+	if ediError.Code != nil {
+		code = *ediError.Code
+	}
+	description := ""
+	if ediError.Description != nil {
+		description = *ediError.Description
+	}
+
+	switch ediError.EDIType {
+	case models.EDIType997:
+		return syntaxFix
+	case models.EDIType824:
+		if code == "MilMove" {
+			return invalidResponseFix
+		}
+	case models.EDIType858:
+		if lineOfAccountingPattern.MatchString(description) {
+			return missingLineOfAccountingFix
+		}
+		if code == "" {
+			return milMoveFix
+		}
+	}
+
+	if fix, ok := tedFixes[code]; ok {
+		return fix
+	}
+	return unknownFix
+}
+
+// TriageEdiErrors groups the open errors of payment requests in EDI_ERROR status by EDI type, code and
+// proposed fix. The largest groups come first.
+func (t *ediErrorTriager) TriageEdiErrors(appCtx appcontext.AppContext) ([]services.EDIErrorGroup, error) {
+	var ediErrors models.EdiErrors
+	err := appCtx.DB().Q().
+		Join("payment_requests", "payment_requests.id = edi_errors.payment_request_id").
+		Where("payment_requests.status = ?", models.PaymentRequestStatusEDIError).
+		Where("edi_errors.resolved_at IS NULL").
+		Order("edi_errors.created_at ASC").
+		All(&ediErrors)
+	if err != nil {
+		return nil, apperror.NewQueryError("EdiErrors", err, "Could not fetch EDI errors to triage")
+	}
+
+	type groupKey struct {
+		ediType models.EDIType
+		code    string
+		fix     string
+	}
+	var groups []services.EDIErrorGroup
+	groupIndexes := map[groupKey]int{}
+	paymentRequestsSeen := map[groupKey]map[uuid.UUID]bool{}
+
+	for _, ediError := range ediErrors {
+		fix := proposeFix(ediError)
+		key := groupKey{ediType: ediError.EDIType, fix: fix.fix}
+		if ediError.Code != nil {
+			key.code = *ediError.Code
+		}
+
+		i, ok := groupIndexes[key]
+		if !ok {
+			i = len(groups)
+			groupIndexes[key] = i
+			paymentRequestsSeen[key] = map[uuid.UUID]bool{}
+			groups = append(groups, services.EDIErrorGroup{
+				EDIType:       key.ediType,
+				Code:          key.code,
+				ProposedFix:   fix.fix,
+				Resubmittable: fix.resubmittable,
+			})
+		}
+
+		groups[i].Count++
+		if !paymentRequestsSeen[key][ediError.PaymentRequestID] {
+			paymentRequestsSeen[key][ediError.PaymentRequestID] = true
+			groups[i].PaymentRequestIDs = append(groups[i].PaymentRequestIDs, ediError.PaymentRequestID)
+		}
+	}
+
+	sort.SliceStable(groups, func(i, j int) bool {
+		return groups[i].Count > groups[j].Count
+	})
+	return groups, nil
+}
+
+// ResubmitPaymentRequest marks the open errors of a payment request in EDI_ERROR status as resolved and
+// puts it back in REVIEWED status, so the next run of the Syncada send job regenerates its 858 with a
+// new ICN and sends it again.
+func (t *ediErrorTriager) ResubmitPaymentRequest(appCtx appcontext.AppContext, paymentRequestID uuid.UUID, eTag string) (*models.PaymentRequest, error) {
+	var paymentRequest models.PaymentRequest
+	err := appCtx.DB().Q().Eager("EdiErrors").Find(&paymentRequest, paymentRequestID)
+	if err != nil {
+		switch err {
+		case sql.ErrNoRows:
+			return nil, apperror.NewNotFoundError(paymentRequestID, "looking for PaymentRequest")
+		default:
+			return nil, apperror.NewQueryError("PaymentRequest", err, "")
+		}
+	}
+
+	if etag.GenerateEtag(paymentRequest.UpdatedAt) != eTag {
+		return nil, apperror.NewPreconditionFailedError(paymentRequestID, nil)
+	}
+
+	if paymentRequest.Status != models.PaymentRequestStatusEDIError {
+		return nil, apperror.NewConflictError(paymentRequestID, fmt.Sprintf("only payment requests in %s status can be resubmitted, this one is %s", models.PaymentRequestStatusEDIError, paymentRequest.Status))
+	}
+
+	var openErrors models.EdiErrors
+	for _, ediError := range paymentRequest.EdiErrors {
+		if ediError.ResolvedAt != nil {
+			continue
+		}
+		if fix := proposeFix(ediError); !fix.resubmittable {
+			return nil, apperror.NewUnprocessableEntityError(fix.fix)
+		}
+		openErrors = append(openErrors, ediError)
+	}
+
+	now := time.Now()
+	var resolvedBy *uuid.UUID
+	if session := appCtx.Session(); session != nil && session.OfficeUserID != uuid.Nil {
+		resolvedBy = &session.OfficeUserID
+	}
+
+	txnErr := appCtx.NewTransaction(func(txnAppCtx appcontext.AppContext) error {
+		for i := range openErrors {
+			openErrors[i].ResolvedAt = &now
+			openErrors[i].ResolvedByOfficeUserID = resolvedBy
+			if err := txnAppCtx.DB().Update(&openErrors[i]); err != nil {
+				return apperror.NewQueryError("EdiError", err, "Could not resolve EDI error")
+			}
+		}
+
+		paymentRequest.Status = models.PaymentRequestStatusReviewed
+		paymentRequest.SentToGexAt = nil
+		paymentRequest.ReceivedByGexAt = nil
+		verrs, err := txnAppCtx.DB().ValidateAndUpdate(&paymentRequest)
+		if verrs != nil && verrs.HasAny() {
+			return apperror.NewInvalidInputError(paymentRequestID, err, verrs, "")
+		}
+		if err != nil {
+			return apperror.NewQueryError("PaymentRequest", err, "Could not resubmit payment request")
+		}
+		return nil
+	})
+	if txnErr != nil {
+		return nil, txnErr
+	}
+
+	return &paymentRequest, nil
+}
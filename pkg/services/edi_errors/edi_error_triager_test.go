@@ -0,0 +1,152 @@
+package edi_errors
+
+import (
+	"time"
+
+	"github.com/gofrs/uuid"
+
+	"github.com/transcom/mymove/pkg/apperror"
+	edisegment "github.com/transcom/mymove/pkg/edi/segment"
+	"github.com/transcom/mymove/pkg/etag"
+	"github.com/transcom/mymove/pkg/models"
+	"github.com/transcom/mymove/pkg/testdatagen"
+)
+
+func (suite *EdiErrorsSuite) makeEdiError(paymentRequest models.PaymentRequest, ediType models.EDIType, code *string, description string) models.EdiError {
+	return testdatagen.MakeEdiError(suite.DB(), testdatagen.Assertions{
+		EdiError: models.EdiError{
+			PaymentRequestID: paymentRequest.ID,
+			Code:             code,
+			EDIType:          ediType,
+			Description:      &description,
+		},
+	})
+}
+
+func (suite *EdiErrorsSuite) makeRejectedPaymentRequest() models.PaymentRequest {
+	sentToGexAt := time.Now()
+	return testdatagen.MakePaymentRequest(suite.DB(), testdatagen.Assertions{
+		PaymentRequest: models.PaymentRequest{
+			Status:      models.PaymentRequestStatusEDIError,
+			SentToGexAt: &sentToGexAt,
+		},
+	})
+}
+
+func (suite *EdiErrorsSuite) TestTriageEdiErrors() {
+	triager := NewEDIErrorTriager()
+	missingData := edisegment.TEDErrorCodeMissingData
+	previouslyPaid := edisegment.TEDErrorCodePreviouslyPaid
+
+	suite.Run("groups open errors by code and proposes fixes", func() {
+		first := suite.makeRejectedPaymentRequest()
+		second := suite.makeRejectedPaymentRequest()
+		suite.makeEdiError(first, models.EDIType824, &missingData, "N1 missing")
+		suite.makeEdiError(first, models.EDIType824, &missingData, "FA2 missing")
+		suite.makeEdiError(second, models.EDIType824, &missingData, "N1 missing")
+		suite.makeEdiError(second, models.EDIType824, &previouslyPaid, "paid")
+		suite.makeEdiError(second, models.EDIType858, nil, "Invalid order. Must have an HHG TAC value")
+
+		groups, err := triager.TriageEdiErrors(suite.AppContextForTest())
+		suite.NoError(err)
+		suite.Len(groups, 3)
+
+		suite.Equal(models.EDIType824, groups[0].EDIType)
+		suite.Equal(missingData, groups[0].Code)
+		suite.Equal(3, groups[0].Count)
+		suite.ElementsMatch([]uuid.UUID{first.ID, second.ID}, groups[0].PaymentRequestIDs)
+		suite.True(groups[0].Resubmittable)
+
+		for _, group := range groups[1:] {
+			suite.Equal(1, group.Count)
+			switch group.EDIType {
+			case models.EDIType824:
+				suite.Equal(previouslyPaid, group.Code)
+				suite.False(group.Resubmittable)
+			case models.EDIType858:
+				suite.Equal("", group.Code)
+				suite.Equal(missingLineOfAccountingFix.fix, group.ProposedFix)
+			}
+		}
+	})
+
+	suite.Run("resolved errors and payment requests no longer in EDI_ERROR are left out", func() {
+		resolved := suite.makeRejectedPaymentRequest()
+		ediError := suite.makeEdiError(resolved, models.EDIType824, &missingData, "N1 missing")
+		now := time.Now()
+		ediError.ResolvedAt = &now
+		suite.NoError(suite.DB().Update(&ediError))
+
+		paid := testdatagen.MakePaymentRequest(suite.DB(), testdatagen.Assertions{
+			PaymentRequest: models.PaymentRequest{Status: models.PaymentRequestStatusPaid},
+		})
+		suite.makeEdiError(paid, models.EDIType824, &missingData, "N1 missing")
+
+		groups, err := triager.TriageEdiErrors(suite.AppContextForTest())
+		suite.NoError(err)
+		suite.Empty(groups)
+	})
+}
+
+func (suite *EdiErrorsSuite) TestResubmitPaymentRequest() {
+	triager := NewEDIErrorTriager()
+	missingData := edisegment.TEDErrorCodeMissingData
+
+	suite.Run("resolves the open errors and puts the payment request back in REVIEWED", func() {
+		paymentRequest := suite.makeRejectedPaymentRequest()
+		suite.makeEdiError(paymentRequest, models.EDIType824, &missingData, "N1 missing")
+
+		updated, err := triager.ResubmitPaymentRequest(suite.AppContextForTest(), paymentRequest.ID, etag.GenerateEtag(paymentRequest.UpdatedAt))
+		suite.NoError(err)
+		suite.Equal(models.PaymentRequestStatusReviewed, updated.Status)
+		suite.Nil(updated.SentToGexAt)
+
+		var ediErrors models.EdiErrors
+		suite.NoError(suite.DB().Where("payment_request_id = ?", paymentRequest.ID).All(&ediErrors))
+		suite.Len(ediErrors, 1)
+		suite.NotNil(ediErrors[0].ResolvedAt)
+	})
+
+	suite.Run("only payment requests in EDI_ERROR can be resubmitted", func() {
+		paymentRequest := testdatagen.MakePaymentRequest(suite.DB(), testdatagen.Assertions{
+			PaymentRequest: models.PaymentRequest{Status: models.PaymentRequestStatusSentToGex},
+		})
+
+		_, err := triager.ResubmitPaymentRequest(suite.AppContextForTest(), paymentRequest.ID, etag.GenerateEtag(paymentRequest.UpdatedAt))
+		suite.IsType(apperror.ConflictError{}, err)
+	})
+
+	suite.Run("previously paid invoices are not resubmitted", func() {
+		paymentRequest := suite.makeRejectedPaymentRequest()
+		previouslyPaid := edisegment.TEDErrorCodePreviouslyPaid
+		suite.makeEdiError(paymentRequest, models.EDIType824, &previouslyPaid, "paid")
+
+		_, err := triager.ResubmitPaymentRequest(suite.AppContextForTest(), paymentRequest.ID, etag.GenerateEtag(paymentRequest.UpdatedAt))
+		suite.IsType(apperror.UnprocessableEntityError{}, err)
+	})
+
+	suite.Run("a stale eTag is rejected", func() {
+		paymentRequest := suite.makeRejectedPaymentRequest()
+
+		_, err := triager.ResubmitPaymentRequest(suite.AppContextForTest(), paymentRequest.ID, etag.GenerateEtag(time.Now().Add(-time.Hour)))
+		suite.IsType(apperror.PreconditionFailedError{}, err)
+	})
+
+	suite.Run("an unknown payment request is not found", func() {
+		_, err := triager.ResubmitPaymentRequest(suite.AppContextForTest(), uuid.Must(uuid.NewV4()), "")
+		suite.IsType(apperror.NotFoundError{}, err)
+	})
+}
+
+func (suite *EdiErrorsSuite) TestProposeFix() {
+	code := func(c string) *string { return &c }
+	description := func(d string) *string { return &d }
+
+	suite.Equal(syntaxFix, proposeFix(models.EdiError{EDIType: models.EDIType997, Code: code("R")}))
+	suite.Equal(invalidResponseFix, proposeFix(models.EdiError{EDIType: models.EDIType824, Code: code("MilMove")}))
+	suite.Equal(tedFixes[edisegment.TEDErrorCodeDuplicate], proposeFix(models.EdiError{EDIType: models.EDIType824, Code: code("DUP")}))
+	suite.Equal(missingLineOfAccountingFix, proposeFix(models.EdiError{EDIType: models.EDIType858, Description: description("no LOA found for the orders")}))
+	suite.Equal(milMoveFix, proposeFix(models.EdiError{EDIType: models.EDIType858, Description: description("origin duty location GBLOC is required")}))
+	suite.Equal(tedFixes[edisegment.TEDErrorCodeMutuallyDefined], proposeFix(models.EdiError{EDIType: models.EDIType858, Code: code("ZZZ"), Description: description("L3 total 200 does not match")}))
+	suite.Equal(unknownFix, proposeFix(models.EdiError{EDIType: models.EDIType824, Code: code("K")}))
+}
@@ -1,14 +1,19 @@
 package edi_errors
 
 import (
+	"github.com/gobuffalo/pop/v6"
 	"github.com/gofrs/uuid"
 
 	"github.com/transcom/mymove/pkg/appcontext"
 	"github.com/transcom/mymove/pkg/apperror"
 	"github.com/transcom/mymove/pkg/models"
 	"github.com/transcom/mymove/pkg/services"
+	"github.com/transcom/mymove/pkg/services/query"
 )
 
+// ListOrder is the order FetchEdiErrors lists EDI errors in, newest first
+var ListOrder = query.NewQueryOrder(models.StringPointer("created_at"), models.BoolPointer(false))
+
 type ediErrorFetcher struct{}
 
 // NewEDIErrorFetcher returns an instance that implements the EDIErrorFetcher interface
@@ -16,17 +21,21 @@ func NewEDIErrorFetcher() services.EDIErrorFetcher {
 	return &ediErrorFetcher{}
 }
 
-// FetchEdiErrors returns all edi_errors related to payment requests with status EDI_ERROR
+// FetchEdiErrors returns all unresolved edi_errors related to payment requests with status EDI_ERROR
 func (f *ediErrorFetcher) FetchEdiErrors(appCtx appcontext.AppContext, pagination services.Pagination) (models.EdiErrors, int, error) {
 	var ediErrors models.EdiErrors
 
 	query := appCtx.DB().Q().
 		Join("payment_requests", "payment_requests.id = edi_errors.payment_request_id").
 		Where("payment_requests.status = ?", models.PaymentRequestStatusEDIError).
-		Eager("PaymentRequest").
-		Order("edi_errors.created_at DESC")
+		Where("edi_errors.resolved_at IS NULL").
+		Eager("PaymentRequest")
 
-	paginator := query.Paginate(pagination.Page(), pagination.PerPage())
+	if pagination.Cursor() != nil {
+		return fetchEdiErrorsAfterCursor(query, pagination)
+	}
+
+	paginator := query.Order("edi_errors.created_at DESC").Paginate(pagination.Page(), pagination.PerPage())
 	err := paginator.All(&ediErrors)
 	if err != nil {
 		return nil, 0, apperror.NewQueryError("edi_errors", err, "Could not fetch paginated EDI errors")
@@ -36,6 +45,27 @@ func (f *ediErrorFetcher) FetchEdiErrors(appCtx appcontext.AppContext, paginatio
 	return ediErrors, count, nil
 }
 
+// fetchEdiErrorsAfterCursor counts the EDI errors the query matches and fetches the page after the cursor
+func fetchEdiErrorsAfterCursor(q *pop.Query, pagination services.Pagination) (models.EdiErrors, int, error) {
+	var ediErrors models.EdiErrors
+
+	count, err := q.Count(&models.EdiError{})
+	if err != nil {
+		return nil, 0, apperror.NewQueryError("edi_errors", err, "Could not count EDI errors")
+	}
+
+	page, err := query.KeysetPaginate(q, pagination, ListOrder, &ediErrors)
+	if err != nil {
+		return nil, 0, err
+	}
+	err = page.All(&ediErrors)
+	if err != nil {
+		return nil, 0, apperror.NewQueryError("edi_errors", err, "Could not fetch EDI errors after the cursor")
+	}
+
+	return ediErrors, count, nil
+}
+
 // FetchEdiErrorByID returns a single edi_error the edi_error ID for a payment_request with status EDI_ERROR
 func (f *ediErrorFetcher) FetchEdiErrorByID(appCtx appcontext.AppContext, id uuid.UUID) (models.EdiError, error) {
 	var ediError models.EdiError
@@ -19,6 +19,7 @@ var apiEndpoints = []EndpointMapType{
 	supportEndpoints,
 	ghcEndpoints,
 	internalEndpoints,
+	primeEndpoints,
 }
 
 // String returns the string representation of the endpoint name
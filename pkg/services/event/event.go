@@ -91,6 +91,27 @@ const PaymentRequestCreateEventKey KeyType = "PaymentRequest.Create"
 // PaymentRequestUpdateEventKey is a key containing PaymentRequest.Update
 const PaymentRequestUpdateEventKey KeyType = "PaymentRequest.Update"
 
+// PaymentRequestReviewEventKey is a key containing PaymentRequest.Review
+const PaymentRequestReviewEventKey KeyType = "PaymentRequest.Review"
+
+// PaymentRequestRejectEDIEventKey is a key containing PaymentRequest.RejectEDI
+const PaymentRequestRejectEDIEventKey KeyType = "PaymentRequest.RejectEDI"
+
+// PaymentRequestReceivePaymentEventKey is a key containing PaymentRequest.ReceivePayment
+const PaymentRequestReceivePaymentEventKey KeyType = "PaymentRequest.ReceivePayment"
+
+// SITExtensionCreateEventKey is a key containing SITExtension.Create
+const SITExtensionCreateEventKey KeyType = "SITExtension.Create"
+
+// ReweighCompleteEventKey is a key containing Reweigh.Complete
+const ReweighCompleteEventKey KeyType = "Reweigh.Complete"
+
+// ShipmentAddressUpdateApproveEventKey is a key containing ShipmentAddressUpdate.Approve
+const ShipmentAddressUpdateApproveEventKey KeyType = "ShipmentAddressUpdate.Approve"
+
+// ShipmentAddressUpdateRejectEventKey is a key containing ShipmentAddressUpdate.Reject
+const ShipmentAddressUpdateRejectEventKey KeyType = "ShipmentAddressUpdate.Reject"
+
 // TestCreateEventKey is a key containing Test.Create
 const TestCreateEventKey KeyType = "Test.Create"
 
@@ -101,27 +122,54 @@ const TestUpdateEventKey KeyType = "Test.Update"
 const TestDeleteEventKey KeyType = "Test.Delete"
 
 var eventModels = map[KeyType]eventModel{
-	OrderUpdateEventKey:                 {OrderUpdateEventKey, models.Order{}},
-	MoveTaskOrderCreateEventKey:         {MoveTaskOrderCreateEventKey, models.Move{}},
-	MoveTaskOrderUpdateEventKey:         {MoveTaskOrderUpdateEventKey, models.Move{}},
-	MTOShipmentCreateEventKey:           {MTOShipmentCreateEventKey, models.MTOShipment{}},
-	MTOShipmentUpdateEventKey:           {MTOShipmentUpdateEventKey, models.MTOShipment{}},
-	ShipmentDeleteEventKey:              {ShipmentDeleteEventKey, models.MTOShipment{}},
-	ShipmentApproveEventKey:             {ShipmentApproveEventKey, models.MTOShipment{}},
-	ShipmentRequestDiversionEventKey:    {ShipmentRequestDiversionEventKey, models.MTOShipment{}},
-	ShipmentApproveDiversionEventKey:    {ShipmentApproveDiversionEventKey, models.MTOShipment{}},
-	ShipmentRejectEventKey:              {ShipmentRejectEventKey, models.MTOShipment{}},
-	ShipmentRequestCancellationEventKey: {ShipmentRequestCancellationEventKey, models.MTOShipment{}},
-	ShipmentRequestReweighEventKey:      {ShipmentRequestReweighEventKey, models.MTOShipment{}},
-	ApproveSITExtensionEventKey:         {ApproveSITExtensionEventKey, models.MTOShipment{}},
-	DenySITExtensionEventKey:            {DenySITExtensionEventKey, models.MTOShipment{}},
-	MTOServiceItemCreateEventKey:        {MTOServiceItemCreateEventKey, models.MTOServiceItem{}},
-	MTOServiceItemUpdateEventKey:        {MTOServiceItemUpdateEventKey, models.MTOServiceItem{}},
-	PaymentRequestCreateEventKey:        {PaymentRequestCreateEventKey, models.PaymentRequest{}},
-	PaymentRequestUpdateEventKey:        {PaymentRequestUpdateEventKey, models.PaymentRequest{}},
-	TestCreateEventKey:                  {TestCreateEventKey, nil},
-	TestUpdateEventKey:                  {TestUpdateEventKey, nil},
-	TestDeleteEventKey:                  {TestDeleteEventKey, nil}}
+	OrderUpdateEventKey:                  {OrderUpdateEventKey, models.Order{}},
+	MoveTaskOrderCreateEventKey:          {MoveTaskOrderCreateEventKey, models.Move{}},
+	MoveTaskOrderUpdateEventKey:          {MoveTaskOrderUpdateEventKey, models.Move{}},
+	MTOShipmentCreateEventKey:            {MTOShipmentCreateEventKey, models.MTOShipment{}},
+	MTOShipmentUpdateEventKey:            {MTOShipmentUpdateEventKey, models.MTOShipment{}},
+	ShipmentDeleteEventKey:               {ShipmentDeleteEventKey, models.MTOShipment{}},
+	ShipmentApproveEventKey:              {ShipmentApproveEventKey, models.MTOShipment{}},
+	ShipmentRequestDiversionEventKey:     {ShipmentRequestDiversionEventKey, models.MTOShipment{}},
+	ShipmentApproveDiversionEventKey:     {ShipmentApproveDiversionEventKey, models.MTOShipment{}},
+	ShipmentRejectEventKey:               {ShipmentRejectEventKey, models.MTOShipment{}},
+	ShipmentRequestCancellationEventKey:  {ShipmentRequestCancellationEventKey, models.MTOShipment{}},
+	ShipmentRequestReweighEventKey:       {ShipmentRequestReweighEventKey, models.MTOShipment{}},
+	ApproveSITExtensionEventKey:          {ApproveSITExtensionEventKey, models.MTOShipment{}},
+	DenySITExtensionEventKey:             {DenySITExtensionEventKey, models.MTOShipment{}},
+	MTOServiceItemCreateEventKey:         {MTOServiceItemCreateEventKey, models.MTOServiceItem{}},
+	MTOServiceItemUpdateEventKey:         {MTOServiceItemUpdateEventKey, models.MTOServiceItem{}},
+	PaymentRequestCreateEventKey:         {PaymentRequestCreateEventKey, models.PaymentRequest{}},
+	PaymentRequestUpdateEventKey:         {PaymentRequestUpdateEventKey, models.PaymentRequest{}},
+	PaymentRequestReviewEventKey:         {PaymentRequestReviewEventKey, models.PaymentRequest{}},
+	PaymentRequestRejectEDIEventKey:      {PaymentRequestRejectEDIEventKey, models.PaymentRequest{}},
+	PaymentRequestReceivePaymentEventKey: {PaymentRequestReceivePaymentEventKey, models.PaymentRequest{}},
+	SITExtensionCreateEventKey:           {SITExtensionCreateEventKey, models.SITDurationUpdate{}},
+	ReweighCompleteEventKey:              {ReweighCompleteEventKey, models.Reweigh{}},
+	ShipmentAddressUpdateApproveEventKey: {ShipmentAddressUpdateApproveEventKey, models.ShipmentAddressUpdate{}},
+	ShipmentAddressUpdateRejectEventKey:  {ShipmentAddressUpdateRejectEventKey, models.ShipmentAddressUpdate{}},
+	TestCreateEventKey:                   {TestCreateEventKey, nil},
+	TestUpdateEventKey:                   {TestUpdateEventKey, nil},
+	TestDeleteEventKey:                   {TestDeleteEventKey, nil}}
+
+// eventPayloadVersions holds the schema version of the events whose payload is built for the notification
+// rather than borrowed from the Prime API. Bump the version whenever a payload changes in a way a subscriber
+// would notice.
+var eventPayloadVersions = map[KeyType]int64{
+	PaymentRequestReviewEventKey:         1,
+	PaymentRequestRejectEDIEventKey:      1,
+	PaymentRequestReceivePaymentEventKey: 1,
+	SITExtensionCreateEventKey:           1,
+	ReweighCompleteEventKey:              1,
+	ShipmentAddressUpdateApproveEventKey: 1,
+	ShipmentAddressUpdateRejectEventKey:  1,
+}
+
+// primeSourcedEvents are sent even when the Prime caused them. The Prime integrates through more than one
+// system, so the one that made the change is not the only one that needs to hear about it.
+var primeSourcedEvents = map[KeyType]bool{
+	SITExtensionCreateEventKey: true,
+	ReweighCompleteEventKey:    true,
+}
 
 // IsCreateEvent returns true if this event is a create event
 func IsCreateEvent(e KeyType) (bool, error) {
@@ -147,6 +195,12 @@ func GetModelFromEvent(e KeyType) (interface{}, error) {
 	return eventModel.ModelInstance, nil
 }
 
+// GetPayloadVersion returns the schema version of the payload sent for this event. Events that send a Prime API
+// model as their payload are unversioned and return 0.
+func GetPayloadVersion(e KeyType) int64 {
+	return eventPayloadVersions[e]
+}
+
 // ExistsEventKey returns true if the event key exists
 func ExistsEventKey(e string) bool {
 	_, ok := eventModels[KeyType(e)]
@@ -12,6 +12,7 @@ import (
 	"github.com/transcom/mymove/pkg/gen/primemessages"
 	"github.com/transcom/mymove/pkg/handlers"
 	"github.com/transcom/mymove/pkg/models"
+	"github.com/transcom/mymove/pkg/testdatagen"
 	"github.com/transcom/mymove/pkg/testingsuite"
 	"github.com/transcom/mymove/pkg/unit"
 )
@@ -499,3 +500,55 @@ func (suite *EventServiceSuite) TestNotificationEventHandler() {
 		suite.Equal("sql: no rows in result set", err.Error())
 	})
 }
+
+func (suite *EventServiceSuite) Test_PrimeSourcedEventTrigger() {
+	suite.Run("Success with Reweigh.Complete from a Prime endpoint", func() {
+		// Reweigh.Complete is sent even though the Prime recorded the reweigh
+		move := factory.BuildAvailableToPrimeMove(suite.DB(), nil, nil)
+		shipment := factory.BuildMTOShipment(suite.DB(), []factory.Customization{
+			{
+				Model:    move,
+				LinkOnly: true,
+			},
+		}, nil)
+		reweigh := testdatagen.MakeReweighForShipment(suite.DB(), testdatagen.Assertions{}, shipment, unit.Pound(3000))
+		traceID := uuid.Must(uuid.NewV4())
+
+		_, err := TriggerEvent(Event{
+			EventKey:        ReweighCompleteEventKey,
+			MtoID:           move.ID,
+			UpdatedObjectID: reweigh.ID,
+			EndpointKey:     PrimeUpdateReweighEndpointKey,
+			AppContext:      suite.AppContextForTest(),
+			TraceID:         traceID,
+		})
+		suite.Nil(err)
+
+		notification, err := suite.getNotification(reweigh.ID, traceID)
+		suite.FatalNoError(err)
+		suite.Equal(string(ReweighCompleteEventKey), notification.EventKey)
+	})
+
+	suite.Run("No notification for other events from a Prime endpoint", func() {
+		move := factory.BuildAvailableToPrimeMove(suite.DB(), nil, nil)
+		count, _ := suite.DB().Count(&models.WebhookNotification{})
+
+		_, err := TriggerEvent(Event{
+			EventKey:        MoveTaskOrderUpdateEventKey,
+			MtoID:           move.ID,
+			UpdatedObjectID: move.ID,
+			EndpointKey:     PrimeUpdateReweighEndpointKey,
+			AppContext:      suite.AppContextForTest(),
+			TraceID:         uuid.Must(uuid.NewV4()),
+		})
+		suite.Nil(err)
+
+		newCount, _ := suite.DB().Count(&models.WebhookNotification{})
+		suite.Equal(count, newCount)
+	})
+}
+
+func (suite *EventServiceSuite) Test_GetPayloadVersion() {
+	suite.Equal(int64(1), GetPayloadVersion(PaymentRequestReviewEventKey))
+	suite.Equal(int64(0), GetPayloadVersion(PaymentRequestUpdateEventKey))
+}
@@ -136,6 +136,9 @@ const GhcAcknowledgeExcessUnaccompaniedBaggageWeightRiskEndpointKey = "Ghc.GhcAc
 // GhcTerminateShipmentEndpointKey is the key for the CreateTermination endpoint in ghc
 const GhcTerminateShipmentEndpointKey = "Ghc.CreateTermination"
 
+// GhcReviewShipmentAddressUpdateEndpointKey is the key for the reviewShipmentAddressUpdate endpoint in ghc
+const GhcReviewShipmentAddressUpdateEndpointKey = "Ghc.ReviewShipmentAddressUpdate"
+
 // -------------------- ENDPOINT MAP ENTRIES --------------------
 var ghcEndpoints = EndpointMapType{
 	GhcGetCustomerEndpointKey: {
@@ -306,4 +309,8 @@ var ghcEndpoints = EndpointMapType{
 		APIName:     GhcAPIName,
 		OperationID: "createTermination",
 	},
+	GhcReviewShipmentAddressUpdateEndpointKey: {
+		APIName:     GhcAPIName,
+		OperationID: "reviewShipmentAddressUpdate",
+	},
 }
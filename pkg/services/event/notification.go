@@ -194,6 +194,138 @@ func assemblePaymentRequestPayload(appCtx appcontext.AppContext, updatedObjectID
 
 }
 
+// assemblePaymentRequestReviewPayload assembles the PaymentRequest.Review payload and returns the JSON in bytes
+func assemblePaymentRequestReviewPayload(appCtx appcontext.AppContext, updatedObjectID uuid.UUID) ([]byte, error) {
+	model := models.PaymentRequest{}
+	err := appCtx.DB().Eager("PaymentServiceItems").Find(&model, updatedObjectID.String())
+	if err != nil {
+		notFoundError := apperror.NewNotFoundError(updatedObjectID, "looking for PaymentRequest")
+		notFoundError.Wrap(err)
+		return nil, notFoundError
+	}
+
+	payloadArray, err := json.Marshal(PaymentRequestReviewModelToPayload(&model))
+	if err != nil {
+		unknownErr := apperror.NewEventError("Unknown error creating PaymentRequest review payload", err)
+		return nil, unknownErr
+	}
+	return payloadArray, nil
+}
+
+// assemblePaymentRequestEDIRejectionPayload assembles the PaymentRequest.RejectEDI payload from the errors recorded
+// for the payment request's latest EDI response and returns the JSON in bytes
+func assemblePaymentRequestEDIRejectionPayload(appCtx appcontext.AppContext, updatedObjectID uuid.UUID) ([]byte, error) {
+	model := models.PaymentRequest{}
+	err := appCtx.DB().Find(&model, updatedObjectID.String())
+	if err != nil {
+		notFoundError := apperror.NewNotFoundError(updatedObjectID, "looking for PaymentRequest")
+		notFoundError.Wrap(err)
+		return nil, notFoundError
+	}
+
+	var ediErrors models.EdiErrors
+	err = appCtx.DB().Where("payment_request_id = ?", updatedObjectID).Order("created_at desc").All(&ediErrors)
+	if err != nil {
+		return nil, apperror.NewQueryError("EdiError", err, "Unable to load EDI errors")
+	}
+
+	// Earlier responses may have been fixed by a resubmission, so only report the latest one
+	var latestErrors models.EdiErrors
+	for _, ediError := range ediErrors {
+		if ediError.InterchangeControlNumberID != nil && ediErrors[0].InterchangeControlNumberID != nil &&
+			*ediError.InterchangeControlNumberID != *ediErrors[0].InterchangeControlNumberID {
+			continue
+		}
+		latestErrors = append(latestErrors, ediError)
+	}
+
+	payloadArray, err := json.Marshal(PaymentRequestEDIRejectionModelToPayload(&model, latestErrors))
+	if err != nil {
+		unknownErr := apperror.NewEventError("Unknown error creating PaymentRequest EDI rejection payload", err)
+		return nil, unknownErr
+	}
+	return payloadArray, nil
+}
+
+// assemblePaymentRequestPaymentPayload assembles the PaymentRequest.ReceivePayment payload from the TPPS paid
+// invoice report rows for the payment request and returns the JSON in bytes
+func assemblePaymentRequestPaymentPayload(appCtx appcontext.AppContext, updatedObjectID uuid.UUID) ([]byte, error) {
+	model := models.PaymentRequest{}
+	err := appCtx.DB().Find(&model, updatedObjectID.String())
+	if err != nil {
+		notFoundError := apperror.NewNotFoundError(updatedObjectID, "looking for PaymentRequest")
+		notFoundError.Wrap(err)
+		return nil, notFoundError
+	}
+
+	var tppsEntries models.TPPSPaidInvoiceReportEntrys
+	err = appCtx.DB().Where("invoice_number = ?", model.PaymentRequestNumber).Order("seller_paid_date desc").All(&tppsEntries)
+	if err != nil {
+		return nil, apperror.NewQueryError("TPPSPaidInvoiceReportEntry", err, "Unable to load TPPS paid invoice report entries")
+	}
+
+	payloadArray, err := json.Marshal(PaymentRequestPaymentModelToPayload(&model, tppsEntries))
+	if err != nil {
+		unknownErr := apperror.NewEventError("Unknown error creating PaymentRequest payment payload", err)
+		return nil, unknownErr
+	}
+	return payloadArray, nil
+}
+
+// assembleSITExtensionPayload assembles the SITExtension payload and returns the JSON in bytes
+func assembleSITExtensionPayload(appCtx appcontext.AppContext, updatedObjectID uuid.UUID) ([]byte, error) {
+	model := models.SITDurationUpdate{}
+	err := appCtx.DB().Find(&model, updatedObjectID)
+	if err != nil {
+		notFoundError := apperror.NewNotFoundError(updatedObjectID, "looking for SITDurationUpdate")
+		notFoundError.Wrap(err)
+		return nil, notFoundError
+	}
+
+	payloadArray, err := json.Marshal(SITExtensionModelToPayload(&model))
+	if err != nil {
+		unknownErr := apperror.NewEventError("Unknown error creating SITExtension payload", err)
+		return nil, unknownErr
+	}
+	return payloadArray, nil
+}
+
+// assembleReweighPayload assembles the Reweigh payload and returns the JSON in bytes
+func assembleReweighPayload(appCtx appcontext.AppContext, updatedObjectID uuid.UUID) ([]byte, error) {
+	model := models.Reweigh{}
+	err := appCtx.DB().Find(&model, updatedObjectID)
+	if err != nil {
+		notFoundError := apperror.NewNotFoundError(updatedObjectID, "looking for Reweigh")
+		notFoundError.Wrap(err)
+		return nil, notFoundError
+	}
+
+	payloadArray, err := json.Marshal(ReweighModelToPayload(&model))
+	if err != nil {
+		unknownErr := apperror.NewEventError("Unknown error creating Reweigh payload", err)
+		return nil, unknownErr
+	}
+	return payloadArray, nil
+}
+
+// assembleShipmentAddressUpdatePayload assembles the ShipmentAddressUpdate payload and returns the JSON in bytes
+func assembleShipmentAddressUpdatePayload(appCtx appcontext.AppContext, eventKey KeyType, updatedObjectID uuid.UUID) ([]byte, error) {
+	model := models.ShipmentAddressUpdate{}
+	err := appCtx.DB().Eager("OriginalAddress", "NewAddress").Find(&model, updatedObjectID)
+	if err != nil {
+		notFoundError := apperror.NewNotFoundError(updatedObjectID, "looking for ShipmentAddressUpdate")
+		notFoundError.Wrap(err)
+		return nil, notFoundError
+	}
+
+	payloadArray, err := json.Marshal(ShipmentAddressUpdateModelToPayload(eventKey, &model))
+	if err != nil {
+		unknownErr := apperror.NewEventError("Unknown error creating ShipmentAddressUpdate payload", err)
+		return nil, unknownErr
+	}
+	return payloadArray, nil
+}
+
 // assembleOrderPayload assembles the Order Payload and returns the JSON in bytes
 func assembleOrderPayload(appCtx appcontext.AppContext, updatedObjectID uuid.UUID) ([]byte, error) {
 	model := models.Order{}
@@ -234,8 +366,8 @@ func objectEventHandler(event *Event, modelBeingUpdated interface{}) (bool, erro
 	appCtx := event.AppContext
 
 	// CHECK SOURCE
-	// Continue only if source of event is not Prime
-	if isSourcePrime(event) {
+	// Continue only if source of event is not Prime, unless the Prime needs to hear about it anyway
+	if isSourcePrime(event) && !primeSourcedEvents[event.EventKey] {
 		return false, nil
 	}
 
@@ -251,7 +383,16 @@ func objectEventHandler(event *Event, modelBeingUpdated interface{}) (bool, erro
 
 	switch modelBeingUpdated.(type) {
 	case models.PaymentRequest:
-		payloadArray, err = assemblePaymentRequestPayload(appCtx, event.UpdatedObjectID)
+		switch event.EventKey {
+		case PaymentRequestReviewEventKey:
+			payloadArray, err = assemblePaymentRequestReviewPayload(appCtx, event.UpdatedObjectID)
+		case PaymentRequestRejectEDIEventKey:
+			payloadArray, err = assemblePaymentRequestEDIRejectionPayload(appCtx, event.UpdatedObjectID)
+		case PaymentRequestReceivePaymentEventKey:
+			payloadArray, err = assemblePaymentRequestPaymentPayload(appCtx, event.UpdatedObjectID)
+		default:
+			payloadArray, err = assemblePaymentRequestPayload(appCtx, event.UpdatedObjectID)
+		}
 	case models.MTOShipment:
 		var shouldNotify bool
 		payloadArray, shouldNotify, err = assembleMTOShipmentPayload(appCtx, event.UpdatedObjectID)
@@ -262,6 +403,12 @@ func objectEventHandler(event *Event, modelBeingUpdated interface{}) (bool, erro
 		payloadArray, err = assembleMTOServiceItemPayload(appCtx, event.UpdatedObjectID)
 	case models.Move:
 		payloadArray, err = assembleMTOPayload(appCtx, event.UpdatedObjectID)
+	case models.SITDurationUpdate:
+		payloadArray, err = assembleSITExtensionPayload(appCtx, event.UpdatedObjectID)
+	case models.Reweigh:
+		payloadArray, err = assembleReweighPayload(appCtx, event.UpdatedObjectID)
+	case models.ShipmentAddressUpdate:
+		payloadArray, err = assembleShipmentAddressUpdatePayload(appCtx, event.EventKey, event.UpdatedObjectID)
 	default:
 		appCtx.Logger().Error("event.NotificationEventHandler: Unknown logical object being updated.")
 		err = apperror.NewEventError(fmt.Sprintf("No notification handler for event %s", event.EventKey), nil)
@@ -6,6 +6,7 @@ import (
 	"github.com/transcom/mymove/pkg/etag"
 	"github.com/transcom/mymove/pkg/gen/primemessages"
 	"github.com/transcom/mymove/pkg/handlers"
+	"github.com/transcom/mymove/pkg/handlers/primeapi/payloads"
 	"github.com/transcom/mymove/pkg/models"
 )
 
@@ -136,3 +137,375 @@ func PaymentServiceItemsModelToPayload(paymentServiceItems *models.PaymentServic
 	}
 	return &payload
 }
+
+// PaymentServiceItemReview is the review result of a single service item in a PaymentRequestReview payload
+type PaymentServiceItemReview struct {
+
+	// id
+	// Format: uuid
+	ID strfmt.UUID `json:"id"`
+
+	// mto service item ID
+	// Format: uuid
+	MtoServiceItemID strfmt.UUID `json:"mtoServiceItemID"`
+
+	// price cents
+	PriceCents *int64 `json:"priceCents,omitempty"`
+
+	// rejection reason
+	RejectionReason *string `json:"rejectionReason,omitempty"`
+
+	// status
+	// Enum: [REQUESTED APPROVED DENIED SENT_TO_GEX PAID EDI_ERROR]
+	Status string `json:"status"`
+}
+
+// PaymentRequestReview is the payload of the PaymentRequest.Review event
+type PaymentRequestReview struct {
+
+	// schema version
+	SchemaVersion int64 `json:"schemaVersion"`
+
+	// id
+	// Format: uuid
+	ID strfmt.UUID `json:"id"`
+
+	// move task order ID
+	// Format: uuid
+	MoveTaskOrderID strfmt.UUID `json:"moveTaskOrderID"`
+
+	// payment request number
+	PaymentRequestNumber string `json:"paymentRequestNumber"`
+
+	// status
+	Status string `json:"status"`
+
+	// reviewed at
+	// Format: date-time
+	ReviewedAt *strfmt.DateTime `json:"reviewedAt,omitempty"`
+
+	// payment service items
+	PaymentServiceItems []PaymentServiceItemReview `json:"paymentServiceItems"`
+
+	// e tag
+	ETag string `json:"eTag"`
+}
+
+// PaymentRequestReviewModelToPayload converts a reviewed payment request into a PaymentRequestReview payload
+func PaymentRequestReviewModelToPayload(paymentRequest *models.PaymentRequest) *PaymentRequestReview {
+	if paymentRequest == nil {
+		return nil
+	}
+
+	serviceItems := make([]PaymentServiceItemReview, len(paymentRequest.PaymentServiceItems))
+	for i, item := range paymentRequest.PaymentServiceItems {
+		serviceItems[i] = PaymentServiceItemReview{
+			ID:               strfmt.UUID(item.ID.String()),
+			MtoServiceItemID: strfmt.UUID(item.MTOServiceItemID.String()),
+			RejectionReason:  item.RejectionReason,
+			Status:           string(item.Status),
+		}
+		if item.PriceCents != nil {
+			serviceItems[i].PriceCents = models.Int64Pointer(int64(*item.PriceCents))
+		}
+	}
+
+	return &PaymentRequestReview{
+		SchemaVersion:        GetPayloadVersion(PaymentRequestReviewEventKey),
+		ID:                   strfmt.UUID(paymentRequest.ID.String()),
+		MoveTaskOrderID:      strfmt.UUID(paymentRequest.MoveTaskOrderID.String()),
+		PaymentRequestNumber: paymentRequest.PaymentRequestNumber,
+		Status:               string(paymentRequest.Status),
+		ReviewedAt:           handlers.FmtDateTimePtr(paymentRequest.ReviewedAt),
+		PaymentServiceItems:  serviceItems,
+		ETag:                 etag.GenerateEtag(paymentRequest.UpdatedAt),
+	}
+}
+
+// EDIRejectionError is a single error reported in a PaymentRequestEDIRejection payload
+type EDIRejectionError struct {
+
+	// code
+	Code *string `json:"code,omitempty"`
+
+	// description
+	Description *string `json:"description,omitempty"`
+
+	// edi type
+	// Enum: [810 824 858 997]
+	EDIType string `json:"ediType"`
+}
+
+// PaymentRequestEDIRejection is the payload of the PaymentRequest.RejectEDI event
+type PaymentRequestEDIRejection struct {
+
+	// schema version
+	SchemaVersion int64 `json:"schemaVersion"`
+
+	// id
+	// Format: uuid
+	ID strfmt.UUID `json:"id"`
+
+	// move task order ID
+	// Format: uuid
+	MoveTaskOrderID strfmt.UUID `json:"moveTaskOrderID"`
+
+	// payment request number
+	PaymentRequestNumber string `json:"paymentRequestNumber"`
+
+	// status
+	Status string `json:"status"`
+
+	// errors
+	Errors []EDIRejectionError `json:"errors"`
+}
+
+// PaymentRequestEDIRejectionModelToPayload converts a payment request and the errors of its latest EDI response into
+// a PaymentRequestEDIRejection payload
+func PaymentRequestEDIRejectionModelToPayload(paymentRequest *models.PaymentRequest, ediErrors models.EdiErrors) *PaymentRequestEDIRejection {
+	if paymentRequest == nil {
+		return nil
+	}
+
+	rejectionErrors := make([]EDIRejectionError, len(ediErrors))
+	for i, ediError := range ediErrors {
+		rejectionErrors[i] = EDIRejectionError{
+			Code:        ediError.Code,
+			Description: ediError.Description,
+			EDIType:     string(ediError.EDIType),
+		}
+	}
+
+	return &PaymentRequestEDIRejection{
+		SchemaVersion:        GetPayloadVersion(PaymentRequestRejectEDIEventKey),
+		ID:                   strfmt.UUID(paymentRequest.ID.String()),
+		MoveTaskOrderID:      strfmt.UUID(paymentRequest.MoveTaskOrderID.String()),
+		PaymentRequestNumber: paymentRequest.PaymentRequestNumber,
+		Status:               string(paymentRequest.Status),
+		Errors:               rejectionErrors,
+	}
+}
+
+// PaymentRequestPayment is the payload of the PaymentRequest.ReceivePayment event
+type PaymentRequestPayment struct {
+
+	// schema version
+	SchemaVersion int64 `json:"schemaVersion"`
+
+	// id
+	// Format: uuid
+	ID strfmt.UUID `json:"id"`
+
+	// move task order ID
+	// Format: uuid
+	MoveTaskOrderID strfmt.UUID `json:"moveTaskOrderID"`
+
+	// payment request number
+	PaymentRequestNumber string `json:"paymentRequestNumber"`
+
+	// status
+	Status string `json:"status"`
+
+	// seller paid date
+	// Format: date
+	SellerPaidDate *strfmt.Date `json:"sellerPaidDate,omitempty"`
+
+	// total paid cents
+	TotalPaidCents *int64 `json:"totalPaidCents,omitempty"`
+}
+
+// PaymentRequestPaymentModelToPayload converts a paid payment request and the TPPS rows reporting its payment into a
+// PaymentRequestPayment payload
+func PaymentRequestPaymentModelToPayload(paymentRequest *models.PaymentRequest, tppsEntries models.TPPSPaidInvoiceReportEntrys) *PaymentRequestPayment {
+	if paymentRequest == nil {
+		return nil
+	}
+
+	payload := &PaymentRequestPayment{
+		SchemaVersion:        GetPayloadVersion(PaymentRequestReceivePaymentEventKey),
+		ID:                   strfmt.UUID(paymentRequest.ID.String()),
+		MoveTaskOrderID:      strfmt.UUID(paymentRequest.MoveTaskOrderID.String()),
+		PaymentRequestNumber: paymentRequest.PaymentRequestNumber,
+		Status:               string(paymentRequest.Status),
+	}
+
+	// Every row of an invoice carries the invoice total and paid date, so the first row is enough
+	if len(tppsEntries) > 0 {
+		sellerPaidDate := strfmt.Date(tppsEntries[0].SellerPaidDate)
+		payload.SellerPaidDate = &sellerPaidDate
+		payload.TotalPaidCents = models.Int64Pointer(tppsEntries[0].InvoiceTotalChargesInMillicents.ToCents().Int64())
+	}
+
+	return payload
+}
+
+// SITExtension is the payload of the SITExtension.Create event
+type SITExtension struct {
+
+	// schema version
+	SchemaVersion int64 `json:"schemaVersion"`
+
+	// id
+	// Format: uuid
+	ID strfmt.UUID `json:"id"`
+
+	// mto shipment ID
+	// Format: uuid
+	MtoShipmentID strfmt.UUID `json:"mtoShipmentID"`
+
+	// request reason
+	RequestReason string `json:"requestReason"`
+
+	// contractor remarks
+	ContractorRemarks *string `json:"contractorRemarks,omitempty"`
+
+	// requested days
+	RequestedDays int64 `json:"requestedDays"`
+
+	// status
+	// Enum: [PENDING APPROVED DENIED REMOVED]
+	Status string `json:"status"`
+
+	// approved days
+	ApprovedDays *int64 `json:"approvedDays,omitempty"`
+
+	// decision date
+	// Format: date-time
+	DecisionDate *strfmt.DateTime `json:"decisionDate,omitempty"`
+
+	// created at
+	// Format: date-time
+	CreatedAt strfmt.DateTime `json:"createdAt"`
+
+	// e tag
+	ETag string `json:"eTag"`
+}
+
+// SITExtensionModelToPayload converts a SITDurationUpdate into a SITExtension payload
+func SITExtensionModelToPayload(sitDurationUpdate *models.SITDurationUpdate) *SITExtension {
+	if sitDurationUpdate == nil {
+		return nil
+	}
+
+	payload := &SITExtension{
+		SchemaVersion:     GetPayloadVersion(SITExtensionCreateEventKey),
+		ID:                strfmt.UUID(sitDurationUpdate.ID.String()),
+		MtoShipmentID:     strfmt.UUID(sitDurationUpdate.MTOShipmentID.String()),
+		RequestReason:     string(sitDurationUpdate.RequestReason),
+		ContractorRemarks: sitDurationUpdate.ContractorRemarks,
+		RequestedDays:     int64(sitDurationUpdate.RequestedDays),
+		Status:            string(sitDurationUpdate.Status),
+		DecisionDate:      handlers.FmtDateTimePtr(sitDurationUpdate.DecisionDate),
+		CreatedAt:         strfmt.DateTime(sitDurationUpdate.CreatedAt),
+		ETag:              etag.GenerateEtag(sitDurationUpdate.UpdatedAt),
+	}
+
+	if sitDurationUpdate.ApprovedDays != nil {
+		payload.ApprovedDays = models.Int64Pointer(int64(*sitDurationUpdate.ApprovedDays))
+	}
+
+	return payload
+}
+
+// Reweigh is the payload of the Reweigh.Complete event
+type Reweigh struct {
+
+	// schema version
+	SchemaVersion int64 `json:"schemaVersion"`
+
+	// id
+	// Format: uuid
+	ID strfmt.UUID `json:"id"`
+
+	// shipment ID
+	// Format: uuid
+	ShipmentID strfmt.UUID `json:"shipmentID"`
+
+	// requested at
+	// Format: date-time
+	RequestedAt strfmt.DateTime `json:"requestedAt"`
+
+	// requested by
+	RequestedBy string `json:"requestedBy"`
+
+	// weight
+	Weight *int64 `json:"weight,omitempty"`
+
+	// verification reason
+	VerificationReason *string `json:"verificationReason,omitempty"`
+
+	// verification provided at
+	// Format: date-time
+	VerificationProvidedAt *strfmt.DateTime `json:"verificationProvidedAt,omitempty"`
+
+	// e tag
+	ETag string `json:"eTag"`
+}
+
+// ReweighModelToPayload converts a Reweigh model into a Reweigh payload
+func ReweighModelToPayload(reweigh *models.Reweigh) *Reweigh {
+	if reweigh == nil {
+		return nil
+	}
+
+	return &Reweigh{
+		SchemaVersion:          GetPayloadVersion(ReweighCompleteEventKey),
+		ID:                     strfmt.UUID(reweigh.ID.String()),
+		ShipmentID:             strfmt.UUID(reweigh.ShipmentID.String()),
+		RequestedAt:            strfmt.DateTime(reweigh.RequestedAt),
+		RequestedBy:            string(reweigh.RequestedBy),
+		Weight:                 handlers.FmtPoundPtr(reweigh.Weight),
+		VerificationReason:     reweigh.VerificationReason,
+		VerificationProvidedAt: handlers.FmtDateTimePtr(reweigh.VerificationProvidedAt),
+		ETag:                   etag.GenerateEtag(reweigh.UpdatedAt),
+	}
+}
+
+// ShipmentAddressUpdate is the payload of the ShipmentAddressUpdate.Approve and ShipmentAddressUpdate.Reject events
+type ShipmentAddressUpdate struct {
+
+	// schema version
+	SchemaVersion int64 `json:"schemaVersion"`
+
+	// id
+	// Format: uuid
+	ID strfmt.UUID `json:"id"`
+
+	// shipment ID
+	// Format: uuid
+	ShipmentID strfmt.UUID `json:"shipmentID"`
+
+	// status
+	// Enum: [REQUESTED REJECTED APPROVED]
+	Status string `json:"status"`
+
+	// contractor remarks
+	ContractorRemarks string `json:"contractorRemarks"`
+
+	// office remarks
+	OfficeRemarks *string `json:"officeRemarks,omitempty"`
+
+	// original address
+	OriginalAddress *primemessages.Address `json:"originalAddress"`
+
+	// new address
+	NewAddress *primemessages.Address `json:"newAddress"`
+}
+
+// ShipmentAddressUpdateModelToPayload converts a reviewed ShipmentAddressUpdate into a ShipmentAddressUpdate payload
+func ShipmentAddressUpdateModelToPayload(eventKey KeyType, shipmentAddressUpdate *models.ShipmentAddressUpdate) *ShipmentAddressUpdate {
+	if shipmentAddressUpdate == nil {
+		return nil
+	}
+
+	return &ShipmentAddressUpdate{
+		SchemaVersion:     GetPayloadVersion(eventKey),
+		ID:                strfmt.UUID(shipmentAddressUpdate.ID.String()),
+		ShipmentID:        strfmt.UUID(shipmentAddressUpdate.ShipmentID.String()),
+		Status:            string(shipmentAddressUpdate.Status),
+		ContractorRemarks: shipmentAddressUpdate.ContractorRemarks,
+		OfficeRemarks:     shipmentAddressUpdate.OfficeRemarks,
+		OriginalAddress:   payloads.Address(&shipmentAddressUpdate.OriginalAddress),
+		NewAddress:        payloads.Address(&shipmentAddressUpdate.NewAddress),
+	}
+}
@@ -1,6 +1,7 @@
 package event
 
 import (
+	"encoding/json"
 	"time"
 
 	"github.com/gofrs/uuid"
@@ -9,6 +10,7 @@ import (
 	"github.com/transcom/mymove/pkg/gen/primemessages"
 	"github.com/transcom/mymove/pkg/models"
 	"github.com/transcom/mymove/pkg/testdatagen"
+	"github.com/transcom/mymove/pkg/unit"
 )
 
 func (suite *EventServiceSuite) Test_MTOServiceItemPayload() {
@@ -325,3 +327,231 @@ func (suite *EventServiceSuite) TestAssembleMTOShipmentPayload() {
 		suite.Nil(payload)
 	})
 }
+
+func (suite *EventServiceSuite) Test_PaymentRequestLifecyclePayloads() {
+	suite.Run("Success with PaymentRequest.Review", func() {
+		// Under test: assemblePaymentRequestReviewPayload
+		// Set up:     Create a reviewed payment request with an approved and a denied service item
+		// Expected outcome: Payload should contain the result of each service item's review
+		now := time.Now()
+		paymentRequest := factory.BuildPaymentRequest(suite.DB(), []factory.Customization{
+			{
+				Model: models.PaymentRequest{
+					Status:     models.PaymentRequestStatusReviewed,
+					ReviewedAt: &now,
+				},
+			},
+		}, nil)
+		approved := factory.BuildPaymentServiceItem(suite.DB(), []factory.Customization{
+			{
+				Model:    paymentRequest,
+				LinkOnly: true,
+			},
+			{
+				Model: models.PaymentServiceItem{
+					Status: models.PaymentServiceItemStatusApproved,
+				},
+			},
+		}, nil)
+		rejectionReason := "Not in the contract"
+		denied := factory.BuildPaymentServiceItem(suite.DB(), []factory.Customization{
+			{
+				Model:    paymentRequest,
+				LinkOnly: true,
+			},
+			{
+				Model: models.PaymentServiceItem{
+					Status:          models.PaymentServiceItemStatusDenied,
+					RejectionReason: &rejectionReason,
+				},
+			},
+		}, nil)
+
+		payload, err := assemblePaymentRequestReviewPayload(suite.AppContextForTest(), paymentRequest.ID)
+		suite.NoError(err)
+
+		data := PaymentRequestReview{}
+		suite.NoError(json.Unmarshal(payload, &data))
+		suite.Equal(int64(1), data.SchemaVersion)
+		suite.Equal(paymentRequest.ID.String(), data.ID.String())
+		suite.Equal(string(models.PaymentRequestStatusReviewed), data.Status)
+		suite.NotNil(data.ReviewedAt)
+		suite.Len(data.PaymentServiceItems, 2)
+		for _, item := range data.PaymentServiceItems {
+			switch item.ID.String() {
+			case approved.ID.String():
+				suite.Equal(string(models.PaymentServiceItemStatusApproved), item.Status)
+				suite.Nil(item.RejectionReason)
+			case denied.ID.String():
+				suite.Equal(string(models.PaymentServiceItemStatusDenied), item.Status)
+				suite.Equal(rejectionReason, *item.RejectionReason)
+			default:
+				suite.Fail("unexpected payment service item", item.ID.String())
+			}
+		}
+	})
+
+	suite.Run("Success with PaymentRequest.RejectEDI", func() {
+		// Under test: assemblePaymentRequestEDIRejectionPayload
+		// Set up:     Create a payment request with errors from an older and a newer 824
+		// Expected outcome: Payload should only contain the errors from the newer 824
+		paymentRequest := factory.BuildPaymentRequest(suite.DB(), []factory.Customization{
+			{
+				Model: models.PaymentRequest{
+					Status: models.PaymentRequestStatusEDIError,
+				},
+			},
+		}, nil)
+		olderICN := factory.BuildPaymentRequestToInterchangeControlNumber(suite.DB(), []factory.Customization{
+			{
+				Model: models.PaymentRequestToInterchangeControlNumber{
+					InterchangeControlNumber: 100,
+					EDIType:                  models.EDIType824,
+				},
+			},
+			{
+				Model:    paymentRequest,
+				LinkOnly: true,
+			},
+		}, nil)
+		newerICN := factory.BuildPaymentRequestToInterchangeControlNumber(suite.DB(), []factory.Customization{
+			{
+				Model: models.PaymentRequestToInterchangeControlNumber{
+					InterchangeControlNumber: 101,
+					EDIType:                  models.EDIType824,
+				},
+			},
+			{
+				Model:    paymentRequest,
+				LinkOnly: true,
+			},
+		}, nil)
+		olderCode := "K"
+		olderDescription := "Fixed by the resubmission"
+		testdatagen.MakeEdiError(suite.DB(), testdatagen.Assertions{
+			EdiError: models.EdiError{
+				PaymentRequestID:           paymentRequest.ID,
+				InterchangeControlNumberID: &olderICN.ID,
+				Code:                       &olderCode,
+				Description:                &olderDescription,
+				EDIType:                    models.EDIType824,
+				CreatedAt:                  time.Now().Add(-time.Hour),
+			},
+		})
+		newerCode := "DUP"
+		newerDescription := "Duplicate transaction"
+		testdatagen.MakeEdiError(suite.DB(), testdatagen.Assertions{
+			EdiError: models.EdiError{
+				PaymentRequestID:           paymentRequest.ID,
+				InterchangeControlNumberID: &newerICN.ID,
+				Code:                       &newerCode,
+				Description:                &newerDescription,
+				EDIType:                    models.EDIType824,
+			},
+		})
+
+		payload, err := assemblePaymentRequestEDIRejectionPayload(suite.AppContextForTest(), paymentRequest.ID)
+		suite.NoError(err)
+
+		data := PaymentRequestEDIRejection{}
+		suite.NoError(json.Unmarshal(payload, &data))
+		suite.Equal(int64(1), data.SchemaVersion)
+		suite.Equal(paymentRequest.PaymentRequestNumber, data.PaymentRequestNumber)
+		suite.Equal(string(models.PaymentRequestStatusEDIError), data.Status)
+		suite.Len(data.Errors, 1)
+		suite.Equal(newerCode, *data.Errors[0].Code)
+		suite.Equal(newerDescription, *data.Errors[0].Description)
+		suite.Equal(string(models.EDIType824), data.Errors[0].EDIType)
+	})
+
+	suite.Run("Success with PaymentRequest.ReceivePayment", func() {
+		// Under test: assemblePaymentRequestPaymentPayload
+		// Set up:     Create a paid payment request and the TPPS row reporting its payment
+		// Expected outcome: Payload should contain the paid date and total
+		paymentRequest := factory.BuildPaymentRequest(suite.DB(), []factory.Customization{
+			{
+				Model: models.PaymentRequest{
+					Status: models.PaymentRequestStatusPaid,
+				},
+			},
+		}, nil)
+		sellerPaidDate := time.Date(2024, time.December, 2, 0, 0, 0, 0, time.UTC)
+		entry := models.TPPSPaidInvoiceReportEntry{
+			InvoiceNumber:                   paymentRequest.PaymentRequestNumber,
+			SellerPaidDate:                  sellerPaidDate,
+			InvoiceTotalChargesInMillicents: unit.Millicents(12345000),
+			LineDescription:                 "DDP",
+			ProductDescription:              "DDP",
+			LineBillingUnits:                1,
+			LineUnitPrice:                   unit.Millicents(12345000),
+			LineNetCharge:                   unit.Millicents(12345000),
+			POTCN:                           paymentRequest.PaymentRequestNumber,
+			LineNumber:                      "1",
+		}
+		suite.MustCreate(&entry)
+
+		payload, err := assemblePaymentRequestPaymentPayload(suite.AppContextForTest(), paymentRequest.ID)
+		suite.NoError(err)
+
+		data := PaymentRequestPayment{}
+		suite.NoError(json.Unmarshal(payload, &data))
+		suite.Equal(int64(1), data.SchemaVersion)
+		suite.Equal(string(models.PaymentRequestStatusPaid), data.Status)
+		suite.Equal("2024-12-02", data.SellerPaidDate.String())
+		suite.Equal(int64(12345), *data.TotalPaidCents)
+	})
+}
+
+func (suite *EventServiceSuite) Test_ShipmentLifecyclePayloads() {
+	suite.Run("Success with SITExtension.Create", func() {
+		// Under test: assembleSITExtensionPayload
+		// Set up:     Create a pending SIT extension
+		// Expected outcome: Payload should contain the request details
+		sitExtension := factory.BuildSITDurationUpdate(suite.DB(), nil, nil)
+
+		payload, err := assembleSITExtensionPayload(suite.AppContextForTest(), sitExtension.ID)
+		suite.NoError(err)
+
+		data := SITExtension{}
+		suite.NoError(json.Unmarshal(payload, &data))
+		suite.Equal(int64(1), data.SchemaVersion)
+		suite.Equal(sitExtension.ID.String(), data.ID.String())
+		suite.Equal(sitExtension.MTOShipmentID.String(), data.MtoShipmentID.String())
+		suite.Equal(int64(sitExtension.RequestedDays), data.RequestedDays)
+		suite.Equal(string(sitExtension.Status), data.Status)
+	})
+
+	suite.Run("Success with Reweigh.Complete", func() {
+		// Under test: assembleReweighPayload
+		// Set up:     Create a reweigh with a weight
+		// Expected outcome: Payload should contain the reweighed weight
+		shipment := factory.BuildMTOShipment(suite.DB(), nil, nil)
+		reweigh := testdatagen.MakeReweighForShipment(suite.DB(), testdatagen.Assertions{}, shipment, unit.Pound(4000))
+
+		payload, err := assembleReweighPayload(suite.AppContextForTest(), reweigh.ID)
+		suite.NoError(err)
+
+		data := Reweigh{}
+		suite.NoError(json.Unmarshal(payload, &data))
+		suite.Equal(int64(1), data.SchemaVersion)
+		suite.Equal(shipment.ID.String(), data.ShipmentID.String())
+		suite.Equal(int64(4000), *data.Weight)
+	})
+
+	suite.Run("Success with ShipmentAddressUpdate.Approve", func() {
+		// Under test: assembleShipmentAddressUpdatePayload
+		// Set up:     Create an approved shipment address update
+		// Expected outcome: Payload should contain the status and both addresses
+		addressUpdate := factory.BuildShipmentAddressUpdate(suite.DB(), nil, []factory.Trait{factory.GetTraitShipmentAddressUpdateApproved})
+
+		payload, err := assembleShipmentAddressUpdatePayload(suite.AppContextForTest(), ShipmentAddressUpdateApproveEventKey, addressUpdate.ID)
+		suite.NoError(err)
+
+		data := ShipmentAddressUpdate{}
+		suite.NoError(json.Unmarshal(payload, &data))
+		suite.Equal(int64(1), data.SchemaVersion)
+		suite.Equal(string(models.ShipmentAddressUpdateStatusApproved), data.Status)
+		suite.Equal(addressUpdate.NewAddress.ID.String(), data.NewAddress.ID.String())
+		suite.Equal(addressUpdate.OriginalAddress.ID.String(), data.OriginalAddress.ID.String())
+	})
+}
@@ -0,0 +1,21 @@
+package event
+
+// -------------------- ENDPOINT KEYS --------------------
+
+// PrimeCreateSITExtensionEndpointKey is the key for the createSITExtension endpoint in prime
+const PrimeCreateSITExtensionEndpointKey = "Prime.CreateSITExtension"
+
+// PrimeUpdateReweighEndpointKey is the key for the updateReweigh endpoint in prime
+const PrimeUpdateReweighEndpointKey = "Prime.UpdateReweigh"
+
+// -------------------- ENDPOINT MAP ENTRIES --------------------
+var primeEndpoints = EndpointMapType{
+	PrimeCreateSITExtensionEndpointKey: {
+		APIName:     PrimeAPIName,
+		OperationID: "createSITExtension",
+	},
+	PrimeUpdateReweighEndpointKey: {
+		APIName:     PrimeAPIName,
+		OperationID: "updateReweigh",
+	},
+}
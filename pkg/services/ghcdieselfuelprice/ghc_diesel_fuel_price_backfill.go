@@ -0,0 +1,182 @@
+package ghcdieselfuelprice
+
+import (
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/transcom/mymove/pkg/appcontext"
+	"github.com/transcom/mymove/pkg/models"
+)
+
+// BackfillResult describes what a backfill did for each week in its range
+type BackfillResult struct {
+	// StoredPublicationDates are the publication dates of the prices that were added
+	StoredPublicationDates []time.Time
+	// ExistingWeeks is the number of weeks that already had a price
+	ExistingWeeks int
+	// MissingWeeks are the weeks, by the Monday they start on, that the source had no price for
+	MissingWeeks []time.Time
+}
+
+// MissingFuelPriceWeek is a week with shipments picked up that no diesel fuel price covers, so their fuel
+// surcharges are priced with an older week's price
+type MissingFuelPriceWeek struct {
+	WeekStart     time.Time `db:"week_start"`
+	ShipmentCount int       `db:"shipment_count"`
+}
+
+// weekStart returns the Monday of the week the date falls in
+func weekStart(date time.Time) time.Time {
+	day := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+	return day.AddDate(0, 0, -((int(day.Weekday()) + 6) % 7))
+}
+
+// RunBackfill fetches the weekly prices published between the start and end dates and stores the ones for
+// weeks that don't have a price yet. Weeks that already have a price are left alone, as RunStorer only
+// accepts the first price published each week.
+func (d *DieselFuelPriceInfo) RunBackfill(appCtx appcontext.AppContext, start time.Time, end time.Time) (BackfillResult, error) {
+	result := BackfillResult{}
+	firstWeek := weekStart(start)
+	lastWeek := weekStart(end)
+	if lastWeek.Before(firstWeek) {
+		return result, fmt.Errorf("backfill end date %s is before start date %s", end.Format("2006-01-02"), start.Format("2006-01-02"))
+	}
+
+	finalEIAAPIURL, err := buildBackfillEIAAPIURL(d.eiaURL, d.eiaKey, firstWeek, lastWeek.AddDate(0, 0, 6))
+	if err != nil {
+		return result, err
+	}
+
+	eiaData, err := d.eiaDataFetcherFunction(finalEIAAPIURL)
+	if err != nil {
+		return result, err
+	}
+
+	err = checkResponseForErrors(eiaData)
+	if err != nil {
+		return result, err
+	}
+
+	verr := eiaData.validateEIAData()
+	if verr != nil {
+		return result, verr
+	}
+	d.eiaData = eiaData
+
+	published, err := publishedPricesByWeek(eiaData, firstWeek, lastWeek)
+	if err != nil {
+		return result, err
+	}
+
+	var existingPrices []models.GHCDieselFuelPrice
+	err = appCtx.DB().
+		Where("publication_date >= ? AND publication_date < ?", firstWeek, lastWeek.AddDate(0, 0, 7)).
+		All(&existingPrices)
+	if err != nil {
+		return result, fmt.Errorf("failed to fetch existing ghcDieselFuelPrices: %w", err)
+	}
+	existingWeeks := make(map[time.Time]bool, len(existingPrices))
+	for _, existingPrice := range existingPrices {
+		existingWeeks[weekStart(existingPrice.PublicationDate)] = true
+	}
+
+	err = appCtx.NewTransaction(func(txnAppCtx appcontext.AppContext) error {
+		for week := firstWeek; !week.After(lastWeek); week = week.AddDate(0, 0, 7) {
+			if existingWeeks[week] {
+				result.ExistingWeeks++
+				continue
+			}
+
+			price, ok := published[week]
+			if !ok {
+				result.MissingWeeks = append(result.MissingWeeks, week)
+				continue
+			}
+
+			newGHCDieselFuelPrice := models.GHCDieselFuelPrice{
+				PublicationDate:       price.publicationDate,
+				FuelPriceInMillicents: priceInMillicents(price.price),
+				EffectiveDate:         price.publicationDate.AddDate(0, 0, 1),
+				EndDate:               fuelPriceEndDate(price.publicationDate),
+			}
+			verrs, err := txnAppCtx.DB().ValidateAndCreate(&newGHCDieselFuelPrice)
+			if err != nil {
+				return fmt.Errorf("failed to create ghcDieselFuelPrice: %w", err)
+			}
+			if verrs.HasAny() {
+				return fmt.Errorf("failed to validate ghcDieselFuelPrice: %w", verrs)
+			}
+
+			txnAppCtx.Logger().Info("backfilled diesel fuel price",
+				zap.String("publication date", price.publicationDate.Format("2006-01-02")),
+				zap.String("fuel price", newGHCDieselFuelPrice.FuelPriceInMillicents.ToDollarString()))
+			result.StoredPublicationDates = append(result.StoredPublicationDates, price.publicationDate)
+		}
+		return nil
+	})
+	if err != nil {
+		return BackfillResult{}, err
+	}
+
+	return result, nil
+}
+
+type publishedPrice struct {
+	publicationDate time.Time
+	price           float64
+}
+
+// publishedPricesByWeek returns the first price published in each week of the range
+func publishedPricesByWeek(eiaData EIAData, firstWeek time.Time, lastWeek time.Time) (map[time.Time]publishedPrice, error) {
+	layout := getEIADateFormatMap()[eiaData.ResponseData.DateFormat]
+	series := eiaData.ResponseData.FuelData[0].Series
+
+	published := make(map[time.Time]publishedPrice)
+	for _, data := range eiaData.ResponseData.FuelData {
+		if data.Series != series {
+			return nil, NewGHCAPIValidationError(fmt.Sprintf("Expected Series to be %s, received %s", series, data.Series))
+		}
+
+		publicationDate, err := time.Parse(layout, data.Period)
+		if err != nil {
+			return nil, err
+		}
+
+		week := weekStart(publicationDate)
+		if week.Before(firstWeek) || week.After(lastWeek) {
+			continue
+		}
+		if existing, ok := published[week]; ok && existing.publicationDate.Before(publicationDate) {
+			continue
+		}
+		published[week] = publishedPrice{publicationDate: publicationDate, price: data.Value}
+	}
+
+	return published, nil
+}
+
+// FindMissingFuelPriceWeeks returns the weeks between the start and end dates that have shipments picked up
+// on a date no diesel fuel price covers
+func FindMissingFuelPriceWeeks(appCtx appcontext.AppContext, start time.Time, end time.Time) ([]MissingFuelPriceWeek, error) {
+	var missingWeeks []MissingFuelPriceWeek
+	err := appCtx.DB().RawQuery(`
+		SELECT date_trunc('week', s.actual_pickup_date)::date AS week_start, count(*) AS shipment_count
+		FROM mto_shipments s
+		WHERE s.actual_pickup_date >= $1
+			AND s.actual_pickup_date < $2
+			AND NOT EXISTS (
+				SELECT 1
+				FROM ghc_diesel_fuel_prices p
+				WHERE s.actual_pickup_date BETWEEN p.effective_date AND p.end_date
+			)
+		GROUP BY week_start
+		ORDER BY week_start`, start, end.AddDate(0, 0, 1)).
+		All(&missingWeeks)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find weeks missing diesel fuel prices: %w", err)
+	}
+
+	return missingWeeks, nil
+}
@@ -0,0 +1,123 @@
+package ghcdieselfuelprice
+
+import (
+	"time"
+
+	"github.com/transcom/mymove/pkg/factory"
+	"github.com/transcom/mymove/pkg/models"
+	"github.com/transcom/mymove/pkg/unit"
+)
+
+const eiaFixtureFile = "fixtures/eia_weekly_diesel_prices.json"
+
+func (suite *GHCDieselFuelPriceServiceSuite) Test_ghcDieselFuelPriceBackfill() {
+	suite.Run("week start is the Monday of the week", func() {
+		monday := time.Date(2024, time.January, 22, 0, 0, 0, 0, time.UTC)
+		suite.Equal(monday, weekStart(monday))
+		suite.Equal(monday, weekStart(time.Date(2024, time.January, 24, 15, 30, 0, 0, time.UTC)))
+		suite.Equal(monday, weekStart(time.Date(2024, time.January, 28, 0, 0, 0, 0, time.UTC)))
+	})
+
+	suite.Run("build EIA Open Data API URL with the backfill range", func() {
+		finalEIAAPIURL, err := buildBackfillEIAAPIURL(
+			"https://api.eia.gov/v2/seriesid/PET.EMD_EPD2D_PTE_NUS_DPG.W",
+			"pUW34B2q8tLooWEVQpU7s9Joq672q2rP", // fake key
+			time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC),
+			time.Date(2024, time.February, 4, 0, 0, 0, 0, time.UTC),
+		)
+		suite.NoError(err)
+		suite.Equal("https://api.eia.gov/v2/seriesid/PET.EMD_EPD2D_PTE_NUS_DPG.W?api_key=pUW34B2q8tLooWEVQpU7s9Joq672q2rP&end=2024-02-04&start=2024-01-01", finalEIAAPIURL)
+	})
+
+	suite.Run("read EIA data from a fixture file", func() {
+		eiaData, err := NewEIAFixtureFetcher(eiaFixtureFile)("")
+		suite.NoError(err)
+		suite.NoError(checkResponseForErrors(eiaData))
+		suite.NoError(eiaData.validateEIAData())
+		suite.Len(eiaData.ResponseData.FuelData, 4)
+		suite.Equal("2024-01-29", eiaData.publicationDate())
+		suite.Equal(3.961, eiaData.price())
+
+		_, err = NewEIAFixtureFetcher("fixtures/does_not_exist.json")("")
+		suite.Error(err)
+	})
+
+	suite.Run("backfill stores the weeks missing a price", func() {
+		existingPrice := models.GHCDieselFuelPrice{
+			PublicationDate:       time.Date(2024, time.January, 8, 0, 0, 0, 0, time.UTC),
+			FuelPriceInMillicents: unit.Millicents(390000),
+			EffectiveDate:         time.Date(2024, time.January, 9, 0, 0, 0, 0, time.UTC),
+			EndDate:               time.Date(2024, time.January, 15, 0, 0, 0, 0, time.UTC),
+		}
+		suite.MustCreate(&existingPrice)
+
+		dieselFuelPriceInfo := NewDieselFuelPriceInfo("", "", NewEIAFixtureFetcher(eiaFixtureFile), suite.Logger())
+		result, err := dieselFuelPriceInfo.RunBackfill(suite.AppContextForTest(),
+			time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC),
+			time.Date(2024, time.January, 31, 0, 0, 0, 0, time.UTC))
+		suite.NoError(err)
+		suite.Equal(1, result.ExistingWeeks)
+		suite.Equal([]time.Time{
+			time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC),
+			time.Date(2024, time.January, 16, 0, 0, 0, 0, time.UTC),
+			time.Date(2024, time.January, 29, 0, 0, 0, 0, time.UTC),
+		}, result.StoredPublicationDates)
+		suite.Equal([]time.Time{time.Date(2024, time.January, 22, 0, 0, 0, 0, time.UTC)}, result.MissingWeeks)
+
+		// the existing price is kept
+		var weekPrices []models.GHCDieselFuelPrice
+		suite.NoError(suite.DB().Where("publication_date = ?", existingPrice.PublicationDate).All(&weekPrices))
+		suite.Len(weekPrices, 1)
+		suite.Equal(unit.Millicents(390000), weekPrices[0].FuelPriceInMillicents)
+
+		// a price published on the Tuesday after a holiday still expires on the following Monday
+		var holidayPrice models.GHCDieselFuelPrice
+		suite.NoError(suite.DB().Where("publication_date = ?", time.Date(2024, time.January, 16, 0, 0, 0, 0, time.UTC)).First(&holidayPrice))
+		suite.Equal(unit.Millicents(392000), holidayPrice.FuelPriceInMillicents)
+		suite.Equal("2024-01-17", holidayPrice.EffectiveDate.Format("2006-01-02"))
+		suite.Equal("2024-01-22", holidayPrice.EndDate.Format("2006-01-02"))
+
+		// running it again finds nothing new to store
+		result, err = dieselFuelPriceInfo.RunBackfill(suite.AppContextForTest(),
+			time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC),
+			time.Date(2024, time.January, 31, 0, 0, 0, 0, time.UTC))
+		suite.NoError(err)
+		suite.Equal(4, result.ExistingWeeks)
+		suite.Empty(result.StoredPublicationDates)
+	})
+
+	suite.Run("backfill rejects an end date before the start date", func() {
+		dieselFuelPriceInfo := NewDieselFuelPriceInfo("", "", NewEIAFixtureFetcher(eiaFixtureFile), suite.Logger())
+		_, err := dieselFuelPriceInfo.RunBackfill(suite.AppContextForTest(),
+			time.Date(2024, time.January, 31, 0, 0, 0, 0, time.UTC),
+			time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC))
+		suite.Error(err)
+	})
+
+	suite.Run("find weeks with shipments picked up without a price", func() {
+		price := models.GHCDieselFuelPrice{
+			PublicationDate:       time.Date(2024, time.January, 8, 0, 0, 0, 0, time.UTC),
+			FuelPriceInMillicents: unit.Millicents(389800),
+			EffectiveDate:         time.Date(2024, time.January, 9, 0, 0, 0, 0, time.UTC),
+			EndDate:               time.Date(2024, time.January, 15, 0, 0, 0, 0, time.UTC),
+		}
+		suite.MustCreate(&price)
+
+		coveredPickup := time.Date(2024, time.January, 10, 0, 0, 0, 0, time.UTC)
+		missingPickup := time.Date(2024, time.January, 24, 0, 0, 0, 0, time.UTC)
+		for _, pickupDate := range []time.Time{coveredPickup, missingPickup, missingPickup} {
+			actualPickupDate := pickupDate
+			factory.BuildMTOShipment(suite.DB(), []factory.Customization{
+				{Model: models.MTOShipment{ActualPickupDate: &actualPickupDate}},
+			}, nil)
+		}
+
+		missingWeeks, err := FindMissingFuelPriceWeeks(suite.AppContextForTest(),
+			time.Date(2024, time.January, 8, 0, 0, 0, 0, time.UTC),
+			time.Date(2024, time.January, 28, 0, 0, 0, 0, time.UTC))
+		suite.NoError(err)
+		suite.Len(missingWeeks, 1)
+		suite.Equal("2024-01-22", missingWeeks[0].WeekStart.Format("2006-01-02"))
+		suite.Equal(2, missingWeeks[0].ShipmentCount)
+	})
+}
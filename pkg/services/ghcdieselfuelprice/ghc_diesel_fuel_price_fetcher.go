@@ -6,6 +6,9 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"os"
+	"path/filepath"
+	"time"
 
 	"go.uber.org/zap"
 
@@ -28,6 +31,48 @@ func buildFinalEIAAPIURL(eiaURL string, eiaKey string) (string, error) {
 	return finalEIAAPIURL, nil
 }
 
+// buildBackfillEIAAPIURL adds the range of weeks to return to the EIA Open Data API URL
+func buildBackfillEIAAPIURL(eiaURL string, eiaKey string, start time.Time, end time.Time) (string, error) {
+	finalEIAAPIURL, err := buildFinalEIAAPIURL(eiaURL, eiaKey)
+	if err != nil {
+		return finalEIAAPIURL, err
+	}
+
+	parsedURL, err := url.Parse(finalEIAAPIURL)
+	if err != nil {
+		return "", fmt.Errorf("unable to parse EIA Open Data API URL: %w", err)
+	}
+
+	query := parsedURL.Query()
+	query.Set("start", start.Format("2006-01-02"))
+	query.Set("end", end.Format("2006-01-02"))
+	parsedURL.RawQuery = query.Encode()
+
+	return parsedURL.String(), nil
+}
+
+// NewEIAFixtureFetcher returns a fetcher that reads a saved EIA Open Data API response from a file instead of
+// calling the API, so fuel prices can be loaded without network access. The fixture has the same format as
+// the API response with the most recent week first; the request URL is ignored.
+func NewEIAFixtureFetcher(filename string) func(string) (EIAData, error) {
+	return func(_ string) (EIAData, error) {
+		eiaData := EIAData{}
+
+		fixture, err := os.ReadFile(filepath.Clean(filename))
+		if err != nil {
+			return eiaData, fmt.Errorf("unable to read EIA fixture file %s: %w", filename, err)
+		}
+
+		err = json.Unmarshal(fixture, &eiaData)
+		if err != nil {
+			return eiaData, fmt.Errorf("unable to unmarshal JSON data from EIA fixture file %s: %w", filename, err)
+		}
+		eiaData.responseStatusCode = http.StatusOK
+
+		return eiaData, nil
+	}
+}
+
 // FetchEIAData makes a call to the EIA Open Data API and returns the API response
 func FetchEIAData(finalEIAAPIURL string) (EIAData, error) {
 	eiaData := EIAData{}
@@ -24,6 +24,27 @@ func publicationDateInTime(d DieselFuelPriceInfo) (time.Time, error) {
 	return publicationDateInTime, err
 }
 
+// fuelPriceEndDate returns the last day a price published on the given date is used
+func fuelPriceEndDate(publicationDate time.Time) time.Time {
+	var daysAdded int
+	//fuel prices are generally published on mondays and then by business rule should expire on monday no matter what- but in case its published on a different day, we will still always expire on the following monday
+	switch publicationDate.Weekday().String() {
+	case "Monday":
+		daysAdded = 7
+	case "Tuesday":
+		daysAdded = 6
+	//very unlikely to get past here- monday is the normal publish day- tuesday if monday is holiday.. but adding other weekdays just in case
+	case "Wednesday":
+		daysAdded = 6
+	case "Thursday":
+		daysAdded = 4
+	case "Friday":
+		daysAdded = 3
+	}
+
+	return publicationDate.AddDate(0, 0, daysAdded)
+}
+
 // RunStorer stores the final EIA weekly average diesel fuel price data in the ghc_diesel_fuel_price table
 func (d *DieselFuelPriceInfo) RunStorer(appCtx appcontext.AppContext) error {
 	priceInMillicents := priceInMillicents(d.dieselFuelPriceData.price)
@@ -47,23 +68,7 @@ func (d *DieselFuelPriceInfo) RunStorer(appCtx appcontext.AppContext) error {
 
 		dayOfWeek := publicationDate.Weekday().String()
 		appCtx.Logger().Info("day_of_week", zap.String("day_of_week", dayOfWeek))
-		var daysAdded int
-		//fuel prices are generally published on mondays and then by business rule should expire on monday no matter what- but in case its published on a different day, we will still always expire on the following monday
-		switch dayOfWeek {
-		case "Monday":
-			daysAdded = 7
-		case "Tuesday":
-			daysAdded = 6
-		//very unlikely to get past here- monday is the normal publish day- tuesday if monday is holiday.. but adding other weekdays just in case
-		case "Wednesday":
-			daysAdded = 6
-		case "Thursday":
-			daysAdded = 4
-		case "Friday":
-			daysAdded = 3
-		}
-
-		newGHCDieselFuelPrice.EndDate = publicationDate.AddDate(0, 0, daysAdded)
+		newGHCDieselFuelPrice.EndDate = fuelPriceEndDate(publicationDate)
 		appCtx.Logger().Info("effective_date", zap.String("effective_date", newGHCDieselFuelPrice.EffectiveDate.String()))
 		appCtx.Logger().Info("end_date", zap.String("EndDate", newGHCDieselFuelPrice.EndDate.String()))
 
@@ -16,6 +16,8 @@ type GHCRateEngineImporter struct {
 	ContractCode                 string
 	ContractName                 string
 	ContractStartDate            time.Time
+	ContractEffectiveDate        *time.Time
+	ContractExpirationDate       *time.Time
 	ContractID                   uuid.UUID
 	serviceAreaToIDMap           map[string]uuid.UUID
 	domesticRateAreaToIDMap      map[string]uuid.UUID
@@ -31,8 +31,10 @@ func (gre *GHCRateEngineImporter) importREContract(appCtx appcontext.AppContext)
 
 	// Contract code is new; insert it.
 	contract := models.ReContract{
-		Code: gre.ContractCode,
-		Name: contractName,
+		Code:           gre.ContractCode,
+		Name:           contractName,
+		EffectiveDate:  gre.ContractEffectiveDate,
+		ExpirationDate: gre.ContractExpirationDate,
 	}
 	verrs, err := appCtx.DB().ValidateAndSave(&contract)
 	if verrs.HasAny() {
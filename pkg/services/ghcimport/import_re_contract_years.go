@@ -54,5 +54,30 @@ func (gre *GHCRateEngineImporter) importREContractYears(appCtx appcontext.AppCon
 		gre.contractYearToIDMap[contractYear.Name] = contractYear.ID
 	}
 
+	return gre.checkOverlappingContracts(appCtx)
+}
+
+// checkOverlappingContracts makes sure a contract whose years overlap another contract's years is
+// given an effective date. Without one both contracts would be in effect for the same dates and
+// either could be picked to price a move.
+func (gre *GHCRateEngineImporter) checkOverlappingContracts(appCtx appcontext.AppContext) error {
+	if gre.ContractEffectiveDate != nil {
+		return nil
+	}
+
+	var overlapping models.ReContracts
+	err := appCtx.DB().Q().
+		Join("re_contract_years other_years", "other_years.contract_id = re_contracts.id").
+		Join("re_contract_years new_years", "new_years.start_date <= other_years.end_date AND new_years.end_date >= other_years.start_date").
+		Where("new_years.contract_id = ?", gre.ContractID).
+		Where("re_contracts.id <> ?", gre.ContractID).
+		All(&overlapping)
+	if err != nil {
+		return fmt.Errorf("could not check for contracts overlapping contract [%s]: %w", gre.ContractCode, err)
+	}
+	if len(overlapping) > 0 {
+		return fmt.Errorf("the contract years of [%s] overlap contract [%s]; provide an effective date so only one contract prices each date", gre.ContractCode, overlapping[0].Code)
+	}
+
 	return nil
 }
@@ -0,0 +1,152 @@
+package ghcrateengine
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/gobuffalo/validate/v3"
+	"github.com/gobuffalo/validate/v3/validators"
+	"github.com/gofrs/uuid"
+
+	"github.com/transcom/mymove/pkg/appcontext"
+	"github.com/transcom/mymove/pkg/apperror"
+	"github.com/transcom/mymove/pkg/models"
+	"github.com/transcom/mymove/pkg/services"
+)
+
+type contractRepricer struct {
+}
+
+// NewContractRepricer creates a new contractRepricer service
+func NewContractRepricer() services.ContractRepricer {
+	return &contractRepricer{}
+}
+
+// RepriceUnderContract prices the service items of historical payment requests as if they had been
+// priced under the candidate contract and reports how each price would change. The params saved when
+// the payment request was priced are reused with only the contract code swapped, so the deltas come
+// from the candidate's rate tables alone. Nothing is written to the database.
+func (r contractRepricer) RepriceUnderContract(appCtx appcontext.AppContext, params services.ContractRepricingParams) (*services.ContractRepricingReport, error) {
+	verrs := validateContractRepricingParams(params)
+	if verrs.HasAny() {
+		return nil, apperror.NewInvalidInputError(uuid.Nil, nil, verrs, "invalid contract repricing parameters")
+	}
+
+	var candidate models.ReContract
+	err := appCtx.DB().Where("code = ?", params.CandidateContractCode).First(&candidate)
+	if err != nil {
+		switch err {
+		case sql.ErrNoRows:
+			return nil, apperror.NewNotFoundError(uuid.Nil, fmt.Sprintf("no contract found with code %s", params.CandidateContractCode))
+		default:
+			return nil, apperror.NewQueryError("ReContract", err, "")
+		}
+	}
+
+	query := appCtx.DB().
+		EagerPreload(
+			"PaymentServiceItems.MTOServiceItem.ReService",
+			"PaymentServiceItems.PaymentServiceItemParams.ServiceItemParamKey",
+		).
+		Order("created_at ASC")
+	if len(params.PaymentRequestNumbers) > 0 {
+		query.Where("payment_request_number IN (?)", params.PaymentRequestNumbers)
+	}
+	if params.CreatedFrom != nil {
+		query.Where("created_at >= ?", *params.CreatedFrom)
+	}
+	if params.CreatedTo != nil {
+		query.Where("created_at < ?", params.CreatedTo.AddDate(0, 0, 1))
+	}
+
+	var paymentRequests models.PaymentRequests
+	err = query.All(&paymentRequests)
+	if err != nil {
+		return nil, apperror.NewQueryError("PaymentRequest", err, "")
+	}
+
+	report := services.ContractRepricingReport{CandidateContractCode: candidate.Code}
+	for _, paymentRequest := range paymentRequests {
+		repriced := services.RepricedPaymentRequest{
+			PaymentRequestID:     paymentRequest.ID,
+			PaymentRequestNumber: paymentRequest.PaymentRequestNumber,
+		}
+
+		for _, item := range paymentRequest.PaymentServiceItems {
+			// items that were never priced have nothing to compare against
+			if item.PriceCents == nil {
+				continue
+			}
+
+			repricedItem := repriceServiceItem(appCtx, item, candidate.Code)
+			repriced.ServiceItems = append(repriced.ServiceItems, repricedItem)
+			if repricedItem.Error != "" {
+				report.FailedCount++
+				continue
+			}
+
+			report.RepricedCount++
+			repriced.OriginalTotalCents = repriced.OriginalTotalCents.AddCents(repricedItem.OriginalPriceCents)
+			repriced.CandidateTotalCents = repriced.CandidateTotalCents.AddCents(repricedItem.CandidatePriceCents)
+		}
+
+		if len(repriced.ServiceItems) == 0 {
+			continue
+		}
+
+		repriced.DeltaCents = repriced.CandidateTotalCents - repriced.OriginalTotalCents
+		report.PaymentRequests = append(report.PaymentRequests, repriced)
+		report.OriginalTotalCents = report.OriginalTotalCents.AddCents(repriced.OriginalTotalCents)
+		report.CandidateTotalCents = report.CandidateTotalCents.AddCents(repriced.CandidateTotalCents)
+	}
+	report.DeltaCents = report.CandidateTotalCents - report.OriginalTotalCents
+
+	return &report, nil
+}
+
+func validateContractRepricingParams(params services.ContractRepricingParams) *validate.Errors {
+	verrs := validate.Validate(
+		&validators.StringIsPresent{Field: params.CandidateContractCode, Name: "CandidateContractCode"},
+	)
+	if len(params.PaymentRequestNumbers) == 0 && params.CreatedFrom == nil && params.CreatedTo == nil {
+		verrs.Add(validators.GenerateKey("PaymentRequestNumbers"), "PaymentRequestNumbers or a created date range must be given to pick the payment requests to reprice")
+	}
+	if params.CreatedFrom != nil && params.CreatedTo != nil && params.CreatedTo.Before(*params.CreatedFrom) {
+		verrs.Add(validators.GenerateKey("CreatedTo"), "CreatedTo must not be before CreatedFrom")
+	}
+	return verrs
+}
+
+// repriceServiceItem prices a payment service item with its saved params under another contract
+func repriceServiceItem(appCtx appcontext.AppContext, item models.PaymentServiceItem, contractCode string) services.RepricedServiceItem {
+	repriced := services.RepricedServiceItem{
+		PaymentServiceItemID: item.ID,
+		ServiceCode:          item.MTOServiceItem.ReService.Code,
+		OriginalPriceCents:   *item.PriceCents,
+	}
+
+	params := make(models.PaymentServiceItemParams, len(item.PaymentServiceItemParams))
+	copy(params, item.PaymentServiceItemParams)
+	for i := range params {
+		if params[i].ServiceItemParamKey.Key == models.ServiceItemParamNameContractCode {
+			repriced.OriginalContractCode = params[i].Value
+			params[i].Value = contractCode
+		}
+	}
+
+	pricer, err := PricerForServiceItem(repriced.ServiceCode)
+	if err != nil {
+		repriced.Error = err.Error()
+		return repriced
+	}
+
+	priceCents, _, err := pricer.PriceUsingParams(appCtx, params)
+	if err != nil {
+		repriced.Error = err.Error()
+		return repriced
+	}
+
+	repriced.CandidatePriceCents = priceCents
+	repriced.DeltaCents = priceCents - repriced.OriginalPriceCents
+	return repriced
+}
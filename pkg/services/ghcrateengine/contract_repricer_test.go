@@ -0,0 +1,132 @@
+package ghcrateengine
+
+import (
+	"time"
+
+	"github.com/transcom/mymove/pkg/apperror"
+	"github.com/transcom/mymove/pkg/factory"
+	"github.com/transcom/mymove/pkg/models"
+	"github.com/transcom/mymove/pkg/services"
+	"github.com/transcom/mymove/pkg/testdatagen"
+	"github.com/transcom/mymove/pkg/unit"
+)
+
+func (suite *GHCRateEngineServiceSuite) TestRepriceUnderContract() {
+	repricer := NewContractRepricer()
+
+	suite.Run("reports the price changes under the candidate contract", func() {
+		candidate := testdatagen.MakeReContract(suite.DB(), testdatagen.Assertions{
+			ReContract: models.ReContract{Code: "CANDIDATE", Name: "Candidate rates"},
+		})
+
+		billedMSPrice := msPriceCents + 100
+		msItem := factory.BuildPaymentServiceItemWithParams(
+			suite.DB(),
+			models.ReServiceCodeMS,
+			[]factory.CreatePaymentServiceItemParams{
+				{
+					Key:     models.ServiceItemParamNameContractCode,
+					KeyType: models.ServiceItemParamTypeString,
+					Value:   factory.DefaultContractCode,
+				},
+				{
+					Key:     models.ServiceItemParamNameLockedPriceCents,
+					KeyType: models.ServiceItemParamTypeInteger,
+					Value:   msPriceCents.String(),
+				},
+			},
+			[]factory.Customization{
+				{Model: models.PaymentServiceItem{PriceCents: &billedMSPrice}},
+			}, nil,
+		)
+
+		// the candidate contract has no linehaul rates, so the linehaul can't be repriced
+		billedDLHPrice := dlhPriceCents
+		dlhItem := factory.BuildPaymentServiceItemWithParams(
+			suite.DB(),
+			models.ReServiceCodeDLH,
+			[]factory.CreatePaymentServiceItemParams{
+				{
+					Key:     models.ServiceItemParamNameContractCode,
+					KeyType: models.ServiceItemParamTypeString,
+					Value:   factory.DefaultContractCode,
+				},
+				{
+					Key:     models.ServiceItemParamNameServiceAreaOrigin,
+					KeyType: models.ServiceItemParamTypeString,
+					Value:   dlhTestServiceArea,
+				},
+			},
+			[]factory.Customization{
+				{Model: msItem.PaymentRequest, LinkOnly: true},
+				{Model: models.PaymentServiceItem{PriceCents: &billedDLHPrice}},
+			}, nil,
+		)
+
+		// payment requests that weren't picked are left out
+		factory.BuildPaymentServiceItem(suite.DB(), nil, nil)
+
+		report, err := repricer.RepriceUnderContract(suite.AppContextForTest(), services.ContractRepricingParams{
+			CandidateContractCode: candidate.Code,
+			PaymentRequestNumbers: []string{msItem.PaymentRequest.PaymentRequestNumber},
+		})
+		suite.NoError(err)
+		suite.Equal(candidate.Code, report.CandidateContractCode)
+		suite.Equal(1, report.RepricedCount)
+		suite.Equal(1, report.FailedCount)
+		suite.Len(report.PaymentRequests, 1)
+
+		paymentRequest := report.PaymentRequests[0]
+		suite.Equal(msItem.PaymentRequest.PaymentRequestNumber, paymentRequest.PaymentRequestNumber)
+		suite.Len(paymentRequest.ServiceItems, 2)
+		for _, item := range paymentRequest.ServiceItems {
+			suite.Equal(factory.DefaultContractCode, item.OriginalContractCode)
+			switch item.PaymentServiceItemID {
+			case msItem.ID:
+				suite.Empty(item.Error)
+				suite.Equal(billedMSPrice, item.OriginalPriceCents)
+				suite.Equal(msPriceCents, item.CandidatePriceCents)
+				suite.Equal(unit.Cents(-100), item.DeltaCents)
+			case dlhItem.ID:
+				suite.NotEmpty(item.Error)
+				suite.Equal(billedDLHPrice, item.OriginalPriceCents)
+			default:
+				suite.Failf("unexpected service item", "%s", item.PaymentServiceItemID)
+			}
+		}
+
+		// only the item that could be repriced is totaled
+		suite.Equal(billedMSPrice, paymentRequest.OriginalTotalCents)
+		suite.Equal(msPriceCents, paymentRequest.CandidateTotalCents)
+		suite.Equal(unit.Cents(-100), report.DeltaCents)
+	})
+
+	suite.Run("returns not found for an unknown contract", func() {
+		_, err := repricer.RepriceUnderContract(suite.AppContextForTest(), services.ContractRepricingParams{
+			CandidateContractCode: "NOT_A_CONTRACT",
+			PaymentRequestNumbers: []string{"1234-5678-1"},
+		})
+		suite.Error(err)
+		suite.IsType(apperror.NotFoundError{}, err)
+	})
+
+	suite.Run("requires payment requests to be picked", func() {
+		_, err := repricer.RepriceUnderContract(suite.AppContextForTest(), services.ContractRepricingParams{
+			CandidateContractCode: "CANDIDATE",
+		})
+		suite.Error(err)
+		suite.IsType(apperror.InvalidInputError{}, err)
+	})
+
+	suite.Run("rejects a created date range that ends before it starts", func() {
+		from := time.Date(testdatagen.TestYear, time.June, 2, 0, 0, 0, 0, time.UTC)
+		to := from.AddDate(0, 0, -1)
+		_, err := repricer.RepriceUnderContract(suite.AppContextForTest(), services.ContractRepricingParams{
+			CandidateContractCode: "CANDIDATE",
+			CreatedFrom:           &from,
+			CreatedTo:             &to,
+		})
+		suite.Error(err)
+		suite.IsType(apperror.InvalidInputError{}, err)
+	})
+}
@@ -23,7 +23,7 @@ func NewDomesticDestinationSITFuelSurchargePricer() services.DomesticDestination
 	return &domesticDestinationSITFuelSurchargePricer{}
 }
 
-func (p domesticDestinationSITFuelSurchargePricer) Price(_ appcontext.AppContext, actualPickupDate time.Time, distance unit.Miles, weight unit.Pound, fscWeightBasedDistanceMultiplier float64, eiaFuelPrice unit.Millicents, isPPM bool) (unit.Cents, services.PricingDisplayParams, error) {
+func (p domesticDestinationSITFuelSurchargePricer) Price(appCtx appcontext.AppContext, actualPickupDate time.Time, distance unit.Miles, weight unit.Pound, fscWeightBasedDistanceMultiplier float64, eiaFuelPrice unit.Millicents, isPPM bool) (unit.Cents, services.PricingDisplayParams, error) {
 	// Validate parameters
 	if actualPickupDate.IsZero() {
 		return 0, nil, errors.New("ActualPickupDate is required")
@@ -43,6 +43,7 @@ func (p domesticDestinationSITFuelSurchargePricer) Price(_ appcontext.AppContext
 
 	fscPriceDifferenceInCents := (eiaFuelPrice - baseGHCDieselFuelPrice).Float64() / 1000.0
 	fscMultiplier := fscWeightBasedDistanceMultiplier * distance.Float64()
+	recordFuelSurchargeTrace(appCtx, actualPickupDate, eiaFuelPrice, fscPriceDifferenceInCents, fscMultiplier)
 	fscPrice := fscMultiplier * fscPriceDifferenceInCents * 100
 	totalCost := unit.Cents(math.Round(fscPrice))
 
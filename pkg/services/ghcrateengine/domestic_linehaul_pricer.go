@@ -3,6 +3,7 @@ package ghcrateengine
 import (
 	"fmt"
 	"math"
+	"strconv"
 	"time"
 
 	"github.com/pkg/errors"
@@ -135,5 +136,14 @@ func fetchDomesticLinehaulPrice(appCtx appcontext.AppContext, contractCode strin
 		return models.ReDomesticLinehaulPrice{}, err
 	}
 
+	recordPriceRow(appCtx, "re_domestic_linehaul_prices", domesticLinehaulPrice.ID,
+		traceParam("service_area", serviceArea),
+		traceParam("is_peak_period", strconv.FormatBool(domesticLinehaulPrice.IsPeakPeriod)),
+		traceParam("weight_lower", strconv.Itoa(domesticLinehaulPrice.WeightLower.Int())),
+		traceParam("weight_upper", strconv.Itoa(domesticLinehaulPrice.WeightUpper.Int())),
+		traceParam("miles_lower", strconv.Itoa(domesticLinehaulPrice.MilesLower)),
+		traceParam("miles_upper", strconv.Itoa(domesticLinehaulPrice.MilesUpper)),
+		traceParam("price_millicents", strconv.Itoa(domesticLinehaulPrice.PriceMillicents.Int())))
+
 	return domesticLinehaulPrice, nil
 }
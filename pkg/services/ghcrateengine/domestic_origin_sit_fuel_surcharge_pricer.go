@@ -24,7 +24,7 @@ func NewDomesticOriginSITFuelSurchargePricer() services.DomesticOriginSITFuelSur
 }
 
 // Price determines the price for Domestic Origin SIT Fuel Surcharges
-func (p domesticOriginFuelSurchargePricer) Price(_ appcontext.AppContext, actualPickupDate time.Time, distance unit.Miles, weight unit.Pound, fscWeightBasedDistanceMultiplier float64, eiaFuelPrice unit.Millicents, isPPM bool) (unit.Cents, services.PricingDisplayParams, error) {
+func (p domesticOriginFuelSurchargePricer) Price(appCtx appcontext.AppContext, actualPickupDate time.Time, distance unit.Miles, weight unit.Pound, fscWeightBasedDistanceMultiplier float64, eiaFuelPrice unit.Millicents, isPPM bool) (unit.Cents, services.PricingDisplayParams, error) {
 	// Validate parameters
 	if actualPickupDate.IsZero() {
 		return 0, nil, errors.New("ActualPickupDate is required")
@@ -44,6 +44,7 @@ func (p domesticOriginFuelSurchargePricer) Price(_ appcontext.AppContext, actual
 
 	fscPriceDifferenceInCents := (eiaFuelPrice - baseGHCDieselFuelPrice).Float64() / 1000.0
 	fscMultiplier := fscWeightBasedDistanceMultiplier * distance.Float64()
+	recordFuelSurchargeTrace(appCtx, actualPickupDate, eiaFuelPrice, fscPriceDifferenceInCents, fscMultiplier)
 	fscPrice := fscMultiplier * fscPriceDifferenceInCents * 100
 	totalCost := unit.Cents(math.Round(fscPrice))
 
@@ -28,7 +28,7 @@ func NewFuelSurchargePricer() services.FuelSurchargePricer {
 }
 
 // Price determines the price for fuel surcharge
-func (p fuelSurchargePricer) Price(_ appcontext.AppContext, actualPickupDate time.Time, distance unit.Miles, weight unit.Pound, fscWeightBasedDistanceMultiplier float64, eiaFuelPrice unit.Millicents, isPPM bool) (unit.Cents, services.PricingDisplayParams, error) {
+func (p fuelSurchargePricer) Price(appCtx appcontext.AppContext, actualPickupDate time.Time, distance unit.Miles, weight unit.Pound, fscWeightBasedDistanceMultiplier float64, eiaFuelPrice unit.Millicents, isPPM bool) (unit.Cents, services.PricingDisplayParams, error) {
 	// Validate parameters
 	if actualPickupDate.IsZero() {
 		return 0, nil, errors.New("ActualPickupDate is required")
@@ -48,6 +48,7 @@ func (p fuelSurchargePricer) Price(_ appcontext.AppContext, actualPickupDate tim
 
 	fscPriceDifferenceInCents := (eiaFuelPrice - baseGHCDieselFuelPrice).Float64() / 1000.0
 	fscMultiplier := fscWeightBasedDistanceMultiplier * distance.Float64()
+	recordFuelSurchargeTrace(appCtx, actualPickupDate, eiaFuelPrice, fscPriceDifferenceInCents, fscMultiplier)
 	fscPrice := fscMultiplier * fscPriceDifferenceInCents * 100
 	totalCost := unit.Cents(math.Round(fscPrice))
 
@@ -59,6 +59,7 @@ func (p *intlNTSHHGPackPricer) Price(
 	}
 
 	// Now we multiply the IHPK base price by the NTS factor
+	recordPricingStep(appCtx, "NTS packing factor", factor)
 	finalPrice := unit.Cents(math.Round(float64(basePrice) * factor))
 
 	// Append the factor to the params
@@ -88,6 +89,7 @@ func (p *intlNTSHHGPackPricer) PriceUsingParams(
 	}
 
 	// Now we multiply the IHPK base price by the NTS factor
+	recordPricingStep(appCtx, "NTS packing factor", factor)
 	finalPrice := unit.Cents(math.Round(float64(basePrice) * factor))
 
 	return finalPrice, displayParams, nil
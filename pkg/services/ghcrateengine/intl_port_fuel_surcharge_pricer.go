@@ -23,7 +23,7 @@ func NewPortFuelSurchargePricer() services.IntlPortFuelSurchargePricer {
 	return &portFuelSurchargePricer{}
 }
 
-func (p portFuelSurchargePricer) Price(_ appcontext.AppContext, actualPickupDate time.Time, distance unit.Miles, weight unit.Pound, fscWeightBasedDistanceMultiplier float64, eiaFuelPrice unit.Millicents, shipmentType models.MTOShipmentType) (unit.Cents, services.PricingDisplayParams, error) {
+func (p portFuelSurchargePricer) Price(appCtx appcontext.AppContext, actualPickupDate time.Time, distance unit.Miles, weight unit.Pound, fscWeightBasedDistanceMultiplier float64, eiaFuelPrice unit.Millicents, shipmentType models.MTOShipmentType) (unit.Cents, services.PricingDisplayParams, error) {
 	// Validate parameters
 	if actualPickupDate.IsZero() {
 		return 0, nil, errors.New("ActualPickupDate is required")
@@ -48,6 +48,7 @@ func (p portFuelSurchargePricer) Price(_ appcontext.AppContext, actualPickupDate
 
 	fscPriceDifferenceInCents := (eiaFuelPrice - baseGHCDieselFuelPrice).Float64() / 1000.0
 	fscMultiplier := fscWeightBasedDistanceMultiplier * distance.Float64()
+	recordFuelSurchargeTrace(appCtx, actualPickupDate, eiaFuelPrice, fscPriceDifferenceInCents, fscMultiplier)
 	fscPrice := fscMultiplier * fscPriceDifferenceInCents * 100
 	totalCost := unit.Cents(math.Round(fscPrice))
 
@@ -50,6 +50,9 @@ func (p intlUBPricer) Price(appCtx appcontext.AppContext, contractCode string, r
 	basePrice := float64(perUnitCents) / 100
 	escalatedPrice := basePrice * contractYear.EscalationCompounded
 	escalatedPrice = math.Round(escalatedPrice*100) / 100
+	recordPricingStep(appCtx, "base price in cents", float64(perUnitCents))
+	recordPricingStep(appCtx, "escalated price in cents", escalatedPrice*100)
+	recordPricingStep(appCtx, "weight in CWT", weight.ToCWTFloat64())
 	totalEscalatedPrice := escalatedPrice * weight.ToCWTFloat64()
 	totalPriceCents := unit.Cents(math.Round(totalEscalatedPrice * 100))
 
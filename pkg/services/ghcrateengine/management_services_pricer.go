@@ -24,6 +24,8 @@ func (p managementServicesPricer) Price(appCtx appcontext.AppContext, lockedPric
 		return 0, nil, fmt.Errorf("invalid value for locked_price_cents")
 	}
 
+	recordPricingStep(appCtx, "locked price in cents", lockedPriceCents.Float64())
+
 	params := services.PricingDisplayParams{
 		{
 			Key:   models.ServiceItemParamNamePriceRateOrFactor,
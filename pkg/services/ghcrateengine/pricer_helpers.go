@@ -507,6 +507,8 @@ func escalatePriceForContractYear(appCtx appcontext.AppContext, contractID uuid.
 	}
 
 	escalatedPrice = roundToPrecision(escalatedPrice, precision)
+	recordPricingStep(appCtx, "base price in cents", basePriceCents)
+	recordPricingStep(appCtx, "escalated price in cents", escalatedPrice)
 	return escalatedPrice, contractYear, nil
 }
 
@@ -563,6 +565,7 @@ func compoundEscalationFactors(appCtx appcontext.AppContext, contractID uuid.UUI
 	if expectations.ExpectedAmountOfContractYearsForCalculation > 0 {
 		for _, contract := range contractYearsForCalculation {
 			compoundedEscalatedPrice = compoundedEscalatedPrice * contract.Escalation
+			recordPriceRow(appCtx, "re_contract_years", contract.ID, contractYearTraceValues(contract)...)
 		}
 	}
 
@@ -16,6 +16,9 @@ import (
 	"github.com/transcom/mymove/pkg/unit"
 )
 
+// fetchContractFromParams returns the contract a payment service item is priced under. The ContractCode param
+// wins when present, so repricing under another contract works; otherwise it's the contract in effect when the
+// move was made available to the Prime.
 func fetchContractFromParams(
 	appCtx appcontext.AppContext,
 	params models.PaymentServiceItemParams,
@@ -24,6 +27,17 @@ func fetchContractFromParams(
 		return models.ReContract{}, fmt.Errorf("no payment service item params provided")
 	}
 
+	if contractCodeParam := getPaymentServiceItemParam(params, models.ServiceItemParamNameContractCode); contractCodeParam != nil && contractCodeParam.Value != "" {
+		contract, err := fetchContractByContractCode(appCtx, contractCodeParam.Value)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return models.ReContract{}, apperror.NewNotFoundError(uuid.Nil, fmt.Sprintf("no contract found with code %s", contractCodeParam.Value))
+			}
+			return models.ReContract{}, err
+		}
+		return contract, nil
+	}
+
 	// All params in this slice should have the same PaymentServiceItemID, so we just take the first
 	paymentServiceItemID := params[0].PaymentServiceItemID
 
@@ -50,17 +64,7 @@ func fetchContractFromParams(
 		return models.ReContract{}, apperror.NewConflictError(move.ID, "unable to fetch contract for ghcrateengine pricing because move is not available to prime")
 	}
 
-	var contractYear models.ReContractYear
-	err = appCtx.DB().EagerPreload("Contract").Where("? between start_date and end_date", move.AvailableToPrimeAt).
-		First(&contractYear)
-	if err != nil {
-		if err == sql.ErrNoRows {
-			return models.ReContract{}, apperror.NewNotFoundError(uuid.Nil, fmt.Sprintf("no contract year found for %s", move.AvailableToPrimeAt.String()))
-		}
-		return models.ReContract{}, err
-	}
-
-	return contractYear.Contract, nil
+	return models.FetchContractInEffect(appCtx, *move.AvailableToPrimeAt)
 }
 
 func priceInternationalShuttling(appCtx appcontext.AppContext, shuttlingCode models.ReServiceCode, contractCode string, referenceDate time.Time, weight unit.Pound, market models.Market) (unit.Cents, services.PricingDisplayParams, error) {
@@ -93,6 +97,7 @@ func priceInternationalShuttling(appCtx appcontext.AppContext, shuttlingCode mod
 		return 0, nil, fmt.Errorf("could not calculate escalated price: %w", err)
 	}
 
+	recordPricingStep(appCtx, "weight in CWT", weight.ToCWTFloat64())
 	escalatedPrice = escalatedPrice * weight.ToCWTFloat64()
 	totalCost := unit.Cents(math.Round(escalatedPrice))
 
@@ -141,6 +146,7 @@ func priceIntlPackUnpack(appCtx appcontext.AppContext, packUnpackCode models.ReS
 		return 0, nil, fmt.Errorf("could not calculate escalated price: %w", err)
 	}
 
+	recordPricingStep(appCtx, "weight in CWT", weight.ToCWTFloat64())
 	escalatedPrice = escalatedPrice * weight.ToCWTFloat64()
 	totalCost := unit.Cents(math.Round(escalatedPrice))
 
@@ -193,6 +199,7 @@ func priceIntlFirstDaySIT(appCtx appcontext.AppContext, firstDaySITCode models.R
 		return 0, nil, fmt.Errorf("could not calculate escalated price: %w", err)
 	}
 
+	recordPricingStep(appCtx, "weight in CWT", weight.ToCWTFloat64())
 	escalatedPrice = escalatedPrice * weight.ToCWTFloat64()
 	totalCost := unit.Cents(math.Round(escalatedPrice))
 
@@ -248,6 +255,7 @@ func priceIntlAdditionalDaySIT(appCtx appcontext.AppContext, additionalDaySITCod
 		return 0, nil, fmt.Errorf("could not calculate escalated price: %w", err)
 	}
 
+	recordPricingStep(appCtx, "weight in CWT", weight.ToCWTFloat64())
 	escalatedPrice = escalatedPrice * weight.ToCWTFloat64()
 	totalForNumberOfDaysPrice := escalatedPrice * float64(numberOfDaysInSIT)
 	totalCost := unit.Cents(math.Round(totalForNumberOfDaysPrice))
@@ -305,6 +313,7 @@ func priceIntlCratingUncrating(appCtx appcontext.AppContext, cratingUncratingCod
 		return 0, nil, fmt.Errorf("could not calculate escalated price: %w", err)
 	}
 
+	recordPricingStep(appCtx, "billed cubic feet", float64(billedCubicFeet))
 	escalatedPrice = escalatedPrice * float64(billedCubicFeet)
 	totalCost := unit.Cents(math.Round(escalatedPrice))
 
@@ -334,7 +343,7 @@ func priceIntlCratingUncrating(appCtx appcontext.AppContext, cratingUncratingCod
 	return totalCost, displayParams, nil
 }
 
-func priceIntlFuelSurchargeSIT(_ appcontext.AppContext, fuelSurchargeCode models.ReServiceCode, actualPickupDate time.Time, distance unit.Miles, weight unit.Pound, fscWeightBasedDistanceMultiplier float64, eiaFuelPrice unit.Millicents) (unit.Cents, services.PricingDisplayParams, error) {
+func priceIntlFuelSurchargeSIT(appCtx appcontext.AppContext, fuelSurchargeCode models.ReServiceCode, actualPickupDate time.Time, distance unit.Miles, weight unit.Pound, fscWeightBasedDistanceMultiplier float64, eiaFuelPrice unit.Millicents) (unit.Cents, services.PricingDisplayParams, error) {
 	if fuelSurchargeCode != models.ReServiceCodeIOSFSC && fuelSurchargeCode != models.ReServiceCodeIDSFSC {
 		return 0, nil, fmt.Errorf("unsupported international fuel surcharge code of %s", fuelSurchargeCode)
 	}
@@ -360,6 +369,7 @@ func priceIntlFuelSurchargeSIT(_ appcontext.AppContext, fuelSurchargeCode models
 
 	fscPriceDifferenceInCents := (eiaFuelPrice - baseGHCDieselFuelPrice).Float64() / 1000.0
 	fscMultiplier := fscWeightBasedDistanceMultiplier * distance.Float64()
+	recordFuelSurchargeTrace(appCtx, actualPickupDate, eiaFuelPrice, fscPriceDifferenceInCents, fscMultiplier)
 	fscPrice := fscMultiplier * fscPriceDifferenceInCents * 100
 	totalCost := unit.Cents(math.Round(fscPrice))
 
@@ -399,8 +409,7 @@ func priceIntlPickupDeliverySIT(appCtx appcontext.AppContext, pickupDeliverySITC
 
 	isPeakPeriod := IsPeakPeriod(referenceDate)
 
-	var reContract models.ReContract
-	err := appCtx.DB().Where("re_contracts.code = ?", contractCode).First(&reContract)
+	reContract, err := fetchContractByContractCode(appCtx, contractCode)
 	if err != nil {
 		return 0, nil, fmt.Errorf("could not retrieve contract by code: %w", err)
 	}
@@ -410,6 +419,8 @@ func priceIntlPickupDeliverySIT(appCtx appcontext.AppContext, pickupDeliverySITC
 		return 0, nil, fmt.Errorf("could not calculate escalated price: %w", err)
 	}
 
+	recordPricingStep(appCtx, "weight in CWT", weight.ToCWTFloat64())
+	recordPricingStep(appCtx, "distance in miles", float64(distance))
 	if distance > 50 {
 		// multiply with distance if over 50 miles
 		escalatedPrice = escalatedPrice * weight.ToCWTFloat64() * float64(distance)
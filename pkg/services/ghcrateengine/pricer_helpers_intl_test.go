@@ -5,6 +5,7 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/transcom/mymove/pkg/apperror"
 	"github.com/transcom/mymove/pkg/factory"
 	"github.com/transcom/mymove/pkg/models"
 	"github.com/transcom/mymove/pkg/services"
@@ -74,6 +75,82 @@ func (suite *GHCRateEngineServiceSuite) Test_fetchContractFromParams() {
 		suite.FatalNoError(err)
 		suite.Equal(fetchedContract.ID, contract.ID)
 	})
+
+	// two contracts whose years both cover the date the move was made available to the Prime
+	setupOverlappingContracts := func(availableToPrimeAt time.Time) (models.ReContract, models.ReContract) {
+		previousEffectiveDate := availableToPrimeAt.AddDate(-1, 0, 0)
+		previous := testdatagen.MakeReContract(suite.DB(), testdatagen.Assertions{
+			ReContract: models.ReContract{Code: "PREVIOUS", Name: "Previous rates", EffectiveDate: &previousEffectiveDate},
+		})
+		currentEffectiveDate := availableToPrimeAt.AddDate(0, 0, -1)
+		current := testdatagen.MakeReContract(suite.DB(), testdatagen.Assertions{
+			ReContract: models.ReContract{Code: "CURRENT", Name: "Current rates", EffectiveDate: &currentEffectiveDate},
+		})
+		for _, overlapping := range []models.ReContract{previous, current} {
+			testdatagen.MakeReContractYear(suite.DB(), testdatagen.Assertions{
+				ReContractYear: models.ReContractYear{
+					Contract:             overlapping,
+					ContractID:           overlapping.ID,
+					StartDate:            availableToPrimeAt.AddDate(0, 0, -7),
+					EndDate:              availableToPrimeAt.AddDate(0, 0, 7),
+					Escalation:           1.0,
+					EscalationCompounded: 1.0,
+				},
+			})
+		}
+		return previous, current
+	}
+
+	buildINPKItem := func(availableToPrimeAt time.Time, params []factory.CreatePaymentServiceItemParams) models.PaymentServiceItem {
+		params = append(params, factory.CreatePaymentServiceItemParams{
+			Key:     models.ServiceItemParamNameWeightBilled,
+			KeyType: models.ServiceItemParamTypeInteger,
+			Value:   strconv.Itoa(ihpkTestWeight.Int()),
+		})
+		return factory.BuildPaymentServiceItemWithParams(suite.DB(), models.ReServiceCodeINPK, params, []factory.Customization{
+			{Model: models.Move{AvailableToPrimeAt: &availableToPrimeAt}},
+		}, nil)
+	}
+
+	suite.Run("picks the contract in effect when contract years overlap", func() {
+		availableToPrimeAt := time.Date(2031, time.March, 15, 0, 0, 0, 0, time.UTC)
+		_, current := setupOverlappingContracts(availableToPrimeAt)
+		paymentServiceItem := buildINPKItem(availableToPrimeAt, nil)
+
+		fetchedContract, err := fetchContractFromParams(suite.AppContextForTest(), paymentServiceItem.PaymentServiceItemParams)
+		suite.FatalNoError(err)
+		suite.Equal(current.ID, fetchedContract.ID)
+	})
+
+	suite.Run("uses the ContractCode param over the contract in effect", func() {
+		availableToPrimeAt := time.Date(2031, time.March, 15, 0, 0, 0, 0, time.UTC)
+		previous, _ := setupOverlappingContracts(availableToPrimeAt)
+		paymentServiceItem := buildINPKItem(availableToPrimeAt, []factory.CreatePaymentServiceItemParams{
+			{
+				Key:     models.ServiceItemParamNameContractCode,
+				KeyType: models.ServiceItemParamTypeString,
+				Value:   previous.Code,
+			},
+		})
+
+		fetchedContract, err := fetchContractFromParams(suite.AppContextForTest(), paymentServiceItem.PaymentServiceItemParams)
+		suite.FatalNoError(err)
+		suite.Equal(previous.ID, fetchedContract.ID)
+	})
+
+	suite.Run("returns not found for an unknown ContractCode param", func() {
+		paymentServiceItem := buildINPKItem(time.Now(), []factory.CreatePaymentServiceItemParams{
+			{
+				Key:     models.ServiceItemParamNameContractCode,
+				KeyType: models.ServiceItemParamTypeString,
+				Value:   "BOGUS",
+			},
+		})
+
+		_, err := fetchContractFromParams(suite.AppContextForTest(), paymentServiceItem.PaymentServiceItemParams)
+		suite.Error(err)
+		suite.IsType(apperror.NotFoundError{}, err)
+	})
 }
 
 func (suite *GHCRateEngineServiceSuite) Test_priceInternationalShuttling() {
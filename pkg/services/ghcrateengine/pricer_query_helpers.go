@@ -1,6 +1,7 @@
 package ghcrateengine
 
 import (
+	"strconv"
 	"time"
 
 	"github.com/gofrs/uuid"
@@ -24,6 +25,9 @@ func fetchTaskOrderFee(appCtx appcontext.AppContext, contractCode string, servic
 		return models.ReTaskOrderFee{}, err
 	}
 
+	recordPriceRow(appCtx, "re_task_order_fees", taskOrderFee.ID,
+		traceParam("price_cents", taskOrderFee.PriceCents.String()))
+
 	return taskOrderFee, nil
 }
 
@@ -42,6 +46,11 @@ func fetchDomOtherPrice(appCtx appcontext.AppContext, contractCode string, servi
 		return models.ReDomesticOtherPrice{}, err
 	}
 
+	recordPriceRow(appCtx, "re_domestic_other_prices", domOtherPrice.ID,
+		traceParam("schedule", strconv.Itoa(domOtherPrice.Schedule)),
+		traceParam("is_peak_period", strconv.FormatBool(domOtherPrice.IsPeakPeriod)),
+		traceParam("price_cents", domOtherPrice.PriceCents.String()))
+
 	return domOtherPrice, nil
 }
 
@@ -61,6 +70,11 @@ func fetchDomServiceAreaPrice(appCtx appcontext.AppContext, contractCode string,
 		return models.ReDomesticServiceAreaPrice{}, err
 	}
 
+	recordPriceRow(appCtx, "re_domestic_service_area_prices", domServiceAreaPrice.ID,
+		traceParam("service_area", serviceArea),
+		traceParam("is_peak_period", strconv.FormatBool(domServiceAreaPrice.IsPeakPeriod)),
+		traceParam("price_cents", domServiceAreaPrice.PriceCents.String()))
+
 	return domServiceAreaPrice, nil
 }
 
@@ -78,6 +92,10 @@ func fetchInternationalAccessorialPrice(appCtx appcontext.AppContext, contractCo
 		return models.ReIntlAccessorialPrice{}, err
 	}
 
+	recordPriceRow(appCtx, "re_intl_accessorial_prices", internationalAccessorialPrice.ID,
+		traceParam("market", string(internationalAccessorialPrice.Market)),
+		traceParam("per_unit_cents", internationalAccessorialPrice.PerUnitCents.String()))
+
 	return internationalAccessorialPrice, nil
 }
 
@@ -95,6 +113,10 @@ func fetchAccessorialPrice(appCtx appcontext.AppContext, contractCode string, se
 		return models.ReDomesticAccessorialPrice{}, err
 	}
 
+	recordPriceRow(appCtx, "re_domestic_accessorial_prices", domAccessorialPrice.ID,
+		traceParam("services_schedule", strconv.Itoa(domAccessorialPrice.ServicesSchedule)),
+		traceParam("per_unit_cents", domAccessorialPrice.PerUnitCents.String()))
+
 	return domAccessorialPrice, nil
 }
 
@@ -107,6 +129,8 @@ func fetchContractYear(appCtx appcontext.AppContext, contractID uuid.UUID, date
 		return models.ReContractYear{}, err
 	}
 
+	recordPriceRow(appCtx, "re_contract_years", contractYear.ID, contractYearTraceValues(contractYear)...)
+
 	return contractYear, nil
 }
 
@@ -127,6 +151,10 @@ func fetchContractByContractCode(appCtx appcontext.AppContext, contractCode stri
 		return models.ReContract{}, err
 	}
 
+	recordPriceRow(appCtx, "re_contracts", contract.ID,
+		traceParam("code", contract.Code),
+		traceParam("name", contract.Name))
+
 	return contract, nil
 }
 
@@ -144,5 +172,9 @@ func fetchShipmentTypePrice(appCtx appcontext.AppContext, contractCode string, s
 		return models.ReShipmentTypePrice{}, err
 	}
 
+	recordPriceRow(appCtx, "re_shipment_type_prices", shipmentTypePrice.ID,
+		traceParam("market", string(shipmentTypePrice.Market)),
+		traceParam("factor", strconv.FormatFloat(shipmentTypePrice.Factor, 'f', -1, 64)))
+
 	return shipmentTypePrice, nil
 }
@@ -0,0 +1,105 @@
+package ghcrateengine
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"go.uber.org/zap"
+
+	"github.com/transcom/mymove/pkg/appcontext"
+	"github.com/transcom/mymove/pkg/models"
+	"github.com/transcom/mymove/pkg/unit"
+)
+
+type pricingTraceContextKey struct{}
+
+// newTracingAppContext returns an AppContext that collects the pricing trace of a service item while its pricer
+// runs. The trace rides on the context of the database connection, so transactions and other AppContexts
+// derived from it keep recording into the same trace.
+func newTracingAppContext(appCtx appcontext.AppContext, trace *models.PricingTrace) appcontext.AppContext {
+	ctx := context.WithValue(appCtx.DB().Context(), pricingTraceContextKey{}, trace)
+	return appcontext.NewAppContext(appCtx.DB().WithContext(ctx), appCtx.Logger(), appCtx.Session(), appCtx.HTTPRequest())
+}
+
+// pricingTraceFromAppContext returns the trace being collected, if the pricer is being traced
+func pricingTraceFromAppContext(appCtx appcontext.AppContext) (*models.PricingTrace, bool) {
+	if appCtx.DB() == nil {
+		return nil, false
+	}
+	trace, ok := appCtx.DB().Context().Value(pricingTraceContextKey{}).(*models.PricingTrace)
+	return trace, ok
+}
+
+// recordPriceRow adds a row of a pricing table to the trace, when the pricer is being traced
+func recordPriceRow(appCtx appcontext.AppContext, table string, id uuid.UUID, values ...models.PricingTraceParam) {
+	trace, ok := pricingTraceFromAppContext(appCtx)
+	if !ok {
+		return
+	}
+	for _, row := range trace.PriceRows {
+		if row.Table == table && row.ID == id {
+			return
+		}
+	}
+	trace.PriceRows = append(trace.PriceRows, models.PricingTraceRow{Table: table, ID: id, Values: values})
+}
+
+// recordPricingStep adds an intermediate value of the calculation to the trace, when the pricer is being traced
+func recordPricingStep(appCtx appcontext.AppContext, description string, value float64) {
+	trace, ok := pricingTraceFromAppContext(appCtx)
+	if !ok {
+		return
+	}
+	trace.Steps = append(trace.Steps, models.PricingTraceParam{
+		Key:   description,
+		Value: strconv.FormatFloat(value, 'f', -1, 64),
+	})
+}
+
+// recordFuelSurchargeTrace adds the ghc_diesel_fuel_prices row the EIAFuelPrice param was looked up from and the
+// fuel surcharge calculation steps to the trace, when the pricer is being traced
+func recordFuelSurchargeTrace(appCtx appcontext.AppContext, actualPickupDate time.Time, eiaFuelPrice unit.Millicents, fscPriceDifferenceInCents float64, fscMultiplier float64) {
+	if _, ok := pricingTraceFromAppContext(appCtx); !ok {
+		return
+	}
+
+	// the same lookup the EIAFuelPrice param came from, so the row is the one the price was taken from
+	ghcDieselFuelPrice, _, err := models.FetchGHCDieselFuelPriceForPickupDate(appCtx.DB(), actualPickupDate)
+	switch {
+	case err != nil:
+		appCtx.Logger().Warn("could not find the diesel fuel price used for the fuel surcharge",
+			zap.Time("actual_pickup_date", actualPickupDate),
+			zap.Error(err))
+	case ghcDieselFuelPrice.FuelPriceInMillicents != eiaFuelPrice:
+		// the param wasn't looked up from the current fuel prices, for example it was entered by hand
+		appCtx.Logger().Warn("the diesel fuel price for the pickup date doesn't match the EIAFuelPrice param",
+			zap.Time("actual_pickup_date", actualPickupDate),
+			zap.Int("eia_fuel_price", eiaFuelPrice.Int()),
+			zap.Int("fuel_price_in_millicents", ghcDieselFuelPrice.FuelPriceInMillicents.Int()))
+	default:
+		recordPriceRow(appCtx, "ghc_diesel_fuel_prices", ghcDieselFuelPrice.ID,
+			traceParam("fuel_price_in_millicents", strconv.Itoa(ghcDieselFuelPrice.FuelPriceInMillicents.Int())),
+			traceParam("publication_date", ghcDieselFuelPrice.PublicationDate.Format(DateParamFormat)),
+			traceParam("effective_date", ghcDieselFuelPrice.EffectiveDate.Format(DateParamFormat)),
+			traceParam("end_date", ghcDieselFuelPrice.EndDate.Format(DateParamFormat)))
+	}
+
+	recordPricingStep(appCtx, "fuel price difference in cents", fscPriceDifferenceInCents)
+	recordPricingStep(appCtx, "fuel surcharge multiplier", fscMultiplier)
+}
+
+func traceParam(key string, value string) models.PricingTraceParam {
+	return models.PricingTraceParam{Key: key, Value: value}
+}
+
+func contractYearTraceValues(contractYear models.ReContractYear) []models.PricingTraceParam {
+	return []models.PricingTraceParam{
+		traceParam("name", contractYear.Name),
+		traceParam("start_date", contractYear.StartDate.Format(DateParamFormat)),
+		traceParam("end_date", contractYear.EndDate.Format(DateParamFormat)),
+		traceParam("escalation", strconv.FormatFloat(contractYear.Escalation, 'f', -1, 64)),
+		traceParam("escalation_compounded", strconv.FormatFloat(contractYear.EscalationCompounded, 'f', -1, 64)),
+	}
+}
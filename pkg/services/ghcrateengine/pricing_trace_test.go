@@ -0,0 +1,601 @@
+package ghcrateengine
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/gofrs/uuid"
+
+	"github.com/transcom/mymove/pkg/appcontext"
+	"github.com/transcom/mymove/pkg/models"
+	"github.com/transcom/mymove/pkg/testdatagen"
+	"github.com/transcom/mymove/pkg/unit"
+)
+
+func (suite *GHCRateEngineServiceSuite) TestRecordPricingTrace() {
+	suite.Run("records each price row once", func() {
+		var trace models.PricingTrace
+		appCtx := newTracingAppContext(suite.AppContextForTest(), &trace)
+		id := uuid.Must(uuid.NewV4())
+
+		recordPriceRow(appCtx, "re_task_order_fees", id, traceParam("price_cents", "8327"))
+		recordPriceRow(appCtx, "re_task_order_fees", id, traceParam("price_cents", "8327"))
+		recordPricingStep(appCtx, "escalated price in cents", 8660.08)
+
+		suite.Equal([]models.PricingTraceRow{
+			{Table: "re_task_order_fees", ID: id, Values: []models.PricingTraceParam{traceParam("price_cents", "8327")}},
+		}, trace.PriceRows)
+		suite.Equal([]models.PricingTraceParam{traceParam("escalated price in cents", "8660.08")}, trace.Steps)
+	})
+
+	suite.Run("keeps recording through transactions and derived app contexts", func() {
+		var trace models.PricingTrace
+		appCtx := newTracingAppContext(suite.AppContextForTest(), &trace)
+
+		err := appCtx.NewTransaction(func(txnAppCtx appcontext.AppContext) error {
+			recordPricingStep(txnAppCtx, "base price in cents", 100)
+			return nil
+		})
+		suite.NoError(err)
+		derivedAppCtx := appcontext.NewAppContext(appCtx.DB(), appCtx.Logger(), nil, nil)
+		recordPricingStep(derivedAppCtx, "escalated price in cents", 111)
+
+		suite.Equal([]models.PricingTraceParam{
+			traceParam("base price in cents", "100"),
+			traceParam("escalated price in cents", "111"),
+		}, trace.Steps)
+	})
+
+	suite.Run("records the diesel fuel price row the EIAFuelPrice lookup uses", func() {
+		fscPriceDifferenceInCents := (fscFuelPrice - baseGHCDieselFuelPrice).Float64() / 1000.0
+		fscMultiplier := fscWeightDistanceMultiplier * fscTestDistance.Float64()
+		// an earlier week published the same price, which only the pickup date tells apart
+		suite.setupPickupWeekDieselFuelPrice(fscFuelPrice, fscActualPickupDate.AddDate(0, 0, -14))
+		pickupWeekFuelPrice := suite.setupPickupWeekDieselFuelPrice(fscFuelPrice, fscActualPickupDate)
+
+		var trace models.PricingTrace
+		_, _, err := NewFuelSurchargePricer().Price(newTracingAppContext(suite.AppContextForTest(), &trace), fscActualPickupDate, fscTestDistance, fscTestWeight, fscWeightDistanceMultiplier, fscFuelPrice, false)
+		suite.NoError(err)
+
+		suite.assertFuelSurchargeTrace(trace, pickupWeekFuelPrice, fscPriceDifferenceInCents, fscMultiplier)
+	})
+
+	suite.Run("records no diesel fuel price row when the EIAFuelPrice param doesn't match it", func() {
+		suite.setupPickupWeekDieselFuelPrice(fscFuelPrice+1000, fscActualPickupDate)
+
+		var trace models.PricingTrace
+		_, _, err := NewFuelSurchargePricer().Price(newTracingAppContext(suite.AppContextForTest(), &trace), fscActualPickupDate, fscTestDistance, fscTestWeight, fscWeightDistanceMultiplier, fscFuelPrice, false)
+		suite.NoError(err)
+
+		suite.Empty(trace.PriceRows)
+		suite.Equal([]string{"fuel price difference in cents", "fuel surcharge multiplier"}, traceStepKeys(trace))
+	})
+
+	suite.Run("does nothing when the pricer is not traced", func() {
+		suite.NotPanics(func() {
+			recordPriceRow(suite.AppContextForTest(), "re_task_order_fees", uuid.Must(uuid.NewV4()))
+			recordPricingStep(suite.AppContextForTest(), "escalated price in cents", 1)
+		})
+	})
+}
+
+func (suite *GHCRateEngineServiceSuite) TestPricersRecordPricingTrace() {
+	testCases := []struct {
+		name string
+		// price sets up the pricing data and prices a service item with the tracing appCtx
+		price     func(appCtx appcontext.AppContext) error
+		priceRows []string
+		steps     []string
+	}{
+		{
+			name: "counseling services",
+			price: func(appCtx appcontext.AppContext) error {
+				paymentServiceItem := suite.setupCounselingServicesItem()
+				_, _, err := NewCounselingServicesPricer().PriceUsingParams(appCtx, paymentServiceItem.PaymentServiceItemParams)
+				return err
+			},
+			priceRows: nil,
+			steps:     []string{"locked price in cents"},
+		},
+		{
+			name: "domestic crating",
+			price: func(appCtx appcontext.AppContext) error {
+				suite.setupDomesticAccessorialPrice(models.ReServiceCodeDCRT, dcrtTestServiceSchedule, dcrtTestBasePriceCents, testdatagen.DefaultContractCode, dcrtTestEscalationCompounded)
+				paymentServiceItem := suite.setupDomesticCratingServiceItem(dcrtTestBilledCubicFeet)
+				_, _, err := NewDomesticCratingPricer().PriceUsingParams(appCtx, paymentServiceItem.PaymentServiceItemParams)
+				return err
+			},
+			priceRows: []string{"re_domestic_accessorial_prices", "re_contract_years"},
+			steps:     []string{"base price in cents", "escalated price in cents"},
+		},
+		{
+			name: "domestic destination additional days SIT",
+			price: func(appCtx appcontext.AppContext) error {
+				suite.setupDomesticServiceAreaPrice(models.ReServiceCodeDDASIT, ddasitTestServiceArea, ddasitTestIsPeakPeriod, ddasitTestBasePriceCents, ddasitTestContractYearName, ddasitTestEscalationCompounded)
+				paymentServiceItem := suite.setupDomesticDestinationAdditionalDaysSITServiceItem()
+				_, _, err := NewDomesticDestinationAdditionalDaysSITPricer().PriceUsingParams(appCtx, paymentServiceItem.PaymentServiceItemParams)
+				return err
+			},
+			priceRows: []string{"re_domestic_service_area_prices", "re_contract_years"},
+			steps:     []string{"base price in cents", "escalated price in cents"},
+		},
+		{
+			name: "domestic destination first day SIT",
+			price: func(appCtx appcontext.AppContext) error {
+				suite.setupDomesticServiceAreaPrice(models.ReServiceCodeDDFSIT, ddfsitTestServiceArea, ddfsitTestIsPeakPeriod, ddfsitTestBasePriceCents, ddfsitTestContractYearName, ddfsitTestEscalationCompounded)
+				paymentServiceItem := suite.setupDomesticDestinationFirstDaySITServiceItem()
+				_, _, err := NewDomesticDestinationFirstDaySITPricer().PriceUsingParams(appCtx, paymentServiceItem.PaymentServiceItemParams)
+				return err
+			},
+			priceRows: []string{"re_domestic_service_area_prices", "re_contract_years"},
+			steps:     []string{"base price in cents", "escalated price in cents"},
+		},
+		{
+			name: "domestic destination",
+			price: func(appCtx appcontext.AppContext) error {
+				suite.setUpDomesticDestinationData()
+				paymentServiceItem := suite.setupDomesticDestinationServiceItems()
+				_, _, err := NewDomesticDestinationPricer().PriceUsingParams(appCtx, paymentServiceItem.PaymentServiceItemParams)
+				return err
+			},
+			priceRows: []string{"re_domestic_service_area_prices", "re_contract_years"},
+			steps:     []string{"base price in cents", "escalated price in cents"},
+		},
+		{
+			name: "domestic destination shuttling",
+			price: func(appCtx appcontext.AppContext) error {
+				suite.setupDomesticAccessorialPrice(models.ReServiceCodeDDSHUT, ddshutTestServiceSchedule, ddshutTestBasePriceCents, testdatagen.DefaultContractCode, ddshutTestEscalationCompounded)
+				paymentServiceItem := suite.setupDomesticDestinationShuttlingServiceItem()
+				_, _, err := NewDomesticDestinationShuttlingPricer().PriceUsingParams(appCtx, paymentServiceItem.PaymentServiceItemParams)
+				return err
+			},
+			priceRows: []string{"re_domestic_accessorial_prices", "re_contract_years"},
+			steps:     []string{"base price in cents", "escalated price in cents"},
+		},
+		{
+			name: "domestic destination SIT delivery",
+			price: func(appCtx appcontext.AppContext) error {
+				suite.setupDomesticOtherPrice(models.ReServiceCodeDDDSIT, dddsitTestSchedule, dddsitTestIsPeakPeriod, dddsitTestDomesticOtherBasePriceCents, dddsitTestContractYearName, dddsitTestEscalationCompounded)
+				paymentServiceItem := suite.setupDomesticDestinationSITDeliveryServiceItem("30907", "30901", unit.Miles(37))
+				_, _, err := NewDomesticDestinationSITDeliveryPricer().PriceUsingParams(appCtx, paymentServiceItem.PaymentServiceItemParams)
+				return err
+			},
+			priceRows: []string{"re_domestic_other_prices", "re_contract_years"},
+			steps:     []string{"base price in cents", "escalated price in cents"},
+		},
+		{
+			name: "domestic destination SIT fuel surcharge",
+			price: func(appCtx appcontext.AppContext) error {
+				suite.setupPickupWeekDieselFuelPrice(ddsfscFuelPrice, ddsfscActualPickupDate)
+				_, _, err := NewDomesticDestinationSITFuelSurchargePricer().Price(appCtx, ddsfscActualPickupDate, ddsfscTestDistance, ddsfscTestWeight, ddsfscWeightDistanceMultiplier, ddsfscFuelPrice, false)
+				return err
+			},
+			priceRows: []string{"ghc_diesel_fuel_prices"},
+			steps:     []string{"fuel price difference in cents", "fuel surcharge multiplier"},
+		},
+		{
+			name: "domestic linehaul",
+			price: func(appCtx appcontext.AppContext) error {
+				suite.setupDomesticLinehaulPrice(dlhTestServiceArea, dlhTestContractYearName, dlhTestEscalationCompounded)
+				_, _, err := NewDomesticLinehaulPricer().Price(appCtx, testdatagen.DefaultContractCode, dlhRequestedPickupDate, dlhTestDistance, dlhTestWeight, dlhTestServiceArea, false)
+				return err
+			},
+			priceRows: []string{"re_domestic_linehaul_prices", "re_contract_years"},
+			steps:     []string{"base price in cents", "escalated price in cents"},
+		},
+		{
+			name: "domestic NTS pack",
+			price: func(appCtx appcontext.AppContext) error {
+				paymentServiceItem := suite.setupDomesticNTSPackServiceItem()
+				_, _, err := NewDomesticNTSPackPricer().PriceUsingParams(appCtx, paymentServiceItem.PaymentServiceItemParams)
+				return err
+			},
+			priceRows: []string{"re_domestic_other_prices", "re_shipment_type_prices", "re_contract_years"},
+			steps:     []string{"base price in cents", "escalated price in cents"},
+		},
+		{
+			name: "domestic origin additional days SIT",
+			price: func(appCtx appcontext.AppContext) error {
+				suite.setupDomesticServiceAreaPrice(models.ReServiceCodeDOASIT, doasitTestServiceArea, doasitTestIsPeakPeriod, doasitTestBasePriceCents, doasitTestContractYearName, doasitTestEscalationCompounded)
+				paymentServiceItem := suite.setupDomesticOriginAdditionalDaysSITServiceItem()
+				_, _, err := NewDomesticOriginAdditionalDaysSITPricer().PriceUsingParams(appCtx, paymentServiceItem.PaymentServiceItemParams)
+				return err
+			},
+			priceRows: []string{"re_domestic_service_area_prices", "re_contract_years"},
+			steps:     []string{"base price in cents", "escalated price in cents"},
+		},
+		{
+			name: "domestic origin first day SIT",
+			price: func(appCtx appcontext.AppContext) error {
+				suite.setupDomesticServiceAreaPrice(models.ReServiceCodeDOFSIT, dofsitTestServiceArea, dofsitTestIsPeakPeriod, dofsitTestBasePriceCents, dofsitTestContractYearName, dofsitTestEscalationCompounded)
+				paymentServiceItem := suite.setupDomesticOriginFirstDaySITServiceItem()
+				_, _, err := NewDomesticOriginFirstDaySITPricer().PriceUsingParams(appCtx, paymentServiceItem.PaymentServiceItemParams)
+				return err
+			},
+			priceRows: []string{"re_domestic_service_area_prices", "re_contract_years"},
+			steps:     []string{"base price in cents", "escalated price in cents"},
+		},
+		{
+			name: "domestic origin",
+			price: func(appCtx appcontext.AppContext) error {
+				suite.setUpDomesticOriginData()
+				paymentServiceItem := suite.setupDomesticOriginServiceItems()
+				_, _, err := NewDomesticOriginPricer().PriceUsingParams(appCtx, paymentServiceItem.PaymentServiceItemParams)
+				return err
+			},
+			priceRows: []string{"re_domestic_service_area_prices", "re_contract_years"},
+			steps:     []string{"base price in cents", "escalated price in cents"},
+		},
+		{
+			name: "domestic origin shuttling",
+			price: func(appCtx appcontext.AppContext) error {
+				suite.setupDomesticAccessorialPrice(models.ReServiceCodeDOSHUT, doshutTestServiceSchedule, doshutTestBasePriceCents, testdatagen.DefaultContractCode, doshutTestEscalationCompounded)
+				paymentServiceItem := suite.setupDomesticOriginShuttlingServiceItem()
+				_, _, err := NewDomesticOriginShuttlingPricer().PriceUsingParams(appCtx, paymentServiceItem.PaymentServiceItemParams)
+				return err
+			},
+			priceRows: []string{"re_domestic_accessorial_prices", "re_contract_years"},
+			steps:     []string{"base price in cents", "escalated price in cents"},
+		},
+		{
+			name: "domestic origin SIT fuel surcharge",
+			price: func(appCtx appcontext.AppContext) error {
+				suite.setupPickupWeekDieselFuelPrice(dosfscFuelPrice, dosfscActualPickupDate)
+				_, _, err := NewDomesticOriginSITFuelSurchargePricer().Price(appCtx, dosfscActualPickupDate, dosfscTestDistance, dosfscTestWeight, dosfscWeightDistanceMultiplier, dosfscFuelPrice, false)
+				return err
+			},
+			priceRows: []string{"ghc_diesel_fuel_prices"},
+			steps:     []string{"fuel price difference in cents", "fuel surcharge multiplier"},
+		},
+		{
+			name: "domestic origin SIT pickup",
+			price: func(appCtx appcontext.AppContext) error {
+				suite.setupDomesticOtherPrice(models.ReServiceCodeDOPSIT, dopsitTestSchedule, dopsitTestIsPeakPeriod, dopsitTestDomesticServiceAreaBasePriceCents, dopsitTestContractYearName, dopsitTestEscalationCompounded)
+				paymentServiceItem := suite.setupDomesticOriginSITPickupServiceItem("29201", "29212", unit.Miles(12))
+				_, _, err := NewDomesticOriginSITPickupPricer().PriceUsingParams(appCtx, paymentServiceItem.PaymentServiceItemParams)
+				return err
+			},
+			priceRows: []string{"re_domestic_other_prices", "re_contract_years"},
+			steps:     []string{"base price in cents", "escalated price in cents"},
+		},
+		{
+			name: "domestic pack",
+			price: func(appCtx appcontext.AppContext) error {
+				suite.setupDomesticOtherPrice(models.ReServiceCodeDPK, dpkTestServicesScheduleOrigin, dpkTestIsPeakPeriod, dpkTestBasePriceCents, dpkTestContractYearName, dpkTestEscalationCompounded)
+				paymentServiceItem := suite.setupDomesticPackServiceItem()
+				_, _, err := NewDomesticPackPricer().PriceUsingParams(appCtx, paymentServiceItem.PaymentServiceItemParams)
+				return err
+			},
+			priceRows: []string{"re_domestic_other_prices", "re_contract_years"},
+			steps:     []string{"base price in cents", "escalated price in cents"},
+		},
+		{
+			name: "domestic shorthaul",
+			price: func(appCtx appcontext.AppContext) error {
+				suite.setUpDomesticShorthaulData()
+				paymentServiceItem := suite.setupDomesticShorthaulServiceItems(time.Date(testdatagen.TestYear, peakStart.month, peakStart.day, 0, 0, 0, 0, time.UTC).Format(DateParamFormat))
+				_, _, err := NewDomesticShorthaulPricer().PriceUsingParams(appCtx, paymentServiceItem.PaymentServiceItemParams)
+				return err
+			},
+			priceRows: []string{"re_domestic_service_area_prices", "re_contract_years"},
+			steps:     []string{"base price in cents", "escalated price in cents"},
+		},
+		{
+			name: "domestic uncrating",
+			price: func(appCtx appcontext.AppContext) error {
+				suite.setupDomesticAccessorialPrice(models.ReServiceCodeDUCRT, ducrtTestServiceSchedule, ducrtTestBasePriceCents, testdatagen.DefaultContractCode, ducrtTestEscalationCompounded)
+				paymentServiceItem := suite.setupDomesticUncratingServiceItem()
+				_, _, err := NewDomesticUncratingPricer().PriceUsingParams(appCtx, paymentServiceItem.PaymentServiceItemParams)
+				return err
+			},
+			priceRows: []string{"re_domestic_accessorial_prices", "re_contract_years"},
+			steps:     []string{"base price in cents", "escalated price in cents"},
+		},
+		{
+			name: "domestic unpack",
+			price: func(appCtx appcontext.AppContext) error {
+				suite.setupDomesticOtherPrice(models.ReServiceCodeDUPK, dupkTestServicesScheduleDest, dupkTestIsPeakPeriod, dupkTestBasePriceCents, dupkTestContractYearName, dupkTestEscalationCompounded)
+				paymentServiceItem := suite.setupDomesticUnpackServiceItem()
+				_, _, err := NewDomesticUnpackPricer().PriceUsingParams(appCtx, paymentServiceItem.PaymentServiceItemParams)
+				return err
+			},
+			priceRows: []string{"re_domestic_other_prices", "re_contract_years"},
+			steps:     []string{"base price in cents", "escalated price in cents"},
+		},
+		{
+			name: "fuel surcharge",
+			price: func(appCtx appcontext.AppContext) error {
+				suite.setupPickupWeekDieselFuelPrice(fscFuelPrice, fscActualPickupDate)
+				_, _, err := NewFuelSurchargePricer().Price(appCtx, fscActualPickupDate, fscTestDistance, fscTestWeight, fscWeightDistanceMultiplier, fscFuelPrice, false)
+				return err
+			},
+			priceRows: []string{"ghc_diesel_fuel_prices"},
+			steps:     []string{"fuel price difference in cents", "fuel surcharge multiplier"},
+		},
+		{
+			name: "international destination shuttling",
+			price: func(appCtx appcontext.AppContext) error {
+				suite.setupInternationalAccessorialPrice(models.ReServiceCodeIDSHUT, ioshutTestMarket, idshutTestBasePriceCents, testdatagen.DefaultContractCode, idshutTestEscalationCompounded)
+				paymentServiceItem := suite.setupInternationalDestinationShuttlingServiceItem()
+				_, _, err := NewInternationalDestinationShuttlingPricer().PriceUsingParams(appCtx, paymentServiceItem.PaymentServiceItemParams)
+				return err
+			},
+			priceRows: []string{"re_intl_accessorial_prices", "re_contract_years"},
+			steps:     []string{"weight in CWT"},
+		},
+		{
+			name: "international destination SIT fuel surcharge",
+			price: func(appCtx appcontext.AppContext) error {
+				suite.setupPickupWeekDieselFuelPrice(idsfscFuelPrice, idsfscActualPickupDate)
+				_, _, err := NewInternationalDestinationSITFuelSurchargePricer().Price(appCtx, idsfscActualPickupDate, idsfscTestDistance, idsfscTestWeight, idsfscWeightDistanceMultiplier, idsfscFuelPrice)
+				return err
+			},
+			priceRows: []string{"ghc_diesel_fuel_prices"},
+			steps:     []string{"fuel price difference in cents", "fuel surcharge multiplier"},
+		},
+		{
+			name: "international origin shuttling",
+			price: func(appCtx appcontext.AppContext) error {
+				suite.setupInternationalAccessorialPrice(models.ReServiceCodeIOSHUT, ioshutTestMarket, ioshutTestBasePriceCents, testdatagen.DefaultContractCode, ioshutTestEscalationCompounded)
+				paymentServiceItem := suite.setupInternationalOriginShuttlingServiceItem()
+				_, _, err := NewInternationalOriginShuttlingPricer().PriceUsingParams(appCtx, paymentServiceItem.PaymentServiceItemParams)
+				return err
+			},
+			priceRows: []string{"re_intl_accessorial_prices", "re_contract_years"},
+			steps:     []string{"weight in CWT"},
+		},
+		{
+			name: "international origin SIT fuel surcharge",
+			price: func(appCtx appcontext.AppContext) error {
+				suite.setupPickupWeekDieselFuelPrice(iosfscFuelPrice, iosfscActualPickupDate)
+				_, _, err := NewInternationalOriginSITFuelSurchargePricer().Price(appCtx, iosfscActualPickupDate, iosfscTestDistance, iosfscTestWeight, iosfscWeightDistanceMultiplier, iosfscFuelPrice)
+				return err
+			},
+			priceRows: []string{"ghc_diesel_fuel_prices"},
+			steps:     []string{"fuel price difference in cents", "fuel surcharge multiplier"},
+		},
+		{
+			name: "intl crating",
+			price: func(appCtx appcontext.AppContext) error {
+				suite.setupInternationalAccessorialPrice(models.ReServiceCodeICRT, icrtTestMarket, icrtTestBasePriceCents, testdatagen.DefaultContractCode, icrtTestEscalationCompounded)
+				paymentServiceItem := suite.setupIntlCratingServiceItem(icrtTestBilledCubicFeet)
+				_, _, err := NewIntlCratingPricer().PriceUsingParams(appCtx, paymentServiceItem.PaymentServiceItemParams)
+				return err
+			},
+			priceRows: []string{"re_intl_accessorial_prices", "re_contract_years"},
+			steps:     []string{"billed cubic feet"},
+		},
+		{
+			name: "intl destination additional days SIT",
+			price: func(appCtx appcontext.AppContext) error {
+				paymentServiceItem := suite.setupIntlDestinationAdditionalDayServiceItem()
+				_, _, err := NewIntlDestinationAdditionalDaySITPricer().PriceUsingParams(appCtx, paymentServiceItem.PaymentServiceItemParams)
+				return err
+			},
+			priceRows: []string{"re_contracts", "re_contract_years"},
+			steps:     []string{"weight in CWT"},
+		},
+		{
+			name: "intl destination delivery SIT",
+			price: func(appCtx appcontext.AppContext) error {
+				cy := testdatagen.MakeReContractYear(suite.DB(), testdatagen.Assertions{
+					ReContractYear: models.ReContractYear{
+						StartDate:            time.Date(2019, time.October, 1, 0, 0, 0, 0, time.UTC),
+						EndDate:              time.Date(2020, time.September, 30, 0, 0, 0, 0, time.UTC),
+						EscalationCompounded: iddsitTestEscalationCompounded,
+					},
+				})
+				_, _, err := NewInternationalDestinationSITDeliveryPricer().Price(appCtx, cy.Contract.Code, cy.StartDate.AddDate(0, 0, 1), iddsitTestWeight, int(iddsitTestPerUnitCents), int(iddsitTestDistanceLessThan50Miles))
+				return err
+			},
+			priceRows: []string{"re_contracts", "re_contract_years"},
+			steps:     []string{"distance in miles", "weight in CWT"},
+		},
+		{
+			name: "intl destination first day SIT",
+			price: func(appCtx appcontext.AppContext) error {
+				paymentServiceItem := suite.setupIntlDestinationFirstDayServiceItem()
+				_, _, err := NewIntlDestinationFirstDaySITPricer().PriceUsingParams(appCtx, paymentServiceItem.PaymentServiceItemParams)
+				return err
+			},
+			priceRows: []string{"re_contracts", "re_contract_years"},
+			steps:     []string{"weight in CWT"},
+		},
+		{
+			name: "intl HHG pack",
+			price: func(appCtx appcontext.AppContext) error {
+				paymentServiceItem, _ := suite.setupIntlPackServiceItem(models.ReServiceCodeIHPK)
+				_, _, err := NewIntlHHGPackPricer().PriceUsingParams(appCtx, paymentServiceItem.PaymentServiceItemParams)
+				return err
+			},
+			priceRows: []string{"re_contracts", "re_contract_years"},
+			steps:     []string{"weight in CWT"},
+		},
+		{
+			name: "intl HHG unpack",
+			price: func(appCtx appcontext.AppContext) error {
+				paymentServiceItem := suite.setupIntlUnpackServiceItem()
+				_, _, err := NewIntlHHGUnpackPricer().PriceUsingParams(appCtx, paymentServiceItem.PaymentServiceItemParams)
+				return err
+			},
+			priceRows: []string{"re_contracts", "re_contract_years"},
+			steps:     []string{"weight in CWT"},
+		},
+		{
+			name: "intl NTS pack",
+			price: func(appCtx appcontext.AppContext) error {
+				paymentServiceItem, _ := suite.setupIntlPackServiceItem(models.ReServiceCodeIHPK)
+				_, _, err := NewIntlNTSHHGPackPricer(NewIntlHHGPackPricer()).PriceUsingParams(appCtx, paymentServiceItem.PaymentServiceItemParams)
+				return err
+			},
+			priceRows: []string{"re_contracts", "re_contract_years"},
+			steps:     []string{"NTS packing factor", "weight in CWT"},
+		},
+		{
+			name: "intl origin additional days SIT",
+			price: func(appCtx appcontext.AppContext) error {
+				paymentServiceItem := suite.setupIntlOriginAdditionalDayServiceItem()
+				_, _, err := NewIntlOriginAdditionalDaySITPricer().PriceUsingParams(appCtx, paymentServiceItem.PaymentServiceItemParams)
+				return err
+			},
+			priceRows: []string{"re_contracts", "re_contract_years"},
+			steps:     []string{"weight in CWT"},
+		},
+		{
+			name: "intl origin first day SIT",
+			price: func(appCtx appcontext.AppContext) error {
+				paymentServiceItem := suite.setupIntlOriginFirstDayServiceItem()
+				_, _, err := NewIntlOriginFirstDaySITPricer().PriceUsingParams(appCtx, paymentServiceItem.PaymentServiceItemParams)
+				return err
+			},
+			priceRows: []string{"re_contracts", "re_contract_years"},
+			steps:     []string{"weight in CWT"},
+		},
+		{
+			name: "intl origin pickup SIT",
+			price: func(appCtx appcontext.AppContext) error {
+				cy := testdatagen.MakeReContractYear(suite.DB(), testdatagen.Assertions{
+					ReContractYear: models.ReContractYear{
+						StartDate:            time.Date(2019, time.October, 1, 0, 0, 0, 0, time.UTC),
+						EndDate:              time.Date(2020, time.September, 30, 0, 0, 0, 0, time.UTC),
+						EscalationCompounded: iopsitTestEscalationCompounded,
+					},
+				})
+				_, _, err := NewInternationalOriginSITPickupPricer().Price(appCtx, cy.Contract.Code, cy.StartDate.AddDate(0, 0, 1), iopsitTestWeight, int(iopsitTestPerUnitCents), int(iopsitTestDistanceLessThan50Miles))
+				return err
+			},
+			priceRows: []string{"re_contracts", "re_contract_years"},
+			steps:     []string{"distance in miles", "weight in CWT"},
+		},
+		{
+			name: "intl port fuel surcharge",
+			price: func(appCtx appcontext.AppContext) error {
+				suite.setupPickupWeekDieselFuelPrice(intlPortFscFuelPrice, intlPortFscActualPickupDate)
+				_, _, err := NewPortFuelSurchargePricer().Price(appCtx, intlPortFscActualPickupDate, intlPortFscTestDistance, intlPortFscTestWeight, intlPortFscWeightDistanceMultiplier, intlPortFscFuelPrice, hhgShipmentType)
+				return err
+			},
+			priceRows: []string{"ghc_diesel_fuel_prices"},
+			steps:     []string{"fuel price difference in cents", "fuel surcharge multiplier"},
+		},
+		{
+			name: "intl shipping and linehaul",
+			price: func(appCtx appcontext.AppContext) error {
+				paymentServiceItem := suite.setupIntlShippingAndLinehaulServiceItem()
+				_, _, err := NewIntlShippingAndLinehaulPricer().PriceUsingParams(appCtx, paymentServiceItem.PaymentServiceItemParams)
+				return err
+			},
+			priceRows: []string{"re_contracts", "re_contract_years"},
+			steps:     []string{"base price in cents", "escalated price in cents", "weight in CWT"},
+		},
+		{
+			name: "intl UB pack",
+			price: func(appCtx appcontext.AppContext) error {
+				paymentServiceItem := suite.setupIntlUBPackServiceItem()
+				_, _, err := NewIntlUBPackPricer().PriceUsingParams(appCtx, paymentServiceItem.PaymentServiceItemParams)
+				return err
+			},
+			priceRows: []string{"re_contracts", "re_contract_years"},
+			steps:     []string{"weight in CWT"},
+		},
+		{
+			name: "intl UB",
+			price: func(appCtx appcontext.AppContext) error {
+				paymentServiceItem := suite.setupIntlUBPricerServiceItem()
+				_, _, err := NewIntlUBPricer().PriceUsingParams(appCtx, paymentServiceItem.PaymentServiceItemParams)
+				return err
+			},
+			priceRows: []string{"re_contracts", "re_contract_years"},
+			steps:     []string{"base price in cents", "escalated price in cents", "weight in CWT"},
+		},
+		{
+			name: "intl UB unpack",
+			price: func(appCtx appcontext.AppContext) error {
+				paymentServiceItem := suite.setupIntlUBUnpackServiceItem()
+				_, _, err := NewIntlUBUnpackPricer().PriceUsingParams(appCtx, paymentServiceItem.PaymentServiceItemParams)
+				return err
+			},
+			priceRows: []string{"re_contracts", "re_contract_years"},
+			steps:     []string{"weight in CWT"},
+		},
+		{
+			name: "intl uncrating",
+			price: func(appCtx appcontext.AppContext) error {
+				suite.setupInternationalAccessorialPrice(models.ReServiceCodeIUCRT, iucrtTestMarket, iucrtTestBasePriceCents, testdatagen.DefaultContractCode, iucrtTestEscalationCompounded)
+				paymentServiceItem := suite.setupIntlUncratingServiceItem()
+				_, _, err := NewIntlUncratingPricer().PriceUsingParams(appCtx, paymentServiceItem.PaymentServiceItemParams)
+				return err
+			},
+			priceRows: []string{"re_intl_accessorial_prices", "re_contract_years"},
+			steps:     []string{"billed cubic feet"},
+		},
+		{
+			name: "management services",
+			price: func(appCtx appcontext.AppContext) error {
+				suite.setupTaskOrderFeeData(models.ReServiceCodeMS, msPriceCents)
+				paymentServiceItem := suite.setupManagementServicesItem()
+				_, _, err := NewManagementServicesPricer().PriceUsingParams(appCtx, paymentServiceItem.PaymentServiceItemParams)
+				return err
+			},
+			priceRows: nil,
+			steps:     []string{"locked price in cents"},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			var trace models.PricingTrace
+			suite.NoError(tc.price(newTracingAppContext(suite.AppContextForTest(), &trace)))
+
+			suite.Subset(priceRowTables(trace), tc.priceRows)
+			suite.Subset(traceStepKeys(trace), tc.steps)
+		})
+	}
+}
+
+// priceRowTables returns the tables of the price rows recorded in the trace
+func priceRowTables(trace models.PricingTrace) []string {
+	var tables []string
+	for _, row := range trace.PriceRows {
+		tables = append(tables, row.Table)
+	}
+	return tables
+}
+
+// traceStepKeys returns the descriptions of the calculation steps recorded in the trace
+func traceStepKeys(trace models.PricingTrace) []string {
+	var keys []string
+	for _, step := range trace.Steps {
+		keys = append(keys, step.Key)
+	}
+	return keys
+}
+
+func traceFloatParam(key string, value float64) models.PricingTraceParam {
+	return traceParam(key, strconv.FormatFloat(value, 'f', -1, 64))
+}
+
+// setupPickupWeekDieselFuelPrice stores the diesel fuel price published for the week of the pickup date, which is the
+// row the EIAFuelPrice lookup prices the fuel surcharge from
+func (suite *GHCRateEngineServiceSuite) setupPickupWeekDieselFuelPrice(fuelPrice unit.Millicents, actualPickupDate time.Time) models.GHCDieselFuelPrice {
+	effectiveDate := time.Date(actualPickupDate.Year(), actualPickupDate.Month(), actualPickupDate.Day(), 0, 0, 0, 0, time.UTC)
+	return testdatagen.MakeGHCDieselFuelPrice(suite.DB(), testdatagen.Assertions{
+		GHCDieselFuelPrice: models.GHCDieselFuelPrice{
+			FuelPriceInMillicents: fuelPrice,
+			PublicationDate:       effectiveDate.AddDate(0, 0, -1),
+			EffectiveDate:         effectiveDate,
+			EndDate:               effectiveDate.AddDate(0, 0, 6),
+		},
+	})
+}
+
+// assertFuelSurchargeTrace checks a fuel surcharge pricer traced the diesel fuel price row and its calculation
+func (suite *GHCRateEngineServiceSuite) assertFuelSurchargeTrace(trace models.PricingTrace, fuelPrice models.GHCDieselFuelPrice, fscPriceDifferenceInCents float64, fscMultiplier float64) {
+	suite.Len(trace.PriceRows, 1)
+	suite.Equal("ghc_diesel_fuel_prices", trace.PriceRows[0].Table)
+	suite.Equal(fuelPrice.ID, trace.PriceRows[0].ID)
+	suite.Contains(trace.PriceRows[0].Values, traceParam("fuel_price_in_millicents", strconv.Itoa(fuelPrice.FuelPriceInMillicents.Int())))
+	suite.Equal([]models.PricingTraceParam{
+		traceFloatParam("fuel price difference in cents", fscPriceDifferenceInCents),
+		traceFloatParam("fuel surcharge multiplier", fscMultiplier),
+	}, trace.Steps)
+}
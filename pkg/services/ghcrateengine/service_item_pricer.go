@@ -3,6 +3,8 @@ package ghcrateengine
 import (
 	"fmt"
 
+	"github.com/gofrs/uuid"
+
 	"github.com/transcom/mymove/pkg/appcontext"
 	"github.com/transcom/mymove/pkg/apperror"
 	"github.com/transcom/mymove/pkg/models"
@@ -26,9 +28,15 @@ func (p serviceItemPricer) PriceServiceItem(appCtx appcontext.AppContext, item m
 		return unit.Cents(0), nil, err
 	}
 
+	// the pricer is traced so the price rows and calculation steps it used are kept with the price
+	trace := models.PricingTrace{ServiceCode: item.MTOServiceItem.ReService.Code}
+	for _, param := range item.PaymentServiceItemParams {
+		trace.Inputs = append(trace.Inputs, traceParam(param.ServiceItemParamKey.Key.String(), param.Value))
+	}
+
 	// pricingParams are rate engine params that were queried from the pricing tables such as
 	// price, rate, escalation etc.
-	priceCents, pricingParams, err := pricer.PriceUsingParams(appCtx, item.PaymentServiceItemParams)
+	priceCents, pricingParams, err := pricer.PriceUsingParams(newTracingAppContext(appCtx, &trace), item.PaymentServiceItemParams)
 	if err != nil {
 		return unit.Cents(0), nil, err
 	}
@@ -39,10 +47,35 @@ func (p serviceItemPricer) PriceServiceItem(appCtx appcontext.AppContext, item m
 	var displayParams models.PaymentServiceItemParams
 	if len(pricingParams) > 0 {
 		displayParams, err = createPricerGeneratedParams(appCtx, item.ID, pricingParams)
+		if err != nil {
+			return priceCents, displayParams, err
+		}
 	}
+
+	trace.PriceCents = priceCents
+	for _, param := range pricingParams {
+		trace.Results = append(trace.Results, traceParam(param.Key.String(), param.Value))
+	}
+	err = createPricingTrace(appCtx, item.ID, trace)
 	return priceCents, displayParams, err
 }
 
+// createPricingTrace stores the calculation trace of a payment service item's price
+func createPricingTrace(appCtx appcontext.AppContext, paymentServiceItemID uuid.UUID, trace models.PricingTrace) error {
+	pricingTrace := models.PaymentServiceItemPricingTrace{
+		PaymentServiceItemID: paymentServiceItemID,
+		Trace:                trace,
+	}
+	verrs, err := appCtx.DB().ValidateAndCreate(&pricingTrace)
+	if verrs.HasAny() {
+		return apperror.NewInvalidCreateInputError(verrs, "validation error with creating payment service item pricing trace")
+	}
+	if err != nil {
+		return fmt.Errorf("failure creating payment service item pricing trace: %w", err)
+	}
+	return nil
+}
+
 func (p serviceItemPricer) getPricer(serviceCode models.ReServiceCode) (services.ParamsPricer, error) {
 	return PricerForServiceItem(serviceCode)
 }
@@ -22,6 +22,16 @@ func (suite *GHCRateEngineServiceSuite) TestPriceServiceItem() {
 		priceCents, _, err := serviceItemPricer.PriceServiceItem(suite.AppContextForTest(), paymentServiceItem)
 		suite.NoError(err)
 		suite.Equal(msPriceCents, priceCents)
+
+		var pricingTrace models.PaymentServiceItemPricingTrace
+		err = suite.DB().Where("payment_service_item_id = ?", paymentServiceItem.ID).First(&pricingTrace)
+		suite.NoError(err)
+		suite.Equal(models.ReServiceCodeMS, pricingTrace.Trace.ServiceCode)
+		suite.Equal(msPriceCents, pricingTrace.Trace.PriceCents)
+		suite.Contains(pricingTrace.Trace.Inputs, traceParam(models.ServiceItemParamNameLockedPriceCents.String(), msPriceCents.String()))
+		suite.Equal([]models.PricingTraceParam{
+			traceParam(models.ServiceItemParamNamePriceRateOrFactor.String(), FormatCents(msPriceCents)),
+		}, pricingTrace.Trace.Results)
 	})
 
 	suite.Run("not implemented pricer", func() {
@@ -0,0 +1,42 @@
+package services
+
+import (
+	"github.com/transcom/mymove/pkg/appcontext"
+)
+
+// IdentityMismatch names a profile field that doesn't match the DEERS record
+type IdentityMismatch string
+
+const (
+	// IdentityMismatchFirstName means the first name doesn't match DEERS
+	IdentityMismatchFirstName IdentityMismatch = "first_name"
+	// IdentityMismatchLastName means the last name doesn't match DEERS
+	IdentityMismatchLastName IdentityMismatch = "last_name"
+)
+
+// IdentityVerificationParams is the profile data a service member entered
+type IdentityVerificationParams struct {
+	Edipi     string
+	FirstName string
+	LastName  string
+}
+
+// IdentityVerification compares the profile data a service member entered with their DEERS record. It
+// holds none of the DEERS record itself, since the EDIPI it was looked up with is one the customer entered.
+type IdentityVerification struct {
+	Edipi      string
+	EdipiFound bool
+	Mismatches []IdentityMismatch
+}
+
+// Verified is true when DEERS has a record for the EDIPI and the names match it
+func (v IdentityVerification) Verified() bool {
+	return v.EdipiFound && len(v.Mismatches) == 0
+}
+
+// IdentityVerifier looks up a service member in DEERS to verify their profile
+//
+//go:generate mockery --name IdentityVerifier
+type IdentityVerifier interface {
+	VerifyIdentity(appCtx appcontext.AppContext, params IdentityVerificationParams) (*IdentityVerification, error)
+}
@@ -0,0 +1,23 @@
+package identityverification
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/transcom/mymove/pkg/testingsuite"
+)
+
+type IdentityVerificationServiceSuite struct {
+	*testingsuite.PopTestSuite
+}
+
+func TestIdentityVerificationServiceSuite(t *testing.T) {
+
+	ts := &IdentityVerificationServiceSuite{
+		PopTestSuite: testingsuite.NewPopTestSuite(testingsuite.CurrentPackage(),
+			testingsuite.WithPerTestTransaction()),
+	}
+	suite.Run(t, ts)
+	ts.PopTestSuite.TearDown()
+}
@@ -0,0 +1,90 @@
+package identityverification
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/gobuffalo/validate/v3"
+	"github.com/gobuffalo/validate/v3/validators"
+	"github.com/gofrs/uuid"
+	"go.uber.org/zap"
+
+	"github.com/transcom/mymove/pkg/appcontext"
+	"github.com/transcom/mymove/pkg/apperror"
+	"github.com/transcom/mymove/pkg/iws"
+	"github.com/transcom/mymove/pkg/services"
+)
+
+type identityVerifier struct {
+	personLookup iws.PersonLookup
+}
+
+// NewIdentityVerifier creates a new identityVerifier service that looks service members up with the person lookup
+func NewIdentityVerifier(personLookup iws.PersonLookup) services.IdentityVerifier {
+	return &identityVerifier{
+		personLookup: personLookup,
+	}
+}
+
+// VerifyIdentity looks the EDIPI up in DEERS and compares the names on record with the ones entered. Names
+// are compared ignoring case, spaces and punctuation, since DEERS records them in upper case.
+func (v *identityVerifier) VerifyIdentity(appCtx appcontext.AppContext, params services.IdentityVerificationParams) (*services.IdentityVerification, error) {
+	// the testing person lookup finds the same fake person for every EDIPI, so it can't verify anyone
+	if isTestingPersonLookup(v.personLookup) {
+		return nil, apperror.NewNotImplementedError("identity verification needs IWS RBS or its stand-in, not the testing person lookup")
+	}
+
+	verrs := validate.Validate(
+		&validators.RegexMatch{Field: params.Edipi, Name: "Edipi", Expr: `^\d{10}$`, Message: "Edipi must be 10 digits"},
+	)
+	if verrs.HasAny() {
+		return nil, apperror.NewInvalidInputError(uuid.Nil, nil, verrs, "invalid identity verification parameters")
+	}
+
+	edipi, err := strconv.ParseUint(params.Edipi, 10, 64)
+	if err != nil {
+		return nil, apperror.NewInvalidInputError(uuid.Nil, err, nil, "invalid EDIPI")
+	}
+
+	person, _, err := v.personLookup.GetPersonUsingEDIPI(edipi)
+	if err != nil {
+		appCtx.Logger().Error("error looking up EDIPI in DEERS", zap.Error(err))
+		return nil, fmt.Errorf("unable to look up EDIPI in DEERS: %w", err)
+	}
+
+	verification := services.IdentityVerification{Edipi: params.Edipi}
+	if person == nil {
+		return &verification, nil
+	}
+
+	verification.EdipiFound = true
+
+	if params.FirstName != "" && normalizeName(params.FirstName) != normalizeName(person.FirstName) {
+		verification.Mismatches = append(verification.Mismatches, services.IdentityMismatchFirstName)
+	}
+	if params.LastName != "" && normalizeName(params.LastName) != normalizeName(person.LastName) {
+		verification.Mismatches = append(verification.Mismatches, services.IdentityMismatchLastName)
+	}
+
+	return &verification, nil
+}
+
+// normalizeName keeps only the letters and digits of a name, in upper case
+func normalizeName(name string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return unicode.ToUpper(r)
+		}
+		return -1
+	}, name)
+}
+
+func isTestingPersonLookup(personLookup iws.PersonLookup) bool {
+	switch personLookup.(type) {
+	case iws.TestingPersonLookup, *iws.TestingPersonLookup:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,100 @@
+package identityverification
+
+import (
+	"errors"
+
+	"github.com/transcom/mymove/pkg/apperror"
+	"github.com/transcom/mymove/pkg/iws"
+	"github.com/transcom/mymove/pkg/services"
+)
+
+// stubPersonLookup answers EDIPI lookups with a fixed person, or no one when the person is nil
+type stubPersonLookup struct {
+	iws.TestingPersonLookup
+	person    *iws.Person
+	personnel []iws.Personnel
+	err       error
+}
+
+func (s stubPersonLookup) GetPersonUsingEDIPI(_ uint64) (*iws.Person, []iws.Personnel, error) {
+	return s.person, s.personnel, s.err
+}
+
+func (suite *IdentityVerificationServiceSuite) TestVerifyIdentity() {
+	deersPerson := &iws.Person{
+		ID:         "666104732",
+		TypeCode:   iws.PersonTypeCodeSSN,
+		FirstName:  "RILEY",
+		MiddleName: "JORDAN",
+		LastName:   "O'KAFOR",
+		CdncyName:  "JR",
+	}
+	deersPersonnel := []iws.Personnel{
+		{PnlCatCd: iws.PersonnelCategoryCodeActiveDuty, SvcCd: iws.ServiceCodeNotApplicable},
+		{PnlCatCd: iws.PersonnelCategoryCodeActiveDuty, SvcCd: iws.ServiceCodeArmy},
+	}
+	verifier := NewIdentityVerifier(stubPersonLookup{person: deersPerson, personnel: deersPersonnel})
+
+	suite.Run("matching names are verified", func() {
+		verification, err := verifier.VerifyIdentity(suite.AppContextForTest(), services.IdentityVerificationParams{
+			Edipi:     "3850947261",
+			FirstName: "Riley",
+			LastName:  "Okafor",
+		})
+		suite.NoError(err)
+		suite.True(verification.EdipiFound)
+		suite.True(verification.Verified())
+		suite.Empty(verification.Mismatches)
+	})
+
+	suite.Run("names that don't match are reported", func() {
+		verification, err := verifier.VerifyIdentity(suite.AppContextForTest(), services.IdentityVerificationParams{
+			Edipi:     "3850947261",
+			FirstName: "Rylee",
+			LastName:  "Smith",
+		})
+		suite.NoError(err)
+		suite.True(verification.EdipiFound)
+		suite.False(verification.Verified())
+		suite.Equal([]services.IdentityMismatch{services.IdentityMismatchFirstName, services.IdentityMismatchLastName}, verification.Mismatches)
+	})
+
+	suite.Run("names that weren't entered aren't compared", func() {
+		verification, err := verifier.VerifyIdentity(suite.AppContextForTest(), services.IdentityVerificationParams{Edipi: "3850947261"})
+		suite.NoError(err)
+		suite.True(verification.Verified())
+	})
+
+	suite.Run("an EDIPI DEERS doesn't know is not verified", func() {
+		verification, err := NewIdentityVerifier(stubPersonLookup{}).VerifyIdentity(suite.AppContextForTest(), services.IdentityVerificationParams{
+			Edipi:    "9999999999",
+			LastName: "Okafor",
+		})
+		suite.NoError(err)
+		suite.False(verification.EdipiFound)
+		suite.False(verification.Verified())
+	})
+
+	suite.Run("an EDIPI that isn't 10 digits is rejected", func() {
+		verification, err := verifier.VerifyIdentity(suite.AppContextForTest(), services.IdentityVerificationParams{Edipi: "12345"})
+		suite.Nil(verification)
+		suite.IsType(apperror.InvalidInputError{}, err)
+	})
+
+	suite.Run("lookup errors are returned", func() {
+		verification, err := NewIdentityVerifier(stubPersonLookup{err: errors.New("RBS is down")}).VerifyIdentity(suite.AppContextForTest(), services.IdentityVerificationParams{Edipi: "3850947261"})
+		suite.Nil(verification)
+		suite.ErrorContains(err, "RBS is down")
+	})
+
+	suite.Run("the testing person lookup can't verify anyone", func() {
+		verification, err := NewIdentityVerifier(iws.TestingPersonLookup{}).VerifyIdentity(suite.AppContextForTest(), services.IdentityVerificationParams{Edipi: "1234567890"})
+		suite.Nil(verification)
+		suite.IsType(apperror.NotImplementedError{}, err)
+
+		testingPersonLookup, err := iws.NewTestingPersonLookup()
+		suite.NoError(err)
+		_, err = NewIdentityVerifier(testingPersonLookup).VerifyIdentity(suite.AppContextForTest(), services.IdentityVerificationParams{Edipi: "1234567890"})
+		suite.IsType(apperror.NotImplementedError{}, err)
+	})
+}
@@ -69,6 +69,15 @@ type SyncadaSFTPReader interface {
 	FetchAndProcessSyncadaFiles(appCtx appcontext.AppContext, pickupPath string, lastRead time.Time, processor SyncadaFileProcessor) (time.Time, error)
 }
 
+// SyncadaTransport is the exported interface for exchanging files with Syncada. Files are sent to
+// Syncada's upload directory and fetched from its pickup directories.
+//
+//go:generate mockery --name SyncadaTransport
+type SyncadaTransport interface {
+	SyncadaSFTPSender
+	SFTPClient
+}
+
 // SyncadaFileProcessor is the exported interface for processing EDI files from Syncada
 //
 //go:generate mockery --name SyncadaFileProcessor
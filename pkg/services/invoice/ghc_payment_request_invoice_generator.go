@@ -964,6 +964,8 @@ func (g ghcPaymentRequestInvoiceGenerator) generatePaymentServiceItemSegments(ap
 	l3 := edisegment.L3{
 		PriceCents: 0,
 	}
+	// the heaviest weight billed on each shipment's service items, which is the shipment's weight billed
+	shipmentWeights := map[uuid.UUID]float64{}
 	// Iterate over payment service items
 	for idx, serviceItem := range paymentServiceItems {
 		var newSegment ediinvoice.ServiceItemSegments
@@ -1112,6 +1114,18 @@ func (g ghcPaymentRequestInvoiceGenerator) generatePaymentServiceItemSegments(ap
 		newSegment.FA1 = fa1
 		newSegment.FA2s = fa2s
 		segments = append(segments, newSegment)
+
+		if shipmentID := serviceItem.MTOServiceItem.MTOShipmentID; shipmentID != nil && newSegment.L0.Weight > shipmentWeights[*shipmentID] {
+			shipmentWeights[*shipmentID] = newSegment.L0.Weight
+		}
+	}
+
+	// the L3 weight is the total weight billed for the shipments on the invoice
+	for _, weight := range shipmentWeights {
+		l3.Weight += weight
+	}
+	if l3.Weight > 0 {
+		l3.WeightQualifier = "B"
 	}
 
 	return segments, l3, nil
@@ -1214,6 +1214,17 @@ func (suite *GHCInvoiceSuite) TestAllGenerateEdi() {
 		l3 := result.L3
 		// Will need to be updated as more service items are supported
 		suite.Equal(int64(19536), l3.PriceCents)
+		suite.Equal(float64(4242), l3.Weight)
+		suite.Equal("B", l3.WeightQualifier)
+	})
+
+	suite.Run("no service item is billed for more than the l3 weight", func() {
+		suite.NoError(result.CheckBusinessRules(ediinvoice.CheckTotals))
+
+		overweight := result
+		overweight.ServiceItems = append([]ediinvoice.ServiceItemSegments{}, result.ServiceItems...)
+		overweight.ServiceItems[0].L0.Weight = result.L3.Weight + 1
+		suite.Error(overweight.CheckBusinessRules(ediinvoice.CheckTotals))
 	})
 }
 
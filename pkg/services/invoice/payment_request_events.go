@@ -0,0 +1,35 @@
+package invoice
+
+import (
+	"go.uber.org/zap"
+
+	"github.com/transcom/mymove/pkg/appcontext"
+	"github.com/transcom/mymove/pkg/models"
+	"github.com/transcom/mymove/pkg/services/event"
+)
+
+// triggerEDIRejectedEvent lets the Prime know a payment request was rejected by Syncada or GEX
+func triggerEDIRejectedEvent(appCtx appcontext.AppContext, paymentRequest models.PaymentRequest) {
+	triggerPaymentRequestEvent(appCtx, event.PaymentRequestRejectEDIEventKey, paymentRequest)
+}
+
+// triggerPaymentReceivedEvent lets the Prime know TPPS has paid a payment request
+func triggerPaymentReceivedEvent(appCtx appcontext.AppContext, paymentRequest models.PaymentRequest) {
+	triggerPaymentRequestEvent(appCtx, event.PaymentRequestReceivePaymentEventKey, paymentRequest)
+}
+
+func triggerPaymentRequestEvent(appCtx appcontext.AppContext, eventKey event.KeyType, paymentRequest models.PaymentRequest) {
+	_, err := event.TriggerEvent(event.Event{
+		EventKey:        eventKey,
+		MtoID:           paymentRequest.MoveTaskOrderID,
+		UpdatedObjectID: paymentRequest.ID,
+		AppContext:      appCtx,
+	})
+	// The file has already been processed, so a failed event is only logged
+	if err != nil {
+		appCtx.Logger().Error("could not generate the payment request event",
+			zap.String("eventKey", string(eventKey)),
+			zap.String("paymentRequestID", paymentRequest.ID.String()),
+			zap.Error(err))
+	}
+}
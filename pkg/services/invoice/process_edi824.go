@@ -39,6 +39,7 @@ func (e *edi824Processor) ProcessFile(appCtx appcontext.AppContext, _ string, st
 	e.logEDI(appCtx, edi824)
 
 	var transactionError error
+	var rejectedPaymentRequest *models.PaymentRequest
 	var otiGCN int64
 	var bgn edisegment.BGN
 	transactionError = appCtx.NewTransaction(func(txnAppCtx appcontext.AppContext) error {
@@ -170,6 +171,7 @@ func (e *edi824Processor) ProcessFile(appCtx appcontext.AppContext, _ string, st
 			}
 			txnAppCtx.Logger().Info("SUCCESS: 824 Processor updated Payment Request to new status")
 			e.logEDIWithPaymentRequest(txnAppCtx, edi824, paymentRequest)
+			rejectedPaymentRequest = &paymentRequest
 
 		}
 		paymentRequestNotifier := notifications.NewPaymentRequestFailed(paymentRequest)
@@ -188,6 +190,10 @@ func (e *edi824Processor) ProcessFile(appCtx appcontext.AppContext, _ string, st
 		return transactionError
 	}
 
+	if rejectedPaymentRequest != nil {
+		triggerEDIRejectedEvent(appCtx, *rejectedPaymentRequest)
+	}
+
 	return nil
 }
 
@@ -71,6 +71,7 @@ func (e *edi997Processor) ProcessFile(appCtx appcontext.AppContext, _ string, st
 		EDIType:                  models.EDIType997,
 	}
 
+	rejected := false
 	transactionError := appCtx.NewTransaction(func(txnAppCtx appcontext.AppContext) error {
 		lookupErr := txnAppCtx.DB().Where("payment_request_id = ? and interchange_control_number = ? and edi_type = ?", prToICN.PaymentRequestID, prToICN.InterchangeControlNumber, prToICN.EDIType).First(&prToICN)
 		if lookupErr != nil {
@@ -105,6 +106,40 @@ func (e *edi997Processor) ProcessFile(appCtx appcontext.AppContext, _ string, st
 			return fmt.Errorf("Validation error(s) detected with the EDI997: %w, %v", err, desc)
 		}
 
+		// A rejected functional group means none of the 858 was accepted, so the payment request has to be fixed
+		// and sent again rather than waiting on TPPS
+		ak9 := edi997.InterchangeControlEnvelope.FunctionalGroups[0].TransactionSets[0].FunctionalGroupResponse.AK9
+		if ak9.FunctionalGroupAcknowledgeCode == "R" {
+			code := ak9.FunctionalGroupAcknowledgeCode
+			if ak9.FunctionalGroupSyntaxErrorCodeAK905 != "" {
+				code = ak9.FunctionalGroupSyntaxErrorCodeAK905
+			}
+			desc := "Functional group rejected"
+			ediError := models.EdiError{
+				Code:                       &code,
+				Description:                &desc,
+				PaymentRequestID:           paymentRequest.ID,
+				InterchangeControlNumberID: &prToICN.ID,
+				EDIType:                    models.EDIType997,
+			}
+			err = txnAppCtx.DB().Save(&ediError)
+			if err != nil {
+				txnAppCtx.Logger().Error("failure saving edi rejection", zap.Error(err))
+				return fmt.Errorf("failure saving edi rejection: %w", err)
+			}
+
+			paymentRequest.Status = models.PaymentRequestStatusEDIError
+			err = txnAppCtx.DB().Update(&paymentRequest)
+			if err != nil {
+				txnAppCtx.Logger().Error("failure updating payment request", zap.Error(err))
+				return fmt.Errorf("failure updating payment request status: %w", err)
+			}
+			txnAppCtx.Logger().Info("SUCCESS: 997 Processor updated rejected Payment Request to new status")
+			e.logEDIWithPaymentRequest(txnAppCtx, edi997, paymentRequest)
+			rejected = true
+			return nil
+		}
+
 		paymentRequest.Status = models.PaymentRequestStatusTppsReceived
 		ReceivedByGexAt := time.Now()
 		paymentRequest.ReceivedByGexAt = &ReceivedByGexAt
@@ -123,6 +158,10 @@ func (e *edi997Processor) ProcessFile(appCtx appcontext.AppContext, _ string, st
 		return transactionError
 	}
 
+	if rejected {
+		triggerEDIRejectedEvent(appCtx, paymentRequest)
+	}
+
 	return nil
 }
 
@@ -199,6 +199,56 @@ IEA*1*000000995
 		suite.NotNil(updatedPR.ReceivedByGexAt)
 	})
 
+	suite.Run("records an error and updates the payment request status after processing a rejected EDI997", func() {
+		sample997EDIString := `
+ISA*00*0084182369*00*0000000000*ZZ*MILMOVE        *12*8004171844     *201002*1504*U*00401*00000996*0*T*|
+GS*SI*MILMOVE*8004171844*20190903*1617*9999*X*004010
+ST*997*0001
+AK1*SI*100001262
+AK2*858*0001
+
+AK5*R
+AK9*R*1*1*0*5
+SE*6*0001
+GE*1*220001
+IEA*1*000000996
+	`
+		paymentRequest := factory.BuildPaymentRequest(suite.DB(), []factory.Customization{
+			{
+				Model: models.PaymentRequest{
+					Status: models.PaymentRequestStatusSentToGex,
+				},
+			},
+		}, nil)
+		factory.BuildPaymentRequestToInterchangeControlNumber(suite.DB(), []factory.Customization{
+			{
+				Model: models.PaymentRequestToInterchangeControlNumber{
+					InterchangeControlNumber: 100001262,
+					EDIType:                  models.EDIType858,
+				},
+			},
+			{
+				Model:    paymentRequest,
+				LinkOnly: true,
+			},
+		}, nil)
+		err := edi997Processor.ProcessFile(suite.AppContextForTest(), "", sample997EDIString)
+		suite.NoError(err)
+
+		var updatedPR models.PaymentRequest
+		err = suite.DB().Where("id = ?", paymentRequest.ID).First(&updatedPR)
+		suite.NoError(err)
+		suite.Equal(models.PaymentRequestStatusEDIError, updatedPR.Status)
+		suite.Nil(updatedPR.ReceivedByGexAt)
+
+		var ediErrors models.EdiErrors
+		err = suite.DB().Where("payment_request_id = ?", paymentRequest.ID).All(&ediErrors)
+		suite.NoError(err)
+		suite.Len(ediErrors, 1)
+		suite.Equal("5", *ediErrors[0].Code)
+		suite.Equal(models.EDIType997, ediErrors[0].EDIType)
+	})
+
 	suite.Run("can handle 997 and 858 with same ICN", func() {
 		sample997EDIString := `
 ISA*00*0084182369*00*0000000000*ZZ*MILMOVE        *12*8004171844     *201002*1504*U*00401*00000995*0*T*|
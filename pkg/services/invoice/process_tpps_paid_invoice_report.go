@@ -125,6 +125,7 @@ func (t *tppsPaidInvoiceReportProcessor) ProcessFile(appCtx appcontext.AppContex
 					}
 					updatedPaymentRequestStatusCount += 1
 					paymentRequestWithStatusUpdatedToPaid[paymentRequest.ID.String()] = paymentRequest.PaymentRequestNumber
+					triggerPaymentReceivedEvent(appCtx, paymentRequest)
 				}
 			}
 		}
@@ -0,0 +1,220 @@
+package invoice
+
+import (
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/pkg/sftp"
+	"go.uber.org/zap"
+	"golang.org/x/crypto/ssh"
+)
+
+// SyncadaSFTPStandInServer is an SFTP server that stands in for Syncada's in local development and
+// integration tests. It serves the files of a local directory, so a SyncadaStandIn using the same
+// directory can answer the 858s uploaded to it.
+type SyncadaSFTPStandInServer struct {
+	logger    *zap.Logger
+	root      string
+	config    *ssh.ServerConfig
+	listener  net.Listener
+	waitGroup sync.WaitGroup
+}
+
+// NewSyncadaSFTPStandInServer returns a server for the directory root that accepts the user ID and
+// password and identifies itself with the host key
+func NewSyncadaSFTPStandInServer(logger *zap.Logger, root string, userID string, password string, hostKey ssh.Signer) *SyncadaSFTPStandInServer {
+	config := &ssh.ServerConfig{
+		PasswordCallback: func(conn ssh.ConnMetadata, pass []byte) (*ssh.Permissions, error) {
+			userMatches := subtle.ConstantTimeCompare([]byte(conn.User()), []byte(userID)) == 1
+			passwordMatches := subtle.ConstantTimeCompare(pass, []byte(password)) == 1
+			if userMatches && passwordMatches {
+				return nil, nil
+			}
+			return nil, fmt.Errorf("invalid credentials for %s", conn.User())
+		},
+	}
+	config.AddHostKey(hostKey)
+
+	return &SyncadaSFTPStandInServer{
+		logger: logger,
+		root:   root,
+		config: config,
+	}
+}
+
+// Listen starts listening for connections on the address, such as "127.0.0.1:0"
+func (s *SyncadaSFTPStandInServer) Listen(address string) error {
+	listener, err := net.Listen("tcp", address)
+	if err != nil {
+		return err
+	}
+	s.listener = listener
+	return nil
+}
+
+// Addr returns the address the server is listening on
+func (s *SyncadaSFTPStandInServer) Addr() net.Addr {
+	return s.listener.Addr()
+}
+
+// Serve accepts connections until the server is closed
+func (s *SyncadaSFTPStandInServer) Serve() error {
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			if errors.Is(err, net.ErrClosed) {
+				return nil
+			}
+			return err
+		}
+
+		s.waitGroup.Add(1)
+		go func() {
+			defer s.waitGroup.Done()
+			s.serveConnection(conn)
+		}()
+	}
+}
+
+// Close stops listening and waits for open connections to finish
+func (s *SyncadaSFTPStandInServer) Close() error {
+	err := s.listener.Close()
+	s.waitGroup.Wait()
+	return err
+}
+
+func (s *SyncadaSFTPStandInServer) serveConnection(conn net.Conn) {
+	serverConn, channels, requests, err := ssh.NewServerConn(conn, s.config)
+	if err != nil {
+		s.logger.Warn("Syncada SFTP stand-in handshake failed", zap.Error(err))
+		return
+	}
+	defer serverConn.Close()
+	go ssh.DiscardRequests(requests)
+
+	for newChannel := range channels {
+		if newChannel.ChannelType() != "session" {
+			if err := newChannel.Reject(ssh.UnknownChannelType, "only sessions are supported"); err != nil {
+				s.logger.Warn("Syncada SFTP stand-in could not reject channel", zap.Error(err))
+			}
+			continue
+		}
+		channel, channelRequests, err := newChannel.Accept()
+		if err != nil {
+			s.logger.Warn("Syncada SFTP stand-in could not accept channel", zap.Error(err))
+			continue
+		}
+
+		go func(in <-chan *ssh.Request) {
+			for req := range in {
+				// the payload of a subsystem request is the length-prefixed subsystem name
+				isSFTP := req.Type == "subsystem" && len(req.Payload) > 4 && string(req.Payload[4:]) == "sftp"
+				if err := req.Reply(isSFTP, nil); err != nil {
+					s.logger.Warn("Syncada SFTP stand-in could not reply to request", zap.Error(err))
+				}
+			}
+		}(channelRequests)
+
+		server := sftp.NewRequestServer(channel, s.handlers())
+		if err := server.Serve(); err != nil && !errors.Is(err, io.EOF) {
+			s.logger.Warn("Syncada SFTP stand-in session ended", zap.Error(err))
+		}
+		if err := server.Close(); err != nil && !errors.Is(err, io.EOF) {
+			s.logger.Debug("Syncada SFTP stand-in could not close session", zap.Error(err))
+		}
+	}
+}
+
+func (s *SyncadaSFTPStandInServer) handlers() sftp.Handlers {
+	files := &standInFiles{&directorySyncadaTransport{root: s.root}}
+	return sftp.Handlers{
+		FileGet:  files,
+		FilePut:  files,
+		FileCmd:  files,
+		FileList: files,
+	}
+}
+
+// standInFiles serves SFTP requests from the local directory of a directorySyncadaTransport
+type standInFiles struct {
+	directory *directorySyncadaTransport
+}
+
+func (f *standInFiles) Fileread(r *sftp.Request) (io.ReaderAt, error) {
+	return os.Open(f.directory.localPath(r.Filepath))
+}
+
+// Filewrite writes to a temporary file that is renamed when the client closes it, so the stand-in
+// never reads a partially uploaded 858
+func (f *standInFiles) Filewrite(r *sftp.Request) (io.WriterAt, error) {
+	localPath := f.directory.localPath(r.Filepath)
+	if err := os.MkdirAll(filepath.Dir(localPath), 0750); err != nil {
+		return nil, err
+	}
+	tempFile, err := os.CreateTemp(filepath.Dir(localPath), ".sending-*")
+	if err != nil {
+		return nil, err
+	}
+	return &renameOnCloseFile{File: tempFile, finalPath: localPath}, nil
+}
+
+func (f *standInFiles) Filecmd(r *sftp.Request) error {
+	localPath := f.directory.localPath(r.Filepath)
+	switch r.Method {
+	case "Remove", "Rmdir":
+		return os.Remove(localPath)
+	case "Rename", "PosixRename":
+		return os.Rename(localPath, f.directory.localPath(r.Target))
+	case "Mkdir":
+		return os.MkdirAll(localPath, 0750)
+	case "Setstat":
+		return nil
+	}
+	return sftp.ErrSSHFxOpUnsupported
+}
+
+func (f *standInFiles) Filelist(r *sftp.Request) (sftp.ListerAt, error) {
+	switch r.Method {
+	case "List":
+		fileInfos, err := f.directory.ReadDir(r.Filepath)
+		return fileInfoLister(fileInfos), err
+	case "Stat", "Lstat":
+		fileInfo, err := os.Stat(f.directory.localPath(r.Filepath))
+		if err != nil {
+			return nil, err
+		}
+		return fileInfoLister{fileInfo}, nil
+	}
+	return nil, sftp.ErrSSHFxOpUnsupported
+}
+
+type renameOnCloseFile struct {
+	*os.File
+	finalPath string
+}
+
+func (f *renameOnCloseFile) Close() error {
+	if err := f.File.Close(); err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), f.finalPath)
+}
+
+type fileInfoLister []os.FileInfo
+
+func (l fileInfoLister) ListAt(fileInfos []os.FileInfo, offset int64) (int, error) {
+	if offset >= int64(len(l)) {
+		return 0, io.EOF
+	}
+	n := copy(fileInfos, l[offset:])
+	if n+int(offset) >= len(l) {
+		return n, io.EOF
+	}
+	return n, nil
+}
@@ -0,0 +1,303 @@
+package invoice
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"go.uber.org/zap"
+	"golang.org/x/text/encoding/unicode"
+
+	ediinvoice "github.com/transcom/mymove/pkg/edi/invoice"
+	edisegment "github.com/transcom/mymove/pkg/edi/segment"
+)
+
+// syncadaStandInArchiveDirectory is where answered 858s are moved, inside the upload directory
+const syncadaStandInArchiveDirectory = "archive"
+
+// tppsDateFormat is the date format used in TPPS paid invoice reports
+const tppsDateFormat = "2006-01-02"
+
+// tppsReportHeaders are the columns of a TPPS paid invoice report, in order
+var tppsReportHeaders = []string{
+	"Invoice Number From Invoice", "Document Create Date", "Seller Paid Date", "Invoice Total Charges",
+	"Line Description", "Product Description", "Line Billing Units", "Line Unit Price", "Line Net Charge",
+	"PO/TCN", "Line Number",
+	"First Note Code", "First Note Code Description", "First Note To", "First Note Message",
+	"Second Note Code", "Second Note Code Description", "Second Note To", "Second Note Message",
+	"Third Note Code", "Third Note Code Description", "Third Note To", "Third Note Message",
+}
+
+// SyncadaStandIn plays Syncada's part of the invoice exchange for local development and integration
+// tests. Every 858 in the upload directory is answered with a 997 acknowledgement. 858s that break a
+// business rule are then rejected with an 824, and the rest are paid in a TPPS paid invoice report.
+// All directories are inside Root, laid out the same as the Syncada SFTP server.
+type SyncadaStandIn struct {
+	Root               string
+	UploadDirectory    string
+	PickupDirectory997 string
+	PickupDirectory824 string
+	// TPPSDirectory is where paid invoice reports are written. No reports are written when it is empty.
+	TPPSDirectory string
+	Clock         clock.Clock
+}
+
+// RespondToInvoices answers the 858s waiting in the upload directory and moves them to its archive
+// directory. It returns the number of 858s answered.
+func (s SyncadaStandIn) RespondToInvoices(logger *zap.Logger) (int, error) {
+	uploadDirectory := s.localPath(s.UploadDirectory)
+	entries, err := os.ReadDir(uploadDirectory)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return 0, nil
+		}
+		return 0, err
+	}
+
+	answered := 0
+	for _, entry := range entries {
+		if entry.IsDir() || isTemporaryFile(entry.Name()) {
+			continue
+		}
+		filePath := filepath.Join(uploadDirectory, entry.Name())
+		if err := s.respondToInvoice(logger, filePath); err != nil {
+			logger.Error("Syncada stand-in could not answer 858", zap.String("path", filePath), zap.Error(err))
+		} else {
+			answered++
+		}
+
+		// Archive the 858 even if it could not be answered so it is not tried again on every pass
+		archivePath := filepath.Join(uploadDirectory, syncadaStandInArchiveDirectory, entry.Name())
+		if err := os.MkdirAll(filepath.Dir(archivePath), 0750); err != nil {
+			return answered, err
+		}
+		if err := os.Rename(filePath, archivePath); err != nil {
+			return answered, err
+		}
+	}
+	return answered, nil
+}
+
+// Watch answers 858s as they are uploaded until the context is done
+func (s SyncadaStandIn) Watch(ctx context.Context, logger *zap.Logger, interval time.Duration) error {
+	ticker := s.clock().Ticker(interval)
+	defer ticker.Stop()
+
+	for {
+		answered, err := s.RespondToInvoices(logger)
+		if err != nil {
+			return err
+		}
+		if answered > 0 {
+			logger.Info("Syncada stand-in answered 858s", zap.Int("count", answered))
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s SyncadaStandIn) respondToInvoice(logger *zap.Logger, filePath string) error {
+	content, err := os.ReadFile(filepath.Clean(filePath))
+	if err != nil {
+		return err
+	}
+
+	var edi858 ediinvoice.Invoice858C
+	if err := edi858.Parse(string(content)); err != nil {
+		return err
+	}
+	icn := edi858.ISA.InterchangeControlNumber
+	logger.Info("Syncada stand-in received 858", zap.Int64("ICN", icn), zap.String("PaymentRequestNumber", edi858.Header.PaymentRequestNumber.ReferenceIdentification))
+
+	if err := s.writeResponse(s.PickupDirectory997, fmt.Sprintf("%d_edi997.txt", icn), s.edi997(edi858)); err != nil {
+		return err
+	}
+
+	var ruleErr ediinvoice.BusinessRuleError
+	if err := edi858.CheckBusinessRules(); errors.As(err, &ruleErr) {
+		return s.writeResponse(s.PickupDirectory824, fmt.Sprintf("%d_edi824.txt", icn), s.edi824(edi858, ruleErr.Violations))
+	} else if err != nil {
+		return err
+	}
+
+	if s.TPPSDirectory == "" {
+		return nil
+	}
+	report, err := s.tppsReport(edi858)
+	if err != nil {
+		return err
+	}
+	return s.writeResponse(s.TPPSDirectory, fmt.Sprintf("TPPSPaidInvoiceReport_%d.csv", icn), report)
+}
+
+// edi997 acknowledges the 858's functional group
+func (s SyncadaStandIn) edi997(edi858 ediinvoice.Invoice858C) string {
+	segments := s.envelopeHeader(edi858, "FA")
+	segments = append(segments,
+		(&edisegment.ST{TransactionSetIdentifierCode: "997", TransactionSetControlNumber: "0001"}).StringArray(),
+		(&edisegment.AK1{FunctionalIdentifierCode: "SI", GroupControlNumber: edi858.GS.GroupControlNumber}).StringArray(),
+		(&edisegment.AK2{TransactionSetIdentifierCode: "858", TransactionSetControlNumber: edi858.ST.TransactionSetControlNumber}).StringArray(),
+		(&edisegment.AK5{TransactionSetAcknowledgmentCode: "A"}).StringArray(),
+		(&edisegment.AK9{
+			FunctionalGroupAcknowledgeCode:  "A",
+			NumberOfTransactionSetsIncluded: 1,
+			NumberOfReceivedTransactionSets: 1,
+			NumberOfAcceptedTransactionSets: 1,
+		}).StringArray(),
+		(&edisegment.SE{NumberOfIncludedSegments: 6, TransactionSetControlNumber: "0001"}).StringArray(),
+	)
+	return x12String(append(segments, s.envelopeTrailer(edi858)...))
+}
+
+// edi824 rejects the 858 with a TED for each business rule it breaks
+func (s SyncadaStandIn) edi824(edi858 ediinvoice.Invoice858C, violations []ediinvoice.RuleViolation) string {
+	// BGN02 is the move reference ID, which is the payment request number without its sequence number
+	paymentRequestNumber := edi858.Header.PaymentRequestNumber.ReferenceIdentification
+	moveReferenceID := paymentRequestNumber
+	if i := strings.LastIndex(paymentRequestNumber, "-"); i > 0 {
+		moveReferenceID = paymentRequestNumber[:i]
+	}
+	date := s.clock().Now().Format(dateFormat)
+
+	segments := s.envelopeHeader(edi858, "AG")
+	segments = append(segments,
+		(&edisegment.ST{TransactionSetIdentifierCode: "824", TransactionSetControlNumber: "0001"}).StringArray(),
+		(&edisegment.BGN{TransactionSetPurposeCode: "11", ReferenceIdentification: moveReferenceID, Date: date}).StringArray(),
+		(&edisegment.OTI{
+			ApplicationAcknowledgementCode:   "TR",
+			ReferenceIdentificationQualifier: "BM",
+			ReferenceIdentification:          moveReferenceID,
+			ApplicationSendersCode:           "MILMOVE",
+			ApplicationReceiversCode:         "8004171844",
+			Date:                             date,
+			GroupControlNumber:               edi858.GS.GroupControlNumber,
+			TransactionSetControlNumber:      edi858.ST.TransactionSetControlNumber,
+		}).StringArray(),
+	)
+	for _, violation := range violations {
+		ted := violation.TED()
+		segments = append(segments, ted.StringArray())
+	}
+	segments = append(segments, (&edisegment.SE{NumberOfIncludedSegments: len(violations) + 4, TransactionSetControlNumber: "0001"}).StringArray())
+	return x12String(append(segments, s.envelopeTrailer(edi858)...))
+}
+
+// envelopeHeader returns the ISA and GS segments of a response from Syncada. Responses reuse the
+// control number of the 858 they answer.
+func (s SyncadaStandIn) envelopeHeader(edi858 ediinvoice.Invoice858C, functionalIdentifierCode string) [][]string {
+	now := s.clock().Now()
+	icn := edi858.ISA.InterchangeControlNumber
+	return [][]string{
+		(&edisegment.ISA{
+			AuthorizationInformationQualifier: "00",
+			AuthorizationInformation:          "0084182369",
+			SecurityInformationQualifier:      "00",
+			SecurityInformation:               "0000000000",
+			InterchangeSenderIDQualifier:      "12",
+			InterchangeSenderID:               fmt.Sprintf("%-15s", "8004171844"),
+			InterchangeReceiverIDQualifier:    "ZZ",
+			InterchangeReceiverID:             fmt.Sprintf("%-15s", "MILMOVE"),
+			InterchangeDate:                   now.Format(isaDateFormat),
+			InterchangeTime:                   now.Format(timeFormat),
+			InterchangeControlStandards:       "U",
+			InterchangeControlVersionNumber:   "00401",
+			InterchangeControlNumber:          icn,
+			AcknowledgementRequested:          0,
+			UsageIndicator:                    edi858.ISA.UsageIndicator,
+			ComponentElementSeparator:         "|",
+		}).StringArray(),
+		(&edisegment.GS{
+			FunctionalIdentifierCode: functionalIdentifierCode,
+			ApplicationSendersCode:   "8004171844",
+			ApplicationReceiversCode: "MILMOVE",
+			Date:                     now.Format(dateFormat),
+			Time:                     now.Format(timeFormat),
+			GroupControlNumber:       icn,
+			ResponsibleAgencyCode:    "X",
+			Version:                  "004010",
+		}).StringArray(),
+	}
+}
+
+func (s SyncadaStandIn) envelopeTrailer(edi858 ediinvoice.Invoice858C) [][]string {
+	icn := edi858.ISA.InterchangeControlNumber
+	return [][]string{
+		(&edisegment.GE{NumberOfTransactionSetsIncluded: 1, GroupControlNumber: icn}).StringArray(),
+		(&edisegment.IEA{NumberOfIncludedFunctionalGroups: 1, InterchangeControlNumber: icn}).StringArray(),
+	}
+}
+
+// tppsReport pays every service item of the 858 in full. Like the real reports, it is UTF-16 encoded
+// and tab separated.
+func (s SyncadaStandIn) tppsReport(edi858 ediinvoice.Invoice858C) (string, error) {
+	today := s.clock().Now().Format(tppsDateFormat)
+	rows := [][]string{tppsReportHeaders}
+	for _, item := range edi858.ServiceItems {
+		units := int64(item.L0.Weight)
+		if units <= 0 {
+			units = 1
+		}
+		row := []string{
+			edi858.Header.PaymentRequestNumber.ReferenceIdentification,
+			today,
+			today,
+			dollars(edi858.L3.PriceCents),
+			item.L5.LadingDescription,
+			item.L5.LadingDescription,
+			strconv.FormatInt(units, 10),
+			fmt.Sprintf("%.4f", float64(item.L1.Charge)/100/float64(units)),
+			dollars(item.L1.Charge),
+			item.N9.ReferenceIdentification,
+			item.HL.HierarchicalIDNumber,
+		}
+		// no notes
+		row = append(row, make([]string, len(tppsReportHeaders)-len(row))...)
+		rows = append(rows, row)
+	}
+
+	var lines []string
+	for _, row := range rows {
+		lines = append(lines, strings.Join(row, "\t"))
+	}
+	encoder := unicode.UTF16(unicode.LittleEndian, unicode.UseBOM).NewEncoder()
+	return encoder.String(strings.Join(lines, "\n") + "\n")
+}
+
+func (s SyncadaStandIn) writeResponse(directory string, fileName string, content string) error {
+	_, err := writeFileAtomically(s.localPath(directory), fileName, bytes.NewBufferString(content))
+	return err
+}
+
+func (s SyncadaStandIn) localPath(syncadaPath string) string {
+	return (&directorySyncadaTransport{root: s.Root}).localPath(syncadaPath)
+}
+
+func (s SyncadaStandIn) clock() clock.Clock {
+	if s.Clock == nil {
+		return clock.New()
+	}
+	return s.Clock
+}
+
+func x12String(segments [][]string) string {
+	lines := make([]string, len(segments))
+	for i, segment := range segments {
+		lines[i] = strings.Join(segment, "*")
+	}
+	return strings.Join(lines, "\n") + "\n"
+}
+
+func dollars(cents int64) string {
+	return fmt.Sprintf("%.2f", float64(cents)/100)
+}
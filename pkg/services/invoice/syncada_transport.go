@@ -0,0 +1,136 @@
+package invoice
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+
+	"github.com/pkg/sftp"
+	"go.uber.org/zap"
+
+	"github.com/transcom/mymove/pkg/appcontext"
+	"github.com/transcom/mymove/pkg/services"
+)
+
+// sftpSyncadaTransport exchanges files with Syncada over an open SFTP connection
+type sftpSyncadaTransport struct {
+	sftpClientWrapper
+	uploadDirectory string
+}
+
+// NewSFTPSyncadaTransport returns a SyncadaTransport that uploads to uploadDirectory and reads the
+// pickup directories over the given SFTP client. The caller remains responsible for closing the client.
+func NewSFTPSyncadaTransport(client *sftp.Client, uploadDirectory string) services.SyncadaTransport {
+	return &sftpSyncadaTransport{
+		sftpClientWrapper: sftpClientWrapper{client},
+		uploadDirectory:   uploadDirectory,
+	}
+}
+
+// SendToSyncadaViaSFTP copies the content to a file in the upload directory
+func (t *sftpSyncadaTransport) SendToSyncadaViaSFTP(appCtx appcontext.AppContext, localDataReader io.Reader, syncadaFileName string) (int64, error) {
+	syncadaFile, err := t.client.Create(sftp.Join(t.uploadDirectory, syncadaFileName))
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		if closeErr := syncadaFile.Close(); closeErr != nil {
+			appCtx.Logger().Error("Failed to close Syncada destination file", zap.Error(closeErr))
+		}
+	}()
+
+	return io.Copy(syncadaFile, localDataReader)
+}
+
+// directorySyncadaTransport exchanges files with Syncada through a local directory, such as one
+// shared with a SyncadaStandIn. Syncada paths are resolved inside the root directory.
+type directorySyncadaTransport struct {
+	root            string
+	uploadDirectory string
+}
+
+// NewDirectorySyncadaTransport returns a SyncadaTransport backed by the local directory root.
+// Files sent to Syncada are written to uploadDirectory inside root.
+func NewDirectorySyncadaTransport(root string, uploadDirectory string) services.SyncadaTransport {
+	return &directorySyncadaTransport{
+		root:            root,
+		uploadDirectory: uploadDirectory,
+	}
+}
+
+// localPath maps a Syncada path to a path inside the root directory. Paths cannot climb out of the root.
+func (t *directorySyncadaTransport) localPath(syncadaPath string) string {
+	return filepath.Join(t.root, filepath.FromSlash(path.Clean("/"+syncadaPath)))
+}
+
+// SendToSyncadaViaSFTP writes the content to a file in the upload directory
+func (t *directorySyncadaTransport) SendToSyncadaViaSFTP(_ appcontext.AppContext, localDataReader io.Reader, syncadaFileName string) (int64, error) {
+	return writeFileAtomically(t.localPath(t.uploadDirectory), syncadaFileName, localDataReader)
+}
+
+// ReadDir lists the files in a Syncada directory. Directories and the temporary files of sends that
+// are still in progress are left out.
+func (t *directorySyncadaTransport) ReadDir(p string) ([]os.FileInfo, error) {
+	entries, err := os.ReadDir(t.localPath(p))
+	if err != nil {
+		return nil, err
+	}
+
+	var fileInfos []os.FileInfo
+	for _, entry := range entries {
+		if entry.IsDir() || isTemporaryFile(entry.Name()) {
+			continue
+		}
+		fileInfo, err := entry.Info()
+		if err != nil {
+			if os.IsNotExist(err) {
+				// removed since the directory was read
+				continue
+			}
+			return nil, err
+		}
+		fileInfos = append(fileInfos, fileInfo)
+	}
+	return fileInfos, nil
+}
+
+func (t *directorySyncadaTransport) Open(p string) (services.SFTPFiler, error) {
+	return os.Open(t.localPath(p))
+}
+
+func (t *directorySyncadaTransport) Remove(p string) error {
+	return os.Remove(t.localPath(p))
+}
+
+func isTemporaryFile(name string) bool {
+	return len(name) > 0 && name[0] == '.'
+}
+
+// writeFileAtomically writes the content to a file in the directory, creating the directory if needed.
+// The file is written under a temporary name and renamed so a watcher never picks up a partial file.
+func writeFileAtomically(directory string, fileName string, content io.Reader) (int64, error) {
+	if err := os.MkdirAll(directory, 0750); err != nil {
+		return 0, fmt.Errorf("could not create directory %s: %w", directory, err)
+	}
+
+	tempFile, err := os.CreateTemp(directory, ".sending-*")
+	if err != nil {
+		return 0, err
+	}
+	written, err := io.Copy(tempFile, content)
+	if closeErr := tempFile.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(tempFile.Name(), filepath.Join(directory, filepath.Base(fileName)))
+	}
+	if err != nil {
+		if removeErr := os.Remove(tempFile.Name()); removeErr != nil && !os.IsNotExist(removeErr) {
+			return 0, fmt.Errorf("%w (and could not remove %s: %v)", err, tempFile.Name(), removeErr)
+		}
+		return 0, err
+	}
+	return written, nil
+}
@@ -0,0 +1,353 @@
+package invoice
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/rand"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/pkg/sftp"
+	"golang.org/x/crypto/ssh"
+
+	ediinvoice "github.com/transcom/mymove/pkg/edi/invoice"
+	edisegment "github.com/transcom/mymove/pkg/edi/segment"
+	"github.com/transcom/mymove/pkg/factory"
+	"github.com/transcom/mymove/pkg/models"
+	"github.com/transcom/mymove/pkg/notifications"
+	"github.com/transcom/mymove/pkg/services"
+)
+
+// makeSyncadaTestInvoice returns an 858 that passes the business rules
+func makeSyncadaTestInvoice(paymentRequestNumber string, icn int64) ediinvoice.Invoice858C {
+	return ediinvoice.Invoice858C{
+		ISA: edisegment.ISA{
+			AuthorizationInformationQualifier: "00",
+			AuthorizationInformation:          "0084182369",
+			SecurityInformationQualifier:      "00",
+			SecurityInformation:               "0000000000",
+			InterchangeSenderIDQualifier:      "ZZ",
+			InterchangeSenderID:               "MILMOVE        ",
+			InterchangeReceiverIDQualifier:    "12",
+			InterchangeReceiverID:             "8004171844     ",
+			InterchangeDate:                   "210217",
+			InterchangeTime:                   "1504",
+			InterchangeControlStandards:       "U",
+			InterchangeControlVersionNumber:   "00401",
+			InterchangeControlNumber:          icn,
+			AcknowledgementRequested:          0,
+			UsageIndicator:                    "T",
+			ComponentElementSeparator:         "|",
+		},
+		GS: edisegment.GS{
+			FunctionalIdentifierCode: "SI",
+			ApplicationSendersCode:   "MILMOVE",
+			ApplicationReceiversCode: "8004171844",
+			Date:                     "20210217",
+			Time:                     "1504",
+			GroupControlNumber:       icn,
+			ResponsibleAgencyCode:    "X",
+			Version:                  "004010",
+		},
+		ST: edisegment.ST{TransactionSetIdentifierCode: "858", TransactionSetControlNumber: "0001"},
+		Header: ediinvoice.InvoiceHeader{
+			ShipmentInformation: edisegment.BX{
+				TransactionSetPurposeCode:    "00",
+				TransactionMethodTypeCode:    "J",
+				ShipmentMethodOfPayment:      "PP",
+				ShipmentIdentificationNumber: paymentRequestNumber,
+				StandardCarrierAlphaCode:     "BLKW",
+				ShipmentQualifier:            "4",
+			},
+			PaymentRequestNumber: edisegment.N9{ReferenceIdentificationQualifier: "CN", ReferenceIdentification: paymentRequestNumber},
+			ContractCode:         edisegment.N9{ReferenceIdentificationQualifier: "CT", ReferenceIdentification: "TRUSS_TEST"},
+			ServiceMemberName:    edisegment.N9{ReferenceIdentificationQualifier: "1W", ReferenceIdentification: "Leo, Spacemen"},
+			OrderPayGrade:        edisegment.N9{ReferenceIdentificationQualifier: "ML", ReferenceIdentification: "E_1"},
+			ServiceMemberBranch:  edisegment.N9{ReferenceIdentificationQualifier: "3L", ReferenceIdentification: "ARMY"},
+			ServiceMemberID:      edisegment.N9{ReferenceIdentificationQualifier: "4A", ReferenceIdentification: "7562672421"},
+			MoveCode:             edisegment.N9{ReferenceIdentificationQualifier: "CMN", ReferenceIdentification: "RDY4PY"},
+			Currency:             edisegment.C3{CurrencyCodeC301: "USD"},
+			BuyerOrganizationName: edisegment.N1{
+				EntityIdentifierCode: "BY", Name: "BuyerOrganizationName", IdentificationCodeQualifier: "92", IdentificationCode: "LKNQ",
+			},
+			SellerOrganizationName: edisegment.N1{
+				EntityIdentifierCode: "SE", Name: "SellerOrganizationName", IdentificationCodeQualifier: "2", IdentificationCode: "BLKW",
+			},
+			DestinationName: edisegment.N1{
+				EntityIdentifierCode: "ST", Name: "DestinationName", IdentificationCodeQualifier: "10", IdentificationCode: "CNNQ",
+			},
+			DestinationPostalDetails: edisegment.N4{CityName: "Fort Eisenhower", StateOrProvinceCode: "GA", PostalCode: "30813", CountryCode: "US"},
+			OriginName: edisegment.N1{
+				EntityIdentifierCode: "SF", Name: "OriginName", IdentificationCodeQualifier: "10", IdentificationCode: "LKNQ",
+			},
+			OriginPostalDetails: edisegment.N4{CityName: "Des Moines", StateOrProvinceCode: "IA", PostalCode: "50309", CountryCode: "US"},
+		},
+		ServiceItems: []ediinvoice.ServiceItemSegments{
+			{
+				HL: edisegment.HL{HierarchicalIDNumber: "1", HierarchicalLevelCode: "I"},
+				N9: edisegment.N9{ReferenceIdentificationQualifier: "PO", ReferenceIdentification: paymentRequestNumber + "-cs"},
+				L5: edisegment.L5{
+					LadingLineItemNumber: 1, LadingDescription: models.ReServiceCodeCS.String(), CommodityCode: "TBD", CommodityCodeQualifier: "D",
+				},
+				L0:   edisegment.L0{LadingLineItemNumber: 1},
+				L1:   edisegment.L1{LadingLineItemNumber: 1, Charge: 22353},
+				FA1:  edisegment.FA1{AgencyQualifierCode: "DF"},
+				FA2s: []edisegment.FA2{{BreakdownStructureDetailCode: edisegment.FA2DetailCodeTA, FinancialInformationCode: "1234"}},
+			},
+		},
+		L3:  edisegment.L3{PriceCents: 22353},
+		SE:  edisegment.SE{NumberOfIncludedSegments: 1, TransactionSetControlNumber: "0001"},
+		GE:  edisegment.GE{NumberOfTransactionSetsIncluded: 1, GroupControlNumber: icn},
+		IEA: edisegment.IEA{NumberOfIncludedFunctionalGroups: 1, InterchangeControlNumber: icn},
+	}
+}
+
+func (suite *SyncadaSftpReaderSuite) sendTestInvoice(transport services.SyncadaTransport, invoice ediinvoice.Invoice858C) {
+	edi858, err := invoice.EDIString(suite.Logger())
+	suite.NoError(err)
+	_, err = transport.SendToSyncadaViaSFTP(suite.AppContextForTest(), strings.NewReader(edi858), "edi858.txt")
+	suite.NoError(err)
+}
+
+func (suite *SyncadaSftpReaderSuite) TestDirectorySyncadaTransport() {
+	suite.Run("files sent are written to the upload directory", func() {
+		root := suite.T().TempDir()
+		transport := NewDirectorySyncadaTransport(root, "/user")
+
+		written, err := transport.SendToSyncadaViaSFTP(suite.AppContextForTest(), strings.NewReader("edi"), "edi858.txt")
+		suite.NoError(err)
+		suite.Equal(int64(3), written)
+
+		content, err := os.ReadFile(filepath.Join(root, "user", "edi858.txt"))
+		suite.NoError(err)
+		suite.Equal("edi", string(content))
+	})
+
+	suite.Run("directories and files still being written are not listed", func() {
+		root := suite.T().TempDir()
+		transport := NewDirectorySyncadaTransport(root, "/user")
+		suite.NoError(os.MkdirAll(filepath.Join(root, "997", "archive"), 0750))
+		suite.NoError(os.WriteFile(filepath.Join(root, "997", "edi997.txt"), []byte("997"), 0600))
+		suite.NoError(os.WriteFile(filepath.Join(root, "997", ".sending-123"), []byte("99"), 0600))
+
+		fileInfos, err := transport.ReadDir("/997")
+		suite.NoError(err)
+		suite.Len(fileInfos, 1)
+		suite.Equal("edi997.txt", fileInfos[0].Name())
+
+		file, err := transport.Open("/997/edi997.txt")
+		suite.NoError(err)
+		var buf bytes.Buffer
+		_, err = file.WriteTo(&buf)
+		suite.NoError(err)
+		suite.NoError(file.Close())
+		suite.Equal("997", buf.String())
+
+		suite.NoError(transport.Remove("/997/edi997.txt"))
+		suite.NoFileExists(filepath.Join(root, "997", "edi997.txt"))
+	})
+
+	suite.Run("paths cannot leave the root directory", func() {
+		root := suite.T().TempDir()
+		transport := NewDirectorySyncadaTransport(root, "../../user")
+
+		_, err := transport.SendToSyncadaViaSFTP(suite.AppContextForTest(), strings.NewReader("edi"), "../edi858.txt")
+		suite.NoError(err)
+		suite.FileExists(filepath.Join(root, "user", "edi858.txt"))
+	})
+}
+
+func (suite *SyncadaSftpReaderSuite) TestSyncadaStandIn() {
+	newStandIn := func() (SyncadaStandIn, services.SyncadaTransport) {
+		root := suite.T().TempDir()
+		standIn := SyncadaStandIn{
+			Root:               root,
+			UploadDirectory:    "/user",
+			PickupDirectory997: "/997",
+			PickupDirectory824: "/824",
+			TPPSDirectory:      "/tpps",
+		}
+		return standIn, NewDirectorySyncadaTransport(root, "/user")
+	}
+
+	readResponse := func(standIn SyncadaStandIn, directory string) string {
+		entries, err := os.ReadDir(filepath.Join(standIn.Root, directory))
+		suite.NoError(err)
+		suite.Len(entries, 1)
+		content, err := os.ReadFile(filepath.Join(standIn.Root, directory, entries[0].Name()))
+		suite.NoError(err)
+		return string(content)
+	}
+
+	suite.Run("accepts and pays an 858 that passes the business rules", func() {
+		standIn, transport := newStandIn()
+		suite.sendTestInvoice(transport, makeSyncadaTestInvoice("1234-5678-1", 100001251))
+
+		answered, err := standIn.RespondToInvoices(suite.Logger())
+		suite.NoError(err)
+		suite.Equal(1, answered)
+
+		edi997 := readResponse(standIn, "997")
+		suite.Contains(edi997, "AK1*SI*100001251\n")
+		suite.Contains(edi997, "AK5*A*")
+		suite.NoDirExists(filepath.Join(standIn.Root, "824"))
+
+		report := readResponse(standIn, "tpps")
+		suite.True(strings.HasPrefix(report, "\xff\xfe"), "TPPS reports are UTF-16LE with a byte order mark")
+
+		// the 858 is archived so it is only answered once
+		suite.FileExists(filepath.Join(standIn.Root, "user", "archive", "edi858.txt"))
+		answered, err = standIn.RespondToInvoices(suite.Logger())
+		suite.NoError(err)
+		suite.Equal(0, answered)
+	})
+
+	suite.Run("rejects an 858 that breaks a business rule with an 824", func() {
+		standIn, transport := newStandIn()
+		invoice := makeSyncadaTestInvoice("1234-5678-1", 100001252)
+		invoice.L3.PriceCents = 1
+		suite.sendTestInvoice(transport, invoice)
+
+		answered, err := standIn.RespondToInvoices(suite.Logger())
+		suite.NoError(err)
+		suite.Equal(1, answered)
+
+		suite.Contains(readResponse(standIn, "997"), "AK1*SI*100001252\n")
+		edi824 := readResponse(standIn, "824")
+		suite.Contains(edi824, "BGN*11*1234-5678*")
+		suite.Contains(edi824, "*100001252*0001\n")
+		suite.Contains(edi824, "TED*ZZZ*L3 total 1 does not match the L1 charges 22353")
+		suite.NoDirExists(filepath.Join(standIn.Root, "tpps"))
+	})
+}
+
+func (suite *SyncadaSftpReaderSuite) TestSyncadaSFTPStandInServer() {
+	_, hostPrivateKey, err := ed25519.GenerateKey(rand.Reader)
+	suite.NoError(err)
+	hostKey, err := ssh.NewSignerFromKey(hostPrivateKey)
+	suite.NoError(err)
+
+	root := suite.T().TempDir()
+	server := NewSyncadaSFTPStandInServer(suite.Logger(), root, "user", "password", hostKey)
+	suite.NoError(server.Listen("127.0.0.1:0"))
+	go func() {
+		suite.NoError(server.Serve())
+	}()
+	defer func() {
+		suite.NoError(server.Close())
+	}()
+
+	dial := func(password string) (*ssh.Client, error) {
+		return ssh.Dial("tcp", server.Addr().String(), &ssh.ClientConfig{
+			User:            "user",
+			Auth:            []ssh.AuthMethod{ssh.Password(password)},
+			HostKeyCallback: ssh.FixedHostKey(hostKey.PublicKey()),
+			Timeout:         5 * time.Second,
+		})
+	}
+
+	suite.Run("rejects unknown credentials", func() {
+		_, err := dial("wrong")
+		suite.Error(err)
+	})
+
+	suite.Run("the SFTP transport exchanges files with the stand-in", func() {
+		sshClient, err := dial("password")
+		suite.NoError(err)
+		defer sshClient.Close()
+		sftpClient, err := sftp.NewClient(sshClient)
+		suite.NoError(err)
+		defer sftpClient.Close()
+
+		transport := NewSFTPSyncadaTransport(sftpClient, "/user")
+		suite.sendTestInvoice(transport, makeSyncadaTestInvoice("1234-5678-1", 100001253))
+		suite.FileExists(filepath.Join(root, "user", "edi858.txt"))
+
+		standIn := SyncadaStandIn{Root: root, UploadDirectory: "/user", PickupDirectory997: "/997", PickupDirectory824: "/824", TPPSDirectory: "/tpps"}
+		answered, err := standIn.RespondToInvoices(suite.Logger())
+		suite.NoError(err)
+		suite.Equal(1, answered)
+
+		fileInfos, err := transport.ReadDir("/997")
+		suite.NoError(err)
+		suite.Len(fileInfos, 1)
+		file, err := transport.Open(sftp.Join("/997", fileInfos[0].Name()))
+		suite.NoError(err)
+		var buf bytes.Buffer
+		_, err = file.WriteTo(&buf)
+		suite.NoError(err)
+		suite.NoError(file.Close())
+		suite.Contains(buf.String(), "AK1*SI*100001253\n")
+
+		suite.NoError(transport.Remove(sftp.Join("/997", fileInfos[0].Name())))
+		fileInfos, err = transport.ReadDir("/997")
+		suite.NoError(err)
+		suite.Empty(fileInfos)
+	})
+}
+
+func (suite *SyncadaSftpReaderSuite) TestSyncadaStandInLoop() {
+	makeSentPaymentRequest := func(icn int) models.PaymentRequest {
+		paymentRequest := factory.BuildPaymentRequest(suite.DB(), []factory.Customization{
+			{
+				Model: models.PaymentRequest{Status: models.PaymentRequestStatusSentToGex},
+			},
+		}, nil)
+		factory.BuildPaymentRequestToInterchangeControlNumber(suite.DB(), []factory.Customization{
+			{
+				Model: models.PaymentRequestToInterchangeControlNumber{
+					InterchangeControlNumber: icn,
+					EDIType:                  models.EDIType858,
+				},
+			},
+			{
+				Model:    paymentRequest,
+				LinkOnly: true,
+			},
+		}, nil)
+		return paymentRequest
+	}
+
+	runLoop := func(invoice ediinvoice.Invoice858C) {
+		root := suite.T().TempDir()
+		transport := NewDirectorySyncadaTransport(root, "/user")
+		standIn := SyncadaStandIn{Root: root, UploadDirectory: "/user", PickupDirectory997: "/997", PickupDirectory824: "/824", TPPSDirectory: "/tpps"}
+
+		suite.sendTestInvoice(transport, invoice)
+		_, err := standIn.RespondToInvoices(suite.Logger())
+		suite.NoError(err)
+
+		reader := NewSyncadaSFTPReaderSession(transport, true)
+		_, err = reader.FetchAndProcessSyncadaFiles(suite.AppContextForTest(), "/997", time.Time{}, NewEDI997Processor())
+		suite.NoError(err)
+		_, err = reader.FetchAndProcessSyncadaFiles(suite.AppContextForTest(), "/824", time.Time{}, NewEDI824Processor(notifications.NewStubNotificationSender("")))
+		suite.NoError(err)
+		_, err = reader.FetchAndProcessSyncadaFiles(suite.AppContextForTest(), "/tpps", time.Time{}, NewTPPSSyncadaFileProcessor(NewTPPSPaidInvoiceReportProcessor()))
+		suite.NoError(err)
+	}
+
+	suite.Run("an accepted 858 is paid by the TPPS paid invoice report", func() {
+		paymentRequest := makeSentPaymentRequest(100001254)
+		runLoop(makeSyncadaTestInvoice(paymentRequest.PaymentRequestNumber, 100001254))
+
+		suite.NoError(suite.DB().Reload(&paymentRequest))
+		suite.Equal(models.PaymentRequestStatusPaid, paymentRequest.Status)
+	})
+
+	suite.Run("a rejected 858 moves the payment request to EDI_ERROR", func() {
+		paymentRequest := makeSentPaymentRequest(100001255)
+		invoice := makeSyncadaTestInvoice(paymentRequest.PaymentRequestNumber, 100001255)
+		invoice.L3.PriceCents = 1
+		runLoop(invoice)
+
+		suite.NoError(suite.DB().Reload(&paymentRequest))
+		suite.Equal(models.PaymentRequestStatusEDIError, paymentRequest.Status)
+
+		var ediErrors models.EdiErrors
+		suite.NoError(suite.DB().Where("payment_request_id = ?", paymentRequest.ID).All(&ediErrors))
+		suite.Len(ediErrors, 1)
+		suite.Equal(edisegment.TEDErrorCodeMutuallyDefined, *ediErrors[0].Code)
+	})
+}
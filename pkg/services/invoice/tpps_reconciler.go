@@ -0,0 +1,241 @@
+package invoice
+
+import (
+	"sort"
+	"time"
+
+	"github.com/gobuffalo/validate/v3"
+	"github.com/gofrs/uuid"
+
+	"github.com/transcom/mymove/pkg/appcontext"
+	"github.com/transcom/mymove/pkg/apperror"
+	"github.com/transcom/mymove/pkg/models"
+	"github.com/transcom/mymove/pkg/services"
+	"github.com/transcom/mymove/pkg/unit"
+)
+
+type tppsReconciler struct {
+}
+
+// NewTPPSReconciler returns a new TPPS reconciler
+func NewTPPSReconciler() services.TPPSReconciler {
+	return &tppsReconciler{}
+}
+
+// ReconcileTPPSPayments compares the TPPS lines paid between the start and end dates with the approved
+// payment service items of their payment requests. TPPS lines are matched to service items by their
+// PO/TCN, which is the service item reference ID sent in the 858. Invoices sent to Syncada between the
+// dates that TPPS has not paid at all, unless they are excluded, and TPPS invoices with no payment request
+// are reported as unmatched.
+func (r *tppsReconciler) ReconcileTPPSPayments(appCtx appcontext.AppContext, params services.TPPSReconciliationParams) (*services.TPPSReconciliationReport, error) {
+	verrs := validate.NewErrors()
+	if params.StartDate.IsZero() {
+		verrs.Add("startDate", "start date is required")
+	}
+	if params.EndDate.IsZero() {
+		verrs.Add("endDate", "end date is required")
+	}
+	if params.EndDate.Before(params.StartDate) {
+		verrs.Add("endDate", "end date must not be before the start date")
+	}
+	if params.ToleranceCents < 0 {
+		verrs.Add("toleranceCents", "tolerance must not be negative")
+	}
+	if verrs.HasAny() {
+		return nil, apperror.NewInvalidInputError(uuid.Nil, nil, verrs, "invalid TPPS reconciliation parameters")
+	}
+
+	// the dates are whole days, so the end date is included
+	start := truncateToDate(params.StartDate)
+	endExclusive := truncateToDate(params.EndDate).AddDate(0, 0, 1)
+
+	var paidInWindow models.TPPSPaidInvoiceReportEntrys
+	err := appCtx.DB().
+		Select("DISTINCT invoice_number").
+		Where("seller_paid_date >= ? AND seller_paid_date < ?", start, endExclusive).
+		All(&paidInWindow)
+	if err != nil {
+		return nil, apperror.NewQueryError("TPPSPaidInvoiceReportEntry", err, "")
+	}
+
+	report := services.TPPSReconciliationReport{StartDate: start, EndDate: endExclusive.AddDate(0, 0, -1)}
+	if len(paidInWindow) > 0 {
+		invoiceNumbers := make([]string, len(paidInWindow))
+		for i, entry := range paidInWindow {
+			invoiceNumbers[i] = entry.InvoiceNumber
+		}
+
+		// All of the TPPS lines of an invoice are compared, even ones paid outside the dates, so a service
+		// item paid on another day is not reported as unpaid
+		var entries models.TPPSPaidInvoiceReportEntrys
+		err = appCtx.DB().
+			Where("invoice_number IN (?)", invoiceNumbers).
+			Order("invoice_number, line_number").
+			All(&entries)
+		if err != nil {
+			return nil, apperror.NewQueryError("TPPSPaidInvoiceReportEntry", err, "")
+		}
+
+		var paymentRequests models.PaymentRequests
+		err = appCtx.DB().
+			EagerPreload("PaymentServiceItems.MTOServiceItem.ReService").
+			Where("payment_request_number IN (?)", invoiceNumbers).
+			All(&paymentRequests)
+		if err != nil {
+			return nil, apperror.NewQueryError("PaymentRequest", err, "")
+		}
+
+		entriesByInvoice := map[string]models.TPPSPaidInvoiceReportEntrys{}
+		for _, entry := range entries {
+			entriesByInvoice[entry.InvoiceNumber] = append(entriesByInvoice[entry.InvoiceNumber], entry)
+		}
+		for _, paymentRequest := range paymentRequests {
+			report.Lines = append(report.Lines, reconcilePaymentRequest(paymentRequest, entriesByInvoice[paymentRequest.PaymentRequestNumber], params.ToleranceCents)...)
+			delete(entriesByInvoice, paymentRequest.PaymentRequestNumber)
+		}
+		// TPPS paid invoices we have no payment request for, so every one of their lines is unmatched
+		for invoiceNumber, invoiceEntries := range entriesByInvoice {
+			report.Lines = append(report.Lines, reconcilePaymentRequest(models.PaymentRequest{PaymentRequestNumber: invoiceNumber}, invoiceEntries, params.ToleranceCents)...)
+		}
+	}
+
+	if !params.ExcludeUnpaidInvoices {
+		var unpaid models.PaymentRequests
+		err = appCtx.DB().
+			EagerPreload("PaymentServiceItems").
+			Where("status IN (?)", models.PaymentRequestStatusSentToGex, models.PaymentRequestStatusTppsReceived).
+			Where("sent_to_gex_at >= ? AND sent_to_gex_at < ?", start, endExclusive).
+			Where("NOT EXISTS (SELECT 1 FROM tpps_paid_invoice_reports WHERE tpps_paid_invoice_reports.invoice_number = payment_requests.payment_request_number)").
+			All(&unpaid)
+		if err != nil {
+			return nil, apperror.NewQueryError("PaymentRequest", err, "")
+		}
+		for _, paymentRequest := range unpaid {
+			var billed unit.Cents
+			for _, item := range billedServiceItems(paymentRequest) {
+				billed += *item.PriceCents
+			}
+			report.Lines = append(report.Lines, services.TPPSReconciliationLine{
+				PaymentRequestID:     paymentRequest.ID,
+				PaymentRequestNumber: paymentRequest.PaymentRequestNumber,
+				BilledCents:          &billed,
+				VarianceCents:        -billed,
+				Status:               services.TPPSReconciliationStatusUnmatched,
+			})
+		}
+	}
+
+	sort.SliceStable(report.Lines, func(i, j int) bool {
+		if report.Lines[i].PaymentRequestNumber != report.Lines[j].PaymentRequestNumber {
+			return report.Lines[i].PaymentRequestNumber < report.Lines[j].PaymentRequestNumber
+		}
+		return report.Lines[i].ReferenceID < report.Lines[j].ReferenceID
+	})
+	for _, line := range report.Lines {
+		if line.BilledCents != nil {
+			report.TotalBilledCents += *line.BilledCents
+		}
+		if line.PaidCents != nil {
+			report.TotalPaidCents += *line.PaidCents
+		}
+	}
+
+	return &report, nil
+}
+
+// reconcilePaymentRequest compares the TPPS lines of a payment request with its billed service items
+func reconcilePaymentRequest(paymentRequest models.PaymentRequest, entries models.TPPSPaidInvoiceReportEntrys, tolerance unit.Cents) []services.TPPSReconciliationLine {
+	type payment struct {
+		paid           unit.Cents
+		sellerPaidDate time.Time
+	}
+	payments := map[string]*payment{}
+	var unmatched []services.TPPSReconciliationLine
+	items := billedServiceItems(paymentRequest)
+	billedReferences := map[string]bool{}
+	for _, item := range items {
+		billedReferences[item.ReferenceID] = true
+	}
+
+	for _, entry := range entries {
+		paid := entry.LineNetCharge.ToCents()
+		if !billedReferences[entry.POTCN] {
+			sellerPaidDate := entry.SellerPaidDate
+			unmatched = append(unmatched, services.TPPSReconciliationLine{
+				PaymentRequestID:     paymentRequest.ID,
+				PaymentRequestNumber: paymentRequest.PaymentRequestNumber,
+				ReferenceID:          entry.POTCN,
+				ServiceCode:          entry.ProductDescription,
+				PaidCents:            &paid,
+				VarianceCents:        paid,
+				SellerPaidDate:       &sellerPaidDate,
+				Status:               services.TPPSReconciliationStatusUnmatched,
+			})
+			continue
+		}
+
+		// a service item can be paid over more than one TPPS line
+		p, ok := payments[entry.POTCN]
+		if !ok {
+			p = &payment{}
+			payments[entry.POTCN] = p
+		}
+		p.paid += paid
+		if entry.SellerPaidDate.After(p.sellerPaidDate) {
+			p.sellerPaidDate = entry.SellerPaidDate
+		}
+	}
+
+	lines := make([]services.TPPSReconciliationLine, 0, len(items)+len(unmatched))
+	for _, item := range items {
+		itemID := item.ID
+		billed := *item.PriceCents
+		line := services.TPPSReconciliationLine{
+			PaymentRequestID:     paymentRequest.ID,
+			PaymentRequestNumber: paymentRequest.PaymentRequestNumber,
+			PaymentServiceItemID: &itemID,
+			ReferenceID:          item.ReferenceID,
+			ServiceCode:          string(item.MTOServiceItem.ReService.Code),
+			BilledCents:          &billed,
+		}
+
+		var paid unit.Cents
+		if p, ok := payments[item.ReferenceID]; ok {
+			paid = p.paid
+			sellerPaidDate := p.sellerPaidDate
+			line.SellerPaidDate = &sellerPaidDate
+		}
+		line.PaidCents = &paid
+		line.VarianceCents = paid - billed
+		line.Status = varianceStatus(line.VarianceCents, tolerance)
+		lines = append(lines, line)
+	}
+
+	return append(lines, unmatched...)
+}
+
+// billedServiceItems returns the payment service items sent in the 858, which are the approved ones
+func billedServiceItems(paymentRequest models.PaymentRequest) models.PaymentServiceItems {
+	var items models.PaymentServiceItems
+	for _, item := range paymentRequest.PaymentServiceItems {
+		if item.Status == models.PaymentServiceItemStatusApproved && item.PriceCents != nil {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+func varianceStatus(variance unit.Cents, tolerance unit.Cents) services.TPPSReconciliationStatus {
+	switch {
+	case variance < -tolerance:
+		return services.TPPSReconciliationStatusUnderpaid
+	case variance > tolerance:
+		return services.TPPSReconciliationStatusOverpaid
+	default:
+		return services.TPPSReconciliationStatusMatched
+	}
+}
+
+func truncateToDate(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,207 @@
+package invoice
+
+import (
+	"time"
+
+	"github.com/gofrs/uuid"
+
+	"github.com/transcom/mymove/pkg/apperror"
+	"github.com/transcom/mymove/pkg/factory"
+	"github.com/transcom/mymove/pkg/models"
+	"github.com/transcom/mymove/pkg/services"
+	"github.com/transcom/mymove/pkg/unit"
+)
+
+func (suite *ProcessTPPSPaidInvoiceReportSuite) TestReconcileTPPSPayments() {
+	reconciler := NewTPPSReconciler()
+	paidDate := time.Date(2025, time.February, 10, 0, 0, 0, 0, time.UTC)
+
+	buildPaymentRequest := func(paymentRequestNumber string, status models.PaymentRequestStatus, sentToGexAt *time.Time) models.PaymentRequest {
+		return factory.BuildPaymentRequest(suite.DB(), []factory.Customization{
+			{
+				Model: models.PaymentRequest{
+					Status:               status,
+					PaymentRequestNumber: paymentRequestNumber,
+					SentToGexAt:          sentToGexAt,
+				},
+			},
+		}, nil)
+	}
+
+	buildServiceItem := func(paymentRequest models.PaymentRequest, referenceID string, priceCents unit.Cents, status models.PaymentServiceItemStatus) models.PaymentServiceItem {
+		return factory.BuildPaymentServiceItem(suite.DB(), []factory.Customization{
+			{
+				Model:    paymentRequest,
+				LinkOnly: true,
+			},
+			{
+				Model: models.PaymentServiceItem{
+					ReferenceID: referenceID,
+					PriceCents:  &priceCents,
+					Status:      status,
+				},
+			},
+		}, nil)
+	}
+
+	buildTPPSEntry := func(invoiceNumber string, poTCN string, lineNumber string, paidCents unit.Cents, sellerPaidDate time.Time) {
+		entry := models.TPPSPaidInvoiceReportEntry{
+			InvoiceNumber:      invoiceNumber,
+			SellerPaidDate:     sellerPaidDate,
+			LineDescription:    poTCN,
+			ProductDescription: "DLH",
+			LineBillingUnits:   1,
+			LineUnitPrice:      paidCents.ToMillicents(),
+			LineNetCharge:      paidCents.ToMillicents(),
+			POTCN:              poTCN,
+			LineNumber:         lineNumber,
+		}
+		suite.MustCreate(&entry)
+	}
+
+	suite.Run("flags underpayments, overpayments and unmatched lines", func() {
+		paymentRequest := buildPaymentRequest("1841-7267-3", models.PaymentRequestStatusPaid, &paidDate)
+		matched := buildServiceItem(paymentRequest, "1841-7267-a1b2c3d4", 10000, models.PaymentServiceItemStatusApproved)
+		buildServiceItem(paymentRequest, "1841-7267-b1b2c3d4", 20000, models.PaymentServiceItemStatusApproved)
+		buildServiceItem(paymentRequest, "1841-7267-c1b2c3d4", 5000, models.PaymentServiceItemStatusApproved)
+		// denied items were not billed
+		buildServiceItem(paymentRequest, "1841-7267-d1b2c3d4", 7000, models.PaymentServiceItemStatusDenied)
+
+		buildTPPSEntry(paymentRequest.PaymentRequestNumber, "1841-7267-a1b2c3d4", "1", 10000, paidDate)
+		buildTPPSEntry(paymentRequest.PaymentRequestNumber, "1841-7267-b1b2c3d4", "2", 15000, paidDate)
+		// a service item paid over two lines is compared as a whole
+		buildTPPSEntry(paymentRequest.PaymentRequestNumber, "1841-7267-c1b2c3d4", "3", 3000, paidDate)
+		buildTPPSEntry(paymentRequest.PaymentRequestNumber, "1841-7267-c1b2c3d4", "4", 2500, paidDate)
+		buildTPPSEntry(paymentRequest.PaymentRequestNumber, "1841-7267-e1b2c3d4", "5", 1000, paidDate)
+
+		report, err := reconciler.ReconcileTPPSPayments(suite.AppContextForTest(), services.TPPSReconciliationParams{
+			StartDate: paidDate,
+			EndDate:   paidDate,
+		})
+		suite.NoError(err)
+		suite.Len(report.Lines, 4)
+		suite.Equal(unit.Cents(35000), report.TotalBilledCents)
+		suite.Equal(unit.Cents(31500), report.TotalPaidCents)
+
+		suite.Equal("1841-7267-a1b2c3d4", report.Lines[0].ReferenceID)
+		suite.Equal(matched.ID, *report.Lines[0].PaymentServiceItemID)
+		suite.Equal(services.TPPSReconciliationStatusMatched, report.Lines[0].Status)
+
+		suite.Equal("1841-7267-b1b2c3d4", report.Lines[1].ReferenceID)
+		suite.Equal(unit.Cents(-5000), report.Lines[1].VarianceCents)
+		suite.Equal(services.TPPSReconciliationStatusUnderpaid, report.Lines[1].Status)
+
+		suite.Equal("1841-7267-c1b2c3d4", report.Lines[2].ReferenceID)
+		suite.Equal(unit.Cents(5500), *report.Lines[2].PaidCents)
+		suite.Equal(unit.Cents(500), report.Lines[2].VarianceCents)
+		suite.Equal(services.TPPSReconciliationStatusOverpaid, report.Lines[2].Status)
+
+		suite.Equal("1841-7267-e1b2c3d4", report.Lines[3].ReferenceID)
+		suite.Nil(report.Lines[3].BilledCents)
+		suite.Nil(report.Lines[3].PaymentServiceItemID)
+		suite.Equal(services.TPPSReconciliationStatusUnmatched, report.Lines[3].Status)
+
+		suite.Len(report.Variances(), 3)
+	})
+
+	suite.Run("variances within the tolerance are matched", func() {
+		paymentRequest := buildPaymentRequest("1841-7267-3", models.PaymentRequestStatusPaid, &paidDate)
+		buildServiceItem(paymentRequest, "1841-7267-a1b2c3d4", 10000, models.PaymentServiceItemStatusApproved)
+		buildTPPSEntry(paymentRequest.PaymentRequestNumber, "1841-7267-a1b2c3d4", "1", 9999, paidDate)
+
+		report, err := reconciler.ReconcileTPPSPayments(suite.AppContextForTest(), services.TPPSReconciliationParams{
+			StartDate:      paidDate,
+			EndDate:        paidDate,
+			ToleranceCents: 1,
+		})
+		suite.NoError(err)
+		suite.Len(report.Lines, 1)
+		suite.Equal(services.TPPSReconciliationStatusMatched, report.Lines[0].Status)
+		suite.Empty(report.Variances())
+	})
+
+	suite.Run("invoices sent to Syncada without a TPPS payment are unmatched", func() {
+		paymentRequest := buildPaymentRequest("9436-4123-3", models.PaymentRequestStatusSentToGex, &paidDate)
+		buildServiceItem(paymentRequest, "9436-4123-a1b2c3d4", 12345, models.PaymentServiceItemStatusApproved)
+
+		report, err := reconciler.ReconcileTPPSPayments(suite.AppContextForTest(), services.TPPSReconciliationParams{
+			StartDate: paidDate.AddDate(0, 0, -1),
+			EndDate:   paidDate,
+		})
+		suite.NoError(err)
+		suite.Len(report.Lines, 1)
+		suite.Equal(paymentRequest.ID, report.Lines[0].PaymentRequestID)
+		suite.Equal(unit.Cents(12345), *report.Lines[0].BilledCents)
+		suite.Nil(report.Lines[0].PaidCents)
+		suite.Equal(unit.Cents(-12345), report.Lines[0].VarianceCents)
+		suite.Equal(services.TPPSReconciliationStatusUnmatched, report.Lines[0].Status)
+	})
+
+	suite.Run("invoices without a TPPS payment can be excluded", func() {
+		paymentRequest := buildPaymentRequest("9436-4123-4", models.PaymentRequestStatusSentToGex, &paidDate)
+		buildServiceItem(paymentRequest, "9436-4123-b1b2c3d4", 12345, models.PaymentServiceItemStatusApproved)
+
+		report, err := reconciler.ReconcileTPPSPayments(suite.AppContextForTest(), services.TPPSReconciliationParams{
+			StartDate:             paidDate,
+			EndDate:               paidDate,
+			ExcludeUnpaidInvoices: true,
+		})
+		suite.NoError(err)
+		suite.Empty(report.Lines)
+	})
+
+	suite.Run("TPPS invoices without a payment request are unmatched", func() {
+		buildTPPSEntry("5555-0000-1", "5555-0000-a1b2c3d4", "1", 4200, paidDate)
+		buildTPPSEntry("5555-0000-1", "5555-0000-b1b2c3d4", "2", 800, paidDate)
+
+		report, err := reconciler.ReconcileTPPSPayments(suite.AppContextForTest(), services.TPPSReconciliationParams{
+			StartDate: paidDate,
+			EndDate:   paidDate,
+		})
+		suite.NoError(err)
+		suite.Len(report.Lines, 2)
+		suite.Equal(unit.Cents(0), report.TotalBilledCents)
+		suite.Equal(unit.Cents(5000), report.TotalPaidCents)
+		for _, line := range report.Lines {
+			suite.Equal("5555-0000-1", line.PaymentRequestNumber)
+			suite.Equal(uuid.Nil, line.PaymentRequestID)
+			suite.Nil(line.BilledCents)
+			suite.Equal(services.TPPSReconciliationStatusUnmatched, line.Status)
+		}
+		suite.Equal("5555-0000-a1b2c3d4", report.Lines[0].ReferenceID)
+		suite.Equal(unit.Cents(4200), report.Lines[0].VarianceCents)
+		suite.Len(report.Variances(), 2)
+	})
+
+	suite.Run("payments outside the dates are not reconciled", func() {
+		paymentRequest := buildPaymentRequest("1841-7267-3", models.PaymentRequestStatusPaid, &paidDate)
+		buildServiceItem(paymentRequest, "1841-7267-a1b2c3d4", 10000, models.PaymentServiceItemStatusApproved)
+		buildTPPSEntry(paymentRequest.PaymentRequestNumber, "1841-7267-a1b2c3d4", "1", 5000, paidDate)
+
+		report, err := reconciler.ReconcileTPPSPayments(suite.AppContextForTest(), services.TPPSReconciliationParams{
+			StartDate: paidDate.AddDate(0, 0, 1),
+			EndDate:   paidDate.AddDate(0, 0, 7),
+		})
+		suite.NoError(err)
+		suite.Empty(report.Lines)
+	})
+}
+
+func (suite *ProcessTPPSPaidInvoiceReportSuite) TestReconcileTPPSPaymentsValidation() {
+	reconciler := NewTPPSReconciler()
+	paidDate := time.Date(2025, time.February, 10, 0, 0, 0, 0, time.UTC)
+
+	testCases := map[string]services.TPPSReconciliationParams{
+		"missing dates":          {},
+		"end before start":       {StartDate: paidDate, EndDate: paidDate.AddDate(0, 0, -1)},
+		"negative tolerance":     {StartDate: paidDate, EndDate: paidDate, ToleranceCents: -1},
+		"missing the start date": {EndDate: paidDate},
+	}
+	for name, params := range testCases {
+		suite.Run(name, func() {
+			_, err := reconciler.ReconcileTPPSPayments(suite.AppContextForTest(), params)
+			suite.Error(err)
+			suite.IsType(apperror.InvalidInputError{}, err)
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package invoice
+
+import (
+	"os"
+
+	"go.uber.org/zap"
+
+	"github.com/transcom/mymove/pkg/appcontext"
+	"github.com/transcom/mymove/pkg/models"
+	"github.com/transcom/mymove/pkg/services"
+)
+
+// tppsSyncadaFileProcessor lets a TPPS paid invoice report fetched from Syncada be processed like an EDI response.
+// The TPPS processor parses reports from a local file, so the fetched report is written to a temporary file first.
+type tppsSyncadaFileProcessor struct {
+	tppsProcessor services.TPPSPaidInvoiceReportProcessor
+}
+
+// NewTPPSSyncadaFileProcessor returns a SyncadaFileProcessor for TPPS paid invoice reports
+func NewTPPSSyncadaFileProcessor(tppsProcessor services.TPPSPaidInvoiceReportProcessor) services.SyncadaFileProcessor {
+	return &tppsSyncadaFileProcessor{tppsProcessor}
+}
+
+// ProcessFile processes the TPPS paid invoice report text fetched from syncadaPath
+func (p *tppsSyncadaFileProcessor) ProcessFile(appCtx appcontext.AppContext, syncadaPath string, text string) error {
+	reportFile, err := os.CreateTemp("", "tpps-paid-invoice-report-*.csv")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if removeErr := os.Remove(reportFile.Name()); removeErr != nil {
+			appCtx.Logger().Error("could not remove temporary TPPS paid invoice report", zap.String("path", reportFile.Name()), zap.Error(removeErr))
+		}
+	}()
+
+	_, err = reportFile.WriteString(text)
+	if closeErr := reportFile.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+
+	appCtx.Logger().Info("Processing TPPS paid invoice report from Syncada", zap.String("syncadaPath", syncadaPath))
+	return p.tppsProcessor.ProcessFile(appCtx, reportFile.Name(), "")
+}
+
+// EDIType returns the type of file processed
+func (p *tppsSyncadaFileProcessor) EDIType() models.EDIType {
+	return models.TPPSPaidInvoiceReport
+}
@@ -13,6 +13,8 @@ import (
 type MoveLocker interface {
 	LockMove(appCtx appcontext.AppContext, move *models.Move, officeUserID uuid.UUID) (*models.Move, error)
 	LockMoves(appCtx appcontext.AppContext, moveIds []uuid.UUID, officeUserID uuid.UUID) error
+	RenewMoveLock(appCtx appcontext.AppContext, moveID uuid.UUID, officeUserID uuid.UUID) (*models.Move, error)
+	TakeOverMoveLock(appCtx appcontext.AppContext, moveID uuid.UUID, officeUserID uuid.UUID) (*models.Move, error)
 }
 
 // MoveUnlocker is the exported interface for unlocking moves
@@ -21,4 +23,12 @@ type MoveLocker interface {
 type MoveUnlocker interface {
 	UnlockMove(appCtx appcontext.AppContext, move *models.Move, officeUserID uuid.UUID) (*models.Move, error)
 	CheckForLockedMovesAndUnlock(appCtx appcontext.AppContext, officeUserID uuid.UUID) error
+	ExpireMoveLocks(appCtx appcontext.AppContext) (int, error)
+}
+
+// MoveLockChecker is the exported interface for checking that an office user may change moves
+//
+//go:generate mockery --name MoveLockChecker
+type MoveLockChecker interface {
+	CheckMoveLocks(appCtx appcontext.AppContext, moveIDs []uuid.UUID, officeUserID uuid.UUID) error
 }
@@ -0,0 +1,118 @@
+package lockmove
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/gofrs/uuid"
+
+	"github.com/transcom/mymove/pkg/appcontext"
+	"github.com/transcom/mymove/pkg/apperror"
+	"github.com/transcom/mymove/pkg/models"
+)
+
+// MoveLockDuration is how long a lock lasts after it is acquired or renewed. The office
+// UI renews the lock with a heartbeat while the move is open.
+const MoveLockDuration = 30 * time.Minute
+
+// moveLockState is the lock currently recorded on a move
+type moveLockState struct {
+	LockedBy      *uuid.UUID `db:"locked_by"`
+	LockExpiresAt *time.Time `db:"lock_expires_at"`
+}
+
+// activeHolder returns the office user holding an unexpired lock, if any
+func (s moveLockState) activeHolder(now time.Time) *uuid.UUID {
+	if s.LockedBy == nil || s.LockExpiresAt == nil || !now.Before(*s.LockExpiresAt) {
+		return nil
+	}
+	return s.LockedBy
+}
+
+// fetchMoveLockState reads the lock on a move and holds the row until the transaction
+// ends, so that two office users cannot acquire the lock at the same time
+func fetchMoveLockState(txnAppCtx appcontext.AppContext, moveID uuid.UUID) (moveLockState, error) {
+	var state moveLockState
+	err := txnAppCtx.DB().RawQuery("SELECT locked_by, lock_expires_at FROM moves WHERE id = ? FOR UPDATE", moveID).First(&state)
+	if err != nil {
+		switch err {
+		case sql.ErrNoRows:
+			return state, apperror.NewNotFoundError(moveID, "while looking for move to lock")
+		default:
+			return state, apperror.NewQueryError("Move", err, "")
+		}
+	}
+	return state, nil
+}
+
+// setMoveLock writes the lock columns without touching the move's updated_at
+func setMoveLock(txnAppCtx appcontext.AppContext, moveID uuid.UUID, officeUserID *uuid.UUID, expiresAt *time.Time) error {
+	err := txnAppCtx.DB().RawQuery("UPDATE moves SET locked_by=?, lock_expires_at=? WHERE id=?", officeUserID, expiresAt, moveID).Exec()
+	if err != nil {
+		return apperror.NewQueryError("Move", err, "")
+	}
+	return nil
+}
+
+// recordMoveLockEvent adds an entry to the lock audit trail of a move
+func recordMoveLockEvent(txnAppCtx appcontext.AppContext, moveID uuid.UUID, eventType models.MoveLockEventType, officeUserID *uuid.UUID, previousOfficeUserID *uuid.UUID, expiresAt *time.Time) error {
+	event := models.MoveLockEvent{
+		MoveID:               moveID,
+		EventType:            eventType,
+		OfficeUserID:         officeUserID,
+		PreviousOfficeUserID: previousOfficeUserID,
+		LockExpiresAt:        expiresAt,
+	}
+	verrs, err := txnAppCtx.DB().ValidateAndCreate(&event)
+	if verrs != nil && verrs.HasAny() {
+		return apperror.NewInvalidInputError(moveID, err, verrs, "invalid move lock event")
+	}
+	if err != nil {
+		return apperror.NewQueryError("MoveLockEvent", err, "")
+	}
+	return nil
+}
+
+// acquireMoveLock gives the office user the lock on the move, or extends the lock they
+// already hold. When takeOver is false it fails with a ConflictError if another office
+// user holds an unexpired lock; when it is true that lock is taken over and recorded as
+// stolen.
+func acquireMoveLock(appCtx appcontext.AppContext, moveID uuid.UUID, officeUserID uuid.UUID, takeOver bool) (time.Time, error) {
+	now := time.Now()
+	expiresAt := now.Add(MoveLockDuration)
+
+	err := appCtx.NewTransaction(func(txnAppCtx appcontext.AppContext) error {
+		state, err := fetchMoveLockState(txnAppCtx, moveID)
+		if err != nil {
+			return err
+		}
+
+		holder := state.activeHolder(now)
+		switch {
+		case holder != nil && *holder == officeUserID:
+			// Renewing our own lock is not audited, otherwise every heartbeat would be
+		case holder != nil:
+			if !takeOver {
+				return apperror.NewConflictError(moveID, "move is locked by another office user")
+			}
+			if err := recordMoveLockEvent(txnAppCtx, moveID, models.MoveLockEventTypeSTOLEN, &officeUserID, holder, &expiresAt); err != nil {
+				return err
+			}
+		default:
+			if state.LockedBy != nil {
+				if err := recordMoveLockEvent(txnAppCtx, moveID, models.MoveLockEventTypeEXPIRED, nil, state.LockedBy, state.LockExpiresAt); err != nil {
+					return err
+				}
+			}
+			if err := recordMoveLockEvent(txnAppCtx, moveID, models.MoveLockEventTypeLOCKED, &officeUserID, nil, &expiresAt); err != nil {
+				return err
+			}
+		}
+
+		return setMoveLock(txnAppCtx, moveID, &officeUserID, &expiresAt)
+	})
+	if err != nil {
+		return time.Time{}, err
+	}
+	return expiresAt, nil
+}
@@ -0,0 +1,42 @@
+package lockmove
+
+import (
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/lib/pq"
+
+	"github.com/transcom/mymove/pkg/appcontext"
+	"github.com/transcom/mymove/pkg/apperror"
+	"github.com/transcom/mymove/pkg/services"
+)
+
+type moveLockChecker struct {
+}
+
+// NewMoveLockChecker creates a new moveLockChecker service
+func NewMoveLockChecker() services.MoveLockChecker {
+	return &moveLockChecker{}
+}
+
+// CheckMoveLocks returns a ConflictError when any of the moves is locked by an office
+// user other than officeUserID. Expired locks do not block changes.
+func (c moveLockChecker) CheckMoveLocks(appCtx appcontext.AppContext, moveIDs []uuid.UUID, officeUserID uuid.UUID) error {
+	if len(moveIDs) == 0 {
+		return nil
+	}
+
+	var lockedMoveIDs []uuid.UUID
+	err := appCtx.DB().RawQuery(
+		"SELECT id FROM moves WHERE id = ANY(?) AND locked_by IS NOT NULL AND locked_by <> ? AND lock_expires_at > ?",
+		pq.Array(moveIDs), officeUserID, time.Now(),
+	).All(&lockedMoveIDs)
+	if err != nil {
+		return apperror.NewQueryError("Move", err, "could not check move locks")
+	}
+
+	if len(lockedMoveIDs) > 0 {
+		return apperror.NewConflictError(lockedMoveIDs[0], "move is locked by another office user")
+	}
+	return nil
+}
@@ -0,0 +1,56 @@
+package lockmove
+
+import (
+	"time"
+
+	"github.com/gofrs/uuid"
+
+	"github.com/transcom/mymove/pkg/apperror"
+	"github.com/transcom/mymove/pkg/factory"
+	"github.com/transcom/mymove/pkg/models"
+	"github.com/transcom/mymove/pkg/models/roles"
+)
+
+func (suite *MoveLockerServiceSuite) TestCheckMoveLocks() {
+	checker := NewMoveLockChecker()
+
+	holder := factory.BuildOfficeUserWithRoles(suite.DB(), nil, []roles.RoleType{roles.RoleTypeTOO})
+	other := factory.BuildOfficeUserWithRoles(suite.DB(), nil, []roles.RoleType{roles.RoleTypeTOO})
+	expiresAt := time.Now().Add(time.Minute)
+	expiredAt := time.Now().Add(-time.Minute)
+	lockedMove := factory.BuildMove(suite.DB(), []factory.Customization{
+		{
+			Model: models.Move{
+				LockedByOfficeUserID: &holder.ID,
+				LockExpiresAt:        &expiresAt,
+			},
+		},
+	}, nil)
+	expiredMove := factory.BuildMove(suite.DB(), []factory.Customization{
+		{
+			Model: models.Move{
+				LockedByOfficeUserID: &holder.ID,
+				LockExpiresAt:        &expiredAt,
+			},
+		},
+	}, nil)
+	unlockedMove := factory.BuildMove(suite.DB(), nil, nil)
+
+	suite.Run("the lock holder may change the move", func() {
+		err := checker.CheckMoveLocks(suite.AppContextForTest(), []uuid.UUID{lockedMove.ID}, holder.ID)
+		suite.NoError(err)
+	})
+
+	suite.Run("other office users may not change a locked move", func() {
+		err := checker.CheckMoveLocks(suite.AppContextForTest(), []uuid.UUID{unlockedMove.ID, lockedMove.ID}, other.ID)
+		suite.IsType(apperror.ConflictError{}, err)
+	})
+
+	suite.Run("expired and missing locks do not block changes", func() {
+		err := checker.CheckMoveLocks(suite.AppContextForTest(), []uuid.UUID{unlockedMove.ID, expiredMove.ID}, other.ID)
+		suite.NoError(err)
+
+		err = checker.CheckMoveLocks(suite.AppContextForTest(), nil, other.ID)
+		suite.NoError(err)
+	})
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/transcom/mymove/pkg/appcontext"
 	"github.com/transcom/mymove/pkg/apperror"
 	"github.com/transcom/mymove/pkg/models"
+	"github.com/transcom/mymove/pkg/models/roles"
 	"github.com/transcom/mymove/pkg/services"
 )
 
@@ -21,6 +22,7 @@ func NewMoveLocker() services.MoveLocker {
 }
 
 // LockMove updates a move with relevant values of who has a move locked and the expiration of the lock pending it isn't unlocked before then
+// a ConflictError is returned when another office user holds an unexpired lock on the move
 func (m moveLocker) LockMove(appCtx appcontext.AppContext, move *models.Move, officeUserID uuid.UUID) (*models.Move, error) {
 
 	if officeUserID == uuid.Nil {
@@ -47,10 +49,16 @@ func (m moveLocker) LockMove(appCtx appcontext.AppContext, move *models.Move, of
 		return nil, err
 	}
 
-	if move.LockedByOfficeUserID != models.UUIDPointer(officeUserID) {
-		move.LockedByOfficeUserID = models.UUIDPointer(officeUserID)
+	// the lock will have a default expiration time of 30 minutes from initial opening
+	// this will reset with heartbeats from the office UI
+	expirationTime, err := acquireMoveLock(appCtx, move.ID, officeUserID, false)
+	if err != nil {
+		return nil, err
 	}
 
+	move.LockedByOfficeUserID = models.UUIDPointer(officeUserID)
+	move.LockExpiresAt = &expirationTime
+
 	if officeUser != nil {
 		move.LockedByOfficeUser = officeUser
 	}
@@ -59,27 +67,6 @@ func (m moveLocker) LockMove(appCtx appcontext.AppContext, move *models.Move, of
 		move.LockedByOfficeUser.TransportationOffice = transportationOffice
 	}
 
-	// the lock will have a default expiration time of 30 minutes from initial opening
-	// this will reset with valid user activity
-	now := time.Now()
-	expirationTime := now.Add(30 * time.Minute)
-	move.LockExpiresAt = &expirationTime
-
-	// Store move before update
-	var moveBeforeUpdate = *move
-
-	transactionError := appCtx.NewTransaction(func(txnAppCtx appcontext.AppContext) error {
-		if err := appCtx.DB().RawQuery("UPDATE moves SET locked_by=?, lock_expires_at=?, updated_at=? WHERE id=?", officeUserID, expirationTime, moveBeforeUpdate.UpdatedAt, move.ID).Exec(); err != nil {
-			return err
-		}
-
-		return nil
-	})
-
-	if transactionError != nil {
-		return nil, transactionError
-	}
-
 	return move, nil
 }
 
@@ -97,16 +84,22 @@ func (m moveLocker) LockMoves(appCtx appcontext.AppContext, moveIds []uuid.UUID,
 	}
 
 	now := time.Now()
-	expirationTime := now.Add(30 * time.Minute)
+	expirationTime := now.Add(MoveLockDuration)
 
 	transactionError := appCtx.NewTransaction(func(txnAppCtx appcontext.AppContext) error {
-		if err := appCtx.DB().RawQuery(
+		if err := txnAppCtx.DB().RawQuery(
 			"UPDATE moves SET locked_by=?, lock_expires_at=? WHERE id=ANY(?)",
 			officeUser.ID, expirationTime, pq.Array(moveIds),
 		).Exec(); err != nil {
 			return err
 		}
 
+		for _, moveID := range moveIds {
+			if err := recordMoveLockEvent(txnAppCtx, moveID, models.MoveLockEventTypeLOCKED, &officeUser.ID, nil, &expirationTime); err != nil {
+				return err
+			}
+		}
+
 		return nil
 	})
 
@@ -116,3 +109,49 @@ func (m moveLocker) LockMoves(appCtx appcontext.AppContext, moveIds []uuid.UUID,
 
 	return nil
 }
+
+// RenewMoveLock extends the lock the office user holds on a move. It is called by the
+// office UI as a heartbeat while the move is open.
+func (m moveLocker) RenewMoveLock(appCtx appcontext.AppContext, moveID uuid.UUID, officeUserID uuid.UUID) (*models.Move, error) {
+	if officeUserID == uuid.Nil {
+		return nil, apperror.NewQueryError("OfficeUserID", nil, "No office user provided in request to renew move lock")
+	}
+
+	if _, err := acquireMoveLock(appCtx, moveID, officeUserID, false); err != nil {
+		return nil, err
+	}
+
+	return fetchLockedMove(appCtx, moveID)
+}
+
+// TakeOverMoveLock gives a supervisor the lock on a move even when another office user
+// holds it. The previous holder is recorded in the lock audit trail.
+func (m moveLocker) TakeOverMoveLock(appCtx appcontext.AppContext, moveID uuid.UUID, officeUserID uuid.UUID) (*models.Move, error) {
+	if officeUserID == uuid.Nil {
+		return nil, apperror.NewQueryError("OfficeUserID", nil, "No office user provided in request to take over move lock")
+	}
+
+	privileges, err := roles.FetchPrivilegesForUser(appCtx.DB(), appCtx.Session().UserID)
+	if err != nil {
+		return nil, apperror.NewQueryError("UsersPrivileges", err, "")
+	}
+	if !privileges.HasPrivilege(roles.PrivilegeTypeSupervisor) {
+		return nil, apperror.NewForbiddenError("only supervisors may take over the lock on a move")
+	}
+
+	if _, err := acquireMoveLock(appCtx, moveID, officeUserID, true); err != nil {
+		return nil, err
+	}
+
+	return fetchLockedMove(appCtx, moveID)
+}
+
+// fetchLockedMove returns the move along with the office user holding its lock
+func fetchLockedMove(appCtx appcontext.AppContext, moveID uuid.UUID) (*models.Move, error) {
+	var move models.Move
+	err := appCtx.DB().EagerPreload("LockedByOfficeUser", "LockedByOfficeUser.TransportationOffice").Find(&move, moveID)
+	if err != nil {
+		return nil, apperror.NewQueryError("Move", err, "")
+	}
+	return &move, nil
+}
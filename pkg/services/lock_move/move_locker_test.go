@@ -5,9 +5,11 @@ import (
 
 	"github.com/gofrs/uuid"
 
+	"github.com/transcom/mymove/pkg/apperror"
 	"github.com/transcom/mymove/pkg/auth"
 	"github.com/transcom/mymove/pkg/factory"
 	"github.com/transcom/mymove/pkg/gen/ghcmessages"
+	"github.com/transcom/mymove/pkg/models"
 	"github.com/transcom/mymove/pkg/models/roles"
 	movefetcher "github.com/transcom/mymove/pkg/services/move"
 )
@@ -162,3 +164,162 @@ func (suite *MoveLockerServiceSuite) TestLockMoves() {
 		}
 	})
 }
+
+func (suite *MoveLockerServiceSuite) TestLockMoveHeldByAnotherUser() {
+	moveLocker := NewMoveLocker()
+
+	suite.Run("returns a conflict when another office user holds an unexpired lock", func() {
+		holder := factory.BuildOfficeUserWithRoles(suite.DB(), nil, []roles.RoleType{roles.RoleTypeTOO})
+		other := factory.BuildOfficeUserWithRoles(suite.DB(), nil, []roles.RoleType{roles.RoleTypeTOO})
+		move := factory.BuildMove(suite.DB(), nil, nil)
+
+		_, err := moveLocker.LockMove(suite.AppContextForTest(), &move, holder.ID)
+		suite.FatalNoError(err)
+
+		moveCopy := move
+		_, err = moveLocker.LockMove(suite.AppContextForTest(), &moveCopy, other.ID)
+		suite.Error(err)
+		suite.IsType(apperror.ConflictError{}, err)
+
+		var moveInDB models.Move
+		suite.NoError(suite.DB().Find(&moveInDB, move.ID))
+		suite.Equal(&holder.ID, moveInDB.LockedByOfficeUserID)
+	})
+
+	suite.Run("takes an expired lock and records the expiry", func() {
+		holder := factory.BuildOfficeUserWithRoles(suite.DB(), nil, []roles.RoleType{roles.RoleTypeTOO})
+		other := factory.BuildOfficeUserWithRoles(suite.DB(), nil, []roles.RoleType{roles.RoleTypeTOO})
+		expiredAt := time.Now().Add(-time.Minute)
+		move := factory.BuildMove(suite.DB(), []factory.Customization{
+			{
+				Model: models.Move{
+					LockedByOfficeUserID: &holder.ID,
+					LockExpiresAt:        &expiredAt,
+				},
+			},
+		}, nil)
+
+		lockedMove, err := moveLocker.LockMove(suite.AppContextForTest(), &move, other.ID)
+		suite.FatalNoError(err)
+		suite.Equal(&other.ID, lockedMove.LockedByOfficeUserID)
+
+		events, err := models.FetchMoveLockEvents(suite.DB(), move.ID)
+		suite.NoError(err)
+		suite.Len(events, 2)
+		suite.Equal(models.MoveLockEventTypeEXPIRED, events[0].EventType)
+		suite.Equal(&holder.ID, events[0].PreviousOfficeUserID)
+		suite.Equal(models.MoveLockEventTypeLOCKED, events[1].EventType)
+		suite.Equal(&other.ID, events[1].OfficeUserID)
+	})
+}
+
+func (suite *MoveLockerServiceSuite) TestRenewMoveLock() {
+	moveLocker := NewMoveLocker()
+
+	suite.Run("extends the lock without adding to the audit trail", func() {
+		tooUser := factory.BuildOfficeUserWithRoles(suite.DB(), nil, []roles.RoleType{roles.RoleTypeTOO})
+		soonExpiresAt := time.Now().Add(time.Minute)
+		move := factory.BuildMove(suite.DB(), []factory.Customization{
+			{
+				Model: models.Move{
+					LockedByOfficeUserID: &tooUser.ID,
+					LockExpiresAt:        &soonExpiresAt,
+				},
+			},
+		}, nil)
+
+		renewedMove, err := moveLocker.RenewMoveLock(suite.AppContextForTest(), move.ID, tooUser.ID)
+		suite.FatalNoError(err)
+		suite.Equal(&tooUser.ID, renewedMove.LockedByOfficeUserID)
+		suite.True(renewedMove.LockExpiresAt.After(time.Now().Add(MoveLockDuration - time.Minute)))
+
+		events, err := models.FetchMoveLockEvents(suite.DB(), move.ID)
+		suite.NoError(err)
+		suite.Len(events, 0)
+	})
+
+	suite.Run("returns a conflict when another office user holds the lock", func() {
+		holder := factory.BuildOfficeUserWithRoles(suite.DB(), nil, []roles.RoleType{roles.RoleTypeTOO})
+		other := factory.BuildOfficeUserWithRoles(suite.DB(), nil, []roles.RoleType{roles.RoleTypeTOO})
+		move := factory.BuildMove(suite.DB(), nil, nil)
+
+		_, err := moveLocker.LockMove(suite.AppContextForTest(), &move, holder.ID)
+		suite.FatalNoError(err)
+
+		_, err = moveLocker.RenewMoveLock(suite.AppContextForTest(), move.ID, other.ID)
+		suite.IsType(apperror.ConflictError{}, err)
+	})
+
+	suite.Run("returns not found for a move that does not exist", func() {
+		tooUser := factory.BuildOfficeUserWithRoles(suite.DB(), nil, []roles.RoleType{roles.RoleTypeTOO})
+
+		_, err := moveLocker.RenewMoveLock(suite.AppContextForTest(), uuid.Must(uuid.NewV4()), tooUser.ID)
+		suite.IsType(apperror.NotFoundError{}, err)
+	})
+}
+
+func (suite *MoveLockerServiceSuite) TestTakeOverMoveLock() {
+	moveLocker := NewMoveLocker()
+
+	suite.Run("supervisors take over the lock and the steal is recorded", func() {
+		holder := factory.BuildOfficeUserWithRoles(suite.DB(), nil, []roles.RoleType{roles.RoleTypeTOO})
+		supervisor := factory.BuildOfficeUserWithPrivileges(suite.DB(), []factory.Customization{
+			{
+				Model: models.User{
+					Privileges: []roles.Privilege{
+						{
+							PrivilegeType: roles.PrivilegeTypeSupervisor,
+						},
+					},
+					Roles: []roles.Role{
+						{
+							RoleType: roles.RoleTypeTOO,
+						},
+					},
+				},
+			},
+		}, nil)
+		move := factory.BuildMove(suite.DB(), nil, nil)
+
+		_, err := moveLocker.LockMove(suite.AppContextForTest(), &move, holder.ID)
+		suite.FatalNoError(err)
+
+		appCtx := suite.AppContextWithSessionForTest(&auth.Session{
+			ApplicationName: auth.OfficeApp,
+			UserID:          *supervisor.UserID,
+			OfficeUserID:    supervisor.ID,
+		})
+		lockedMove, err := moveLocker.TakeOverMoveLock(appCtx, move.ID, supervisor.ID)
+		suite.FatalNoError(err)
+		suite.Equal(&supervisor.ID, lockedMove.LockedByOfficeUserID)
+
+		events, err := models.FetchMoveLockEvents(suite.DB(), move.ID)
+		suite.NoError(err)
+		suite.Len(events, 2)
+		suite.Equal(models.MoveLockEventTypeLOCKED, events[0].EventType)
+		suite.Equal(models.MoveLockEventTypeSTOLEN, events[1].EventType)
+		suite.Equal(&supervisor.ID, events[1].OfficeUserID)
+		suite.Equal(&holder.ID, events[1].PreviousOfficeUserID)
+	})
+
+	suite.Run("office users without the supervisor privilege cannot take over a lock", func() {
+		holder := factory.BuildOfficeUserWithRoles(suite.DB(), nil, []roles.RoleType{roles.RoleTypeTOO})
+		other := factory.BuildOfficeUserWithRoles(suite.DB(), nil, []roles.RoleType{roles.RoleTypeTOO})
+		move := factory.BuildMove(suite.DB(), nil, nil)
+
+		_, err := moveLocker.LockMove(suite.AppContextForTest(), &move, holder.ID)
+		suite.FatalNoError(err)
+
+		appCtx := suite.AppContextWithSessionForTest(&auth.Session{
+			ApplicationName: auth.OfficeApp,
+			UserID:          *other.UserID,
+			OfficeUserID:    other.ID,
+		})
+		_, err = moveLocker.TakeOverMoveLock(appCtx, move.ID, other.ID)
+		suite.IsType(apperror.ForbiddenError{}, err)
+
+		var moveInDB models.Move
+		suite.NoError(suite.DB().Find(&moveInDB, move.ID))
+		suite.Equal(&holder.ID, moveInDB.LockedByOfficeUserID)
+	})
+}
@@ -1,6 +1,8 @@
 package lockmove
 
 import (
+	"time"
+
 	"github.com/gofrs/uuid"
 
 	"github.com/transcom/mymove/pkg/appcontext"
@@ -29,6 +31,8 @@ func (m moveUnlocker) UnlockMove(appCtx appcontext.AppContext, move *models.Move
 		return nil, apperror.NewQueryError("OfficeUserID", nil, "No office user provided in request to unlock move")
 	}
 
+	wasLocked := move.LockedByOfficeUserID != nil
+
 	// nil out all of the columns since the office user is no longer in the move
 	if move.LockExpiresAt != nil {
 		move.LockExpiresAt = nil
@@ -46,10 +50,13 @@ func (m moveUnlocker) UnlockMove(appCtx appcontext.AppContext, move *models.Move
 	var moveBeforeUpdate = *move
 
 	transactionError := appCtx.NewTransaction(func(txnAppCtx appcontext.AppContext) error {
-		if err := appCtx.DB().RawQuery("UPDATE moves SET locked_by=?, lock_expires_at=?, updated_at=? WHERE id=?", nil, nil, moveBeforeUpdate.UpdatedAt, move.ID).Exec(); err != nil {
+		if err := txnAppCtx.DB().RawQuery("UPDATE moves SET locked_by=?, lock_expires_at=?, updated_at=? WHERE id=?", nil, nil, moveBeforeUpdate.UpdatedAt, move.ID).Exec(); err != nil {
 			return err
 		}
 
+		if wasLocked {
+			return recordMoveLockEvent(txnAppCtx, move.ID, models.MoveLockEventTypeRELEASED, &officeUserID, nil, nil)
+		}
 		return nil
 	})
 
@@ -68,6 +75,11 @@ func (m moveUnlocker) CheckForLockedMovesAndUnlock(appCtx appcontext.AppContext,
 		return apperror.NewQueryError("OfficeUserID", nil, "No office user provided in request to unlock move")
 	}
 
+	// clear any locks that were abandoned without being released while we're here
+	if _, err := m.ExpireMoveLocks(appCtx); err != nil {
+		return err
+	}
+
 	// get all moves where locked_by matches officeUserID
 	var moves []models.Move
 	query := appCtx.DB().Where("locked_by = ?", officeUserID)
@@ -95,3 +107,33 @@ func (m moveUnlocker) CheckForLockedMovesAndUnlock(appCtx appcontext.AppContext,
 
 	return err
 }
+
+// ExpireMoveLocks clears every lock that was not renewed before it expired and records
+// the expiry in the lock audit trail. It returns the number of locks cleared.
+func (m moveUnlocker) ExpireMoveLocks(appCtx appcontext.AppContext) (int, error) {
+	// SKIP LOCKED leaves moves that are having their lock changed right now to the
+	// next sweep rather than waiting on them
+	query := `
+		WITH expired AS (
+			SELECT id, locked_by, lock_expires_at
+			FROM moves
+			WHERE locked_by IS NOT NULL AND lock_expires_at < ?
+			FOR UPDATE SKIP LOCKED
+		), cleared AS (
+			UPDATE moves
+			SET locked_by = NULL, lock_expires_at = NULL
+			FROM expired
+			WHERE moves.id = expired.id
+			RETURNING expired.id, expired.locked_by, expired.lock_expires_at
+		)
+		INSERT INTO move_lock_events (id, move_id, event_type, previous_office_user_id, lock_expires_at, created_at)
+		SELECT uuid_generate_v4(), id, ?, locked_by, lock_expires_at, ?
+		FROM cleared`
+
+	now := time.Now()
+	count, err := appCtx.DB().RawQuery(query, now, models.MoveLockEventTypeEXPIRED, now).ExecWithCount()
+	if err != nil {
+		return 0, apperror.NewQueryError("Move", err, "could not expire move locks")
+	}
+	return count, nil
+}
@@ -1,6 +1,8 @@
 package lockmove
 
 import (
+	"time"
+
 	"github.com/transcom/mymove/pkg/auth"
 	"github.com/transcom/mymove/pkg/factory"
 	"github.com/transcom/mymove/pkg/models"
@@ -134,3 +136,65 @@ func (suite *MoveLockerServiceSuite) TestCheckForLockedMovesAndUnlock() {
 		suite.Equal(unlockedMove.UpdatedAt, move.UpdatedAt)
 	})
 }
+
+func (suite *MoveLockerServiceSuite) TestUnlockMoveRecordsRelease() {
+	moveLocker := NewMoveLocker()
+	moveUnlocker := NewMoveUnlocker()
+
+	tooUser := factory.BuildOfficeUserWithRoles(suite.DB(), nil, []roles.RoleType{roles.RoleTypeTOO})
+	move := factory.BuildMove(suite.DB(), nil, nil)
+
+	lockedMove, err := moveLocker.LockMove(suite.AppContextForTest(), &move, tooUser.ID)
+	suite.FatalNoError(err)
+	_, err = moveUnlocker.UnlockMove(suite.AppContextForTest(), lockedMove, tooUser.ID)
+	suite.FatalNoError(err)
+
+	events, err := models.FetchMoveLockEvents(suite.DB(), move.ID)
+	suite.NoError(err)
+	suite.Len(events, 2)
+	suite.Equal(models.MoveLockEventTypeLOCKED, events[0].EventType)
+	suite.Equal(models.MoveLockEventTypeRELEASED, events[1].EventType)
+	suite.Equal(&tooUser.ID, events[1].OfficeUserID)
+}
+
+func (suite *MoveLockerServiceSuite) TestExpireMoveLocks() {
+	moveUnlocker := NewMoveUnlocker()
+
+	tooUser := factory.BuildOfficeUserWithRoles(suite.DB(), nil, []roles.RoleType{roles.RoleTypeTOO})
+	expiredAt := time.Now().Add(-time.Minute)
+	expiresAt := time.Now().Add(time.Minute)
+	expiredMove := factory.BuildMove(suite.DB(), []factory.Customization{
+		{
+			Model: models.Move{
+				LockedByOfficeUserID: &tooUser.ID,
+				LockExpiresAt:        &expiredAt,
+			},
+		},
+	}, nil)
+	lockedMove := factory.BuildMove(suite.DB(), []factory.Customization{
+		{
+			Model: models.Move{
+				LockedByOfficeUserID: &tooUser.ID,
+				LockExpiresAt:        &expiresAt,
+			},
+		},
+	}, nil)
+
+	count, err := moveUnlocker.ExpireMoveLocks(suite.AppContextForTest())
+	suite.FatalNoError(err)
+	suite.Equal(1, count)
+
+	var moveInDB models.Move
+	suite.NoError(suite.DB().Find(&moveInDB, expiredMove.ID))
+	suite.Nil(moveInDB.LockedByOfficeUserID)
+	suite.Nil(moveInDB.LockExpiresAt)
+
+	suite.NoError(suite.DB().Find(&moveInDB, lockedMove.ID))
+	suite.Equal(&tooUser.ID, moveInDB.LockedByOfficeUserID)
+
+	events, err := models.FetchMoveLockEvents(suite.DB(), expiredMove.ID)
+	suite.NoError(err)
+	suite.Len(events, 1)
+	suite.Equal(models.MoveLockEventTypeEXPIRED, events[0].EventType)
+	suite.Equal(&tooUser.ID, events[0].PreviousOfficeUserID)
+}
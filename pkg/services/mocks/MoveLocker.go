@@ -64,6 +64,66 @@ func (_m *MoveLocker) LockMoves(appCtx appcontext.AppContext, moveIds []uuid.UUI
 	return r0
 }
 
+// RenewMoveLock provides a mock function with given fields: appCtx, moveID, officeUserID
+func (_m *MoveLocker) RenewMoveLock(appCtx appcontext.AppContext, moveID uuid.UUID, officeUserID uuid.UUID) (*models.Move, error) {
+	ret := _m.Called(appCtx, moveID, officeUserID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RenewMoveLock")
+	}
+
+	var r0 *models.Move
+	var r1 error
+	if rf, ok := ret.Get(0).(func(appcontext.AppContext, uuid.UUID, uuid.UUID) (*models.Move, error)); ok {
+		return rf(appCtx, moveID, officeUserID)
+	}
+	if rf, ok := ret.Get(0).(func(appcontext.AppContext, uuid.UUID, uuid.UUID) *models.Move); ok {
+		r0 = rf(appCtx, moveID, officeUserID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Move)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(appcontext.AppContext, uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(appCtx, moveID, officeUserID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TakeOverMoveLock provides a mock function with given fields: appCtx, moveID, officeUserID
+func (_m *MoveLocker) TakeOverMoveLock(appCtx appcontext.AppContext, moveID uuid.UUID, officeUserID uuid.UUID) (*models.Move, error) {
+	ret := _m.Called(appCtx, moveID, officeUserID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TakeOverMoveLock")
+	}
+
+	var r0 *models.Move
+	var r1 error
+	if rf, ok := ret.Get(0).(func(appcontext.AppContext, uuid.UUID, uuid.UUID) (*models.Move, error)); ok {
+		return rf(appCtx, moveID, officeUserID)
+	}
+	if rf, ok := ret.Get(0).(func(appcontext.AppContext, uuid.UUID, uuid.UUID) *models.Move); ok {
+		r0 = rf(appCtx, moveID, officeUserID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Move)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(appcontext.AppContext, uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(appCtx, moveID, officeUserID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // NewMoveLocker creates a new instance of MoveLocker. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMoveLocker(t interface {
@@ -34,6 +34,34 @@ func (_m *MoveUnlocker) CheckForLockedMovesAndUnlock(appCtx appcontext.AppContex
 	return r0
 }
 
+// ExpireMoveLocks provides a mock function with given fields: appCtx
+func (_m *MoveUnlocker) ExpireMoveLocks(appCtx appcontext.AppContext) (int, error) {
+	ret := _m.Called(appCtx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExpireMoveLocks")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(appcontext.AppContext) (int, error)); ok {
+		return rf(appCtx)
+	}
+	if rf, ok := ret.Get(0).(func(appcontext.AppContext) int); ok {
+		r0 = rf(appCtx)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(appcontext.AppContext) error); ok {
+		r1 = rf(appCtx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UnlockMove provides a mock function with given fields: appCtx, move, officeUserID
 func (_m *MoveUnlocker) UnlockMove(appCtx appcontext.AppContext, move *models.Move, officeUserID uuid.UUID) (*models.Move, error) {
 	ret := _m.Called(appCtx, move, officeUserID)
@@ -10,6 +10,7 @@ import (
 	"github.com/transcom/mymove/pkg/appcontext"
 	"github.com/transcom/mymove/pkg/gen/ghcmessages"
 	"github.com/transcom/mymove/pkg/models"
+	"github.com/transcom/mymove/pkg/models/roles"
 	"github.com/transcom/mymove/pkg/storage"
 )
 
@@ -147,3 +148,10 @@ type CheckForLockedMovesAndUnlockHandler interface {
 type MoveAssigner interface {
 	BulkMoveAssignment(appCtx appcontext.AppContext, queueType string, officeUserData []*ghcmessages.BulkAssignmentForUser, movesToAssign models.Moves) (*models.Moves, error)
 }
+
+// MoveAccessChecker is the exported interface for checking that an office user may see moves
+//
+//go:generate mockery --name MoveAccessChecker
+type MoveAccessChecker interface {
+	CheckMoveAccess(appCtx appcontext.AppContext, moveIDs []uuid.UUID, officeUserID uuid.UUID, roleType roles.RoleType) error
+}
@@ -0,0 +1,128 @@
+package move
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/gofrs/uuid"
+	"github.com/lib/pq"
+	"go.uber.org/zap"
+
+	"github.com/transcom/mymove/pkg/appcontext"
+	"github.com/transcom/mymove/pkg/apperror"
+	"github.com/transcom/mymove/pkg/models"
+	"github.com/transcom/mymove/pkg/models/roles"
+	"github.com/transcom/mymove/pkg/services"
+)
+
+// moveAccessAttributes are the attributes of a move that decide which office users may see it. They
+// are only fetched to log a denial; office_user_can_see_move makes the decision.
+type moveAccessAttributes struct {
+	MoveID             uuid.UUID      `db:"move_id"`
+	Gblocs             pq.StringArray `db:"gblocs"`
+	CounselingOfficeID *uuid.UUID     `db:"counseling_office_id"`
+	CloseoutOfficeID   *uuid.UUID     `db:"closeout_office_id"`
+	Affiliation        *string        `db:"affiliation"`
+}
+
+// officeAccessAttributes are the transportation offices an office user works for
+type officeAccessAttributes struct {
+	ID    uuid.UUID `db:"id"`
+	Gbloc string    `db:"gbloc"`
+}
+
+// The GBLOCs of a move are those of the origin duty location, the destination duty location, the
+// first shipment's pickup address, the shipment destinations and the closeout office.
+const moveAccessAttributesQuery = `
+	SELECT
+		moves.id AS move_id,
+		array_remove(
+			ARRAY[orders.gbloc::text, orders.destination_gbloc::text, closeout_to.gbloc::text, move_to_gbloc.gbloc::text]
+				|| ARRAY(SELECT move_to_dest_gbloc.gbloc::text FROM move_to_dest_gbloc WHERE move_to_dest_gbloc.move_id = moves.id),
+			NULL) AS gblocs,
+		moves.counseling_transportation_office_id AS counseling_office_id,
+		moves.closeout_office_id,
+		service_members.affiliation
+	FROM moves
+	JOIN orders ON orders.id = moves.orders_id
+	JOIN service_members ON service_members.id = orders.service_member_id
+	LEFT JOIN move_to_gbloc ON move_to_gbloc.move_id = moves.id
+	LEFT JOIN transportation_offices closeout_to ON closeout_to.id = moves.closeout_office_id
+	WHERE moves.id = ?`
+
+const deniedMovesQuery = `
+	SELECT moves.id
+	FROM moves
+	WHERE moves.id = ANY(?) AND NOT office_user_can_see_move(?, moves.id)`
+
+const officeAccessAttributesQuery = `
+	SELECT transportation_offices.id, transportation_offices.gbloc
+	FROM transportation_offices
+	WHERE transportation_offices.id IN (
+		SELECT transportation_office_id FROM office_users WHERE id = ?
+		UNION
+		SELECT transportation_office_id FROM transportation_office_assignments WHERE id = ?
+	)`
+
+type moveAccessChecker struct {
+}
+
+// NewMoveAccessChecker creates a new moveAccessChecker service
+func NewMoveAccessChecker() services.MoveAccessChecker {
+	return &moveAccessChecker{}
+}
+
+// CheckMoveAccess returns a ForbiddenError when the office user, acting in roleType, may not see one
+// of the moves. Office users in national roles see every move. Everyone else sees the moves in the
+// GBLOCs of their transportation offices, moves counseled or closed out by those offices and moves
+// assigned to them.
+func (c moveAccessChecker) CheckMoveAccess(appCtx appcontext.AppContext, moveIDs []uuid.UUID, officeUserID uuid.UUID, roleType roles.RoleType) error {
+	if len(moveIDs) == 0 || slices.Contains(models.MoveAccessNationalRoles, roleType) {
+		return nil
+	}
+
+	var deniedMoveIDs []uuid.UUID
+	err := appCtx.DB().RawQuery(deniedMovesQuery, pq.Array(moveIDs), officeUserID).All(&deniedMoveIDs)
+	if err != nil {
+		return apperror.NewQueryError("Move", err, "could not check move access")
+	}
+	if len(deniedMoveIDs) == 0 {
+		return nil
+	}
+
+	var move moveAccessAttributes
+	err = appCtx.DB().RawQuery(moveAccessAttributesQuery, deniedMoveIDs[0]).First(&move)
+	if err != nil {
+		return apperror.NewQueryError("Move", err, "could not fetch move access attributes")
+	}
+
+	var offices []officeAccessAttributes
+	err = appCtx.DB().RawQuery(officeAccessAttributesQuery, officeUserID, officeUserID).All(&offices)
+	if err != nil {
+		return apperror.NewQueryError("TransportationOffice", err, "could not fetch office user's transportation offices")
+	}
+
+	officeGblocs := make([]string, len(offices))
+	for i, office := range offices {
+		officeGblocs[i] = office.Gbloc
+	}
+	appCtx.Logger().Warn("Denied office user access to a move outside their area",
+		zap.String("officeUserID", officeUserID.String()),
+		zap.String("roleType", string(roleType)),
+		zap.Strings("officeGblocs", officeGblocs),
+		zap.String("moveID", move.MoveID.String()),
+		zap.Strings("moveGblocs", move.Gblocs),
+		zap.Stringp("counselingOfficeID", uuidString(move.CounselingOfficeID)),
+		zap.Stringp("closeoutOfficeID", uuidString(move.CloseoutOfficeID)),
+		zap.Stringp("affiliation", move.Affiliation),
+	)
+	return apperror.NewForbiddenError(fmt.Sprintf("move %s is outside the office user's area", move.MoveID))
+}
+
+func uuidString(id *uuid.UUID) *string {
+	if id == nil {
+		return nil
+	}
+	s := id.String()
+	return &s
+}
@@ -0,0 +1,136 @@
+package move
+
+import (
+	"github.com/gofrs/uuid"
+
+	"github.com/transcom/mymove/pkg/apperror"
+	"github.com/transcom/mymove/pkg/factory"
+	"github.com/transcom/mymove/pkg/models"
+	"github.com/transcom/mymove/pkg/models/roles"
+)
+
+func (suite *MoveServiceSuite) TestCheckMoveAccess() {
+	checker := NewMoveAccessChecker()
+
+	buildOfficeUser := func(gbloc string, roleType roles.RoleType) models.OfficeUser {
+		return factory.BuildOfficeUserWithRoles(suite.DB(), []factory.Customization{
+			{
+				Model: models.TransportationOffice{
+					Gbloc: gbloc,
+				},
+			},
+		}, []roles.RoleType{roleType})
+	}
+
+	buildMoveInGbloc := func(gbloc string, customs ...factory.Customization) models.Move {
+		customs = append(customs, factory.Customization{
+			Model: models.Order{
+				OriginDutyLocationGBLOC: models.StringPointer(gbloc),
+				DestinationGBLOC:        models.StringPointer(gbloc),
+			},
+		})
+		return factory.BuildMove(suite.DB(), customs, nil)
+	}
+
+	suite.Run("allows moves in the office user's GBLOC", func() {
+		officeUser := buildOfficeUser("LKNQ", roles.RoleTypeTOO)
+		move := buildMoveInGbloc("LKNQ")
+
+		err := checker.CheckMoveAccess(suite.AppContextForTest(), []uuid.UUID{move.ID}, officeUser.ID, roles.RoleTypeTOO)
+		suite.NoError(err)
+	})
+
+	suite.Run("denies moves outside the office user's GBLOC", func() {
+		officeUser := buildOfficeUser("LKNQ", roles.RoleTypeTOO)
+		move := buildMoveInGbloc("BGAC")
+
+		err := checker.CheckMoveAccess(suite.AppContextForTest(), []uuid.UUID{move.ID}, officeUser.ID, roles.RoleTypeTOO)
+		suite.Error(err)
+		suite.IsType(apperror.ForbiddenError{}, err)
+	})
+
+	suite.Run("denies the whole request when any move is outside the office user's GBLOC", func() {
+		officeUser := buildOfficeUser("LKNQ", roles.RoleTypeTIO)
+		inside := buildMoveInGbloc("LKNQ")
+		outside := buildMoveInGbloc("BGAC")
+
+		err := checker.CheckMoveAccess(suite.AppContextForTest(), []uuid.UUID{inside.ID, outside.ID}, officeUser.ID, roles.RoleTypeTIO)
+		suite.IsType(apperror.ForbiddenError{}, err)
+	})
+
+	suite.Run("allows national roles to see any move", func() {
+		officeUser := buildOfficeUser("LKNQ", roles.RoleTypeHQ)
+		move := buildMoveInGbloc("BGAC")
+
+		err := checker.CheckMoveAccess(suite.AppContextForTest(), []uuid.UUID{move.ID}, officeUser.ID, roles.RoleTypeHQ)
+		suite.NoError(err)
+	})
+
+	suite.Run("allows moves counseled by the office user's transportation office", func() {
+		officeUser := buildOfficeUser("LKNQ", roles.RoleTypeServicesCounselor)
+		move := buildMoveInGbloc("BGAC", factory.Customization{
+			Model:    officeUser.TransportationOffice,
+			LinkOnly: true,
+			Type:     &factory.TransportationOffices.CounselingOffice,
+		})
+
+		err := checker.CheckMoveAccess(suite.AppContextForTest(), []uuid.UUID{move.ID}, officeUser.ID, roles.RoleTypeServicesCounselor)
+		suite.NoError(err)
+	})
+
+	suite.Run("allows moves closed out by the office user's transportation office", func() {
+		officeUser := buildOfficeUser("LKNQ", roles.RoleTypeServicesCounselor)
+		move := buildMoveInGbloc("BGAC", factory.Customization{
+			Model:    officeUser.TransportationOffice,
+			LinkOnly: true,
+			Type:     &factory.TransportationOffices.CloseoutOffice,
+		})
+
+		err := checker.CheckMoveAccess(suite.AppContextForTest(), []uuid.UUID{move.ID}, officeUser.ID, roles.RoleTypeServicesCounselor)
+		suite.NoError(err)
+	})
+
+	suite.Run("allows moves in the GBLOC of an alternate transportation office", func() {
+		officeUser := buildOfficeUser("LKNQ", roles.RoleTypeTOO)
+		factory.BuildAlternateTransportationOfficeAssignment(suite.DB(), []factory.Customization{
+			{
+				Model:    officeUser,
+				LinkOnly: true,
+			},
+			{
+				Model: models.TransportationOffice{
+					Gbloc: "BGAC",
+				},
+			},
+		}, nil)
+		move := buildMoveInGbloc("BGAC")
+
+		err := checker.CheckMoveAccess(suite.AppContextForTest(), []uuid.UUID{move.ID}, officeUser.ID, roles.RoleTypeTOO)
+		suite.NoError(err)
+	})
+
+	suite.Run("allows moves assigned to the office user", func() {
+		officeUser := buildOfficeUser("LKNQ", roles.RoleTypeTOO)
+		move := buildMoveInGbloc("BGAC", factory.Customization{
+			Model: models.Move{
+				TOOTaskOrderAssignedID: &officeUser.ID,
+			},
+		})
+
+		err := checker.CheckMoveAccess(suite.AppContextForTest(), []uuid.UUID{move.ID}, officeUser.ID, roles.RoleTypeTOO)
+		suite.NoError(err)
+	})
+
+	suite.Run("allows Marine Corps moves for USMC office users", func() {
+		officeUser := buildOfficeUser("USMC", roles.RoleTypeTOO)
+		marines := models.AffiliationMARINES
+		move := buildMoveInGbloc("BGAC", factory.Customization{
+			Model: models.ServiceMember{
+				Affiliation: &marines,
+			},
+		})
+
+		err := checker.CheckMoveAccess(suite.AppContextForTest(), []uuid.UUID{move.ID}, officeUser.ID, roles.RoleTypeTOO)
+		suite.NoError(err)
+	})
+}
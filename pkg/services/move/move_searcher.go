@@ -90,9 +90,11 @@ func (s moveSearcher) SearchMoves(appCtx appcontext.AppContext, params *services
 	scheduledDeliveryDateQuery := scheduledDeliveryDateFilter(params.DeliveryDate)
 	orderQuery := sortOrder(params.Sort, params.Order, params.CustomerName, params.PaymentRequestCode)
 	paymentRequestQuery := paymentRequestCodeFilter(params.PaymentRequestCode)
+	moveAccessQuery := moveAccessFilter(models.MoveAccessOfficeUserID(appCtx.Session()))
 
-	options := [12]QueryOption{customerNameQuery, locatorQuery, dodIDQuery, branchQuery, orderQuery, originPostalCodeQuery,
-		destinationPostalCodeQuery, statusQuery, shipmentsCountQuery, scheduledPickupDateQuery, scheduledDeliveryDateQuery, paymentRequestQuery}
+	options := [13]QueryOption{customerNameQuery, locatorQuery, dodIDQuery, branchQuery, orderQuery, originPostalCodeQuery,
+		destinationPostalCodeQuery, statusQuery, shipmentsCountQuery, scheduledPickupDateQuery, scheduledDeliveryDateQuery, paymentRequestQuery,
+		moveAccessQuery}
 
 	for _, option := range options {
 		if option != nil {
@@ -127,6 +129,15 @@ var parameters = map[string]string{
 	"shipmentsCount":        "COUNT(mto_shipments.id)",
 }
 
+// moveAccessFilter keeps the moves the office user may see. A nil office user sees every move.
+func moveAccessFilter(officeUserID *uuid.UUID) QueryOption {
+	return func(query *pop.Query) {
+		if officeUserID != nil {
+			query.Where(models.OfficeUserCanSeeMoveCondition, *officeUserID)
+		}
+	}
+}
+
 func dodIDFilter(dodID *string) QueryOption {
 	return func(query *pop.Query) {
 		if dodID != nil {
@@ -262,6 +262,59 @@ func (suite *MoveServiceSuite) TestMoveSearch() {
 		suite.Equal(secondMove.Locator, moves[0].Locator)
 		suite.Equal(2, totalCount)
 	})
+	suite.Run("search only finds moves in the office user's area", func() {
+		tooUser := factory.BuildOfficeUserWithRoles(suite.DB(), []factory.Customization{
+			{
+				Model: models.TransportationOffice{
+					Gbloc: "LKNQ",
+				},
+			},
+		}, []roles.RoleType{roles.RoleTypeTOO})
+		defaultRole, err := tooUser.User.Roles.Default()
+		suite.FatalNoError(err)
+		session := auth.Session{
+			ApplicationName: auth.OfficeApp,
+			ActiveRole:      *defaultRole,
+			OfficeUserID:    tooUser.ID,
+			IDToken:         "fake_token",
+			AccessToken:     "fakeAccessToken",
+		}
+
+		buildMoveInGbloc := func(locator string, gbloc string) models.Move {
+			return factory.BuildMove(suite.DB(), []factory.Customization{
+				{
+					Model: models.Move{
+						Locator: locator,
+					},
+				},
+				{
+					Model: models.Order{
+						OriginDutyLocationGBLOC: models.StringPointer(gbloc),
+						DestinationGBLOC:        models.StringPointer(gbloc),
+					},
+				},
+				{
+					Model: models.ServiceMember{
+						FirstName: models.StringPointer("Grace"),
+						LastName:  models.StringPointer("Griffin"),
+					},
+				},
+			}, nil)
+		}
+		insideMove := buildMoveInGbloc("AAAAAA", "LKNQ")
+		outsideMove := buildMoveInGbloc("BBBBBB", "BGAC")
+
+		moves, totalCount, err := searcher.SearchMoves(suite.AppContextWithSessionForTest(&session), &services.SearchMovesParams{CustomerName: models.StringPointer("Grace Griffin")})
+		suite.NoError(err)
+		suite.Len(moves, 1)
+		suite.Equal(insideMove.Locator, moves[0].Locator)
+		suite.Equal(1, totalCount)
+
+		moves, totalCount, err = searcher.SearchMoves(suite.AppContextWithSessionForTest(&session), &services.SearchMovesParams{Locator: &outsideMove.Locator})
+		suite.NoError(err)
+		suite.Len(moves, 0)
+		suite.Equal(0, totalCount)
+	})
 	suite.Run("filtering mto shipments search results", func() {
 		qaeUser := factory.BuildOfficeUserWithRoles(suite.DB(), nil, []roles.RoleType{roles.RoleTypeQae})
 
@@ -16,4 +16,8 @@ type FetchMoveHistoryParams struct {
 	Locator string
 	Page    *int64
 	PerPage *int64
+	// Cursor asks for the page after an opaque cursor instead of a page number. An empty cursor asks for the first page.
+	Cursor *string
+	// NextCursor is set by FetchMoveHistory to the cursor for the following page when Cursor was given
+	NextCursor *string
 }
@@ -2,6 +2,7 @@ package movehistory
 
 import (
 	"database/sql"
+	"fmt"
 	"strings"
 
 	"github.com/gobuffalo/pop/v6"
@@ -11,6 +12,7 @@ import (
 	"github.com/transcom/mymove/pkg/apperror"
 	"github.com/transcom/mymove/pkg/models"
 	"github.com/transcom/mymove/pkg/services"
+	"github.com/transcom/mymove/pkg/services/pagination"
 	"github.com/transcom/mymove/pkg/services/query"
 )
 
@@ -24,6 +26,11 @@ func NewMoveHistoryFetcher() services.MoveHistoryFetcher {
 
 // FetchMoveHistory retrieves a Move's history if it is visible for a given locator
 func (f moveHistoryFetcher) FetchMoveHistory(appCtx appcontext.AppContext, params *services.FetchMoveHistoryParams, useDatabaseProcInstead bool) (*models.MoveHistory, int64, error) {
+	// fetch_move_history only pages by page number, so cursor requests always run the query
+	if params.Cursor != nil {
+		useDatabaseProcInstead = false
+	}
+
 	var rawQuery string
 	if useDatabaseProcInstead {
 		// casting types to match function declared params
@@ -62,6 +69,11 @@ func (f moveHistoryFetcher) FetchMoveHistory(appCtx appcontext.AppContext, param
 			nil,
 			nil,
 		)
+	} else if params.Cursor != nil {
+		query, totalCount, err = keysetMoveHistoryQuery(appCtx, rawQuery, params)
+		if err != nil {
+			return &models.MoveHistory{}, 0, err
+		}
 	} else {
 		query = appCtx.DB().RawQuery(rawQuery, locator).Paginate(int(*params.Page), int(*params.PerPage))
 	}
@@ -95,6 +107,10 @@ func (f moveHistoryFetcher) FetchMoveHistory(appCtx appcontext.AppContext, param
 		}
 	}
 
+	if params.Cursor != nil {
+		params.NextCursor = moveHistoryNextCursor(audits, int(*params.PerPage))
+	}
+
 	var move models.Move
 	err = appCtx.DB().Q().Where("locator = $1", locator).First(&move)
 	if err != nil {
@@ -116,3 +132,64 @@ func (f moveHistoryFetcher) FetchMoveHistory(appCtx appcontext.AppContext, param
 
 	return &moveHistory, int64(totalCount), nil
 }
+
+// moveHistoryKeysetColumns is the ordering cursor requests page by. id breaks ties between changes made in
+// the same transaction, which share an action_tstamp_tx.
+var moveHistoryKeysetColumns = []query.KeysetColumn{
+	{Expression: "action_tstamp_tx", Descending: true},
+	{Expression: "id", Descending: true},
+}
+
+// keysetMoveHistoryQuery wraps the move history query to return the page after params.Cursor. The
+// returned count is of all the move's history, the same as the paginator reports for page requests.
+func keysetMoveHistoryQuery(appCtx appcontext.AppContext, rawQuery string, params *services.FetchMoveHistoryParams) (*pop.Query, int64, error) {
+	cursor := &services.PaginationCursor{}
+	if *params.Cursor != "" {
+		var err error
+		cursor, err = pagination.DecodeCursor(*params.Cursor)
+		if err != nil {
+			return nil, 0, err
+		}
+	}
+	sort := query.KeysetOrder(moveHistoryKeysetColumns)
+	if err := pagination.CheckCursorSort(cursor, sort, len(moveHistoryKeysetColumns)); err != nil {
+		return nil, 0, err
+	}
+
+	rawQuery = strings.TrimRight(strings.TrimSpace(rawQuery), ";")
+
+	var totalCount int64
+	err := appCtx.DB().RawQuery("SELECT COUNT(*) FROM ("+rawQuery+") AS move_history", params.Locator).First(&totalCount)
+	if err != nil {
+		return nil, 0, apperror.NewQueryError("AuditHistory Count", err, "")
+	}
+
+	condition := "TRUE"
+	args := []interface{}{params.Locator}
+	if len(cursor.Values) > 0 {
+		var conditionArgs []interface{}
+		condition, conditionArgs = query.KeysetCondition(moveHistoryKeysetColumns, cursor.Values)
+		// the move history query already uses $1 for the locator, so number the cursor values after it
+		for i := range conditionArgs {
+			condition = strings.Replace(condition, "?", fmt.Sprintf("$%d", i+2), 1)
+		}
+		args = append(args, conditionArgs...)
+	}
+
+	keysetQuery := fmt.Sprintf("SELECT * FROM (%s) AS move_history WHERE %s ORDER BY %s LIMIT %d",
+		rawQuery, condition, sort, *params.PerPage)
+	return appCtx.DB().RawQuery(keysetQuery, args...), totalCount, nil
+}
+
+// moveHistoryNextCursor returns the cursor for the page after audits, or nil when audits is the last page
+func moveHistoryNextCursor(audits models.AuditHistories, perPage int) *string {
+	if len(audits) == 0 || len(audits) < perPage {
+		return nil
+	}
+	last := audits[len(audits)-1]
+	nextCursor := pagination.EncodeCursor(query.KeysetOrder(moveHistoryKeysetColumns), []*string{
+		query.KeysetValue(last.ActionTstampTx),
+		query.KeysetValue(last.ID),
+	})
+	return &nextCursor
+}
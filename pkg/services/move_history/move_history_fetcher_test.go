@@ -324,6 +324,58 @@ func (suite *MoveHistoryServiceSuite) TestMoveHistoryFetcherFunctionality() {
 		}
 	})
 
+	suite.Run("returns cursor paginated results", func() {
+		for _, tc := range procFeatureFlagCases {
+			suite.Run(tc.testScenario, func() {
+
+				approvedMove := factory.BuildAvailableToPrimeMove(suite.DB(), nil, nil)
+
+				// update move
+				tioRemarks := "updating TIO remarks for test"
+				approvedMove.TIORemarks = &tioRemarks
+				suite.MustSave(&approvedMove)
+
+				// update move
+				tioRemarks = "updating TIO remarks for test AGAIN"
+				approvedMove.TIORemarks = &tioRemarks
+				suite.MustSave(&approvedMove)
+
+				params := services.FetchMoveHistoryParams{Locator: approvedMove.Locator, Page: models.Int64Pointer(1), PerPage: models.Int64Pointer(1000)}
+				allHistory, totalCount, err := moveHistoryFetcher.FetchMoveHistory(suite.AppContextForTest(), &params, false)
+				suite.NoError(err)
+				suite.Greater(totalCount, int64(2))
+
+				// the changes were all made in the test's transaction, so they share an action_tstamp_tx and the
+				// pages are only stable if ties are broken on id
+				var pagedHistory models.AuditHistories
+				params = services.FetchMoveHistoryParams{Locator: approvedMove.Locator, PerPage: models.Int64Pointer(2), Cursor: models.StringPointer("")}
+				for params.Cursor != nil {
+					moveHistoryData, count, err := moveHistoryFetcher.FetchMoveHistory(suite.AppContextForTest(), &params, tc.useDbProc)
+					suite.NoError(err)
+					suite.Equal(totalCount, count)
+					suite.LessOrEqual(len(moveHistoryData.AuditHistories), 2)
+					pagedHistory = append(pagedHistory, moveHistoryData.AuditHistories...)
+					params.Cursor = params.NextCursor
+				}
+
+				suite.Len(pagedHistory, len(allHistory.AuditHistories))
+				seen := map[uuid.UUID]bool{}
+				for _, audit := range pagedHistory {
+					suite.False(seen[audit.ID], "audit history %s was returned on two pages", audit.ID)
+					seen[audit.ID] = true
+				}
+			})
+		}
+	})
+
+	suite.Run("rejects a cursor it didn't make", func() {
+		approvedMove := factory.BuildAvailableToPrimeMove(suite.DB(), nil, nil)
+
+		params := services.FetchMoveHistoryParams{Locator: approvedMove.Locator, Cursor: models.StringPointer("not a cursor!")}
+		_, _, err := moveHistoryFetcher.FetchMoveHistory(suite.AppContextForTest(), &params, false)
+		suite.IsType(&apperror.BadDataError{}, err)
+	})
+
 	suite.Run("filters shipments and service items from different move", func() {
 		for _, tc := range procFeatureFlagCases {
 			suite.Run(tc.testScenario, func() {
@@ -89,7 +89,8 @@ type MoveTaskOrderFetcherParams struct {
 	ExcludeExternalShipments bool       // indicates if external vendor shipments should be returned
 	Page                     *int64
 	PerPage                  *int64
-	Acknowledged             *bool      // indicates if the move and all its shipments must be acknowledged by the prime
-	AcknowledgedAfter        *time.Time // indicates if the move or one of its shipments must be acknowledged after this timestamp
-	AcknowledgedBefore       *time.Time // indicates if the move or one of its shipments must be acknowledged before this timestamp
+	Acknowledged             *bool             // indicates if the move and all its shipments must be acknowledged by the prime
+	AcknowledgedAfter        *time.Time        // indicates if the move or one of its shipments must be acknowledged after this timestamp
+	AcknowledgedBefore       *time.Time        // indicates if the move or one of its shipments must be acknowledged before this timestamp
+	Cursor                   *PaginationCursor // if filled, only the PerPage MTOs after this cursor are returned
 }
@@ -20,6 +20,8 @@ import (
 	"github.com/transcom/mymove/pkg/models"
 	"github.com/transcom/mymove/pkg/services"
 	"github.com/transcom/mymove/pkg/services/featureflag"
+	"github.com/transcom/mymove/pkg/services/pagination"
+	"github.com/transcom/mymove/pkg/services/query"
 )
 
 type moveTaskOrderFetcher struct {
@@ -446,6 +448,13 @@ func (f moveTaskOrderFetcher) ListPrimeMoveTaskOrders(appCtx appcontext.AppConte
 		sql = sql + getPrimeAcknowledgedFilter(searchParams.Acknowledged)
 		sql = sql + getPrimeAcknowledgedAfterFilter(searchParams.AcknowledgedAfter, &sqlParams)
 		sql = sql + getPrimeAcknowledgedBeforeFilter(searchParams.AcknowledgedBefore, &sqlParams)
+
+		if searchParams.Cursor != nil {
+			if err = pagination.CheckCursorSort(searchParams.Cursor, query.KeysetOrder(primeMovesKeysetColumns), len(primeMovesKeysetColumns)); err != nil {
+				return models.Moves{}, err
+			}
+			sql = sql + getCursorFilter(searchParams.Cursor, searchParams.PerPage, &sqlParams)
+		}
 	}
 	sql = sql + `;`
 
@@ -462,6 +471,38 @@ func (f moveTaskOrderFetcher) ListPrimeMoveTaskOrders(appCtx appcontext.AppConte
 	return moveTaskOrders, nil
 }
 
+// ListPrimeMovesOrder is the order ListPrimeMoveTaskOrders pages moves in when given a cursor: the order they
+// became available to the Prime
+var ListPrimeMovesOrder = query.NewQueryOrder(models.StringPointer("available_to_prime_at"), models.BoolPointer(true))
+
+// primeMovesKeysetColumns are the columns ListPrimeMovesOrder pages on, named the way its cursors name them
+var primeMovesKeysetColumns = []query.KeysetColumn{{Expression: "available_to_prime_at"}, {Expression: "id"}}
+
+func getCursorFilter(cursor *services.PaginationCursor, perPage *int64, sqlParams *[]any) string {
+	sql := ""
+	if len(cursor.Values) > 0 {
+		columns := make([]query.KeysetColumn, len(primeMovesKeysetColumns))
+		for i, column := range primeMovesKeysetColumns {
+			columns[i] = query.KeysetColumn{Expression: "moves." + column.Expression, Descending: column.Descending}
+		}
+
+		// number the condition's placeholders after the params already in the query
+		condition, args := query.KeysetCondition(columns, cursor.Values)
+		for _, arg := range args {
+			*sqlParams = append(*sqlParams, arg)
+			condition = strings.Replace(condition, "?", "$"+strconv.Itoa(len(*sqlParams)), 1)
+		}
+		sql = ` AND ` + condition
+	}
+
+	limit := pagination.DefaultPerPage()
+	if perPage != nil {
+		limit = *perPage
+	}
+	*sqlParams = append(*sqlParams, limit)
+	return sql + ` ORDER BY moves.available_to_prime_at, moves.id LIMIT $` + strconv.Itoa(len(*sqlParams))
+}
+
 func getSinceFilter(since *time.Time, sqlParams *[]any) string {
 
 	if since == nil {
@@ -578,6 +619,9 @@ func (f moveTaskOrderFetcher) ListPrimeMoveTaskOrdersAmendments(appCtx appcontex
 	moveTaskOrders, err := f.ListPrimeMoveTaskOrders(appCtx, searchParams)
 
 	if err != nil {
+		if _, ok := err.(apperror.InvalidInputError); ok {
+			return models.Moves{}, services.MoveOrderAmendmentAvailableSinceCounts{}, err
+		}
 		return models.Moves{}, services.MoveOrderAmendmentAvailableSinceCounts{}, apperror.NewQueryError("MoveTaskOrder", err, "Unexpected error while querying db.")
 	}
 
@@ -222,20 +222,12 @@ func fetchCurrentTaskOrderFee(appCtx appcontext.AppContext, serviceCode models.R
 }
 
 func FetchContractCode(appCtx appcontext.AppContext, date time.Time) (string, error) {
-	var contractYear models.ReContractYear
-	err := appCtx.DB().EagerPreload("Contract").Where("? between start_date and end_date", date).
-		First(&contractYear)
+	contract, err := models.FetchContractInEffect(appCtx, date)
 	if err != nil {
-		if err == sql.ErrNoRows {
-			return "", apperror.NewNotFoundError(uuid.Nil, fmt.Sprintf("no contract year found for %s", date.String()))
-		}
 		return "", err
 	}
 
-	contract := contractYear.Contract
-
-	contractCode := contract.Code
-	return contractCode, nil
+	return contract.Code, nil
 }
 
 func fetchDomesticServiceArea(appCtx appcontext.AppContext, contractCode string, shipmentPostalCode string) (models.ReDomesticServiceArea, error) {
@@ -138,15 +138,10 @@ func fetchOconusRateAreaByPostalCode(appCtx appcontext.AppContext, contractId uu
 }
 
 func fetchContract(appCtx appcontext.AppContext, date time.Time) (*models.ReContract, error) {
-	var contractYear models.ReContractYear
-	err := appCtx.DB().EagerPreload("Contract").Where("? between start_date and end_date", date).
-		First(&contractYear)
+	contract, err := models.FetchContractInEffect(appCtx, date)
 	if err != nil {
-		if err == sql.ErrNoRows {
-			return nil, apperror.NewNotFoundError(uuid.Nil, fmt.Sprintf("no contract year found for %s", date.String()))
-		}
 		return nil, err
 	}
 
-	return &contractYear.Contract, nil
+	return &contract, nil
 }
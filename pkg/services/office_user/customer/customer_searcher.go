@@ -43,6 +43,10 @@ func (s customerSearcher) SearchCustomers(appCtx appcontext.AppContext, params *
 		return nil, 0, err
 	}
 
+	// Customers without a move can be found by anyone who may search. The rest are only found when one of
+	// their moves is in the office user's area.
+	accessOfficeUserID := models.MoveAccessOfficeUserID(appCtx.Session())
+
 	var query *pop.Query
 	rawquery := `SELECT * FROM
 		(SELECT DISTINCT ON (id)
@@ -69,7 +73,10 @@ func (s customerSearcher) SearchCustomers(appCtx appcontext.AppContext, params *
 	FROM service_members AS service_members
 		JOIN users ON users.id = service_members.user_id
 		LEFT JOIN orders ON orders.service_member_id = service_members.id
-		WHERE (((orders.orders_type != 'SAFETY' OR orders.orders_type IS NULL) AND LEFT(service_members.edipi, 2) != 'SM') AND`
+		WHERE (((orders.orders_type != 'SAFETY' OR orders.orders_type IS NULL) AND LEFT(service_members.edipi, 2) != 'SM') AND
+		($2::uuid IS NULL
+			OR NOT EXISTS (SELECT 1 FROM orders JOIN moves ON moves.orders_id = orders.id WHERE orders.service_member_id = service_members.id)
+			OR EXISTS (SELECT 1 FROM orders JOIN moves ON moves.orders_id = orders.id WHERE orders.service_member_id = service_members.id AND office_user_can_see_move($2, moves.id))) AND`
 
 	if params.Edipi != nil {
 		rawquery += ` service_members.edipi = $1) ) distinct_customers`
@@ -79,14 +86,14 @@ func (s customerSearcher) SearchCustomers(appCtx appcontext.AppContext, params *
 		} else {
 			rawquery += ` ORDER BY distinct_customers.last_name ASC`
 		}
-		query = appCtx.DB().RawQuery(rawquery, params.Edipi)
+		query = appCtx.DB().RawQuery(rawquery, params.Edipi, accessOfficeUserID)
 	} else {
 		rawquery += ` f_unaccent(lower($1)) % searchable_full_name(first_name, last_name)) ) distinct_customers ORDER BY total_sim DESC`
 		if params.Sort != nil && params.Order != nil {
 			sortTerm := parameters[*params.Sort]
 			rawquery += `, ` + sortTerm + ` ` + *params.Order
 		}
-		query = appCtx.DB().RawQuery(rawquery, params.CustomerName)
+		query = appCtx.DB().RawQuery(rawquery, params.CustomerName, accessOfficeUserID)
 	}
 
 	customerNameQuery := customerNameSearch(params.CustomerName)
@@ -9,6 +9,7 @@ import (
 	"github.com/transcom/mymove/pkg/appcontext"
 	"github.com/transcom/mymove/pkg/models"
 	"github.com/transcom/mymove/pkg/services"
+	"github.com/transcom/mymove/pkg/services/query"
 )
 
 type officeUsersListQueryBuilder interface {
@@ -38,6 +39,15 @@ func (o *officeUserListFetcher) FetchOfficeUsersList(appCtx appcontext.AppContex
 
 	query = query.Where("status = ?", models.OfficeUserStatusAPPROVED)
 	query.GroupBy("office_users.id")
+	query.Select("office_users.*")
+
+	if pagination.Cursor() != nil {
+		count, err := fetchOfficeUsersAfterCursor(query, &officeUsers, pagination, ordering)
+		if err != nil {
+			return nil, 0, err
+		}
+		return officeUsers, count, nil
+	}
 
 	var order = "desc"
 	if ordering.SortOrder() != nil && *ordering.SortOrder() {
@@ -50,7 +60,6 @@ func (o *officeUserListFetcher) FetchOfficeUsersList(appCtx appcontext.AppContex
 	}
 
 	query.Order(fmt.Sprintf("%s %s", orderTerm, order))
-	query.Select("office_users.*")
 
 	err := query.Paginate(pagination.Page(), pagination.PerPage()).All(&officeUsers)
 	if err != nil {
@@ -73,6 +82,21 @@ func (o *officeUserListFetcher) FetchOfficeUsersList(appCtx appcontext.AppContex
 	return officeUsers, count, nil
 }
 
+// fetchOfficeUsersAfterCursor counts the office users the query matches and fetches the page after the cursor. The page is
+// ordered by the requested column itself rather than re-sorted by office name.
+func fetchOfficeUsersAfterCursor(q *pop.Query, officeUsers *models.OfficeUsers, pagination services.Pagination, ordering services.QueryOrder) (int, error) {
+	count, err := q.Count(&models.OfficeUser{})
+	if err != nil {
+		return 0, err
+	}
+
+	page, err := query.KeysetPaginate(q, pagination, ordering, officeUsers)
+	if err != nil {
+		return 0, err
+	}
+	return count, page.All(officeUsers)
+}
+
 // FetchOfficeUserList uses the passed query builder to fetch a list of office users
 func (o *officeUserListFetcher) FetchOfficeUsersCount(appCtx appcontext.AppContext, filters []services.QueryFilter) (int, error) {
 	var officeUsers models.OfficeUsers
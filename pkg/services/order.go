@@ -74,4 +74,9 @@ type ListOrderParams struct {
 	ViewAsGBLOC             *string
 	CounselingOffice        *string
 	AssignedTo              *string
+	// Cursor asks ListOrders and the origin and destination requests queues for the page after an opaque cursor
+	// instead of a page number. An empty cursor asks for the first page.
+	Cursor *string
+	// NextCursor is set to the cursor for the following page when Cursor was given
+	NextCursor *string
 }
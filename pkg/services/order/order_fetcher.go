@@ -21,6 +21,8 @@ import (
 	"github.com/transcom/mymove/pkg/models/roles"
 	"github.com/transcom/mymove/pkg/services"
 	officeuser "github.com/transcom/mymove/pkg/services/office_user"
+	"github.com/transcom/mymove/pkg/services/pagination"
+	"github.com/transcom/mymove/pkg/services/query"
 )
 
 // Since timestamps in a postgres DB are stored with at the microsecond precision, we want to ensure that we are checking all timestamps up until that point to prevent moves from not showing up
@@ -124,10 +126,20 @@ func (f orderFetcher) ListOrders(appCtx appcontext.AppContext, officeUserID uuid
 	assignedToQuery := assignedUserFilter(params.AssignedTo)
 	sortOrderQuery := sortOrder(params.Sort, params.Order, ppmCloseoutGblocs)
 	secondarySortOrderQuery := secondarySortOrder(params.Sort)
+	cursor, keysetColumns, err := listOrdersCursor(params, ppmCloseoutGblocs)
+	if err != nil {
+		return []models.Move{}, 0, err
+	}
+	if cursor != nil {
+		// A keyset page has to be ordered by exactly the columns its cursor holds
+		sortOrderQuery = keysetSortOrder(keysetColumns)
+		secondarySortOrderQuery = nil
+	}
 	counselingQuery := counselingOfficeFilter(params.CounselingOffice)
 	tooFilterOutDestinationRequestsQuery := tooQueueOriginRequestsFilter(role)
+	moveAccessQuery := moveAccessFilter(models.MoveAccessOfficeUserID(appCtx.Session()))
 	// Adding to an array so we can iterate over them and apply the filters after the query structure is set below
-	options := [21]QueryOption{branchQuery, locatorQuery, dodIDQuery, emplidQuery, customerNameQuery, originDutyLocationQuery, destinationDutyLocationQuery, moveStatusQuery, gblocQuery, submittedAtQuery, appearedInTOOAtQuery, requestedMoveDateQuery, ppmTypeQuery, closeoutInitiatedQuery, closeoutLocationQuery, ppmStatusQuery, sortOrderQuery, secondarySortOrderQuery, assignedToQuery, counselingQuery, tooFilterOutDestinationRequestsQuery}
+	options := [22]QueryOption{branchQuery, locatorQuery, dodIDQuery, emplidQuery, customerNameQuery, originDutyLocationQuery, destinationDutyLocationQuery, moveStatusQuery, gblocQuery, submittedAtQuery, appearedInTOOAtQuery, requestedMoveDateQuery, ppmTypeQuery, closeoutInitiatedQuery, closeoutLocationQuery, ppmStatusQuery, sortOrderQuery, secondarySortOrderQuery, assignedToQuery, counselingQuery, tooFilterOutDestinationRequestsQuery, moveAccessQuery}
 
 	var query *pop.Query
 	if ppmCloseoutGblocs {
@@ -264,12 +276,33 @@ func (f orderFetcher) ListOrders(appCtx appcontext.AppContext, officeUserID uuid
 		groupByColumms = append(groupByColumms, "assigned_user.last_name", "assigned_user.first_name")
 	}
 
-	err = query.GroupBy("moves.id", groupByColumms...).Paginate(int(*params.Page), int(*params.PerPage)).All(&moves)
-	if err != nil {
-		return []models.Move{}, 0, err
+	var count int
+	if cursor != nil {
+		query.GroupBy("moves.id", groupByColumms...)
+		count, err = query.Count(&models.Move{})
+		if err != nil {
+			return []models.Move{}, 0, err
+		}
+
+		keysetAfterCursor(keysetColumns, cursor)(query)
+		perPage := keysetPerPage(params.PerPage)
+		err = query.Limit(perPage).All(&moves)
+		if err != nil {
+			return []models.Move{}, 0, err
+		}
+
+		params.NextCursor, err = listOrdersNextCursor(appCtx, query, moves, keysetColumns, perPage)
+		if err != nil {
+			return []models.Move{}, 0, err
+		}
+	} else {
+		err = query.GroupBy("moves.id", groupByColumms...).Paginate(int(*params.Page), int(*params.PerPage)).All(&moves)
+		if err != nil {
+			return []models.Move{}, 0, err
+		}
+		// Get the count
+		count = query.Paginator.TotalEntriesSize
 	}
-	// Get the count
-	count := query.Paginator.TotalEntriesSize
 
 	// Services Counselors in PPM Closeout GBLOCs should see their closeout GBLOC in the CloseoutOffice field for every
 	// move.
@@ -330,6 +363,7 @@ type MoveWithCount struct {
 	TOODestinationAssignedUser    *models.OfficeUser           `json:"-"`
 	MTOServiceItemsRaw            json.RawMessage              `json:"mto_service_items" db:"mto_service_items"`
 	MTOServiceItems               *models.MTOServiceItems      `json:"-"`
+	SortValuesRaw                 json.RawMessage              `json:"sort_values" db:"sort_values"`
 	TotalCount                    int64                        `json:"total_count" db:"total_count"`
 }
 
@@ -344,6 +378,18 @@ func (f orderFetcher) ListOriginRequestsOrders(appCtx appcontext.AppContext, off
 	var moves []models.Move
 	var movesWithCount []MoveWithCount
 
+	// the queue continues after a cursor's sort values instead of skipping to a page
+	page, perPage := params.Page, params.PerPage
+	var afterSortValues []*string
+	if params.Cursor != nil {
+		var err error
+		afterSortValues, err = pagination.DecodeSortCursor(*params.Cursor, params.Sort, params.Order)
+		if err != nil {
+			return []models.Move{}, 0, err
+		}
+		page, perPage = models.Int64Pointer(1), models.Int64Pointer(int64(keysetPerPage(params.PerPage)))
+	}
+
 	var officeUserGbloc string
 	hasSafetyPrivilege := false
 	if params.ViewAsGBLOC != nil {
@@ -364,7 +410,7 @@ func (f orderFetcher) ListOriginRequestsOrders(appCtx appcontext.AppContext, off
 		appCtx.Logger().Error("Error retrieving user privileges", zap.Error(privErr))
 	}
 
-	err := appCtx.DB().RawQuery("SELECT * FROM get_origin_queue($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17)",
+	err := appCtx.DB().RawQuery("SELECT * FROM get_origin_queue($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19)",
 		officeUserGbloc,
 		params.CustomerName,
 		params.Edipi,
@@ -378,16 +424,25 @@ func (f orderFetcher) ListOriginRequestsOrders(appCtx appcontext.AppContext, off
 		params.CounselingOffice,
 		params.AssignedTo,
 		hasSafetyPrivilege,
-		params.Page,
-		params.PerPage,
+		page,
+		perPage,
 		params.Sort,
-		params.Order).
+		params.Order,
+		models.MoveAccessOfficeUserID(appCtx.Session()),
+		pagination.SortValuesParam(afterSortValues)).
 		All(&movesWithCount)
 
 	if err != nil {
 		return []models.Move{}, 0, err
 	}
 
+	if params.Cursor != nil && len(movesWithCount) > 0 {
+		params.NextCursor, err = pagination.NextSortCursor(params.Sort, params.Order, movesWithCount[len(movesWithCount)-1].SortValuesRaw, len(movesWithCount), int(*perPage))
+		if err != nil {
+			return []models.Move{}, 0, err
+		}
+	}
+
 	var count int64
 	if len(movesWithCount) > 0 {
 		count = movesWithCount[0].TotalCount
@@ -447,6 +502,18 @@ func (f orderFetcher) ListDestinationRequestsOrders(appCtx appcontext.AppContext
 	var moves []models.Move
 	var movesWithCount []MoveWithCount
 
+	// the queue continues after a cursor's sort values instead of skipping to a page
+	page, perPage := params.Page, params.PerPage
+	var afterSortValues []*string
+	if params.Cursor != nil {
+		var err error
+		afterSortValues, err = pagination.DecodeSortCursor(*params.Cursor, params.Sort, params.Order)
+		if err != nil {
+			return []models.Move{}, 0, err
+		}
+		page, perPage = models.Int64Pointer(1), models.Int64Pointer(int64(keysetPerPage(params.PerPage)))
+	}
+
 	// getting the office user's GBLOC
 	var officeUserGbloc string
 	hasSafetyPrivilege := false
@@ -467,7 +534,7 @@ func (f orderFetcher) ListDestinationRequestsOrders(appCtx appcontext.AppContext
 		appCtx.Logger().Error("Error retrieving user privileges", zap.Error(privErr))
 	}
 	// calling the database function with all passed in parameters
-	err := appCtx.DB().RawQuery("SELECT * FROM get_destination_queue($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17)",
+	err := appCtx.DB().RawQuery("SELECT * FROM get_destination_queue($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19)",
 		officeUserGbloc,
 		params.CustomerName,
 		params.Edipi,
@@ -481,16 +548,25 @@ func (f orderFetcher) ListDestinationRequestsOrders(appCtx appcontext.AppContext
 		params.CounselingOffice,
 		params.AssignedTo,
 		hasSafetyPrivilege,
-		params.Page,
-		params.PerPage,
+		page,
+		perPage,
 		params.Sort,
-		params.Order).
+		params.Order,
+		models.MoveAccessOfficeUserID(appCtx.Session()),
+		pagination.SortValuesParam(afterSortValues)).
 		All(&movesWithCount)
 
 	if err != nil {
 		return []models.Move{}, 0, err
 	}
 
+	if params.Cursor != nil && len(movesWithCount) > 0 {
+		params.NextCursor, err = pagination.NextSortCursor(params.Sort, params.Order, movesWithCount[len(movesWithCount)-1].SortValuesRaw, len(movesWithCount), int(*perPage))
+		if err != nil {
+			return []models.Move{}, 0, err
+		}
+	}
+
 	// each row is sent back with the total count from the db func, so we will take the value from the first one
 	var count int64
 	if len(movesWithCount) > 0 {
@@ -622,8 +698,9 @@ func (f orderFetcher) ListAllOrderLocations(appCtx appcontext.AppContext, office
 		gblocQuery = gblocFilterForTOO(&officeUserGbloc)
 	}
 	moveStatusQuery := moveStatusFilter(params.Status)
+	moveAccessQuery := moveAccessFilter(models.MoveAccessOfficeUserID(appCtx.Session()))
 	// Adding to an array so we can iterate over them and apply the filters after the query structure is set below
-	options := [15]QueryOption{branchQuery, moveStatusQuery, gblocQuery}
+	options := [15]QueryOption{branchQuery, moveStatusQuery, gblocQuery, moveAccessQuery}
 
 	var query *pop.Query
 	if ppmCloseoutGblocs {
@@ -806,6 +883,15 @@ func emplidFilter(emplid *string) QueryOption {
 	}
 }
 
+// moveAccessFilter keeps the moves the office user may see. A nil office user sees every move.
+func moveAccessFilter(officeUserID *uuid.UUID) QueryOption {
+	return func(query *pop.Query) {
+		if officeUserID != nil {
+			query.Where(models.OfficeUserCanSeeMoveCondition, *officeUserID)
+		}
+	}
+}
+
 func locatorFilter(locator *string) QueryOption {
 	return func(query *pop.Query) {
 		if locator != nil {
@@ -975,28 +1061,29 @@ func gblocFilterForPPMCloseoutForNavyMarineAndCG(gbloc *string) QueryOption {
 	}
 }
 
-func sortOrder(sort *string, order *string, ppmCloseoutGblocs bool) QueryOption {
-	parameters := map[string]string{
-		"customerName":            "(service_members.last_name || ' ' || service_members.first_name)",
-		"edipi":                   "service_members.edipi",
-		"emplid":                  "service_members.emplid",
-		"branch":                  "service_members.affiliation",
-		"locator":                 "moves.locator",
-		"status":                  "moves.status",
-		"submittedAt":             "moves.submitted_at",
-		"appearedInTooAt":         "GREATEST(moves.submitted_at, moves.service_counseling_completed_at, moves.approvals_requested_at)",
-		"originDutyLocation":      "origin_dl.name",
-		"destinationDutyLocation": "dest_dl.name",
-		"requestedMoveDate":       "LEAST(COALESCE(MIN(mto_shipments.requested_pickup_date), 'infinity'), COALESCE(MIN(ppm_shipments.expected_departure_date), 'infinity'), COALESCE(MIN(mto_shipments.requested_delivery_date), 'infinity'))",
-		"originGBLOC":             "origin_to.gbloc",
-		"ppmType":                 "moves.ppm_type",
-		"ppmStatus":               "ppm_shipments.status",
-		"closeoutLocation":        "closeout_to.name",
-		"closeoutInitiated":       "MAX(ppm_shipments.submitted_at)",
-		"counselingOffice":        "transportation_offices.name",
-		"assignedTo":              "assigned_user.last_name,assigned_user.first_name",
-	}
+// sortOrderParameters maps the queue's sort names to the SQL they order by
+var sortOrderParameters = map[string]string{
+	"customerName":            "(service_members.last_name || ' ' || service_members.first_name)",
+	"edipi":                   "service_members.edipi",
+	"emplid":                  "service_members.emplid",
+	"branch":                  "service_members.affiliation",
+	"locator":                 "moves.locator",
+	"status":                  "moves.status",
+	"submittedAt":             "moves.submitted_at",
+	"appearedInTooAt":         "GREATEST(moves.submitted_at, moves.service_counseling_completed_at, moves.approvals_requested_at)",
+	"originDutyLocation":      "origin_dl.name",
+	"destinationDutyLocation": "dest_dl.name",
+	"requestedMoveDate":       "LEAST(COALESCE(MIN(mto_shipments.requested_pickup_date), 'infinity'), COALESCE(MIN(ppm_shipments.expected_departure_date), 'infinity'), COALESCE(MIN(mto_shipments.requested_delivery_date), 'infinity'))",
+	"originGBLOC":             "origin_to.gbloc",
+	"ppmType":                 "moves.ppm_type",
+	"ppmStatus":               "ppm_shipments.status",
+	"closeoutLocation":        "closeout_to.name",
+	"closeoutInitiated":       "MAX(ppm_shipments.submitted_at)",
+	"counselingOffice":        "transportation_offices.name",
+	"assignedTo":              "assigned_user.last_name,assigned_user.first_name",
+}
 
+func sortOrder(sort *string, order *string, ppmCloseoutGblocs bool) QueryOption {
 	return func(query *pop.Query) {
 		// If we have a sort and order defined let's use it. Otherwise we'll use our default status desc sort order.
 		if sort != nil && order != nil {
@@ -1005,7 +1092,7 @@ func sortOrder(sort *string, order *string, ppmCloseoutGblocs bool) QueryOption
 			if *sort == "closeoutLocation" && ppmCloseoutGblocs {
 				return
 			}
-			if sortTerm, ok := parameters[*sort]; ok {
+			if sortTerm, ok := sortOrderParameters[*sort]; ok {
 				if *sort == "customerName" {
 					query.Order(fmt.Sprintf("service_members.last_name %s, service_members.first_name %s", *order, *order))
 				} else if *sort == "assignedTo" {
@@ -1022,6 +1109,114 @@ func sortOrder(sort *string, order *string, ppmCloseoutGblocs bool) QueryOption
 	}
 }
 
+// keysetSortColumns returns the columns a cursor paged queue is ordered by. They match sortOrder, then end with
+// moves.id so rows that share a sort value keep the same order from page to page.
+func keysetSortColumns(sort *string, order *string, ppmCloseoutGblocs bool) []query.KeysetColumn {
+	idColumn := query.KeysetColumn{Expression: "moves.id"}
+	if sort == nil || order == nil {
+		return []query.KeysetColumn{{Expression: "moves.status", Descending: true}, idColumn}
+	}
+	if *sort == "closeoutLocation" && ppmCloseoutGblocs {
+		return []query.KeysetColumn{idColumn}
+	}
+
+	descending := strings.EqualFold(*order, "desc")
+	var expressions []string
+	switch *sort {
+	case "customerName":
+		expressions = []string{"service_members.last_name", "service_members.first_name"}
+	case "assignedTo":
+		expressions = []string{"assigned_user.last_name", "assigned_user.first_name"}
+	default:
+		sortTerm, ok := sortOrderParameters[*sort]
+		if !ok {
+			return []query.KeysetColumn{{Expression: "moves.status", Descending: true}, idColumn}
+		}
+		expressions = []string{sortTerm}
+	}
+
+	var columns []query.KeysetColumn
+	for _, expression := range expressions {
+		columns = append(columns, query.KeysetColumn{Expression: expression, Descending: descending})
+	}
+	return append(columns, idColumn)
+}
+
+// listOrdersCursor decodes the cursor ListOrders was asked to continue after, along with the columns it pages by.
+// It returns a nil cursor when the queue is paged by page number.
+func listOrdersCursor(params *services.ListOrderParams, ppmCloseoutGblocs bool) (*services.PaginationCursor, []query.KeysetColumn, error) {
+	if params.Cursor == nil {
+		return nil, nil, nil
+	}
+
+	columns := keysetSortColumns(params.Sort, params.Order, ppmCloseoutGblocs)
+	cursor := &services.PaginationCursor{}
+	if *params.Cursor != "" {
+		var err error
+		cursor, err = pagination.DecodeCursor(*params.Cursor)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	if err := pagination.CheckCursorSort(cursor, query.KeysetOrder(columns), len(columns)); err != nil {
+		return nil, nil, err
+	}
+	return cursor, columns, nil
+}
+
+func keysetSortOrder(columns []query.KeysetColumn) QueryOption {
+	return func(q *pop.Query) {
+		q.Order(query.KeysetOrder(columns))
+	}
+}
+
+// keysetAfterCursor keeps the moves that sort after the cursor. It uses HAVING rather than WHERE since some of
+// the sort columns are aggregates over the move's shipments.
+func keysetAfterCursor(columns []query.KeysetColumn, cursor *services.PaginationCursor) QueryOption {
+	return func(q *pop.Query) {
+		if len(cursor.Values) > 0 {
+			condition, args := query.KeysetCondition(columns, cursor.Values)
+			q.Having(condition, args...)
+		}
+	}
+}
+
+// keysetPerPage returns the page size for a cursor paged queue, defaulting the same way Paginate does
+func keysetPerPage(perPage *int64) int {
+	if perPage == nil || *perPage < 1 {
+		return 20
+	}
+	return int(*perPage)
+}
+
+// listOrdersNextCursor returns the cursor for the page after moves, or nil when moves is the last page. The sort
+// values are read back from the database since some of them are aggregates over the move's shipments.
+func listOrdersNextCursor(appCtx appcontext.AppContext, q *pop.Query, moves []models.Move, columns []query.KeysetColumn, perPage int) (*string, error) {
+	if len(moves) == 0 || len(moves) < perPage {
+		return nil, nil
+	}
+
+	expressions := make([]string, len(columns))
+	for i, column := range columns {
+		expressions[i] = column.Expression + "::text"
+	}
+	q.Where("moves.id = ?", moves[len(moves)-1].ID)
+	sql, args := q.ToSQL(pop.NewModel(&models.Move{}, appCtx.DB().Context()),
+		fmt.Sprintf("json_build_array(%s)::text AS sort_values", strings.Join(expressions, ", ")))
+
+	var sortValues string
+	if err := appCtx.DB().RawQuery(sql, args...).First(&sortValues); err != nil {
+		return nil, apperror.NewQueryError("Move", err, "")
+	}
+	var values []*string
+	if err := json.Unmarshal([]byte(sortValues), &values); err != nil {
+		return nil, err
+	}
+
+	nextCursor := pagination.EncodeCursor(query.KeysetOrder(columns), values)
+	return &nextCursor, nil
+}
+
 // When a queue is sorted by a non-unique value (ex: status, branch) the order within each value is inconsistent at different page sizes
 // Adding a secondary sort ensures a consistent order within the primary sort column
 func secondarySortOrder(sort *string) QueryOption {
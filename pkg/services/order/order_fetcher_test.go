@@ -9,6 +9,7 @@ import (
 	"github.com/gofrs/uuid"
 	"github.com/stretchr/testify/mock"
 
+	"github.com/transcom/mymove/pkg/apperror"
 	"github.com/transcom/mymove/pkg/auth"
 	"github.com/transcom/mymove/pkg/factory"
 	"github.com/transcom/mymove/pkg/models"
@@ -2035,6 +2036,84 @@ func (suite *OrderServiceSuite) TestListOrdersWithPagination() {
 	suite.Equal(2, count)
 }
 
+func (suite *OrderServiceSuite) TestListOrdersWithCursor() {
+	waf := entitlements.NewWeightAllotmentFetcher()
+	orderFetcher := NewOrderFetcher(waf)
+	statuses := []string{string(models.MoveStatusNeedsServiceCounseling)}
+
+	setupTestData := func() (auth.Session, models.OfficeUser, []uuid.UUID) {
+		officeUser := factory.BuildOfficeUserWithRoles(suite.DB(), nil, []roles.RoleType{roles.RoleTypeServicesCounselor})
+		session := auth.Session{
+			ApplicationName: auth.OfficeApp,
+			ActiveRole:      officeUser.User.Roles[0],
+			OfficeUserID:    officeUser.ID,
+			IDToken:         "fake_token",
+			AccessToken:     "fakeAccessToken",
+		}
+
+		var moveIDs []uuid.UUID
+		for i := 0; i < 3; i++ {
+			move := factory.BuildMoveWithShipment(suite.DB(), []factory.Customization{
+				{
+					Model: models.Move{
+						Status: models.MoveStatusNeedsServiceCounseling,
+					},
+				},
+			}, nil)
+			moveIDs = append(moveIDs, move.ID)
+		}
+		return session, officeUser, moveIDs
+	}
+
+	for _, sort := range []string{"status", "requestedMoveDate", "customerName"} {
+		suite.Run("pages through every move once sorted by "+sort, func() {
+			session, officeUser, moveIDs := setupTestData()
+
+			var fetchedIDs []uuid.UUID
+			params := services.ListOrderParams{
+				Sort:    models.StringPointer(sort),
+				Order:   models.StringPointer("asc"),
+				Status:  statuses,
+				PerPage: models.Int64Pointer(2),
+				Cursor:  models.StringPointer(""),
+			}
+			for pages := 1; params.Cursor != nil; pages++ {
+				suite.LessOrEqual(pages, 2)
+				moves, count, err := orderFetcher.ListOrders(suite.AppContextWithSessionForTest(&session), officeUser.ID, roles.RoleTypeServicesCounselor, &params)
+				suite.NoError(err)
+				suite.Equal(3, count)
+				for _, move := range moves {
+					fetchedIDs = append(fetchedIDs, move.ID)
+				}
+				params.Cursor = params.NextCursor
+			}
+
+			suite.ElementsMatch(moveIDs, fetchedIDs)
+		})
+	}
+
+	suite.Run("rejects a cursor made for another sort", func() {
+		session, officeUser, _ := setupTestData()
+
+		params := services.ListOrderParams{Sort: models.StringPointer("status"), Order: models.StringPointer("asc"), Status: statuses, PerPage: models.Int64Pointer(1), Cursor: models.StringPointer("")}
+		_, _, err := orderFetcher.ListOrders(suite.AppContextWithSessionForTest(&session), officeUser.ID, roles.RoleTypeServicesCounselor, &params)
+		suite.NoError(err)
+		suite.NotNil(params.NextCursor)
+
+		params = services.ListOrderParams{Sort: models.StringPointer("locator"), Order: models.StringPointer("asc"), Status: statuses, PerPage: models.Int64Pointer(1), Cursor: params.NextCursor}
+		_, _, err = orderFetcher.ListOrders(suite.AppContextWithSessionForTest(&session), officeUser.ID, roles.RoleTypeServicesCounselor, &params)
+		suite.IsType(apperror.InvalidInputError{}, err)
+	})
+
+	suite.Run("is not supported by the task order queue", func() {
+		session, officeUser, _ := setupTestData()
+
+		params := services.ListOrderParams{Cursor: models.StringPointer("")}
+		_, _, err := orderFetcher.ListOriginRequestsOrders(suite.AppContextWithSessionForTest(&session), officeUser.ID, &params)
+		suite.IsType(apperror.InvalidInputError{}, err)
+	})
+}
+
 func (suite *OrderServiceSuite) TestListOrdersWithSortOrder() {
 
 	// SET UP: Service Members for sorting by Service Member Last Name and Branch
@@ -3069,6 +3148,83 @@ func (suite *OrderServiceSuite) TestListOrdersWithViewAsGBLOCParam() {
 	})
 }
 
+func (suite *OrderServiceSuite) TestListOrdersOutsideOfficeUserArea() {
+	waf := entitlements.NewWeightAllotmentFetcher()
+	orderFetcher := NewOrderFetcher(waf)
+
+	factory.FetchOrBuildPostalCodeToGBLOC(suite.DB(), "06001", "AGFM")
+	agfmShipment := factory.BuildMTOShipment(suite.DB(), []factory.Customization{
+		{
+			Model: models.TransportationOffice{
+				Gbloc: "AGFM",
+			},
+		},
+		{
+			Model: models.Order{
+				OriginDutyLocationGBLOC: models.StringPointer("AGFM"),
+				DestinationGBLOC:        models.StringPointer("AGFM"),
+			},
+		},
+		{
+			Model: models.MTOShipment{
+				Status: models.MTOShipmentStatusSubmitted,
+			},
+		},
+		{
+			Model: models.Address{
+				PostalCode: "06001",
+			},
+			Type: &factory.Addresses.PickupAddress,
+		},
+	}, nil)
+
+	// the TOO works out of an LKNQ office, so viewing the AGFM queue shows them nothing
+	tooOfficeUser := factory.BuildOfficeUserWithRoles(suite.DB(), []factory.Customization{
+		{
+			Model: models.TransportationOffice{
+				Gbloc: "LKNQ",
+			},
+		},
+	}, []roles.RoleType{roles.RoleTypeTOO})
+	session := auth.Session{
+		ApplicationName: auth.OfficeApp,
+		ActiveRole:      tooOfficeUser.User.Roles[0],
+		OfficeUserID:    tooOfficeUser.ID,
+		IDToken:         "fake_token",
+		AccessToken:     "fakeAccessToken",
+	}
+	params := services.ListOrderParams{ViewAsGBLOC: models.StringPointer("AGFM")}
+
+	moves, moveCount, err := orderFetcher.ListOriginRequestsOrders(suite.AppContextWithSessionForTest(&session), tooOfficeUser.ID, &params)
+	suite.NoError(err)
+	suite.Equal(0, moveCount)
+	suite.Len(moves, 0)
+
+	moves, moveCount, err = orderFetcher.ListOrders(suite.AppContextWithSessionForTest(&session), tooOfficeUser.ID, roles.RoleTypeTOO, &params)
+	suite.NoError(err)
+	suite.Equal(0, moveCount)
+	suite.Len(moves, 0)
+
+	// once the TOO is also assigned to an AGFM office the move is in their area
+	factory.BuildAlternateTransportationOfficeAssignment(suite.DB(), []factory.Customization{
+		{
+			Model:    tooOfficeUser,
+			LinkOnly: true,
+		},
+		{
+			Model: models.TransportationOffice{
+				Gbloc: "AGFM",
+			},
+		},
+	}, nil)
+
+	moves, moveCount, err = orderFetcher.ListOriginRequestsOrders(suite.AppContextWithSessionForTest(&session), tooOfficeUser.ID, &params)
+	suite.NoError(err)
+	suite.Equal(1, moveCount)
+	suite.Len(moves, 1)
+	suite.Equal(agfmShipment.MoveTaskOrderID, moves[0].ID)
+}
+
 func (suite *OrderServiceSuite) TestListOrdersForTOOWithPPMWithDeletedShipment() {
 	postalCode := "50309"
 	deletedAt := time.Now()
@@ -5,7 +5,21 @@ type Pagination interface {
 	Page() int
 	PerPage() int
 	Offset() int
+	// Cursor returns the position to continue after, or nil when paging by page and perPage
+	Cursor() *PaginationCursor
+}
+
+// PaginationCursor is the decoded form of an opaque keyset pagination cursor. It holds the values of the
+// ordering columns of the last row of the previous page, ending with the row's unique tiebreaker.
+type PaginationCursor struct {
+	// Sort names the ordering the cursor was made for, so a cursor can't be reused with another sort
+	Sort string
+	// Values are the text values of the ordering columns of the last row; nil is a NULL value
+	Values []*string
 }
 
 // NewPagination creates a new Pagination interface
 type NewPagination func(page *int64, perPage *int64) Pagination
+
+// NewCursorPagination creates a Pagination that continues after an opaque cursor
+type NewCursorPagination func(cursor *string, perPage *int64) (Pagination, error)
@@ -1,10 +1,22 @@
 package pagination
 
-import "github.com/transcom/mymove/pkg/services"
+import (
+	"database/sql"
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+
+	"github.com/gofrs/uuid"
+	"github.com/lib/pq"
+
+	"github.com/transcom/mymove/pkg/apperror"
+	"github.com/transcom/mymove/pkg/services"
+)
 
 type pagination struct {
 	page    int
 	perPage int
+	cursor  *services.PaginationCursor
 }
 
 // Page represents the page number
@@ -22,6 +34,11 @@ func (p pagination) Offset() int {
 	return int((p.Page() - 1) * p.PerPage())
 }
 
+// Cursor returns the position to continue after, or nil for page based pagination
+func (p pagination) Cursor() *services.PaginationCursor {
+	return p.cursor
+}
+
 // DefaultPage returns the default page
 func DefaultPage() int64 {
 	return 1
@@ -35,10 +52,130 @@ func DefaultPerPage() int64 {
 // NewPagination creates a new pagination object
 func NewPagination(page *int64, perPage *int64) services.Pagination {
 	if page == nil {
-		return pagination{int(DefaultPage()), int(DefaultPerPage())}
+		return pagination{int(DefaultPage()), int(DefaultPerPage()), nil}
 	}
 
 	pageValue, perPageValue := int(*page), int(*perPage)
 
-	return pagination{pageValue, perPageValue}
+	return pagination{pageValue, perPageValue, nil}
+}
+
+// NewCursorPagination creates a pagination object that continues after an opaque cursor. Without a cursor
+// it starts at the first row, so the first page of a keyset paged list is asked for the same way as the rest.
+func NewCursorPagination(cursor *string, perPage *int64) (services.Pagination, error) {
+	perPageValue := int(DefaultPerPage())
+	if perPage != nil {
+		perPageValue = int(*perPage)
+	}
+
+	if cursor == nil || *cursor == "" {
+		return pagination{int(DefaultPage()), perPageValue, &services.PaginationCursor{}}, nil
+	}
+
+	decoded, err := DecodeCursor(*cursor)
+	if err != nil {
+		return nil, err
+	}
+	return pagination{int(DefaultPage()), perPageValue, decoded}, nil
+}
+
+// cursorJSON is what an opaque cursor holds once base64 decoded. The keys are short to keep cursors short.
+type cursorJSON struct {
+	Sort   string    `json:"s"`
+	Values []*string `json:"v"`
+}
+
+// EncodeCursor returns the opaque cursor for continuing after a row with the given ordering column values
+func EncodeCursor(sort string, values []*string) string {
+	// marshaling a struct of strings can't fail
+	b, _ := json.Marshal(cursorJSON{Sort: sort, Values: values})
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// DecodeCursor reads an opaque cursor made by EncodeCursor
+func DecodeCursor(cursor string) (*services.PaginationCursor, error) {
+	b, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, apperror.NewBadDataError("invalid pagination cursor")
+	}
+
+	var decoded cursorJSON
+	if err = json.Unmarshal(b, &decoded); err != nil || len(decoded.Values) == 0 {
+		return nil, apperror.NewBadDataError("invalid pagination cursor")
+	}
+
+	return &services.PaginationCursor{Sort: decoded.Sort, Values: decoded.Values}, nil
+}
+
+// CheckCursorSort returns an InvalidInputError when a cursor was made for another ordering than the one requested
+func CheckCursorSort(cursor *services.PaginationCursor, sort string, columnCount int) error {
+	if cursor == nil || len(cursor.Values) == 0 {
+		return nil
+	}
+	if cursor.Sort != sort || len(cursor.Values) != columnCount {
+		return apperror.NewInvalidInputError(uuid.Nil, nil, nil, "pagination cursor was made for a different sort order")
+	}
+	return nil
+}
+
+// sortCursorName names the ordering of a list ordered by a sort name and direction, like the queues database
+// functions page, rather than by columns
+func sortCursorName(sort *string, order *string) string {
+	var name []string
+	if sort != nil {
+		name = append(name, *sort)
+	}
+	if order != nil {
+		name = append(name, *order)
+	}
+	return strings.Join(name, " ")
+}
+
+// DecodeSortCursor reads the cursor for a list ordered by a sort name and direction. It returns the sort values of
+// the row to continue after, which are nil for the first page, or an InvalidInputError when the cursor was made for
+// another sort or direction.
+func DecodeSortCursor(cursor string, sort *string, order *string) ([]*string, error) {
+	if cursor == "" {
+		return nil, nil
+	}
+
+	decoded, err := DecodeCursor(cursor)
+	if err != nil {
+		return nil, err
+	}
+	if err = CheckCursorSort(decoded, sortCursorName(sort, order), len(decoded.Values)); err != nil {
+		return nil, err
+	}
+	return decoded.Values, nil
+}
+
+// SortValuesParam returns sort values as a text array query parameter, or NULL for the first page
+func SortValuesParam(values []*string) interface{} {
+	if len(values) == 0 {
+		return nil
+	}
+
+	array := make([]sql.NullString, len(values))
+	for i, value := range values {
+		if value != nil {
+			array[i] = sql.NullString{String: *value, Valid: true}
+		}
+	}
+	return pq.Array(array)
+}
+
+// NextSortCursor returns the cursor for the page after a page of a list ordered by a sort name and direction, or
+// nil when the page was the last one. sortValues is the JSON array of the sort values on the page's last row.
+func NextSortCursor(sort *string, order *string, sortValues json.RawMessage, rowCount int, perPage int) (*string, error) {
+	if rowCount == 0 || rowCount < perPage {
+		return nil, nil
+	}
+
+	var values []*string
+	if err := json.Unmarshal(sortValues, &values); err != nil {
+		return nil, err
+	}
+
+	cursor := EncodeCursor(sortCursorName(sort, order), values)
+	return &cursor, nil
 }
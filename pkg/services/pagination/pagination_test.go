@@ -1,5 +1,12 @@
 package pagination
 
+import (
+	"encoding/json"
+
+	"github.com/transcom/mymove/pkg/apperror"
+	"github.com/transcom/mymove/pkg/services"
+)
+
 func (suite *PaginationServiceSuite) TestOffset() {
 	suite.Run("should return the correct offset for a given page", func() {
 		page, perPage := int64(4), int64(25)
@@ -8,3 +15,86 @@ func (suite *PaginationServiceSuite) TestOffset() {
 		suite.Equal(75, pagination.Offset())
 	})
 }
+
+func (suite *PaginationServiceSuite) TestCursorPagination() {
+	suite.Run("starts at the first row without a cursor", func() {
+		perPage := int64(10)
+		pagination, err := NewCursorPagination(nil, &perPage)
+
+		suite.NoError(err)
+		suite.Equal(10, pagination.PerPage())
+		suite.Equal(0, pagination.Offset())
+		suite.NotNil(pagination.Cursor())
+		suite.Empty(pagination.Cursor().Values)
+	})
+
+	suite.Run("continues after an encoded cursor", func() {
+		createdAt, id := "2024-01-02T03:04:05.123456Z", "7b8ba5a8-0e67-4e58-b8b0-5ac3e2a1c5ee"
+		cursor := EncodeCursor("created_at desc, id desc", []*string{&createdAt, nil, &id})
+
+		pagination, err := NewCursorPagination(&cursor, nil)
+
+		suite.NoError(err)
+		suite.Equal(int(DefaultPerPage()), pagination.PerPage())
+		suite.Equal("created_at desc, id desc", pagination.Cursor().Sort)
+		suite.Equal([]*string{&createdAt, nil, &id}, pagination.Cursor().Values)
+	})
+
+	suite.Run("rejects a cursor it didn't make", func() {
+		for _, cursor := range []string{"not a cursor!", "e30"} {
+			_, err := NewCursorPagination(&cursor, nil)
+			suite.IsType(&apperror.BadDataError{}, err)
+		}
+	})
+
+	suite.Run("rejects a cursor made for another sort", func() {
+		id := "7b8ba5a8-0e67-4e58-b8b0-5ac3e2a1c5ee"
+		cursor, err := DecodeCursor(EncodeCursor("id asc", []*string{&id}))
+		suite.NoError(err)
+
+		suite.NoError(CheckCursorSort(cursor, "id asc", 1))
+		suite.IsType(apperror.InvalidInputError{}, CheckCursorSort(cursor, "id desc", 1))
+		suite.IsType(apperror.InvalidInputError{}, CheckCursorSort(cursor, "id asc", 2))
+		suite.NoError(CheckCursorSort(&services.PaginationCursor{}, "id desc", 1))
+	})
+}
+
+func (suite *PaginationServiceSuite) TestSortCursor() {
+	sort, order := "customerName", "desc"
+
+	suite.Run("continues a sort after the last row of a full page", func() {
+		cursor, err := NextSortCursor(&sort, &order, json.RawMessage(`["Smith", null, "ABC123"]`), 2, 2)
+		suite.NoError(err)
+		suite.NotNil(cursor)
+
+		values, err := DecodeSortCursor(*cursor, &sort, &order)
+		suite.NoError(err)
+		suite.Len(values, 3)
+		suite.Equal("Smith", *values[0])
+		suite.Nil(values[1])
+		suite.Equal("ABC123", *values[2])
+	})
+
+	suite.Run("has no cursor after the last page", func() {
+		cursor, err := NextSortCursor(&sort, &order, json.RawMessage(`["Smith", "ABC123"]`), 1, 2)
+		suite.NoError(err)
+		suite.Nil(cursor)
+	})
+
+	suite.Run("starts at the first row for an empty cursor", func() {
+		values, err := DecodeSortCursor("", &sort, &order)
+		suite.NoError(err)
+		suite.Nil(values)
+	})
+
+	suite.Run("rejects a cursor made for another sort", func() {
+		cursor, err := NextSortCursor(&sort, &order, json.RawMessage(`["Smith", "ABC123"]`), 2, 2)
+		suite.NoError(err)
+
+		asc := "asc"
+		_, err = DecodeSortCursor(*cursor, &sort, &asc)
+		suite.IsType(apperror.InvalidInputError{}, err)
+		_, err = DecodeSortCursor(*cursor, nil, nil)
+		suite.IsType(apperror.InvalidInputError{}, err)
+	})
+}
@@ -95,6 +95,11 @@ type FetchPaymentRequestListParams struct {
 	ViewAsGBLOC             *string
 	TIOAssignedUser         *string
 	CounselingOffice        *string
+	// Cursor asks for the page after an opaque cursor instead of a page number. An empty cursor asks for the
+	// first page.
+	Cursor *string
+	// NextCursor is set to the cursor for the following page when Cursor was given
+	NextCursor *string
 }
 
 // ShipmentPaymentSITBalance is a public struct that's used to return current SIT balances to the TIO for a payment
@@ -0,0 +1,202 @@
+package paymentrequest
+
+import (
+	"fmt"
+
+	"github.com/gobuffalo/validate/v3"
+	"github.com/gobuffalo/validate/v3/validators"
+	"github.com/gofrs/uuid"
+
+	"github.com/transcom/mymove/pkg/appcontext"
+	"github.com/transcom/mymove/pkg/apperror"
+	"github.com/transcom/mymove/pkg/models"
+	"github.com/transcom/mymove/pkg/services"
+)
+
+// BulkRecalculationEventName is the audit event name recorded for payment requests recalculated in bulk
+const BulkRecalculationEventName = "bulkRecalculatePaymentRequests"
+
+type bulkPaymentRequestRecalculator struct {
+	paymentRequestRecalculator services.PaymentRequestRecalculator
+}
+
+// NewBulkPaymentRequestRecalculator returns a new recalculator for pending payment requests matching a set of filters
+func NewBulkPaymentRequestRecalculator(paymentRequestRecalculator services.PaymentRequestRecalculator) services.BulkPaymentRequestRecalculator {
+	return &bulkPaymentRequestRecalculator{
+		paymentRequestRecalculator: paymentRequestRecalculator,
+	}
+}
+
+// RecalculatePaymentRequests recalculates each pending payment request matching the params and reports how
+// the price of each service item changed. Each payment request is recalculated inside a savepoint; unless
+// the params say to apply the recalculation, the savepoint is rolled back once the new prices are known.
+// A payment request that fails to recalculate is reported and does not stop the others.
+func (p *bulkPaymentRequestRecalculator) RecalculatePaymentRequests(appCtx appcontext.AppContext, params services.BulkRecalculationParams) (*services.BulkRecalculationReport, error) {
+	verrs := validateBulkRecalculationParams(params)
+	if verrs.HasAny() {
+		return nil, apperror.NewInvalidInputError(uuid.Nil, nil, verrs, "invalid bulk recalculation parameters")
+	}
+
+	paymentRequests, err := findPaymentRequestsForBulkRecalculation(appCtx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	report := services.BulkRecalculationReport{Applied: params.Apply}
+	for _, paymentRequest := range paymentRequests {
+		var recalculated services.BulkRecalculatedPaymentRequest
+		transactionError := appCtx.NewTransaction(func(txnAppCtx appcontext.AppContext) error {
+			var err error
+			recalculated, err = p.recalculateInSavepoint(txnAppCtx, paymentRequest, params.Apply)
+			return err
+		})
+		if transactionError != nil {
+			return nil, transactionError
+		}
+
+		report.PaymentRequests = append(report.PaymentRequests, recalculated)
+		switch {
+		case recalculated.Error != "":
+			report.FailedCount++
+		case recalculated.DeltaCents != 0:
+			report.ChangedCount++
+		default:
+			report.UnchangedCount++
+		}
+		report.DeltaCents = report.DeltaCents.AddCents(recalculated.DeltaCents)
+	}
+
+	return &report, nil
+}
+
+// recalculateInSavepoint recalculates a payment request inside a savepoint, which is released when applying
+// and rolled back otherwise. pop doesn't know about nested transactions, so the savepoint is managed here;
+// it also keeps a failed recalculation from aborting the enclosing transaction.
+func (p *bulkPaymentRequestRecalculator) recalculateInSavepoint(appCtx appcontext.AppContext, paymentRequest models.PaymentRequest, apply bool) (services.BulkRecalculatedPaymentRequest, error) {
+	recalculated := services.BulkRecalculatedPaymentRequest{
+		PaymentRequestID:     paymentRequest.ID,
+		PaymentRequestNumber: paymentRequest.PaymentRequestNumber,
+	}
+
+	err := appCtx.DB().RawQuery("SET LOCAL audit.current_event_name = '" + BulkRecalculationEventName + "'").Exec()
+	if err != nil {
+		return recalculated, apperror.NewQueryError("PaymentRequest", err, "unable to set audit event name")
+	}
+	err = appCtx.DB().RawQuery("SAVEPOINT bulk_recalculation").Exec()
+	if err != nil {
+		return recalculated, apperror.NewQueryError("PaymentRequest", err, "unable to start bulk recalculation savepoint")
+	}
+
+	newPaymentRequest, recalculateErr := p.paymentRequestRecalculator.RecalculatePaymentRequest(appCtx, paymentRequest.ID)
+	if recalculateErr == nil {
+		recalculated.ServiceItems = compareRecalculatedServiceItems(paymentRequest.PaymentServiceItems, newPaymentRequest.PaymentServiceItems)
+		for _, item := range recalculated.ServiceItems {
+			recalculated.OldTotalCents = recalculated.OldTotalCents.AddCents(item.OldPriceCents)
+			recalculated.NewTotalCents = recalculated.NewTotalCents.AddCents(item.NewPriceCents)
+		}
+		recalculated.DeltaCents = recalculated.NewTotalCents - recalculated.OldTotalCents
+	} else {
+		recalculated.Error = recalculateErr.Error()
+	}
+
+	if recalculateErr != nil || !apply {
+		err = appCtx.DB().RawQuery("ROLLBACK TO SAVEPOINT bulk_recalculation").Exec()
+		if err != nil {
+			return recalculated, apperror.NewQueryError("PaymentRequest", err, "unable to roll back bulk recalculation savepoint")
+		}
+		return recalculated, nil
+	}
+
+	err = appCtx.DB().RawQuery("RELEASE SAVEPOINT bulk_recalculation").Exec()
+	if err != nil {
+		return recalculated, apperror.NewQueryError("PaymentRequest", err, "unable to release bulk recalculation savepoint")
+	}
+	recalculated.NewPaymentRequestID = &newPaymentRequest.ID
+	recalculated.NewPaymentRequestNumber = &newPaymentRequest.PaymentRequestNumber
+	return recalculated, nil
+}
+
+// compareRecalculatedServiceItems pairs the old and new payment service items by their MTO service item.
+// Items that were never priced count as zero.
+func compareRecalculatedServiceItems(oldItems models.PaymentServiceItems, newItems models.PaymentServiceItems) []services.BulkRecalculatedServiceItem {
+	newItemsByMTOServiceItem := make(map[uuid.UUID]models.PaymentServiceItem, len(newItems))
+	for _, newItem := range newItems {
+		newItemsByMTOServiceItem[newItem.MTOServiceItemID] = newItem
+	}
+
+	compared := make([]services.BulkRecalculatedServiceItem, 0, len(oldItems))
+	for _, oldItem := range oldItems {
+		item := services.BulkRecalculatedServiceItem{
+			MTOServiceItemID: oldItem.MTOServiceItemID,
+			ServiceCode:      oldItem.MTOServiceItem.ReService.Code,
+		}
+		if oldItem.PriceCents != nil {
+			item.OldPriceCents = *oldItem.PriceCents
+		}
+		if newItem, ok := newItemsByMTOServiceItem[oldItem.MTOServiceItemID]; ok && newItem.PriceCents != nil {
+			item.NewPriceCents = *newItem.PriceCents
+		}
+		item.DeltaCents = item.NewPriceCents - item.OldPriceCents
+		compared = append(compared, item)
+	}
+	return compared
+}
+
+func findPaymentRequestsForBulkRecalculation(appCtx appcontext.AppContext, params services.BulkRecalculationParams) (models.PaymentRequests, error) {
+	query := appCtx.DB().
+		EagerPreload("PaymentServiceItems.MTOServiceItem.ReService").
+		Where("payment_requests.status = ?", models.PaymentRequestStatusPending).
+		Order("payment_requests.created_at ASC")
+	if params.ContractCode != nil {
+		query.Where(`EXISTS (
+			SELECT 1
+			FROM payment_service_items psi
+			JOIN payment_service_item_params psip ON psip.payment_service_item_id = psi.id
+			JOIN service_item_param_keys sipk ON sipk.id = psip.service_item_param_key_id
+			WHERE psi.payment_request_id = payment_requests.id
+				AND sipk.key = ?
+				AND psip.value = ?)`, models.ServiceItemParamNameContractCode, *params.ContractCode)
+	}
+	if params.CreatedFrom != nil {
+		query.Where("payment_requests.created_at >= ?", *params.CreatedFrom)
+	}
+	if params.CreatedTo != nil {
+		query.Where("payment_requests.created_at < ?", params.CreatedTo.AddDate(0, 0, 1))
+	}
+	if len(params.ServiceCodes) > 0 {
+		query.Where(`EXISTS (
+			SELECT 1
+			FROM payment_service_items psi
+			JOIN mto_service_items msi ON msi.id = psi.mto_service_item_id
+			JOIN re_services rs ON rs.id = msi.re_service_id
+			WHERE psi.payment_request_id = payment_requests.id
+				AND rs.code IN (?))`, params.ServiceCodes)
+	}
+	if params.GBLOC != nil {
+		query.Where("payment_requests.move_id IN (SELECT move_id FROM move_to_gbloc WHERE gbloc = ?)", *params.GBLOC)
+	}
+
+	var paymentRequests models.PaymentRequests
+	err := query.All(&paymentRequests)
+	if err != nil {
+		return nil, apperror.NewQueryError("PaymentRequest", err, fmt.Sprintf("unable to find payment requests to recalculate: %s", err))
+	}
+	return paymentRequests, nil
+}
+
+func validateBulkRecalculationParams(params services.BulkRecalculationParams) *validate.Errors {
+	verrs := validate.NewErrors()
+	if params.ContractCode == nil && params.CreatedFrom == nil && params.CreatedTo == nil && len(params.ServiceCodes) == 0 && params.GBLOC == nil {
+		verrs.Add(validators.GenerateKey("ContractCode"), "at least one filter must be given to pick the payment requests to recalculate")
+	}
+	if params.ContractCode != nil && *params.ContractCode == "" {
+		verrs.Add(validators.GenerateKey("ContractCode"), "ContractCode can not be blank")
+	}
+	if params.GBLOC != nil && *params.GBLOC == "" {
+		verrs.Add(validators.GenerateKey("GBLOC"), "GBLOC can not be blank")
+	}
+	if params.CreatedFrom != nil && params.CreatedTo != nil && params.CreatedTo.Before(*params.CreatedFrom) {
+		verrs.Add(validators.GenerateKey("CreatedTo"), "CreatedTo must not be before CreatedFrom")
+	}
+	return verrs
+}
@@ -0,0 +1,185 @@
+package paymentrequest
+
+import (
+	"errors"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/transcom/mymove/pkg/apperror"
+	"github.com/transcom/mymove/pkg/factory"
+	"github.com/transcom/mymove/pkg/models"
+	routemocks "github.com/transcom/mymove/pkg/route/mocks"
+	"github.com/transcom/mymove/pkg/services"
+	"github.com/transcom/mymove/pkg/services/ghcrateengine"
+	"github.com/transcom/mymove/pkg/services/mocks"
+	"github.com/transcom/mymove/pkg/services/query"
+)
+
+func (suite *PaymentRequestServiceSuite) TestBulkRecalculatePaymentRequests() {
+	// Mock out a planner.
+	mockPlanner := &routemocks.Planner{}
+	mockPlanner.On("ZipTransitDistance",
+		mock.AnythingOfType("*appcontext.appContext"),
+		recalculateTestPickupZip,
+		recalculateTestDestinationZip,
+	).Return(recalculateTestZip3Distance, nil)
+
+	creator := NewPaymentRequestCreator(mockPlanner, ghcrateengine.NewServiceItemPricer())
+	statusUpdater := NewPaymentRequestStatusUpdater(query.NewQueryBuilder())
+	bulkRecalculator := NewBulkPaymentRequestRecalculator(NewPaymentRequestRecalculator(creator, statusUpdater))
+
+	// setupPendingPaymentRequest creates a pending payment request and then changes the shipment's weight
+	// so a recalculation prices it differently
+	setupPendingPaymentRequest := func() *models.PaymentRequest {
+		move, paymentRequestArg := suite.setupRecalculateData1()
+		paymentRequest, err := creator.CreatePaymentRequestCheck(suite.AppContextForTest(), &paymentRequestArg)
+		suite.FatalNoError(err)
+
+		mtoShipment := move.MTOShipments[0]
+		newWeight := recalculateTestNewOriginalWeight
+		mtoShipment.PrimeActualWeight = &newWeight
+		suite.MustSave(&mtoShipment)
+		return paymentRequest
+	}
+
+	suite.Run("Dry run reports the deltas without saving a new payment request", func() {
+		paymentRequest := setupPendingPaymentRequest()
+
+		report, err := bulkRecalculator.RecalculatePaymentRequests(suite.AppContextForTest(), services.BulkRecalculationParams{
+			ServiceCodes: []models.ReServiceCode{models.ReServiceCodeDLH},
+		})
+		suite.NoError(err)
+		suite.False(report.Applied)
+		suite.Len(report.PaymentRequests, 1)
+		suite.Equal(1, report.ChangedCount)
+		suite.Equal(0, report.FailedCount)
+
+		recalculated := report.PaymentRequests[0]
+		suite.Equal(paymentRequest.ID, recalculated.PaymentRequestID)
+		suite.Empty(recalculated.Error)
+		suite.Nil(recalculated.NewPaymentRequestID)
+		suite.Len(recalculated.ServiceItems, len(paymentRequest.PaymentServiceItems))
+		suite.NotZero(recalculated.DeltaCents)
+		suite.Equal(recalculated.DeltaCents, report.DeltaCents)
+		for _, item := range recalculated.ServiceItems {
+			suite.Equal(item.NewPriceCents-item.OldPriceCents, item.DeltaCents)
+			switch item.ServiceCode {
+			case models.ReServiceCodeMS, models.ReServiceCodeCS:
+				suite.Zero(item.DeltaCents, "%s does not depend on weight", item.ServiceCode)
+			case models.ReServiceCodeDLH:
+				suite.Negative(item.DeltaCents.Int64(), "a lower weight should lower the linehaul price")
+			}
+		}
+
+		var paymentRequests models.PaymentRequests
+		err = suite.DB().Where("move_id = ?", paymentRequest.MoveTaskOrderID).All(&paymentRequests)
+		suite.NoError(err)
+		suite.Len(paymentRequests, 1)
+		suite.Equal(models.PaymentRequestStatusPending, paymentRequests[0].Status)
+	})
+
+	suite.Run("Apply saves the recalculated payment request", func() {
+		paymentRequest := setupPendingPaymentRequest()
+		createdOn := paymentRequest.CreatedAt
+
+		report, err := bulkRecalculator.RecalculatePaymentRequests(suite.AppContextForTest(), services.BulkRecalculationParams{
+			CreatedFrom: &createdOn,
+			CreatedTo:   &createdOn,
+			Apply:       true,
+		})
+		suite.NoError(err)
+		suite.True(report.Applied)
+		suite.Len(report.PaymentRequests, 1)
+
+		recalculated := report.PaymentRequests[0]
+		suite.Empty(recalculated.Error)
+		suite.FatalNotNil(recalculated.NewPaymentRequestID)
+		suite.NotNil(recalculated.NewPaymentRequestNumber)
+
+		var oldPaymentRequest models.PaymentRequest
+		suite.NoError(suite.DB().Find(&oldPaymentRequest, paymentRequest.ID))
+		suite.Equal(models.PaymentRequestStatusDeprecated, oldPaymentRequest.Status)
+
+		var newPaymentRequest models.PaymentRequest
+		suite.NoError(suite.DB().Find(&newPaymentRequest, *recalculated.NewPaymentRequestID))
+		suite.Equal(models.PaymentRequestStatusPending, newPaymentRequest.Status)
+		if suite.NotNil(newPaymentRequest.RecalculationOfPaymentRequestID) {
+			suite.Equal(paymentRequest.ID, *newPaymentRequest.RecalculationOfPaymentRequestID)
+		}
+	})
+
+	suite.Run("Payment requests that don't match the filters are left alone", func() {
+		setupPendingPaymentRequest()
+		otherGBLOC := "ZZZZ"
+		otherContract := "NOT_A_CONTRACT"
+
+		report, err := bulkRecalculator.RecalculatePaymentRequests(suite.AppContextForTest(), services.BulkRecalculationParams{
+			GBLOC: &otherGBLOC,
+		})
+		suite.NoError(err)
+		suite.Empty(report.PaymentRequests)
+
+		report, err = bulkRecalculator.RecalculatePaymentRequests(suite.AppContextForTest(), services.BulkRecalculationParams{
+			ContractCode: &otherContract,
+		})
+		suite.NoError(err)
+		suite.Empty(report.PaymentRequests)
+
+		report, err = bulkRecalculator.RecalculatePaymentRequests(suite.AppContextForTest(), services.BulkRecalculationParams{
+			ServiceCodes: []models.ReServiceCode{models.ReServiceCodeIHPK},
+		})
+		suite.NoError(err)
+		suite.Empty(report.PaymentRequests)
+	})
+
+	suite.Run("A failed recalculation is reported without stopping the others", func() {
+		paymentRequest := factory.BuildPaymentRequest(suite.DB(), []factory.Customization{
+			{
+				Model: models.PaymentRequest{
+					Status: models.PaymentRequestStatusPending,
+				},
+			},
+		}, nil)
+		createdOn := paymentRequest.CreatedAt
+
+		errString := "mock recalculator test error"
+		mockRecalculator := &mocks.PaymentRequestRecalculator{}
+		mockRecalculator.On("RecalculatePaymentRequest",
+			mock.AnythingOfType("*appcontext.appContext"),
+			paymentRequest.ID,
+		).Return(nil, errors.New(errString))
+
+		report, err := NewBulkPaymentRequestRecalculator(mockRecalculator).RecalculatePaymentRequests(suite.AppContextForTest(), services.BulkRecalculationParams{
+			CreatedFrom: &createdOn,
+			CreatedTo:   &createdOn,
+			Apply:       true,
+		})
+		suite.NoError(err)
+		suite.Len(report.PaymentRequests, 1)
+		suite.Equal(1, report.FailedCount)
+		suite.Equal(errString, report.PaymentRequests[0].Error)
+		suite.Nil(report.PaymentRequests[0].NewPaymentRequestID)
+
+		// the enclosing transaction is still usable after the savepoint was rolled back
+		var reloaded models.PaymentRequest
+		suite.NoError(suite.DB().Find(&reloaded, paymentRequest.ID))
+		suite.Equal(models.PaymentRequestStatusPending, reloaded.Status)
+	})
+
+	suite.Run("At least one filter is required", func() {
+		report, err := bulkRecalculator.RecalculatePaymentRequests(suite.AppContextForTest(), services.BulkRecalculationParams{Apply: true})
+		suite.Nil(report)
+		suite.IsType(apperror.InvalidInputError{}, err)
+	})
+
+	suite.Run("Created dates must be in order", func() {
+		createdFrom := recalculateSITEntryDate
+		createdTo := createdFrom.AddDate(0, 0, -1)
+		report, err := bulkRecalculator.RecalculatePaymentRequests(suite.AppContextForTest(), services.BulkRecalculationParams{
+			CreatedFrom: &createdFrom,
+			CreatedTo:   &createdTo,
+		})
+		suite.Nil(report)
+		suite.IsType(apperror.InvalidInputError{}, err)
+	})
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/transcom/mymove/pkg/models/roles"
 	"github.com/transcom/mymove/pkg/services"
 	officeuser "github.com/transcom/mymove/pkg/services/office_user"
+	"github.com/transcom/mymove/pkg/services/pagination"
 )
 
 type paymentRequestListFetcher struct {
@@ -35,6 +36,7 @@ type paymentRequestRow struct {
 	OriginToOffice   json.RawMessage `db:"origin_to_office"`
 	TIOUser          json.RawMessage `db:"tio_user"`
 	CounselingOffice json.RawMessage `db:"counseling_office"`
+	SortValues       json.RawMessage `db:"sort_values"`
 	TotalCount       int             `db:"total_count"`
 }
 
@@ -57,10 +59,24 @@ func (f *paymentRequestListFetcher) FetchPaymentRequestList(appCtx appcontext.Ap
 	}
 	hasSafetyPrivilege := privileges.HasPrivilege(roles.PrivilegeTypeSafety)
 
+	// the queue continues after a cursor's sort values instead of skipping to a page
+	page, perPage := params.Page, params.PerPage
+	var afterSortValues []*string
+	if params.Cursor != nil {
+		afterSortValues, err = pagination.DecodeSortCursor(*params.Cursor, params.Sort, params.Order)
+		if err != nil {
+			return nil, 0, err
+		}
+		page = models.Int64Pointer(1)
+		if perPage == nil || *perPage < 1 {
+			perPage = models.Int64Pointer(20)
+		}
+	}
+
 	var rows []paymentRequestRow
 	err = appCtx.DB().
 		RawQuery(
-			`SELECT * FROM get_payment_request_queue($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14,$15,$16)`,
+			`SELECT * FROM get_payment_request_queue($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14,$15,$16,$17,$18)`,
 			gbloc,
 			params.Branch,
 			params.Locator,
@@ -73,16 +89,25 @@ func (f *paymentRequestListFetcher) FetchPaymentRequestList(appCtx appcontext.Ap
 			params.TIOAssignedUser,
 			params.CounselingOffice,
 			hasSafetyPrivilege,
-			params.Page,
-			params.PerPage,
+			page,
+			perPage,
 			params.Sort,
 			params.Order,
+			models.MoveAccessOfficeUserID(appCtx.Session()),
+			pagination.SortValuesParam(afterSortValues),
 		).
 		All(&rows)
 	if err != nil {
 		return nil, 0, err
 	}
 
+	if params.Cursor != nil && len(rows) > 0 {
+		params.NextCursor, err = pagination.NextSortCursor(params.Sort, params.Order, rows[len(rows)-1].SortValues, len(rows), int(*perPage))
+		if err != nil {
+			return nil, 0, err
+		}
+	}
+
 	moves := make(models.PaymentRequests, len(rows))
 	var total int
 	for i, r := range rows {
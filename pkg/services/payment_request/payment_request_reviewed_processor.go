@@ -2,11 +2,13 @@ package paymentrequest
 
 import (
 	"database/sql"
+	"errors"
 	"fmt"
 	"strings"
 	"time"
 
 	"github.com/benbjohnson/clock"
+	"github.com/gobuffalo/validate/v3"
 	"github.com/gofrs/uuid"
 	"github.com/spf13/pflag"
 	"github.com/spf13/viper"
@@ -62,8 +64,9 @@ func NewPaymentRequestReviewedProcessor(
 		notifications:                 notificationSender}
 }
 
-// InitNewPaymentRequestReviewedProcessor initialize NewPaymentRequestReviewedProcessor for production use
-func InitNewPaymentRequestReviewedProcessor(appCtx appcontext.AppContext, sendToSyncada bool, icnSequencer sequence.Sequencer, gexSender services.GexSender) (services.PaymentRequestReviewedProcessor, error) {
+// InitNewPaymentRequestReviewedProcessor initialize NewPaymentRequestReviewedProcessor for production use.
+// When neither gexSender nor sftpSender is given, 858s are sent over an SFTP session configured from the environment.
+func InitNewPaymentRequestReviewedProcessor(appCtx appcontext.AppContext, sendToSyncada bool, icnSequencer sequence.Sequencer, gexSender services.GexSender, sftpSender services.SyncadaSFTPSender) (services.PaymentRequestReviewedProcessor, error) {
 	reviewedPaymentRequestFetcher := NewPaymentRequestReviewedFetcher()
 	tacFetcher := transportationaccountingcode.NewTransportationAccountingCodeFetcher()
 	loaFetcher := lineofaccounting.NewLinesOfAccountingFetcher(tacFetcher)
@@ -78,8 +81,8 @@ func InitNewPaymentRequestReviewedProcessor(appCtx appcontext.AppContext, sendTo
 	if notificationErr != nil {
 		appCtx.Logger().Error("notification sender initialization failed", zap.Error(notificationErr))
 	}
-	var sftpSession services.SyncadaSFTPSender
-	if gexSender == nil {
+	sftpSession := sftpSender
+	if gexSender == nil && sftpSession == nil {
 		var err error
 		sftpSession, err = invoice.InitNewSyncadaSFTPSession()
 		if err != nil {
@@ -134,6 +137,11 @@ func (p *paymentRequestReviewedProcessor) ProcessAndLockReviewedPR(appCtx appcon
 		if err != nil {
 			return fmt.Errorf("function ProcessReviewedPaymentRequest failed call to generator.Generate: %w", err)
 		}
+		// catch the problems Syncada would reject the invoice for before sending it
+		err = edi858c.CheckBusinessRules()
+		if err != nil {
+			return fmt.Errorf("function ProcessReviewedPaymentRequest failed call to edi858c.CheckBusinessRules: %w", err)
+		}
 		var edi858cString string
 		edi858cString, err = edi858c.EDIString(txnAppCtx.Logger())
 		if err != nil {
@@ -173,30 +181,26 @@ func (p *paymentRequestReviewedProcessor) ProcessAndLockReviewedPR(appCtx appcon
 	})
 	paymentRequestNotifier := notifications.NewPaymentRequestFailed(pr)
 	if transactionError != nil {
-		errDescription := transactionError.Error()
-
-		errToSave := models.EdiError{
-			PaymentRequestID:           pr.ID,
-			InterchangeControlNumberID: nil,
-			Code:                       nil,
-			Description:                &errDescription,
-			EDIType:                    models.EDIType858,
-		}
-		verrs, err := appCtx.DB().ValidateAndCreate(&errToSave)
+		var verrs *validate.Errors
+		var err error
+		for _, errToSave := range ediErrorsToSave(pr, transactionError) {
+			errToSave := errToSave
+			verrs, err = appCtx.DB().ValidateAndCreate(&errToSave)
 
-		// We are just logging these errors instead of returning them to avoid obscuring the original error
-		if err != nil {
-			appCtx.Logger().Error(
-				"failed to save EDI 858 error",
-				zap.String("PaymentRequestID", pr.ID.String()),
-				zap.Error(err),
-			)
-		} else if verrs != nil && verrs.HasAny() {
-			appCtx.Logger().Error(
-				"failed to save EDI 858 error due to validation errors",
-				zap.String("PaymentRequestID", pr.ID.String()),
-				zap.Error(verrs),
-			)
+			// We are just logging these errors instead of returning them to avoid obscuring the original error
+			if err != nil {
+				appCtx.Logger().Error(
+					"failed to save EDI 858 error",
+					zap.String("PaymentRequestID", pr.ID.String()),
+					zap.Error(err),
+				)
+			} else if verrs != nil && verrs.HasAny() {
+				appCtx.Logger().Error(
+					"failed to save EDI 858 error due to validation errors",
+					zap.String("PaymentRequestID", pr.ID.String()),
+					zap.Error(verrs),
+				)
+			}
 		}
 
 		switch transactionError.(type) {
@@ -233,6 +237,37 @@ func (p *paymentRequestReviewedProcessor) ProcessAndLockReviewedPR(appCtx appcon
 	return nil
 }
 
+// ediErrorsToSave returns the EDI errors to record for a payment request that could not be sent.
+// Business rule violations are saved one per violation with the TED code Syncada would have used,
+// so they show up the same way as the 824 rejections they prevent.
+func ediErrorsToSave(pr models.PaymentRequest, transactionError error) []models.EdiError {
+	var ruleErr ediinvoice.BusinessRuleError
+	if errors.As(transactionError, &ruleErr) {
+		ediErrors := make([]models.EdiError, len(ruleErr.Violations))
+		for i, violation := range ruleErr.Violations {
+			ted := violation.TED()
+			ediErrors[i] = models.EdiError{
+				PaymentRequestID: pr.ID,
+				Code:             &ted.ApplicationErrorConditionCode,
+				Description:      &ted.FreeFormMessage,
+				EDIType:          models.EDIType858,
+			}
+		}
+		return ediErrors
+	}
+
+	errDescription := transactionError.Error()
+	return []models.EdiError{
+		{
+			PaymentRequestID:           pr.ID,
+			InterchangeControlNumberID: nil,
+			Code:                       nil,
+			Description:                &errDescription,
+			EDIType:                    models.EDIType858,
+		},
+	}
+}
+
 func (p *paymentRequestReviewedProcessor) ProcessReviewedPaymentRequest(appCtx appcontext.AppContext) {
 	// Store/log metrics about EDI processing upon exiting this method.
 	numProcessed := 0
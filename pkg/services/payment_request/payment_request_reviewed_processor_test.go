@@ -15,6 +15,7 @@ import (
 
 	"github.com/transcom/mymove/pkg/db/sequence"
 	ediinvoice "github.com/transcom/mymove/pkg/edi/invoice"
+	edisegment "github.com/transcom/mymove/pkg/edi/segment"
 	"github.com/transcom/mymove/pkg/factory"
 	"github.com/transcom/mymove/pkg/models"
 	"github.com/transcom/mymove/pkg/notifications"
@@ -425,6 +426,51 @@ func (suite *PaymentRequestServiceSuite) TestProcessReviewedPaymentRequest() {
 		suite.Equal(updatedPaymentRequest.Status, models.PaymentRequestStatusEDIError)
 	})
 
+	suite.Run("process reviewed payment request, EDI fails business rules", func() {
+		prs := suite.createPaymentRequest(1)
+
+		reviewedPaymentRequestFetcher := NewPaymentRequestReviewedFetcher()
+		SFTPSession, SFTPSessionError := invoice.InitNewSyncadaSFTPSession()
+		suite.NoError(SFTPSessionError)
+		gexSender := services.GexSender(nil)
+		sendToSyncada := false
+
+		// the L3 total does not match the charges of the (missing) service items
+		edi858c := ediinvoice.Invoice858C{
+			ISA: edisegment.ISA{InterchangeDate: "201002"},
+			GS:  edisegment.GS{Date: "20190903"},
+			L3:  edisegment.L3{PriceCents: 100},
+		}
+		ediGenerator := &mocks.GHCPaymentRequestInvoiceGenerator{}
+		ediGenerator.
+			On("Generate", mock.AnythingOfType("*appcontext.appContext"), mock.MatchedBy(func(pr models.PaymentRequest) bool {
+				return pr.ID == prs[0].ID
+			}), false).Return(edi858c, nil)
+
+		paymentRequestReviewedProcessor := NewPaymentRequestReviewedProcessor(
+			reviewedPaymentRequestFetcher,
+			ediGenerator,
+			sendToSyncada,
+			gexSender,
+			SFTPSession,
+			mockNotificationSender)
+		paymentRequestReviewedProcessor.ProcessReviewedPaymentRequest(suite.AppContextForTest())
+
+		var updatedPaymentRequest models.PaymentRequest
+		err := suite.DB().Where("id = ?", prs[0].ID).First(&updatedPaymentRequest)
+		suite.NoError(err)
+		suite.Equal(models.PaymentRequestStatusEDIError, updatedPaymentRequest.Status)
+		suite.Nil(updatedPaymentRequest.SentToGexAt)
+
+		// Each violation is recorded with the TED code Syncada would have rejected it with
+		var ediErrors models.EdiErrors
+		err = suite.DB().Where("payment_request_id = ?", prs[0].ID).All(&ediErrors)
+		suite.NoError(err)
+		suite.Len(ediErrors, 1)
+		suite.Equal(edisegment.TEDErrorCodeMutuallyDefined, *ediErrors[0].Code)
+		suite.Contains(*ediErrors[0].Description, "L3 total")
+	})
+
 	suite.Run("process reviewed payment request, failed EDI generator (mock GEX HTTP)", func() {
 		var ediProcessingBefore models.EDIProcessing
 		countProcessingRecordsBefore, err := suite.DB().Where("edi_type = ?", models.EDIType858).Count(&ediProcessingBefore)
@@ -668,7 +714,7 @@ func (suite *PaymentRequestServiceSuite) TestProcessReviewedPaymentRequest() {
 	suite.Run("process reviewed payment request, successfully test init function", func() {
 		// Run init with no issues
 		icnSequencer := sequence.NewDatabaseSequencer(ediinvoice.ICNSequenceName)
-		_, err := InitNewPaymentRequestReviewedProcessor(suite.AppContextForTest(), false, icnSequencer, nil)
+		_, err := InitNewPaymentRequestReviewedProcessor(suite.AppContextForTest(), false, icnSequencer, nil, nil)
 		suite.NoError(err)
 	})
 }
@@ -0,0 +1,69 @@
+package services
+
+import (
+	"time"
+
+	"github.com/gofrs/uuid"
+
+	"github.com/transcom/mymove/pkg/appcontext"
+	"github.com/transcom/mymove/pkg/models"
+	"github.com/transcom/mymove/pkg/unit"
+)
+
+// BulkRecalculationParams picks the pending payment requests to recalculate. Every filter that is
+// set must match for a payment request to be picked.
+type BulkRecalculationParams struct {
+	// ContractCode limits the recalculation to payment requests priced under this contract
+	ContractCode *string
+	// CreatedFrom and CreatedTo limit the recalculation to payment requests created within the dates
+	CreatedFrom *time.Time
+	CreatedTo   *time.Time
+	// ServiceCodes limits the recalculation to payment requests with a service item for one of the codes
+	ServiceCodes []models.ReServiceCode
+	// GBLOC limits the recalculation to payment requests for moves in this GBLOC
+	GBLOC *string
+	// Apply saves the recalculated payment requests; otherwise they are rolled back after being priced
+	Apply bool
+}
+
+// BulkRecalculatedServiceItem compares the price of a service item on the old payment request with its
+// recalculated price
+type BulkRecalculatedServiceItem struct {
+	MTOServiceItemID uuid.UUID
+	ServiceCode      models.ReServiceCode
+	OldPriceCents    unit.Cents
+	NewPriceCents    unit.Cents
+	DeltaCents       unit.Cents
+}
+
+// BulkRecalculatedPaymentRequest totals the recalculated service items of a payment request
+type BulkRecalculatedPaymentRequest struct {
+	PaymentRequestID     uuid.UUID
+	PaymentRequestNumber string
+	// NewPaymentRequestID and NewPaymentRequestNumber are only set when the recalculation was applied
+	NewPaymentRequestID     *uuid.UUID
+	NewPaymentRequestNumber *string
+	OldTotalCents           unit.Cents
+	NewTotalCents           unit.Cents
+	DeltaCents              unit.Cents
+	ServiceItems            []BulkRecalculatedServiceItem
+	// Error is set when the payment request could not be recalculated
+	Error string
+}
+
+// BulkRecalculationReport is the result of recalculating pending payment requests in bulk
+type BulkRecalculationReport struct {
+	Applied         bool
+	PaymentRequests []BulkRecalculatedPaymentRequest
+	DeltaCents      unit.Cents
+	ChangedCount    int
+	UnchangedCount  int
+	FailedCount     int
+}
+
+// BulkPaymentRequestRecalculator recalculates every pending payment request matching a set of filters
+//
+//go:generate mockery --name BulkPaymentRequestRecalculator
+type BulkPaymentRequestRecalculator interface {
+	RecalculatePaymentRequests(appCtx appcontext.AppContext, params BulkRecalculationParams) (*BulkRecalculationReport, error)
+}
@@ -15,3 +15,10 @@ type PaymentServiceItemStatusUpdater interface {
 	UpdatePaymentServiceItemStatus(appCtx appcontext.AppContext, paymentServiceItemID uuid.UUID,
 		status models.PaymentServiceItemStatus, rejectionReason *string, eTag string) (models.PaymentServiceItem, *validate.Errors, error)
 }
+
+// PaymentServiceItemPricingTraceFetcher is the exported interface for fetching how payment service items were priced
+//
+//go:generate mockery --name PaymentServiceItemPricingTraceFetcher
+type PaymentServiceItemPricingTraceFetcher interface {
+	FetchPricingTraces(appCtx appcontext.AppContext, paymentServiceItemID uuid.UUID) (models.PaymentServiceItemPricingTraces, error)
+}
@@ -0,0 +1,50 @@
+package paymentserviceitem
+
+import (
+	"database/sql"
+
+	"github.com/gofrs/uuid"
+
+	"github.com/transcom/mymove/pkg/appcontext"
+	"github.com/transcom/mymove/pkg/apperror"
+	"github.com/transcom/mymove/pkg/models"
+	"github.com/transcom/mymove/pkg/services"
+)
+
+type pricingTraceFetcher struct {
+}
+
+// NewPaymentServiceItemPricingTraceFetcher returns a new fetcher for payment service item pricing traces
+func NewPaymentServiceItemPricingTraceFetcher() services.PaymentServiceItemPricingTraceFetcher {
+	return &pricingTraceFetcher{}
+}
+
+// FetchPricingTraces returns the pricing traces of the payment service item and of the payment service items
+// billed earlier for the same MTO service item, newest first. When a payment request is recalculated the
+// new payment service item's trace is first, followed by the one it replaced.
+func (f *pricingTraceFetcher) FetchPricingTraces(appCtx appcontext.AppContext, paymentServiceItemID uuid.UUID) (models.PaymentServiceItemPricingTraces, error) {
+	var paymentServiceItem models.PaymentServiceItem
+	err := appCtx.DB().Find(&paymentServiceItem, paymentServiceItemID)
+	if err != nil {
+		switch err {
+		case sql.ErrNoRows:
+			return nil, apperror.NewNotFoundError(paymentServiceItemID, "looking for PaymentServiceItem")
+		default:
+			return nil, apperror.NewQueryError("PaymentServiceItem", err, "")
+		}
+	}
+
+	var traces models.PaymentServiceItemPricingTraces
+	err = appCtx.DB().Q().
+		EagerPreload("PaymentServiceItem.PaymentRequest").
+		Join("payment_service_items psi", "psi.id = payment_service_item_pricing_traces.payment_service_item_id").
+		Where("psi.mto_service_item_id = ?", paymentServiceItem.MTOServiceItemID).
+		Where("psi.created_at <= ?", paymentServiceItem.CreatedAt).
+		Order("payment_service_item_pricing_traces.created_at DESC").
+		All(&traces)
+	if err != nil {
+		return nil, apperror.NewQueryError("PaymentServiceItemPricingTrace", err, "")
+	}
+
+	return traces, nil
+}
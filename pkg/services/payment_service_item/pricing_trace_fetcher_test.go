@@ -0,0 +1,68 @@
+package paymentserviceitem
+
+import (
+	"time"
+
+	"github.com/gofrs/uuid"
+
+	"github.com/transcom/mymove/pkg/apperror"
+	"github.com/transcom/mymove/pkg/factory"
+	"github.com/transcom/mymove/pkg/models"
+	"github.com/transcom/mymove/pkg/unit"
+)
+
+func (suite *PaymentServiceItemSuite) TestFetchPricingTraces() {
+	fetcher := NewPaymentServiceItemPricingTraceFetcher()
+
+	createTrace := func(paymentServiceItem models.PaymentServiceItem, priceCents unit.Cents) {
+		trace := models.PaymentServiceItemPricingTrace{
+			PaymentServiceItemID: paymentServiceItem.ID,
+			Trace: models.PricingTrace{
+				ServiceCode: models.ReServiceCodeDLH,
+				PriceCents:  priceCents,
+				PriceRows: []models.PricingTraceRow{
+					{Table: "re_domestic_linehaul_prices", ID: uuid.Must(uuid.NewV4())},
+				},
+			},
+		}
+		suite.MustCreate(&trace)
+	}
+
+	suite.Run("returns the traces of the item and the items it replaced, newest first", func() {
+		original := factory.BuildPaymentServiceItem(suite.DB(), []factory.Customization{
+			{
+				Model: models.PaymentServiceItem{CreatedAt: time.Now().Add(-time.Hour)},
+			},
+		}, nil)
+		createTrace(original, 10000)
+
+		recalculated := factory.BuildPaymentServiceItem(suite.DB(), []factory.Customization{
+			{
+				Model:    original.MTOServiceItem,
+				LinkOnly: true,
+			},
+		}, nil)
+		createTrace(recalculated, 12000)
+
+		traces, err := fetcher.FetchPricingTraces(suite.AppContextForTest(), recalculated.ID)
+		suite.NoError(err)
+		suite.Len(traces, 2)
+		suite.Equal(recalculated.ID, traces[0].PaymentServiceItemID)
+		suite.Equal(unit.Cents(12000), traces[0].Trace.PriceCents)
+		suite.Equal(recalculated.PaymentRequest.PaymentRequestNumber, traces[0].PaymentServiceItem.PaymentRequest.PaymentRequestNumber)
+		suite.Equal(original.ID, traces[1].PaymentServiceItemID)
+		suite.Equal("re_domestic_linehaul_prices", traces[1].Trace.PriceRows[0].Table)
+
+		// later items are not included when looking at the original
+		traces, err = fetcher.FetchPricingTraces(suite.AppContextForTest(), original.ID)
+		suite.NoError(err)
+		suite.Len(traces, 1)
+		suite.Equal(original.ID, traces[0].PaymentServiceItemID)
+	})
+
+	suite.Run("returns not found for an unknown payment service item", func() {
+		_, err := fetcher.FetchPricingTraces(suite.AppContextForTest(), uuid.Must(uuid.NewV4()))
+		suite.Error(err)
+		suite.IsType(apperror.NotFoundError{}, err)
+	})
+}
@@ -0,0 +1,43 @@
+package services
+
+import (
+	"time"
+
+	"github.com/transcom/mymove/pkg/appcontext"
+	"github.com/transcom/mymove/pkg/models"
+	"github.com/transcom/mymove/pkg/unit"
+)
+
+// PricingSimulationParams describes a hypothetical domestic shipment to price
+type PricingSimulationParams struct {
+	// ContractCode is optional; the contract in effect on the pickup date is used when it is empty
+	ContractCode          string
+	PickupPostalCode      string
+	DestinationPostalCode string
+	Weight                unit.Pound
+	PickupDate            time.Time
+	OriginSITDays         int
+	DestinationSITDays    int
+}
+
+// SimulatedServiceItemPrice is the price of a single service in a pricing simulation
+type SimulatedServiceItemPrice struct {
+	ServiceCode   models.ReServiceCode
+	PriceCents    unit.Cents
+	PricingParams PricingDisplayParams
+}
+
+// PricingSimulation is the priced breakdown of a hypothetical shipment
+type PricingSimulation struct {
+	ContractCode    string
+	Distance        unit.Miles
+	TotalPriceCents unit.Cents
+	ServiceItems    []SimulatedServiceItemPrice
+}
+
+// PricingSimulator prices hypothetical shipments without saving anything
+//
+//go:generate mockery --name PricingSimulator
+type PricingSimulator interface {
+	SimulatePricing(appCtx appcontext.AppContext, params PricingSimulationParams) (*PricingSimulation, error)
+}
@@ -0,0 +1,322 @@
+package pricingsimulator
+
+import (
+	"database/sql"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/gobuffalo/validate/v3"
+	"github.com/gobuffalo/validate/v3/validators"
+	"github.com/gofrs/uuid"
+
+	"github.com/transcom/mymove/pkg/appcontext"
+	"github.com/transcom/mymove/pkg/apperror"
+	"github.com/transcom/mymove/pkg/models"
+	serviceparamvaluelookups "github.com/transcom/mymove/pkg/payment_request/service_param_value_lookups"
+	"github.com/transcom/mymove/pkg/route"
+	"github.com/transcom/mymove/pkg/services"
+	"github.com/transcom/mymove/pkg/services/ghcrateengine"
+	mtoserviceitem "github.com/transcom/mymove/pkg/services/mto_service_item"
+	"github.com/transcom/mymove/pkg/unit"
+)
+
+type pricingSimulator struct {
+	planner route.Planner
+}
+
+// NewPricingSimulator creates a new pricingSimulator service
+func NewPricingSimulator(planner route.Planner) services.PricingSimulator {
+	return &pricingSimulator{planner: planner}
+}
+
+// simulationInputs are the values the pricers need, looked up once for the whole simulation
+type simulationInputs struct {
+	contractCode           string
+	pickupDate             time.Time
+	weight                 unit.Pound
+	distance               unit.Miles
+	serviceAreaOrigin      string
+	serviceAreaDest        string
+	servicesScheduleOrigin int
+	servicesScheduleDest   int
+	eiaFuelPrice           unit.Millicents
+	fscMultiplier          float64
+	originSITDays          int
+	destinationSITDays     int
+}
+
+// simulatedService prices one service of a simulated shipment
+type simulatedService struct {
+	code  models.ReServiceCode
+	price func(appCtx appcontext.AppContext, inputs simulationInputs) (unit.Cents, services.PricingDisplayParams, error)
+}
+
+// SimulatePricing prices the services a domestic HHG shipment with the given details would be
+// billed for. The same pricers used for payment requests are called directly with the
+// hypothetical values, so nothing is read from or written to moves or shipments.
+func (s pricingSimulator) SimulatePricing(appCtx appcontext.AppContext, params services.PricingSimulationParams) (*services.PricingSimulation, error) {
+	verrs := validateSimulationParams(params)
+	if verrs.HasAny() {
+		return nil, apperror.NewInvalidInputError(uuid.Nil, nil, verrs, "invalid pricing simulation parameters")
+	}
+
+	inputs, err := s.lookupInputs(appCtx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	simulation := services.PricingSimulation{
+		ContractCode: inputs.contractCode,
+		Distance:     inputs.distance,
+	}
+	for _, service := range servicesToSimulate(params) {
+		// like a payment request, the service is priced at its weight billed, which raises light shipments to
+		// the minimum weight
+		serviceInputs := inputs
+		serviceInputs.weight = serviceparamvaluelookups.ApplyMinimumWeight(service.code, models.MTOShipmentTypeHHG, inputs.weight)
+		priceCents, pricingParams, err := service.price(appCtx, serviceInputs)
+		if err != nil {
+			return nil, apperror.NewUnprocessableEntityError(fmt.Sprintf("unable to price %s: %s", service.code, err))
+		}
+
+		simulation.ServiceItems = append(simulation.ServiceItems, services.SimulatedServiceItemPrice{
+			ServiceCode:   service.code,
+			PriceCents:    priceCents,
+			PricingParams: pricingParams,
+		})
+		simulation.TotalPriceCents = simulation.TotalPriceCents.AddCents(priceCents)
+	}
+
+	return &simulation, nil
+}
+
+func validateSimulationParams(params services.PricingSimulationParams) *validate.Errors {
+	return validate.Validate(
+		&validators.RegexMatch{Field: params.PickupPostalCode, Name: "PickupPostalCode", Expr: `^\d{5}$`, Message: "PickupPostalCode must be a 5 digit ZIP code"},
+		&validators.RegexMatch{Field: params.DestinationPostalCode, Name: "DestinationPostalCode", Expr: `^\d{5}$`, Message: "DestinationPostalCode must be a 5 digit ZIP code"},
+		&validators.IntIsGreaterThan{Field: params.Weight.Int(), Name: "Weight", Compared: 0},
+		&validators.TimeIsPresent{Field: params.PickupDate, Name: "PickupDate"},
+		&validators.IntIsGreaterThan{Field: params.OriginSITDays, Name: "OriginSITDays", Compared: -1},
+		&validators.IntIsGreaterThan{Field: params.DestinationSITDays, Name: "DestinationSITDays", Compared: -1},
+	)
+}
+
+func (s pricingSimulator) lookupInputs(appCtx appcontext.AppContext, params services.PricingSimulationParams) (simulationInputs, error) {
+	inputs := simulationInputs{
+		contractCode:       params.ContractCode,
+		pickupDate:         params.PickupDate,
+		weight:             params.Weight,
+		originSITDays:      params.OriginSITDays,
+		destinationSITDays: params.DestinationSITDays,
+	}
+
+	if inputs.contractCode == "" {
+		contractCode, err := mtoserviceitem.FetchContractCode(appCtx, params.PickupDate)
+		if err != nil {
+			return simulationInputs{}, err
+		}
+		inputs.contractCode = contractCode
+	} else {
+		var contract models.ReContract
+		err := appCtx.DB().Where("code = ?", inputs.contractCode).First(&contract)
+		if err != nil {
+			switch err {
+			case sql.ErrNoRows:
+				return simulationInputs{}, apperror.NewNotFoundError(uuid.Nil, fmt.Sprintf("no contract found with code %s", inputs.contractCode))
+			default:
+				return simulationInputs{}, apperror.NewQueryError("ReContract", err, "")
+			}
+		}
+	}
+
+	var err error
+	inputs.serviceAreaOrigin, inputs.servicesScheduleOrigin, err = lookupServiceArea(appCtx, inputs.contractCode, params.PickupPostalCode)
+	if err != nil {
+		return simulationInputs{}, err
+	}
+	inputs.serviceAreaDest, inputs.servicesScheduleDest, err = lookupServiceArea(appCtx, inputs.contractCode, params.DestinationPostalCode)
+	if err != nil {
+		return simulationInputs{}, err
+	}
+
+	distance, err := s.planner.ZipTransitDistance(appCtx, params.PickupPostalCode, params.DestinationPostalCode)
+	if err != nil {
+		return simulationInputs{}, err
+	}
+	inputs.distance = unit.Miles(distance)
+
+	eiaFuelPrice, err := serviceparamvaluelookups.EIAFuelPriceLookup{
+		MTOShipment: models.MTOShipment{ActualPickupDate: &params.PickupDate},
+	}.ParamValue(appCtx)
+	if err != nil {
+		return simulationInputs{}, err
+	}
+	fuelPrice, err := strconv.Atoi(eiaFuelPrice)
+	if err != nil {
+		return simulationInputs{}, fmt.Errorf("could not convert EIA fuel price %s to an int: %w", eiaFuelPrice, err)
+	}
+	inputs.eiaFuelPrice = unit.Millicents(fuelPrice)
+
+	fscWeightBilled := serviceparamvaluelookups.ApplyMinimumWeight(models.ReServiceCodeFSC, models.MTOShipmentTypeHHG, params.Weight)
+	inputs.fscMultiplier, err = strconv.ParseFloat(serviceparamvaluelookups.FSCWeightBasedDistanceMultiplier(fscWeightBilled), 64)
+	if err != nil {
+		return simulationInputs{}, err
+	}
+
+	return inputs, nil
+}
+
+// lookupServiceArea returns the domestic service area and services schedule for a ZIP code
+func lookupServiceArea(appCtx appcontext.AppContext, contractCode string, postalCode string) (string, int, error) {
+	address := models.Address{PostalCode: postalCode}
+
+	serviceArea, err := serviceparamvaluelookups.ServiceAreaLookup{Address: address}.ParamValue(appCtx, contractCode)
+	if err != nil {
+		return "", 0, apperror.NewUnprocessableEntityError(err.Error())
+	}
+
+	schedule, err := serviceparamvaluelookups.ServicesScheduleLookup{Address: address}.ParamValue(appCtx, contractCode)
+	if err != nil {
+		return "", 0, apperror.NewUnprocessableEntityError(err.Error())
+	}
+	servicesSchedule, err := strconv.Atoi(schedule)
+	if err != nil {
+		return "", 0, fmt.Errorf("could not convert services schedule %s to an int: %w", schedule, err)
+	}
+
+	return serviceArea, servicesSchedule, nil
+}
+
+// servicesToSimulate returns the services that are created and approved for a domestic HHG
+// shipment, along with the SIT services for any requested days in storage
+func servicesToSimulate(params services.PricingSimulationParams) []simulatedService {
+	simulated := []simulatedService{
+		{models.ReServiceCodeMS, priceTaskOrderFee(ghcrateengine.NewManagementServicesPricer(), models.ReServiceCodeMS)},
+		{models.ReServiceCodeCS, priceTaskOrderFee(ghcrateengine.NewCounselingServicesPricer(), models.ReServiceCodeCS)},
+	}
+
+	// moves within the same ZIP3 are priced as shorthaul rather than linehaul
+	if params.PickupPostalCode[:3] == params.DestinationPostalCode[:3] {
+		simulated = append(simulated, simulatedService{models.ReServiceCodeDSH, priceShorthaul})
+	} else {
+		simulated = append(simulated, simulatedService{models.ReServiceCodeDLH, priceLinehaul})
+	}
+
+	simulated = append(simulated,
+		simulatedService{models.ReServiceCodeFSC, priceFuelSurcharge},
+		simulatedService{models.ReServiceCodeDOP, priceOrigin},
+		simulatedService{models.ReServiceCodeDDP, priceDestination},
+		simulatedService{models.ReServiceCodeDPK, pricePack},
+		simulatedService{models.ReServiceCodeDUPK, priceUnpack},
+	)
+
+	if params.OriginSITDays > 0 {
+		simulated = append(simulated, simulatedService{models.ReServiceCodeDOFSIT, priceOriginFirstDaySIT})
+	}
+	if params.OriginSITDays > 1 {
+		simulated = append(simulated, simulatedService{models.ReServiceCodeDOASIT, priceOriginAdditionalDaysSIT})
+	}
+	if params.DestinationSITDays > 0 {
+		simulated = append(simulated, simulatedService{models.ReServiceCodeDDFSIT, priceDestinationFirstDaySIT})
+	}
+	if params.DestinationSITDays > 1 {
+		simulated = append(simulated, simulatedService{models.ReServiceCodeDDASIT, priceDestinationAdditionalDaysSIT})
+	}
+
+	return simulated
+}
+
+// taskOrderFeePricer is implemented by the management and counseling services pricers
+type taskOrderFeePricer interface {
+	Price(appCtx appcontext.AppContext, lockedPriceCents *unit.Cents) (unit.Cents, services.PricingDisplayParams, error)
+}
+
+func priceTaskOrderFee(pricer taskOrderFeePricer, serviceCode models.ReServiceCode) func(appcontext.AppContext, simulationInputs) (unit.Cents, services.PricingDisplayParams, error) {
+	return func(appCtx appcontext.AppContext, inputs simulationInputs) (unit.Cents, services.PricingDisplayParams, error) {
+		taskOrderFee, err := models.FetchTaskOrderFee(appCtx, inputs.contractCode, serviceCode, inputs.pickupDate)
+		if err != nil {
+			return 0, nil, fmt.Errorf("could not fetch task order fee: %w", err)
+		}
+		return pricer.Price(appCtx, &taskOrderFee.PriceCents)
+	}
+}
+
+func priceLinehaul(appCtx appcontext.AppContext, inputs simulationInputs) (unit.Cents, services.PricingDisplayParams, error) {
+	price, params, err := ghcrateengine.NewDomesticLinehaulPricer().Price(appCtx, inputs.contractCode, inputs.pickupDate, inputs.distance, inputs.weight, inputs.serviceAreaOrigin, false)
+	return price, append(params, distanceParam(inputs), serviceAreaOriginParam(inputs)), err
+}
+
+func priceShorthaul(appCtx appcontext.AppContext, inputs simulationInputs) (unit.Cents, services.PricingDisplayParams, error) {
+	price, params, err := ghcrateengine.NewDomesticShorthaulPricer().Price(appCtx, inputs.contractCode, inputs.pickupDate, inputs.distance, inputs.weight, inputs.serviceAreaOrigin, false)
+	return price, append(params, distanceParam(inputs), serviceAreaOriginParam(inputs)), err
+}
+
+func priceFuelSurcharge(appCtx appcontext.AppContext, inputs simulationInputs) (unit.Cents, services.PricingDisplayParams, error) {
+	price, params, err := ghcrateengine.NewFuelSurchargePricer().Price(appCtx, inputs.pickupDate, inputs.distance, inputs.weight, inputs.fscMultiplier, inputs.eiaFuelPrice, false)
+	return price, append(params,
+		distanceParam(inputs),
+		services.PricingDisplayParam{Key: models.ServiceItemParamNameEIAFuelPrice, Value: strconv.Itoa(inputs.eiaFuelPrice.Int())},
+		services.PricingDisplayParam{Key: models.ServiceItemParamNameFSCWeightBasedDistanceMultiplier, Value: strconv.FormatFloat(inputs.fscMultiplier, 'f', -1, 64)},
+	), err
+}
+
+func priceOrigin(appCtx appcontext.AppContext, inputs simulationInputs) (unit.Cents, services.PricingDisplayParams, error) {
+	price, params, err := ghcrateengine.NewDomesticOriginPricer().Price(appCtx, inputs.contractCode, inputs.pickupDate, inputs.weight, inputs.serviceAreaOrigin, false)
+	return price, append(params, serviceAreaOriginParam(inputs)), err
+}
+
+func priceDestination(appCtx appcontext.AppContext, inputs simulationInputs) (unit.Cents, services.PricingDisplayParams, error) {
+	price, params, err := ghcrateengine.NewDomesticDestinationPricer().Price(appCtx, inputs.contractCode, inputs.pickupDate, inputs.weight, inputs.serviceAreaDest, false)
+	return price, append(params, serviceAreaDestParam(inputs)), err
+}
+
+func pricePack(appCtx appcontext.AppContext, inputs simulationInputs) (unit.Cents, services.PricingDisplayParams, error) {
+	price, params, err := ghcrateengine.NewDomesticPackPricer().Price(appCtx, inputs.contractCode, inputs.pickupDate, inputs.weight, inputs.servicesScheduleOrigin, false)
+	return price, append(params, services.PricingDisplayParam{Key: models.ServiceItemParamNameServicesScheduleOrigin, Value: strconv.Itoa(inputs.servicesScheduleOrigin)}), err
+}
+
+func priceUnpack(appCtx appcontext.AppContext, inputs simulationInputs) (unit.Cents, services.PricingDisplayParams, error) {
+	price, params, err := ghcrateengine.NewDomesticUnpackPricer().Price(appCtx, inputs.contractCode, inputs.pickupDate, inputs.weight, inputs.servicesScheduleDest, false)
+	return price, append(params, services.PricingDisplayParam{Key: models.ServiceItemParamNameServicesScheduleDest, Value: strconv.Itoa(inputs.servicesScheduleDest)}), err
+}
+
+func priceOriginFirstDaySIT(appCtx appcontext.AppContext, inputs simulationInputs) (unit.Cents, services.PricingDisplayParams, error) {
+	price, params, err := ghcrateengine.NewDomesticOriginFirstDaySITPricer().Price(appCtx, inputs.contractCode, inputs.pickupDate, inputs.weight, inputs.serviceAreaOrigin, false)
+	return price, append(params, serviceAreaOriginParam(inputs)), err
+}
+
+func priceOriginAdditionalDaysSIT(appCtx appcontext.AppContext, inputs simulationInputs) (unit.Cents, services.PricingDisplayParams, error) {
+	// the first day in SIT is priced by DOFSIT
+	additionalDays := inputs.originSITDays - 1
+	price, params, err := ghcrateengine.NewDomesticOriginAdditionalDaysSITPricer().Price(appCtx, inputs.contractCode, inputs.pickupDate, inputs.weight, inputs.serviceAreaOrigin, additionalDays, false)
+	return price, append(params, serviceAreaOriginParam(inputs), numberDaysSITParam(additionalDays)), err
+}
+
+func priceDestinationFirstDaySIT(appCtx appcontext.AppContext, inputs simulationInputs) (unit.Cents, services.PricingDisplayParams, error) {
+	price, params, err := ghcrateengine.NewDomesticDestinationFirstDaySITPricer().Price(appCtx, inputs.contractCode, inputs.pickupDate, inputs.weight, inputs.serviceAreaDest, false)
+	return price, append(params, serviceAreaDestParam(inputs)), err
+}
+
+func priceDestinationAdditionalDaysSIT(appCtx appcontext.AppContext, inputs simulationInputs) (unit.Cents, services.PricingDisplayParams, error) {
+	// the first day in SIT is priced by DDFSIT
+	additionalDays := inputs.destinationSITDays - 1
+	price, params, err := ghcrateengine.NewDomesticDestinationAdditionalDaysSITPricer().Price(appCtx, inputs.contractCode, inputs.pickupDate, inputs.weight, inputs.serviceAreaDest, additionalDays, false)
+	return price, append(params, serviceAreaDestParam(inputs), numberDaysSITParam(additionalDays)), err
+}
+
+func distanceParam(inputs simulationInputs) services.PricingDisplayParam {
+	return services.PricingDisplayParam{Key: models.ServiceItemParamNameDistanceZip, Value: strconv.Itoa(inputs.distance.Int())}
+}
+
+func serviceAreaOriginParam(inputs simulationInputs) services.PricingDisplayParam {
+	return services.PricingDisplayParam{Key: models.ServiceItemParamNameServiceAreaOrigin, Value: inputs.serviceAreaOrigin}
+}
+
+func serviceAreaDestParam(inputs simulationInputs) services.PricingDisplayParam {
+	return services.PricingDisplayParam{Key: models.ServiceItemParamNameServiceAreaDest, Value: inputs.serviceAreaDest}
+}
+
+func numberDaysSITParam(days int) services.PricingDisplayParam {
+	return services.PricingDisplayParam{Key: models.ServiceItemParamNameNumberDaysSIT, Value: strconv.Itoa(days)}
+}
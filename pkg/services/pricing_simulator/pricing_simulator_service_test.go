@@ -0,0 +1,23 @@
+package pricingsimulator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/transcom/mymove/pkg/testingsuite"
+)
+
+type PricingSimulatorServiceSuite struct {
+	*testingsuite.PopTestSuite
+}
+
+func TestPricingSimulatorServiceSuite(t *testing.T) {
+
+	ts := &PricingSimulatorServiceSuite{
+		PopTestSuite: testingsuite.NewPopTestSuite(testingsuite.CurrentPackage(),
+			testingsuite.WithPerTestTransaction()),
+	}
+	suite.Run(t, ts)
+	ts.PopTestSuite.TearDown()
+}
@@ -0,0 +1,305 @@
+package pricingsimulator
+
+import (
+	"time"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/transcom/mymove/pkg/apperror"
+	"github.com/transcom/mymove/pkg/factory"
+	"github.com/transcom/mymove/pkg/models"
+	"github.com/transcom/mymove/pkg/route/mocks"
+	"github.com/transcom/mymove/pkg/services"
+	"github.com/transcom/mymove/pkg/testdatagen"
+	"github.com/transcom/mymove/pkg/unit"
+)
+
+func (suite *PricingSimulatorServiceSuite) setupPricerData() {
+	testdatagen.FetchOrMakeGHCDieselFuelPrice(suite.DB(), testdatagen.Assertions{
+		GHCDieselFuelPrice: models.GHCDieselFuelPrice{
+			FuelPriceInMillicents: unit.Millicents(281400),
+			PublicationDate:       time.Date(2020, time.March, 9, 0, 0, 0, 0, time.UTC),
+			EffectiveDate:         time.Date(2020, time.March, 10, 0, 0, 0, 0, time.UTC),
+			EndDate:               time.Date(2020, time.March, 16, 0, 0, 0, 0, time.UTC),
+		},
+	})
+
+	originDomesticServiceArea := testdatagen.FetchOrMakeReDomesticServiceArea(suite.DB(), testdatagen.Assertions{
+		ReDomesticServiceArea: models.ReDomesticServiceArea{
+			ServiceArea:      "056",
+			ServicesSchedule: 3,
+			SITPDSchedule:    3,
+		},
+		ReContract: testdatagen.FetchOrMakeReContract(suite.DB(), testdatagen.Assertions{}),
+	})
+
+	contractYear := testdatagen.FetchOrMakeReContractYear(suite.DB(), testdatagen.Assertions{
+		ReContractYear: models.ReContractYear{
+			Contract:             originDomesticServiceArea.Contract,
+			ContractID:           originDomesticServiceArea.ContractID,
+			StartDate:            time.Date(2019, time.June, 1, 0, 0, 0, 0, time.UTC),
+			EndDate:              time.Date(2020, time.May, 31, 0, 0, 0, 0, time.UTC),
+			Escalation:           1.0,
+			EscalationCompounded: 1.0,
+		},
+	})
+
+	testdatagen.FetchOrMakeReZip3(suite.DB(), testdatagen.Assertions{
+		ReZip3: models.ReZip3{
+			Contract:            originDomesticServiceArea.Contract,
+			ContractID:          originDomesticServiceArea.ContractID,
+			DomesticServiceArea: originDomesticServiceArea,
+			Zip3:                "503",
+		},
+	})
+
+	destDomesticServiceArea := testdatagen.FetchOrMakeReDomesticServiceArea(suite.DB(), testdatagen.Assertions{
+		ReDomesticServiceArea: models.ReDomesticServiceArea{
+			Contract:         originDomesticServiceArea.Contract,
+			ContractID:       originDomesticServiceArea.ContractID,
+			ServiceArea:      "208",
+			ServicesSchedule: 2,
+			SITPDSchedule:    2,
+		},
+	})
+
+	testdatagen.FetchOrMakeReZip3(suite.DB(), testdatagen.Assertions{
+		ReZip3: models.ReZip3{
+			Contract:            destDomesticServiceArea.Contract,
+			ContractID:          destDomesticServiceArea.ContractID,
+			DomesticServiceArea: destDomesticServiceArea,
+			Zip3:                "308",
+		},
+	})
+
+	for _, code := range []models.ReServiceCode{models.ReServiceCodeMS, models.ReServiceCodeCS} {
+		service := factory.FetchReServiceByCode(suite.DB(), code)
+		suite.MustSave(&models.ReTaskOrderFee{
+			ContractYearID: contractYear.ID,
+			ServiceID:      service.ID,
+			PriceCents:     unit.Cents(45115),
+		})
+	}
+
+	testdatagen.FetchOrMakeReDomesticLinehaulPrice(suite.DB(), testdatagen.Assertions{
+		ReDomesticLinehaulPrice: models.ReDomesticLinehaulPrice{
+			Contract:              originDomesticServiceArea.Contract,
+			ContractID:            originDomesticServiceArea.ContractID,
+			DomesticServiceArea:   originDomesticServiceArea,
+			DomesticServiceAreaID: originDomesticServiceArea.ID,
+			WeightLower:           unit.Pound(500),
+			WeightUpper:           unit.Pound(4999),
+			MilesLower:            2001,
+			MilesUpper:            2500,
+			PriceMillicents:       unit.Millicents(412400),
+		},
+	})
+
+	serviceAreaPrices := []struct {
+		code        models.ReServiceCode
+		serviceArea models.ReDomesticServiceArea
+		priceCents  unit.Cents
+	}{
+		{models.ReServiceCodeDOP, originDomesticServiceArea, 404},
+		{models.ReServiceCodeDDP, destDomesticServiceArea, 832},
+		{models.ReServiceCodeDOFSIT, originDomesticServiceArea, 1153},
+		{models.ReServiceCodeDOASIT, originDomesticServiceArea, 46},
+	}
+	for _, serviceAreaPrice := range serviceAreaPrices {
+		service := factory.FetchReServiceByCode(suite.DB(), serviceAreaPrice.code)
+		testdatagen.FetchOrMakeReDomesticServiceAreaPrice(suite.DB(), testdatagen.Assertions{
+			ReDomesticServiceAreaPrice: models.ReDomesticServiceAreaPrice{
+				ContractID:            serviceAreaPrice.serviceArea.ContractID,
+				Contract:              serviceAreaPrice.serviceArea.Contract,
+				ServiceID:             service.ID,
+				Service:               service,
+				DomesticServiceAreaID: serviceAreaPrice.serviceArea.ID,
+				DomesticServiceArea:   serviceAreaPrice.serviceArea,
+				PriceCents:            serviceAreaPrice.priceCents,
+			},
+		})
+	}
+
+	otherPrices := []struct {
+		code       models.ReServiceCode
+		schedule   int
+		priceCents unit.Cents
+	}{
+		{models.ReServiceCodeDPK, 3, 7395},
+		{models.ReServiceCodeDUPK, 2, 597},
+	}
+	for _, otherPrice := range otherPrices {
+		service := factory.FetchReServiceByCode(suite.DB(), otherPrice.code)
+		testdatagen.FetchOrMakeReDomesticOtherPrice(suite.DB(), testdatagen.Assertions{
+			ReDomesticOtherPrice: models.ReDomesticOtherPrice{
+				ContractID: originDomesticServiceArea.ContractID,
+				Contract:   originDomesticServiceArea.Contract,
+				ServiceID:  service.ID,
+				Service:    service,
+				Schedule:   otherPrice.schedule,
+				PriceCents: otherPrice.priceCents,
+			},
+		})
+	}
+}
+
+func (suite *PricingSimulatorServiceSuite) TestSimulatePricing() {
+	validParams := func() services.PricingSimulationParams {
+		return services.PricingSimulationParams{
+			PickupPostalCode:      "50309",
+			DestinationPostalCode: "30813",
+			Weight:                unit.Pound(4000),
+			PickupDate:            time.Date(2020, time.March, 15, 0, 0, 0, 0, time.UTC),
+		}
+	}
+
+	setupPlanner := func() *mocks.Planner {
+		planner := &mocks.Planner{}
+		planner.On("ZipTransitDistance",
+			mock.AnythingOfType("*appcontext.appContext"),
+			"50309",
+			"30813",
+		).Return(2361, nil)
+		return planner
+	}
+
+	suite.Run("prices the services of a domestic HHG shipment", func() {
+		suite.setupPricerData()
+		planner := setupPlanner()
+
+		simulation, err := NewPricingSimulator(planner).SimulatePricing(suite.AppContextForTest(), validParams())
+		suite.NoError(err)
+		suite.NotNil(simulation)
+
+		suite.Equal(unit.Miles(2361), simulation.Distance)
+		suite.Equal(testdatagen.DefaultContractCode, simulation.ContractCode)
+
+		var serviceCodes []models.ReServiceCode
+		var total unit.Cents
+		for _, serviceItem := range simulation.ServiceItems {
+			serviceCodes = append(serviceCodes, serviceItem.ServiceCode)
+			suite.NotEmpty(serviceItem.PricingParams)
+			total = total.AddCents(serviceItem.PriceCents)
+		}
+		suite.Equal([]models.ReServiceCode{
+			models.ReServiceCodeMS,
+			models.ReServiceCodeCS,
+			models.ReServiceCodeDLH,
+			models.ReServiceCodeFSC,
+			models.ReServiceCodeDOP,
+			models.ReServiceCodeDDP,
+			models.ReServiceCodeDPK,
+			models.ReServiceCodeDUPK,
+		}, serviceCodes)
+		suite.Equal(total, simulation.TotalPriceCents)
+		suite.Positive(simulation.TotalPriceCents.Int())
+		planner.AssertNumberOfCalls(suite.T(), "ZipTransitDistance", 1)
+	})
+
+	suite.Run("prices origin SIT when days in storage are given", func() {
+		suite.setupPricerData()
+		params := validParams()
+		params.OriginSITDays = 30
+
+		simulation, err := NewPricingSimulator(setupPlanner()).SimulatePricing(suite.AppContextForTest(), params)
+		suite.NoError(err)
+
+		var additionalDays *services.SimulatedServiceItemPrice
+		for i, serviceItem := range simulation.ServiceItems {
+			if serviceItem.ServiceCode == models.ReServiceCodeDOASIT {
+				additionalDays = &simulation.ServiceItems[i]
+			}
+		}
+		suite.NotNil(additionalDays)
+		suite.Contains(additionalDays.PricingParams, services.PricingDisplayParam{
+			Key:   models.ServiceItemParamNameNumberDaysSIT,
+			Value: "29",
+		})
+	})
+
+	suite.Run("prices a shipment under the minimum weight at the minimum weight", func() {
+		suite.setupPricerData()
+		params := validParams()
+		params.Weight = unit.Pound(300)
+
+		lightSimulation, err := NewPricingSimulator(setupPlanner()).SimulatePricing(suite.AppContextForTest(), params)
+		suite.NoError(err)
+
+		params.Weight = unit.Pound(500)
+		minimumSimulation, err := NewPricingSimulator(setupPlanner()).SimulatePricing(suite.AppContextForTest(), params)
+		suite.NoError(err)
+
+		suite.Equal(minimumSimulation.ServiceItems, lightSimulation.ServiceItems)
+		suite.Equal(minimumSimulation.TotalPriceCents, lightSimulation.TotalPriceCents)
+	})
+
+	suite.Run("returns an InvalidInputError for invalid parameters", func() {
+		params := validParams()
+		params.PickupPostalCode = "5030"
+		params.Weight = 0
+		params.PickupDate = time.Time{}
+
+		simulation, err := NewPricingSimulator(&mocks.Planner{}).SimulatePricing(suite.AppContextForTest(), params)
+		suite.Nil(simulation)
+		suite.IsType(apperror.InvalidInputError{}, err)
+
+		verrs := err.(apperror.InvalidInputError).ValidationErrors
+		suite.NotEmpty(verrs.Get("PickupPostalCode"))
+		suite.NotEmpty(verrs.Get("Weight"))
+		suite.NotEmpty(verrs.Get("PickupDate"))
+	})
+
+	suite.Run("returns a NotFoundError for an unknown contract", func() {
+		params := validParams()
+		params.ContractCode = "NOT-A-CONTRACT"
+
+		simulation, err := NewPricingSimulator(&mocks.Planner{}).SimulatePricing(suite.AppContextForTest(), params)
+		suite.Nil(simulation)
+		suite.IsType(apperror.NotFoundError{}, err)
+	})
+
+	suite.Run("returns an UnprocessableEntityError when a ZIP has no service area", func() {
+		suite.setupPricerData()
+		params := validParams()
+		params.DestinationPostalCode = "99999"
+
+		simulation, err := NewPricingSimulator(&mocks.Planner{}).SimulatePricing(suite.AppContextForTest(), params)
+		suite.Nil(simulation)
+		suite.IsType(apperror.UnprocessableEntityError{}, err)
+	})
+}
+
+func (suite *PricingSimulatorServiceSuite) TestServicesToSimulate() {
+	serviceCodes := func(params services.PricingSimulationParams) []models.ReServiceCode {
+		var codes []models.ReServiceCode
+		for _, service := range servicesToSimulate(params) {
+			codes = append(codes, service.code)
+		}
+		return codes
+	}
+
+	suite.Run("uses shorthaul within the same ZIP3", func() {
+		codes := serviceCodes(services.PricingSimulationParams{PickupPostalCode: "50309", DestinationPostalCode: "50310"})
+		suite.Contains(codes, models.ReServiceCodeDSH)
+		suite.NotContains(codes, models.ReServiceCodeDLH)
+	})
+
+	suite.Run("uses linehaul across ZIP3s", func() {
+		codes := serviceCodes(services.PricingSimulationParams{PickupPostalCode: "50309", DestinationPostalCode: "30813"})
+		suite.Contains(codes, models.ReServiceCodeDLH)
+		suite.NotContains(codes, models.ReServiceCodeDSH)
+	})
+
+	suite.Run("adds additional days SIT only after the first day", func() {
+		codes := serviceCodes(services.PricingSimulationParams{
+			PickupPostalCode:      "50309",
+			DestinationPostalCode: "30813",
+			OriginSITDays:         1,
+			DestinationSITDays:    5,
+		})
+		suite.Contains(codes, models.ReServiceCodeDOFSIT)
+		suite.NotContains(codes, models.ReServiceCodeDOASIT)
+		suite.Contains(codes, models.ReServiceCodeDDFSIT)
+		suite.Contains(codes, models.ReServiceCodeDDASIT)
+	})
+}
@@ -0,0 +1,206 @@
+package query
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gobuffalo/pop/v6"
+	"github.com/gofrs/uuid"
+
+	"github.com/transcom/mymove/pkg/services"
+	"github.com/transcom/mymove/pkg/services/pagination"
+)
+
+// KeysetColumn is one column of the ordering a keyset paginated query is sorted by
+type KeysetColumn struct {
+	// Expression is the trusted SQL expression sorted on
+	Expression string
+	Descending bool
+}
+
+// KeysetOrder returns the ORDER BY terms for the columns
+func KeysetOrder(columns []KeysetColumn) string {
+	terms := make([]string, len(columns))
+	for i, column := range columns {
+		direction := asc
+		if column.Descending {
+			direction = desc
+		}
+		terms[i] = fmt.Sprintf("%s %s", column.Expression, direction)
+	}
+	return strings.Join(terms, ", ")
+}
+
+// KeysetCondition returns a condition matching the rows that sort after the row whose column values are
+// given. The columns should end with a unique column so no two rows sort the same. NULLs sort the way
+// Postgres sorts them by default: last when ascending and first when descending.
+func KeysetCondition(columns []KeysetColumn, values []*string) (string, []interface{}) {
+	var conditions []string
+	var args []interface{}
+
+	for i, column := range columns {
+		var terms []string
+		var termArgs []interface{}
+		for j := 0; j < i; j++ {
+			if values[j] == nil {
+				terms = append(terms, fmt.Sprintf("%s IS NULL", columns[j].Expression))
+			} else {
+				terms = append(terms, fmt.Sprintf("%s = ?", columns[j].Expression))
+				termArgs = append(termArgs, *values[j])
+			}
+		}
+
+		switch {
+		case values[i] == nil && column.Descending:
+			terms = append(terms, fmt.Sprintf("%s IS NOT NULL", column.Expression))
+		case values[i] == nil:
+			// nothing sorts after NULL when ascending
+			continue
+		case column.Descending:
+			terms = append(terms, fmt.Sprintf("%s < ?", column.Expression))
+			termArgs = append(termArgs, *values[i])
+		default:
+			terms = append(terms, fmt.Sprintf("(%s > ? OR %s IS NULL)", column.Expression, column.Expression))
+			termArgs = append(termArgs, *values[i])
+		}
+
+		conditions = append(conditions, "("+strings.Join(terms, " AND ")+")")
+		args = append(args, termArgs...)
+	}
+
+	if len(conditions) == 0 {
+		return "FALSE", nil
+	}
+	return "(" + strings.Join(conditions, " OR ") + ")", args
+}
+
+// KeysetValue returns the text form of a column value for a pagination cursor, or nil for NULL
+func KeysetValue(value interface{}) *string {
+	v := reflect.ValueOf(value)
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+
+	var s string
+	switch typed := v.Interface().(type) {
+	case time.Time:
+		// keep the microseconds Postgres stores so rows in the same second don't get skipped
+		s = typed.Format(time.RFC3339Nano)
+	case uuid.UUID:
+		s = typed.String()
+	default:
+		switch v.Kind() {
+		case reflect.String:
+			s = v.String()
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			s = strconv.FormatInt(v.Int(), 10)
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			s = strconv.FormatUint(v.Uint(), 10)
+		case reflect.Float32, reflect.Float64:
+			s = strconv.FormatFloat(v.Float(), 'f', -1, 64)
+		case reflect.Bool:
+			s = strconv.FormatBool(v.Bool())
+		default:
+			s = fmt.Sprint(v.Interface())
+		}
+	}
+	return &s
+}
+
+// keysetColumns returns the ordering FetchMany pages a model by: the requested column, then id
+func keysetColumns(ordering services.QueryOrder) []KeysetColumn {
+	var columns []KeysetColumn
+	descending := false
+	if ordering != nil && ordering.Column() != nil && ordering.SortOrder() != nil {
+		descending = !*ordering.SortOrder()
+		if *ordering.Column() != "id" {
+			columns = append(columns, KeysetColumn{Expression: *ordering.Column(), Descending: descending})
+		}
+	}
+	return append(columns, KeysetColumn{Expression: "id", Descending: descending})
+}
+
+// KeysetPaginate limits query to the page after the pagination's cursor, ordered by ordering and then id. It is
+// also for list queries the query builder doesn't build, so the ordering column is checked against the model's
+// columns and qualified with its table, which lets the query join other tables. model is a pointer to the
+// model or to a slice of them.
+func KeysetPaginate(query *pop.Query, page services.Pagination, ordering services.QueryOrder, model interface{}) (*pop.Query, error) {
+	t := reflect.TypeOf(model)
+	for t.Kind() == reflect.Ptr || t.Kind() == reflect.Slice {
+		t = t.Elem()
+	}
+	if ordering != nil && ordering.Column() != nil && ordering.SortOrder() != nil {
+		if invalidField := validateOrder(ordering, t); len(invalidField) != 0 {
+			return nil, fmt.Errorf("%v is not valid input", invalidField)
+		}
+	}
+	if _, ok := getDBColumn(t, "id"); !ok {
+		return nil, fmt.Errorf("%s can't be keyset paginated without an id column", t.Name())
+	}
+
+	columns := keysetColumns(ordering)
+	cursor := page.Cursor()
+	if err := pagination.CheckCursorSort(cursor, KeysetOrder(columns), len(columns)); err != nil {
+		return nil, err
+	}
+
+	// the cursor names the columns unqualified so it doesn't depend on how the query was built
+	table := pop.NewModel(model, context.Background()).TableName()
+	qualified := make([]KeysetColumn, len(columns))
+	for i, column := range columns {
+		qualified[i] = KeysetColumn{Expression: table + "." + column.Expression, Descending: column.Descending}
+	}
+	if len(cursor.Values) > 0 {
+		condition, args := KeysetCondition(qualified, cursor.Values)
+		query = query.Where(condition, args...)
+	}
+
+	return query.Order(KeysetOrder(qualified)).Limit(page.PerPage()), nil
+}
+
+// NextCursor returns the cursor for the page after the records FetchMany returned, or nil when the
+// records were the last page. records must be the pointer to a slice of structs that was fetched.
+func NextCursor(records interface{}, page services.Pagination, ordering services.QueryOrder) *string {
+	if page == nil || page.Cursor() == nil {
+		return nil
+	}
+
+	v := reflect.Indirect(reflect.ValueOf(records))
+	if v.Kind() != reflect.Slice || v.Len() == 0 || v.Len() < page.PerPage() {
+		return nil
+	}
+	last := reflect.Indirect(v.Index(v.Len() - 1))
+	if last.Kind() != reflect.Struct {
+		return nil
+	}
+
+	columns := keysetColumns(ordering)
+	values := make([]*string, len(columns))
+	for i, column := range columns {
+		field, ok := fieldForDBColumn(last, column.Expression)
+		if !ok {
+			return nil
+		}
+		values[i] = KeysetValue(field.Interface())
+	}
+
+	cursor := pagination.EncodeCursor(KeysetOrder(columns), values)
+	return &cursor
+}
+
+func fieldForDBColumn(v reflect.Value, column string) (reflect.Value, bool) {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		if dbTag, ok := t.Field(i).Tag.Lookup("db"); ok && dbTag == column {
+			return v.Field(i), true
+		}
+	}
+	return reflect.Value{}, false
+}
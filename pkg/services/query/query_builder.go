@@ -21,6 +21,7 @@ import (
 // allowed comparators for this query builder implementation
 const equals = "="
 const greaterThan = ">"
+const lessThan = "<"
 const ilike = "ILIKE" // Case insensitive
 const isNull = "IS NULL"
 
@@ -64,6 +65,8 @@ func getComparator(comparator string) (string, bool) {
 		return equals, true
 	case greaterThan:
 		return greaterThan, true
+	case lessThan:
+		return lessThan, true
 	case ilike:
 		return ilike, true
 	case isNull:
@@ -79,6 +82,10 @@ func buildQuery(query *pop.Query, filters []services.QueryFilter, pagination ser
 		return nil, err
 	}
 
+	if pagination != nil && pagination.Cursor() != nil {
+		return keysetPaginatedQuery(query, pagination, order, t)
+	}
+
 	query, err = paginatedQuery(query, pagination, t)
 	if err != nil {
 		return nil, err
@@ -100,6 +107,12 @@ func paginatedQuery(query *pop.Query, pagination services.Pagination, _ reflect.
 	return query.Paginate(pagination.Page(), pagination.PerPage()), nil
 }
 
+// keysetPaginatedQuery pages by the values of the ordering columns of the last row of the previous page
+// rather than an OFFSET, so deep pages cost the same as the first one
+func keysetPaginatedQuery(query *pop.Query, page services.Pagination, order services.QueryOrder, t reflect.Type) (*pop.Query, error) {
+	return KeysetPaginate(query, page, order, reflect.New(t).Interface())
+}
+
 func orderedQuery(query *pop.Query, order services.QueryOrder, t reflect.Type) (*pop.Query, error) {
 	//omit sorting if no column specified
 	if order == nil || order.Column() == nil || order.SortOrder() == nil {
@@ -119,6 +132,11 @@ func orderedQuery(query *pop.Query, order services.QueryOrder, t reflect.Type) (
 	orderQuery := fmt.Sprintf("%s %s", *order.Column(), sortOrder)
 	query = query.Order(orderQuery)
 
+	// Break ties on id so rows that share a sort value keep the same order from page to page
+	if _, ok := getDBColumn(t, "id"); ok && *order.Column() != "id" {
+		query = query.Order(fmt.Sprintf("id %s", sortOrder))
+	}
+
 	if len(invalidField) != 0 {
 		return query, fmt.Errorf("%v is not valid input", invalidField)
 	}
@@ -12,6 +12,7 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	"github.com/transcom/mymove/pkg/appcontext"
+	"github.com/transcom/mymove/pkg/apperror"
 	"github.com/transcom/mymove/pkg/etag"
 	"github.com/transcom/mymove/pkg/factory"
 	"github.com/transcom/mymove/pkg/models"
@@ -184,6 +185,30 @@ func (suite *QueryBuilderSuite) TestFetchMany() {
 		suite.Equal(user2.ID, actualUsers[0].ID)
 	})
 
+	suite.Run("fetches many with a time range filter", func() {
+		// Under test: FetchMany function
+		// Mocked: 	None
+		// Set up: 	Create 3 users, fetch based on createdAt timestamp being between
+		//			those recorded for the first and last users
+		// Expected outcome: Fetch returns the single matching record
+
+		user := factory.BuildOfficeUserWithRoles(suite.DB(), nil, []roles.RoleType{roles.RoleTypeTOO})
+		user2 := factory.BuildOfficeUserWithRoles(suite.DB(), nil, []roles.RoleType{roles.RoleTypeTOO})
+		user3 := factory.BuildOfficeUserWithRoles(suite.DB(), nil, []roles.RoleType{roles.RoleTypeTOO})
+
+		filters := []services.QueryFilter{
+			NewQueryFilter("created_at", greaterThan, user.CreatedAt),
+			NewQueryFilter("created_at", lessThan, user3.CreatedAt),
+		}
+		var actualUsers models.OfficeUsers
+
+		err := builder.FetchMany(suite.AppContextForTest(), &actualUsers, filters, defaultAssociations(), defaultPagination(), defaultOrder())
+
+		suite.NoError(err)
+		suite.Len(actualUsers, 1)
+		suite.Equal(user2.ID, actualUsers[0].ID)
+	})
+
 	suite.Run("fetches many with ilike filter", func() {
 		// Under test: FetchMany function
 		// Mocked: None
@@ -414,6 +439,114 @@ func (suite *QueryBuilderSuite) TestFetchManyAssociations() {
 	})
 }
 
+func (suite *QueryBuilderSuite) TestFetchManyWithCursor() {
+	builder := NewQueryBuilder()
+
+	suite.Run("pages through every record once", func() {
+		// Under test: FetchMany function with a cursor pagination
+		// Mocked: None
+		// Set up: Create 5 users sharing a created_at, page through them 2 at a time by created_at desc
+		// Expected outcome: Each user comes back on exactly one page, ordered by id within the tied created_at
+		createdAt := time.Now().Truncate(time.Second)
+		var expectedIDs []uuid.UUID
+		for i := 0; i < 5; i++ {
+			user := factory.BuildOfficeUserWithRoles(suite.DB(), nil, []roles.RoleType{roles.RoleTypeTOO})
+			err := suite.DB().RawQuery("UPDATE office_users SET created_at = $1 WHERE id = $2", createdAt, user.ID).Exec()
+			suite.NoError(err)
+			expectedIDs = append(expectedIDs, user.ID)
+		}
+		filters := []services.QueryFilter{NewQueryFilter("created_at", equals, createdAt)}
+		order, sort := "created_at", false
+		ordering := NewQueryOrder(&order, &sort)
+		perPage := int64(2)
+
+		var fetchedIDs []uuid.UUID
+		var cursor *string
+		for pages := 1; ; pages++ {
+			suite.LessOrEqual(pages, 3)
+			page, err := pagination.NewCursorPagination(cursor, &perPage)
+			suite.NoError(err)
+
+			var actualUsers models.OfficeUsers
+			err = builder.FetchMany(suite.AppContextForTest(), &actualUsers, filters, defaultAssociations(), page, ordering)
+			suite.NoError(err)
+			suite.LessOrEqual(len(actualUsers), 2)
+			for _, user := range actualUsers {
+				fetchedIDs = append(fetchedIDs, user.ID)
+			}
+
+			cursor = NextCursor(&actualUsers, page, ordering)
+			if cursor == nil {
+				break
+			}
+		}
+
+		suite.Len(fetchedIDs, 5)
+		suite.ElementsMatch(expectedIDs, fetchedIDs)
+		for i := 1; i < len(fetchedIDs); i++ {
+			suite.Greater(fetchedIDs[i-1].String(), fetchedIDs[i].String())
+		}
+	})
+
+	suite.Run("fails with a cursor made for another sort", func() {
+		order, sort := "created_at", true
+		otherOrder := "updated_at"
+		perPage := int64(1)
+		factory.BuildOfficeUserWithRoles(suite.DB(), nil, []roles.RoleType{roles.RoleTypeTOO})
+		factory.BuildOfficeUserWithRoles(suite.DB(), nil, []roles.RoleType{roles.RoleTypeTOO})
+
+		page, err := pagination.NewCursorPagination(nil, &perPage)
+		suite.NoError(err)
+		var actualUsers models.OfficeUsers
+		err = builder.FetchMany(suite.AppContextForTest(), &actualUsers, nil, defaultAssociations(), page, NewQueryOrder(&order, &sort))
+		suite.NoError(err)
+		cursor := NextCursor(&actualUsers, page, NewQueryOrder(&order, &sort))
+		suite.NotNil(cursor)
+
+		page, err = pagination.NewCursorPagination(cursor, &perPage)
+		suite.NoError(err)
+		err = builder.FetchMany(suite.AppContextForTest(), &actualUsers, nil, defaultAssociations(), page, NewQueryOrder(&otherOrder, &sort))
+		suite.IsType(apperror.InvalidInputError{}, err)
+	})
+}
+
+func (suite *QueryBuilderSuite) TestKeysetCondition() {
+	status, id := "APPROVED", "7b8ba5a8-0e67-4e58-b8b0-5ac3e2a1c5ee"
+	columns := []KeysetColumn{
+		{Expression: "status", Descending: true},
+		{Expression: "name"},
+		{Expression: "id"},
+	}
+
+	suite.Run("orders by every column", func() {
+		suite.Equal("status desc, name asc, id asc", KeysetOrder(columns))
+	})
+
+	suite.Run("matches the rows after the cursor values", func() {
+		name := "Jones"
+		condition, args := KeysetCondition(columns, []*string{&status, &name, &id})
+
+		suite.Equal("((status < ?) OR "+
+			"(status = ? AND (name > ? OR name IS NULL)) OR "+
+			"(status = ? AND name = ? AND (id > ? OR id IS NULL)))", condition)
+		suite.Equal([]interface{}{status, status, name, status, name, id}, args)
+	})
+
+	suite.Run("sorts NULL last when ascending", func() {
+		condition, args := KeysetCondition(columns, []*string{&status, nil, &id})
+
+		suite.Equal("((status < ?) OR (status = ? AND name IS NULL AND (id > ? OR id IS NULL)))", condition)
+		suite.Equal([]interface{}{status, status, id}, args)
+	})
+
+	suite.Run("sorts NULL first when descending", func() {
+		condition, args := KeysetCondition(columns[:1], []*string{nil})
+
+		suite.Equal("((status IS NOT NULL))", condition)
+		suite.Empty(args)
+	})
+}
+
 func (suite *QueryBuilderSuite) TestCount() {
 	builder := NewQueryBuilder()
 
@@ -0,0 +1,39 @@
+package services
+
+import (
+	"github.com/gofrs/uuid"
+
+	"github.com/transcom/mymove/pkg/appcontext"
+	"github.com/transcom/mymove/pkg/models"
+)
+
+// QueueViewFetcher fetches the saved queue views an office user can use: their own views and the
+// views shared with the transportation offices they work for
+//
+//go:generate mockery --name QueueViewFetcher
+type QueueViewFetcher interface {
+	FetchQueueViews(appCtx appcontext.AppContext, officeUserID uuid.UUID, queueType *models.QueueType) (models.OfficeQueueViews, error)
+	FetchQueueView(appCtx appcontext.AppContext, officeUserID uuid.UUID, viewID uuid.UUID) (*models.OfficeQueueView, error)
+}
+
+// QueueViewCreator saves a queue view for an office user, shared with their primary transportation
+// office when shared is true
+//
+//go:generate mockery --name QueueViewCreator
+type QueueViewCreator interface {
+	CreateQueueView(appCtx appcontext.AppContext, view *models.OfficeQueueView, shared bool) (*models.OfficeQueueView, error)
+}
+
+// QueueViewUpdater replaces the settings of a queue view owned by view.OfficeUserID
+//
+//go:generate mockery --name QueueViewUpdater
+type QueueViewUpdater interface {
+	UpdateQueueView(appCtx appcontext.AppContext, view *models.OfficeQueueView, shared bool, eTag string) (*models.OfficeQueueView, error)
+}
+
+// QueueViewDeleter deletes a queue view owned by the office user
+//
+//go:generate mockery --name QueueViewDeleter
+type QueueViewDeleter interface {
+	DeleteQueueView(appCtx appcontext.AppContext, officeUserID uuid.UUID, viewID uuid.UUID) error
+}
@@ -0,0 +1,86 @@
+package queueview
+
+import (
+	"database/sql"
+
+	"github.com/gobuffalo/validate/v3"
+	"github.com/gofrs/uuid"
+	"github.com/lib/pq"
+
+	"github.com/transcom/mymove/pkg/appcontext"
+	"github.com/transcom/mymove/pkg/apperror"
+	"github.com/transcom/mymove/pkg/models"
+	"github.com/transcom/mymove/pkg/services"
+)
+
+type queueViewCreator struct {
+}
+
+// NewQueueViewCreator creates a new queueViewCreator service
+func NewQueueViewCreator() services.QueueViewCreator {
+	return &queueViewCreator{}
+}
+
+// CreateQueueView saves a queue view for view.OfficeUserID
+func (c *queueViewCreator) CreateQueueView(appCtx appcontext.AppContext, view *models.OfficeQueueView, shared bool) (*models.OfficeQueueView, error) {
+	view.TransportationOfficeID = nil
+	if shared {
+		officeID, err := primaryTransportationOfficeID(appCtx, view.OfficeUserID)
+		if err != nil {
+			return nil, err
+		}
+		view.TransportationOfficeID = officeID
+	}
+	if view.Columns == nil {
+		view.Columns = pq.StringArray{}
+	}
+
+	if err := checkQueueViewNameIsFree(appCtx, view); err != nil {
+		return nil, err
+	}
+
+	verrs, err := appCtx.DB().ValidateAndCreate(view)
+	if verrs != nil && verrs.HasAny() {
+		return nil, apperror.NewInvalidInputError(uuid.Nil, err, verrs, "")
+	}
+	if err != nil {
+		return nil, apperror.NewQueryError("OfficeQueueView", err, "")
+	}
+
+	if err := appCtx.DB().Load(view, "OfficeUser"); err != nil {
+		return nil, apperror.NewQueryError("OfficeUser", err, "")
+	}
+	return view, nil
+}
+
+// primaryTransportationOfficeID returns the office an office user shares their views with
+func primaryTransportationOfficeID(appCtx appcontext.AppContext, officeUserID uuid.UUID) (*uuid.UUID, error) {
+	var officeUser models.OfficeUser
+	err := appCtx.DB().Find(&officeUser, officeUserID)
+	if err != nil {
+		switch err {
+		case sql.ErrNoRows:
+			return nil, apperror.NewNotFoundError(officeUserID, "while looking for office user")
+		default:
+			return nil, apperror.NewQueryError("OfficeUser", err, "")
+		}
+	}
+	return &officeUser.TransportationOfficeID, nil
+}
+
+// checkQueueViewNameIsFree keeps an office user from saving two views with the same name for a queue,
+// so they can tell them apart in the view picker
+func checkQueueViewNameIsFree(appCtx appcontext.AppContext, view *models.OfficeQueueView) error {
+	exists, err := appCtx.DB().
+		Where("office_user_id = ? AND queue_type = ? AND name = ? AND id <> ?", view.OfficeUserID, view.QueueType, view.Name, view.ID).
+		Exists(&models.OfficeQueueView{})
+	if err != nil {
+		return apperror.NewQueryError("OfficeQueueView", err, "")
+	}
+	if exists {
+		verrs := validate.NewErrors()
+		verrs.Add("name", "a queue view with this name already exists for this queue")
+		return apperror.NewInvalidInputError(view.ID, nil, verrs, "")
+	}
+	return nil
+}
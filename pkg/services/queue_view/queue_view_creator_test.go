@@ -0,0 +1,64 @@
+package queueview
+
+import (
+	"github.com/transcom/mymove/pkg/apperror"
+	"github.com/transcom/mymove/pkg/factory"
+	"github.com/transcom/mymove/pkg/models"
+	"github.com/transcom/mymove/pkg/models/roles"
+)
+
+func (suite *QueueViewServiceSuite) TestCreateQueueView() {
+	creator := NewQueueViewCreator()
+
+	newView := func(officeUser models.OfficeUser, name string) *models.OfficeQueueView {
+		return &models.OfficeQueueView{
+			OfficeUserID: officeUser.ID,
+			QueueType:    models.QueueTypeCounseling,
+			Name:         name,
+			Filters: models.QueueViewFilters{
+				OriginDutyLocation: []string{"Fort Eisenhower, GA 30813"},
+			},
+		}
+	}
+
+	suite.Run("saves a private view", func() {
+		officeUser := factory.BuildOfficeUserWithRoles(suite.DB(), nil, []roles.RoleType{roles.RoleTypeServicesCounselor})
+
+		view, err := creator.CreateQueueView(suite.AppContextForTest(), newView(officeUser, "Fort Eisenhower"), false)
+		suite.NoError(err)
+		suite.NotEqual(models.OfficeQueueView{}.ID, view.ID)
+		suite.False(view.IsShared())
+		suite.Equal(officeUser.ID, view.OfficeUser.ID)
+		suite.Empty(view.Columns)
+
+		var saved models.OfficeQueueView
+		suite.NoError(suite.DB().Find(&saved, view.ID))
+		suite.Equal([]string{"Fort Eisenhower, GA 30813"}, saved.Filters.OriginDutyLocation)
+	})
+
+	suite.Run("shares a view with the office user's primary office", func() {
+		officeUser := factory.BuildOfficeUserWithRoles(suite.DB(), nil, []roles.RoleType{roles.RoleTypeServicesCounselor})
+
+		view, err := creator.CreateQueueView(suite.AppContextForTest(), newView(officeUser, "Fort Eisenhower"), true)
+		suite.NoError(err)
+		suite.Equal(&officeUser.TransportationOfficeID, view.TransportationOfficeID)
+	})
+
+	suite.Run("rejects a second view with the same name for the queue", func() {
+		officeUser := factory.BuildOfficeUserWithRoles(suite.DB(), nil, []roles.RoleType{roles.RoleTypeServicesCounselor})
+		_, err := creator.CreateQueueView(suite.AppContextForTest(), newView(officeUser, "Fort Eisenhower"), false)
+		suite.NoError(err)
+
+		_, err = creator.CreateQueueView(suite.AppContextForTest(), newView(officeUser, "Fort Eisenhower"), false)
+		suite.IsType(apperror.InvalidInputError{}, err)
+	})
+
+	suite.Run("rejects an invalid view", func() {
+		officeUser := factory.BuildOfficeUserWithRoles(suite.DB(), nil, []roles.RoleType{roles.RoleTypeServicesCounselor})
+		view := newView(officeUser, "")
+		view.Order = models.StringPointer("sideways")
+
+		_, err := creator.CreateQueueView(suite.AppContextForTest(), view, false)
+		suite.IsType(apperror.InvalidInputError{}, err)
+	})
+}
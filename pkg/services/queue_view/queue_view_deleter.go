@@ -0,0 +1,30 @@
+package queueview
+
+import (
+	"github.com/gofrs/uuid"
+
+	"github.com/transcom/mymove/pkg/appcontext"
+	"github.com/transcom/mymove/pkg/apperror"
+	"github.com/transcom/mymove/pkg/services"
+)
+
+type queueViewDeleter struct {
+}
+
+// NewQueueViewDeleter creates a new queueViewDeleter service
+func NewQueueViewDeleter() services.QueueViewDeleter {
+	return &queueViewDeleter{}
+}
+
+// DeleteQueueView deletes a view the office user saved. Office users the view was shared with lose it too.
+func (d *queueViewDeleter) DeleteQueueView(appCtx appcontext.AppContext, officeUserID uuid.UUID, viewID uuid.UUID) error {
+	view, err := fetchOwnedQueueView(appCtx, officeUserID, viewID)
+	if err != nil {
+		return err
+	}
+
+	if err := appCtx.DB().Destroy(view); err != nil {
+		return apperror.NewQueryError("OfficeQueueView", err, "")
+	}
+	return nil
+}
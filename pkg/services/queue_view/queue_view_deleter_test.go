@@ -0,0 +1,35 @@
+package queueview
+
+import (
+	"github.com/transcom/mymove/pkg/apperror"
+	"github.com/transcom/mymove/pkg/factory"
+	"github.com/transcom/mymove/pkg/models"
+	"github.com/transcom/mymove/pkg/models/roles"
+)
+
+func (suite *QueueViewServiceSuite) TestDeleteQueueView() {
+	deleter := NewQueueViewDeleter()
+
+	suite.Run("deletes the office user's view", func() {
+		officeUser := factory.BuildOfficeUserWithRoles(suite.DB(), nil, []roles.RoleType{roles.RoleTypeTOO})
+		view := suite.buildQueueView(officeUser, "Navy", &officeUser.TransportationOffice)
+
+		err := deleter.DeleteQueueView(suite.AppContextForTest(), officeUser.ID, view.ID)
+		suite.NoError(err)
+
+		exists, err := suite.DB().Where("id = ?", view.ID).Exists(&models.OfficeQueueView{})
+		suite.NoError(err)
+		suite.False(exists)
+	})
+
+	suite.Run("does not let office users delete views shared with them", func() {
+		officeUser := factory.BuildOfficeUserWithRoles(suite.DB(), nil, []roles.RoleType{roles.RoleTypeTOO})
+		coworker := factory.BuildOfficeUserWithRoles(suite.DB(), []factory.Customization{
+			{Model: officeUser.TransportationOffice, LinkOnly: true},
+		}, []roles.RoleType{roles.RoleTypeTOO})
+		view := suite.buildQueueView(coworker, "Navy", &officeUser.TransportationOffice)
+
+		err := deleter.DeleteQueueView(suite.AppContextForTest(), officeUser.ID, view.ID)
+		suite.IsType(apperror.ForbiddenError{}, err)
+	})
+}
@@ -0,0 +1,81 @@
+package queueview
+
+import (
+	"database/sql"
+
+	"github.com/gofrs/uuid"
+
+	"github.com/transcom/mymove/pkg/appcontext"
+	"github.com/transcom/mymove/pkg/apperror"
+	"github.com/transcom/mymove/pkg/models"
+	"github.com/transcom/mymove/pkg/services"
+)
+
+// visibleQueueViewsClause matches the views an office user saved and the views shared with the
+// transportation offices they work for, primary or assigned
+const visibleQueueViewsClause = `(office_queue_views.office_user_id = ? OR office_queue_views.transportation_office_id IN (
+	SELECT transportation_office_id FROM office_users WHERE id = ?
+	UNION
+	SELECT transportation_office_id FROM transportation_office_assignments WHERE id = ?
+))`
+
+type queueViewFetcher struct {
+}
+
+// NewQueueViewFetcher creates a new queueViewFetcher service
+func NewQueueViewFetcher() services.QueueViewFetcher {
+	return &queueViewFetcher{}
+}
+
+// FetchQueueViews returns the views the office user can use, optionally only those for one queue,
+// ordered by name
+func (f *queueViewFetcher) FetchQueueViews(appCtx appcontext.AppContext, officeUserID uuid.UUID, queueType *models.QueueType) (models.OfficeQueueViews, error) {
+	query := appCtx.DB().EagerPreload("OfficeUser").
+		Where(visibleQueueViewsClause, officeUserID, officeUserID, officeUserID)
+	if queueType != nil {
+		query = query.Where("office_queue_views.queue_type = ?", *queueType)
+	}
+
+	var views models.OfficeQueueViews
+	err := query.Order("office_queue_views.name ASC, office_queue_views.created_at ASC").All(&views)
+	if err != nil {
+		return nil, apperror.NewQueryError("OfficeQueueView", err, "")
+	}
+	return views, nil
+}
+
+// FetchQueueView returns a view the office user can use. Views saved by someone else and not shared
+// with the office user are not found.
+func (f *queueViewFetcher) FetchQueueView(appCtx appcontext.AppContext, officeUserID uuid.UUID, viewID uuid.UUID) (*models.OfficeQueueView, error) {
+	return fetchVisibleQueueView(appCtx, officeUserID, viewID)
+}
+
+func fetchVisibleQueueView(appCtx appcontext.AppContext, officeUserID uuid.UUID, viewID uuid.UUID) (*models.OfficeQueueView, error) {
+	var view models.OfficeQueueView
+	err := appCtx.DB().EagerPreload("OfficeUser").
+		Where("office_queue_views.id = ?", viewID).
+		Where(visibleQueueViewsClause, officeUserID, officeUserID, officeUserID).
+		First(&view)
+	if err != nil {
+		switch err {
+		case sql.ErrNoRows:
+			return nil, apperror.NewNotFoundError(viewID, "while looking for queue view")
+		default:
+			return nil, apperror.NewQueryError("OfficeQueueView", err, "")
+		}
+	}
+	return &view, nil
+}
+
+// fetchOwnedQueueView returns a view the office user saved. Shared views saved by someone else can be
+// used but not changed.
+func fetchOwnedQueueView(appCtx appcontext.AppContext, officeUserID uuid.UUID, viewID uuid.UUID) (*models.OfficeQueueView, error) {
+	view, err := fetchVisibleQueueView(appCtx, officeUserID, viewID)
+	if err != nil {
+		return nil, err
+	}
+	if view.OfficeUserID != officeUserID {
+		return nil, apperror.NewForbiddenError("only the office user who saved a queue view can change it")
+	}
+	return view, nil
+}
@@ -0,0 +1,94 @@
+package queueview
+
+import (
+	"github.com/gofrs/uuid"
+
+	"github.com/transcom/mymove/pkg/apperror"
+	"github.com/transcom/mymove/pkg/factory"
+	"github.com/transcom/mymove/pkg/models"
+	"github.com/transcom/mymove/pkg/models/roles"
+)
+
+func (suite *QueueViewServiceSuite) TestFetchQueueViews() {
+	fetcher := NewQueueViewFetcher()
+
+	suite.Run("returns the office user's views and the views shared with their office", func() {
+		office := factory.BuildTransportationOffice(suite.DB(), nil, nil)
+		officeUser := factory.BuildOfficeUserWithRoles(suite.DB(), []factory.Customization{
+			{Model: office, LinkOnly: true},
+		}, []roles.RoleType{roles.RoleTypeTOO})
+		coworker := factory.BuildOfficeUserWithRoles(suite.DB(), []factory.Customization{
+			{Model: office, LinkOnly: true},
+		}, []roles.RoleType{roles.RoleTypeTOO})
+		stranger := factory.BuildOfficeUserWithRoles(suite.DB(), nil, []roles.RoleType{roles.RoleTypeTOO})
+
+		own := suite.buildQueueView(officeUser, "B mine", nil)
+		shared := suite.buildQueueView(coworker, "A shared", &office)
+		suite.buildQueueView(coworker, "C private", nil)
+		suite.buildQueueView(stranger, "D elsewhere", &stranger.TransportationOffice)
+
+		views, err := fetcher.FetchQueueViews(suite.AppContextForTest(), officeUser.ID, nil)
+		suite.NoError(err)
+		suite.Len(views, 2)
+		suite.Equal(shared.ID, views[0].ID)
+		suite.Equal(coworker.ID, views[0].OfficeUser.ID)
+		suite.Equal(own.ID, views[1].ID)
+		suite.Equal(models.StringPointer(string(models.AffiliationNAVY)), views[1].Filters.Branch)
+	})
+
+	suite.Run("returns views shared with an assigned transportation office", func() {
+		officeUser := factory.BuildOfficeUserWithRoles(suite.DB(), nil, []roles.RoleType{roles.RoleTypeTOO})
+		coworker := factory.BuildOfficeUserWithRoles(suite.DB(), nil, []roles.RoleType{roles.RoleTypeTOO})
+		factory.BuildAlternateTransportationOfficeAssignment(suite.DB(), []factory.Customization{
+			{Model: officeUser, LinkOnly: true},
+			{Model: coworker.TransportationOffice, LinkOnly: true},
+		}, nil)
+		shared := suite.buildQueueView(coworker, "Shared", &coworker.TransportationOffice)
+
+		views, err := fetcher.FetchQueueViews(suite.AppContextForTest(), officeUser.ID, nil)
+		suite.NoError(err)
+		suite.Len(views, 1)
+		suite.Equal(shared.ID, views[0].ID)
+	})
+
+	suite.Run("filters by queue type", func() {
+		officeUser := factory.BuildOfficeUserWithRoles(suite.DB(), nil, []roles.RoleType{roles.RoleTypeTOO})
+		suite.buildQueueView(officeUser, "Task orders", nil)
+
+		queueType := models.QueueTypePaymentRequest
+		views, err := fetcher.FetchQueueViews(suite.AppContextForTest(), officeUser.ID, &queueType)
+		suite.NoError(err)
+		suite.Empty(views)
+	})
+}
+
+func (suite *QueueViewServiceSuite) TestFetchQueueView() {
+	fetcher := NewQueueViewFetcher()
+
+	suite.Run("returns a view shared with the office user", func() {
+		office := factory.BuildTransportationOffice(suite.DB(), nil, nil)
+		officeUser := factory.BuildOfficeUserWithRoles(suite.DB(), []factory.Customization{
+			{Model: office, LinkOnly: true},
+		}, []roles.RoleType{roles.RoleTypeTOO})
+		coworker := factory.BuildOfficeUserWithRoles(suite.DB(), []factory.Customization{
+			{Model: office, LinkOnly: true},
+		}, []roles.RoleType{roles.RoleTypeTOO})
+		shared := suite.buildQueueView(coworker, "Shared", &office)
+
+		view, err := fetcher.FetchQueueView(suite.AppContextForTest(), officeUser.ID, shared.ID)
+		suite.NoError(err)
+		suite.Equal(shared.ID, view.ID)
+	})
+
+	suite.Run("does not find another office user's private view", func() {
+		officeUser := factory.BuildOfficeUserWithRoles(suite.DB(), nil, []roles.RoleType{roles.RoleTypeTOO})
+		stranger := factory.BuildOfficeUserWithRoles(suite.DB(), nil, []roles.RoleType{roles.RoleTypeTOO})
+		private := suite.buildQueueView(stranger, "Private", nil)
+
+		_, err := fetcher.FetchQueueView(suite.AppContextForTest(), officeUser.ID, private.ID)
+		suite.IsType(apperror.NotFoundError{}, err)
+
+		_, err = fetcher.FetchQueueView(suite.AppContextForTest(), officeUser.ID, uuid.Must(uuid.NewV4()))
+		suite.IsType(apperror.NotFoundError{}, err)
+	})
+}
@@ -0,0 +1,42 @@
+package queueview
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/transcom/mymove/pkg/models"
+	"github.com/transcom/mymove/pkg/testingsuite"
+)
+
+type QueueViewServiceSuite struct {
+	*testingsuite.PopTestSuite
+}
+
+func TestQueueViewServiceSuite(t *testing.T) {
+	ts := &QueueViewServiceSuite{
+		PopTestSuite: testingsuite.NewPopTestSuite(testingsuite.CurrentPackage(), testingsuite.WithPerTestTransaction()),
+	}
+	suite.Run(t, ts)
+	ts.PopTestSuite.TearDown()
+}
+
+// buildQueueView saves a task order queue view for the office user, shared with sharedWith when it isn't nil
+func (suite *QueueViewServiceSuite) buildQueueView(officeUser models.OfficeUser, name string, sharedWith *models.TransportationOffice) models.OfficeQueueView {
+	view := models.OfficeQueueView{
+		OfficeUserID: officeUser.ID,
+		QueueType:    models.QueueTypeTaskOrder,
+		Name:         name,
+		Filters: models.QueueViewFilters{
+			Branch: models.StringPointer(string(models.AffiliationNAVY)),
+		},
+		Sort:    models.StringPointer("customerName"),
+		Order:   models.StringPointer("asc"),
+		Columns: []string{"customerName", "locator"},
+	}
+	if sharedWith != nil {
+		view.TransportationOfficeID = &sharedWith.ID
+	}
+	suite.MustCreate(&view)
+	return view
+}
@@ -0,0 +1,64 @@
+package queueview
+
+import (
+	"github.com/lib/pq"
+
+	"github.com/transcom/mymove/pkg/appcontext"
+	"github.com/transcom/mymove/pkg/apperror"
+	"github.com/transcom/mymove/pkg/etag"
+	"github.com/transcom/mymove/pkg/models"
+	"github.com/transcom/mymove/pkg/services"
+	"github.com/transcom/mymove/pkg/services/query"
+)
+
+type queueViewUpdater struct {
+}
+
+// NewQueueViewUpdater creates a new queueViewUpdater service
+func NewQueueViewUpdater() services.QueueViewUpdater {
+	return &queueViewUpdater{}
+}
+
+// UpdateQueueView replaces the name, filters, sort, columns and sharing of the view with view.ID.
+// A view that is shared already stays shared with the same office.
+func (u *queueViewUpdater) UpdateQueueView(appCtx appcontext.AppContext, view *models.OfficeQueueView, shared bool, eTag string) (*models.OfficeQueueView, error) {
+	existing, err := fetchOwnedQueueView(appCtx, view.OfficeUserID, view.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if etag.GenerateEtag(existing.UpdatedAt) != eTag {
+		return nil, apperror.NewPreconditionFailedError(view.ID, query.StaleIdentifierError{StaleIdentifier: eTag})
+	}
+
+	if !shared {
+		existing.TransportationOfficeID = nil
+	} else if !existing.IsShared() {
+		officeID, err := primaryTransportationOfficeID(appCtx, existing.OfficeUserID)
+		if err != nil {
+			return nil, err
+		}
+		existing.TransportationOfficeID = officeID
+	}
+	existing.Name = view.Name
+	existing.Filters = view.Filters
+	existing.Sort = view.Sort
+	existing.Order = view.Order
+	existing.Columns = view.Columns
+	if existing.Columns == nil {
+		existing.Columns = pq.StringArray{}
+	}
+
+	if err := checkQueueViewNameIsFree(appCtx, existing); err != nil {
+		return nil, err
+	}
+
+	verrs, err := appCtx.DB().ValidateAndUpdate(existing)
+	if verrs != nil && verrs.HasAny() {
+		return nil, apperror.NewInvalidInputError(existing.ID, err, verrs, "")
+	}
+	if err != nil {
+		return nil, apperror.NewQueryError("OfficeQueueView", err, "")
+	}
+	return existing, nil
+}
@@ -0,0 +1,67 @@
+package queueview
+
+import (
+	"github.com/transcom/mymove/pkg/apperror"
+	"github.com/transcom/mymove/pkg/etag"
+	"github.com/transcom/mymove/pkg/factory"
+	"github.com/transcom/mymove/pkg/models"
+	"github.com/transcom/mymove/pkg/models/roles"
+)
+
+func (suite *QueueViewServiceSuite) TestUpdateQueueView() {
+	updater := NewQueueViewUpdater()
+
+	changes := func(view models.OfficeQueueView, officeUser models.OfficeUser) *models.OfficeQueueView {
+		return &models.OfficeQueueView{
+			ID:           view.ID,
+			OfficeUserID: officeUser.ID,
+			Name:         "Marines",
+			Filters: models.QueueViewFilters{
+				Branch: models.StringPointer(string(models.AffiliationMARINES)),
+			},
+			Order: models.StringPointer("desc"),
+		}
+	}
+
+	suite.Run("replaces the settings of the office user's view and shares it", func() {
+		officeUser := factory.BuildOfficeUserWithRoles(suite.DB(), nil, []roles.RoleType{roles.RoleTypeTOO})
+		view := suite.buildQueueView(officeUser, "Navy", nil)
+
+		updated, err := updater.UpdateQueueView(suite.AppContextForTest(), changes(view, officeUser), true, etag.GenerateEtag(view.UpdatedAt))
+		suite.NoError(err)
+		suite.Equal("Marines", updated.Name)
+		suite.Equal(models.StringPointer(string(models.AffiliationMARINES)), updated.Filters.Branch)
+		suite.Nil(updated.Sort)
+		suite.Equal(models.StringPointer("desc"), updated.Order)
+		suite.Empty(updated.Columns)
+		suite.Equal(&officeUser.TransportationOfficeID, updated.TransportationOfficeID)
+	})
+
+	suite.Run("stops sharing a view", func() {
+		officeUser := factory.BuildOfficeUserWithRoles(suite.DB(), nil, []roles.RoleType{roles.RoleTypeTOO})
+		view := suite.buildQueueView(officeUser, "Navy", &officeUser.TransportationOffice)
+
+		updated, err := updater.UpdateQueueView(suite.AppContextForTest(), changes(view, officeUser), false, etag.GenerateEtag(view.UpdatedAt))
+		suite.NoError(err)
+		suite.False(updated.IsShared())
+	})
+
+	suite.Run("rejects a stale eTag", func() {
+		officeUser := factory.BuildOfficeUserWithRoles(suite.DB(), nil, []roles.RoleType{roles.RoleTypeTOO})
+		view := suite.buildQueueView(officeUser, "Navy", nil)
+
+		_, err := updater.UpdateQueueView(suite.AppContextForTest(), changes(view, officeUser), false, "stale")
+		suite.IsType(apperror.PreconditionFailedError{}, err)
+	})
+
+	suite.Run("does not let office users change views shared with them", func() {
+		officeUser := factory.BuildOfficeUserWithRoles(suite.DB(), nil, []roles.RoleType{roles.RoleTypeTOO})
+		coworker := factory.BuildOfficeUserWithRoles(suite.DB(), []factory.Customization{
+			{Model: officeUser.TransportationOffice, LinkOnly: true},
+		}, []roles.RoleType{roles.RoleTypeTOO})
+		view := suite.buildQueueView(coworker, "Navy", &officeUser.TransportationOffice)
+
+		_, err := updater.UpdateQueueView(suite.AppContextForTest(), changes(view, officeUser), true, etag.GenerateEtag(view.UpdatedAt))
+		suite.IsType(apperror.ForbiddenError{}, err)
+	})
+}
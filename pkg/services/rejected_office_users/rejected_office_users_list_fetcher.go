@@ -9,6 +9,7 @@ import (
 	"github.com/transcom/mymove/pkg/appcontext"
 	"github.com/transcom/mymove/pkg/models"
 	"github.com/transcom/mymove/pkg/services"
+	"github.com/transcom/mymove/pkg/services/query"
 )
 
 type rejectedOfficeUsersListQueryBuilder interface {
@@ -38,6 +39,16 @@ func (o *rejectedOfficeUserListFetcher) FetchRejectedOfficeUsersList(appCtx appc
 
 	query = query.Where("status = ?", models.OfficeUserStatusREJECTED)
 	query.GroupBy("office_users.id")
+	query.Select("office_users.*")
+
+	if pagination.Cursor() != nil {
+		count, err := fetchRejectedOfficeUsersAfterCursor(query, &rejectedUsers, pagination, ordering)
+		if err != nil {
+			return nil, 0, err
+		}
+		sortRoles(rejectedUsers)
+		return rejectedUsers, count, nil
+	}
 
 	var order = "desc"
 	if ordering.SortOrder() != nil && *ordering.SortOrder() {
@@ -59,18 +70,12 @@ func (o *rejectedOfficeUserListFetcher) FetchRejectedOfficeUsersList(appCtx appc
 		query = query.Order(fmt.Sprintf("%s %s", orderTerm, order))
 	}
 
-	query.Select("office_users.*")
-
 	err := query.Paginate(pagination.Page(), pagination.PerPage()).All(&rejectedUsers)
 	if err != nil {
 		return nil, 0, err
 	}
 
-	for i := range rejectedUsers {
-		sort.Slice(rejectedUsers[i].User.Roles, func(a, b int) bool {
-			return rejectedUsers[i].User.Roles[a].RoleName < rejectedUsers[i].User.Roles[b].RoleName
-		})
-	}
+	sortRoles(rejectedUsers)
 
 	if orderTerm == "transportation_office_id" {
 		if order == "desc" {
@@ -88,6 +93,30 @@ func (o *rejectedOfficeUserListFetcher) FetchRejectedOfficeUsersList(appCtx appc
 	return rejectedUsers, count, nil
 }
 
+// sortRoles puts each rejected user's roles in name order
+func sortRoles(rejectedUsers models.OfficeUsers) {
+	for i := range rejectedUsers {
+		sort.Slice(rejectedUsers[i].User.Roles, func(a, b int) bool {
+			return rejectedUsers[i].User.Roles[a].RoleName < rejectedUsers[i].User.Roles[b].RoleName
+		})
+	}
+}
+
+// fetchRejectedOfficeUsersAfterCursor counts the rejected office users the query matches and fetches the page after the cursor. The page is
+// ordered by the requested column itself rather than re-sorted by office name.
+func fetchRejectedOfficeUsersAfterCursor(q *pop.Query, rejectedUsers *models.OfficeUsers, pagination services.Pagination, ordering services.QueryOrder) (int, error) {
+	count, err := q.Count(&models.OfficeUser{})
+	if err != nil {
+		return 0, err
+	}
+
+	page, err := query.KeysetPaginate(q, pagination, ordering, rejectedUsers)
+	if err != nil {
+		return 0, err
+	}
+	return count, page.All(rejectedUsers)
+}
+
 // FetchRejectedUserList uses the passed query builder to fetch a list of office users
 func (o *rejectedOfficeUserListFetcher) FetchRejectedOfficeUsersCount(appCtx appcontext.AppContext, filters []services.QueryFilter) (int, error) {
 	var rejectedUsers models.OfficeUsers
@@ -10,6 +10,7 @@ import (
 	"github.com/transcom/mymove/pkg/models"
 	"github.com/transcom/mymove/pkg/models/roles"
 	"github.com/transcom/mymove/pkg/services"
+	"github.com/transcom/mymove/pkg/services/query"
 )
 
 type requestedOfficeUsersListQueryBuilder interface {
@@ -39,6 +40,19 @@ func (o *requestedOfficeUserListFetcher) FetchRequestedOfficeUsersList(appCtx ap
 
 	query = query.Where("status = ?", models.OfficeUserStatusREQUESTED)
 	query.GroupBy("office_users.id")
+	query.Select("office_users.*")
+
+	if pagination.Cursor() != nil {
+		count, err := fetchRequestedOfficeUsersAfterCursor(query, &requestedUsers, pagination, ordering)
+		if err != nil {
+			return nil, 0, err
+		}
+		err = loadLiveRoles(appCtx, requestedUsers)
+		if err != nil {
+			return nil, 0, err
+		}
+		return requestedUsers, count, nil
+	}
 
 	var order = "desc"
 	if ordering.SortOrder() != nil && *ordering.SortOrder() {
@@ -51,7 +65,6 @@ func (o *requestedOfficeUserListFetcher) FetchRequestedOfficeUsersList(appCtx ap
 	}
 
 	query.Order(fmt.Sprintf("%s %s", orderTerm, order))
-	query.Select("office_users.*")
 
 	err := query.Paginate(pagination.Page(), pagination.PerPage()).All(&requestedUsers)
 	if err != nil {
@@ -69,6 +82,17 @@ func (o *requestedOfficeUserListFetcher) FetchRequestedOfficeUsersList(appCtx ap
 			})
 		}
 	}
+	err = loadLiveRoles(appCtx, requestedUsers)
+	if err != nil {
+		return nil, 0, err
+	}
+	count := query.Paginator.TotalEntriesSize
+	return requestedUsers, count, nil
+
+}
+
+// loadLiveRoles replaces the requested users' roles with the ones that haven't been removed
+func loadLiveRoles(appCtx appcontext.AppContext, requestedUsers models.OfficeUsers) error {
 	for i := range requestedUsers {
 		var liveRoles []roles.Role
 		err := appCtx.DB().Q().
@@ -77,13 +101,26 @@ func (o *requestedOfficeUserListFetcher) FetchRequestedOfficeUsersList(appCtx ap
 			Where("users_roles.deleted_at IS NULL").
 			All(&liveRoles)
 		if err != nil {
-			return nil, 0, err
+			return err
 		}
 		requestedUsers[i].User.Roles = liveRoles
 	}
-	count := query.Paginator.TotalEntriesSize
-	return requestedUsers, count, nil
+	return nil
+}
+
+// fetchRequestedOfficeUsersAfterCursor counts the requested office users the query matches and fetches the page after the cursor. The page is
+// ordered by the requested column itself rather than re-sorted by office name.
+func fetchRequestedOfficeUsersAfterCursor(q *pop.Query, requestedUsers *models.OfficeUsers, pagination services.Pagination, ordering services.QueryOrder) (int, error) {
+	count, err := q.Count(&models.OfficeUser{})
+	if err != nil {
+		return 0, err
+	}
 
+	page, err := query.KeysetPaginate(q, pagination, ordering, requestedUsers)
+	if err != nil {
+		return 0, err
+	}
+	return count, page.All(requestedUsers)
 }
 
 // FetchAdminUserList uses the passed query builder to fetch a list of office users
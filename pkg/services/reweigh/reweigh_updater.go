@@ -144,5 +144,6 @@ func (f *reweighUpdater) doUpdateReweigh(appCtx appcontext.AppContext, reweigh *
 		}
 	}
 
+	updatedReweigh.Shipment = shipment
 	return &updatedReweigh, nil
 }
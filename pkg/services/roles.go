@@ -15,3 +15,44 @@ type RoleAssociater interface {
 	FetchRolesPrivileges(appCtx appcontext.AppContext) ([]roles.Role, error)
 	FetchRoleTypes(appCtx appcontext.AppContext) ([]roles.RoleType, error)
 }
+
+// RolePermissionsFetcher is the service object interface for fetching office permissions
+// and the roles they are granted to
+//
+//go:generate mockery --name RolePermissionsFetcher
+type RolePermissionsFetcher interface {
+	FetchPermissions(appCtx appcontext.AppContext) (roles.Permissions, error)
+	FetchPermissionsForRole(appCtx appcontext.AppContext, roleType roles.RoleType) (roles.Permissions, error)
+}
+
+// RolePermissionsUpdater is the service object interface for replacing the permissions granted to a role
+//
+//go:generate mockery --name RolePermissionsUpdater
+type RolePermissionsUpdater interface {
+	UpdateRolePermissions(appCtx appcontext.AppContext, roleType roles.RoleType, permissionKeys []string) (*roles.Role, roles.Permissions, error)
+}
+
+// PermissionSimulationParams is what to check in a permission simulation
+type PermissionSimulationParams struct {
+	OfficeUserID  uuid.UUID
+	PermissionKey string
+	// MoveID is the move the action would be taken on, if any
+	MoveID *uuid.UUID
+	// CheckMoveLocks is whether moves locked by other office users block changes
+	CheckMoveLocks bool
+}
+
+// PermissionSimulation is the outcome of a permission simulation
+type PermissionSimulation struct {
+	Allowed           bool
+	GrantingRoleTypes []roles.RoleType
+	Reasons           []string
+}
+
+// PermissionSimulator is the service object interface for checking whether an office user
+// could take an action without taking it
+//
+//go:generate mockery --name PermissionSimulator
+type PermissionSimulator interface {
+	SimulatePermission(appCtx appcontext.AppContext, params PermissionSimulationParams) (*PermissionSimulation, error)
+}
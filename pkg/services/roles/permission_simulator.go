@@ -0,0 +1,130 @@
+package roles
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/gofrs/uuid"
+
+	"github.com/transcom/mymove/pkg/appcontext"
+	"github.com/transcom/mymove/pkg/apperror"
+	"github.com/transcom/mymove/pkg/models"
+	"github.com/transcom/mymove/pkg/models/roles"
+	"github.com/transcom/mymove/pkg/services"
+)
+
+type permissionSimulator struct {
+	moveLockChecker   services.MoveLockChecker
+	moveAccessChecker services.MoveAccessChecker
+}
+
+// NewPermissionSimulator returns a new permission simulator
+func NewPermissionSimulator(moveLockChecker services.MoveLockChecker, moveAccessChecker services.MoveAccessChecker) services.PermissionSimulator {
+	return permissionSimulator{
+		moveLockChecker:   moveLockChecker,
+		moveAccessChecker: moveAccessChecker,
+	}
+}
+
+// SimulatePermission checks whether the office user could use the permission, on the move when one
+// is given, under the role permissions currently in the database. An office user only holds the
+// permissions of their active role, so the action is allowed when any of their roles grants it
+// and, for a move, that role can see the move.
+func (s permissionSimulator) SimulatePermission(appCtx appcontext.AppContext, params services.PermissionSimulationParams) (*services.PermissionSimulation, error) {
+	var officeUser models.OfficeUser
+	err := appCtx.DB().Find(&officeUser, params.OfficeUserID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, apperror.NewNotFoundError(params.OfficeUserID, "looking for OfficeUser")
+		}
+		return nil, apperror.NewQueryError("OfficeUser", err, "")
+	}
+
+	var permission roles.Permission
+	err = appCtx.DB().Where("permission_key = ?", params.PermissionKey).First(&permission)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, apperror.NewNotFoundError(params.OfficeUserID, fmt.Sprintf("permission %s", params.PermissionKey))
+		}
+		return nil, apperror.NewQueryError("Permission", err, "")
+	}
+
+	simulation := services.PermissionSimulation{
+		GrantingRoleTypes: []roles.RoleType{},
+		Reasons:           []string{},
+	}
+
+	if !officeUser.Active {
+		simulation.Reasons = append(simulation.Reasons, "office user is not active")
+	}
+
+	if officeUser.UserID != nil {
+		userRoles, err := roles.FetchRolesForUser(appCtx.DB(), *officeUser.UserID)
+		if err != nil {
+			return nil, apperror.NewQueryError("Role", err, "")
+		}
+		for _, role := range userRoles {
+			permissions, err := roles.FetchPermissionsForRole(appCtx.DB(), role.RoleType)
+			if err != nil {
+				return nil, apperror.NewQueryError("Permission", err, "")
+			}
+			for _, p := range permissions {
+				if p.ID == permission.ID {
+					simulation.GrantingRoleTypes = append(simulation.GrantingRoleTypes, role.RoleType)
+					break
+				}
+			}
+		}
+	}
+	if len(simulation.GrantingRoleTypes) == 0 {
+		simulation.Reasons = append(simulation.Reasons, fmt.Sprintf("no role of the office user grants %s", permission.PermissionKey))
+	}
+
+	if params.MoveID != nil {
+		var move models.Move
+		err = appCtx.DB().Select("id").Find(&move, *params.MoveID)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return nil, apperror.NewNotFoundError(*params.MoveID, "looking for Move")
+			}
+			return nil, apperror.NewQueryError("Move", err, "")
+		}
+
+		if len(simulation.GrantingRoleTypes) > 0 {
+			canSeeMove := false
+			for _, roleType := range simulation.GrantingRoleTypes {
+				err = s.moveAccessChecker.CheckMoveAccess(appCtx, []uuid.UUID{move.ID}, officeUser.ID, roleType)
+				var forbiddenErr apperror.ForbiddenError
+				if err == nil {
+					canSeeMove = true
+					break
+				} else if !errors.As(err, &forbiddenErr) {
+					return nil, err
+				}
+			}
+			if !canSeeMove {
+				simulation.Reasons = append(simulation.Reasons, "move is outside the office user's area")
+			}
+		}
+
+		if params.CheckMoveLocks && isChangePermission(permission.PermissionKey) {
+			err = s.moveLockChecker.CheckMoveLocks(appCtx, []uuid.UUID{move.ID}, officeUser.ID)
+			var conflictErr apperror.ConflictError
+			if errors.As(err, &conflictErr) {
+				simulation.Reasons = append(simulation.Reasons, "move is locked by another office user")
+			} else if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	simulation.Allowed = len(simulation.Reasons) == 0
+	return &simulation, nil
+}
+
+// isChangePermission reports whether the permission is for an action the move lock blocks
+func isChangePermission(permissionKey string) bool {
+	return !strings.HasPrefix(permissionKey, "read.") && !strings.HasPrefix(permissionKey, "view.")
+}
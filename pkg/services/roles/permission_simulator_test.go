@@ -0,0 +1,160 @@
+package roles
+
+import (
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/transcom/mymove/pkg/apperror"
+	"github.com/transcom/mymove/pkg/factory"
+	"github.com/transcom/mymove/pkg/models"
+	"github.com/transcom/mymove/pkg/models/roles"
+	"github.com/transcom/mymove/pkg/services"
+	lockmove "github.com/transcom/mymove/pkg/services/lock_move"
+	"github.com/transcom/mymove/pkg/services/mocks"
+)
+
+func (suite *RolesServiceSuite) TestSimulatePermission() {
+	// factory moves and office users are in different areas, so access is mocked
+	moveAccessChecker := &mocks.MoveAccessChecker{}
+	moveAccessChecker.On("CheckMoveAccess", mock.AnythingOfType("*appcontext.appContext"), mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	simulator := NewPermissionSimulator(lockmove.NewMoveLockChecker(), moveAccessChecker)
+
+	suite.Run("allows an action granted by one of the office user's roles", func() {
+		officeUser := factory.BuildOfficeUserWithRoles(suite.DB(), factory.GetTraitActiveOfficeUser(),
+			[]roles.RoleType{roles.RoleTypeHQ, roles.RoleTypeTOO})
+
+		simulation, err := simulator.SimulatePermission(suite.AppContextForTest(), services.PermissionSimulationParams{
+			OfficeUserID:  officeUser.ID,
+			PermissionKey: "update.move",
+		})
+		suite.NoError(err)
+		suite.True(simulation.Allowed)
+		suite.Equal([]roles.RoleType{roles.RoleTypeTOO}, simulation.GrantingRoleTypes)
+		suite.Empty(simulation.Reasons)
+	})
+
+	suite.Run("refuses an action no role grants", func() {
+		officeUser := factory.BuildOfficeUserWithRoles(suite.DB(), factory.GetTraitActiveOfficeUser(),
+			[]roles.RoleType{roles.RoleTypeQae})
+
+		simulation, err := simulator.SimulatePermission(suite.AppContextForTest(), services.PermissionSimulationParams{
+			OfficeUserID:  officeUser.ID,
+			PermissionKey: "update.paymentRequest",
+		})
+		suite.NoError(err)
+		suite.False(simulation.Allowed)
+		suite.Empty(simulation.GrantingRoleTypes)
+		suite.Equal([]string{"no role of the office user grants update.paymentRequest"}, simulation.Reasons)
+	})
+
+	suite.Run("refuses changes to a move locked by another office user", func() {
+		officeUser := factory.BuildOfficeUserWithRoles(suite.DB(), factory.GetTraitActiveOfficeUser(),
+			[]roles.RoleType{roles.RoleTypeTOO})
+		holder := factory.BuildOfficeUserWithRoles(suite.DB(), nil, []roles.RoleType{roles.RoleTypeTOO})
+		expiresAt := time.Now().Add(time.Minute)
+		move := factory.BuildMove(suite.DB(), []factory.Customization{
+			{
+				Model: models.Move{
+					LockedByOfficeUserID: &holder.ID,
+					LockExpiresAt:        &expiresAt,
+				},
+			},
+		}, nil)
+
+		simulation, err := simulator.SimulatePermission(suite.AppContextForTest(), services.PermissionSimulationParams{
+			OfficeUserID:   officeUser.ID,
+			PermissionKey:  "update.move",
+			MoveID:         &move.ID,
+			CheckMoveLocks: true,
+		})
+		suite.NoError(err)
+		suite.False(simulation.Allowed)
+		suite.Equal([]string{"move is locked by another office user"}, simulation.Reasons)
+
+		// reads are not blocked by the lock
+		simulation, err = simulator.SimulatePermission(suite.AppContextForTest(), services.PermissionSimulationParams{
+			OfficeUserID:   officeUser.ID,
+			PermissionKey:  "read.paymentRequest",
+			MoveID:         &move.ID,
+			CheckMoveLocks: true,
+		})
+		suite.NoError(err)
+		suite.True(simulation.Allowed)
+
+		// nor are changes while move locking is off
+		simulation, err = simulator.SimulatePermission(suite.AppContextForTest(), services.PermissionSimulationParams{
+			OfficeUserID:  officeUser.ID,
+			PermissionKey: "update.move",
+			MoveID:        &move.ID,
+		})
+		suite.NoError(err)
+		suite.True(simulation.Allowed)
+	})
+
+	suite.Run("refuses actions on moves outside the areas of the granting roles", func() {
+		officeUser := factory.BuildOfficeUserWithRoles(suite.DB(), factory.GetTraitActiveOfficeUser(),
+			[]roles.RoleType{roles.RoleTypeTOO, roles.RoleTypeTIO})
+		move := factory.BuildMove(suite.DB(), nil, nil)
+
+		outsideArea := &mocks.MoveAccessChecker{}
+		for _, roleType := range []roles.RoleType{roles.RoleTypeTOO, roles.RoleTypeTIO} {
+			outsideArea.On("CheckMoveAccess", mock.AnythingOfType("*appcontext.appContext"), []uuid.UUID{move.ID}, officeUser.ID, roleType).
+				Return(apperror.NewForbiddenError("move is outside the office user's area"))
+		}
+		simulation, err := NewPermissionSimulator(lockmove.NewMoveLockChecker(), outsideArea).SimulatePermission(suite.AppContextForTest(), services.PermissionSimulationParams{
+			OfficeUserID:  officeUser.ID,
+			PermissionKey: "update.orders",
+			MoveID:        &move.ID,
+		})
+		suite.NoError(err)
+		suite.False(simulation.Allowed)
+		suite.ElementsMatch([]roles.RoleType{roles.RoleTypeTOO, roles.RoleTypeTIO}, simulation.GrantingRoleTypes)
+		suite.Equal([]string{"move is outside the office user's area"}, simulation.Reasons)
+		outsideArea.AssertExpectations(suite.T())
+	})
+
+	suite.Run("refuses actions of inactive office users", func() {
+		officeUser := factory.BuildOfficeUserWithRoles(suite.DB(), []factory.Customization{
+			{
+				Model: models.OfficeUser{
+					Active: false,
+				},
+			},
+		}, []roles.RoleType{roles.RoleTypeTOO})
+
+		simulation, err := simulator.SimulatePermission(suite.AppContextForTest(), services.PermissionSimulationParams{
+			OfficeUserID:  officeUser.ID,
+			PermissionKey: "update.move",
+		})
+		suite.NoError(err)
+		suite.False(simulation.Allowed)
+		suite.Contains(simulation.Reasons, "office user is not active")
+	})
+
+	suite.Run("returns not found for unknown office users, permissions and moves", func() {
+		officeUser := factory.BuildOfficeUserWithRoles(suite.DB(), factory.GetTraitActiveOfficeUser(),
+			[]roles.RoleType{roles.RoleTypeTOO})
+		missingMoveID := uuid.Must(uuid.NewV4())
+
+		_, err := simulator.SimulatePermission(suite.AppContextForTest(), services.PermissionSimulationParams{
+			OfficeUserID:  uuid.Must(uuid.NewV4()),
+			PermissionKey: "update.move",
+		})
+		suite.IsType(apperror.NotFoundError{}, err)
+
+		_, err = simulator.SimulatePermission(suite.AppContextForTest(), services.PermissionSimulationParams{
+			OfficeUserID:  officeUser.ID,
+			PermissionKey: "update.everything",
+		})
+		suite.IsType(apperror.NotFoundError{}, err)
+
+		_, err = simulator.SimulatePermission(suite.AppContextForTest(), services.PermissionSimulationParams{
+			OfficeUserID:  officeUser.ID,
+			PermissionKey: "update.move",
+			MoveID:        &missingMoveID,
+		})
+		suite.IsType(apperror.NotFoundError{}, err)
+	})
+}
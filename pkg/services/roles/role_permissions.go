@@ -0,0 +1,149 @@
+package roles
+
+import (
+	"database/sql"
+	"fmt"
+	"slices"
+
+	"github.com/gobuffalo/validate/v3"
+	"github.com/gofrs/uuid"
+
+	"github.com/transcom/mymove/pkg/appcontext"
+	"github.com/transcom/mymove/pkg/apperror"
+	"github.com/transcom/mymove/pkg/models/roles"
+	"github.com/transcom/mymove/pkg/services"
+)
+
+type rolePermissionsFetcher struct {
+}
+
+// NewRolePermissionsFetcher returns a new role permissions fetcher
+func NewRolePermissionsFetcher() services.RolePermissionsFetcher {
+	return rolePermissionsFetcher{}
+}
+
+// FetchPermissions returns every permission with the roles it is granted to
+func (f rolePermissionsFetcher) FetchPermissions(appCtx appcontext.AppContext) (roles.Permissions, error) {
+	var permissions roles.Permissions
+	err := appCtx.DB().Q().Eager("Roles").Order("permission_key ASC").All(&permissions)
+	if err != nil {
+		return nil, apperror.NewQueryError("Permission", err, "")
+	}
+	return permissions, nil
+}
+
+// FetchPermissionsForRole returns the permissions granted to a role
+func (f rolePermissionsFetcher) FetchPermissionsForRole(appCtx appcontext.AppContext, roleType roles.RoleType) (roles.Permissions, error) {
+	if _, err := fetchRoleByType(appCtx, roleType); err != nil {
+		return nil, err
+	}
+
+	permissions, err := roles.FetchPermissionsForRole(appCtx.DB(), roleType)
+	if err != nil {
+		return nil, apperror.NewQueryError("Permission", err, "")
+	}
+	return permissions, nil
+}
+
+type rolePermissionsUpdater struct {
+}
+
+// NewRolePermissionsUpdater returns a new role permissions updater
+func NewRolePermissionsUpdater() services.RolePermissionsUpdater {
+	return rolePermissionsUpdater{}
+}
+
+// UpdateRolePermissions makes permissionKeys the only permissions granted to the role. Sessions
+// keep the permissions they were given until the user signs in again or switches roles.
+func (u rolePermissionsUpdater) UpdateRolePermissions(appCtx appcontext.AppContext, roleType roles.RoleType, permissionKeys []string) (*roles.Role, roles.Permissions, error) {
+	role, err := fetchRoleByType(appCtx, roleType)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	keys := slices.Clone(permissionKeys)
+	slices.Sort(keys)
+	keys = slices.Compact(keys)
+
+	var permissions roles.Permissions
+	if len(keys) > 0 {
+		err = appCtx.DB().Where("permission_key IN (?)", keys).All(&permissions)
+		if err != nil {
+			return nil, nil, apperror.NewQueryError("Permission", err, "")
+		}
+	}
+
+	if len(permissions) != len(keys) {
+		found := permissions.Keys()
+		verrs := validate.NewErrors()
+		for _, key := range keys {
+			if !slices.Contains(found, key) {
+				verrs.Add("permission_keys", fmt.Sprintf("%s is not a permission", key))
+			}
+		}
+		return nil, nil, apperror.NewInvalidInputError(role.ID, nil, verrs, "unknown permissions")
+	}
+
+	txErr := appCtx.NewTransaction(func(txnAppCtx appcontext.AppContext) error {
+		var current roles.RolePermissions
+		if err := txnAppCtx.DB().Where("role_id = ?", role.ID).All(&current); err != nil {
+			return apperror.NewQueryError("RolePermission", err, "")
+		}
+
+		wanted := make(map[uuid.UUID]bool, len(permissions))
+		for _, permission := range permissions {
+			wanted[permission.ID] = true
+		}
+
+		granted := make(map[uuid.UUID]bool, len(current))
+		for i := range current {
+			granted[current[i].PermissionID] = true
+			if wanted[current[i].PermissionID] {
+				continue
+			}
+			if err := txnAppCtx.DB().Destroy(&current[i]); err != nil {
+				return apperror.NewQueryError("RolePermission", err, "could not remove permission from role")
+			}
+		}
+
+		for _, permission := range permissions {
+			if granted[permission.ID] {
+				continue
+			}
+			rolePermission := roles.RolePermission{
+				ID:           uuid.Must(uuid.NewV4()),
+				RoleID:       role.ID,
+				PermissionID: permission.ID,
+			}
+			verrs, err := txnAppCtx.DB().ValidateAndCreate(&rolePermission)
+			if verrs.HasAny() {
+				return apperror.NewInvalidInputError(role.ID, nil, verrs, "")
+			}
+			if err != nil {
+				return apperror.NewQueryError("RolePermission", err, "could not grant permission to role")
+			}
+		}
+		return nil
+	})
+	if txErr != nil {
+		return nil, nil, txErr
+	}
+
+	updated, err := roles.FetchPermissionsForRole(appCtx.DB(), roleType)
+	if err != nil {
+		return nil, nil, apperror.NewQueryError("Permission", err, "")
+	}
+	return role, updated, nil
+}
+
+func fetchRoleByType(appCtx appcontext.AppContext, roleType roles.RoleType) (*roles.Role, error) {
+	var role roles.Role
+	err := appCtx.DB().Where("role_type = ?", roleType).First(&role)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, apperror.NewNotFoundError(uuid.Nil, fmt.Sprintf("role type %s", roleType))
+		}
+		return nil, apperror.NewQueryError("Role", err, "")
+	}
+	return &role, nil
+}
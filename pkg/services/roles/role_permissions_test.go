@@ -0,0 +1,78 @@
+package roles
+
+import (
+	"github.com/transcom/mymove/pkg/apperror"
+	"github.com/transcom/mymove/pkg/models/roles"
+)
+
+func (suite *RolesServiceSuite) TestFetchPermissions() {
+	fetcher := NewRolePermissionsFetcher()
+
+	suite.Run("lists every permission with the roles it is granted to", func() {
+		permissions, err := fetcher.FetchPermissions(suite.AppContextForTest())
+		suite.NoError(err)
+		suite.NotEmpty(permissions)
+
+		for _, permission := range permissions {
+			if permission.PermissionKey != "update.paymentRequest" {
+				continue
+			}
+			suite.True(permission.Roles.HasRole(roles.RoleTypeTIO))
+			suite.False(permission.Roles.HasRole(roles.RoleTypeTOO))
+			return
+		}
+		suite.Fail("update.paymentRequest was not seeded")
+	})
+
+	suite.Run("fetches the permissions of a role", func() {
+		permissions, err := fetcher.FetchPermissionsForRole(suite.AppContextForTest(), roles.RoleTypeQae)
+		suite.NoError(err)
+		suite.Contains(permissions.Keys(), "create.evaluationReport")
+	})
+
+	suite.Run("returns not found for an unknown role", func() {
+		_, err := fetcher.FetchPermissionsForRole(suite.AppContextForTest(), roles.RoleType("not_a_role"))
+		suite.IsType(apperror.NotFoundError{}, err)
+	})
+}
+
+func (suite *RolesServiceSuite) TestUpdateRolePermissions() {
+	updater := NewRolePermissionsUpdater()
+
+	suite.Run("grants and takes away permissions", func() {
+		role, permissions, err := updater.UpdateRolePermissions(suite.AppContextForTest(), roles.RoleTypeHQ,
+			[]string{"read.paymentRequest", "read.tppsReconciliation", "read.paymentRequest"})
+		suite.NoError(err)
+		suite.Equal(roles.RoleTypeHQ, role.RoleType)
+		suite.Equal([]string{"read.paymentRequest", "read.tppsReconciliation"}, permissions.Keys())
+
+		stored, err := roles.FetchPermissionsForRole(suite.DB(), roles.RoleTypeHQ)
+		suite.NoError(err)
+		suite.Equal(permissions.Keys(), stored.Keys())
+	})
+
+	suite.Run("takes away every permission", func() {
+		_, permissions, err := updater.UpdateRolePermissions(suite.AppContextForTest(), roles.RoleTypeGSR, []string{})
+		suite.NoError(err)
+		suite.Empty(permissions)
+	})
+
+	suite.Run("rejects unknown permissions without changing the role", func() {
+		before, err := roles.FetchPermissionsForRole(suite.DB(), roles.RoleTypeTOO)
+		suite.NoError(err)
+
+		_, _, err = updater.UpdateRolePermissions(suite.AppContextForTest(), roles.RoleTypeTOO,
+			[]string{"update.move", "update.everything"})
+		suite.IsType(apperror.InvalidInputError{}, err)
+		suite.Contains(err.(apperror.InvalidInputError).ValidationErrors.Get("permission_keys"), "update.everything is not a permission")
+
+		after, err := roles.FetchPermissionsForRole(suite.DB(), roles.RoleTypeTOO)
+		suite.NoError(err)
+		suite.Equal(before.Keys(), after.Keys())
+	})
+
+	suite.Run("returns not found for an unknown role", func() {
+		_, _, err := updater.UpdateRolePermissions(suite.AppContextForTest(), roles.RoleType("not_a_role"), []string{"update.move"})
+		suite.IsType(apperror.NotFoundError{}, err)
+	})
+}
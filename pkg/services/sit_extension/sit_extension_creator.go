@@ -106,5 +106,6 @@ func (f *sitExtensionCreator) CreateSITExtension(appCtx appcontext.AppContext, s
 
 	}
 
+	sitExtension.MTOShipment = *shipment
 	return sitExtension, nil
 }
@@ -0,0 +1,80 @@
+package services
+
+import (
+	"time"
+
+	"github.com/gofrs/uuid"
+
+	"github.com/transcom/mymove/pkg/appcontext"
+	"github.com/transcom/mymove/pkg/unit"
+)
+
+// TPPSReconciliationStatus is how a paid amount compares to what was billed
+type TPPSReconciliationStatus string
+
+const (
+	// TPPSReconciliationStatusMatched means TPPS paid what was billed, within the tolerance
+	TPPSReconciliationStatusMatched TPPSReconciliationStatus = "MATCHED"
+	// TPPSReconciliationStatusUnderpaid means TPPS paid less than what was billed, including nothing at all
+	TPPSReconciliationStatusUnderpaid TPPSReconciliationStatus = "UNDERPAID"
+	// TPPSReconciliationStatusOverpaid means TPPS paid more than what was billed
+	TPPSReconciliationStatusOverpaid TPPSReconciliationStatus = "OVERPAID"
+	// TPPSReconciliationStatusUnmatched means a TPPS line has no billed service item, or an invoice sent to
+	// Syncada has no TPPS payment at all
+	TPPSReconciliationStatusUnmatched TPPSReconciliationStatus = "UNMATCHED"
+)
+
+// TPPSReconciliationParams selects the TPPS payments to reconcile
+type TPPSReconciliationParams struct {
+	// StartDate and EndDate bound the seller paid dates of the TPPS lines, and the dates unpaid invoices were sent
+	StartDate time.Time
+	EndDate   time.Time
+	// ToleranceCents is the largest variance still reported as matched
+	ToleranceCents unit.Cents
+	// ExcludeUnpaidInvoices leaves out the invoices sent to Syncada that TPPS has not paid, for reconciling
+	// before TPPS has had time to pay them
+	ExcludeUnpaidInvoices bool
+}
+
+// TPPSReconciliationLine compares what TPPS paid for a service item with what was billed. Unmatched TPPS
+// lines have no billed amount, and unmatched invoices have no service item or paid amount.
+type TPPSReconciliationLine struct {
+	PaymentRequestID     uuid.UUID
+	PaymentRequestNumber string
+	PaymentServiceItemID *uuid.UUID
+	// ReferenceID is the PO/TCN TPPS reported, which is the payment service item reference ID
+	ReferenceID    string
+	ServiceCode    string
+	BilledCents    *unit.Cents
+	PaidCents      *unit.Cents
+	VarianceCents  unit.Cents
+	SellerPaidDate *time.Time
+	Status         TPPSReconciliationStatus
+}
+
+// TPPSReconciliationReport is the outcome of reconciling TPPS payments against what was billed
+type TPPSReconciliationReport struct {
+	StartDate        time.Time
+	EndDate          time.Time
+	TotalBilledCents unit.Cents
+	TotalPaidCents   unit.Cents
+	Lines            []TPPSReconciliationLine
+}
+
+// Variances returns the lines that are not matched
+func (r TPPSReconciliationReport) Variances() []TPPSReconciliationLine {
+	var variances []TPPSReconciliationLine
+	for _, line := range r.Lines {
+		if line.Status != TPPSReconciliationStatusMatched {
+			variances = append(variances, line)
+		}
+	}
+	return variances
+}
+
+// TPPSReconciler is the exported interface for reconciling TPPS paid invoice reports against billed amounts
+//
+//go:generate mockery --name TPPSReconciler
+type TPPSReconciler interface {
+	ReconcileTPPSPayments(appCtx appcontext.AppContext, params TPPSReconciliationParams) (*TPPSReconciliationReport, error)
+}
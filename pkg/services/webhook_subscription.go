@@ -1,7 +1,10 @@
 package services
 
 import (
+	"time"
+
 	"github.com/gobuffalo/validate/v3"
+	"github.com/gofrs/uuid"
 
 	"github.com/transcom/mymove/pkg/appcontext"
 	"github.com/transcom/mymove/pkg/models"
@@ -27,3 +30,17 @@ type WebhookSubscriptionCreator interface {
 type WebhookSubscriptionUpdater interface {
 	UpdateWebhookSubscription(appCtx appcontext.AppContext, webhooksubscription *models.WebhookSubscription, severity *int64, eTag *string) (*models.WebhookSubscription, error)
 }
+
+// WebhookNotificationReplayer is the service object interface for ReplayDeadLetteredNotifications
+//
+//go:generate mockery --name WebhookNotificationReplayer
+type WebhookNotificationReplayer interface {
+	ReplayDeadLetteredNotifications(appCtx appcontext.AppContext, webhookSubscriptionID uuid.UUID) (int, error)
+}
+
+// WebhookSubscriptionSecretRotator is the service object interface for RotateWebhookSubscriptionSecret
+//
+//go:generate mockery --name WebhookSubscriptionSecretRotator
+type WebhookSubscriptionSecretRotator interface {
+	RotateWebhookSubscriptionSecret(appCtx appcontext.AppContext, webhookSubscriptionID uuid.UUID, overlap time.Duration) (*models.WebhookSubscription, error)
+}
@@ -0,0 +1,63 @@
+package webhooksubscription
+
+import (
+	"github.com/gofrs/uuid"
+	"go.uber.org/zap"
+
+	"github.com/transcom/mymove/pkg/appcontext"
+	"github.com/transcom/mymove/pkg/models"
+	"github.com/transcom/mymove/pkg/services"
+	"github.com/transcom/mymove/pkg/services/query"
+)
+
+type webhookNotificationReplayer struct {
+	builder webhookSubscriptionQueryBuilder
+}
+
+// ReplayDeadLetteredNotifications queues the dead lettered notifications for a subscription's event to be sent again
+// and clears the subscription's backoff so the webhook client retries it on its next run. Notifications aren't tied
+// to a single subscription, so this replays every dead lettered notification with the subscription's event key.
+// Returns the number of notifications that were replayed.
+func (o *webhookNotificationReplayer) ReplayDeadLetteredNotifications(appCtx appcontext.AppContext, webhookSubscriptionID uuid.UUID) (int, error) {
+	queryFilters := []services.QueryFilter{query.NewQueryFilter("id", "=", webhookSubscriptionID)}
+
+	var foundSub models.WebhookSubscription
+	err := o.builder.FetchOne(appCtx, &foundSub, queryFilters)
+	if err != nil {
+		return 0, err
+	}
+
+	replayed := 0
+	txErr := appCtx.NewTransaction(func(txnAppCtx appcontext.AppContext) error {
+		replayed, err = txnAppCtx.DB().RawQuery(`
+			UPDATE webhook_notifications
+			SET status = ?, attempt_count = 0, first_attempted_at = NULL, updated_at = NOW()
+			WHERE event_key = ? AND status = ?`,
+			models.WebhookNotificationPending, foundSub.EventKey, models.WebhookNotificationDeadLetter,
+		).ExecWithCount()
+		if err != nil {
+			return err
+		}
+
+		return txnAppCtx.DB().RawQuery(`
+			UPDATE webhook_subscriptions
+			SET consecutive_failures = 0, next_attempt_at = NULL, updated_at = NOW()
+			WHERE id = ?`,
+			foundSub.ID,
+		).Exec()
+	})
+	if txErr != nil {
+		return 0, txErr
+	}
+
+	appCtx.Logger().Info("Replayed dead lettered webhook notifications",
+		zap.String("subscriptionID", foundSub.ID.String()),
+		zap.String("eventKey", foundSub.EventKey),
+		zap.Int("count", replayed))
+	return replayed, nil
+}
+
+// NewWebhookNotificationReplayer returns an implementation of the WebhookNotificationReplayer interface
+func NewWebhookNotificationReplayer(builder webhookSubscriptionQueryBuilder) services.WebhookNotificationReplayer {
+	return &webhookNotificationReplayer{builder}
+}
@@ -0,0 +1,88 @@
+package webhooksubscription
+
+import (
+	"time"
+
+	"github.com/gofrs/uuid"
+
+	"github.com/transcom/mymove/pkg/factory"
+	"github.com/transcom/mymove/pkg/models"
+	"github.com/transcom/mymove/pkg/services/query"
+	"github.com/transcom/mymove/pkg/testdatagen"
+)
+
+func (suite *WebhookSubscriptionServiceSuite) TestWebhookNotificationReplayer() {
+	builder := query.NewQueryBuilder()
+	replayer := NewWebhookNotificationReplayer(builder)
+
+	suite.Run("Replays dead lettered notifications for the subscription's event", func() {
+		// Testing:           WebhookNotificationReplayer
+		// Set up:            A backing off subscription with dead lettered notifications for its event and another event
+		// Expected Outcome:  Only the notifications for its event are PENDING again and the backoff is cleared
+		nextAttemptAt := time.Now().Add(time.Hour)
+		sub := testdatagen.MakeWebhookSubscription(suite.DB(), testdatagen.Assertions{
+			WebhookSubscription: models.WebhookSubscription{
+				EventKey:            "MTOShipment.Create",
+				Status:              models.WebhookSubscriptionStatusFailing,
+				ConsecutiveFailures: 4,
+				NextAttemptAt:       &nextAttemptAt,
+			},
+		})
+
+		firstAttemptedAt := time.Now().Add(-time.Hour)
+		deadLetter := factory.BuildWebhookNotification(suite.DB(), []factory.Customization{
+			{
+				Model: models.WebhookNotification{
+					EventKey:         "MTOShipment.Create",
+					Status:           models.WebhookNotificationDeadLetter,
+					FirstAttemptedAt: &firstAttemptedAt,
+					AttemptCount:     20,
+				},
+			},
+		}, nil)
+		otherEvent := factory.BuildWebhookNotification(suite.DB(), []factory.Customization{
+			{
+				Model: models.WebhookNotification{
+					EventKey: "MTOShipment.Update",
+					Status:   models.WebhookNotificationDeadLetter,
+				},
+			},
+		}, nil)
+		sent := factory.BuildWebhookNotification(suite.DB(), []factory.Customization{
+			{
+				Model: models.WebhookNotification{
+					EventKey: "MTOShipment.Create",
+					Status:   models.WebhookNotificationSent,
+				},
+			},
+		}, nil)
+
+		replayed, err := replayer.ReplayDeadLetteredNotifications(suite.AppContextForTest(), sub.ID)
+		suite.NoError(err)
+		suite.Equal(1, replayed)
+
+		suite.NoError(suite.DB().Find(&deadLetter, deadLetter.ID))
+		suite.Equal(models.WebhookNotificationPending, deadLetter.Status)
+		suite.Equal(0, deadLetter.AttemptCount)
+		suite.Nil(deadLetter.FirstAttemptedAt)
+
+		suite.NoError(suite.DB().Find(&otherEvent, otherEvent.ID))
+		suite.Equal(models.WebhookNotificationDeadLetter, otherEvent.Status)
+		suite.NoError(suite.DB().Find(&sent, sent.ID))
+		suite.Equal(models.WebhookNotificationSent, sent.Status)
+
+		suite.NoError(suite.DB().Find(&sub, sub.ID))
+		suite.Equal(0, sub.ConsecutiveFailures)
+		suite.Nil(sub.NextAttemptAt)
+		suite.Equal(models.WebhookSubscriptionStatusFailing, sub.Status)
+	})
+
+	suite.Run("Fails to find the subscription", func() {
+		// Testing:           WebhookNotificationReplayer
+		// Set up:            Call the replayer with an ID that doesn't exist
+		// Expected Outcome:  We receive a RecordNotFound error
+		replayed, err := replayer.ReplayDeadLetteredNotifications(suite.AppContextForTest(), uuid.Must(uuid.NewV4()))
+		suite.Equal(models.RecordNotFoundErrorString, err.Error())
+		suite.Equal(0, replayed)
+	})
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/transcom/mymove/pkg/apperror"
 	"github.com/transcom/mymove/pkg/models"
 	"github.com/transcom/mymove/pkg/services"
+	"github.com/transcom/mymove/pkg/webhooksignature"
 )
 
 type webhookSubscriptionCreator struct {
@@ -27,6 +28,15 @@ func (o *webhookSubscriptionCreator) createWebhookSubscription(appCtx appcontext
 	if e != nil {
 		return nil, nil, e
 	}
+
+	// Every subscription gets a secret to sign its deliveries with
+	if subscription.SigningSecret == nil || *subscription.SigningSecret == "" {
+		secret, secretErr := webhooksignature.GenerateSecret()
+		if secretErr != nil {
+			return nil, nil, secretErr
+		}
+		subscription.SigningSecret = &secret
+	}
 	verrs, err = o.builder.CreateOne(appCtx, subscription)
 	if verrs != nil && verrs.HasAny() {
 		return nil, verrs, nil
@@ -28,6 +28,8 @@ func (suite *WebhookSubscriptionServiceSuite) TestCreateWebhookSubscription() {
 		suite.NotNil(webhookSubscription.ID)
 		suite.NotNil(webhookSubscription.Severity)
 		suite.Equal(webhookSubscriptionInfo.Status, webhookSubscription.Status)
+		suite.NotNil(webhookSubscription.SigningSecret)
+		suite.Len(*webhookSubscription.SigningSecret, 64)
 	})
 
 	// Bad subscriber ID
@@ -0,0 +1,65 @@
+package webhooksubscription
+
+import (
+	"time"
+
+	"github.com/gofrs/uuid"
+
+	"github.com/transcom/mymove/pkg/appcontext"
+	"github.com/transcom/mymove/pkg/apperror"
+	"github.com/transcom/mymove/pkg/models"
+	"github.com/transcom/mymove/pkg/services"
+	"github.com/transcom/mymove/pkg/services/query"
+	"github.com/transcom/mymove/pkg/webhooksignature"
+)
+
+type webhookSubscriptionSecretRotator struct {
+	builder webhookSubscriptionQueryBuilder
+}
+
+// RotateWebhookSubscriptionSecret gives the subscription a new signing secret. Deliveries are signed with both the
+// new and the replaced secret until the overlap has passed, which gives the subscriber time to start using the new
+// one. A zero overlap stops using the replaced secret right away.
+func (o *webhookSubscriptionSecretRotator) RotateWebhookSubscriptionSecret(appCtx appcontext.AppContext, webhookSubscriptionID uuid.UUID, overlap time.Duration) (*models.WebhookSubscription, error) {
+	if overlap < 0 {
+		return nil, apperror.NewBadDataError("overlap must not be negative")
+	}
+
+	queryFilters := []services.QueryFilter{query.NewQueryFilter("id", "=", webhookSubscriptionID)}
+
+	var foundSub models.WebhookSubscription
+	err := o.builder.FetchOne(appCtx, &foundSub, queryFilters)
+	if err != nil {
+		return nil, err
+	}
+
+	secret, err := webhooksignature.GenerateSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	if overlap > 0 && foundSub.SigningSecret != nil {
+		expiresAt := time.Now().Add(overlap)
+		foundSub.PreviousSigningSecret = foundSub.SigningSecret
+		foundSub.PreviousSigningSecretExpiresAt = &expiresAt
+	} else {
+		foundSub.PreviousSigningSecret = nil
+		foundSub.PreviousSigningSecretExpiresAt = nil
+	}
+	foundSub.SigningSecret = &secret
+
+	// Rotation is always allowed, so don't check an eTag
+	verrs, err := o.builder.UpdateOne(appCtx, &foundSub, nil)
+	if verrs != nil && verrs.HasAny() {
+		return nil, apperror.NewInvalidInputError(webhookSubscriptionID, err, verrs, "")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &foundSub, nil
+}
+
+// NewWebhookSubscriptionSecretRotator returns an implementation of the WebhookSubscriptionSecretRotator interface
+func NewWebhookSubscriptionSecretRotator(builder webhookSubscriptionQueryBuilder) services.WebhookSubscriptionSecretRotator {
+	return &webhookSubscriptionSecretRotator{builder}
+}
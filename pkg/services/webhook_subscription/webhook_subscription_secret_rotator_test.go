@@ -0,0 +1,66 @@
+package webhooksubscription
+
+import (
+	"time"
+
+	"github.com/gofrs/uuid"
+
+	"github.com/transcom/mymove/pkg/models"
+	"github.com/transcom/mymove/pkg/services/query"
+	"github.com/transcom/mymove/pkg/testdatagen"
+)
+
+func (suite *WebhookSubscriptionServiceSuite) TestRotateWebhookSubscriptionSecret() {
+	builder := query.NewQueryBuilder()
+	rotator := NewWebhookSubscriptionSecretRotator(builder)
+
+	suite.Run("Keeps signing with the replaced secret during the overlap", func() {
+		// Testing:           WebhookSubscriptionSecretRotator
+		// Set up:            Rotate the secret of a subscription with an overlap
+		// Expected Outcome:  The subscription has a new secret and the old one is active until the overlap ends
+		origSub := testdatagen.MakeDefaultWebhookSubscription(suite.DB())
+		oldSecret := *origSub.SigningSecret
+
+		rotatedSub, err := rotator.RotateWebhookSubscriptionSecret(suite.AppContextForTest(), origSub.ID, time.Hour)
+		suite.NoError(err)
+		suite.NotEqual(oldSecret, *rotatedSub.SigningSecret)
+		suite.Equal(oldSecret, *rotatedSub.PreviousSigningSecret)
+		suite.WithinDuration(time.Now().Add(time.Hour), *rotatedSub.PreviousSigningSecretExpiresAt, time.Minute)
+
+		var savedSub models.WebhookSubscription
+		suite.NoError(suite.DB().Find(&savedSub, origSub.ID))
+		suite.Equal([]string{*rotatedSub.SigningSecret, oldSecret}, savedSub.ActiveSigningSecrets(time.Now()))
+		suite.Equal([]string{*rotatedSub.SigningSecret}, savedSub.ActiveSigningSecrets(time.Now().Add(2*time.Hour)))
+	})
+
+	suite.Run("Stops using the replaced secret right away without an overlap", func() {
+		// Testing:           WebhookSubscriptionSecretRotator
+		// Set up:            Rotate the secret of a subscription that was already rotating, with no overlap
+		// Expected Outcome:  Only the new secret is active
+		origSub := testdatagen.MakeDefaultWebhookSubscription(suite.DB())
+		_, err := rotator.RotateWebhookSubscriptionSecret(suite.AppContextForTest(), origSub.ID, time.Hour)
+		suite.NoError(err)
+
+		rotatedSub, err := rotator.RotateWebhookSubscriptionSecret(suite.AppContextForTest(), origSub.ID, 0)
+		suite.NoError(err)
+		suite.Nil(rotatedSub.PreviousSigningSecret)
+		suite.Nil(rotatedSub.PreviousSigningSecretExpiresAt)
+		suite.Equal([]string{*rotatedSub.SigningSecret}, rotatedSub.ActiveSigningSecrets(time.Now()))
+	})
+
+	suite.Run("Fails to find the subscription", func() {
+		// Testing:           WebhookSubscriptionSecretRotator
+		// Set up:            Call the rotator with an ID that doesn't exist
+		// Expected Outcome:  We receive a RecordNotFound error
+		rotatedSub, err := rotator.RotateWebhookSubscriptionSecret(suite.AppContextForTest(), uuid.Must(uuid.NewV4()), time.Hour)
+		suite.Equal(models.RecordNotFoundErrorString, err.Error())
+		suite.Nil(rotatedSub)
+	})
+
+	suite.Run("Fails with a negative overlap", func() {
+		origSub := testdatagen.MakeDefaultWebhookSubscription(suite.DB())
+		rotatedSub, err := rotator.RotateWebhookSubscriptionSecret(suite.AppContextForTest(), origSub.ID, -time.Hour)
+		suite.Error(err)
+		suite.Nil(rotatedSub)
+	})
+}
@@ -2,6 +2,7 @@ package storage
 
 import (
 	"io"
+	"path/filepath"
 	"strings"
 	"testing"
 )
@@ -82,3 +83,37 @@ func TestFilesystemTags(t *testing.T) {
 		t.Fatal("tag 'av-status' should return CLEAN")
 	}
 }
+
+func TestFilesystemContentAddressedStoreDeduplicates(t *testing.T) {
+	fsParams := FilesystemParams{
+		root:    t.TempDir(),
+		webRoot: "https://example.text/files",
+	}
+	filesystem := NewFilesystem(fsParams)
+
+	checksum, err := ComputeChecksum(strings.NewReader("orders"))
+	if err != nil {
+		t.Fatalf("could not compute checksum: %s", err)
+	}
+	key, err := ContentAddressedKey(checksum)
+	if err != nil {
+		t.Fatalf("could not build key: %s", err)
+	}
+
+	first, err := filesystem.Store(key, strings.NewReader("orders"), checksum, nil)
+	if err != nil {
+		t.Fatalf("could not store in filesystem: %s", err)
+	}
+	second, err := filesystem.Store(key, strings.NewReader("orders"), checksum, nil)
+	if err != nil {
+		t.Fatalf("could not store in filesystem: %s", err)
+	}
+	if first.Deduplicated || !second.Deduplicated {
+		t.Errorf("expected only the second store to be deduplicated, got %v and %v", first.Deduplicated, second.Deduplicated)
+	}
+
+	exists, err := filesystem.FileSystem().Exists(filepath.Join(fsParams.root, key))
+	if err != nil || !exists {
+		t.Fatalf("content-addressed file was not written: %v", err)
+	}
+}
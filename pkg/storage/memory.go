@@ -16,10 +16,11 @@ import (
 // Memory is a storage backend that uses an in memory filesystem. It is intended only
 // for use in development to avoid dependency on an external service.
 type Memory struct {
-	root    string
-	webRoot string
-	fs      *afero.Afero
-	tempFs  *afero.Afero
+	root             string
+	webRoot          string
+	fs               *afero.Afero
+	tempFs           *afero.Afero
+	contentAddressed bool
 }
 
 // MemoryParams contains parameter for instantiating a Memory storage backend
@@ -65,6 +66,18 @@ func (fs *Memory) Store(key string, data io.ReadSeeker, _ string, _ *string) (*S
 	joined := filepath.Join(fs.root, key)
 	dir := filepath.Dir(joined)
 
+	// Content-addressed keys are only ever written with identical data, so an existing
+	// file can be shared rather than rewritten.
+	if IsContentAddressedKey(key) {
+		exists, err := fs.fs.Exists(joined)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not check for existing file")
+		}
+		if exists {
+			return &StoreResult{Deduplicated: true}, nil
+		}
+	}
+
 	err := fs.fs.MkdirAll(dir, 0755)
 	if err != nil {
 		return nil, errors.Wrap(err, "could not create parent directory")
@@ -88,8 +101,22 @@ func (fs *Memory) Store(key string, data io.ReadSeeker, _ string, _ *string) (*S
 	return &StoreResult{}, nil
 }
 
-// Delete deletes the file at the specified key
+// Delete deletes the file at the specified key. Content-addressed keys are rejected,
+// see DeleteContentAddressed.
 func (fs *Memory) Delete(key string) error {
+	if IsContentAddressedKey(key) {
+		return ErrContentAddressedDelete
+	}
+	return fs.remove(key)
+}
+
+// DeleteContentAddressed deletes the file at a content-addressed key. It must only be
+// called once no upload references the key.
+func (fs *Memory) DeleteContentAddressed(key string) error {
+	return fs.remove(key)
+}
+
+func (fs *Memory) remove(key string) error {
 	joined := filepath.Join(fs.root, key)
 	return errors.Wrap(fs.fs.Remove(joined), "could not remove file")
 }
@@ -131,6 +158,16 @@ func (fs *Memory) TempFileSystem() *afero.Afero {
 	return fs.tempFs
 }
 
+// SetContentAddressed enables or disables content-addressed keys for new uploads
+func (fs *Memory) SetContentAddressed(enabled bool) {
+	fs.contentAddressed = enabled
+}
+
+// ContentAddressed returns true if new uploads should use content-addressed keys
+func (fs *Memory) ContentAddressed() bool {
+	return fs.contentAddressed
+}
+
 // NewMemoryHandler returns an Handler that adds a Content-Type header so that
 // files are handled properly by the browser.
 func NewMemoryHandler(root string) http.HandlerFunc {
@@ -1,6 +1,7 @@
 package storage
 
 import (
+	"errors"
 	"io"
 	"strings"
 	"testing"
@@ -82,3 +83,87 @@ func TestMemoryTags(t *testing.T) {
 		t.Fatal("tag 'av-status' should return CLEAN")
 	}
 }
+
+func TestMemoryContentAddressedStoreDeduplicates(t *testing.T) {
+	fsParams := MemoryParams{
+		root:    "/home/username",
+		webRoot: "https://example.text/files",
+	}
+	memory := NewMemory(fsParams)
+	memory.SetContentAddressed(true)
+	if !IsContentAddressed(memory) {
+		t.Fatal("memory should report content-addressed storage as enabled")
+	}
+
+	data := strings.NewReader("weight ticket")
+	checksum, err := ComputeChecksum(data)
+	if err != nil {
+		t.Fatalf("could not compute checksum: %s", err)
+	}
+	key, err := ContentAddressedKey(checksum)
+	if err != nil {
+		t.Fatalf("could not build key: %s", err)
+	}
+
+	first, err := memory.Store(key, data, checksum, nil)
+	if err != nil {
+		t.Fatalf("could not store in memory: %s", err)
+	}
+	if first.Deduplicated {
+		t.Error("first store should write the object")
+	}
+
+	second, err := memory.Store(key, strings.NewReader("weight ticket"), checksum, nil)
+	if err != nil {
+		t.Fatalf("could not store in memory: %s", err)
+	}
+	if !second.Deduplicated {
+		t.Error("second store of the same content should be deduplicated")
+	}
+
+	// Plain keys are always written
+	plain, err := memory.Store("anyKey", strings.NewReader("weight ticket"), checksum, nil)
+	if err != nil {
+		t.Fatalf("could not store in memory: %s", err)
+	}
+	if plain.Deduplicated {
+		t.Error("plain keys should never be deduplicated")
+	}
+}
+
+func TestMemoryDeleteRejectsContentAddressedKeys(t *testing.T) {
+	fsParams := MemoryParams{
+		root:    "/home/username",
+		webRoot: "https://example.text/files",
+	}
+	memory := NewMemory(fsParams)
+	memory.SetContentAddressed(true)
+
+	data := strings.NewReader("weight ticket")
+	checksum, err := ComputeChecksum(data)
+	if err != nil {
+		t.Fatalf("could not compute checksum: %s", err)
+	}
+	key, err := ContentAddressedKey(checksum)
+	if err != nil {
+		t.Fatalf("could not build key: %s", err)
+	}
+	if _, err = memory.Store(key, data, checksum, nil); err != nil {
+		t.Fatalf("could not store in memory: %s", err)
+	}
+
+	err = memory.Delete(key)
+	if !errors.Is(err, ErrContentAddressedDelete) {
+		t.Fatalf("expected content-addressed delete to be rejected, got %v", err)
+	}
+	if _, err = memory.Fetch(key); err != nil {
+		t.Fatalf("object should still be stored: %s", err)
+	}
+
+	if err = DeleteUnreferenced(memory, key); err != nil {
+		t.Fatalf("could not delete unreferenced object: %s", err)
+	}
+	if _, err = memory.Fetch(key); err == nil {
+		t.Fatal("object should be deleted")
+	}
+}
@@ -17,11 +17,12 @@ import (
 
 // S3 implements the file storage API using S3.
 type S3 struct {
-	bucket       string
-	keyNamespace string
-	client       *s3.Client
-	fs           *afero.Afero
-	tempFs       *afero.Afero
+	bucket           string
+	keyNamespace     string
+	client           *s3.Client
+	fs               *afero.Afero
+	tempFs           *afero.Afero
+	contentAddressed bool
 }
 
 // NewS3 creates a new S3 using the provided AWS session.
@@ -46,6 +47,19 @@ func (s *S3) Store(key string, data io.ReadSeeker, checksum string, tags *string
 
 	namespacedKey := path.Join(s.keyNamespace, key)
 
+	// Content-addressed keys are only ever written with identical data, so an existing
+	// object can be shared rather than rewritten. Its tags, including the anti-virus
+	// status, carry over to every upload that references it.
+	if IsContentAddressedKey(key) {
+		exists, err := s.exists(namespacedKey)
+		if err != nil {
+			return nil, err
+		}
+		if exists {
+			return &StoreResult{Deduplicated: true}, nil
+		}
+	}
+
 	input := &s3.PutObjectInput{
 		Bucket:               &s.bucket,
 		Key:                  &namespacedKey,
@@ -56,6 +70,10 @@ func (s *S3) Store(key string, data io.ReadSeeker, checksum string, tags *string
 	if tags != nil {
 		input.Tagging = tags
 	}
+	// Have S3 reject the write if the data does not match the key it is addressed by
+	if IsContentAddressedKey(key) && checksum != "" {
+		input.ChecksumSHA256 = &checksum
+	}
 
 	if _, err := s.client.PutObject(context.Background(),
 		input); err != nil {
@@ -65,9 +83,42 @@ func (s *S3) Store(key string, data io.ReadSeeker, checksum string, tags *string
 	return &StoreResult{}, nil
 }
 
+// exists returns true if an object is present at the namespaced key
+func (s *S3) exists(namespacedKey string) (bool, error) {
+	input := &s3.HeadObjectInput{
+		Bucket: &s.bucket,
+		Key:    &namespacedKey,
+	}
+
+	_, err := s.client.HeadObject(context.Background(), input)
+	if err != nil {
+		var notFound *types.NotFound
+		if errors.As(err, &notFound) {
+			return false, nil
+		}
+		return false, errors.Wrap(err, "head object on S3 failed")
+	}
+
+	return true, nil
+}
+
 // Delete deletes an object at a specified key
 // Use with caution, deletions are disabled on our S3 buckets as per our ATO.
+// Content-addressed keys are rejected, see DeleteContentAddressed.
 func (s *S3) Delete(key string) error {
+	if IsContentAddressedKey(key) {
+		return ErrContentAddressedDelete
+	}
+	return s.deleteObject(key)
+}
+
+// DeleteContentAddressed deletes an object at a content-addressed key. It must only be
+// called once no upload references the key.
+func (s *S3) DeleteContentAddressed(key string) error {
+	return s.deleteObject(key)
+}
+
+func (s *S3) deleteObject(key string) error {
 	namespacedKey := path.Join(s.keyNamespace, key)
 
 	input := &s3.DeleteObjectInput{
@@ -113,6 +164,16 @@ func (s *S3) TempFileSystem() *afero.Afero {
 	return s.tempFs
 }
 
+// SetContentAddressed enables or disables content-addressed keys for new uploads
+func (s *S3) SetContentAddressed(enabled bool) {
+	s.contentAddressed = enabled
+}
+
+// ContentAddressed returns true if new uploads should use content-addressed keys
+func (s *S3) ContentAddressed() bool {
+	return s.contentAddressed
+}
+
 // PresignedURL returns a URL that provides access to a file for 15 minutes.
 func (s *S3) PresignedURL(key string, contentType string, filename string) (string, error) {
 	namespacedKey := path.Join(s.keyNamespace, key)
@@ -4,8 +4,10 @@ import (
 	"context"
 	"crypto/sha256"
 	"encoding/base64"
+	"encoding/hex"
 	"io"
 	"path"
+	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/config"
 	"github.com/gabriel-vasile/mimetype"
@@ -17,8 +19,16 @@ import (
 	"github.com/transcom/mymove/pkg/cli"
 )
 
+// ContentAddressedKeyPrefix is the key prefix under which objects are stored by their
+// SHA256 checksum when content-addressed storage is enabled.
+const ContentAddressedKeyPrefix = "content/sha256"
+
 // StoreResult represents the result of a call to Store().
-type StoreResult struct{}
+type StoreResult struct {
+	// Deduplicated is true when the data was already present at a content-addressed key
+	// and nothing new was written.
+	Deduplicated bool
+}
 
 // FileStorer is the set of methods needed to store and retrieve objects.
 //
@@ -33,6 +43,65 @@ type FileStorer interface {
 	Tags(string) (map[string]string, error)
 }
 
+// ContentAddresser is implemented by storage backends that can be configured to
+// store uploads under keys derived from their content.
+type ContentAddresser interface {
+	ContentAddressed() bool
+}
+
+// IsContentAddressed returns true if new uploads written through the storer should be
+// stored under a content-addressed key.
+func IsContentAddressed(storer FileStorer) bool {
+	if addresser, ok := storer.(ContentAddresser); ok {
+		return addresser.ContentAddressed()
+	}
+	return false
+}
+
+// ContentAddressedKey returns the storage key for content with the given checksum, as
+// returned by ComputeChecksum. Identical content always maps to the same key.
+func ContentAddressedKey(checksum string) (string, error) {
+	sum, err := base64.StdEncoding.DecodeString(checksum)
+	if err != nil {
+		return "", errors.Wrap(err, "could not decode checksum")
+	}
+	if len(sum) != sha256.Size {
+		return "", errors.Errorf("checksum is %d bytes, expected %d", len(sum), sha256.Size)
+	}
+	return path.Join(ContentAddressedKeyPrefix, hex.EncodeToString(sum)), nil
+}
+
+// IsContentAddressedKey returns true if the key was produced by ContentAddressedKey.
+//
+// Objects at these keys may be shared by several uploads, so they must not be deleted
+// while any upload still references them.
+func IsContentAddressedKey(key string) bool {
+	return strings.HasPrefix(key, ContentAddressedKeyPrefix+"/")
+}
+
+// ErrContentAddressedDelete is returned by Delete for content-addressed keys, which have
+// to go through DeleteUnreferenced once no upload references them.
+var ErrContentAddressedDelete = errors.New("content-addressed objects may be shared and cannot be deleted directly")
+
+// ContentAddressedDeleter is implemented by storage backends that can remove objects
+// stored under content-addressed keys.
+type ContentAddressedDeleter interface {
+	DeleteContentAddressed(string) error
+}
+
+// DeleteUnreferenced deletes the object at the key, including objects at content-addressed
+// keys. The caller must have established that no upload still references the key.
+func DeleteUnreferenced(storer FileStorer, key string) error {
+	if !IsContentAddressedKey(key) {
+		return storer.Delete(key)
+	}
+	deleter, ok := storer.(ContentAddressedDeleter)
+	if !ok {
+		return errors.Errorf("storer cannot delete content-addressed key %s", key)
+	}
+	return deleter.DeleteContentAddressed(key)
+}
+
 // ComputeChecksum calculates the SHA256 checksum for the provided data. It expects that
 // the passed io object will be seeked to its beginning and will seek back to the
 // beginning after reading its content.
@@ -73,6 +142,7 @@ func InitStorage(v *viper.Viper, logger *zap.Logger) FileStorer {
 	storageBackend := v.GetString(cli.StorageBackendFlag)
 	localStorageRoot := v.GetString(cli.LocalStorageRootFlag)
 	localStorageWebRoot := v.GetString(cli.LocalStorageWebRootFlag)
+	contentAddressed := v.GetBool(cli.StorageContentAddressedFlag)
 
 	var storer FileStorer
 	if storageBackend == "s3" {
@@ -101,19 +171,28 @@ func InitStorage(v *viper.Viper, logger *zap.Logger) FileStorer {
 			logger.Fatal("error loading S3 aws config", zap.Error(err))
 		}
 
-		storer = NewS3(awsS3Bucket, awsS3KeyNamespace, cfg)
+		s3Storer := NewS3(awsS3Bucket, awsS3KeyNamespace, cfg)
+		s3Storer.SetContentAddressed(contentAddressed)
+		storer = s3Storer
 	} else if storageBackend == "memory" {
 		logger.Info("Using memory storage backend",
 			zap.String(cli.LocalStorageRootFlag, path.Join(localStorageRoot, localStorageWebRoot)),
 			zap.String(cli.LocalStorageWebRootFlag, localStorageWebRoot))
 		fsParams := NewMemoryParams(localStorageRoot, localStorageWebRoot)
-		storer = NewMemory(fsParams)
+		memoryStorer := NewMemory(fsParams)
+		memoryStorer.SetContentAddressed(contentAddressed)
+		storer = memoryStorer
 	} else {
 		logger.Info("Using local storage backend",
 			zap.String(cli.LocalStorageRootFlag, path.Join(localStorageRoot, localStorageWebRoot)),
 			zap.String(cli.LocalStorageWebRootFlag, localStorageWebRoot))
 		fsParams := NewFilesystemParams(localStorageRoot, localStorageWebRoot)
-		storer = NewFilesystem(fsParams)
+		filesystemStorer := NewFilesystem(fsParams)
+		filesystemStorer.SetContentAddressed(contentAddressed)
+		storer = filesystemStorer
+	}
+	if contentAddressed {
+		logger.Info("Content-addressed storage enabled for new uploads")
 	}
 	return storer
 }
@@ -0,0 +1,40 @@
+package storage
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestContentAddressedKey(t *testing.T) {
+	checksum, err := ComputeChecksum(strings.NewReader("anyValue"))
+	if err != nil {
+		t.Fatalf("could not compute checksum: %s", err)
+	}
+
+	key, err := ContentAddressedKey(checksum)
+	if err != nil {
+		t.Fatalf("could not build key: %s", err)
+	}
+	if !IsContentAddressedKey(key) {
+		t.Errorf("expected %s to be a content-addressed key", key)
+	}
+	if !strings.HasPrefix(key, "content/sha256/") || len(key) != len("content/sha256/")+64 {
+		t.Errorf("unexpected content-addressed key %s", key)
+	}
+
+	again, err := ContentAddressedKey(checksum)
+	if err != nil || again != key {
+		t.Errorf("expected the same checksum to produce the same key, got %s and %s", key, again)
+	}
+
+	if IsContentAddressedKey("user/1234/uploads/5678") {
+		t.Error("upload keys should not be treated as content-addressed")
+	}
+
+	if _, err := ContentAddressedKey("not a checksum"); err == nil {
+		t.Error("expected an error for an invalid checksum")
+	}
+	if _, err := ContentAddressedKey("YWJj"); err == nil {
+		t.Error("expected an error for a checksum of the wrong length")
+	}
+}
@@ -21,8 +21,20 @@ type FakeS3Storage struct {
 	EmptyTags   bool // Used for testing only
 }
 
-// Delete removes a file.
+// Delete removes a file. Content-addressed keys are rejected like the real storers do.
 func (fake *FakeS3Storage) Delete(key string) error {
+	if storage.IsContentAddressedKey(key) {
+		return storage.ErrContentAddressedDelete
+	}
+	return fake.remove(key)
+}
+
+// DeleteContentAddressed removes a file at a content-addressed key.
+func (fake *FakeS3Storage) DeleteContentAddressed(key string) error {
+	return fake.remove(key)
+}
+
+func (fake *FakeS3Storage) remove(key string) error {
 	f, err := fake.fs.Open(key)
 	if err != nil {
 		return err
@@ -0,0 +1,81 @@
+package telemetry
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.uber.org/zap"
+)
+
+// DistanceCacheResult describes how a mileage lookup was answered
+type DistanceCacheResult string
+
+const (
+	// DistanceCacheHit means a fresh cached mileage was used
+	DistanceCacheHit DistanceCacheResult = "hit"
+	// DistanceCacheMiss means mileage had to be requested from DTOD
+	DistanceCacheMiss DistanceCacheResult = "miss"
+	// DistanceCacheStale means an expired cached mileage was used because DTOD was failing
+	DistanceCacheStale DistanceCacheResult = "stale"
+)
+
+const (
+	RouteTelemetryName    = "github.com/transcom/mymove/route"
+	RouteTelemetryVersion = "0.1"
+
+	distanceCacheResultKey = attribute.Key("route.distance_cache.result")
+)
+
+type RouteTelemetry struct {
+	distanceCacheCounter metric.Int64Counter
+	circuitOpenCounter   metric.Int64Counter
+}
+
+// NewRouteTelemetry provides counters for the DTOD mileage cache and
+// circuit breaker so we can see how often pricing is served from the
+// cache and how often DTOD is failing
+func NewRouteTelemetry(logger *zap.Logger) *RouteTelemetry {
+	meterProvider := otel.GetMeterProvider()
+
+	routeMeter := meterProvider.Meter(RouteTelemetryName,
+		metric.WithInstrumentationVersion(RouteTelemetryVersion))
+
+	distanceCacheCounter, err := routeMeter.Int64Counter("route.distance_cache.lookups",
+		metric.WithDescription("Count of DTOD mileage lookups by cache result"),
+	)
+	if err != nil {
+		logger.Error("Error registering distance cache counter", zap.Error(err))
+		return nil
+	}
+
+	circuitOpenCounter, err := routeMeter.Int64Counter("route.dtod.circuit_open",
+		metric.WithDescription("Count of times the DTOD circuit breaker has opened"),
+	)
+	if err != nil {
+		logger.Error("Error registering DTOD circuit breaker counter", zap.Error(err))
+		return nil
+	}
+
+	return &RouteTelemetry{
+		distanceCacheCounter: distanceCacheCounter,
+		circuitOpenCounter:   circuitOpenCounter,
+	}
+}
+
+// IncrementDistanceCacheLookup records how a mileage lookup was answered
+func (rt *RouteTelemetry) IncrementDistanceCacheLookup(ctx context.Context, result DistanceCacheResult) {
+	if rt == nil {
+		return
+	}
+	rt.distanceCacheCounter.Add(ctx, 1, metric.WithAttributes(distanceCacheResultKey.String(string(result))))
+}
+
+// IncrementCircuitOpen records the DTOD circuit breaker opening
+func (rt *RouteTelemetry) IncrementCircuitOpen(ctx context.Context) {
+	if rt == nil {
+		return
+	}
+	rt.circuitOpenCounter.Add(ctx, 1)
+}
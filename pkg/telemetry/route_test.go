@@ -0,0 +1,61 @@
+package telemetry
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+
+	"github.com/transcom/mymove/pkg/telemetry/metrictest"
+)
+
+func (suite *TelemetrySuite) TestRouteStats() {
+	// use memory metric to see what is reported
+
+	config := &Config{
+		Enabled:          true,
+		Endpoint:         "memory",
+		SamplingFraction: 1,
+		CollectSeconds:   0,
+		EnvironmentName:  "test",
+	}
+
+	shutdownFn, _, metricExporter := Init(suite.Logger(), config)
+	defer shutdownFn()
+
+	rt := NewRouteTelemetry(suite.Logger())
+	suite.NotNil(rt)
+
+	ctx := context.Background()
+	rt.IncrementDistanceCacheLookup(ctx, DistanceCacheHit)
+	rt.IncrementDistanceCacheLookup(ctx, DistanceCacheMiss)
+	rt.IncrementCircuitOpen(ctx)
+
+	mp := otel.GetMeterProvider()
+	mmp, ok := mp.(*sdkmetric.MeterProvider)
+	if !ok {
+		suite.FailNow("Cannot convert global metric provider to sdkmetric.MeterProvider")
+	}
+	// flush to export data
+	suite.NoError(mmp.ForceFlush(ctx))
+
+	mme, ok := metricExporter.(*metrictest.InMemoryExporter)
+	suite.FatalTrue(ok)
+	metrics := mme.GetMetrics()
+	suite.Equal(1, len(metrics))
+
+	metricData := metrics[0]
+	suite.Equal(1, len(metricData.ScopeMetrics))
+	// currently recording 2 route metrics: distance cache lookups and circuit opens
+	suite.Equal(2, len(metricData.ScopeMetrics[0].Metrics))
+	suite.Equal("github.com/transcom/mymove/route",
+		metricData.ScopeMetrics[0].Scope.Name)
+}
+
+func (suite *TelemetrySuite) TestNilRouteTelemetry() {
+	var rt *RouteTelemetry
+	suite.NotPanics(func() {
+		rt.IncrementDistanceCacheLookup(context.Background(), DistanceCacheHit)
+		rt.IncrementCircuitOpen(context.Background())
+	})
+}
@@ -19,6 +19,8 @@ func MakeWebhookSubscription(db *pop.Connection, assertions Assertions) models.W
 		Status:       models.WebhookSubscriptionStatusActive,
 		EventKey:     "PaymentRequest.Update",
 		CallbackURL:  "/my/callback/url",
+		// Not secret, only used to sign test deliveries
+		SigningSecret: models.StringPointer("3f1c5e0b2d9a4f6e8b7c1d2e3f4a5b6c7d8e9f0a1b2c3d4e5f6a7b8c9d0e1f2a"),
 	}
 
 	mergeModels(&webhookSubscription, assertions.WebhookSubscription)
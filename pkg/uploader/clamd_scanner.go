@@ -0,0 +1,116 @@
+package uploader
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"io"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// defaultClamdTimeout bounds a single scan when no timeout is configured
+	defaultClamdTimeout = 2 * time.Minute
+	// clamdChunkSize is the largest chunk written to clamd at once. It must stay below
+	// the daemon's StreamMaxLength.
+	clamdChunkSize = 64 * 1024
+)
+
+// ClamdScanner scans files with a clamd daemon using its INSTREAM command
+type ClamdScanner struct {
+	network string
+	address string
+	timeout time.Duration
+}
+
+// NewClamdScanner returns a Scanner that talks to clamd at the address. The network is
+// "tcp" or "unix".
+func NewClamdScanner(network string, address string, timeout time.Duration) *ClamdScanner {
+	if timeout <= 0 {
+		timeout = defaultClamdTimeout
+	}
+	return &ClamdScanner{
+		network: network,
+		address: address,
+		timeout: timeout,
+	}
+}
+
+// Scan streams data to clamd and parses its verdict
+func (c *ClamdScanner) Scan(ctx context.Context, data io.Reader) (ScanResult, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, c.network, c.address)
+	if err != nil {
+		return ScanResult{}, errors.Wrap(err, "could not connect to clamd")
+	}
+	defer conn.Close()
+
+	if deadline, ok := ctx.Deadline(); ok {
+		if err := conn.SetDeadline(deadline); err != nil {
+			return ScanResult{}, errors.Wrap(err, "could not set clamd deadline")
+		}
+	}
+
+	// The z prefix asks clamd for null-terminated commands and replies
+	if _, err := conn.Write([]byte("zINSTREAM\x00")); err != nil {
+		return ScanResult{}, errors.Wrap(err, "could not start clamd stream")
+	}
+
+	// Each chunk is prefixed with its length as a 4 byte big-endian integer and the
+	// stream ends with a zero length chunk
+	buf := make([]byte, clamdChunkSize)
+	size := make([]byte, 4)
+	for {
+		n, readErr := data.Read(buf)
+		if n > 0 {
+			binary.BigEndian.PutUint32(size, uint32(n))
+			if _, err := conn.Write(size); err != nil {
+				return ScanResult{}, errors.Wrap(err, "could not write to clamd")
+			}
+			if _, err := conn.Write(buf[:n]); err != nil {
+				return ScanResult{}, errors.Wrap(err, "could not write to clamd")
+			}
+		}
+		if readErr == io.EOF {
+			break
+		}
+		if readErr != nil {
+			return ScanResult{}, errors.Wrap(readErr, "could not read file to scan")
+		}
+	}
+	binary.BigEndian.PutUint32(size, 0)
+	if _, err := conn.Write(size); err != nil {
+		return ScanResult{}, errors.Wrap(err, "could not finish clamd stream")
+	}
+
+	reply, err := bufio.NewReader(conn).ReadString('\x00')
+	if err != nil && err != io.EOF {
+		return ScanResult{}, errors.Wrap(err, "could not read clamd reply")
+	}
+
+	return parseClamdReply(strings.TrimRight(reply, "\x00\n"))
+}
+
+// parseClamdReply interprets a reply such as "stream: OK" or
+// "stream: Eicar-Test-Signature FOUND"
+func parseClamdReply(reply string) (ScanResult, error) {
+	verdict := strings.TrimSpace(strings.TrimPrefix(reply, "stream:"))
+	switch {
+	case verdict == "OK":
+		return ScanResult{}, nil
+	case strings.HasSuffix(verdict, " FOUND"):
+		return ScanResult{
+			Infected:  true,
+			Signature: strings.TrimSuffix(verdict, " FOUND"),
+		}, nil
+	default:
+		return ScanResult{}, errors.Errorf("unexpected clamd reply %q", reply)
+	}
+}
@@ -0,0 +1,175 @@
+package uploader
+
+import (
+	"bytes"
+	"io"
+	"net/url"
+	"sort"
+
+	"github.com/gofrs/uuid"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"github.com/transcom/mymove/pkg/appcontext"
+	"github.com/transcom/mymove/pkg/models"
+	"github.com/transcom/mymove/pkg/storage"
+)
+
+// ContentAddressedMigrationParams controls a run of MigrateToContentAddressedKeys
+type ContentAddressedMigrationParams struct {
+	// BatchSize is the number of uploads read from the database at a time
+	BatchSize int
+	// DryRun reports what would change without writing objects or updating uploads
+	DryRun bool
+	// DeleteOldObjects removes the original object once no upload references its key
+	DeleteOldObjects bool
+}
+
+// ContentAddressedMigrationResult summarizes a run of MigrateToContentAddressedKeys
+type ContentAddressedMigrationResult struct {
+	Migrated       int
+	Deduplicated   int
+	Skipped        int
+	DeletedObjects int
+}
+
+// MigrateToContentAddressedKeys moves the files of existing uploads to content-addressed
+// keys so that duplicate files share one stored object. Each upload is re-keyed in its
+// own transaction; an upload whose stored file does not match its checksum is skipped and
+// left where it is.
+func MigrateToContentAddressedKeys(appCtx appcontext.AppContext, storer storage.FileStorer, params ContentAddressedMigrationParams) (ContentAddressedMigrationResult, error) {
+	var result ContentAddressedMigrationResult
+	if params.BatchSize <= 0 {
+		return result, errors.New("batch size must be greater than zero")
+	}
+
+	lastID := uuid.Nil
+	for {
+		var uploads models.Uploads
+		err := appCtx.DB().Q().
+			Where("deleted_at IS NULL AND storage_key NOT LIKE ? AND id > ?", storage.ContentAddressedKeyPrefix+"/%", lastID).
+			Order("id ASC").
+			Limit(params.BatchSize).
+			All(&uploads)
+		if err != nil {
+			return result, errors.Wrap(err, "could not fetch uploads to migrate")
+		}
+		if len(uploads) == 0 {
+			return result, nil
+		}
+
+		for i := range uploads {
+			upload := uploads[i]
+			lastID = upload.ID
+
+			err := migrateUploadToContentAddressedKey(appCtx, storer, &upload, params, &result)
+			if err != nil {
+				return result, errors.Wrapf(err, "could not migrate upload %s", upload.ID)
+			}
+		}
+	}
+}
+
+func migrateUploadToContentAddressedKey(appCtx appcontext.AppContext, storer storage.FileStorer, upload *models.Upload, params ContentAddressedMigrationParams, result *ContentAddressedMigrationResult) error {
+	logger := appCtx.Logger().With(zap.String("upload_id", upload.ID.String()), zap.String("key", upload.StorageKey))
+
+	contentKey, err := storage.ContentAddressedKey(upload.Checksum)
+	if err != nil {
+		logger.Warn("skipping upload with an invalid checksum", zap.Error(err))
+		result.Skipped++
+		return nil
+	}
+
+	data, err := fetchAll(storer, upload.StorageKey)
+	if err != nil {
+		logger.Warn("skipping upload whose file could not be fetched", zap.Error(err))
+		result.Skipped++
+		return nil
+	}
+
+	reader := bytes.NewReader(data)
+	checksum, err := storage.ComputeChecksum(reader)
+	if err != nil {
+		return err
+	}
+	if checksum != upload.Checksum {
+		logger.Warn("skipping upload whose file does not match its checksum", zap.String("stored_checksum", checksum))
+		result.Skipped++
+		return nil
+	}
+
+	if params.DryRun {
+		logger.Info("would move upload to content-addressed key", zap.String("new_key", contentKey))
+		result.Migrated++
+		return nil
+	}
+
+	oldKey := upload.StorageKey
+	err = appCtx.NewTransaction(func(txnAppCtx appcontext.AppContext) error {
+		// Hold both keys so a purge can't delete the shared object this upload is moving to, or check
+		// the old key's references while it still counts this upload. Keys are locked in order so two
+		// migrations can't deadlock.
+		lockKeys := []string{oldKey, contentKey}
+		sort.Strings(lockKeys)
+		for _, key := range lockKeys {
+			if err := models.LockUploadStorageKey(txnAppCtx.DB(), key); err != nil {
+				return err
+			}
+		}
+
+		// Keep the anti-virus status and any other tags with the object
+		var tags *string
+		existingTags, err := storer.Tags(oldKey)
+		if err != nil {
+			return err
+		}
+		if len(existingTags) > 0 {
+			values := url.Values{}
+			for key, value := range existingTags {
+				values.Set(key, value)
+			}
+			encoded := values.Encode()
+			tags = &encoded
+		}
+
+		storeResult, err := storer.Store(contentKey, reader, checksum, tags)
+		if err != nil {
+			return err
+		}
+		if storeResult != nil && storeResult.Deduplicated {
+			result.Deduplicated++
+		}
+
+		return txnAppCtx.DB().RawQuery("UPDATE uploads SET storage_key = ? WHERE id = ?", contentKey, upload.ID).Exec()
+	})
+	if err != nil {
+		return err
+	}
+	upload.StorageKey = contentKey
+	result.Migrated++
+	logger.Info("moved upload to content-addressed key", zap.String("new_key", contentKey))
+
+	if !params.DeleteOldObjects {
+		return nil
+	}
+	// the old object is only deleted once the new key is committed, so a rollback can't leave the
+	// upload pointing at a deleted object
+	deleted, err := deleteStoredFileIfUnreferenced(appCtx, storer, oldKey)
+	if err != nil {
+		return err
+	}
+	if deleted {
+		result.DeletedObjects++
+	}
+	return nil
+}
+
+func fetchAll(storer storage.FileStorer, key string) ([]byte, error) {
+	file, err := storer.Fetch(key)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	return io.ReadAll(file)
+}
@@ -0,0 +1,194 @@
+package uploader
+
+import (
+	"context"
+	"io"
+	"net/url"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/viper"
+	"go.uber.org/zap"
+
+	"github.com/transcom/mymove/pkg/appcontext"
+	"github.com/transcom/mymove/pkg/cli"
+	"github.com/transcom/mymove/pkg/models"
+	"github.com/transcom/mymove/pkg/pubsub"
+	"github.com/transcom/mymove/pkg/storage"
+)
+
+// ScanResult is the outcome of scanning a file for viruses
+type ScanResult struct {
+	Infected bool
+	// Signature names the threat that was found when Infected is true
+	Signature string
+}
+
+// Scanner checks the content of an upload for viruses.
+//
+// In deployed environments S3 tags the stored objects and no Scanner is configured.
+// A Scanner lets the filesystem and memory storage backends report an anti-virus
+// status too.
+type Scanner interface {
+	Scan(ctx context.Context, data io.Reader) (ScanResult, error)
+}
+
+// ScanningFileStorer attaches a Scanner to a FileStorer. Uploaders created with it scan
+// every new upload and record the result on the upload.
+type ScanningFileStorer struct {
+	storage.FileStorer
+	scanner Scanner
+	pubSub  pubsub.PubSub
+}
+
+// NewScanningFileStorer returns a FileStorer that scans uploads with the given Scanner
+func NewScanningFileStorer(storer storage.FileStorer, scanner Scanner) *ScanningFileStorer {
+	return &ScanningFileStorer{
+		FileStorer: storer,
+		scanner:    scanner,
+	}
+}
+
+// Scanner returns the Scanner used for new uploads
+func (s *ScanningFileStorer) Scanner() Scanner {
+	return s.scanner
+}
+
+// SetPubSub sets the PubSub that scan results are announced on, so that requests
+// streaming the status of an upload hear about them
+func (s *ScanningFileStorer) SetPubSub(pubSub pubsub.PubSub) {
+	s.pubSub = pubSub
+}
+
+// ContentAddressed returns true if the wrapped storer uses content-addressed keys
+func (s *ScanningFileStorer) ContentAddressed() bool {
+	return storage.IsContentAddressed(s.FileStorer)
+}
+
+// DeleteContentAddressed deletes an object at a content-addressed key through the wrapped storer
+func (s *ScanningFileStorer) DeleteContentAddressed(key string) error {
+	deleter, ok := s.FileStorer.(storage.ContentAddressedDeleter)
+	if !ok {
+		return errors.Errorf("storer cannot delete content-addressed key %s", key)
+	}
+	return deleter.DeleteContentAddressed(key)
+}
+
+// scannerForStorer returns the Scanner attached to the storer, if any
+func scannerForStorer(storer storage.FileStorer) Scanner {
+	if scanning, ok := storer.(*ScanningFileStorer); ok {
+		return scanning.Scanner()
+	}
+	return nil
+}
+
+// InitScanner wraps the storer with the Scanner selected by the command line flags.
+// The storer is returned unchanged when no scanner is configured.
+func InitScanner(v *viper.Viper, logger *zap.Logger, storer storage.FileStorer, pubSub pubsub.PubSub) (storage.FileStorer, error) {
+	var scanner Scanner
+	switch v.GetString(cli.UploadScannerFlag) {
+	case "stub":
+		logger.Info("Using stub upload scanner")
+		scanner = NewStubScanner()
+	case "clamd":
+		address, err := url.Parse(v.GetString(cli.ClamdAddressFlag))
+		if err != nil {
+			return nil, errors.Wrap(err, "could not parse clamd address")
+		}
+		network, addr := address.Scheme, address.Host
+		if network == "unix" {
+			addr = address.Path
+		}
+		logger.Info("Using clamd upload scanner", zap.String("network", network), zap.String("address", addr))
+		scanner = NewClamdScanner(network, addr, v.GetDuration(cli.ClamdTimeoutFlag))
+	default:
+		return storer, nil
+	}
+
+	scanningStorer := NewScanningFileStorer(storer, scanner)
+	scanningStorer.SetPubSub(pubSub)
+	return scanningStorer, nil
+}
+
+// AVStatus returns the anti-virus status of an upload. Uploads scanned by a Scanner
+// carry their own status; otherwise it comes from the tags on the stored object.
+func AVStatus(storer storage.FileStorer, upload models.Upload) models.AVStatusType {
+	if upload.ScanStatus != nil {
+		return upload.ScanStatus.AVStatus()
+	}
+
+	tags, err := storer.Tags(upload.StorageKey)
+	if err != nil {
+		return models.AVStatusPROCESSING
+	}
+	return models.GetAVStatusFromTags(tags)
+}
+
+// ScanUpload scans the stored file of an upload that is waiting to be scanned or whose
+// previous scan failed, and saves the result on the upload.
+func (u *Uploader) ScanUpload(appCtx appcontext.AppContext, upload *models.Upload) error {
+	if u.Scanner == nil {
+		return errors.New("no scanner is configured")
+	}
+
+	file, err := u.Storer.Fetch(upload.StorageKey)
+	if err != nil {
+		return errors.Wrap(err, "could not fetch upload to scan")
+	}
+	defer file.Close()
+
+	return u.scan(appCtx, upload, file)
+}
+
+// scan runs the Scanner over data and saves the resulting scan status on the upload.
+// A scan that cannot complete is recorded as failed rather than returned as an error so
+// that the upload itself still succeeds and can be scanned again later.
+func (u *Uploader) scan(appCtx appcontext.AppContext, upload *models.Upload, data io.Reader) error {
+	if err := upload.StartScan(); err != nil {
+		return err
+	}
+	if err := appCtx.DB().Update(upload); err != nil {
+		return errors.Wrap(err, "could not save scan status")
+	}
+
+	ctx := context.Background()
+	if request := appCtx.HTTPRequest(); request != nil {
+		ctx = request.Context()
+	}
+
+	start := time.Now()
+	result, scanErr := u.Scanner.Scan(ctx, data)
+	logger := appCtx.Logger().With(zap.String("upload_id", upload.ID.String()), zap.Duration("duration", time.Since(start)))
+	switch {
+	case scanErr != nil:
+		logger.Error("virus scan failed", zap.Error(scanErr))
+		if err := upload.FinishScanFailed(); err != nil {
+			return err
+		}
+	case result.Infected:
+		logger.Warn("virus scan found a threat", zap.String("signature", result.Signature))
+		if err := upload.FinishScanInfected(result.Signature); err != nil {
+			return err
+		}
+	default:
+		logger.Info("virus scan found no threats")
+		if err := upload.FinishScanClean(); err != nil {
+			return err
+		}
+	}
+
+	if err := appCtx.DB().Update(upload); err != nil {
+		return errors.Wrap(err, "could not save scan status")
+	}
+
+	if scanning, ok := u.Storer.(*ScanningFileStorer); ok && scanning.pubSub != nil {
+		status := upload.ScanStatus.AVStatus()
+		// Publish with the scan's transaction so listeners don't read the old status before it commits
+		publishCtx := pubsub.ContextWithConnection(ctx, appCtx.DB())
+		if err := scanning.pubSub.Publish(publishCtx, pubsub.UploadStatusTopic(upload.StorageKey), []byte(status)); err != nil {
+			// Listeners fall back to checking the upload periodically
+			logger.Warn("could not publish scan status", zap.Error(err))
+		}
+	}
+	return nil
+}
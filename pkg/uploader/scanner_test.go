@@ -0,0 +1,180 @@
+package uploader_test
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"io"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/transcom/mymove/pkg/models"
+	"github.com/transcom/mymove/pkg/storage"
+	"github.com/transcom/mymove/pkg/uploader"
+)
+
+// fakeClamd accepts a single INSTREAM command and replies with the verdict for the
+// streamed content
+func (suite *UploaderSuite) fakeClamd(verdict func(content string) string) string {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	suite.NoError(err)
+
+	go func() {
+		defer listener.Close()
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		reader := bufio.NewReader(conn)
+		command, err := reader.ReadString('\x00')
+		if err != nil || command != "zINSTREAM\x00" {
+			return
+		}
+
+		var content strings.Builder
+		size := make([]byte, 4)
+		for {
+			if _, err := io.ReadFull(reader, size); err != nil {
+				return
+			}
+			n := binary.BigEndian.Uint32(size)
+			if n == 0 {
+				break
+			}
+			chunk := make([]byte, n)
+			if _, err := io.ReadFull(reader, chunk); err != nil {
+				return
+			}
+			content.Write(chunk)
+		}
+
+		_, _ = conn.Write([]byte("stream: " + verdict(content.String()) + "\x00"))
+	}()
+
+	return listener.Addr().String()
+}
+
+func (suite *UploaderSuite) TestClamdScanner() {
+	verdict := func(content string) string {
+		if strings.Contains(content, uploader.EICARTestSignature) {
+			return "Eicar-Test-Signature FOUND"
+		}
+		return "OK"
+	}
+
+	suite.Run("clean file", func() {
+		scanner := uploader.NewClamdScanner("tcp", suite.fakeClamd(verdict), time.Second)
+		result, err := scanner.Scan(context.Background(), strings.NewReader("orders"))
+		suite.NoError(err)
+		suite.False(result.Infected)
+	})
+
+	suite.Run("infected file", func() {
+		scanner := uploader.NewClamdScanner("tcp", suite.fakeClamd(verdict), time.Second)
+		result, err := scanner.Scan(context.Background(), strings.NewReader(uploader.EICARTestSignature))
+		suite.NoError(err)
+		suite.True(result.Infected)
+		suite.Equal("Eicar-Test-Signature", result.Signature)
+	})
+
+	suite.Run("clamd error", func() {
+		scanner := uploader.NewClamdScanner("tcp", suite.fakeClamd(func(string) string { return "INSTREAM size limit exceeded. ERROR" }), time.Second)
+		_, err := scanner.Scan(context.Background(), strings.NewReader("orders"))
+		suite.Error(err)
+	})
+
+	suite.Run("clamd unavailable", func() {
+		listener, err := net.Listen("tcp", "127.0.0.1:0")
+		suite.NoError(err)
+		address := listener.Addr().String()
+		listener.Close()
+
+		scanner := uploader.NewClamdScanner("tcp", address, time.Second)
+		_, err = scanner.Scan(context.Background(), strings.NewReader("orders"))
+		suite.Error(err)
+	})
+}
+
+func (suite *UploaderSuite) TestStubScanner() {
+	scanner := uploader.NewStubScanner()
+
+	result, err := scanner.Scan(context.Background(), strings.NewReader("orders"))
+	suite.NoError(err)
+	suite.False(result.Infected)
+
+	result, err = scanner.Scan(context.Background(), strings.NewReader("header "+uploader.EICARTestSignature+" trailer"))
+	suite.NoError(err)
+	suite.True(result.Infected)
+}
+
+func (suite *UploaderSuite) TestScanningFileStorerDeletesContentAddressed() {
+	memory := storage.NewMemory(storage.NewMemoryParams("tmp", "storage"))
+	storer := uploader.NewScanningFileStorer(memory, uploader.NewStubScanner())
+
+	file := suite.fixture("test.pdf")
+	checksum, err := storage.ComputeChecksum(file)
+	suite.NoError(err)
+	key, err := storage.ContentAddressedKey(checksum)
+	suite.NoError(err)
+	_, err = storer.Store(key, file, checksum, nil)
+	suite.NoError(err)
+
+	suite.NoError(storage.DeleteUnreferenced(storer, key))
+	_, err = memory.Fetch(key)
+	suite.Error(err)
+}
+
+func (suite *UploaderSuite) TestCreateUploadScanned() {
+	storer := uploader.NewScanningFileStorer(storage.NewMemory(storage.NewMemoryParams("tmp", "storage")), uploader.NewStubScanner())
+	up, err := uploader.NewUploader(storer, 25*uploader.MB, models.UploadTypeUSER)
+	suite.NoError(err)
+	suite.NotNil(up.Scanner)
+
+	suite.Run("clean upload", func() {
+		upload, verrs, err := up.CreateUpload(suite.AppContextForTest(), uploader.File{File: suite.fixture("test.pdf")}, uploader.AllowedTypesPDF)
+		suite.NoError(err)
+		suite.False(verrs.HasAny())
+		suite.Equal(models.UploadScanStatusCLEAN, *upload.ScanStatus)
+		suite.NotNil(upload.ScannedAt)
+		suite.Equal(models.AVStatusCLEAN, uploader.AVStatus(storer, *upload))
+
+		reloaded, err := models.FetchUpload(suite.DB(), upload.ID)
+		suite.NoError(err)
+		suite.Equal(models.UploadScanStatusCLEAN, *reloaded.ScanStatus)
+	})
+
+	suite.Run("infected upload", func() {
+		file, err := suite.fs.Create("eicar.txt")
+		suite.NoError(err)
+		_, err = file.WriteString(uploader.EICARTestSignature)
+		suite.NoError(err)
+		_, err = file.Seek(0, io.SeekStart)
+		suite.NoError(err)
+		suite.closeFile(file)
+
+		upload, verrs, err := up.CreateUpload(suite.AppContextForTest(), uploader.File{File: file}, uploader.AllowedTypesAny)
+		suite.NoError(err)
+		suite.False(verrs.HasAny())
+		suite.Equal(models.UploadScanStatusINFECTED, *upload.ScanStatus)
+		suite.Equal("Eicar-Test-Signature", *upload.ScanSignature)
+		suite.Equal(models.AVStatusINFECTED, uploader.AVStatus(storer, *upload))
+	})
+
+	suite.Run("failed scans can be retried", func() {
+		failing := uploader.NewScanningFileStorer(storage.NewMemory(storage.NewMemoryParams("tmp", "storage")), uploader.NewClamdScanner("tcp", "127.0.0.1:1", time.Second))
+		failingUploader, err := uploader.NewUploader(failing, 25*uploader.MB, models.UploadTypeUSER)
+		suite.NoError(err)
+
+		upload, _, err := failingUploader.CreateUpload(suite.AppContextForTest(), uploader.File{File: suite.fixture("test.pdf")}, uploader.AllowedTypesPDF)
+		suite.NoError(err)
+		suite.Equal(models.UploadScanStatusFAILED, *upload.ScanStatus)
+		suite.Equal(models.AVStatusPROCESSING, uploader.AVStatus(failing, *upload))
+
+		failingUploader.Scanner = uploader.NewStubScanner()
+		suite.NoError(failingUploader.ScanUpload(suite.AppContextForTest(), upload))
+		suite.Equal(models.UploadScanStatusCLEAN, *upload.ScanStatus)
+	})
+}
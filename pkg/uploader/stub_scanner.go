@@ -0,0 +1,36 @@
+package uploader
+
+import (
+	"bytes"
+	"context"
+	"io"
+
+	"github.com/pkg/errors"
+)
+
+// EICARTestSignature is the industry standard anti-virus test string. Files containing
+// it are reported as infected by every scanner, including the StubScanner.
+const EICARTestSignature = `X5O!P%@AP[4\PZX54(P^)7CC)7}$EICAR-STANDARD-ANTIVIRUS-TEST-FILE!$H+H*`
+
+// StubScanner is an in-process Scanner for development and tests. It reports files
+// containing the EICAR test string as infected so the infected-file paths can be
+// exercised without running clamd.
+type StubScanner struct{}
+
+// NewStubScanner returns a new StubScanner
+func NewStubScanner() *StubScanner {
+	return &StubScanner{}
+}
+
+// Scan looks for the EICAR test string in data
+func (s *StubScanner) Scan(_ context.Context, data io.Reader) (ScanResult, error) {
+	content, err := io.ReadAll(data)
+	if err != nil {
+		return ScanResult{}, errors.Wrap(err, "could not read file to scan")
+	}
+
+	if bytes.Contains(content, []byte(EICARTestSignature)) {
+		return ScanResult{Infected: true, Signature: "Eicar-Test-Signature"}, nil
+	}
+	return ScanResult{}, nil
+}
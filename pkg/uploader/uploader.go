@@ -110,6 +110,7 @@ type File struct {
 // generating pre-signed URLs for file access, and deleting Uploads.
 type Uploader struct {
 	Storer            storage.FileStorer
+	Scanner           Scanner
 	UploadStorageKey  string
 	DefaultStorageKey string
 	FileSizeLimit     ByteSize
@@ -123,6 +124,7 @@ func NewUploader(storer storage.FileStorer, fileSizeLimit ByteSize, uploadType m
 	}
 	return &Uploader{
 		Storer:           storer,
+		Scanner:          scannerForStorer(storer),
 		UploadStorageKey: "",
 		FileSizeLimit:    fileSizeLimit,
 		UploadType:       uploadType,
@@ -156,6 +158,20 @@ func (u *Uploader) PrepareFileForUpload(appCtx appcontext.AppContext, file io.Re
 
 func (u *Uploader) createAndPushUploadToS3(appCtx appcontext.AppContext, file File, upload *models.Upload) (*models.Upload, *validate.Errors, error) {
 
+	if u.Scanner != nil {
+		if err := upload.QueueScan(); err != nil {
+			return nil, validate.NewErrors(), err
+		}
+	}
+
+	// Hold the key until the transaction commits so a concurrent purge can't delete a
+	// shared object after this upload was deduplicated against it
+	if storage.IsContentAddressedKey(upload.StorageKey) {
+		if err := models.LockUploadStorageKey(appCtx.DB(), upload.StorageKey); err != nil {
+			return nil, validate.NewErrors(), fmt.Errorf("could not lock storage key %w", err)
+		}
+	}
+
 	verrs, err := appCtx.DB().ValidateAndCreate(upload)
 	if err != nil || verrs.HasAny() {
 		appCtx.Logger().Error("Error creating new upload", zap.Error(err))
@@ -163,12 +179,26 @@ func (u *Uploader) createAndPushUploadToS3(appCtx appcontext.AppContext, file Fi
 	}
 
 	// Push file to S3
-	if _, err := u.Storer.Store(upload.StorageKey, file.File, upload.Checksum, file.Tags); err != nil {
+	storeResult, err := u.Storer.Store(upload.StorageKey, file.File, upload.Checksum, file.Tags)
+	if err != nil {
 		responseVErrors := validate.NewErrors()
 		appCtx.Logger().Error("failed to store object", zap.Error(err))
 		responseVErrors.Append(verrs)
 		return nil, responseVErrors, fmt.Errorf("failed to store object %w", err)
 	}
+	if storeResult != nil && storeResult.Deduplicated {
+		appCtx.Logger().Info("upload content already stored, sharing existing object", zap.Any("upload_id", upload.ID), zap.String("key", upload.StorageKey))
+	}
+
+	if u.Scanner != nil {
+		if _, err := file.File.Seek(0, io.SeekStart); err != nil {
+			return nil, verrs, fmt.Errorf("could not seek to beginning of file %w", err)
+		}
+		if err := u.scan(appCtx, upload, file.File); err != nil {
+			appCtx.Logger().Error("failed to scan upload", zap.Error(err))
+			return nil, verrs, fmt.Errorf("failed to scan upload %w", err)
+		}
+	}
 
 	appCtx.Logger().Info("created an upload with id and key ", zap.Any("new_upload_id", upload.ID), zap.String("key", upload.StorageKey))
 	return upload, verrs, nil
@@ -232,6 +262,14 @@ func (u *Uploader) CreateUpload(appCtx appcontext.AppContext, file File, allowed
 	// Set the Upload.StorageKey if set
 	if u.UploadStorageKey != "" {
 		newUpload.StorageKey = u.UploadStorageKey
+	} else if storage.IsContentAddressed(u.Storer) {
+		// Duplicate files share a single stored object keyed by their checksum
+		contentKey, keyErr := storage.ContentAddressedKey(checksum)
+		if keyErr != nil {
+			appCtx.Logger().Error("Could not build content-addressed storage key", zap.Error(keyErr))
+			return nil, responseVErrors, keyErr
+		}
+		newUpload.StorageKey = contentKey
 	} else if u.DefaultStorageKey != "" {
 		newUpload.StorageKey = path.Join(u.DefaultStorageKey, "uploads", id.String())
 	}
@@ -271,6 +309,56 @@ func (u *Uploader) DeleteUpload(appCtx appcontext.AppContext, upload *models.Upl
 	return models.DeleteUpload(appCtx.DB(), upload)
 }
 
+// PurgeUpload removes an Upload from the database and deletes its stored file once no
+// other Upload references it. Files at content-addressed keys may be shared by several
+// Uploads, so they are kept until the last reference is gone. The file is only deleted
+// after the Upload's removal commits, so call it outside of any other transaction.
+func (u *Uploader) PurgeUpload(appCtx appcontext.AppContext, upload *models.Upload) error {
+	err := appCtx.NewTransaction(func(txnAppCtx appcontext.AppContext) error {
+		if err := models.LockUploadStorageKey(txnAppCtx.DB(), upload.StorageKey); err != nil {
+			return err
+		}
+		return models.DeleteUpload(txnAppCtx.DB(), upload)
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = deleteStoredFileIfUnreferenced(appCtx, u.Storer, upload.StorageKey)
+	return err
+}
+
+// deleteStoredFileIfUnreferenced deletes the stored file at the key when no upload references it
+// any more, reporting whether it did. It must run after the transaction that dropped the last
+// reference commits, so a rollback can't leave an upload pointing at a deleted file. It holds the
+// key's lock while it checks and deletes, so no upload can start sharing the file in between.
+func deleteStoredFileIfUnreferenced(appCtx appcontext.AppContext, storer storage.FileStorer, key string) (bool, error) {
+	deleted := false
+	err := appCtx.NewTransaction(func(txnAppCtx appcontext.AppContext) error {
+		if err := models.LockUploadStorageKey(txnAppCtx.DB(), key); err != nil {
+			return err
+		}
+
+		references, err := models.CountUploadStorageKeyReferences(txnAppCtx.DB(), key)
+		if err != nil {
+			return err
+		}
+		if references > 0 {
+			txnAppCtx.Logger().Info("keeping stored file that is still referenced",
+				zap.String("key", key),
+				zap.Int("references", references))
+			return nil
+		}
+
+		if err := storage.DeleteUnreferenced(storer, key); err != nil {
+			return err
+		}
+		deleted = true
+		return nil
+	})
+	return deleted, err
+}
+
 // Download fetches an Upload's file and stores it in a tempfile. The path to this
 // file is returned.
 //
@@ -256,3 +256,129 @@ func (suite *UploaderSuite) TestDeleteUpload() {
 	suite.NoError(err)
 	suite.NotNil(upload.DeletedAt)
 }
+
+func (suite *UploaderSuite) TestCreateUploadContentAddressed() {
+	storer := storage.NewMemory(storage.NewMemoryParams("tmp", "storage"))
+	storer.SetContentAddressed(true)
+	up, err := uploader.NewUploader(storer, 25*uploader.MB, models.UploadTypeUSER)
+	suite.NoError(err)
+
+	first, verrs, err := up.CreateUpload(suite.AppContextForTest(), uploader.File{File: suite.fixture("test.pdf")}, uploader.AllowedTypesPDF)
+	suite.NoError(err)
+	suite.False(verrs.HasAny())
+	second, verrs, err := up.CreateUpload(suite.AppContextForTest(), uploader.File{File: suite.fixture("test.pdf")}, uploader.AllowedTypesPDF)
+	suite.NoError(err)
+	suite.False(verrs.HasAny())
+
+	suite.NotEqual(first.ID, second.ID)
+	suite.True(storage.IsContentAddressedKey(first.StorageKey))
+	suite.Equal(first.StorageKey, second.StorageKey)
+
+	references, err := models.CountUploadStorageKeyReferences(suite.DB(), first.StorageKey)
+	suite.NoError(err)
+	suite.Equal(2, references)
+}
+
+func (suite *UploaderSuite) TestPurgeUploadKeepsSharedContent() {
+	storer := storage.NewMemory(storage.NewMemoryParams("tmp", "storage"))
+	storer.SetContentAddressed(true)
+	up, err := uploader.NewUploader(storer, 25*uploader.MB, models.UploadTypeUSER)
+	suite.NoError(err)
+
+	first, _, err := up.CreateUpload(suite.AppContextForTest(), uploader.File{File: suite.fixture("test.pdf")}, uploader.AllowedTypesPDF)
+	suite.NoError(err)
+	second, _, err := up.CreateUpload(suite.AppContextForTest(), uploader.File{File: suite.fixture("test.pdf")}, uploader.AllowedTypesPDF)
+	suite.NoError(err)
+
+	// The second upload still references the content, so it stays in storage
+	err = up.PurgeUpload(suite.AppContextForTest(), first)
+	suite.NoError(err)
+	suite.NotNil(first.DeletedAt)
+	download, err := up.Download(suite.AppContextForTest(), second)
+	suite.NoError(err)
+	download.Close()
+
+	// Once the last reference is gone the content is removed
+	err = up.PurgeUpload(suite.AppContextForTest(), second)
+	suite.NoError(err)
+	_, err = up.Download(suite.AppContextForTest(), second)
+	suite.Error(err)
+}
+
+func (suite *UploaderSuite) TestMigrateToContentAddressedKeys() {
+	storer := storage.NewMemory(storage.NewMemoryParams("tmp", "storage"))
+	up, err := uploader.NewUploader(storer, 25*uploader.MB, models.UploadTypeUSER)
+	suite.NoError(err)
+	up.DefaultStorageKey = "user/migration"
+
+	first, _, err := up.CreateUpload(suite.AppContextForTest(), uploader.File{File: suite.fixture("test.pdf")}, uploader.AllowedTypesPDF)
+	suite.NoError(err)
+	second, _, err := up.CreateUpload(suite.AppContextForTest(), uploader.File{File: suite.fixture("test.pdf")}, uploader.AllowedTypesPDF)
+	suite.NoError(err)
+	suite.NotEqual(first.StorageKey, second.StorageKey)
+
+	suite.Run("dry run leaves uploads in place", func() {
+		result, err := uploader.MigrateToContentAddressedKeys(suite.AppContextForTest(), storer, uploader.ContentAddressedMigrationParams{BatchSize: 1, DryRun: true})
+		suite.NoError(err)
+		suite.GreaterOrEqual(result.Migrated, 2)
+
+		reloaded, err := models.FetchUpload(suite.DB(), first.ID)
+		suite.NoError(err)
+		suite.Equal(first.StorageKey, reloaded.StorageKey)
+	})
+
+	suite.Run("duplicates share one object", func() {
+		result, err := uploader.MigrateToContentAddressedKeys(suite.AppContextForTest(), storer, uploader.ContentAddressedMigrationParams{BatchSize: 1, DeleteOldObjects: true})
+		suite.NoError(err)
+		suite.GreaterOrEqual(result.Migrated, 2)
+		suite.GreaterOrEqual(result.Deduplicated, 1)
+
+		reloadedFirst, err := models.FetchUpload(suite.DB(), first.ID)
+		suite.NoError(err)
+		reloadedSecond, err := models.FetchUpload(suite.DB(), second.ID)
+		suite.NoError(err)
+		suite.True(storage.IsContentAddressedKey(reloadedFirst.StorageKey))
+		suite.Equal(reloadedFirst.StorageKey, reloadedSecond.StorageKey)
+
+		_, err = storer.Fetch(first.StorageKey)
+		suite.Error(err)
+		download, err := up.Download(suite.AppContextForTest(), reloadedFirst)
+		suite.NoError(err)
+		download.Close()
+	})
+
+	suite.Run("rejects an invalid batch size", func() {
+		_, err := uploader.MigrateToContentAddressedKeys(suite.AppContextForTest(), storer, uploader.ContentAddressedMigrationParams{})
+		suite.Error(err)
+	})
+}
+
+// failingStoreStorer fails every Store so that re-keying an upload rolls back
+type failingStoreStorer struct {
+	storage.FileStorer
+}
+
+func (f failingStoreStorer) Store(_ string, _ io.ReadSeeker, _ string, _ *string) (*storage.StoreResult, error) {
+	return nil, errors.New("store failed")
+}
+
+func (suite *UploaderSuite) TestMigrateToContentAddressedKeysKeepsObjectOnRollback() {
+	storer := storage.NewMemory(storage.NewMemoryParams("tmp", "storage"))
+	up, err := uploader.NewUploader(storer, 25*uploader.MB, models.UploadTypeUSER)
+	suite.NoError(err)
+	up.DefaultStorageKey = "user/rollback"
+
+	upload, _, err := up.CreateUpload(suite.AppContextForTest(), uploader.File{File: suite.fixture("test.pdf")}, uploader.AllowedTypesPDF)
+	suite.NoError(err)
+
+	_, err = uploader.MigrateToContentAddressedKeys(suite.AppContextForTest(), failingStoreStorer{storer}, uploader.ContentAddressedMigrationParams{BatchSize: 10, DeleteOldObjects: true})
+	suite.Error(err)
+
+	// the upload keeps its key and the object it points at is still there
+	reloaded, err := models.FetchUpload(suite.DB(), upload.ID)
+	suite.NoError(err)
+	suite.Equal(upload.StorageKey, reloaded.StorageKey)
+	download, err := up.Download(suite.AppContextForTest(), reloaded)
+	suite.NoError(err)
+	download.Close()
+}
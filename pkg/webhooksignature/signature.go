@@ -0,0 +1,125 @@
+// Package webhooksignature signs webhook notification deliveries and verifies those signatures.
+//
+// Each delivery carries the time it was sent in the TimestampHeader and one or more signatures in the
+// SignatureHeader. A signature is the hex encoded HMAC-SHA256 of "<timestamp>.<body>" keyed with one of the
+// subscription's signing secrets, prefixed with the scheme version, e.g. "v1=5257a869...". While a secret is being
+// rotated the delivery is signed with both the new and the previous secret, so receivers can switch over at their
+// own pace. Receivers should reject deliveries whose timestamp is too old to protect against replays.
+package webhooksignature
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// SignatureHeader holds the comma separated signatures of a delivery
+	SignatureHeader = "X-MilMove-Signature"
+	// TimestampHeader holds the unix time in seconds the delivery was signed
+	TimestampHeader = "X-MilMove-Timestamp"
+	// DefaultTolerance is how far a delivery's timestamp may be from the receiver's clock
+	DefaultTolerance = 5 * time.Minute
+
+	signatureVersion = "v1"
+	secretBytes      = 32
+)
+
+var (
+	// ErrMissingSignature means the delivery was not signed
+	ErrMissingSignature = errors.New("webhook signature is missing")
+	// ErrInvalidTimestamp means the timestamp header could not be parsed
+	ErrInvalidTimestamp = errors.New("webhook timestamp is invalid")
+	// ErrTimestampOutsideTolerance means the delivery was signed too long ago, or too far in the future
+	ErrTimestampOutsideTolerance = errors.New("webhook timestamp is outside the allowed tolerance")
+	// ErrNoMatchingSignature means none of the signatures were made with any of the receiver's secrets
+	ErrNoMatchingSignature = errors.New("webhook signature does not match")
+)
+
+// GenerateSecret returns a new random signing secret
+func GenerateSecret() (string, error) {
+	secret := make([]byte, secretBytes)
+	if _, err := rand.Read(secret); err != nil {
+		return "", fmt.Errorf("unable to generate webhook signing secret: %w", err)
+	}
+	return hex.EncodeToString(secret), nil
+}
+
+// Sign returns the hex encoded signature of the body sent at the timestamp using the secret
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// Headers returns the headers to send with the body, signed with each of the secrets. Empty secrets are ignored,
+// and no headers are returned if there aren't any secrets left.
+func Headers(secrets []string, timestamp time.Time, body []byte) http.Header {
+	header := http.Header{}
+	unix := timestamp.Unix()
+
+	var signatures []string
+	for _, secret := range secrets {
+		if secret == "" {
+			continue
+		}
+		signatures = append(signatures, signatureVersion+"="+Sign(secret, unix, body))
+	}
+	if len(signatures) == 0 {
+		return header
+	}
+
+	header.Set(TimestampHeader, strconv.FormatInt(unix, 10))
+	header.Set(SignatureHeader, strings.Join(signatures, ","))
+	return header
+}
+
+// Verify checks that the body was signed with at least one of the secrets within the tolerance of now. Receivers
+// that are rotating their secret should pass both the old and new secrets.
+func Verify(header http.Header, body []byte, secrets []string, tolerance time.Duration, now time.Time) error {
+	signatureValue := header.Get(SignatureHeader)
+	timestampValue := header.Get(TimestampHeader)
+	if signatureValue == "" || timestampValue == "" {
+		return ErrMissingSignature
+	}
+
+	timestamp, err := strconv.ParseInt(timestampValue, 10, 64)
+	if err != nil {
+		return ErrInvalidTimestamp
+	}
+	age := now.Sub(time.Unix(timestamp, 0))
+	if age > tolerance || age < -tolerance {
+		return ErrTimestampOutsideTolerance
+	}
+
+	for _, signature := range strings.Split(signatureValue, ",") {
+		version, value, found := strings.Cut(strings.TrimSpace(signature), "=")
+		if !found || version != signatureVersion {
+			// Skip schemes we don't understand so new ones can be added without breaking receivers
+			continue
+		}
+		received, err := hex.DecodeString(value)
+		if err != nil {
+			continue
+		}
+		for _, secret := range secrets {
+			if secret == "" {
+				continue
+			}
+			expected, _ := hex.DecodeString(Sign(secret, timestamp, body))
+			if hmac.Equal(received, expected) {
+				return nil
+			}
+		}
+	}
+	return ErrNoMatchingSignature
+}
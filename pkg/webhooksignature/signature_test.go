@@ -0,0 +1,97 @@
+package webhooksignature
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/transcom/mymove/pkg/testingsuite"
+)
+
+type signatureSuite struct {
+	testingsuite.BaseTestSuite
+}
+
+func TestSignatureSuite(t *testing.T) {
+	suite.Run(t, &signatureSuite{})
+}
+
+func (suite *signatureSuite) TestSign() {
+	// Known value so receivers in other languages have something to check against
+	suite.Equal("086f6aff7bd084c98679825129c5a64dbad88c760016d6d2c0fb123f27951d54", Sign("secret", 1700000000, []byte(`{"id":"1"}`)))
+	suite.NotEqual(Sign("secret", 1700000000, []byte("body")), Sign("secret", 1700000001, []byte("body")))
+	suite.NotEqual(Sign("secret", 1700000000, []byte("body")), Sign("other", 1700000000, []byte("body")))
+}
+
+func (suite *signatureSuite) TestGenerateSecret() {
+	first, err := GenerateSecret()
+	suite.NoError(err)
+	suite.Len(first, 64)
+
+	second, err := GenerateSecret()
+	suite.NoError(err)
+	suite.NotEqual(first, second)
+}
+
+func (suite *signatureSuite) TestHeaders() {
+	now := time.Unix(1700000000, 0)
+	body := []byte(`{"eventKey":"MTOShipment.Create"}`)
+
+	suite.Run("signs with every secret", func() {
+		header := Headers([]string{"new", "", "old"}, now, body)
+		suite.Equal("1700000000", header.Get(TimestampHeader))
+		suite.Equal("v1="+Sign("new", 1700000000, body)+",v1="+Sign("old", 1700000000, body), header.Get(SignatureHeader))
+	})
+
+	suite.Run("no secrets means no headers", func() {
+		header := Headers([]string{""}, now, body)
+		suite.Empty(header.Get(TimestampHeader))
+		suite.Empty(header.Get(SignatureHeader))
+	})
+}
+
+func (suite *signatureSuite) TestVerify() {
+	sentAt := time.Unix(1700000000, 0)
+	body := []byte(`{"eventKey":"MTOShipment.Create"}`)
+	header := Headers([]string{"new", "old"}, sentAt, body)
+
+	suite.Run("accepts a signature from any of the secrets", func() {
+		suite.NoError(Verify(header, body, []string{"new"}, DefaultTolerance, sentAt))
+		suite.NoError(Verify(header, body, []string{"old"}, DefaultTolerance, sentAt.Add(time.Minute)))
+		suite.NoError(Verify(header, body, []string{"unrelated", "old"}, DefaultTolerance, sentAt))
+	})
+
+	suite.Run("rejects a modified body", func() {
+		suite.ErrorIs(Verify(header, []byte(`{"eventKey":"MTOShipment.Update"}`), []string{"new"}, DefaultTolerance, sentAt), ErrNoMatchingSignature)
+	})
+
+	suite.Run("rejects an unknown secret", func() {
+		suite.ErrorIs(Verify(header, body, []string{"unrelated", ""}, DefaultTolerance, sentAt), ErrNoMatchingSignature)
+	})
+
+	suite.Run("rejects a replayed delivery", func() {
+		suite.ErrorIs(Verify(header, body, []string{"new"}, DefaultTolerance, sentAt.Add(DefaultTolerance+time.Second)), ErrTimestampOutsideTolerance)
+		suite.ErrorIs(Verify(header, body, []string{"new"}, DefaultTolerance, sentAt.Add(-DefaultTolerance-time.Second)), ErrTimestampOutsideTolerance)
+	})
+
+	suite.Run("rejects a changed timestamp", func() {
+		changed := header.Clone()
+		changed.Set(TimestampHeader, "1700000001")
+		suite.ErrorIs(Verify(changed, body, []string{"new"}, DefaultTolerance, sentAt), ErrNoMatchingSignature)
+	})
+
+	suite.Run("rejects missing or malformed headers", func() {
+		suite.ErrorIs(Verify(http.Header{}, body, []string{"new"}, DefaultTolerance, sentAt), ErrMissingSignature)
+
+		badTimestamp := header.Clone()
+		badTimestamp.Set(TimestampHeader, "yesterday")
+		suite.ErrorIs(Verify(badTimestamp, body, []string{"new"}, DefaultTolerance, sentAt), ErrInvalidTimestamp)
+
+		badSignature := header.Clone()
+		badSignature.Set(SignatureHeader, "v1=not-hex,v0="+strings.Repeat("a", 64))
+		suite.ErrorIs(Verify(badSignature, body, []string{"new"}, DefaultTolerance, sentAt), ErrNoMatchingSignature)
+	})
+}
@@ -15,8 +15,12 @@ import Inaccessible, { INACCESSIBLE_API_RESPONSE } from 'shared/Inaccessible';
 import SomethingWentWrong from 'shared/SomethingWentWrong';
 import LockedMoveBanner from 'components/LockedMoveBanner/LockedMoveBanner';
 import { isBooleanFlagEnabled } from 'utils/featureFlags';
+import { renewMoveLock } from 'services/ghcApi';
 import EvaluationReportView from 'components/Office/EvaluationReportView/EvaluationReportView';
 
+// the server holds a move lock for 30 minutes, renew it well before then
+const MOVE_LOCK_HEARTBEAT_INTERVAL = 5 * 60 * 1000;
+
 const MoveDetails = lazy(() => import('pages/Office/MoveDetails/MoveDetails'));
 const MoveDocumentWrapper = lazy(() => import('pages/Office/MoveDocumentWrapper/MoveDocumentWrapper'));
 const MoveTaskOrder = lazy(() => import('pages/Office/MoveTaskOrder/MoveTaskOrder'));
@@ -65,6 +69,24 @@ const TXOMoveInfo = () => {
     fetchData();
   }, [move, officeUserID, moveLockFlag]);
 
+  // while this user holds the lock on the move, keep renewing it so it doesn't expire and become available to others
+  useEffect(() => {
+    if (!moveLockFlag || !move?.id || !officeUserID || move?.lockedByOfficeUserID !== officeUserID) {
+      return undefined;
+    }
+    const heartbeat = setInterval(async () => {
+      try {
+        await renewMoveLock(move.id);
+      } catch (error) {
+        // someone else took over the lock, so this user can no longer make changes
+        if (error?.response?.status === 409) {
+          setIsMoveLocked(true);
+        }
+      }
+    }, MOVE_LOCK_HEARTBEAT_INTERVAL);
+    return () => clearInterval(heartbeat);
+  }, [move?.id, move?.lockedByOfficeUserID, officeUserID, moveLockFlag]);
+
   const hideNav =
     matchPath(
       {
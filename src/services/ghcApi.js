@@ -1114,6 +1114,10 @@ export async function checkForLockedMovesAndUnlock(officeUserID) {
   });
 }
 
+export async function renewMoveLock(moveID) {
+  return makeGHCRequest('move.renewMoveLock', { moveID }, { normalize: false });
+}
+
 export async function deleteAssignedOfficeUserForMove({ moveID, queueType }) {
   return makeGHCRequest('move.deleteAssignedOfficeUser', {
     moveID,